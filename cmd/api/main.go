@@ -13,23 +13,32 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
 	"os"
-	"os/signal"
 	"time"
 
 	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/database"
 	"github.com/array/banking-api/internal/handlers"
+	"github.com/array/banking-api/internal/leader"
+	"github.com/array/banking-api/internal/lifecycle"
+	"github.com/array/banking-api/internal/logging"
 	"github.com/array/banking-api/internal/middleware"
 	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/scheduler"
 	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/telemetry"
 	"github.com/array/banking-api/internal/validation"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 var cfg *config.Config
@@ -52,6 +61,19 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 func main() {
 	cfg = config.Load()
 
+	// Logging and tracing are set up first so startup queries and log lines go through the
+	// shared, redacted pipeline and carry trace context
+	logHandler, err := logging.NewHandler(cfg.Logging, os.Stderr)
+	if err != nil {
+		log.Fatal("Failed to set up logging:", err)
+	}
+	slog.SetDefault(slog.New(telemetry.NewLogHandler(logHandler)))
+
+	shutdownTracing, err := telemetry.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		log.Fatal("Failed to set up tracing:", err)
+	}
+
 	// Initialize database
 	db, err := database.Initialize(cfg)
 	if err != nil {
@@ -68,28 +90,60 @@ func main() {
 	transferRepo := repositories.NewTransferRepository(db)
 	externalAccountRepo := repositories.NewExternalAccountRepository(db)
 	processingQueueRepo := repositories.NewProcessingQueueRepository(db)
+	inboundCreditRepo := repositories.NewInboundCreditRepository(db)
+	transferSagaRepo := repositories.NewTransferSagaRepository(db)
+	outboxRepo := repositories.NewOutboxRepository(db)
+	jobRepo := repositories.NewJobRepository(db)
 
 	// Initialize services
 	auditService := services.NewAuditService(auditLogRepo)
 	passwordService := services.NewPasswordService(userRepo, auditService)
 	tokenService := services.NewTokenService(&cfg.JWT)
-	// Initialize Northwind Client
-	northwindClient := services.NewNorthwindClient(cfg.Northwind.APIKey)
+	// Initialize Northwind Client; its own breaker keeps partner outages from tripping other callers
+	northwindClient := services.NewNorthwindClient(cfg.Northwind, services.NewCircuitBreaker(services.DefaultCircuitBreakerConfig()))
 
 	// Initialize Regulator Client and Webhook Service
 	regulatorClient := services.NewRegulatorClient(cfg.Regulator)
 	webhookNotificationRepo := repositories.NewWebhookNotificationRepository(db)
-	webhookService := services.NewWebhookService(webhookNotificationRepo, regulatorClient, cfg.Regulator)
+	prometheusMetrics := services.NewPrometheusMetrics()
+	webhookService := services.NewWebhookService(webhookNotificationRepo, regulatorClient, auditLogRepo, prometheusMetrics, cfg.Regulator)
+	webhookSubscriptionRepo := repositories.NewWebhookSubscriptionRepository(db)
+	customerWebhookService := services.NewCustomerWebhookService(webhookSubscriptionRepo, accountRepo, auditLogRepo)
+	complianceReportRepo := repositories.NewComplianceReportRepository(db)
+	complianceService := services.NewComplianceService(complianceReportRepo, auditLogRepo, regulatorClient, cfg.Compliance)
+	fraudRepo := repositories.NewFraudRepository(db)
+	fraudService := services.NewFraudScreeningService(fraudRepo, auditLogRepo, services.DefaultFraudRules(), cfg.Fraud)
+	sanctionsRepo := repositories.NewSanctionsRepository(db)
+	sanctionsService := services.NewSanctionsScreeningService(sanctionsRepo, userRepo, auditLogRepo, cfg.Sanctions)
+	transferReviewRepo := repositories.NewTransferReviewRepository(db)
+	transferReviewService := services.NewTransferReviewService(transferReviewRepo, auditLogRepo, services.DefaultTransferReviewTriggers(cfg.TransferReview), cfg.TransferReview)
+
+	auditLogger := services.NewAuditLogger(slog.Default())
+	circuitBreaker := services.NewCircuitBreaker(services.DefaultCircuitBreakerConfig())
+
+	processingService := services.NewTransactionProcessingService(
+		transactionRepo,
+		processingQueueRepo,
+		accountRepo,
+		auditLogger,
+		prometheusMetrics,
+		circuitBreaker,
+		cfg.ProcessingQueue,
+	)
 
 	accountService := services.NewAccountService(
 		accountRepo,
 		transactionRepo,
 		transferRepo,
 		externalAccountRepo,
-		webhookService,
+		transferSagaRepo,
 		northwindClient,
 		userRepo,
 		auditLogRepo,
+		fraudService,
+		sanctionsService,
+		transferReviewService,
+		processingService,
 		slog.Default(),
 	)
 
@@ -101,22 +155,11 @@ func main() {
 		passwordService,
 		tokenService,
 		accountService,
+		sanctionsService,
 		slog.Default(),
 	)
 
-	auditLogger := services.NewAuditLogger(slog.Default())
-	prometheusMetrics := services.NewPrometheusMetrics()
-	circuitBreaker := services.NewCircuitBreaker(services.DefaultCircuitBreakerConfig())
-
-	processingService := services.NewTransactionProcessingService(
-		transactionRepo,
-		processingQueueRepo,
-		accountRepo,
-		auditLogger,
-		prometheusMetrics,
-		circuitBreaker,
-		5,
-	)
+	queueAdminService := services.NewQueueAdminService(processingQueueRepo, auditLogRepo, processingService)
 
 	accountSummaryService := services.NewAccountSummaryService(accountRepo, userRepo)
 	accountMetricsService := services.NewAccountMetricsService(accountRepo, transactionRepo, userRepo)
@@ -124,8 +167,9 @@ func main() {
 
 	// Customer management services
 	customerSearchService := services.NewCustomerSearchService(userRepo)
-	customerProfileService := services.NewCustomerProfileService(userRepo, accountRepo, auditService)
-	externalAccountService := services.NewExternalAccountService(externalAccountRepo, northwindClient)
+	customerProfileService := services.NewCustomerProfileService(userRepo, accountRepo, auditService, sanctionsService)
+	externalAccountService := services.NewExternalAccountService(externalAccountRepo, transferRepo, auditLogRepo, northwindClient, sanctionsService, cfg.Northwind)
+	inboundCreditService := services.NewInboundCreditService(inboundCreditRepo, accountService, auditLogRepo, northwindClient, cfg.Northwind)
 	accountAssociationService := services.NewAccountAssociationService(userRepo, accountRepo, auditService, slog.Default())
 	customerLogger := services.NewCustomerLogger(slog.Default())
 
@@ -133,41 +177,193 @@ func main() {
 		transferRepo,
 		accountService,
 		northwindClient,
-		webhookService,
+		cfg.TransferMonitor,
 	)
+	transferSagaRecoveryService := services.NewTransferSagaRecoveryService(transferSagaRepo, accountService, cfg.TransferMonitor.SagaGracePeriod)
+	outboxRelayService := services.NewOutboxRelayService(
+		outboxRepo,
+		auditLogRepo,
+		services.NewRegulatorWebhookConsumer(webhookService),
+		services.NewAuditEventConsumer(auditLogRepo),
+		services.NewMetricsEventConsumer(prometheusMetrics),
+		services.NewCustomerWebhookConsumer(customerWebhookService),
+	)
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatal("Failed to get sql.DB:", err)
+	}
 
-	processingCtx, cancelProcessing := context.WithCancel(context.Background())
-	defer cancelProcessing()
+	// Jobs that must not run on every replica at once are marked Singleton and run only on the
+	// instance holding the leader election lock. The interfaces stay nil when election is
+	// disabled, so every instance runs every job.
+	var elector *leader.Elector
+	var leadership scheduler.Leadership
+	var leaderStatus leader.StatusReporter
+	if cfg.LeaderElection.Enabled {
+		elector = leader.NewElector(
+			leader.NewPostgresLocker(sqlDB, cfg.LeaderElection.LockName),
+			cfg.ProcessingQueue.WorkerID,
+			cfg.LeaderElection.RetryInterval,
+			cfg.LeaderElection.KeepaliveInterval,
+		)
+		leadership, leaderStatus = elector, elector
+	}
 
-	go processingService.StartProcessing(processingCtx)
+	// The lifecycle manager owns readiness, the background loops and the shutdown sequence
+	lifecycleManager := lifecycle.NewManager(cfg.Shutdown.ReadinessDelay)
 
 	e := configureEcho()
 
-	// Start background services
-	go func() {
-		ticker := time.NewTicker(30 * time.Second) // Check every 30 seconds
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ticker.C:
-				transferMonitorService.MonitorPendingTransfers(context.Background())
-			case <-processingCtx.Done():
-				return
-			}
-		}
-	}()
-	go func() {
-		ticker := time.NewTicker(15 * time.Second) // Check every 15 seconds
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ticker.C:
-				webhookService.ProcessPendingWebhooks(context.Background())
-			case <-processingCtx.Done():
-				return
-			}
+	// Periodic background work runs under the scheduler, which records every run in job_runs.
+	// Schedules and timeouts can be overridden per job with JOB_SCHEDULES and JOB_TIMEOUTS.
+	jobScheduler := scheduler.New(jobRepo, auditLogRepo, leadership, cfg.ProcessingQueue.WorkerID)
+	backgroundJobs := []scheduler.Job{
+		{
+			// Finish external transfers interrupted by a crash or a change of leader as soon as
+			// this instance leads, then keep sweeping for submissions deferred while Northwind
+			// was unreachable.
+			Name:        "transfer-saga-recovery",
+			Description: "Resubmits or compensates external transfers whose saga stalled after the debit",
+			Schedule:    scheduler.Every(time.Minute),
+			RunOnStart:  true,
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				return scheduler.Counts{"resolved": int64(transferSagaRecoveryService.RecoverIncompleteSagas(ctx))}, nil
+			},
+		},
+		{
+			// Fallback reconciliation for missed Northwind status callbacks; each transfer backs off
+			// individually, so the schedule only bounds how quickly a due transfer is picked up.
+			Name:        "transfer-monitor",
+			Description: "Polls Northwind for external transfers whose status callback was missed",
+			Schedule:    scheduler.Every(30 * time.Second),
+			Jitter:      5 * time.Second,
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				transferMonitorService.MonitorPendingTransfers(ctx)
+				return nil, nil
+			},
+		},
+		{
+			// Dispatch domain events committed alongside state changes; each consumer checkpoints
+			// and backs off independently.
+			Name:        "outbox-relay",
+			Description: "Delivers committed domain events to the outbox consumers",
+			Schedule:    scheduler.Every(5 * time.Second),
+			Timeout:     time.Minute,
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				return scheduler.Counts{"delivered": int64(outboxRelayService.Relay(ctx))}, nil
+			},
+		},
+		{
+			Name:        "regulator-webhooks",
+			Description: "Sends pending regulator webhook notifications and records the dead-letter backlog",
+			Schedule:    scheduler.Every(15 * time.Second),
+			Jitter:      3 * time.Second,
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				webhookService.ProcessPendingWebhooks(ctx)
+				webhookService.RecordDeadLetterMetrics(ctx)
+				return nil, nil
+			},
+		},
+		{
+			Name:        "customer-webhooks",
+			Description: "Delivers pending customer webhook events to subscribed endpoints",
+			Schedule:    scheduler.Every(15 * time.Second),
+			Jitter:      3 * time.Second,
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				customerWebhookService.ProcessPendingDeliveries(ctx)
+				return nil, nil
+			},
+		},
+		{
+			// Generate yesterday's CTR and structuring reports once the business day has closed,
+			// and file reports admins have approved.
+			Name:        "compliance-reports",
+			Description: "Generates the previous business day's compliance reports and files approved ones",
+			Schedule:    scheduler.Every(time.Minute),
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				generateErr := complianceService.GeneratePreviousBusinessDay(ctx)
+				submitErr := complianceService.SubmitApprovedReports(ctx)
+				return nil, errors.Join(generateErr, submitErr)
+			},
+		},
+		{
+			// Every instance screens against its own in-memory copy of the sanctions list, so
+			// loading it runs everywhere
+			Name:        "sanctions-list-load",
+			Description: "Loads the sanctions list into this instance at startup and when the file changes",
+			Schedule:    scheduler.Every(cfg.Sanctions.ListCheckInterval),
+			RunOnStart:  true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				return nil, sanctionsService.LoadList(ctx)
+			},
+		},
+		{
+			// A new list version rescreens every customer and payee once, on the leader
+			Name:        "sanctions-list-refresh",
+			Description: "Reloads the sanctions list when the file changes and rescreens on a new version",
+			Schedule:    scheduler.Every(cfg.Sanctions.ListCheckInterval),
+			RunOnStart:  true,
+			Singleton:   true,
+			Timeout:     30 * time.Minute,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				return nil, sanctionsService.RefreshList(ctx)
+			},
+		},
+		{
+			Name:        "expired-token-cleanup",
+			Description: "Deletes expired refresh tokens, long-revoked refresh tokens and expired blacklist entries",
+			Schedule:    mustParseSchedule("30 3 * * *"),
+			Jitter:      10 * time.Minute,
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				expired, err := refreshTokenRepo.DeleteExpired(ctx)
+				if err != nil {
+					return nil, err
+				}
+				revoked, err := refreshTokenRepo.DeleteRevokedOlderThan(ctx, revokedTokenRetention)
+				if err != nil {
+					return nil, err
+				}
+				blacklisted, err := blacklistedTokenRepo.DeleteExpired(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return scheduler.Counts{"expired_refresh_tokens": expired, "revoked_refresh_tokens": revoked, "blacklisted_tokens": blacklisted}, nil
+			},
+		},
+		{
+			// Runs still marked running long after any job's timeout belong to an instance that
+			// stopped mid-run; mark them abandoned, then trim history past the retention period.
+			Name:        "job-run-retention",
+			Description: "Marks runs left behind by stopped instances as abandoned and deletes old run history",
+			Schedule:    scheduler.Every(time.Hour),
+			Jitter:      5 * time.Minute,
+			Singleton:   true,
+			Run: func(ctx context.Context) (scheduler.Counts, error) {
+				abandoned, err := jobRepo.AbandonRunsStartedBefore(ctx, time.Now().Add(-abandonedJobRunAge))
+				if err != nil {
+					return nil, err
+				}
+				deleted, err := jobRepo.DeleteRunsBefore(ctx, time.Now().Add(-cfg.Scheduler.RunRetention))
+				if err != nil {
+					return nil, err
+				}
+				return scheduler.Counts{"abandoned": abandoned, "deleted": deleted}, nil
+			},
+		},
+	}
+	for _, job := range backgroundJobs {
+		if err := registerJob(jobScheduler, job, cfg.Scheduler); err != nil {
+			log.Fatal("Failed to register background job:", err)
 		}
-	}()
+	}
 
 	authHandler := handlers.NewAuthHandler(authService)
 	adminHandler := handlers.NewAdminHandler(userRepo, auditLogRepo)
@@ -176,37 +372,122 @@ func main() {
 	accountSummaryHandler := handlers.NewAccountSummaryHandler(accountSummaryService, accountMetricsService, statementService)
 	devHandler := handlers.NewDevHandler(transactionRepo, accountRepo)
 	customerHandler := handlers.NewCustomerHandler(customerSearchService, customerProfileService, accountAssociationService, passwordService, auditService, customerLogger, prometheusMetrics)
-	healthCheckHandler := handlers.NewHealthCheckHandler(db, northwindClient)
+	healthCheckHandler := handlers.NewHealthCheckHandler(db, northwindClient, leaderStatus, lifecycleManager)
 	docsHandler := handlers.NewDocsHandler()
+	partnerWebhookHandler := handlers.NewPartnerWebhookHandler(inboundCreditService, transferMonitorService, cfg.Northwind.WebhookSecret, cfg.Northwind.WebhookTolerance)
+	inboundCreditHandler := handlers.NewInboundCreditHandler(inboundCreditService)
+	stuckTransferHandler := handlers.NewStuckTransferHandler(transferMonitorService)
+	outboxHandler := handlers.NewOutboxHandler(outboxRelayService)
+	customerWebhookHandler := handlers.NewCustomerWebhookHandler(customerWebhookService)
+	webhookNotificationHandler := handlers.NewWebhookNotificationHandler(webhookService)
+	complianceHandler := handlers.NewComplianceHandler(complianceService)
+	fraudHandler := handlers.NewFraudHandler(fraudService)
+	sanctionsHandler := handlers.NewSanctionsHandler(sanctionsService)
+	transferReviewHandler := handlers.NewTransferReviewHandler(transferReviewService, accountService)
+	queueAdminHandler := handlers.NewQueueAdminHandler(queueAdminService)
+	jobHandler := handlers.NewJobHandler(jobScheduler)
 
 	api := e.Group("/api/v1")
 	tokenSvc := tokenService.(*services.TokenService)
 	addAuthEndpoints(api, tokenSvc, blacklistedTokenRepo, authHandler)
 	addAccountEndpoints(api, tokenSvc, blacklistedTokenRepo, accountHandler, accountSummaryHandler, transactionHandler, customerHandler)
-	addCustomerEndpoints(api, tokenSvc, blacklistedTokenRepo, customerHandler, accountHandler)
+	addCustomerEndpoints(api, tokenSvc, blacklistedTokenRepo, customerHandler, accountHandler, customerWebhookHandler)
 	addDevEndpoints(api, tokenSvc, blacklistedTokenRepo, devHandler)
-	addAdminEndpoints(api, tokenSvc, blacklistedTokenRepo, adminHandler, accountHandler)
+	addAdminEndpoints(api, tokenSvc, blacklistedTokenRepo, adminHandler, accountHandler, inboundCreditHandler, stuckTransferHandler, outboxHandler, webhookNotificationHandler, complianceHandler, fraudHandler, sanctionsHandler, transferReviewHandler, queueAdminHandler, jobHandler)
+	addPartnerEndpoints(api, partnerWebhookHandler)
 	addHealthCheckEndpoint(api, healthCheckHandler)
 	addDocumentationEndpoints(e, docsHandler)
 
+	// Pool stats and background backlogs are read at scrape time, alongside the HTTP metrics
+	// recorded by the middleware and the counters registered by PrometheusMetrics.
+	prometheus.MustRegister(
+		collectors.NewDBStatsCollector(sqlDB, cfg.Database.Name),
+		services.NewBacklogCollector(processingService, webhookSubscriptionRepo, transferRepo),
+	)
+	metricsServer := addMetricsEndpoint(e)
+
+	// Background loops start only once setup has succeeded, so a failed startup leaves nothing
+	// half-running
+	lifecycleManager.Go("transaction queue", processingService.StartProcessing)
+	if elector != nil {
+		lifecycleManager.Go("leader election", elector.Run)
+	}
+	lifecycleManager.Go("job scheduler", func(ctx context.Context) {
+		jobScheduler.Start(ctx)
+		jobScheduler.Wait()
+	})
+
 	go func() {
 		if err := e.Start(":" + cfg.Server.Port); err != nil && err != http.ErrServerClosed {
-			log.Fatal("Failed to start server:", err)
+			lifecycleManager.Fail(fmt.Errorf("start server: %w", err))
 		}
 	}()
+	if metricsServer != nil {
+		go func() {
+			if err := metricsServer.Start(":" + cfg.Metrics.Port); err != nil && err != http.ErrServerClosed {
+				lifecycleManager.Fail(fmt.Errorf("start metrics server: %w", err))
+			}
+		}()
+	}
+	lifecycleManager.MarkReady()
+
+	// Once readiness has failed for SHUTDOWN_READINESS_DELAY: stop accepting requests while
+	// in-flight requests, claimed queue items and job runs such as webhook sends finish; then stop
+	// the background loops, interrupting anything still running; then flush telemetry and close
+	// the database, which everything before may still be using.
+	lifecycleManager.OnShutdown("drain", cfg.Shutdown.DrainTimeout, lifecycle.All(
+		e.Shutdown,
+		processingService.Drain,
+		jobScheduler.Drain,
+	))
+	lifecycleManager.OnShutdown("background loops", cfg.Shutdown.StopTimeout, lifecycleManager.StopLoops)
+	if metricsServer != nil {
+		lifecycleManager.OnShutdown("metrics server", cfg.Shutdown.StopTimeout, metricsServer.Shutdown)
+	}
+	lifecycleManager.OnShutdown("tracing", cfg.Shutdown.StopTimeout, shutdownTracing)
+	lifecycleManager.OnShutdown("database", 0, func(context.Context) error {
+		return sqlDB.Close()
+	})
+
+	startErr := lifecycleManager.Wait()
+	shutdownErr := lifecycleManager.Shutdown()
+	if startErr != nil || shutdownErr != nil {
+		os.Exit(1)
+	}
+}
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt)
-	<-quit
+const (
+	// revokedTokenRetention is how long revoked refresh tokens are kept, so reuse of a rotated
+	// token can still be detected, before expired-token-cleanup deletes them.
+	revokedTokenRetention = 30 * 24 * time.Hour
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	// abandonedJobRunAge is how long after starting a run still marked running is taken to belong
+	// to an instance that stopped mid-run. It must exceed every job's timeout.
+	abandonedJobRunAge = 6 * time.Hour
+)
 
-	if err := e.Shutdown(ctx); err != nil {
-		log.Fatal("Server forced to shut down:", err)
+// registerJob applies the configured schedule and timeout overrides to a job and registers it.
+func registerJob(jobScheduler *scheduler.Scheduler, job scheduler.Job, schedulerCfg config.SchedulerConfig) error {
+	if spec, ok := schedulerCfg.Schedules[job.Name]; ok {
+		schedule, err := scheduler.ParseSchedule(spec)
+		if err != nil {
+			return fmt.Errorf("%s: %w", job.Name, err)
+		}
+		job.Schedule = schedule
+	}
+	if timeout, ok := schedulerCfg.Timeouts[job.Name]; ok {
+		job.Timeout = timeout
 	}
+	return jobScheduler.Register(job)
+}
 
-	log.Println("Server shutdown complete")
+// mustParseSchedule parses a schedule written in code, where an invalid one is a programming error.
+func mustParseSchedule(spec string) scheduler.Schedule {
+	schedule, err := scheduler.ParseSchedule(spec)
+	if err != nil {
+		log.Fatal("Invalid background job schedule:", err)
+	}
+	return schedule
 }
 
 func configureEcho() *echo.Echo {
@@ -217,15 +498,19 @@ func configureEcho() *echo.Echo {
 	e.Validator = &CustomValidator{validator: customValidator.GetValidate()}
 	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
 
+	e.Use(middleware.Tracing())
 	e.Use(middleware.RequestID())
+	e.Use(middleware.HTTPMetrics())
 	e.Use(middleware.PanicRecovery())
-	e.Use(echomiddleware.Logger())
+	e.Use(middleware.RequestLogger(slog.Default()))
+	e.Use(middleware.ClientInfo())
+	e.Use(middleware.RequestTimeout(cfg.Server.RequestTimeout, cfg.Server.RouteTimeouts))
 	e.Use(middleware.RateLimiter())
 	e.Use(middleware.SecurityHeaders())
 	e.Use(echomiddleware.CORSWithConfig(echomiddleware.CORSConfig{
 		AllowOrigins: cfg.Server.CORSAllowOrigins,
 		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, middleware.TraceIDHeader},
+		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, middleware.TraceIDHeader, "traceparent", "tracestate"},
 	}))
 	return e
 }
@@ -248,9 +533,12 @@ func addAccountEndpoints(api *echo.Group, tokenService *services.TokenService, b
 	accountGroup.POST("/:accountId/transactions", accountHandler.PerformTransaction)
 	accountGroup.GET("/:accountId/transactions", transactionHandler.ListTransactions)
 	accountGroup.GET("/:accountId/transactions/:id", transactionHandler.GetTransaction)
+	accountGroup.GET("/:accountId/operations/:operationId", accountHandler.GetTransactionOperation)
 	accountGroup.POST("/:accountId/transfer", accountHandler.Transfer)
 	accountGroup.POST("/:accountId/external-transfer", accountHandler.InitiateExternalTransfer)
 	accountGroup.POST("/external", accountHandler.RegisterExternalAccount)
+	accountGroup.POST("/external/:externalAccountId/micro-deposits", accountHandler.SendMicroDeposits)
+	accountGroup.POST("/external/:externalAccountId/verify", accountHandler.VerifyExternalAccount)
 
 	// Summary endpoints
 	accountGroup.GET("/summary", accountSummaryHandler.GetAccountSummary)
@@ -269,10 +557,102 @@ func addDevEndpoints(api *echo.Group, tokenService *services.TokenService, black
 	}
 }
 
-func addAdminEndpoints(api *echo.Group, tokenService *services.TokenService, blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface, adminHandler *handlers.AdminHandler, accountHandler *handlers.AccountHandler) {
+func addAdminEndpoints(api *echo.Group, tokenService *services.TokenService, blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface, adminHandler *handlers.AdminHandler, accountHandler *handlers.AccountHandler, inboundCreditHandler *handlers.InboundCreditHandler, stuckTransferHandler *handlers.StuckTransferHandler, outboxHandler *handlers.OutboxHandler, webhookNotificationHandler *handlers.WebhookNotificationHandler, complianceHandler *handlers.ComplianceHandler, fraudHandler *handlers.FraudHandler, sanctionsHandler *handlers.SanctionsHandler, transferReviewHandler *handlers.TransferReviewHandler, queueAdminHandler *handlers.QueueAdminHandler, jobHandler *handlers.JobHandler) {
 	adminGroup := api.Group("/admin", middleware.RequireAuth(tokenService, blacklistedTokenRepo), middleware.RequireAdmin())
 	addAdminUserManagementEndpoints(adminGroup, adminHandler)
 	addAdminAccountManagementEndpoints(adminGroup, accountHandler)
+	addAdminInboundCreditEndpoints(adminGroup, inboundCreditHandler)
+	addAdminStuckTransferEndpoints(adminGroup, stuckTransferHandler)
+	addAdminOutboxEndpoints(adminGroup, outboxHandler)
+	addAdminWebhookNotificationEndpoints(adminGroup, webhookNotificationHandler)
+	addAdminComplianceEndpoints(adminGroup, complianceHandler)
+	addAdminFraudEndpoints(adminGroup, fraudHandler)
+	addAdminSanctionsEndpoints(adminGroup, sanctionsHandler)
+	addAdminTransferReviewEndpoints(adminGroup, transferReviewHandler)
+	addAdminQueueEndpoints(adminGroup, queueAdminHandler)
+	addAdminJobEndpoints(adminGroup, jobHandler)
+}
+
+func addAdminJobEndpoints(adminGroup *echo.Group, jobHandler *handlers.JobHandler) {
+	adminGroup.GET("/jobs", jobHandler.ListJobs)
+	adminGroup.GET("/jobs/:name/runs", jobHandler.ListRuns)
+	adminGroup.POST("/jobs/:name/run", jobHandler.TriggerJob)
+	adminGroup.POST("/jobs/:name/pause", jobHandler.PauseJob)
+	adminGroup.POST("/jobs/:name/resume", jobHandler.ResumeJob)
+}
+
+func addAdminQueueEndpoints(adminGroup *echo.Group, queueAdminHandler *handlers.QueueAdminHandler) {
+	adminGroup.GET("/queue/metrics", queueAdminHandler.GetMetrics)
+	adminGroup.GET("/queue/items", queueAdminHandler.ListItems)
+	adminGroup.POST("/queue/items/retry", queueAdminHandler.RetryItems)
+	adminGroup.GET("/queue/items/:id", queueAdminHandler.GetItem)
+	adminGroup.POST("/queue/items/:id/retry", queueAdminHandler.RetryItem)
+	adminGroup.POST("/queue/items/:id/reschedule", queueAdminHandler.RescheduleItem)
+	adminGroup.POST("/queue/items/:id/cancel", queueAdminHandler.CancelItem)
+	adminGroup.POST("/queue/items/:id/dead-letter", queueAdminHandler.DeadLetterItem)
+	adminGroup.GET("/queue/workers", queueAdminHandler.GetWorkerStatus)
+	adminGroup.POST("/queue/workers/pause", queueAdminHandler.PauseWorkers)
+	adminGroup.POST("/queue/workers/resume", queueAdminHandler.ResumeWorkers)
+	adminGroup.POST("/queue/cleanup", queueAdminHandler.CleanupCompleted)
+}
+
+func addAdminTransferReviewEndpoints(adminGroup *echo.Group, transferReviewHandler *handlers.TransferReviewHandler) {
+	adminGroup.GET("/transfer-reviews", transferReviewHandler.ListReviews)
+	adminGroup.GET("/transfer-reviews/:id", transferReviewHandler.GetReview)
+	adminGroup.POST("/transfer-reviews/:id/approve", transferReviewHandler.ApproveReview)
+	adminGroup.POST("/transfer-reviews/:id/reject", transferReviewHandler.RejectReview)
+}
+
+func addAdminSanctionsEndpoints(adminGroup *echo.Group, sanctionsHandler *handlers.SanctionsHandler) {
+	adminGroup.GET("/sanctions/list", sanctionsHandler.GetListStatus)
+	adminGroup.POST("/sanctions/rescreen", sanctionsHandler.Rescreen)
+	adminGroup.GET("/sanctions/matches", sanctionsHandler.ListMatches)
+	adminGroup.GET("/sanctions/matches/:id", sanctionsHandler.GetMatch)
+	adminGroup.POST("/sanctions/matches/:id/clear", sanctionsHandler.ClearMatch)
+	adminGroup.POST("/sanctions/matches/:id/confirm", sanctionsHandler.ConfirmMatch)
+}
+
+func addAdminFraudEndpoints(adminGroup *echo.Group, fraudHandler *handlers.FraudHandler) {
+	adminGroup.GET("/fraud/rules", fraudHandler.ListRules)
+	adminGroup.PUT("/fraud/rules/:name", fraudHandler.UpdateRule)
+	adminGroup.GET("/fraud/decisions", fraudHandler.ListDecisions)
+	adminGroup.GET("/fraud/decisions/:id", fraudHandler.GetDecision)
+}
+
+func addAdminComplianceEndpoints(adminGroup *echo.Group, complianceHandler *handlers.ComplianceHandler) {
+	adminGroup.GET("/compliance/reports", complianceHandler.ListReports)
+	adminGroup.POST("/compliance/reports/generate", complianceHandler.GenerateReports)
+	adminGroup.GET("/compliance/reports/:id", complianceHandler.GetReport)
+	adminGroup.GET("/compliance/reports/:id/file", complianceHandler.GetReportFile)
+	adminGroup.POST("/compliance/reports/:id/approve", complianceHandler.ApproveReport)
+	adminGroup.POST("/compliance/reports/:id/reject", complianceHandler.RejectReport)
+}
+
+func addAdminWebhookNotificationEndpoints(adminGroup *echo.Group, webhookNotificationHandler *handlers.WebhookNotificationHandler) {
+	adminGroup.GET("/webhooks/notifications", webhookNotificationHandler.ListNotifications)
+	adminGroup.POST("/webhooks/notifications/replay", webhookNotificationHandler.ReplayNotifications)
+	adminGroup.GET("/webhooks/notifications/:id", webhookNotificationHandler.GetNotification)
+	adminGroup.POST("/webhooks/notifications/:id/replay", webhookNotificationHandler.ReplayNotification)
+	adminGroup.POST("/webhooks/notifications/:id/resolve", webhookNotificationHandler.ResolveNotification)
+}
+
+func addAdminOutboxEndpoints(adminGroup *echo.Group, outboxHandler *handlers.OutboxHandler) {
+	adminGroup.GET("/outbox/consumers", outboxHandler.ListConsumers)
+	adminGroup.GET("/outbox/consumers/:consumer/events", outboxHandler.ListUndeliveredEvents)
+	adminGroup.POST("/outbox/consumers/:consumer/skip", outboxHandler.SkipEvent)
+	adminGroup.GET("/outbox/consumers/:consumer/dead-letters", outboxHandler.ListDeadLetters)
+	adminGroup.POST("/outbox/consumers/:consumer/dead-letters/:sequence/replay", outboxHandler.ReplayDeadLetter)
+}
+
+func addAdminStuckTransferEndpoints(adminGroup *echo.Group, stuckTransferHandler *handlers.StuckTransferHandler) {
+	adminGroup.GET("/transfers/stuck", stuckTransferHandler.ListStuckTransfers)
+	adminGroup.POST("/transfers/:transferId/requeue", stuckTransferHandler.RequeueStuckTransfer)
+}
+
+func addAdminInboundCreditEndpoints(adminGroup *echo.Group, inboundCreditHandler *handlers.InboundCreditHandler) {
+	adminGroup.GET("/inbound-credits", inboundCreditHandler.ListInboundCredits)
+	adminGroup.POST("/inbound-credits/:id/resolve", inboundCreditHandler.ResolveInboundCredit)
+	adminGroup.POST("/inbound-credits/:id/return", inboundCreditHandler.ReturnInboundCredit)
 }
 
 func addAdminAccountManagementEndpoints(adminGroup *echo.Group, accountHandler *handlers.AccountHandler) {
@@ -288,7 +668,7 @@ func addAdminUserManagementEndpoints(adminGroup *echo.Group, adminHandler *handl
 	adminGroup.DELETE("/users/:userId", adminHandler.DeleteUser)
 }
 
-func addCustomerEndpoints(api *echo.Group, tokenService *services.TokenService, blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface, customerHandler *handlers.CustomerHandler, accountHandler *handlers.AccountHandler) {
+func addCustomerEndpoints(api *echo.Group, tokenService *services.TokenService, blacklistedTokenRepo repositories.BlacklistedTokenRepositoryInterface, customerHandler *handlers.CustomerHandler, accountHandler *handlers.AccountHandler, customerWebhookHandler *handlers.CustomerWebhookHandler) {
 	// Admin-only customer management endpoints
 	adminCustomerGroup := api.Group("/customers", middleware.RequireAuth(tokenService, blacklistedTokenRepo), middleware.RequireAdmin())
 	adminCustomerGroup.GET("/search", customerHandler.SearchCustomers)
@@ -309,11 +689,60 @@ func addCustomerEndpoints(api *echo.Group, tokenService *services.TokenService,
 	selfServiceGroup.GET("/transfers", accountHandler.GetTransferHistory)
 	selfServiceGroup.GET("/activity", customerHandler.GetMyActivity)
 	selfServiceGroup.PUT("/password", customerHandler.UpdateMyPassword)
+
+	// Payee (external account) management
+	selfServiceGroup.GET("/external-accounts", accountHandler.ListExternalAccounts)
+	selfServiceGroup.GET("/external-accounts/:externalAccountId", accountHandler.GetExternalAccount)
+	selfServiceGroup.PATCH("/external-accounts/:externalAccountId", accountHandler.UpdateExternalAccount)
+	selfServiceGroup.DELETE("/external-accounts/:externalAccountId", accountHandler.DeleteExternalAccount)
+	selfServiceGroup.GET("/external-accounts/:externalAccountId/transfers", accountHandler.GetExternalAccountTransfers)
+
+	// Webhook subscriptions for account events
+	selfServiceGroup.POST("/webhooks", customerWebhookHandler.CreateSubscription)
+	selfServiceGroup.GET("/webhooks", customerWebhookHandler.ListSubscriptions)
+	selfServiceGroup.GET("/webhooks/:id", customerWebhookHandler.GetSubscription)
+	selfServiceGroup.PATCH("/webhooks/:id", customerWebhookHandler.UpdateSubscription)
+	selfServiceGroup.DELETE("/webhooks/:id", customerWebhookHandler.DeleteSubscription)
+	selfServiceGroup.GET("/webhooks/:id/deliveries", customerWebhookHandler.ListDeliveries)
+	selfServiceGroup.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", customerWebhookHandler.Redeliver)
+	selfServiceGroup.POST("/webhooks/:id/ping", customerWebhookHandler.Ping)
+}
+
+// addPartnerEndpoints registers webhook endpoints called by banking partners.
+// These are public; requests are authenticated by their HMAC signature instead of a JWT.
+func addPartnerEndpoints(api *echo.Group, partnerWebhookHandler *handlers.PartnerWebhookHandler) {
+	partnerGroup := api.Group("/partners")
+	partnerGroup.POST("/northwind/webhooks", partnerWebhookHandler.NorthwindWebhook)
 }
 
 // addDocumentationEndpoints registers the health check endpoint
+// addMetricsEndpoint exposes the Prometheus registry at /metrics. With METRICS_PORT it is served by
+// a separate server, returned so the caller can start and stop it; otherwise it is mounted on the
+// API only when METRICS_TOKEN is set. The token is enforced on either server when configured.
+func addMetricsEndpoint(e *echo.Echo) *echo.Echo {
+	var metricsServer *echo.Echo
+	target := e
+	switch {
+	case cfg.Metrics.Port != "":
+		metricsServer = echo.New()
+		metricsServer.HideBanner = true
+		target = metricsServer
+	case cfg.Metrics.Token == "":
+		slog.Warn("metrics endpoint disabled; set METRICS_PORT or METRICS_TOKEN to expose /metrics")
+		return nil
+	}
+
+	var mw []echo.MiddlewareFunc
+	if cfg.Metrics.Token != "" {
+		mw = append(mw, middleware.RequireMetricsToken(cfg.Metrics.Token))
+	}
+	target.GET("/metrics", echo.WrapHandler(promhttp.Handler()), mw...)
+	return metricsServer
+}
+
 func addHealthCheckEndpoint(api *echo.Group, healthCheckHandler *handlers.HealthCheckHandler) {
 	api.GET("/health", healthCheckHandler.HealthCheck)
+	api.GET("/health/ready", healthCheckHandler.ReadinessCheck)
 }
 
 // addDocumentationEndpoints registers API documentation routes
@@ -0,0 +1,96 @@
+// Command partnersim runs a local simulator of Northwind and the regulator so the API can be
+// developed and tested offline.
+//
+// Point the API at it with:
+//
+//	NORTHWIND_BASE_URL=http://localhost:8090/api/v1
+//	REGULATOR_WEBHOOK_URL=http://localhost:8090/regulator/webhooks
+//	REGULATOR_REPORT_URL=http://localhost:8090/regulator/reports
+//
+// Scenarios can be changed while running, for example:
+//
+//	curl -X PUT localhost:8090/_sim/scenario -d '{"completion_delay":"30s","failure_rate":0.2}'
+//	curl -X PUT localhost:8090/_sim/scenario -d '{"outage":true}'
+//	curl localhost:8090/_sim/state
+//	curl -X POST localhost:8090/_sim/transfers/<id>/fail -d '{"reason":"account closed"}'
+//	curl -X POST localhost:8090/_sim/credits -d '{"account_number":"1012345678","amount":"25.00"}'
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/array/banking-api/internal/northwindtest"
+)
+
+func main() {
+	addr := flag.String("addr", ":8090", "Address to listen on")
+	apiURL := flag.String("api-url", "http://localhost:8080", "Banking API base URL that webhooks are pushed to; empty disables pushes")
+	webhookSecret := flag.String("webhook-secret", os.Getenv("NORTHWIND_WEBHOOK_SECRET"), "Secret used to sign webhooks (defaults to NORTHWIND_WEBHOOK_SECRET)")
+	apiKey := flag.String("api-key", os.Getenv("NORTHWIND_API_KEY"), "Required X-Api-Key for Northwind API calls; empty accepts any (defaults to NORTHWIND_API_KEY)")
+	regulatorAPIKey := flag.String("regulator-api-key", os.Getenv("REGULATOR_WEBHOOK_API_KEY"), "Required X-Api-Key for regulator webhooks; empty accepts any (defaults to REGULATOR_WEBHOOK_API_KEY)")
+	regulatorSecret := flag.String("regulator-signing-secret", os.Getenv("REGULATOR_WEBHOOK_SIGNING_SECRET"), "Secret regulator webhook signatures are verified with; empty accepts unsigned webhooks (defaults to REGULATOR_WEBHOOK_SIGNING_SECRET)")
+	advanceInterval := flag.Duration("advance-interval", time.Second, "How often due transfers are resolved and status callbacks pushed")
+	latency := flag.Duration("latency", 0, "Latency added to every Northwind API response")
+	completionDelay := flag.Duration("completion-delay", 5*time.Second, "Time a transfer stays processing before it resolves")
+	failureRate := flag.Float64("failure-rate", 0, "Probability (0-1) that a transfer resolves as failed")
+	seed := flag.Uint64("seed", 0, "Seed for failure draws; zero picks a random seed")
+	flag.Parse()
+
+	var webhooks *northwindtest.WebhookSender
+	if *apiURL != "" && *webhookSecret != "" {
+		webhooks = northwindtest.NewWebhookSender(*apiURL, *webhookSecret)
+	} else {
+		slog.Warn("webhook pushes disabled: set -api-url and -webhook-secret to enable them")
+	}
+
+	var regulatorSecrets []string
+	if *regulatorSecret != "" {
+		regulatorSecrets = []string{*regulatorSecret}
+	}
+
+	sim := northwindtest.NewSimulator(northwindtest.Options{
+		APIKey:           *apiKey,
+		RegulatorAPIKey:  *regulatorAPIKey,
+		RegulatorSecrets: regulatorSecrets,
+		Webhooks:         webhooks,
+		Seed:             *seed,
+		Scenario: northwindtest.Scenario{
+			Latency:         northwindtest.Duration(*latency),
+			CompletionDelay: northwindtest.Duration(*completionDelay),
+			FailureRate:     *failureRate,
+		},
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	go sim.Run(ctx, *advanceInterval)
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           sim,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		slog.Info("partner simulator listening", "addr", *addr, "northwind_base_url", "http://localhost"+*addr+northwindtest.APIPrefix)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal("Failed to start partner simulator:", err)
+		}
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Fatal("Partner simulator forced to shutdown:", err)
+	}
+}
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_external_accounts_verification_status;
+DROP INDEX IF EXISTS idx_external_accounts_deleted_at;
+DROP INDEX IF EXISTS idx_external_accounts_user_id;
+
+-- Drop table
+DROP TABLE IF EXISTS external_accounts;
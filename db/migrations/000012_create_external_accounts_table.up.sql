@@ -0,0 +1,34 @@
+-- Create external_accounts table for registered payees at external banks
+CREATE TABLE IF NOT EXISTS external_accounts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    external_account_id UUID NOT NULL UNIQUE,
+    nickname VARCHAR(100) NOT NULL,
+    account_number_mask VARCHAR(4) NOT NULL,
+    name_on_account VARCHAR(255) NOT NULL,
+    bank_name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+);
+
+-- Micro-deposit verification columns. Added separately so databases where the table
+-- was created by GORM AutoMigrate pick them up as well. Existing payees start unverified.
+ALTER TABLE external_accounts
+    ADD COLUMN IF NOT EXISTS verification_status VARCHAR(30) NOT NULL DEFAULT 'unverified'
+        CHECK (verification_status IN ('unverified', 'micro_deposits_sent', 'verified', 'failed', 'locked')),
+    ADD COLUMN IF NOT EXISTS verification_attempts INTEGER NOT NULL DEFAULT 0 CHECK (verification_attempts >= 0),
+    ADD COLUMN IF NOT EXISTS micro_deposit_amount1 DECIMAL(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS micro_deposit_amount2 DECIMAL(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS micro_deposits_sent_at TIMESTAMP NULL,
+    ADD COLUMN IF NOT EXISTS verified_at TIMESTAMP NULL;
+
+-- Create indexes for external_accounts table
+CREATE INDEX IF NOT EXISTS idx_external_accounts_user_id ON external_accounts(user_id);
+CREATE INDEX IF NOT EXISTS idx_external_accounts_deleted_at ON external_accounts(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_external_accounts_verification_status ON external_accounts(verification_status);
+
+-- Add comments to table
+COMMENT ON TABLE external_accounts IS 'Payee accounts at external banks registered through Northwind';
+COMMENT ON COLUMN external_accounts.verification_status IS 'Micro-deposit ownership verification state';
+COMMENT ON COLUMN external_accounts.verification_attempts IS 'Confirmation attempts since micro-deposits were last sent (locks at 3)';
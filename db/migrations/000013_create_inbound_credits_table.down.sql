@@ -0,0 +1,2 @@
+-- Drop inbound_credits table
+DROP TABLE IF EXISTS inbound_credits;
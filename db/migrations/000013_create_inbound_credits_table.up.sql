@@ -0,0 +1,33 @@
+-- Create inbound_credits table for credit notifications received from banking partners
+CREATE TABLE IF NOT EXISTS inbound_credits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id VARCHAR(255) NOT NULL UNIQUE,
+    external_transfer_id VARCHAR(255),
+    account_number VARCHAR(20) NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    sender_name VARCHAR(255),
+    sender_account_id VARCHAR(255),
+    reference VARCHAR(255),
+    status VARCHAR(20) NOT NULL DEFAULT 'received'
+        CHECK (status IN ('received', 'posted', 'suspense', 'returned')),
+    suspense_reason TEXT,
+    account_id UUID REFERENCES accounts(id),
+    transaction_id UUID,
+    return_transfer_id VARCHAR(255),
+    resolved_by UUID REFERENCES users(id),
+    resolved_at TIMESTAMP NULL,
+    resolution_note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for inbound_credits table
+CREATE INDEX IF NOT EXISTS idx_inbound_credits_status ON inbound_credits(status);
+CREATE INDEX IF NOT EXISTS idx_inbound_credits_account_number ON inbound_credits(account_number);
+CREATE INDEX IF NOT EXISTS idx_inbound_credits_account_id ON inbound_credits(account_id);
+CREATE INDEX IF NOT EXISTS idx_inbound_credits_external_transfer_id ON inbound_credits(external_transfer_id);
+
+-- Add comments to table
+COMMENT ON TABLE inbound_credits IS 'Inbound credits pushed by banking partners; event_id deduplicates redeliveries';
+COMMENT ON COLUMN inbound_credits.status IS 'received, posted, suspense (awaiting admin review) or returned';
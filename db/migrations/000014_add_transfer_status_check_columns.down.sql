@@ -0,0 +1,8 @@
+-- Remove status check backoff and escalation columns from transfers
+DROP INDEX IF EXISTS idx_transfers_escalated_at;
+DROP INDEX IF EXISTS idx_transfers_next_status_check_at;
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS escalated_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS last_status_check_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS next_status_check_at;
+ALTER TABLE transfers DROP COLUMN IF EXISTS status_check_attempts;
@@ -0,0 +1,8 @@
+-- Track status check backoff and escalation for external transfers
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS status_check_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS next_status_check_at TIMESTAMP NULL;
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS last_status_check_at TIMESTAMP NULL;
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transfers_next_status_check_at ON transfers(next_status_check_at);
+CREATE INDEX IF NOT EXISTS idx_transfers_escalated_at ON transfers(escalated_at);
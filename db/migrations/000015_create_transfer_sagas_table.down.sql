@@ -0,0 +1,2 @@
+-- Drop transfer_sagas table
+DROP TABLE IF EXISTS transfer_sagas;
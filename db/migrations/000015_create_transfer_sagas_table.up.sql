@@ -0,0 +1,22 @@
+-- Create transfer_sagas table tracking the steps of external transfers for crash recovery
+CREATE TABLE IF NOT EXISTS transfer_sagas (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    transfer_id UUID NOT NULL UNIQUE REFERENCES transfers(id),
+    step VARCHAR(30) NOT NULL DEFAULT 'debit_posted'
+        CHECK (step IN ('debit_posted', 'partner_submitted', 'partner_confirmed', 'compensated')),
+    transfer_type VARCHAR(20),
+    debit_transaction_id UUID,
+    compensation_transaction_id UUID,
+    last_error TEXT,
+    recovery_attempts INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL
+);
+
+-- Create indexes for transfer_sagas table
+CREATE INDEX IF NOT EXISTS idx_transfer_sagas_step ON transfer_sagas(step);
+
+-- Add comments to table
+COMMENT ON TABLE transfer_sagas IS 'External transfer sagas; the recovery worker resumes or compensates sagas stuck at debit_posted';
+COMMENT ON COLUMN transfer_sagas.step IS 'debit_posted, partner_submitted, partner_confirmed or compensated (debit reversed exactly once)';
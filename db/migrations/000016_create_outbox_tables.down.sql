@@ -0,0 +1,3 @@
+-- Drop outbox tables
+DROP TABLE IF EXISTS outbox_checkpoints;
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,30 @@
+-- Create outbox_events table holding domain events written in the same transaction as the state change
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    event_type VARCHAR(100) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    payload JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for outbox_events table
+CREATE INDEX IF NOT EXISTS idx_outbox_events_event_type ON outbox_events(event_type);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_aggregate_id ON outbox_events(aggregate_id);
+
+-- Create outbox_checkpoints table tracking each consumer's position in the outbox
+CREATE TABLE IF NOT EXISTS outbox_checkpoints (
+    consumer VARCHAR(100) PRIMARY KEY,
+    last_event_id BIGINT NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Add comments to tables
+COMMENT ON TABLE outbox_events IS 'Transactional outbox; the relay delivers events to in-process consumers at least once, in id order';
+COMMENT ON COLUMN outbox_events.event_id IS 'Stable event identifier consumers use to deduplicate redeliveries';
+COMMENT ON TABLE outbox_checkpoints IS 'Per-consumer outbox position; events up to last_event_id have been handled';
+COMMENT ON COLUMN outbox_checkpoints.next_attempt_at IS 'Set while the consumer backs off after failing to handle the next event';
@@ -0,0 +1,3 @@
+-- Drop webhook_deliveries and webhook_subscriptions tables
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,50 @@
+-- Create webhook_subscriptions table holding customer endpoints for account event callbacks
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id),
+    url VARCHAR(512) NOT NULL,
+    description VARCHAR(255),
+    event_types VARCHAR(255) NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    low_balance_threshold DECIMAL(15,2) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMP NULL,
+    disabled_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for webhook_subscriptions table
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_user_id ON webhook_subscriptions(user_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_status ON webhook_subscriptions(status);
+
+-- Create webhook_deliveries table logging each event sent to a subscription
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY,
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_attempt_at TIMESTAMP NULL,
+    next_attempt_at TIMESTAMP NULL,
+    response_status_code INTEGER,
+    response_body TEXT,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for webhook_deliveries table
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_event ON webhook_deliveries(subscription_id, event_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_status ON webhook_deliveries(status);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_next_attempt_at ON webhook_deliveries(next_attempt_at);
+
+-- Add comments to tables
+COMMENT ON TABLE webhook_subscriptions IS 'Customer webhook endpoints; disabled automatically after repeated delivery failures';
+COMMENT ON COLUMN webhook_subscriptions.event_types IS 'Comma-separated event types: transaction.posted, transfer.completed, transfer.failed, balance.low';
+COMMENT ON COLUMN webhook_subscriptions.secret IS 'HMAC-SHA256 signing secret for deliveries; only returned to the customer on creation';
+COMMENT ON TABLE webhook_deliveries IS 'Delivery log and retry queue for customer webhooks';
+COMMENT ON COLUMN webhook_deliveries.event_id IS 'Event identifier sent to the customer; unchanged across retries and redeliveries';
@@ -0,0 +1,35 @@
+-- Create webhook_notifications table for regulator notifications, previously created only by GORM AutoMigrate
+CREATE TABLE IF NOT EXISTS webhook_notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    transfer_id UUID NOT NULL REFERENCES transfers(id),
+    url VARCHAR(512) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_attempt_at TIMESTAMP NULL,
+    next_attempt_at TIMESTAMP NULL,
+    response_body TEXT,
+    response_status_code INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Track the last delivery error, dead-lettering and admin resolution; tables created by AutoMigrate lack these
+ALTER TABLE webhook_notifications ADD COLUMN IF NOT EXISTS last_error TEXT NULL;
+ALTER TABLE webhook_notifications ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMP NULL;
+ALTER TABLE webhook_notifications ADD COLUMN IF NOT EXISTS resolved_by UUID NULL REFERENCES users(id);
+ALTER TABLE webhook_notifications ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMP NULL;
+ALTER TABLE webhook_notifications ADD COLUMN IF NOT EXISTS resolution_note TEXT NULL;
+
+-- Create indexes for webhook_notifications table
+CREATE INDEX IF NOT EXISTS idx_webhook_notifications_transfer_id ON webhook_notifications(transfer_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_notifications_status ON webhook_notifications(status);
+CREATE INDEX IF NOT EXISTS idx_webhook_notifications_next_attempt_at ON webhook_notifications(next_attempt_at);
+
+-- Notifications that already exhausted their retries were left failed; move them to the dead-letter queue
+UPDATE webhook_notifications
+SET status = 'dead_lettered', dead_lettered_at = updated_at, next_attempt_at = NULL
+WHERE status = 'failed' AND attempts >= 5;
+
+-- Add comments to table
+COMMENT ON TABLE webhook_notifications IS 'Transfer notifications sent to the regulator, retried with backoff';
+COMMENT ON COLUMN webhook_notifications.status IS 'pending, sent, failed (retrying), dead_lettered (retries exhausted) or resolved (closed by an admin)';
@@ -0,0 +1,2 @@
+-- Drop compliance_reports table
+DROP TABLE IF EXISTS compliance_reports;
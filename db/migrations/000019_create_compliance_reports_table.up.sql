@@ -0,0 +1,41 @@
+-- Create compliance_reports table for Currency Transaction Reports and structuring alerts
+CREATE TABLE IF NOT EXISTS compliance_reports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_type VARCHAR(20) NOT NULL
+        CHECK (report_type IN ('ctr', 'structuring')),
+    user_id UUID NOT NULL REFERENCES users(id),
+    business_date DATE NOT NULL,
+    window_start DATE NOT NULL,
+    cash_in_amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+    cash_out_amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+    transfer_in_amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+    transfer_out_amount DECIMAL(15,2) NOT NULL DEFAULT 0,
+    transaction_count INTEGER NOT NULL DEFAULT 0,
+    details JSONB,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending_review'
+        CHECK (status IN ('pending_review', 'approved', 'rejected', 'submitted')),
+    reviewed_by UUID REFERENCES users(id),
+    reviewed_at TIMESTAMP NULL,
+    review_note TEXT,
+    submission_attempts INTEGER NOT NULL DEFAULT 0,
+    next_submission_at TIMESTAMP NULL,
+    submitted_at TIMESTAMP NULL,
+    submission_status_code INTEGER,
+    submission_response TEXT,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A customer has at most one report of each type per business day, so regeneration is idempotent
+CREATE UNIQUE INDEX IF NOT EXISTS idx_compliance_reports_subject_day ON compliance_reports(report_type, user_id, business_date);
+
+-- Create indexes for compliance_reports table
+CREATE INDEX IF NOT EXISTS idx_compliance_reports_user_id ON compliance_reports(user_id);
+CREATE INDEX IF NOT EXISTS idx_compliance_reports_business_date ON compliance_reports(business_date);
+CREATE INDEX IF NOT EXISTS idx_compliance_reports_status ON compliance_reports(status);
+CREATE INDEX IF NOT EXISTS idx_compliance_reports_next_submission_at ON compliance_reports(next_submission_at);
+
+-- Add comments to table
+COMMENT ON TABLE compliance_reports IS 'CTR and structuring reports; filed with the regulator only after admin approval';
+COMMENT ON COLUMN compliance_reports.status IS 'pending_review, approved (queued for filing), rejected or submitted';
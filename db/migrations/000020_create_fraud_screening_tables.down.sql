@@ -0,0 +1,3 @@
+-- Drop fraud screening tables
+DROP TABLE IF EXISTS fraud_decisions;
+DROP TABLE IF EXISTS fraud_rules;
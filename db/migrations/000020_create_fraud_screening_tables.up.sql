@@ -0,0 +1,43 @@
+-- Create fraud_rules table for admin overrides of the built-in screening rules
+CREATE TABLE IF NOT EXISTS fraud_rules (
+    name VARCHAR(50) PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    action VARCHAR(10) NOT NULL
+        CHECK (action IN ('allow', 'review', 'block')),
+    score INTEGER NOT NULL DEFAULT 0,
+    params JSONB,
+    updated_by UUID REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create fraud_decisions table recording every screened money movement and its rule results
+CREATE TABLE IF NOT EXISTS fraud_decisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    operation VARCHAR(20) NOT NULL
+        CHECK (operation IN ('internal_transfer', 'external_transfer', 'transaction')),
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    counterparty_account_id UUID REFERENCES accounts(id),
+    external_account_id UUID REFERENCES external_accounts(id),
+    amount DECIMAL(15,2) NOT NULL,
+    outcome VARCHAR(10) NOT NULL
+        CHECK (outcome IN ('allow', 'review', 'block')),
+    score INTEGER NOT NULL DEFAULT 0,
+    rule_results JSONB,
+    resource_type VARCHAR(20),
+    resource_id UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for fraud_decisions table
+CREATE INDEX IF NOT EXISTS idx_fraud_decisions_user_id ON fraud_decisions(user_id);
+CREATE INDEX IF NOT EXISTS idx_fraud_decisions_account_id ON fraud_decisions(account_id);
+CREATE INDEX IF NOT EXISTS idx_fraud_decisions_outcome ON fraud_decisions(outcome);
+CREATE INDEX IF NOT EXISTS idx_fraud_decisions_resource_id ON fraud_decisions(resource_id);
+CREATE INDEX IF NOT EXISTS idx_fraud_decisions_created_at ON fraud_decisions(created_at);
+
+-- Add comments to tables
+COMMENT ON TABLE fraud_rules IS 'Admin-edited screening rule settings; rules without a row use built-in defaults';
+COMMENT ON TABLE fraud_decisions IS 'Fraud and AML screening result of each money movement, kept for explainability';
+COMMENT ON COLUMN fraud_decisions.outcome IS 'allow, review (proceeds and is flagged) or block (declined)';
@@ -0,0 +1,9 @@
+-- Drop sanctions screening tables and status columns
+DROP TABLE IF EXISTS sanctions_list_loads;
+DROP TABLE IF EXISTS sanctions_matches;
+
+DROP INDEX IF EXISTS idx_external_accounts_sanctions_status;
+DROP INDEX IF EXISTS idx_users_sanctions_status;
+
+ALTER TABLE external_accounts DROP COLUMN IF EXISTS sanctions_status;
+ALTER TABLE users DROP COLUMN IF EXISTS sanctions_status;
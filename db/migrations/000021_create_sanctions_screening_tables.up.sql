@@ -0,0 +1,55 @@
+-- Track the sanctions screening status of customers and payees
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS sanctions_status VARCHAR(20) NOT NULL DEFAULT 'clear'
+        CHECK (sanctions_status IN ('clear', 'pending_review', 'blocked'));
+ALTER TABLE external_accounts
+    ADD COLUMN IF NOT EXISTS sanctions_status VARCHAR(20) NOT NULL DEFAULT 'clear'
+        CHECK (sanctions_status IN ('clear', 'pending_review', 'blocked'));
+
+CREATE INDEX IF NOT EXISTS idx_users_sanctions_status ON users(sanctions_status);
+CREATE INDEX IF NOT EXISTS idx_external_accounts_sanctions_status ON external_accounts(sanctions_status);
+
+-- Create sanctions_matches table recording potential matches against the sanctions list
+CREATE TABLE IF NOT EXISTS sanctions_matches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subject_type VARCHAR(20) NOT NULL
+        CHECK (subject_type IN ('user', 'external_account')),
+    subject_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id),
+    screened_name VARCHAR(255) NOT NULL,
+    entry_id VARCHAR(20) NOT NULL,
+    entry_name VARCHAR(350) NOT NULL,
+    entry_type VARCHAR(20),
+    programs VARCHAR(200),
+    similarity INTEGER NOT NULL CHECK (similarity BETWEEN 0 AND 100),
+    action VARCHAR(10) NOT NULL
+        CHECK (action IN ('review', 'block')),
+    status VARCHAR(20) NOT NULL DEFAULT 'open'
+        CHECK (status IN ('open', 'cleared', 'confirmed')),
+    list_version VARCHAR(64) NOT NULL,
+    reviewed_by UUID REFERENCES users(id),
+    reviewed_at TIMESTAMP,
+    review_note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for sanctions_matches table
+CREATE INDEX IF NOT EXISTS idx_sanctions_matches_subject ON sanctions_matches(subject_type, subject_id);
+CREATE INDEX IF NOT EXISTS idx_sanctions_matches_user_id ON sanctions_matches(user_id);
+CREATE INDEX IF NOT EXISTS idx_sanctions_matches_status ON sanctions_matches(status);
+
+-- Create sanctions_list_loads table recording each list version and its rescreen
+CREATE TABLE IF NOT EXISTS sanctions_list_loads (
+    version VARCHAR(64) PRIMARY KEY,
+    entry_count INTEGER NOT NULL,
+    loaded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    rescreened_at TIMESTAMP,
+    subjects_count INTEGER NOT NULL DEFAULT 0,
+    matches_count INTEGER NOT NULL DEFAULT 0
+);
+
+-- Add comments to tables
+COMMENT ON TABLE sanctions_matches IS 'Potential matches of customer and payee names against the sanctions list';
+COMMENT ON COLUMN sanctions_matches.status IS 'open (awaiting review), cleared (false positive) or confirmed (true match)';
+COMMENT ON TABLE sanctions_list_loads IS 'Sanctions list versions, identified by file SHA-256, and when subjects were rescreened against them';
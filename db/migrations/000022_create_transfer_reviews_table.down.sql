@@ -0,0 +1,6 @@
+-- Drop transfer_reviews table and restore the transfers status check
+DROP TABLE IF EXISTS transfer_reviews;
+
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+    CHECK (status IN ('pending', 'processing', 'completed', 'failed'));
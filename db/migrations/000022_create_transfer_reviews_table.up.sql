@@ -0,0 +1,30 @@
+-- Allow transfers to be held for manual review. The original check predates processing.
+ALTER TABLE transfers DROP CONSTRAINT IF EXISTS transfers_status_check;
+ALTER TABLE transfers ADD CONSTRAINT transfers_status_check
+    CHECK (status IN ('pending', 'processing', 'held_for_review', 'completed', 'failed'));
+
+-- Create transfer_reviews table holding transfers in the manual review queue
+CREATE TABLE IF NOT EXISTS transfer_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    transfer_id UUID NOT NULL UNIQUE REFERENCES transfers(id),
+    user_id UUID NOT NULL REFERENCES users(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    reasons JSONB,
+    transfer_type VARCHAR(20),
+    due_at TIMESTAMP NOT NULL,
+    reviewed_by UUID REFERENCES users(id),
+    reviewed_at TIMESTAMP,
+    review_note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for transfer_reviews table
+CREATE INDEX IF NOT EXISTS idx_transfer_reviews_user_id ON transfer_reviews(user_id);
+CREATE INDEX IF NOT EXISTS idx_transfer_reviews_status ON transfer_reviews(status);
+CREATE INDEX IF NOT EXISTS idx_transfer_reviews_due_at ON transfer_reviews(due_at) WHERE status = 'pending';
+
+-- Add comments to table
+COMMENT ON TABLE transfer_reviews IS 'Transfers held for manual review; the source account is debited while the review is pending';
+COMMENT ON COLUMN transfer_reviews.due_at IS 'SLA deadline for the admin decision';
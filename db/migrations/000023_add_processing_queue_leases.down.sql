@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_processing_queue_lease;
+
+ALTER TABLE transaction_processing_queue
+    DROP COLUMN IF EXISTS lease_expires_at,
+    DROP COLUMN IF EXISTS locked_by;
@@ -0,0 +1,14 @@
+-- Lease columns let several API instances share the processing queue. A worker claims items
+-- with FOR UPDATE SKIP LOCKED, records itself in locked_by and heartbeats lease_expires_at;
+-- items whose lease runs out are returned to pending by the reaper.
+ALTER TABLE transaction_processing_queue
+    ADD COLUMN locked_by VARCHAR(100) NULL,
+    ADD COLUMN lease_expires_at TIMESTAMP NULL;
+
+-- Index for the reaper's expired lease scan
+CREATE INDEX idx_processing_queue_lease
+    ON transaction_processing_queue (lease_expires_at)
+    WHERE status = 'processing';
+
+COMMENT ON COLUMN transaction_processing_queue.locked_by IS 'Worker that claimed the item; set while processing';
+COMMENT ON COLUMN transaction_processing_queue.lease_expires_at IS 'Claim expiry extended by worker heartbeats; expired items are returned to pending';
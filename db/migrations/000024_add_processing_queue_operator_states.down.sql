@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS transaction_processing_queue_control;
+
+DROP INDEX IF EXISTS idx_processing_queue_dead_lettered;
+
+UPDATE transaction_processing_queue
+    SET status = 'failed'
+    WHERE status IN ('cancelled', 'dead_lettered');
+
+ALTER TABLE transaction_processing_queue
+    DROP CONSTRAINT IF EXISTS transaction_processing_queue_status_check;
+
+ALTER TABLE transaction_processing_queue
+    ADD CONSTRAINT transaction_processing_queue_status_check
+    CHECK (status IN ('pending', 'processing', 'completed', 'failed'));
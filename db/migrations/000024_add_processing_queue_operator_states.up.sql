@@ -0,0 +1,28 @@
+-- Operators can cancel queue items or park them in a dead-letter state. Neither is claimed by
+-- workers; dead-lettered items can be retried later.
+ALTER TABLE transaction_processing_queue
+    DROP CONSTRAINT IF EXISTS transaction_processing_queue_status_check;
+
+ALTER TABLE transaction_processing_queue
+    ADD CONSTRAINT transaction_processing_queue_status_check
+    CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'cancelled', 'dead_lettered'));
+
+-- Index for browsing items that need operator attention
+CREATE INDEX idx_processing_queue_dead_lettered
+    ON transaction_processing_queue (status, updated_at DESC)
+    WHERE status = 'dead_lettered';
+
+-- Runtime switches shared by every worker. A single row; workers stop claiming while paused.
+CREATE TABLE transaction_processing_queue_control (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    paused_by UUID NULL,
+    paused_at TIMESTAMP NULL,
+    pause_reason TEXT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO transaction_processing_queue_control (id, paused) VALUES (1, FALSE);
+
+COMMENT ON TABLE transaction_processing_queue_control IS 'Operator controls for the transaction processing queue workers';
+COMMENT ON COLUMN transaction_processing_queue_control.paused IS 'When true no worker claims new queue items';
@@ -0,0 +1,36 @@
+-- One row per run of a scheduled background job, written when the run starts and updated with
+-- its outcome when it finishes.
+CREATE TABLE IF NOT EXISTS job_runs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    job_name VARCHAR(100) NOT NULL,
+    trigger VARCHAR(20) NOT NULL CHECK (trigger IN ('schedule', 'manual')),
+    triggered_by UUID REFERENCES users(id),
+    instance VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running'
+        CHECK (status IN ('running', 'succeeded', 'failed', 'timed_out', 'abandoned')),
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMP,
+    duration_ms BIGINT,
+    counts JSONB,
+    error TEXT
+);
+
+-- Index for a job's run history, newest first
+CREATE INDEX IF NOT EXISTS idx_job_runs_job_started ON job_runs(job_name, started_at DESC);
+-- Index for finding runs left running by an instance that stopped
+CREATE INDEX IF NOT EXISTS idx_job_runs_running ON job_runs(started_at) WHERE status = 'running';
+
+-- Operator state of each job, shared by every instance. Jobs without a row run on schedule.
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+    name VARCHAR(100) PRIMARY KEY,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    paused_by UUID REFERENCES users(id),
+    paused_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE job_runs IS 'Run history of scheduled background jobs';
+COMMENT ON COLUMN job_runs.counts IS 'What the run did, e.g. {"delivered": 12}';
+COMMENT ON COLUMN job_runs.instance IS 'Worker ID of the API instance that ran the job';
+COMMENT ON TABLE scheduled_jobs IS 'Operator controls for scheduled background jobs';
+COMMENT ON COLUMN scheduled_jobs.paused IS 'When true the job is skipped on schedule; manual runs still start';
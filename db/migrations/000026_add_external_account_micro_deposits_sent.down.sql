@@ -0,0 +1,2 @@
+ALTER TABLE external_accounts
+    DROP COLUMN IF EXISTS micro_deposits_sent;
@@ -0,0 +1,10 @@
+-- Micro-deposit amounts are saved before they are sent and each accepted deposit is counted, so a
+-- send that stops part-way resumes with the same amounts instead of paying out new ones.
+ALTER TABLE external_accounts
+    ADD COLUMN IF NOT EXISTS micro_deposits_sent INTEGER NOT NULL DEFAULT 0;
+
+UPDATE external_accounts
+    SET micro_deposits_sent = 2
+    WHERE micro_deposits_sent_at IS NOT NULL;
+
+COMMENT ON COLUMN external_accounts.micro_deposits_sent IS 'How many of the current micro-deposits the partner has accepted';
@@ -0,0 +1,12 @@
+UPDATE inbound_credits
+    SET status = 'suspense'
+    WHERE status = 'returning';
+
+ALTER TABLE inbound_credits
+    DROP CONSTRAINT IF EXISTS inbound_credits_status_check;
+
+ALTER TABLE inbound_credits
+    ADD CONSTRAINT inbound_credits_status_check
+    CHECK (status IN ('received', 'posted', 'suspense', 'returned'));
+
+COMMENT ON COLUMN inbound_credits.status IS 'received, posted, suspense (awaiting admin review) or returned';
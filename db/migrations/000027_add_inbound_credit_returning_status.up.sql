@@ -0,0 +1,19 @@
+-- Admin returns claim the credit as returning before the partner is called, so a credit cannot be
+-- resolved and returned at the same time.
+ALTER TABLE inbound_credits
+    DROP CONSTRAINT IF EXISTS inbound_credits_status_check;
+
+ALTER TABLE inbound_credits
+    ADD CONSTRAINT inbound_credits_status_check
+    CHECK (status IN ('received', 'posted', 'suspense', 'returning', 'returned'));
+
+-- Credits are now recorded and posted in one database transaction. Rows left received by an
+-- interrupted posting may or may not be on the account, so they go to suspense for an admin to
+-- check the ledger before resolving or returning them.
+UPDATE inbound_credits
+    SET status = 'suspense',
+        suspense_reason = 'processing was interrupted; check the account ledger for a matching credit before resolving',
+        updated_at = CURRENT_TIMESTAMP
+    WHERE status = 'received';
+
+COMMENT ON COLUMN inbound_credits.status IS 'received, posted, suspense (awaiting admin review), returning (return in progress) or returned';
@@ -0,0 +1,2 @@
+-- Drop outbox_dead_letters table
+DROP TABLE IF EXISTS outbox_dead_letters;
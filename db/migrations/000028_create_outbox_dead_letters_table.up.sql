@@ -0,0 +1,20 @@
+-- Create outbox_dead_letters table recording events a consumer stopped retrying
+CREATE TABLE IF NOT EXISTS outbox_dead_letters (
+    consumer VARCHAR(100) NOT NULL,
+    event_id BIGINT NOT NULL REFERENCES outbox_events(id),
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('max_attempts', 'skipped')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    skipped_by UUID,
+    replayed_by UUID,
+    replayed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (consumer, event_id)
+);
+
+-- Create index for listing a consumer's unreplayed dead letters
+CREATE INDEX IF NOT EXISTS idx_outbox_dead_letters_pending ON outbox_dead_letters(consumer, event_id) WHERE replayed_at IS NULL;
+
+-- Add comments to table
+COMMENT ON TABLE outbox_dead_letters IS 'Events a consumer gave up on after too many failures or that an admin skipped; the checkpoint has moved past them';
+COMMENT ON COLUMN outbox_dead_letters.replayed_at IS 'Set when an admin redelivered the event to the consumer successfully';
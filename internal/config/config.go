@@ -13,15 +13,29 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 type Config struct {
-	Server    ServerConfig
-	Database  DatabaseConfig
-	JWT       JWTConfig
-	Security  SecurityConfig
-	Northwind NorthwindConfig
-	Regulator RegulatorConfig
+	Server          ServerConfig
+	Database        DatabaseConfig
+	JWT             JWTConfig
+	Security        SecurityConfig
+	Northwind       NorthwindConfig
+	Regulator       RegulatorConfig
+	TransferMonitor TransferMonitorConfig
+	Compliance      ComplianceConfig
+	Fraud           FraudConfig
+	Sanctions       SanctionsConfig
+	TransferReview  TransferReviewConfig
+	ProcessingQueue ProcessingQueueConfig
+	Scheduler       SchedulerConfig
+	LeaderElection  LeaderElectionConfig
+	Shutdown        ShutdownConfig
+	Metrics         MetricsConfig
+	Tracing         TracingConfig
+	Logging         LoggingConfig
 }
 
 type ServerConfig struct {
@@ -31,6 +45,10 @@ type ServerConfig struct {
 	ReadTimeout      time.Duration
 	WriteTimeout     time.Duration
 	CORSAllowOrigins []string
+	// RequestTimeout is the deadline given to each request's context; zero disables it.
+	RequestTimeout time.Duration
+	// RouteTimeouts overrides RequestTimeout per route template, keyed "METHOD /route" or "/route".
+	RouteTimeouts map[string]time.Duration
 }
 
 type DatabaseConfig struct {
@@ -43,6 +61,8 @@ type DatabaseConfig struct {
 	MaxConnections  int
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
+
+	SlowQueryThreshold time.Duration // Queries slower than this are logged at warn
 }
 
 type JWTConfig struct {
@@ -65,12 +85,145 @@ type SecurityConfig struct {
 }
 
 type NorthwindConfig struct {
-	APIKey string
+	BaseURL                   string
+	APIKey                    string
+	Timeout                   time.Duration // Per-attempt HTTP timeout
+	RequestBudget             time.Duration // Bound on a whole call, retries and backoff included; kept below REQUEST_TIMEOUT
+	MaxRetries                int           // Retries after the first attempt, for safe or idempotent calls only
+	RetryBaseDelay            time.Duration // Backoff before the first retry; doubles per retry, with jitter
+	RetryMaxDelay             time.Duration // Upper bound for the backoff between retries
+	MicroDepositSourceAccount string
+	SettlementAccount         string        // Our account at Northwind; source of returned inbound credits
+	WebhookSecret             string        // Shared secret for verifying Northwind webhook signatures
+	WebhookTolerance          time.Duration // Maximum allowed age of a webhook signature timestamp
+}
+
+// TransferMonitorConfig controls the polling fallback for external transfer status.
+// Northwind pushes status callbacks; polling only reconciles transfers it missed.
+type TransferMonitorConfig struct {
+	PollBaseInterval time.Duration // Delay before re-checking a transfer after its first unresolved check
+	PollMaxInterval  time.Duration // Upper bound for the exponential backoff between checks
+	MaxPendingAge    time.Duration // Transfers unresolved for longer are escalated to the admin queue
+	SagaGracePeriod  time.Duration // Sagas stuck at debit_posted for longer are resumed by the recovery worker
 }
 
 type RegulatorConfig struct {
-	WebhookURL    string
-	WebhookAPIKey string
+	WebhookURL                   string
+	WebhookAPIKey                string
+	WebhookSigningSecret         string // Signs each delivery with HMAC-SHA256
+	WebhookPreviousSigningSecret string // Also signed with during rotation until the regulator switches over
+	DeadLetterAlertThreshold     int    // Dead-lettered notifications at which the DLQ alert fires
+	ReportURL                    string // Receives approved compliance report files; signed like webhooks
+}
+
+// ComplianceConfig controls the daily Currency Transaction Report (CTR) and structuring checks.
+type ComplianceConfig struct {
+	CTRThreshold               decimal.Decimal // A customer's daily inbound or outbound total above this is reported
+	StructuringBandPercent     int             // Transactions at or above this percent of the threshold, but below it, are near-threshold
+	StructuringWindowDays      int             // Business days searched for near-threshold transactions, ending on the reported day
+	StructuringMinTransactions int             // Near-threshold transactions in the window that raise a structuring alert
+	BusinessDayLocation        *time.Location  // Time zone in which business days start and end
+	FilingInstitution          string          // Institution name written into report files
+}
+
+// FraudConfig controls how screening rule results combine into a decision. The rules themselves
+// are configured through the admin API.
+type FraudConfig struct {
+	ReviewScore int // Combined risk score at which a movement is flagged for review
+	BlockScore  int // Combined risk score at which a movement is declined
+	HistoryDays int // Days of customer debits rules compare a movement against
+}
+
+// SanctionsConfig controls name screening of customers and payees against a locally loaded
+// sanctions list in the OFAC SDN CSV format.
+type SanctionsConfig struct {
+	ListPath          string        // SDN CSV file; screening is skipped until a list loads
+	ReviewSimilarity  int           // Name similarity percent at which a subject is held for review
+	BlockSimilarity   int           // Name similarity percent at which a subject is blocked
+	ListCheckInterval time.Duration // How often the list file is checked for updates
+}
+
+// TransferReviewConfig controls which transfers are held for manual review before they execute.
+// A zero threshold disables that trigger.
+type TransferReviewConfig struct {
+	InternalThreshold decimal.Decimal // Internal transfers at or above this amount are held
+	ExternalThreshold decimal.Decimal // External transfers at or above this amount are held
+	FirstPayeeHold    bool            // Hold the first transfer to an external payee
+	SLA               time.Duration   // Time admins have to decide a held transfer
+}
+
+// ProcessingQueueConfig controls how this instance claims transaction processing queue items.
+// Instances claim items under leases, so several replicas can share one queue.
+type ProcessingQueueConfig struct {
+	WorkerID          string        // Identifies this instance on claimed items; defaults to hostname-pid
+	MaxWorkers        int           // Items processed concurrently by this instance
+	LeaseDuration     time.Duration // How long a claim lasts without a heartbeat
+	HeartbeatInterval time.Duration // How often a worker extends the lease of an item it is processing
+	ReapInterval      time.Duration // How often expired leases are returned to the queue
+
+	// Account types whose asynchronously submitted transactions are queued at high priority
+	HighPriorityAccountTypes []string
+}
+
+// SchedulerConfig controls the background job scheduler. Schedules and timeouts override, by job
+// name, the defaults each job is registered with.
+type SchedulerConfig struct {
+	Schedules    map[string]string        // "@every 30s", a bare duration or a five-field cron expression in UTC
+	Timeouts     map[string]time.Duration // Bound on a single run
+	RunRetention time.Duration            // How long job run history is kept
+}
+
+// LeaderElectionConfig controls which instance runs the singleton background jobs. Instances
+// sharing a database and lock name elect one leader between them; with election disabled every
+// instance runs every job.
+type LeaderElectionConfig struct {
+	Enabled           bool
+	LockName          string        // Postgres advisory lock the instances compete for
+	RetryInterval     time.Duration // How often a follower tries to take over
+	KeepaliveInterval time.Duration // How often the leader checks it still holds the lock
+}
+
+// ShutdownConfig bounds the shutdown sequence. The total of its durations, plus a few seconds for
+// closing connections, should fit within the orchestrator's termination grace period.
+type ShutdownConfig struct {
+	ReadinessDelay time.Duration // Time between failing readiness and refusing connections
+	DrainTimeout   time.Duration // Bound on in-flight requests, queue items and job runs finishing
+	StopTimeout    time.Duration // Bound on background loops stopping once the drain is over
+}
+
+// MetricsConfig controls how the Prometheus /metrics endpoint is exposed. With a port it is served
+// on its own listener, otherwise on the API port behind the token. With neither it is not served.
+type MetricsConfig struct {
+	Port  string // Separate listener for /metrics, e.g. one reachable only from the cluster network
+	Token string // Bearer token the scraper presents when /metrics is served on the API port
+}
+
+// TracingConfig controls OpenTelemetry tracing. Spans are always created so trace IDs reach
+// error responses and logs; the exporter decides where finished spans are sent.
+type TracingConfig struct {
+	ServiceName string
+	Exporter    string  // none, stdout, file or otlp; otlp reads the standard OTEL_EXPORTER_OTLP_* variables
+	FilePath    string  // Destination for the file exporter
+	SampleRatio float64 // Fraction of new traces sampled; inbound sampled traces are always kept
+}
+
+// LoggingConfig controls the shared slog pipeline used by requests, services and GORM.
+type LoggingConfig struct {
+	Level         string            // debug, info, warn or error
+	Format        string            // text or json
+	PackageLevels map[string]string // Overrides by package name, e.g. database=warn,services=debug
+	RedactKeys    []string          // Attribute keys redacted in addition to the built-in PII and credential keys
+}
+
+// SigningSecrets returns the configured webhook signing secrets, current first
+func (c RegulatorConfig) SigningSecrets() []string {
+	var secrets []string
+	for _, secret := range []string{c.WebhookSigningSecret, c.WebhookPreviousSigningSecret} {
+		if secret != "" {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
 }
 
 func Load() *Config {
@@ -81,6 +234,9 @@ func Load() *Config {
 			Environment:  getEnv("APP_ENV", "development"),
 			ReadTimeout:  getDurationEnv("SERVER_READ_TIMEOUT", 15*time.Second),
 			WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 15*time.Second),
+			// Kept below the write timeout so a timed-out request can still be answered
+			RequestTimeout: getDurationEnv("REQUEST_TIMEOUT", 10*time.Second),
+			RouteTimeouts:  getDurationMapEnv("REQUEST_ROUTE_TIMEOUTS"),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -92,6 +248,8 @@ func Load() *Config {
 			MaxConnections:  getIntEnv("DB_MAX_CONNECTIONS", 25),
 			MaxIdleConns:    getIntEnv("DB_MAX_IDLE_CONNS", 5),
 			ConnMaxLifetime: getDurationEnv("DB_CONN_MAX_LIFETIME", time.Hour),
+
+			SlowQueryThreshold: getDurationEnv("DB_SLOW_QUERY_THRESHOLD", 200*time.Millisecond),
 		},
 		Security: SecurityConfig{
 			BCryptCost:          getIntEnv("BCRYPT_COST", 12),
@@ -109,11 +267,98 @@ func Load() *Config {
 			Issuer:               getEnv("JWT_ISSUER", "banking-api"),
 		},
 		Northwind: NorthwindConfig{
-			APIKey: getEnv("NORTHWIND_API_KEY", ""),
+			BaseURL:                   getEnv("NORTHWIND_BASE_URL", "https://northwind.dev.array.io/api/v1"),
+			APIKey:                    getEnv("NORTHWIND_API_KEY", ""),
+			Timeout:                   getDurationEnv("NORTHWIND_TIMEOUT", 10*time.Second),
+			RequestBudget:             getDurationEnv("NORTHWIND_REQUEST_BUDGET", 8*time.Second),
+			MaxRetries:                getIntEnv("NORTHWIND_MAX_RETRIES", 3),
+			RetryBaseDelay:            getDurationEnv("NORTHWIND_RETRY_BASE_DELAY", 200*time.Millisecond),
+			RetryMaxDelay:             getDurationEnv("NORTHWIND_RETRY_MAX_DELAY", 2*time.Second),
+			MicroDepositSourceAccount: getEnv("NORTHWIND_MICRO_DEPOSIT_SOURCE_ACCOUNT", ""),
+			SettlementAccount:         getEnv("NORTHWIND_SETTLEMENT_ACCOUNT", ""),
+			WebhookSecret:             getEnv("NORTHWIND_WEBHOOK_SECRET", ""),
+			WebhookTolerance:          getDurationEnv("NORTHWIND_WEBHOOK_TOLERANCE", 5*time.Minute),
 		},
 		Regulator: RegulatorConfig{
-			WebhookURL:    getEnv("REGULATOR_WEBHOOK_URL", ""),
-			WebhookAPIKey: getEnv("REGULATOR_WEBHOOK_API_KEY", ""),
+			WebhookURL:                   getEnv("REGULATOR_WEBHOOK_URL", ""),
+			WebhookAPIKey:                getEnv("REGULATOR_WEBHOOK_API_KEY", ""),
+			WebhookSigningSecret:         getEnv("REGULATOR_WEBHOOK_SIGNING_SECRET", ""),
+			WebhookPreviousSigningSecret: getEnv("REGULATOR_WEBHOOK_PREVIOUS_SIGNING_SECRET", ""),
+			DeadLetterAlertThreshold:     getIntEnv("REGULATOR_WEBHOOK_DLQ_ALERT_THRESHOLD", 1),
+			ReportURL:                    getEnv("REGULATOR_REPORT_URL", ""),
+		},
+		TransferMonitor: TransferMonitorConfig{
+			PollBaseInterval: getDurationEnv("TRANSFER_MONITOR_POLL_BASE_INTERVAL", 30*time.Second),
+			PollMaxInterval:  getDurationEnv("TRANSFER_MONITOR_POLL_MAX_INTERVAL", 30*time.Minute),
+			MaxPendingAge:    getDurationEnv("TRANSFER_MONITOR_MAX_PENDING_AGE", 72*time.Hour),
+			SagaGracePeriod:  getDurationEnv("TRANSFER_SAGA_GRACE_PERIOD", time.Minute),
+		},
+		Compliance: ComplianceConfig{
+			CTRThreshold:               getDecimalEnv("COMPLIANCE_CTR_THRESHOLD", decimal.NewFromInt(10000)),
+			StructuringBandPercent:     getIntEnv("COMPLIANCE_STRUCTURING_BAND_PERCENT", 80),
+			StructuringWindowDays:      getIntEnv("COMPLIANCE_STRUCTURING_WINDOW_DAYS", 5),
+			StructuringMinTransactions: getIntEnv("COMPLIANCE_STRUCTURING_MIN_TRANSACTIONS", 3),
+			BusinessDayLocation:        getLocationEnv("COMPLIANCE_BUSINESS_DAY_TIMEZONE", "America/New_York"),
+			FilingInstitution:          getEnv("COMPLIANCE_FILING_INSTITUTION", "Array Banking"),
+		},
+		Fraud: FraudConfig{
+			ReviewScore: getIntEnv("FRAUD_REVIEW_SCORE", 50),
+			BlockScore:  getIntEnv("FRAUD_BLOCK_SCORE", 100),
+			HistoryDays: getIntEnv("FRAUD_HISTORY_DAYS", 90),
+		},
+		Sanctions: SanctionsConfig{
+			ListPath:          getEnv("SANCTIONS_LIST_PATH", ""),
+			ReviewSimilarity:  getIntEnv("SANCTIONS_REVIEW_SIMILARITY", 85),
+			BlockSimilarity:   getIntEnv("SANCTIONS_BLOCK_SIMILARITY", 95),
+			ListCheckInterval: getDurationEnv("SANCTIONS_LIST_CHECK_INTERVAL", 15*time.Minute),
+		},
+		TransferReview: TransferReviewConfig{
+			InternalThreshold: getDecimalEnv("TRANSFER_REVIEW_INTERNAL_THRESHOLD", decimal.NewFromInt(25000)),
+			ExternalThreshold: getDecimalEnv("TRANSFER_REVIEW_EXTERNAL_THRESHOLD", decimal.NewFromInt(10000)),
+			FirstPayeeHold:    getBoolEnv("TRANSFER_REVIEW_FIRST_PAYEE_HOLD", true),
+			SLA:               getDurationEnv("TRANSFER_REVIEW_SLA", 4*time.Hour),
+		},
+		ProcessingQueue: ProcessingQueueConfig{
+			WorkerID:          getEnv("PROCESSING_QUEUE_WORKER_ID", defaultWorkerID()),
+			MaxWorkers:        getIntEnv("PROCESSING_QUEUE_MAX_WORKERS", 5),
+			LeaseDuration:     getDurationEnv("PROCESSING_QUEUE_LEASE_DURATION", 30*time.Second),
+			HeartbeatInterval: getDurationEnv("PROCESSING_QUEUE_HEARTBEAT_INTERVAL", 10*time.Second),
+			ReapInterval:      getDurationEnv("PROCESSING_QUEUE_REAP_INTERVAL", 15*time.Second),
+
+			HighPriorityAccountTypes: getListEnv("PROCESSING_QUEUE_HIGH_PRIORITY_ACCOUNT_TYPES"),
+		},
+		Scheduler: SchedulerConfig{
+			// Entries are separated by semicolons, since cron lists use commas
+			Schedules:    getScheduleMapEnv("JOB_SCHEDULES"),
+			Timeouts:     getDurationMapEnv("JOB_TIMEOUTS"),
+			RunRetention: getDurationEnv("JOB_RUN_RETENTION", 7*24*time.Hour),
+		},
+		LeaderElection: LeaderElectionConfig{
+			Enabled:           getBoolEnv("LEADER_ELECTION_ENABLED", true),
+			LockName:          getEnv("LEADER_ELECTION_LOCK", "banking-api-scheduler"),
+			RetryInterval:     getDurationEnv("LEADER_ELECTION_RETRY_INTERVAL", 2*time.Second),
+			KeepaliveInterval: getDurationEnv("LEADER_ELECTION_KEEPALIVE_INTERVAL", 5*time.Second),
+		},
+		Shutdown: ShutdownConfig{
+			ReadinessDelay: getDurationEnv("SHUTDOWN_READINESS_DELAY", 5*time.Second),
+			DrainTimeout:   getDurationEnv("SHUTDOWN_DRAIN_TIMEOUT", 15*time.Second),
+			StopTimeout:    getDurationEnv("SHUTDOWN_STOP_TIMEOUT", 5*time.Second),
+		},
+		Metrics: MetricsConfig{
+			Port:  getEnv("METRICS_PORT", ""),
+			Token: getEnv("METRICS_TOKEN", ""),
+		},
+		Logging: LoggingConfig{
+			Level:         getEnv("LOG_LEVEL", "info"),
+			Format:        getEnv("LOG_FORMAT", "text"),
+			PackageLevels: getMapEnv("LOG_PACKAGE_LEVELS"),
+			RedactKeys:    getListEnv("LOG_REDACT_KEYS"),
+		},
+		Tracing: TracingConfig{
+			ServiceName: getEnv("OTEL_SERVICE_NAME", "banking-api"),
+			Exporter:    getEnv("TRACING_EXPORTER", "none"),
+			FilePath:    getEnv("TRACING_FILE_PATH", "traces.jsonl"),
+			SampleRatio: getFloatEnv("TRACING_SAMPLE_RATIO", 1),
 		},
 	}
 
@@ -179,6 +424,93 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
+func getDecimalEnv(key string, defaultValue decimal.Decimal) decimal.Decimal {
+	if value := os.Getenv(key); value != "" {
+		if decimalVal, err := decimal.NewFromString(value); err == nil {
+			return decimalVal
+		}
+	}
+	return defaultValue
+}
+
+// getListEnv splits a comma-separated value, dropping empty entries.
+func getListEnv(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// defaultWorkerID identifies the process as hostname-pid, which is unique across replicas.
+func defaultWorkerID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "banking-api"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// getMapEnv parses comma-separated key=value pairs, dropping malformed entries.
+func getMapEnv(key string) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range getListEnv(key) {
+		name, value, found := strings.Cut(pair, "=")
+		if name, value = strings.TrimSpace(name), strings.TrimSpace(value); found && name != "" && value != "" {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// getDurationMapEnv parses a getMapEnv value whose values are durations, skipping invalid entries.
+func getDurationMapEnv(key string) map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for name, value := range getMapEnv(key) {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			log.Printf("Invalid duration for %s in %s: %v", name, key, err)
+			continue
+		}
+		durations[name] = duration
+	}
+	return durations
+}
+
+// getScheduleMapEnv parses name=schedule pairs separated by semicolons, e.g.
+// "transfer-monitor=@every 1m;expired-token-cleanup=30 3 * * 1,4".
+func getScheduleMapEnv(key string) map[string]string {
+	schedules := make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv(key), ";") {
+		name, schedule, found := strings.Cut(pair, "=")
+		if name, schedule = strings.TrimSpace(name), strings.TrimSpace(schedule); found && name != "" && schedule != "" {
+			schedules[name] = schedule
+		}
+	}
+	return schedules
+}
+
+// getLocationEnv loads the named time zone, falling back to UTC when the zone database lacks it.
+func getLocationEnv(key, defaultValue string) *time.Location {
+	location, err := time.LoadLocation(getEnv(key, defaultValue))
+	if err != nil {
+		log.Printf("Unknown time zone in %s, using UTC: %v", key, err)
+		return time.UTC
+	}
+	return location
+}
+
 // loadJWTKeys loads RSA keys for JWT signing and verification
 // Priority order:
 // 1. If JWT_PRIVATE_KEY and JWT_PUBLIC_KEY env vars are set, use them (works in all environments)
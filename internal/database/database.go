@@ -3,13 +3,13 @@ package database
 import (
 	"fmt"
 	"log"
+	"log/slog"
 	"time"
 
 	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 type DB struct {
@@ -19,7 +19,7 @@ type DB struct {
 
 func New(cfg *config.DatabaseConfig) (*DB, error) {
 	gormConfig := &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: NewGormLogger(slog.Default(), cfg.SlowQueryThreshold),
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
 		},
@@ -30,6 +30,10 @@ func New(cfg *config.DatabaseConfig) (*DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	if err := db.Use(NewTracingPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to register tracing plugin: %w", err)
+	}
+
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
@@ -61,6 +65,22 @@ func (db *DB) AutoMigrate() error {
 		&models.ExternalAccount{},
 		&models.WebhookNotification{},
 		&models.ProcessingQueueItem{},
+		&models.ProcessingQueueControl{},
+		&models.InboundCredit{},
+		&models.TransferSaga{},
+		&models.OutboxEvent{},
+		&models.OutboxCheckpoint{},
+		&models.OutboxDeadLetter{},
+		&models.WebhookSubscription{},
+		&models.WebhookDelivery{},
+		&models.ComplianceReport{},
+		&models.FraudRule{},
+		&models.FraudDecision{},
+		&models.SanctionsMatch{},
+		&models.SanctionsListLoad{},
+		&models.TransferReview{},
+		&models.JobRun{},
+		&models.ScheduledJob{},
 	)
 }
 
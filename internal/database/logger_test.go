@@ -0,0 +1,73 @@
+package database
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/stretchr/testify/suite"
+)
+
+type GormLoggerTestSuite struct {
+	suite.Suite
+	db  *DB
+	buf bytes.Buffer
+}
+
+func (s *GormLoggerTestSuite) SetupTest() {
+	s.buf.Reset()
+	s.db = SetupTestDB(s.T())
+}
+
+func (s *GormLoggerTestSuite) TearDownTest() {
+	CleanupTestDB(s.T(), s.db)
+}
+
+func TestGormLoggerTestSuite(t *testing.T) {
+	suite.Run(t, new(GormLoggerTestSuite))
+}
+
+func (s *GormLoggerTestSuite) useLogger(level slog.Level, slowThreshold time.Duration) {
+	handler := slog.NewTextHandler(&s.buf, &slog.HandlerOptions{Level: level})
+	s.db.DB.Logger = NewGormLogger(slog.New(handler), slowThreshold)
+}
+
+func (s *GormLoggerTestSuite) TestQueriesLoggedWithoutBoundValues() {
+	s.useLogger(slog.LevelDebug, time.Hour)
+
+	var user models.User
+	s.db.Where("email = ?", "someone@example.com").Find(&user)
+
+	s.Contains(s.buf.String(), "level=DEBUG msg=query")
+	s.Contains(s.buf.String(), "email = ?")
+	s.NotContains(s.buf.String(), "someone@example.com")
+}
+
+func (s *GormLoggerTestSuite) TestSlowQueriesLoggedAtWarn() {
+	s.useLogger(slog.LevelWarn, time.Nanosecond)
+
+	var count int64
+	s.db.Model(&models.User{}).Count(&count)
+
+	s.Contains(s.buf.String(), "level=WARN msg=\"slow query\"")
+}
+
+func (s *GormLoggerTestSuite) TestFailuresLoggedAtError() {
+	s.useLogger(slog.LevelInfo, time.Hour)
+
+	s.Error(s.db.Exec("SELECT * FROM missing_table").Error)
+
+	s.Contains(s.buf.String(), "level=ERROR msg=\"query failed\"")
+	s.Contains(s.buf.String(), "missing_table")
+}
+
+func (s *GormLoggerTestSuite) TestRecordNotFoundIsNotAnError() {
+	s.useLogger(slog.LevelInfo, time.Hour)
+
+	var user models.User
+	s.Error(s.db.Where("email = ?", "nobody@example.com").First(&user).Error)
+
+	s.Empty(s.buf.String())
+}
@@ -114,6 +114,20 @@ func (tdb *TestDB) Cleanup() {
 
 	tables := []string{
 		"transaction_processing_queue",
+		"transaction_processing_queue_control",
+		"inbound_credits",
+		"transfer_reviews",
+		"transfer_sagas",
+		"outbox_dead_letters",
+		"outbox_events",
+		"outbox_checkpoints",
+		"webhook_deliveries",
+		"webhook_subscriptions",
+		"compliance_reports",
+		"fraud_rules",
+		"fraud_decisions",
+		"sanctions_matches",
+		"sanctions_list_loads",
 		"transactions",
 		"accounts",
 		"audit_logs",
@@ -134,6 +148,20 @@ func CleanupTestDB(t *testing.T, db *DB) {
 
 	tables := []string{
 		"transaction_processing_queue",
+		"transaction_processing_queue_control",
+		"inbound_credits",
+		"transfer_reviews",
+		"transfer_sagas",
+		"outbox_dead_letters",
+		"outbox_events",
+		"outbox_checkpoints",
+		"webhook_deliveries",
+		"webhook_subscriptions",
+		"compliance_reports",
+		"fraud_rules",
+		"fraud_decisions",
+		"sanctions_matches",
+		"sanctions_list_loads",
 		"transactions",
 		"accounts",
 		"audit_logs",
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type TracingPluginTestSuite struct {
+	suite.Suite
+	db       *DB
+	recorder *tracetest.SpanRecorder
+	tracer   trace.Tracer
+}
+
+func (s *TracingPluginTestSuite) SetupTest() {
+	s.db = SetupTestDB(s.T())
+	s.recorder = tracetest.NewSpanRecorder()
+	s.tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.recorder)).Tracer("test")
+	s.Require().NoError(s.db.Use(&TracingPlugin{tracer: s.tracer}))
+}
+
+func (s *TracingPluginTestSuite) TearDownTest() {
+	CleanupTestDB(s.T(), s.db)
+}
+
+func TestTracingPluginTestSuite(t *testing.T) {
+	suite.Run(t, new(TracingPluginTestSuite))
+}
+
+func (s *TracingPluginTestSuite) TestTracesStatementsUnderParentSpan() {
+	ctx, parent := s.tracer.Start(context.Background(), "request")
+	var count int64
+	s.Require().NoError(s.db.WithContext(ctx).Model(&models.User{}).Where("email = ?", "someone@example.com").Count(&count).Error)
+	parent.End()
+
+	spans := s.recorder.Ended()
+	s.Require().Len(spans, 2)
+	query := spans[0]
+	s.Equal("db.query", query.Name())
+	s.Equal(trace.SpanKindClient, query.SpanKind())
+	s.Equal(parent.SpanContext().SpanID(), query.Parent().SpanID())
+	s.Contains(query.Attributes(), attribute.String("db.collection.name", "users"))
+
+	for _, attr := range query.Attributes() {
+		s.NotContains(attr.Value.Emit(), "someone@example.com", "bound values must not be recorded")
+	}
+}
+
+func (s *TracingPluginTestSuite) TestSkipsStatementsWithoutParentSpan() {
+	var count int64
+	s.Require().NoError(s.db.Model(&models.User{}).Count(&count).Error)
+	s.Require().NoError(s.db.WithContext(context.Background()).Model(&models.User{}).Count(&count).Error)
+
+	s.Empty(s.recorder.Ended())
+}
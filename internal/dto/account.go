@@ -87,6 +87,7 @@ type MessageResponse struct {
 type TransferResponse struct {
 	Message             string  `json:"message"`
 	TransferID          string  `json:"transferId"`
+	Status              string  `json:"status"`
 	FromAccountID       string  `json:"fromAccountId"`
 	ToAccountID         string  `json:"toAccountId"`
 	Amount              string  `json:"amount"`
@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ComplianceReportFileFormat identifies the report file layout documented in docs/compliance-reports.md.
+const ComplianceReportFileFormat = "array-compliance-report/v1"
+
+// GenerateComplianceReportsRequest is the DTO for running the CTR and structuring checks for a day.
+type GenerateComplianceReportsRequest struct {
+	BusinessDate string `json:"business_date" validate:"required,datetime=2006-01-02"`
+}
+
+// GenerateComplianceReportsResponse reports the reports created by a run. Reports that already
+// existed for the day are left untouched and not counted.
+type GenerateComplianceReportsResponse struct {
+	BusinessDate       string `json:"business_date"`
+	CustomersScanned   int    `json:"customers_scanned"`
+	CTRReports         int    `json:"ctr_reports"`
+	StructuringReports int    `json:"structuring_reports"`
+}
+
+// ReviewComplianceReportRequest is the DTO for approving or rejecting a report.
+type ReviewComplianceReportRequest struct {
+	Note string `json:"note" validate:"required,max=500"`
+}
+
+// ComplianceReportResponse is the admin view of a CTR or structuring report.
+type ComplianceReportResponse struct {
+	ID                   uuid.UUID                     `json:"id"`
+	ReportType           string                        `json:"report_type"`
+	UserID               uuid.UUID                     `json:"user_id"`
+	BusinessDate         string                        `json:"business_date"`
+	WindowStart          string                        `json:"window_start"`
+	Totals               ComplianceReportTotals        `json:"totals"`
+	Transactions         []ComplianceReportTransaction `json:"transactions"`
+	Status               string                        `json:"status"`
+	ReviewedBy           *uuid.UUID                    `json:"reviewed_by,omitempty"`
+	ReviewedAt           *time.Time                    `json:"reviewed_at,omitempty"`
+	ReviewNote           *string                       `json:"review_note,omitempty"`
+	SubmissionAttempts   int                           `json:"submission_attempts"`
+	NextSubmissionAt     *time.Time                    `json:"next_submission_at,omitempty"`
+	SubmittedAt          *time.Time                    `json:"submitted_at,omitempty"`
+	SubmissionStatusCode *int                          `json:"submission_status_code,omitempty"`
+	SubmissionResponse   *string                       `json:"submission_response,omitempty"`
+	LastError            *string                       `json:"last_error,omitempty"`
+	CreatedAt            time.Time                     `json:"created_at"`
+}
+
+// ComplianceReportListResponse is a paginated list of compliance reports.
+type ComplianceReportListResponse struct {
+	Reports    []ComplianceReportResponse `json:"reports"`
+	Pagination PaginationMeta             `json:"pagination"`
+}
+
+// ComplianceReportFile is the document filed with the regulator report endpoint once a report
+// is approved. See docs/compliance-reports.md for the field reference.
+type ComplianceReportFile struct {
+	EventID           string                        `json:"event_id,omitempty"` // Derived from the report ID on submission; signed like regulator webhooks
+	Format            string                        `json:"format"`
+	ReportID          uuid.UUID                     `json:"report_id"`
+	ReportType        string                        `json:"report_type"` // "ctr" or "structuring"
+	FilingInstitution string                        `json:"filing_institution"`
+	BusinessDate      string                        `json:"business_date"` // YYYY-MM-DD in the bank's business day time zone
+	WindowStart       string                        `json:"window_start"`
+	Currency          string                        `json:"currency"`
+	Threshold         string                        `json:"threshold"`
+	Subject           ComplianceReportSubject       `json:"subject"`
+	Totals            ComplianceReportTotals        `json:"totals"`
+	Transactions      []ComplianceReportTransaction `json:"transactions"`
+	Review            ComplianceReportReview        `json:"review"`
+	GeneratedAt       time.Time                     `json:"generated_at"`
+}
+
+// ComplianceReportSubject identifies the customer a report is about.
+type ComplianceReportSubject struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	FirstName  string    `json:"first_name"`
+	LastName   string    `json:"last_name"`
+	Email      string    `json:"email"`
+}
+
+// ComplianceReportTotals are the amounts a report covers, split by direction and category.
+type ComplianceReportTotals struct {
+	CashIn           string `json:"cash_in"`
+	CashOut          string `json:"cash_out"`
+	TransferIn       string `json:"transfer_in"`
+	TransferOut      string `json:"transfer_out"`
+	TotalIn          string `json:"total_in"`
+	TotalOut         string `json:"total_out"`
+	TransactionCount int    `json:"transaction_count"`
+}
+
+// ComplianceReportTransaction is one transaction covered by a report.
+type ComplianceReportTransaction struct {
+	TransactionID uuid.UUID `json:"transaction_id"`
+	AccountID     uuid.UUID `json:"account_id"`
+	PostedAt      time.Time `json:"posted_at"`
+	Direction     string    `json:"direction"` // "in" or "out"
+	Category      string    `json:"category"`  // "cash" or "transfer"
+	Amount        string    `json:"amount"`
+}
+
+// ComplianceReportReview records the admin approval a report was filed under.
+type ComplianceReportReview struct {
+	ReviewedBy uuid.UUID `json:"reviewed_by"`
+	ReviewedAt time.Time `json:"reviewed_at"`
+	Note       string    `json:"note"`
+}
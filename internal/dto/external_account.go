@@ -17,11 +17,47 @@ type RegisterExternalAccountRequest struct {
 
 // ExternalAccountResponse defines the structure for a successfully registered external account.
 type ExternalAccountResponse struct {
-	ID                uuid.UUID `json:"id"`
-	Nickname          string    `json:"nickname"`
-	AccountNumberMask string    `json:"account_number_mask"`
-	NameOnAccount     string    `json:"name_on_account"`
-	BankName          string    `json:"bank_name"`
+	ID                            uuid.UUID `json:"id"`
+	Nickname                      string    `json:"nickname"`
+	AccountNumberMask             string    `json:"account_number_mask"`
+	NameOnAccount                 string    `json:"name_on_account"`
+	BankName                      string    `json:"bank_name"`
+	VerificationStatus            string    `json:"verification_status"` // unverified, micro_deposits_sent, verified, failed or locked
+	VerificationAttemptsRemaining int       `json:"verification_attempts_remaining"`
+	CreatedAt                     time.Time `json:"created_at"`
+}
+
+// UpdateExternalAccountRequest defines the request body for renaming a payee.
+type UpdateExternalAccountRequest struct {
+	Nickname string `json:"nickname" validate:"required,min=2,max=50"`
+}
+
+// ExternalAccountListResponse lists the authenticated user's payees.
+type ExternalAccountListResponse struct {
+	ExternalAccounts []ExternalAccountResponse `json:"external_accounts"`
+	Total            int                       `json:"total"`
+}
+
+// ExternalAccountStatsResponse summarises transfer usage for a payee.
+type ExternalAccountStatsResponse struct {
+	TotalTransfers     int64      `json:"total_transfers"`
+	CompletedTransfers int64      `json:"completed_transfers"`
+	PendingTransfers   int64      `json:"pending_transfers"`
+	FailedTransfers    int64      `json:"failed_transfers"`
+	TotalAmountSent    string     `json:"total_amount_sent"`
+	LastTransferAt     *time.Time `json:"last_transfer_at,omitempty"`
+}
+
+// ExternalAccountDetailResponse is a payee with its transfer usage statistics.
+type ExternalAccountDetailResponse struct {
+	ExternalAccountResponse
+	Stats ExternalAccountStatsResponse `json:"stats"`
+}
+
+// VerifyExternalAccountRequest defines the request body for confirming micro-deposit amounts.
+type VerifyExternalAccountRequest struct {
+	Amount1 string `json:"amount_1" validate:"required"`
+	Amount2 string `json:"amount_2" validate:"required"`
 }
 
 // NorthwindCreateAccountRequest is the DTO for the request to Northwind's API.
@@ -45,6 +81,10 @@ type NorthwindInitiateTransferRequest struct {
 	Amount               string `json:"amount"`
 	Direction            string `json:"direction"`     // "debit" for sending money out
 	TransferType         string `json:"transfer_type"` // "standard" or "express"
+
+	// IdempotencyKey is sent as the Idempotency-Key header so retries cannot double-send.
+	// Requests without one are never retried.
+	IdempotencyKey string `json:"-"`
 }
 
 // NorthwindInitiateTransferResponse is the DTO for the response from Northwind's /transfers endpoint.
@@ -53,6 +93,12 @@ type NorthwindInitiateTransferResponse struct {
 	Status string `json:"status"`
 }
 
+// NorthwindErrorResponse is the error body Northwind returns with 4xx and 5xx responses.
+type NorthwindErrorResponse struct {
+	Code    string `json:"code"` // e.g. "validation_error", "insufficient_funds", "not_found"
+	Message string `json:"message"`
+}
+
 // NorthwindGetTransferResponse is the DTO for the response from Northwind's GET /transfers/{id} endpoint.
 type NorthwindGetTransferResponse struct {
 	ID                   string    `json:"id"`
@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FraudScreeningRequest describes a money movement about to be made.
+type FraudScreeningRequest struct {
+	UserID                uuid.UUID
+	Operation             string
+	AccountID             uuid.UUID
+	CounterpartyAccountID *uuid.UUID // Destination account of an internal transfer
+	ExternalAccountID     *uuid.UUID // Payee of an external transfer
+	Amount                decimal.Decimal
+}
+
+// FraudRuleResult is the outcome of one screening rule for a money movement.
+type FraudRuleResult struct {
+	Rule      string `json:"rule"`
+	Outcome   string `json:"outcome"`
+	Score     int    `json:"score"`
+	Triggered bool   `json:"triggered"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// FraudRuleResponse is the effective configuration of a screening rule.
+type FraudRuleResponse struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Enabled     bool                   `json:"enabled"`
+	Action      string                 `json:"action"`
+	Score       int                    `json:"score"`
+	Params      map[string]interface{} `json:"params"`
+	Customized  bool                   `json:"customized"` // False while the rule runs with its built-in defaults
+	UpdatedBy   *uuid.UUID             `json:"updated_by,omitempty"`
+	UpdatedAt   *time.Time             `json:"updated_at,omitempty"`
+}
+
+// FraudRuleListResponse lists every screening rule.
+type FraudRuleListResponse struct {
+	Rules []FraudRuleResponse `json:"rules"`
+}
+
+// UpdateFraudRuleRequest is the DTO for changing a screening rule. Omitted fields keep their
+// current value, and params are merged into the current parameters.
+type UpdateFraudRuleRequest struct {
+	Enabled *bool                  `json:"enabled,omitempty"`
+	Action  *string                `json:"action,omitempty" validate:"omitempty,oneof=allow review block"`
+	Score   *int                   `json:"score,omitempty" validate:"omitempty,min=0,max=100"`
+	Params  map[string]interface{} `json:"params,omitempty"`
+}
+
+// FraudDecisionResponse is the admin view of a screening decision.
+type FraudDecisionResponse struct {
+	ID                    uuid.UUID         `json:"id"`
+	UserID                uuid.UUID         `json:"user_id"`
+	Operation             string            `json:"operation"`
+	AccountID             uuid.UUID         `json:"account_id"`
+	CounterpartyAccountID *uuid.UUID        `json:"counterparty_account_id,omitempty"`
+	ExternalAccountID     *uuid.UUID        `json:"external_account_id,omitempty"`
+	Amount                string            `json:"amount"`
+	Outcome               string            `json:"outcome"`
+	Score                 int               `json:"score"`
+	Rules                 []FraudRuleResult `json:"rules"`
+	ResourceType          *string           `json:"resource_type,omitempty"`
+	ResourceID            *uuid.UUID        `json:"resource_id,omitempty"`
+	CreatedAt             time.Time         `json:"created_at"`
+}
+
+// FraudDecisionListResponse is a paginated list of screening decisions.
+type FraudDecisionListResponse struct {
+	Decisions  []FraudDecisionResponse `json:"decisions"`
+	Pagination PaginationMeta          `json:"pagination"`
+}
@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Northwind webhook event types
+const (
+	NorthwindEventCreditReceived        = "credit.received"
+	NorthwindEventTransferStatusChanged = "transfer.status_changed"
+)
+
+// Northwind transfer statuses, as returned by GET /transfers/{id} and in status callbacks
+const (
+	NorthwindTransferStatusProcessing = "processing"
+	NorthwindTransferStatusCompleted  = "completed"
+	NorthwindTransferStatusFailed     = "failed"
+)
+
+// NorthwindWebhookEvent is the signed envelope Northwind posts to /partners/northwind/webhooks.
+type NorthwindWebhookEvent struct {
+	ID        string          `json:"id"` // Partner event ID, unique per event and stable across redeliveries
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// NorthwindCreditReceivedData is the payload of a credit.received event.
+type NorthwindCreditReceivedData struct {
+	TransferID      string `json:"transfer_id"`
+	AccountNumber   string `json:"account_number"` // Our account number being credited
+	Amount          string `json:"amount"`
+	Currency        string `json:"currency"`
+	SenderName      string `json:"sender_name"`
+	SenderAccountID string `json:"sender_account_id"` // Originator's Northwind account, used for returns
+	Reference       string `json:"reference"`
+}
+
+// NorthwindTransferStatusData is the payload of a transfer.status_changed event.
+type NorthwindTransferStatusData struct {
+	TransferID string    `json:"transfer_id"` // Northwind transfer ID, stored as the transfer's external ID
+	Status     string    `json:"status"`      // "processing", "completed" or "failed"
+	Reason     string    `json:"reason,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NorthwindWebhookAck is returned to Northwind once an event has been accepted.
+type NorthwindWebhookAck struct {
+	EventID   string `json:"event_id"`
+	Status    string `json:"status"`
+	Duplicate bool   `json:"duplicate"` // Event already applied, or superseded by a later status
+}
+
+// ResolveInboundCreditRequest is the DTO for posting a suspense credit to a chosen account.
+type ResolveInboundCreditRequest struct {
+	AccountNumber string `json:"account_number" validate:"required"`
+	Note          string `json:"note" validate:"required,max=500"`
+}
+
+// ReturnInboundCreditRequest is the DTO for returning a suspense credit to the sender.
+type ReturnInboundCreditRequest struct {
+	Note string `json:"note" validate:"required,max=500"`
+}
+
+// InboundCreditResponse is the admin view of an inbound credit.
+type InboundCreditResponse struct {
+	ID                 uuid.UUID  `json:"id"`
+	EventID            string     `json:"event_id"`
+	ExternalTransferID string     `json:"external_transfer_id,omitempty"`
+	AccountNumber      string     `json:"account_number"`
+	Amount             string     `json:"amount"`
+	Currency           string     `json:"currency"`
+	SenderName         string     `json:"sender_name,omitempty"`
+	Reference          string     `json:"reference,omitempty"`
+	Status             string     `json:"status"`
+	SuspenseReason     *string    `json:"suspense_reason,omitempty"`
+	AccountID          *uuid.UUID `json:"account_id,omitempty"`
+	TransactionID      *uuid.UUID `json:"transaction_id,omitempty"`
+	ReturnTransferID   *string    `json:"return_transfer_id,omitempty"`
+	ResolvedBy         *uuid.UUID `json:"resolved_by,omitempty"`
+	ResolvedAt         *time.Time `json:"resolved_at,omitempty"`
+	ResolutionNote     *string    `json:"resolution_note,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+// InboundCreditListResponse is a paginated list of inbound credits.
+type InboundCreditListResponse struct {
+	Credits    []InboundCreditResponse `json:"credits"`
+	Pagination PaginationMeta          `json:"pagination"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+)
+
+// JobResponse is the admin view of a registered background job. Running and NextRunAt describe
+// the instance that served the request, which has no next run for a singleton job it does not
+// lead; pause state and run history are shared by every instance.
+type JobResponse struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Schedule    string         `json:"schedule"`
+	Timeout     string         `json:"timeout"`
+	Jitter      string         `json:"jitter,omitempty"`
+	Singleton   bool           `json:"singleton"` // Runs only on the leader instance
+	Paused      bool           `json:"paused"`
+	PausedBy    *uuid.UUID     `json:"paused_by,omitempty"`
+	PausedAt    *time.Time     `json:"paused_at,omitempty"`
+	Running     bool           `json:"running"`
+	NextRunAt   *time.Time     `json:"next_run_at,omitempty"`
+	LastRun     *models.JobRun `json:"last_run,omitempty"`
+}
+
+// JobListResponse lists the registered background jobs.
+type JobListResponse struct {
+	Jobs []JobResponse `json:"jobs"`
+}
+
+// JobRunListResponse is a paginated list of a job's runs, newest first.
+type JobRunListResponse struct {
+	Runs       []models.JobRun `json:"runs"`
+	Pagination PaginationMeta  `json:"pagination"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+)
+
+// OutboxConsumerStatus is the admin view of one outbox consumer's delivery position.
+type OutboxConsumerStatus struct {
+	Consumer          string     `json:"consumer"`
+	LastEventSequence int64      `json:"last_event_sequence"`
+	Undelivered       int64      `json:"undelivered"`  // Events after the checkpoint not yet handled
+	DeadLetters       int64      `json:"dead_letters"` // Events given up on or skipped and not yet replayed
+	Attempts          int        `json:"attempts"`     // Consecutive failures on the next event
+	LastError         *string    `json:"last_error,omitempty"`
+	NextAttemptAt     *time.Time `json:"next_attempt_at,omitempty"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// OutboxConsumerListResponse lists every registered outbox consumer.
+type OutboxConsumerListResponse struct {
+	Consumers []OutboxConsumerStatus `json:"consumers"`
+}
+
+// OutboxEventListResponse is a paginated list of events a consumer has not yet handled.
+type OutboxEventListResponse struct {
+	Events     []models.OutboxEvent `json:"events"`
+	Pagination PaginationMeta       `json:"pagination"`
+}
+
+// SkipOutboxEventRequest names the event to skip, which must be the consumer's next undelivered one.
+type SkipOutboxEventRequest struct {
+	EventSequence int64 `json:"event_sequence" validate:"required,min=1"`
+}
+
+// OutboxDeadLetterListResponse is a paginated list of a consumer's dead-lettered events.
+type OutboxDeadLetterListResponse struct {
+	DeadLetters []models.OutboxDeadLetter `json:"dead_letters"`
+	Pagination  PaginationMeta            `json:"pagination"`
+}
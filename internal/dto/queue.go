@@ -1,11 +1,90 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 // QueueMetrics represents metrics for the processing queue
 type QueueMetrics struct {
-	PendingCount    int64   `json:"pendingCount"`
-	ProcessingCount int64   `json:"processingCount"`
-	CompletedCount  int64   `json:"completedCount"`
-	FailedCount     int64   `json:"failedCount"`
-	AvgProcessingMs float64 `json:"avgProcessingMs"`
-	OldestPending   *string `json:"oldestPending,omitempty"`
+	PendingCount      int64   `json:"pendingCount"`
+	ProcessingCount   int64   `json:"processingCount"`
+	CompletedCount    int64   `json:"completedCount"`
+	FailedCount       int64   `json:"failedCount"`
+	CancelledCount    int64   `json:"cancelledCount"`
+	DeadLetteredCount int64   `json:"deadLetteredCount"`
+	AvgProcessingMs   float64 `json:"avgProcessingMs"`
+	OldestPending     *string `json:"oldestPending,omitempty"`
+	Paused            bool    `json:"paused"`
+}
+
+// QueueItemResponse is the admin view of a transaction processing queue item.
+type QueueItemResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	TransactionID  uuid.UUID  `json:"transaction_id"`
+	Operation      string     `json:"operation"`
+	Priority       int        `json:"priority"`
+	Status         string     `json:"status"`
+	RetryCount     int        `json:"retry_count"`
+	MaxRetries     int        `json:"max_retries"`
+	ScheduledAt    time.Time  `json:"scheduled_at"`
+	LockedBy       string     `json:"locked_by,omitempty"`
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+	ProcessedAt    *time.Time `json:"processed_at,omitempty"`
+	ErrorMessage   string     `json:"error_message,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// QueueItemListResponse is a paginated list of processing queue items.
+type QueueItemListResponse struct {
+	Items      []QueueItemResponse `json:"items"`
+	Pagination PaginationMeta      `json:"pagination"`
+}
+
+// RescheduleQueueItemRequest is the DTO for moving a queue item to a later time.
+type RescheduleQueueItemRequest struct {
+	ScheduledAt time.Time `json:"scheduled_at" validate:"required"`
+}
+
+// RetryQueueItemsRequest selects queue items to retry in bulk, either by ID or every item in a
+// status (up to 100 per request, oldest first). With ScheduledAt the items are rescheduled to
+// that time instead of retried straight away.
+type RetryQueueItemsRequest struct {
+	ItemIDs     []uuid.UUID `json:"item_ids" validate:"required_without=Status,excluded_with=Status,max=100"`
+	Status      string      `json:"status" validate:"omitempty,oneof=failed dead_lettered"`
+	ScheduledAt *time.Time  `json:"scheduled_at,omitempty"`
+}
+
+// QueueItemRetrySkip explains why a queue item in a bulk retry was not requeued.
+type QueueItemRetrySkip struct {
+	ID     uuid.UUID `json:"id"`
+	Reason string    `json:"reason"`
+}
+
+// RetryQueueItemsResponse reports the outcome of a bulk retry.
+type RetryQueueItemsResponse struct {
+	Requeued []uuid.UUID          `json:"requeued"`
+	Skipped  []QueueItemRetrySkip `json:"skipped"`
+}
+
+// CloseQueueItemRequest is the DTO for cancelling or dead-lettering a queue item.
+type CloseQueueItemRequest struct {
+	Note string `json:"note" validate:"required,max=500"`
+}
+
+// PauseQueueWorkersRequest is the DTO for pausing the processing queue workers.
+type PauseQueueWorkersRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// CleanupQueueRequest is the DTO for deleting old completed queue items.
+type CleanupQueueRequest struct {
+	OlderThanHours int `json:"older_than_hours" validate:"required,min=1"`
+}
+
+// CleanupQueueResponse reports how many completed queue items were deleted.
+type CleanupQueueResponse struct {
+	Deleted int64 `json:"deleted"`
 }
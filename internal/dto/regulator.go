@@ -7,7 +7,11 @@ import (
 )
 
 // RegulatorNotificationPayload is the data sent to the regulator's webhook.
+// EventID identifies the notification and is the same on every delivery attempt; Timestamp is set
+// by the regulator client on each attempt.
 type RegulatorNotificationPayload struct {
+	EventID     string     `json:"event_id"`  // Stable across retries; the regulator deduplicates on it
+	Timestamp   time.Time  `json:"timestamp"` // When the delivery was signed
 	TransferID  uuid.UUID  `json:"transfer_id"`
 	Status      string     `json:"status"` // "completed" or "failed"
 	Amount      string     `json:"amount"`
@@ -16,3 +20,55 @@ type RegulatorNotificationPayload struct {
 	FailedAt    *time.Time `json:"failed_at,omitempty"`
 	Reason      *string    `json:"reason,omitempty"` // Reason for failure
 }
+
+// WebhookNotificationResponse is the admin view of a regulator webhook notification,
+// including the regulator's last response for diagnosing failed deliveries.
+type WebhookNotificationResponse struct {
+	ID                 uuid.UUID  `json:"id"`
+	TransferID         uuid.UUID  `json:"transfer_id"`
+	TransferStatus     string     `json:"transfer_status,omitempty"`
+	URL                string     `json:"url"`
+	Status             string     `json:"status"`
+	Attempts           int        `json:"attempts"`
+	LastAttemptAt      *time.Time `json:"last_attempt_at,omitempty"`
+	NextAttemptAt      *time.Time `json:"next_attempt_at,omitempty"`
+	ResponseStatusCode *int       `json:"response_status_code,omitempty"`
+	ResponseBody       *string    `json:"response_body,omitempty"`
+	LastError          *string    `json:"last_error,omitempty"`
+	DeadLetteredAt     *time.Time `json:"dead_lettered_at,omitempty"`
+	ResolvedBy         *uuid.UUID `json:"resolved_by,omitempty"`
+	ResolvedAt         *time.Time `json:"resolved_at,omitempty"`
+	ResolutionNote     *string    `json:"resolution_note,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+// WebhookNotificationListResponse is a paginated list of regulator webhook notifications.
+type WebhookNotificationListResponse struct {
+	Notifications []WebhookNotificationResponse `json:"notifications"`
+	Pagination    PaginationMeta                `json:"pagination"`
+}
+
+// ReplayWebhookNotificationsRequest selects notifications to replay in bulk, either by ID
+// or every notification in a status (up to 100 per request, oldest first).
+type ReplayWebhookNotificationsRequest struct {
+	NotificationIDs []uuid.UUID `json:"notification_ids" validate:"required_without=Status,excluded_with=Status,max=100"`
+	Status          string      `json:"status" validate:"omitempty,oneof=failed dead_lettered"`
+}
+
+// WebhookNotificationReplaySkip explains why a notification in a bulk replay was not requeued.
+type WebhookNotificationReplaySkip struct {
+	ID     uuid.UUID `json:"id"`
+	Reason string    `json:"reason"`
+}
+
+// ReplayWebhookNotificationsResponse reports the outcome of a bulk replay.
+type ReplayWebhookNotificationsResponse struct {
+	Replayed []uuid.UUID                     `json:"replayed"`
+	Skipped  []WebhookNotificationReplaySkip `json:"skipped"`
+}
+
+// ResolveWebhookNotificationRequest is the DTO for closing a notification without delivering it.
+type ResolveWebhookNotificationRequest struct {
+	Note string `json:"note" validate:"required,max=500"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReviewSanctionsMatchRequest is the DTO for clearing or confirming a sanctions match.
+type ReviewSanctionsMatchRequest struct {
+	Note string `json:"note" validate:"required,max=500"`
+}
+
+// SanctionsMatchResponse is the admin view of a potential sanctions list match.
+type SanctionsMatchResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	SubjectType  string     `json:"subject_type"`
+	SubjectID    uuid.UUID  `json:"subject_id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	ScreenedName string     `json:"screened_name"`
+	EntryID      string     `json:"entry_id"`
+	EntryName    string     `json:"entry_name"`
+	EntryType    string     `json:"entry_type,omitempty"`
+	Programs     string     `json:"programs,omitempty"`
+	Similarity   int        `json:"similarity"`
+	Action       string     `json:"action"`
+	Status       string     `json:"status"`
+	ListVersion  string     `json:"list_version"`
+	ReviewedBy   *uuid.UUID `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNote   string     `json:"review_note,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// SanctionsMatchListResponse is a paginated list of sanctions matches.
+type SanctionsMatchListResponse struct {
+	Matches    []SanctionsMatchResponse `json:"matches"`
+	Pagination PaginationMeta           `json:"pagination"`
+}
+
+// SanctionsListStatusResponse describes the sanctions list currently in use.
+type SanctionsListStatusResponse struct {
+	Loaded        bool       `json:"loaded"`
+	Version       string     `json:"version,omitempty"`
+	EntryCount    int        `json:"entry_count"`
+	LoadedAt      *time.Time `json:"loaded_at,omitempty"`
+	RescreenedAt  *time.Time `json:"rescreened_at,omitempty"`
+	SubjectsCount int        `json:"subjects_screened"`
+	MatchesCount  int        `json:"matches_raised"`
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	"github.com/array/banking-api/internal/models"
 	"github.com/google/uuid"
 )
 
@@ -51,3 +52,22 @@ type ListTransactionsResponse struct {
 	Transactions []TransactionWithBalance `json:"transactions"`
 	Pagination   PaginationInfo           `json:"pagination"`
 }
+
+// TransactionOperationResponse reports the progress of a transaction submitted with
+// Prefer: respond-async. Transaction matches the synchronous response once the operation
+// completes; Error is set when it failed.
+type TransactionOperationResponse struct {
+	ID          uuid.UUID           `json:"id"`
+	AccountID   uuid.UUID           `json:"accountId"`
+	Status      string              `json:"status"` // queued, processing, completed, failed, cancelled or held
+	SubmittedAt time.Time           `json:"submittedAt"`
+	Transaction *models.Transaction `json:"transaction,omitempty"`
+	Error       *OperationError     `json:"error,omitempty"`
+}
+
+// OperationError describes why an asynchronous operation failed, using the error code the
+// synchronous request would have returned
+type OperationError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TransferReviewRequest describes a transfer about to be executed, for the review triggers.
+type TransferReviewRequest struct {
+	UserID            uuid.UUID
+	AccountID         uuid.UUID
+	ToAccountID       *uuid.UUID // Destination account of an internal transfer
+	ExternalAccountID *uuid.UUID // Payee of an external transfer
+	Amount            decimal.Decimal
+}
+
+// IsExternal reports whether the transfer leaves the bank.
+func (r *TransferReviewRequest) IsExternal() bool {
+	return r.ExternalAccountID != nil
+}
+
+// TransferReviewReason explains why a review trigger held a transfer.
+type TransferReviewReason struct {
+	Trigger string `json:"trigger"`
+	Reason  string `json:"reason"`
+}
+
+// TransferReviewActivity is the customer's recent activity shown alongside a held transfer.
+type TransferReviewActivity struct {
+	RecentTransfers    []models.Transfer    `json:"recent_transfers"`
+	RecentTransactions []models.Transaction `json:"recent_transactions"`
+}
+
+// DecideTransferReviewRequest is the DTO for approving or rejecting a held transfer.
+type DecideTransferReviewRequest struct {
+	Note string `json:"note" validate:"required,max=500"`
+}
+
+// TransferReviewResponse is the admin view of a transfer held for review.
+type TransferReviewResponse struct {
+	ID         uuid.UUID              `json:"id"`
+	TransferID uuid.UUID              `json:"transfer_id"`
+	UserID     uuid.UUID              `json:"user_id"`
+	Status     string                 `json:"status"`
+	Reasons    []TransferReviewReason `json:"reasons"`
+	Transfer   models.Transfer        `json:"transfer"`
+	DueAt      time.Time              `json:"due_at"`
+	Overdue    bool                   `json:"overdue"`
+	ReviewedBy *uuid.UUID             `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time             `json:"reviewed_at,omitempty"`
+	ReviewNote string                 `json:"review_note,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// TransferReviewDetailResponse is a held transfer with the customer's recent activity.
+type TransferReviewDetailResponse struct {
+	TransferReviewResponse
+	Activity *TransferReviewActivity `json:"activity,omitempty"`
+}
+
+// TransferReviewListResponse is a paginated list of transfer reviews.
+type TransferReviewListResponse struct {
+	Reviews    []TransferReviewResponse `json:"reviews"`
+	Pagination PaginationMeta           `json:"pagination"`
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+)
+
+// CreateWebhookSubscriptionRequest defines the request body for subscribing an endpoint to account
+// events. The URL must be https and must not point at a private, loopback or link-local address.
+type CreateWebhookSubscriptionRequest struct {
+	URL                 string   `json:"url" validate:"required,http_url,max=512"`
+	Description         string   `json:"description" validate:"max=255"`
+	EventTypes          []string `json:"event_types" validate:"required,min=1,dive,oneof=transaction.posted transfer.completed transfer.failed balance.low"`
+	LowBalanceThreshold string   `json:"low_balance_threshold,omitempty"` // Required with balance.low; decimal amount, e.g. "100.00"
+}
+
+// UpdateWebhookSubscriptionRequest defines the request body for changing a subscription. Omitted
+// fields are left unchanged; setting status to active re-enables a disabled subscription.
+type UpdateWebhookSubscriptionRequest struct {
+	URL                 *string  `json:"url,omitempty" validate:"omitempty,http_url,max=512"`
+	Description         *string  `json:"description,omitempty" validate:"omitempty,max=255"`
+	EventTypes          []string `json:"event_types,omitempty" validate:"omitempty,min=1,dive,oneof=transaction.posted transfer.completed transfer.failed balance.low"`
+	LowBalanceThreshold *string  `json:"low_balance_threshold,omitempty"`
+	Status              *string  `json:"status,omitempty" validate:"omitempty,oneof=active disabled"`
+}
+
+// WebhookSubscriptionResponse is a customer's webhook subscription. The signing secret is only
+// returned when the subscription is created.
+type WebhookSubscriptionResponse struct {
+	ID                  uuid.UUID  `json:"id"`
+	URL                 string     `json:"url"`
+	Description         string     `json:"description,omitempty"`
+	EventTypes          []string   `json:"event_types"`
+	LowBalanceThreshold string     `json:"low_balance_threshold"`
+	Status              string     `json:"status"`               // active or disabled
+	ConsecutiveFailures int        `json:"consecutive_failures"` // Failed delivery attempts since the last success
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	DisabledReason      *string    `json:"disabled_reason,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// CreateWebhookSubscriptionResponse is a new subscription with the secret its deliveries are signed with.
+type CreateWebhookSubscriptionResponse struct {
+	WebhookSubscriptionResponse
+	Secret string `json:"secret"`
+}
+
+// WebhookSubscriptionListResponse lists the authenticated user's webhook subscriptions.
+type WebhookSubscriptionListResponse struct {
+	Subscriptions []WebhookSubscriptionResponse `json:"subscriptions"`
+	Total         int                           `json:"total"`
+}
+
+// WebhookDeliveryListResponse is a paginated delivery log for a subscription, newest first.
+type WebhookDeliveryListResponse struct {
+	Deliveries []models.WebhookDelivery `json:"deliveries"`
+	Pagination PaginationMeta           `json:"pagination"`
+}
+
+// CustomerWebhookEnvelope is the JSON body delivered to a subscription. The event ID is stable
+// across retries and manual redeliveries, so receivers can deduplicate on it.
+type CustomerWebhookEnvelope struct {
+	EventID   uuid.UUID              `json:"event_id"`
+	EventType string                 `json:"event_type"`
+	CreatedAt time.Time              `json:"created_at"`
+	Data      map[string]interface{} `json:"data"`
+}
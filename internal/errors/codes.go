@@ -60,6 +60,94 @@ const (
 	TransferNotFound          ErrorCode = "TRANSFER_004"
 	TransferInsufficientFunds ErrorCode = "TRANSFER_005"
 	TransferInvalidAmount     ErrorCode = "TRANSFER_006"
+	TransferNotEscalated      ErrorCode = "TRANSFER_007"
+)
+
+// Payee error codes (PAYEE_*)
+const (
+	PayeeNotFound                 ErrorCode = "PAYEE_001"
+	PayeeNotVerified              ErrorCode = "PAYEE_002"
+	PayeeVerificationMismatch     ErrorCode = "PAYEE_003"
+	PayeeVerificationLocked       ErrorCode = "PAYEE_004"
+	PayeeInvalidVerificationState ErrorCode = "PAYEE_005"
+	PayeeHasPendingTransfers      ErrorCode = "PAYEE_006"
+)
+
+// Partner webhook error codes (WEBHOOK_*)
+const (
+	WebhookInvalidSignature ErrorCode = "WEBHOOK_001"
+	WebhookStaleTimestamp   ErrorCode = "WEBHOOK_002"
+	WebhookInvalidPayload   ErrorCode = "WEBHOOK_003"
+)
+
+// Inbound credit error codes (INBOUND_*)
+const (
+	InboundCreditNotFound      ErrorCode = "INBOUND_001"
+	InboundCreditInvalidState  ErrorCode = "INBOUND_002"
+	InboundCreditNotReturnable ErrorCode = "INBOUND_003"
+)
+
+// Outbox error codes (OUTBOX_*)
+const (
+	OutboxConsumerNotFound   ErrorCode = "OUTBOX_001"
+	OutboxEventNotNext       ErrorCode = "OUTBOX_002"
+	OutboxDeadLetterNotFound ErrorCode = "OUTBOX_003"
+	OutboxDeadLetterReplayed ErrorCode = "OUTBOX_004"
+)
+
+// Webhook subscription error codes (SUBSCRIPTION_*)
+const (
+	SubscriptionNotFound           ErrorCode = "SUBSCRIPTION_001"
+	SubscriptionDeliveryNotFound   ErrorCode = "SUBSCRIPTION_002"
+	SubscriptionLimitReached       ErrorCode = "SUBSCRIPTION_003"
+	SubscriptionDeliveryInProgress ErrorCode = "SUBSCRIPTION_004"
+)
+
+// Regulator notification error codes (NOTIFICATION_*)
+const (
+	NotificationNotFound     ErrorCode = "NOTIFICATION_001"
+	NotificationInvalidState ErrorCode = "NOTIFICATION_002"
+)
+
+// Compliance report error codes (COMPLIANCE_*)
+const (
+	ComplianceReportNotFound         ErrorCode = "COMPLIANCE_001"
+	ComplianceReportNotPendingReview ErrorCode = "COMPLIANCE_002"
+)
+
+// Fraud screening error codes (FRAUD_*)
+const (
+	FraudTransactionDeclined ErrorCode = "FRAUD_001"
+	FraudRuleNotFound        ErrorCode = "FRAUD_002"
+	FraudDecisionNotFound    ErrorCode = "FRAUD_003"
+)
+
+// Sanctions screening error codes (SANCTIONS_*)
+const (
+	SanctionsCustomerRestricted ErrorCode = "SANCTIONS_001"
+	SanctionsPayeeRestricted    ErrorCode = "SANCTIONS_002"
+	SanctionsMatchNotFound      ErrorCode = "SANCTIONS_003"
+	SanctionsMatchNotOpen       ErrorCode = "SANCTIONS_004"
+	SanctionsListNotLoaded      ErrorCode = "SANCTIONS_005"
+)
+
+// Transfer review error codes (REVIEW_*)
+const (
+	TransferReviewNotFound ErrorCode = "REVIEW_001"
+	TransferReviewDecided  ErrorCode = "REVIEW_002"
+)
+
+// Processing queue error codes (QUEUE_*)
+const (
+	QueueItemNotFound     ErrorCode = "QUEUE_001"
+	QueueItemInvalidState ErrorCode = "QUEUE_002"
+)
+
+// Background job error codes (JOB_*)
+const (
+	JobNotFound       ErrorCode = "JOB_001"
+	JobAlreadyRunning ErrorCode = "JOB_002"
+	JobNotLeader      ErrorCode = "JOB_003"
 )
 
 // System error codes (SYSTEM_*)
@@ -70,6 +158,7 @@ const (
 	SystemConfigurationError ErrorCode = "SYSTEM_004"
 	SystemUnexpectedError    ErrorCode = "SYSTEM_005"
 	SystemRateLimitExceeded  ErrorCode = "SYSTEM_006"
+	SystemRequestTimeout     ErrorCode = "SYSTEM_007"
 )
 
 // errorMessages maps error codes to their default human-readable messages
@@ -120,6 +209,70 @@ var errorMessages = map[ErrorCode]string{
 	TransferNotFound:          "Transfer not found",
 	TransferInsufficientFunds: "Source account has insufficient balance for this transfer",
 	TransferInvalidAmount:     "Invalid transfer amount",
+	TransferNotEscalated:      "Transfer is not in the stuck transfer queue",
+
+	// Payee errors
+	PayeeNotFound:                 "External account not found",
+	PayeeNotVerified:              "External account must be verified before sending this amount",
+	PayeeVerificationMismatch:     "Micro-deposit amounts do not match",
+	PayeeVerificationLocked:       "External account verification is locked after too many failed attempts",
+	PayeeInvalidVerificationState: "External account is not in a valid state for this verification step",
+	PayeeHasPendingTransfers:      "External account has pending transfers and cannot be removed",
+
+	// Partner webhook errors
+	WebhookInvalidSignature: "Webhook signature is missing or invalid",
+	WebhookStaleTimestamp:   "Webhook timestamp is outside the allowed tolerance",
+	WebhookInvalidPayload:   "Webhook payload is malformed",
+
+	// Inbound credit errors
+	InboundCreditNotFound:      "Inbound credit not found",
+	InboundCreditInvalidState:  "Inbound credit is not awaiting review",
+	InboundCreditNotReturnable: "Inbound credit cannot be returned to the sender",
+
+	// Outbox errors
+	OutboxConsumerNotFound:   "Outbox consumer not found",
+	OutboxEventNotNext:       "Event is not the consumer's next undelivered event",
+	OutboxDeadLetterNotFound: "Outbox dead letter not found",
+	OutboxDeadLetterReplayed: "Outbox dead letter already replayed",
+
+	// Webhook subscription errors
+	SubscriptionNotFound:           "Webhook subscription not found",
+	SubscriptionDeliveryNotFound:   "Webhook delivery not found",
+	SubscriptionLimitReached:       "Webhook subscription limit reached",
+	SubscriptionDeliveryInProgress: "Webhook delivery is still being retried",
+
+	// Regulator notification errors
+	NotificationNotFound:     "Webhook notification not found",
+	NotificationInvalidState: "Webhook notification is not failed or dead-lettered",
+
+	// Compliance report errors
+	ComplianceReportNotFound:         "Compliance report not found",
+	ComplianceReportNotPendingReview: "Compliance report has already been reviewed",
+
+	// Fraud screening errors
+	FraudTransactionDeclined: "This transaction was declined. Please contact support",
+	FraudRuleNotFound:        "Fraud rule not found",
+	FraudDecisionNotFound:    "Fraud decision not found",
+
+	// Sanctions screening errors
+	SanctionsCustomerRestricted: "This action is unavailable while your account is under review. Please contact support",
+	SanctionsPayeeRestricted:    "This payee is unavailable while it is under review. Please contact support",
+	SanctionsMatchNotFound:      "Sanctions match not found",
+	SanctionsMatchNotOpen:       "Sanctions match has already been reviewed",
+	SanctionsListNotLoaded:      "Sanctions list is not loaded",
+
+	// Transfer review errors
+	TransferReviewNotFound: "Transfer review not found",
+	TransferReviewDecided:  "Transfer review has already been decided",
+
+	// Processing queue errors
+	QueueItemNotFound:     "Queue item not found",
+	QueueItemInvalidState: "Queue item is not in a state that allows this action",
+
+	// Background job errors
+	JobNotFound:       "Background job not found",
+	JobAlreadyRunning: "Background job is already running",
+	JobNotLeader:      "Background job runs only on the leader instance",
 
 	// System errors
 	SystemInternalError:      "An unexpected error occurred. Please contact support with trace ID",
@@ -128,6 +281,7 @@ var errorMessages = map[ErrorCode]string{
 	SystemConfigurationError: "System configuration error",
 	SystemUnexpectedError:    "An unexpected error occurred",
 	SystemRateLimitExceeded:  "Rate limit exceeded. Please try again later",
+	SystemRequestTimeout:     "The request took too long to complete. Please try again",
 }
 
 // GetErrorMessage returns the default message for a given error code
@@ -106,12 +106,52 @@ func (s *CodesTestSuite) TestIsValidErrorCode_ValidCodes() {
 		TransactionDuplicate,
 		TransactionValidationFailed,
 		TransactionInvalidType,
+		PayeeNotFound,
+		PayeeNotVerified,
+		PayeeVerificationMismatch,
+		PayeeVerificationLocked,
+		PayeeInvalidVerificationState,
+		PayeeHasPendingTransfers,
+		WebhookInvalidSignature,
+		WebhookStaleTimestamp,
+		WebhookInvalidPayload,
+		InboundCreditNotFound,
+		InboundCreditInvalidState,
+		InboundCreditNotReturnable,
+		OutboxConsumerNotFound,
+		OutboxEventNotNext,
+		OutboxDeadLetterNotFound,
+		OutboxDeadLetterReplayed,
+		SubscriptionNotFound,
+		SubscriptionDeliveryNotFound,
+		SubscriptionLimitReached,
+		SubscriptionDeliveryInProgress,
+		NotificationNotFound,
+		NotificationInvalidState,
+		ComplianceReportNotFound,
+		ComplianceReportNotPendingReview,
+		FraudTransactionDeclined,
+		FraudRuleNotFound,
+		FraudDecisionNotFound,
+		SanctionsCustomerRestricted,
+		SanctionsPayeeRestricted,
+		SanctionsMatchNotFound,
+		SanctionsMatchNotOpen,
+		SanctionsListNotLoaded,
+		TransferReviewNotFound,
+		TransferReviewDecided,
+		QueueItemNotFound,
+		QueueItemInvalidState,
+		JobNotFound,
+		JobAlreadyRunning,
+		JobNotLeader,
 		SystemInternalError,
 		SystemDatabaseError,
 		SystemServiceUnavailable,
 		SystemConfigurationError,
 		SystemUnexpectedError,
 		SystemRateLimitExceeded,
+		SystemRequestTimeout,
 	}
 
 	for _, code := range validCodes {
@@ -169,12 +209,52 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Uniqueness() {
 		TransactionDuplicate,
 		TransactionValidationFailed,
 		TransactionInvalidType,
+		PayeeNotFound,
+		PayeeNotVerified,
+		PayeeVerificationMismatch,
+		PayeeVerificationLocked,
+		PayeeInvalidVerificationState,
+		PayeeHasPendingTransfers,
+		WebhookInvalidSignature,
+		WebhookStaleTimestamp,
+		WebhookInvalidPayload,
+		InboundCreditNotFound,
+		InboundCreditInvalidState,
+		InboundCreditNotReturnable,
+		OutboxConsumerNotFound,
+		OutboxEventNotNext,
+		OutboxDeadLetterNotFound,
+		OutboxDeadLetterReplayed,
+		SubscriptionNotFound,
+		SubscriptionDeliveryNotFound,
+		SubscriptionLimitReached,
+		SubscriptionDeliveryInProgress,
+		NotificationNotFound,
+		NotificationInvalidState,
+		ComplianceReportNotFound,
+		ComplianceReportNotPendingReview,
+		FraudTransactionDeclined,
+		FraudRuleNotFound,
+		FraudDecisionNotFound,
+		SanctionsCustomerRestricted,
+		SanctionsPayeeRestricted,
+		SanctionsMatchNotFound,
+		SanctionsMatchNotOpen,
+		SanctionsListNotLoaded,
+		TransferReviewNotFound,
+		TransferReviewDecided,
+		QueueItemNotFound,
+		QueueItemInvalidState,
+		JobNotFound,
+		JobAlreadyRunning,
+		JobNotLeader,
 		SystemInternalError,
 		SystemDatabaseError,
 		SystemServiceUnavailable,
 		SystemConfigurationError,
 		SystemUnexpectedError,
 		SystemRateLimitExceeded,
+		SystemRequestTimeout,
 	}
 
 	seen := make(map[ErrorCode]bool)
@@ -244,6 +324,105 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Format() {
 				TransactionInvalidType,
 			},
 		},
+		{
+			prefix: "PAYEE_",
+			codes: []ErrorCode{
+				PayeeNotFound,
+				PayeeNotVerified,
+				PayeeVerificationMismatch,
+				PayeeVerificationLocked,
+				PayeeInvalidVerificationState,
+				PayeeHasPendingTransfers,
+			},
+		},
+		{
+			prefix: "WEBHOOK_",
+			codes: []ErrorCode{
+				WebhookInvalidSignature,
+				WebhookStaleTimestamp,
+				WebhookInvalidPayload,
+			},
+		},
+		{
+			prefix: "INBOUND_",
+			codes: []ErrorCode{
+				InboundCreditNotFound,
+				InboundCreditInvalidState,
+				InboundCreditNotReturnable,
+			},
+		},
+		{
+			prefix: "OUTBOX_",
+			codes: []ErrorCode{
+				OutboxConsumerNotFound,
+				OutboxEventNotNext,
+				OutboxDeadLetterNotFound,
+				OutboxDeadLetterReplayed,
+			},
+		},
+		{
+			prefix: "SUBSCRIPTION_",
+			codes: []ErrorCode{
+				SubscriptionNotFound,
+				SubscriptionDeliveryNotFound,
+				SubscriptionLimitReached,
+				SubscriptionDeliveryInProgress,
+			},
+		},
+		{
+			prefix: "NOTIFICATION_",
+			codes: []ErrorCode{
+				NotificationNotFound,
+				NotificationInvalidState,
+			},
+		},
+		{
+			prefix: "COMPLIANCE_",
+			codes: []ErrorCode{
+				ComplianceReportNotFound,
+				ComplianceReportNotPendingReview,
+			},
+		},
+		{
+			prefix: "FRAUD_",
+			codes: []ErrorCode{
+				FraudTransactionDeclined,
+				FraudRuleNotFound,
+				FraudDecisionNotFound,
+			},
+		},
+		{
+			prefix: "SANCTIONS_",
+			codes: []ErrorCode{
+				SanctionsCustomerRestricted,
+				SanctionsPayeeRestricted,
+				SanctionsMatchNotFound,
+				SanctionsMatchNotOpen,
+				SanctionsListNotLoaded,
+			},
+		},
+		{
+			prefix: "REVIEW_",
+			codes: []ErrorCode{
+				TransferReviewNotFound,
+				TransferReviewDecided,
+			},
+		},
+		{
+			prefix: "QUEUE_",
+			codes: []ErrorCode{
+				QueueItemNotFound,
+				QueueItemInvalidState,
+			},
+		},
+		{
+			prefix: "JOB_",
+			codes: []ErrorCode{
+				JobNotFound,
+				JobAlreadyRunning,
+				JobNotLeader,
+			},
+		},
 		{
 			prefix: "SYSTEM_",
 			codes: []ErrorCode{
@@ -253,6 +432,7 @@ func (s *CodesTestSuite) TestErrorCodeConstants_Format() {
 				SystemConfigurationError,
 				SystemUnexpectedError,
 				SystemRateLimitExceeded,
+				SystemRequestTimeout,
 			},
 		},
 	}
@@ -298,12 +478,52 @@ func (s *CodesTestSuite) TestAllErrorCodesHaveMessages() {
 		TransactionDuplicate,
 		TransactionValidationFailed,
 		TransactionInvalidType,
+		PayeeNotFound,
+		PayeeNotVerified,
+		PayeeVerificationMismatch,
+		PayeeVerificationLocked,
+		PayeeInvalidVerificationState,
+		PayeeHasPendingTransfers,
+		WebhookInvalidSignature,
+		WebhookStaleTimestamp,
+		WebhookInvalidPayload,
+		InboundCreditNotFound,
+		InboundCreditInvalidState,
+		InboundCreditNotReturnable,
+		OutboxConsumerNotFound,
+		OutboxEventNotNext,
+		OutboxDeadLetterNotFound,
+		OutboxDeadLetterReplayed,
+		SubscriptionNotFound,
+		SubscriptionDeliveryNotFound,
+		SubscriptionLimitReached,
+		SubscriptionDeliveryInProgress,
+		NotificationNotFound,
+		NotificationInvalidState,
+		ComplianceReportNotFound,
+		ComplianceReportNotPendingReview,
+		FraudTransactionDeclined,
+		FraudRuleNotFound,
+		FraudDecisionNotFound,
+		SanctionsCustomerRestricted,
+		SanctionsPayeeRestricted,
+		SanctionsMatchNotFound,
+		SanctionsMatchNotOpen,
+		SanctionsListNotLoaded,
+		TransferReviewNotFound,
+		TransferReviewDecided,
+		QueueItemNotFound,
+		QueueItemInvalidState,
+		JobNotFound,
+		JobAlreadyRunning,
+		JobNotLeader,
 		SystemInternalError,
 		SystemDatabaseError,
 		SystemServiceUnavailable,
 		SystemConfigurationError,
 		SystemUnexpectedError,
 		SystemRateLimitExceeded,
+		SystemRequestTimeout,
 	}
 
 	for _, code := range codes {
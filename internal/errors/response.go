@@ -1,7 +1,9 @@
 package errors
 
 import (
+	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"net/http"
 )
@@ -89,11 +91,16 @@ func NewValidationErrorFromList(details []string, traceID string) *ErrorResponse
 // WrapSystemError wraps an internal error with a generic system error message
 // This prevents exposure of internal implementation details to clients
 // The internal error is returned separately for server-side logging
+// Errors caused by the request deadline passing are reported as a timeout instead
 func WrapSystemError(err error, traceID string) (*ErrorResponse, error) {
+	code := SystemInternalError
+	if stderrors.Is(err, context.DeadlineExceeded) {
+		code = SystemRequestTimeout
+	}
 	response := &ErrorResponse{
 		Error: ErrorDetail{
-			Code:    string(SystemInternalError),
-			Message: GetErrorMessage(SystemInternalError),
+			Code:    string(code),
+			Message: GetErrorMessage(code),
 			Details: []string{},
 			TraceID: traceID,
 		},
@@ -126,11 +133,12 @@ func GetHTTPStatus(code ErrorCode) int {
 	case ValidationGeneral, ValidationRequiredField, ValidationInvalidFormat,
 		ValidationOutOfRange, ValidationInvalidEmail, ValidationInvalidPhone,
 		ValidationInvalidDate, CustomerInvalidID, TransactionInvalidAmount,
-		TransferSameAccount, TransferInvalidAmount:
+		TransferSameAccount, TransferInvalidAmount, WebhookInvalidPayload:
 		return http.StatusBadRequest
 
 	// 401 Unauthorized - Authentication failures
-	case AuthInvalidCredentials, AuthMissingToken, AuthExpiredToken, AuthInvalidTokenFormat:
+	case AuthInvalidCredentials, AuthMissingToken, AuthExpiredToken, AuthInvalidTokenFormat,
+		WebhookInvalidSignature, WebhookStaleTimestamp:
 		return http.StatusUnauthorized
 
 	// 403 Forbidden - Authorization failures
@@ -138,11 +146,21 @@ func GetHTTPStatus(code ErrorCode) int {
 		return http.StatusForbidden
 
 	// 404 Not Found - Resource not found
-	case CustomerNotFound, AccountNotFound, TransactionNotFound, TransferNotFound:
+	case CustomerNotFound, AccountNotFound, TransactionNotFound, TransferNotFound,
+		PayeeNotFound, InboundCreditNotFound, OutboxConsumerNotFound,
+		SubscriptionNotFound, SubscriptionDeliveryNotFound, NotificationNotFound,
+		ComplianceReportNotFound, FraudRuleNotFound, FraudDecisionNotFound,
+		SanctionsMatchNotFound, TransferReviewNotFound, QueueItemNotFound, JobNotFound,
+		OutboxDeadLetterNotFound:
 		return http.StatusNotFound
 
 	// 409 Conflict - Resource state conflict
-	case TransferPending, TransferFailed:
+	case TransferPending, TransferFailed, PayeeInvalidVerificationState,
+		PayeeHasPendingTransfers, InboundCreditInvalidState, TransferNotEscalated,
+		SubscriptionDeliveryInProgress, NotificationInvalidState, ComplianceReportNotPendingReview,
+		SanctionsMatchNotOpen, SanctionsListNotLoaded, TransferReviewDecided,
+		QueueItemInvalidState, JobAlreadyRunning, JobNotLeader, OutboxEventNotNext,
+		OutboxDeadLetterReplayed:
 		return http.StatusConflict
 
 	// 422 Unprocessable Entity - Semantic validation failures
@@ -151,7 +169,9 @@ func GetHTTPStatus(code ErrorCode) int {
 		TransactionInsufficientFunds, TransactionDuplicate,
 		TransactionValidationFailed, TransactionInvalidType,
 		AccountInvalidNumber, CustomerNoResults,
-		TransferInsufficientFunds:
+		TransferInsufficientFunds, PayeeNotVerified, PayeeVerificationMismatch,
+		PayeeVerificationLocked, InboundCreditNotReturnable, SubscriptionLimitReached,
+		FraudTransactionDeclined, SanctionsCustomerRestricted, SanctionsPayeeRestricted:
 		return http.StatusUnprocessableEntity
 
 	// 429 Too Many Requests - Rate limiting
@@ -162,6 +182,10 @@ func GetHTTPStatus(code ErrorCode) int {
 	case SystemServiceUnavailable:
 		return http.StatusServiceUnavailable
 
+	// 504 Gateway Timeout - The request deadline passed before the work completed
+	case SystemRequestTimeout:
+		return http.StatusGatewayTimeout
+
 	// 500 Internal Server Error - System errors (default)
 	case SystemInternalError, SystemDatabaseError, SystemConfigurationError,
 		SystemUnexpectedError:
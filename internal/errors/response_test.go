@@ -1,8 +1,10 @@
 package errors
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -159,6 +161,17 @@ func (s *ResponseTestSuite) TestWrapSystemError_NoInternalDetailsExposed() {
 	s.Empty(response.Error.Details)
 }
 
+// TestWrapSystemError_DeadlineExceeded tests that errors from a passed request deadline are reported as timeouts
+func (s *ResponseTestSuite) TestWrapSystemError_DeadlineExceeded() {
+	internalErr := fmt.Errorf("failed to get account: %w", context.DeadlineExceeded)
+
+	response, originalErr := WrapSystemError(internalErr, s.traceID)
+
+	s.Equal("SYSTEM_007", response.Error.Code)
+	s.Equal(http.StatusGatewayTimeout, response.GetHTTPStatus())
+	s.Equal(internalErr, originalErr)
+}
+
 // TestWrapDatabaseError_Success tests wrapping database errors
 func (s *ResponseTestSuite) TestWrapDatabaseError_Success() {
 	dbErr := errors.New("connection pool exhausted")
@@ -229,12 +242,15 @@ func (s *ResponseTestSuite) TestGetHTTPStatus_AllErrorCodes() {
 		{"Validation Invalid Email", ValidationInvalidEmail, http.StatusBadRequest},
 		{"Customer Invalid ID", CustomerInvalidID, http.StatusBadRequest},
 		{"Transaction Invalid Amount", TransactionInvalidAmount, http.StatusBadRequest},
+		{"Webhook Invalid Payload", WebhookInvalidPayload, http.StatusBadRequest},
 
 		// 401 Unauthorized
 		{"Auth Invalid Credentials", AuthInvalidCredentials, http.StatusUnauthorized},
 		{"Auth Missing Token", AuthMissingToken, http.StatusUnauthorized},
 		{"Auth Expired Token", AuthExpiredToken, http.StatusUnauthorized},
 		{"Auth Invalid Token Format", AuthInvalidTokenFormat, http.StatusUnauthorized},
+		{"Webhook Invalid Signature", WebhookInvalidSignature, http.StatusUnauthorized},
+		{"Webhook Stale Timestamp", WebhookStaleTimestamp, http.StatusUnauthorized},
 
 		// 403 Forbidden
 		{"Auth Insufficient Permission", AuthInsufficientPermission, http.StatusForbidden},
@@ -244,12 +260,51 @@ func (s *ResponseTestSuite) TestGetHTTPStatus_AllErrorCodes() {
 		{"Customer Not Found", CustomerNotFound, http.StatusNotFound},
 		{"Account Not Found", AccountNotFound, http.StatusNotFound},
 		{"Transaction Not Found", TransactionNotFound, http.StatusNotFound},
+		{"Payee Not Found", PayeeNotFound, http.StatusNotFound},
+		{"Inbound Credit Not Found", InboundCreditNotFound, http.StatusNotFound},
+		{"Outbox Consumer Not Found", OutboxConsumerNotFound, http.StatusNotFound},
+		{"Outbox Event Not Next", OutboxEventNotNext, http.StatusConflict},
+		{"Outbox Dead Letter Not Found", OutboxDeadLetterNotFound, http.StatusNotFound},
+		{"Outbox Dead Letter Replayed", OutboxDeadLetterReplayed, http.StatusConflict},
+		{"Subscription Not Found", SubscriptionNotFound, http.StatusNotFound},
+		{"Subscription Delivery Not Found", SubscriptionDeliveryNotFound, http.StatusNotFound},
+		{"Notification Not Found", NotificationNotFound, http.StatusNotFound},
+		{"Compliance Report Not Found", ComplianceReportNotFound, http.StatusNotFound},
+		{"Fraud Rule Not Found", FraudRuleNotFound, http.StatusNotFound},
+		{"Fraud Decision Not Found", FraudDecisionNotFound, http.StatusNotFound},
+		{"Sanctions Match Not Found", SanctionsMatchNotFound, http.StatusNotFound},
+		{"Transfer Review Not Found", TransferReviewNotFound, http.StatusNotFound},
+		{"Queue Item Not Found", QueueItemNotFound, http.StatusNotFound},
+		{"Job Not Found", JobNotFound, http.StatusNotFound},
+
+		// 409 Conflict
+		{"Payee Invalid Verification State", PayeeInvalidVerificationState, http.StatusConflict},
+		{"Payee Has Pending Transfers", PayeeHasPendingTransfers, http.StatusConflict},
+		{"Inbound Credit Invalid State", InboundCreditInvalidState, http.StatusConflict},
+		{"Transfer Not Escalated", TransferNotEscalated, http.StatusConflict},
+		{"Subscription Delivery In Progress", SubscriptionDeliveryInProgress, http.StatusConflict},
+		{"Notification Invalid State", NotificationInvalidState, http.StatusConflict},
+		{"Compliance Report Not Pending Review", ComplianceReportNotPendingReview, http.StatusConflict},
+		{"Sanctions Match Not Open", SanctionsMatchNotOpen, http.StatusConflict},
+		{"Sanctions List Not Loaded", SanctionsListNotLoaded, http.StatusConflict},
+		{"Transfer Review Decided", TransferReviewDecided, http.StatusConflict},
+		{"Queue Item Invalid State", QueueItemInvalidState, http.StatusConflict},
+		{"Job Already Running", JobAlreadyRunning, http.StatusConflict},
+		{"Job Not Leader", JobNotLeader, http.StatusConflict},
 
 		// 422 Unprocessable Entity
 		{"Customer Already Exists", CustomerAlreadyExists, http.StatusUnprocessableEntity},
 		{"Customer Inactive", CustomerInactive, http.StatusUnprocessableEntity},
 		{"Account Insufficient Balance", AccountInsufficientBalance, http.StatusUnprocessableEntity},
 		{"Transaction Duplicate", TransactionDuplicate, http.StatusUnprocessableEntity},
+		{"Payee Not Verified", PayeeNotVerified, http.StatusUnprocessableEntity},
+		{"Payee Verification Mismatch", PayeeVerificationMismatch, http.StatusUnprocessableEntity},
+		{"Payee Verification Locked", PayeeVerificationLocked, http.StatusUnprocessableEntity},
+		{"Inbound Credit Not Returnable", InboundCreditNotReturnable, http.StatusUnprocessableEntity},
+		{"Fraud Transaction Declined", FraudTransactionDeclined, http.StatusUnprocessableEntity},
+		{"Sanctions Customer Restricted", SanctionsCustomerRestricted, http.StatusUnprocessableEntity},
+		{"Sanctions Payee Restricted", SanctionsPayeeRestricted, http.StatusUnprocessableEntity},
+		{"Subscription Limit Reached", SubscriptionLimitReached, http.StatusUnprocessableEntity},
 
 		// 429 Too Many Requests
 		{"System Rate Limit Exceeded", SystemRateLimitExceeded, http.StatusTooManyRequests},
@@ -261,6 +316,9 @@ func (s *ResponseTestSuite) TestGetHTTPStatus_AllErrorCodes() {
 
 		// 503 Service Unavailable
 		{"System Service Unavailable", SystemServiceUnavailable, http.StatusServiceUnavailable},
+
+		// 504 Gateway Timeout
+		{"System Request Timeout", SystemRequestTimeout, http.StatusGatewayTimeout},
 	}
 
 	for _, tc := range testCases {
@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/array/banking-api/internal/dto"
@@ -70,7 +73,7 @@ func (h *AccountHandler) CreateAccount(c echo.Context) error {
 		}
 	}
 
-	account, err := h.accountService.CreateAccount(userID, req.AccountType, initialDeposit)
+	account, err := h.accountService.CreateAccount(c.Request().Context(), userID, req.AccountType, initialDeposit)
 	if err != nil {
 		if err == services.ErrAccountAlreadyExists {
 			return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
@@ -113,7 +116,7 @@ func (h *AccountHandler) GetAccount(c echo.Context) error {
 		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid account ID"))
 	}
 
-	account, err := h.accountService.GetAccountByID(accountID, &userID)
+	account, err := h.accountService.GetAccountByID(c.Request().Context(), accountID, &userID)
 	if err != nil {
 		if err == services.ErrAccountNotFound {
 			return SendError(c, errors.AccountNotFound)
@@ -143,7 +146,7 @@ func (h *AccountHandler) GetUserAccounts(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
-	accounts, err := h.accountService.GetUserAccounts(userID)
+	accounts, err := h.accountService.GetUserAccounts(c.Request().Context(), userID)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -188,7 +191,7 @@ func (h *AccountHandler) UpdateAccountStatus(c echo.Context) error {
 		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
 	}
 
-	account, err := h.accountService.UpdateAccountStatus(accountID, &userID, req.Status)
+	account, err := h.accountService.UpdateAccountStatus(c.Request().Context(), accountID, &userID, req.Status)
 	if err != nil {
 		if err == services.ErrAccountNotFound {
 			return SendError(c, errors.AccountNotFound)
@@ -229,7 +232,7 @@ func (h *AccountHandler) CloseAccount(c echo.Context) error {
 		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid account ID"))
 	}
 
-	err = h.accountService.CloseAccount(accountID, userID)
+	err = h.accountService.CloseAccount(c.Request().Context(), accountID, userID)
 	if err != nil {
 		if err == services.ErrAccountNotFound {
 			return SendError(c, errors.AccountNotFound)
@@ -250,19 +253,21 @@ func (h *AccountHandler) CloseAccount(c echo.Context) error {
 
 // PerformTransaction creates a new transaction on an account
 // @Summary Create a transaction
-// @Description Create a new transaction (credit or debit) on an account
+// @Description Create a new transaction (credit or debit) on an account. With Prefer: respond-async the transaction is queued instead and 202 is returned with a Location to poll; funds are then checked when it is processed. Servers without queue processing ignore the preference.
 // @Tags Accounts
 // @Security BearerAuth
 // @Accept json
 // @Produce json
 // @Param accountId path string true "Account ID (UUID)"
+// @Param Prefer header string false "respond-async to queue the transaction"
 // @Param request body dto.TransactionRequest true "Transaction details"
 // @Success 201 {object} models.Transaction "Transaction created successfully"
+// @Success 202 {object} dto.TransactionOperationResponse "Transaction queued; poll the Location header for the outcome"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or account ID"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
 // @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
-// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid transaction amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active"
+// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid transaction amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active, FRAUD_001 - Declined by fraud screening, SANCTIONS_001 - Customer under sanctions review"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /accounts/{accountId}/transactions [post]
 func (h *AccountHandler) PerformTransaction(c echo.Context) error {
@@ -291,7 +296,20 @@ func (h *AccountHandler) PerformTransaction(c echo.Context) error {
 		return SendError(c, errors.TransactionInvalidAmount, errors.WithDetails("Amount must be greater than 0"))
 	}
 
-	transaction, err := h.accountService.PerformTransaction(accountID, amount, req.Type, req.Description, &userID)
+	if prefersAsync(c) {
+		operation, err := h.accountService.SubmitTransaction(c.Request().Context(), accountID, amount, req.Type, req.Description, &userID)
+		if err == nil {
+			c.Response().Header().Set("Preference-Applied", "respond-async")
+			c.Response().Header().Set(echo.HeaderLocation, operationLocation(accountID, operation.Transaction.ID))
+			return c.JSON(http.StatusAccepted, toTransactionOperationResponse(operation))
+		}
+		if err != services.ErrAsyncProcessingDisabled {
+			return mapTransactionErr(c, err)
+		}
+		// The preference is advisory; process synchronously instead
+	}
+
+	transaction, err := h.accountService.PerformTransaction(c.Request().Context(), accountID, amount, req.Type, req.Description, &userID)
 	if err != nil {
 		return mapTransactionErr(c, err)
 	}
@@ -299,6 +317,54 @@ func (h *AccountHandler) PerformTransaction(c echo.Context) error {
 	return c.JSON(http.StatusCreated, transaction)
 }
 
+// GetTransactionOperation reports the progress of a transaction submitted asynchronously
+// @Summary Get a transaction operation
+// @Description Poll a transaction submitted with Prefer: respond-async. Once completed the response includes the transaction exactly as the synchronous request returns it; once failed it includes the error the synchronous request would have returned.
+// @Tags Accounts
+// @Security BearerAuth
+// @Produce json
+// @Param accountId path string true "Account ID (UUID)"
+// @Param operationId path string true "Operation ID (UUID), the ID of the queued transaction"
+// @Success 200 {object} dto.TransactionOperationResponse "Operation status"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid account or operation ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
+// @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found, TRANSACTION_001 - Operation not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /accounts/{accountId}/operations/{operationId} [get]
+func (h *AccountHandler) GetTransactionOperation(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		return SendError(c, errors.AccountInvalidNumber, errors.WithDetails("Account ID must be a valid UUID"))
+	}
+
+	operationID, err := uuid.Parse(c.Param("operationId"))
+	if err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Operation ID must be a valid UUID"))
+	}
+
+	operation, err := h.accountService.GetTransactionOperation(c.Request().Context(), accountID, operationID, &userID)
+	if err != nil {
+		if code, ok := commonErrCode(err); ok {
+			return SendError(c, code)
+		}
+		if err == services.ErrOperationNotFound {
+			return SendError(c, errors.TransactionNotFound)
+		}
+		return SendSystemError(c, err)
+	}
+
+	if !operation.IsFinished() {
+		c.Response().Header().Set("Retry-After", "1")
+	}
+	return c.JSON(http.StatusOK, toTransactionOperationResponse(operation))
+}
+
 // Transfer performs an atomic transfer between user's accounts with idempotency support
 // @Summary Transfer between accounts
 // @Description Perform an atomic transfer between user's accounts. Requires Idempotency-Key header. Both accounts must belong to the authenticated user.
@@ -310,12 +376,13 @@ func (h *AccountHandler) PerformTransaction(c echo.Context) error {
 // @Param Idempotency-Key header string true "Unique key to ensure idempotent transfers"
 // @Param request body dto.TransferRequest true "Transfer details"
 // @Success 200 {object} dto.TransferResponse "Transfer completed successfully"
+// @Success 202 {object} dto.TransferResponse "Transfer held for review; the amount is reserved on the source account"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body, VALIDATION_002 - Missing Idempotency-Key header"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
 // @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Account not found"
 // @Failure 409 {object} errors.ErrorResponse "Duplicate idempotency key with pending or failed transfer"
-// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active"
+// @Failure 422 {object} errors.ErrorResponse "TRANSACTION_002 - Invalid amount, TRANSACTION_003 - Insufficient funds, ACCOUNT_002 - Account not active, FRAUD_001 - Declined by fraud screening, SANCTIONS_001 - Customer under sanctions review"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
 // @Router /accounts/{accountId}/transfer [post]
 func (h *AccountHandler) Transfer(c echo.Context) error {
@@ -362,7 +429,7 @@ func (h *AccountHandler) Transfer(c echo.Context) error {
 		h.auditLogger.LogTransferInitiated(ctx, tempTransferID, fromAccountID, toAccountID, req.Amount, idempotencyKey, userID)
 	}
 
-	transfer, err := h.accountService.TransferBetweenAccounts(fromAccountID, toAccountID, amount, req.Description, idempotencyKey, userID)
+	transfer, err := h.accountService.TransferBetweenAccounts(ctx, fromAccountID, toAccountID, amount, req.Description, idempotencyKey, userID)
 	duration := time.Since(startTime)
 
 	if err != nil {
@@ -378,6 +445,21 @@ func (h *AccountHandler) Transfer(c echo.Context) error {
 		return h.mapTransferErr(c, ctx, transfer, idempotencyKey, err)
 	}
 
+	if transfer.IsHeldForReview() {
+		if h.metricsCollector != nil {
+			h.metricsCollector.IncrementCounter("transfers_total", map[string]string{"status": "held_for_review"})
+		}
+
+		return c.JSON(http.StatusAccepted, dto.TransferResponse{
+			Message:       "Transfer held for review",
+			TransferID:    transfer.ID.String(),
+			Status:        transfer.Status,
+			FromAccountID: transfer.FromAccountID.String(),
+			ToAccountID:   transfer.ToAccountID.String(),
+			Amount:        transfer.Amount.String(),
+		})
+	}
+
 	if h.auditLogger != nil {
 		h.auditLogger.LogTransferCompleted(ctx, transfer.ID, duration.Milliseconds(), transfer.DebitTransactionID, transfer.CreditTransactionID)
 	}
@@ -393,6 +475,7 @@ func (h *AccountHandler) Transfer(c echo.Context) error {
 	response := dto.TransferResponse{
 		Message:       "Transfer completed successfully",
 		TransferID:    transfer.ID.String(),
+		Status:        transfer.Status,
 		FromAccountID: transfer.FromAccountID.String(),
 		ToAccountID:   transfer.ToAccountID.String(),
 		Amount:        transfer.Amount.String(),
@@ -419,7 +502,7 @@ func (h *AccountHandler) Transfer(c echo.Context) error {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Results per page (max 100)" default(20)
-// @Param status query string false "Filter by status" Enums(completed, failed, pending)
+// @Param status query string false "Filter by status" Enums(completed, failed, pending, processing, held_for_review)
 // @Success 200 {object} dto.TransferHistoryResponse "Transfer history with pagination"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
@@ -449,7 +532,7 @@ func (h *AccountHandler) GetTransferHistory(c echo.Context) error {
 		Status: c.QueryParam("status"),
 	}
 
-	transfers, total, err := h.accountService.GetUserTransfers(userID, filters, offset, limit)
+	transfers, total, err := h.accountService.GetUserTransfers(c.Request().Context(), userID, filters, offset, limit)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -503,7 +586,7 @@ func (h *AccountHandler) GetAllAccounts(c echo.Context) error {
 	filters.AccountType = c.QueryParam("account_type")
 	filters.Status = c.QueryParam("status")
 
-	accounts, total, err := h.accountService.GetAllAccounts(filters, offset, limit)
+	accounts, total, err := h.accountService.GetAllAccounts(c.Request().Context(), filters, offset, limit)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -536,7 +619,7 @@ func (h *AccountHandler) GetAccountByIDAdmin(c echo.Context) error {
 		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid account ID"))
 	}
 
-	account, err := h.accountService.GetAccountByID(accountID, nil)
+	account, err := h.accountService.GetAccountByID(c.Request().Context(), accountID, nil)
 	if err != nil {
 		if err == services.ErrAccountNotFound {
 			return SendError(c, errors.AccountNotFound)
@@ -566,7 +649,7 @@ func (h *AccountHandler) GetUserAccountsAdmin(c echo.Context) error {
 		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid user ID"))
 	}
 
-	accounts, err := h.accountService.GetUserAccounts(userID)
+	accounts, err := h.accountService.GetUserAccounts(c.Request().Context(), userID)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -574,22 +657,26 @@ func (h *AccountHandler) GetUserAccountsAdmin(c echo.Context) error {
 	return c.JSON(http.StatusOK, accounts)
 }
 
-func mapCommonErr(c echo.Context, err error) error {
-	if err == services.ErrAccountNotFound {
-		return SendError(c, errors.AccountNotFound)
-	}
-	if err == services.ErrUnauthorized {
-		return SendError(c, errors.AuthInsufficientPermission)
-	}
-	if err == services.ErrAccountNotActive {
-		return SendError(c, errors.AccountInactive)
-	}
-	return nil
+// commonErrCode maps the service errors shared by transactions and transfers to their error code.
+func commonErrCode(err error) (errors.ErrorCode, bool) {
+	switch err {
+	case services.ErrAccountNotFound:
+		return errors.AccountNotFound, true
+	case services.ErrUnauthorized:
+		return errors.AuthInsufficientPermission, true
+	case services.ErrAccountNotActive:
+		return errors.AccountInactive, true
+	case services.ErrTransactionDeclined:
+		return errors.FraudTransactionDeclined, true
+	case services.ErrSanctionsHold:
+		return errors.SanctionsCustomerRestricted, true
+	}
+	return "", false
 }
 
 func mapTransactionErr(c echo.Context, err error) error {
-	if mappedErr := mapCommonErr(c, err); mappedErr != nil {
-		return mappedErr
+	if code, ok := commonErrCode(err); ok {
+		return SendError(c, code)
 	}
 	if err == services.ErrInsufficientFunds {
 		return SendError(c, errors.TransactionInsufficientFunds)
@@ -601,9 +688,60 @@ func mapTransactionErr(c echo.Context, err error) error {
 	return SendSystemError(c, err)
 }
 
+// prefersAsync reports whether the client asked for asynchronous processing (RFC 7240).
+func prefersAsync(c echo.Context) bool {
+	for _, header := range c.Request().Header.Values("Prefer") {
+		for _, preference := range strings.Split(header, ",") {
+			if strings.EqualFold(strings.TrimSpace(preference), "respond-async") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func operationLocation(accountID, operationID uuid.UUID) string {
+	return fmt.Sprintf("/api/v1/accounts/%s/operations/%s", accountID, operationID)
+}
+
+func toTransactionOperationResponse(operation *models.TransactionOperation) dto.TransactionOperationResponse {
+	transaction := operation.Transaction
+	response := dto.TransactionOperationResponse{
+		ID:          transaction.ID,
+		AccountID:   transaction.AccountID,
+		Status:      operation.Status(),
+		SubmittedAt: transaction.CreatedAt,
+	}
+
+	switch response.Status {
+	case models.TransactionOperationCompleted:
+		response.Transaction = transaction
+	case models.TransactionOperationFailed:
+		code := operationErrorCode(transaction.FailureReason())
+		response.Error = &dto.OperationError{Code: string(code), Message: errors.GetErrorMessage(code)}
+	}
+
+	return response
+}
+
+// operationErrorCode maps a queued transaction's failure reason to the code the synchronous
+// request returns for the same failure.
+func operationErrorCode(reason string) errors.ErrorCode {
+	switch reason {
+	case models.TransactionFailureInsufficientFunds:
+		return errors.TransactionInsufficientFunds
+	case models.TransactionFailureAccountNotActive:
+		return errors.AccountInactive
+	case models.TransactionFailureDuplicateReference:
+		return errors.TransactionDuplicate
+	default:
+		return errors.SystemInternalError
+	}
+}
+
 func (h *AccountHandler) mapTransferErr(c echo.Context, ctx context.Context, transfer *models.Transfer, idempotencyKey string, svcErr error) error {
-	if mappedErr := mapCommonErr(c, svcErr); mappedErr != nil {
-		return mappedErr
+	if code, ok := commonErrCode(svcErr); ok {
+		return SendError(c, code)
 	}
 	if svcErr == services.ErrInsufficientFunds {
 		return SendError(c, errors.TransferInsufficientFunds)
@@ -614,6 +752,16 @@ func (h *AccountHandler) mapTransferErr(c echo.Context, ctx context.Context, tra
 	if svcErr == services.ErrSameAccountTransfer {
 		return SendError(c, errors.TransferSameAccount)
 	}
+	if svcErr == services.ErrExternalAccountNotVerified {
+		return SendError(c, errors.PayeeNotVerified, errors.WithDetails(fmt.Sprintf("Transfers to unverified payees are limited to %s in total every %d days",
+			models.UnverifiedExternalTransferLimit.StringFixed(2), int(models.UnverifiedExternalTransferWindow.Hours()/24))))
+	}
+	if svcErr == services.ErrExternalAccountLocked {
+		return SendError(c, errors.PayeeVerificationLocked)
+	}
+	if svcErr == services.ErrPayeeSanctionsHold {
+		return SendError(c, errors.SanctionsPayeeRestricted)
+	}
 	if svcErr == services.ErrTransferPending {
 		if h.auditLogger != nil && transfer != nil {
 			h.auditLogger.LogTransferIdempotencyCheck(ctx, idempotencyKey, transfer.ID, "pending")
@@ -640,13 +788,13 @@ func (h *AccountHandler) mapTransferErr(c echo.Context, ctx context.Context, tra
 // @Param accountId path string true "Source Account ID (UUID)"
 // @Param Idempotency-Key header string true "Unique key to ensure idempotent transfers"
 // @Param request body dto.InitiateExternalTransferRequest true "External transfer details"
-// @Success 202 {object} models.Transfer "Transfer initiated successfully and is now processing"
+// @Success 202 {object} models.Transfer "Transfer initiated successfully and is now processing, or held for review"
 // @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or parameters"
 // @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
 // @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Account belongs to another user"
 // @Failure 404 {object} errors.ErrorResponse "ACCOUNT_001 - Source or destination account not found"
 // @Failure 409 {object} errors.ErrorResponse "Duplicate idempotency key with pending or failed transfer"
-// @Failure 422 {object} errors.ErrorResponse "TRANSFER_004 - Insufficient funds"
+// @Failure 422 {object} errors.ErrorResponse "TRANSFER_005 - Insufficient funds, PAYEE_002 - Payee not verified, PAYEE_004 - Payee locked, FRAUD_001 - Declined by fraud screening, SANCTIONS_001 - Customer under sanctions review, SANCTIONS_002 - Payee under sanctions review"
 // @Failure 503 {object} errors.ErrorResponse "SYSTEM_003 - External banking partner unavailable"
 // @Router /accounts/{accountId}/external-transfer [post]
 func (h *AccountHandler) InitiateExternalTransfer(c echo.Context) error {
@@ -686,7 +834,7 @@ func (h *AccountHandler) InitiateExternalTransfer(c echo.Context) error {
 
 	transfer, err := h.accountService.InitiateExternalTransfer(c.Request().Context(), userID, fromAccountID, toExternalAccountID, amount, req.Description, req.TransferType, idempotencyKey)
 	if err != nil {
-		if errors.Is(err, services.ErrExternalTransferFailed) {
+		if stderrors.Is(err, services.ErrExternalTransferFailed) {
 			return SendError(c, errors.SystemServiceUnavailable, errors.WithDetails("External banking partner is unavailable."))
 		}
 		return h.mapTransferErr(c, c.Request().Context(), transfer, idempotencyKey, err)
@@ -697,7 +845,7 @@ func (h *AccountHandler) InitiateExternalTransfer(c echo.Context) error {
 
 // RegisterExternalAccount registers a new external account (payee) for transfers.
 // @Summary Register an external account
-// @Description Add a new external bank account as a payee for future transfers.
+// @Description Add a new external bank account as a payee for future transfers. Two verification micro-deposits are sent; confirm them via the verify endpoint to lift the unverified transfer limit.
 // @Tags Accounts
 // @Security BearerAuth
 // @Accept json
@@ -726,19 +874,345 @@ func (h *AccountHandler) RegisterExternalAccount(c echo.Context) error {
 
 	account, err := h.externalAccountService.Register(c.Request().Context(), userID, &req)
 	if err != nil {
-		if errors.Is(err, services.ErrRegistrationFailed) {
+		if stderrors.Is(err, services.ErrRegistrationFailed) {
 			return SendError(c, errors.SystemServiceUnavailable, errors.WithDetails("Could not connect to the external bank."))
 		}
 		return SendSystemError(c, err)
 	}
 
-	response := &dto.ExternalAccountResponse{
-		ID:                account.ID,
-		Nickname:          account.Nickname,
-		AccountNumberMask: account.AccountNumberMask,
-		NameOnAccount:     account.NameOnAccount,
-		BankName:          account.BankName,
+	return c.JSON(http.StatusCreated, toExternalAccountResponse(account))
+}
+
+// SendMicroDeposits re-sends the verification micro-deposits to an external account.
+// @Summary Send verification micro-deposits
+// @Description Send two small deposits to an unverified payee. Used when the deposits sent at registration failed.
+// @Tags Accounts
+// @Security BearerAuth
+// @Produce json
+// @Param externalAccountId path string true "External Account ID (UUID)"
+// @Success 202 {object} dto.ExternalAccountResponse "Micro-deposits sent"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid external account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "PAYEE_001 - External account not found"
+// @Failure 409 {object} errors.ErrorResponse "PAYEE_005 - Micro-deposits already sent or account verified"
+// @Failure 422 {object} errors.ErrorResponse "PAYEE_004 - Verification locked, SANCTIONS_002 - Payee under sanctions review"
+// @Failure 503 {object} errors.ErrorResponse "SYSTEM_003 - External banking partner unavailable"
+// @Router /accounts/external/{externalAccountId}/micro-deposits [post]
+func (h *AccountHandler) SendMicroDeposits(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	externalAccountID, err := uuid.Parse(c.Param("externalAccountId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid external account ID"))
+	}
+
+	account, err := h.externalAccountService.SendMicroDeposits(c.Request().Context(), userID, externalAccountID)
+	if err != nil {
+		if stderrors.Is(err, services.ErrMicroDepositsFailed) {
+			return SendError(c, errors.SystemServiceUnavailable, errors.WithDetails("Could not send micro-deposits to the external bank."))
+		}
+		return mapExternalAccountErr(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, toExternalAccountResponse(account))
+}
+
+// VerifyExternalAccount confirms ownership of an external account using the micro-deposit amounts.
+// @Summary Verify an external account
+// @Description Confirm the two micro-deposit amounts received by the payee. Verification locks after 3 incorrect attempts.
+// @Tags Accounts
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param externalAccountId path string true "External Account ID (UUID)"
+// @Param request body dto.VerifyExternalAccountRequest true "Micro-deposit amounts"
+// @Success 200 {object} dto.ExternalAccountResponse "External account verified"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body or amounts"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "PAYEE_001 - External account not found"
+// @Failure 409 {object} errors.ErrorResponse "PAYEE_005 - Micro-deposits have not been sent"
+// @Failure 422 {object} errors.ErrorResponse "PAYEE_003 - Amounts do not match, PAYEE_004 - Verification locked"
+// @Router /accounts/external/{externalAccountId}/verify [post]
+func (h *AccountHandler) VerifyExternalAccount(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	externalAccountID, err := uuid.Parse(c.Param("externalAccountId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid external account ID"))
+	}
+
+	var req dto.VerifyExternalAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	amount1, err := decimal.NewFromString(req.Amount1)
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid amount_1"))
+	}
+	amount2, err := decimal.NewFromString(req.Amount2)
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid amount_2"))
+	}
+
+	account, err := h.externalAccountService.VerifyMicroDeposits(c.Request().Context(), userID, externalAccountID, amount1, amount2)
+	if err != nil {
+		return mapExternalAccountErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toExternalAccountResponse(account))
+}
+
+func mapExternalAccountErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrExternalAccountNotFound):
+		return SendError(c, errors.PayeeNotFound)
+	case stderrors.Is(err, services.ErrMicroDepositMismatch):
+		return SendError(c, errors.PayeeVerificationMismatch, errors.WithDetails(err.Error()))
+	case stderrors.Is(err, services.ErrExternalAccountLocked):
+		return SendError(c, errors.PayeeVerificationLocked)
+	case stderrors.Is(err, services.ErrPayeeSanctionsHold):
+		return SendError(c, errors.SanctionsPayeeRestricted)
+	case stderrors.Is(err, services.ErrInvalidVerificationState):
+		return SendError(c, errors.PayeeInvalidVerificationState)
+	case stderrors.Is(err, services.ErrExternalAccountHasPendingTransfers):
+		return SendError(c, errors.PayeeHasPendingTransfers, errors.WithDetails(err.Error()))
+	}
+	return SendSystemError(c, err)
+}
+
+func toExternalAccountResponse(account *models.ExternalAccount) *dto.ExternalAccountResponse {
+	return &dto.ExternalAccountResponse{
+		ID:                            account.ID,
+		Nickname:                      account.Nickname,
+		AccountNumberMask:             account.AccountNumberMask,
+		NameOnAccount:                 account.NameOnAccount,
+		BankName:                      account.BankName,
+		VerificationStatus:            account.VerificationStatus,
+		VerificationAttemptsRemaining: account.RemainingVerificationAttempts(),
+		CreatedAt:                     account.CreatedAt,
+	}
+}
+
+// ListExternalAccounts lists the authenticated user's payees.
+// @Summary List my payees
+// @Description Retrieve all external accounts (payees) registered by the authenticated user
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.ExternalAccountListResponse "List of payees"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/external-accounts [get]
+func (h *AccountHandler) ListExternalAccounts(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	accounts, err := h.externalAccountService.List(c.Request().Context(), userID)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.ExternalAccountListResponse{
+		ExternalAccounts: make([]dto.ExternalAccountResponse, 0, len(accounts)),
+		Total:            len(accounts),
+	}
+	for i := range accounts {
+		response.ExternalAccounts = append(response.ExternalAccounts, *toExternalAccountResponse(&accounts[i]))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetExternalAccount retrieves a payee with its transfer usage statistics.
+// @Summary Get a payee
+// @Description Retrieve a single payee owned by the authenticated user, including transfer statistics
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param externalAccountId path string true "External Account ID (UUID)"
+// @Success 200 {object} dto.ExternalAccountDetailResponse "Payee details"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid external account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "PAYEE_001 - External account not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/external-accounts/{externalAccountId} [get]
+func (h *AccountHandler) GetExternalAccount(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	externalAccountID, err := uuid.Parse(c.Param("externalAccountId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid external account ID"))
+	}
+
+	account, err := h.externalAccountService.Get(c.Request().Context(), userID, externalAccountID)
+	if err != nil {
+		return mapExternalAccountErr(c, err)
+	}
+
+	stats, err := h.externalAccountService.GetTransferStats(c.Request().Context(), userID, externalAccountID)
+	if err != nil {
+		return mapExternalAccountErr(c, err)
+	}
+
+	response := dto.ExternalAccountDetailResponse{
+		ExternalAccountResponse: *toExternalAccountResponse(account),
+		Stats: dto.ExternalAccountStatsResponse{
+			TotalTransfers:     stats.TotalTransfers,
+			CompletedTransfers: stats.CompletedTransfers,
+			PendingTransfers:   stats.PendingTransfers,
+			FailedTransfers:    stats.FailedTransfers,
+			TotalAmountSent:    stats.TotalAmountSent.StringFixed(2),
+			LastTransferAt:     stats.LastTransferAt,
+		},
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateExternalAccount renames a payee.
+// @Summary Rename a payee
+// @Description Update the nickname of a payee owned by the authenticated user
+// @Tags Customers
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param externalAccountId path string true "External Account ID (UUID)"
+// @Param request body dto.UpdateExternalAccountRequest true "New nickname"
+// @Success 200 {object} dto.ExternalAccountResponse "Payee updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "PAYEE_001 - External account not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/external-accounts/{externalAccountId} [patch]
+func (h *AccountHandler) UpdateExternalAccount(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
 	}
 
-	return c.JSON(http.StatusCreated, response)
+	externalAccountID, err := uuid.Parse(c.Param("externalAccountId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid external account ID"))
+	}
+
+	var req dto.UpdateExternalAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	account, err := h.externalAccountService.UpdateNickname(c.Request().Context(), userID, externalAccountID, req.Nickname)
+	if err != nil {
+		return mapExternalAccountErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toExternalAccountResponse(account))
+}
+
+// DeleteExternalAccount removes a payee.
+// @Summary Remove a payee
+// @Description Remove a payee owned by the authenticated user. Payees with pending transfers cannot be removed.
+// @Tags Customers
+// @Security BearerAuth
+// @Param externalAccountId path string true "External Account ID (UUID)"
+// @Success 204 "Payee removed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid external account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "PAYEE_001 - External account not found"
+// @Failure 409 {object} errors.ErrorResponse "PAYEE_006 - Payee has pending transfers"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/external-accounts/{externalAccountId} [delete]
+func (h *AccountHandler) DeleteExternalAccount(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	externalAccountID, err := uuid.Parse(c.Param("externalAccountId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid external account ID"))
+	}
+
+	if err := h.externalAccountService.Delete(c.Request().Context(), userID, externalAccountID); err != nil {
+		return mapExternalAccountErr(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetExternalAccountTransfers retrieves transfers sent to a payee.
+// @Summary Get payee transfer history
+// @Description Retrieve paginated transfers sent to a payee owned by the authenticated user
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param externalAccountId path string true "External Account ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Results per page (max 100)" default(20)
+// @Success 200 {object} dto.TransferHistoryResponse "Transfer history with pagination"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid external account ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "PAYEE_001 - External account not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/external-accounts/{externalAccountId}/transfers [get]
+func (h *AccountHandler) GetExternalAccountTransfers(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	externalAccountID, err := uuid.Parse(c.Param("externalAccountId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid external account ID"))
+	}
+
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page <= 0 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	offset := (page - 1) * limit
+
+	transfers, total, err := h.externalAccountService.GetTransferHistory(c.Request().Context(), userID, externalAccountID, offset, limit)
+	if err != nil {
+		return mapExternalAccountErr(c, err)
+	}
+
+	response := dto.TransferHistoryResponse{
+		Transfers: transfers,
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+
+	return c.JSON(http.StatusOK, response)
 }
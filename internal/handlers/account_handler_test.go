@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
@@ -99,8 +102,8 @@ func (s *AccountHandlerSuite) TestCreateAccount_WithInitialDeposit() {
 	}
 
 	s.mockAccountService.EXPECT().
-		CreateAccount(s.testUserID, "checking", gomock.Any()).
-		DoAndReturn(func(_ uuid.UUID, _ string, amount decimal.Decimal) (*models.Account, error) {
+		CreateAccount(gomock.Any(), s.testUserID, "checking", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, amount decimal.Decimal) (*models.Account, error) {
 			if !amount.Equal(decimal.NewFromFloat(100.00)) {
 				s.T().Errorf("expected amount 100.00, got %s", amount.String())
 			}
@@ -142,7 +145,7 @@ func (s *AccountHandlerSuite) TestCreateAccount_AccountAlreadyExists() {
 	}
 
 	s.mockAccountService.EXPECT().
-		CreateAccount(s.testUserID, "checking", decimal.Zero).
+		CreateAccount(gomock.Any(), s.testUserID, "checking", decimal.Zero).
 		Return(nil, services.ErrAccountAlreadyExists)
 
 	c, rec := s.createContextWithAuth("POST", "/accounts", reqBody, s.testUserID, "user")
@@ -165,7 +168,7 @@ func (s *AccountHandlerSuite) TestGetAccount_Success() {
 	}
 
 	s.mockAccountService.EXPECT().
-		GetAccountByID(accountID, &s.testUserID).
+		GetAccountByID(gomock.Any(), accountID, &s.testUserID).
 		Return(expectedAccount, nil)
 
 	c, rec := s.createContextWithAuth("GET", "/accounts/"+accountID.String(), nil, s.testUserID, "user")
@@ -186,7 +189,7 @@ func (s *AccountHandlerSuite) TestGetAccount_NotFound() {
 	accountID := uuid.New()
 
 	s.mockAccountService.EXPECT().
-		GetAccountByID(accountID, &s.testUserID).
+		GetAccountByID(gomock.Any(), accountID, &s.testUserID).
 		Return(nil, services.ErrAccountNotFound)
 
 	c, rec := s.createContextWithAuth("GET", "/accounts/"+accountID.String(), nil, s.testUserID, "user")
@@ -202,7 +205,7 @@ func (s *AccountHandlerSuite) TestGetAccount_Unauthorized() {
 	accountID := uuid.New()
 
 	s.mockAccountService.EXPECT().
-		GetAccountByID(accountID, &s.testUserID).
+		GetAccountByID(gomock.Any(), accountID, &s.testUserID).
 		Return(nil, services.ErrUnauthorized)
 
 	c, rec := s.createContextWithAuth("GET", "/accounts/"+accountID.String(), nil, s.testUserID, "user")
@@ -236,7 +239,7 @@ func (s *AccountHandlerSuite) TestGetUserAccounts_Success() {
 	}
 
 	s.mockAccountService.EXPECT().
-		GetUserAccounts(s.testUserID).
+		GetUserAccounts(gomock.Any(), s.testUserID).
 		Return(expectedAccounts, nil)
 
 	c, rec := s.createContextWithAuth("GET", "/accounts", nil, s.testUserID, "user")
@@ -272,8 +275,8 @@ func (s *AccountHandlerSuite) TestPerformTransaction_Credit() {
 	}
 
 	s.mockAccountService.EXPECT().
-		PerformTransaction(accountID, gomock.Any(), "credit", "Deposit", &s.testUserID).
-		DoAndReturn(func(_ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ *uuid.UUID) (*models.Transaction, error) {
+		PerformTransaction(gomock.Any(), accountID, gomock.Any(), "credit", "Deposit", &s.testUserID).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ *uuid.UUID) (*models.Transaction, error) {
 			if !amount.Equal(decimal.NewFromFloat(50.00)) {
 				s.T().Errorf("expected amount 50.00, got %s", amount.String())
 			}
@@ -304,8 +307,8 @@ func (s *AccountHandlerSuite) TestPerformTransaction_InsufficientFunds() {
 	}
 
 	s.mockAccountService.EXPECT().
-		PerformTransaction(accountID, gomock.Any(), "debit", "Withdrawal", &s.testUserID).
-		DoAndReturn(func(_ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ *uuid.UUID) (*models.Transaction, error) {
+		PerformTransaction(gomock.Any(), accountID, gomock.Any(), "debit", "Withdrawal", &s.testUserID).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ *uuid.UUID) (*models.Transaction, error) {
 			if !amount.Equal(decimal.NewFromFloat(1000.00)) {
 				s.T().Errorf("expected amount 1000.00, got %s", amount.String())
 			}
@@ -330,6 +333,236 @@ func (s *AccountHandlerSuite) TestPerformTransaction_InsufficientFunds() {
 	s.Contains(errorResp.Error.Message, "Insufficient account balance")
 }
 
+func (s *AccountHandlerSuite) TestPerformTransaction_DeclinedByFraudScreening() {
+	accountID := uuid.New()
+
+	reqBody := dto.TransactionRequest{
+		Amount:      "5000.00",
+		Type:        "debit",
+		Description: "Withdrawal",
+	}
+
+	s.mockAccountService.EXPECT().
+		PerformTransaction(gomock.Any(), accountID, gomock.Any(), "debit", "Withdrawal", &s.testUserID).
+		Return(nil, services.ErrTransactionDeclined)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+accountID.String()+"/transactions", reqBody, s.testUserID, "user")
+	c.SetParamNames("accountId")
+	c.SetParamValues(accountID.String())
+
+	err := s.handler.PerformTransaction(c)
+	s.NoError(err)
+
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var errorResp ErrorResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &errorResp)
+	s.NoError(err)
+	s.Equal("FRAUD_001", errorResp.Error.Code)
+	s.NotContains(rec.Body.String(), "velocity")
+}
+
+func (s *AccountHandlerSuite) TestPerformTransaction_RefusedUnderSanctionsHold() {
+	accountID := uuid.New()
+
+	reqBody := dto.TransactionRequest{
+		Amount:      "100.00",
+		Type:        "debit",
+		Description: "Withdrawal",
+	}
+
+	s.mockAccountService.EXPECT().
+		PerformTransaction(gomock.Any(), accountID, gomock.Any(), "debit", "Withdrawal", &s.testUserID).
+		Return(nil, services.ErrSanctionsHold)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+accountID.String()+"/transactions", reqBody, s.testUserID, "user")
+	c.SetParamNames("accountId")
+	c.SetParamValues(accountID.String())
+
+	err := s.handler.PerformTransaction(c)
+	s.NoError(err)
+
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var errorResp ErrorResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &errorResp)
+	s.NoError(err)
+	s.Equal("SANCTIONS_001", errorResp.Error.Code)
+	s.NotContains(rec.Body.String(), "sanctions")
+}
+
+func (s *AccountHandlerSuite) asyncTransactionContext(accountID uuid.UUID, reqBody dto.TransactionRequest) (echo.Context, *httptest.ResponseRecorder) {
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+accountID.String()+"/transactions", reqBody, s.testUserID, "user")
+	c.Request().Header.Set("Prefer", "wait=5, respond-async")
+	c.SetParamNames("accountId")
+	c.SetParamValues(accountID.String())
+	return c, rec
+}
+
+func (s *AccountHandlerSuite) TestPerformTransaction_PreferAsync_Accepted() {
+	accountID := uuid.New()
+	transaction := &models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       accountID,
+		Amount:          decimal.NewFromFloat(75.00),
+		TransactionType: "debit",
+		Description:     "Withdrawal",
+		Status:          models.TransactionStatusPending,
+	}
+
+	s.mockAccountService.EXPECT().
+		SubmitTransaction(gomock.Any(), accountID, gomock.Any(), "debit", "Withdrawal", &s.testUserID).
+		Return(&models.TransactionOperation{Transaction: transaction}, nil)
+
+	c, rec := s.asyncTransactionContext(accountID, dto.TransactionRequest{Amount: "75.00", Type: "debit", Description: "Withdrawal"})
+
+	err := s.handler.PerformTransaction(c)
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, rec.Code)
+	s.Equal("respond-async", rec.Header().Get("Preference-Applied"))
+	s.Equal(fmt.Sprintf("/api/v1/accounts/%s/operations/%s", accountID, transaction.ID), rec.Header().Get("Location"))
+
+	var operation dto.TransactionOperationResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &operation))
+	s.Equal(transaction.ID, operation.ID)
+	s.Equal(models.TransactionOperationQueued, operation.Status)
+	s.Nil(operation.Transaction)
+}
+
+func (s *AccountHandlerSuite) TestPerformTransaction_PreferAsync_RejectedLikeSynchronous() {
+	accountID := uuid.New()
+
+	s.mockAccountService.EXPECT().
+		SubmitTransaction(gomock.Any(), accountID, gomock.Any(), "debit", "Withdrawal", &s.testUserID).
+		Return(nil, services.ErrAccountNotActive)
+
+	c, rec := s.asyncTransactionContext(accountID, dto.TransactionRequest{Amount: "75.00", Type: "debit", Description: "Withdrawal"})
+
+	err := s.handler.PerformTransaction(c)
+	s.NoError(err)
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var errorResp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &errorResp))
+	s.Equal("ACCOUNT_002", errorResp.Error.Code)
+}
+
+func (s *AccountHandlerSuite) TestPerformTransaction_PreferAsync_ProcessedSynchronouslyWithoutQueue() {
+	accountID := uuid.New()
+
+	s.mockAccountService.EXPECT().
+		SubmitTransaction(gomock.Any(), accountID, gomock.Any(), "credit", "Deposit", &s.testUserID).
+		Return(nil, services.ErrAsyncProcessingDisabled)
+	s.mockAccountService.EXPECT().
+		PerformTransaction(gomock.Any(), accountID, gomock.Any(), "credit", "Deposit", &s.testUserID).
+		Return(&models.Transaction{ID: uuid.New(), AccountID: accountID, Status: models.TransactionStatusCompleted}, nil)
+
+	c, rec := s.asyncTransactionContext(accountID, dto.TransactionRequest{Amount: "20.00", Type: "credit", Description: "Deposit"})
+
+	err := s.handler.PerformTransaction(c)
+	s.NoError(err)
+	s.Equal(http.StatusCreated, rec.Code)
+	s.Empty(rec.Header().Get("Preference-Applied"))
+}
+
+func (s *AccountHandlerSuite) operationContext(accountID, operationID uuid.UUID) (echo.Context, *httptest.ResponseRecorder) {
+	c, rec := s.createContextWithAuth("GET", "/accounts/"+accountID.String()+"/operations/"+operationID.String(), nil, s.testUserID, "user")
+	c.SetParamNames("accountId", "operationId")
+	c.SetParamValues(accountID.String(), operationID.String())
+	return c, rec
+}
+
+func (s *AccountHandlerSuite) TestGetTransactionOperation_Completed() {
+	accountID := uuid.New()
+	transaction := &models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       accountID,
+		Amount:          decimal.NewFromFloat(75.00),
+		BalanceBefore:   decimal.NewFromFloat(100.00),
+		BalanceAfter:    decimal.NewFromFloat(25.00),
+		TransactionType: "debit",
+		Description:     "Withdrawal",
+		Status:          models.TransactionStatusCompleted,
+	}
+
+	s.mockAccountService.EXPECT().
+		GetTransactionOperation(gomock.Any(), accountID, transaction.ID, &s.testUserID).
+		Return(&models.TransactionOperation{Transaction: transaction}, nil)
+
+	c, rec := s.operationContext(accountID, transaction.ID)
+
+	err := s.handler.GetTransactionOperation(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Empty(rec.Header().Get("Retry-After"))
+
+	var operation dto.TransactionOperationResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &operation))
+	s.Equal(models.TransactionOperationCompleted, operation.Status)
+	s.Require().NotNil(operation.Transaction)
+	s.True(transaction.BalanceAfter.Equal(operation.Transaction.BalanceAfter))
+	s.Nil(operation.Error)
+}
+
+func (s *AccountHandlerSuite) TestGetTransactionOperation_FailedReportsSynchronousErrorCode() {
+	accountID := uuid.New()
+	transaction := &models.Transaction{ID: uuid.New(), AccountID: accountID, Status: models.TransactionStatusPending}
+	transaction.FailWithReason(models.TransactionFailureInsufficientFunds)
+
+	s.mockAccountService.EXPECT().
+		GetTransactionOperation(gomock.Any(), accountID, transaction.ID, &s.testUserID).
+		Return(&models.TransactionOperation{Transaction: transaction}, nil)
+
+	c, rec := s.operationContext(accountID, transaction.ID)
+
+	err := s.handler.GetTransactionOperation(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var operation dto.TransactionOperationResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &operation))
+	s.Equal(models.TransactionOperationFailed, operation.Status)
+	s.Nil(operation.Transaction)
+	s.Require().NotNil(operation.Error)
+	s.Equal("TRANSACTION_003", operation.Error.Code)
+}
+
+func (s *AccountHandlerSuite) TestGetTransactionOperation_InProgressAsksClientToRetry() {
+	accountID := uuid.New()
+	transaction := &models.Transaction{ID: uuid.New(), AccountID: accountID, Status: models.TransactionStatusPending}
+
+	s.mockAccountService.EXPECT().
+		GetTransactionOperation(gomock.Any(), accountID, transaction.ID, &s.testUserID).
+		Return(&models.TransactionOperation{Transaction: transaction, QueueItem: &models.ProcessingQueueItem{Status: models.QueueStatusProcessing}}, nil)
+
+	c, rec := s.operationContext(accountID, transaction.ID)
+
+	err := s.handler.GetTransactionOperation(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("1", rec.Header().Get("Retry-After"))
+	s.Contains(rec.Body.String(), `"status":"processing"`)
+}
+
+func (s *AccountHandlerSuite) TestGetTransactionOperation_NotFound() {
+	accountID := uuid.New()
+	operationID := uuid.New()
+
+	s.mockAccountService.EXPECT().
+		GetTransactionOperation(gomock.Any(), accountID, operationID, &s.testUserID).
+		Return(nil, services.ErrOperationNotFound)
+
+	c, rec := s.operationContext(accountID, operationID)
+
+	err := s.handler.GetTransactionOperation(c)
+	s.NoError(err)
+	s.Equal(http.StatusNotFound, rec.Code)
+
+	var errorResp ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &errorResp))
+	s.Equal("TRANSACTION_001", errorResp.Error.Code)
+}
+
 // Test Transfer functionality
 func (s *AccountHandlerSuite) TestTransfer_Success() {
 	fromAccountID := uuid.New()
@@ -349,8 +582,8 @@ func (s *AccountHandlerSuite) TestTransfer_Success() {
 
 	// Expect service call
 	s.mockAccountService.EXPECT().
-		TransferBetweenAccounts(fromAccountID, toAccountID, gomock.Any(), "Transfer to savings", idempotencyKey, s.testUserID).
-		DoAndReturn(func(_ uuid.UUID, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ uuid.UUID) (*models.Transfer, error) {
+		TransferBetweenAccounts(gomock.Any(), fromAccountID, toAccountID, gomock.Any(), "Transfer to savings", idempotencyKey, s.testUserID).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ uuid.UUID) (*models.Transfer, error) {
 			if !amount.Equal(decimal.NewFromFloat(100.00)) {
 				s.T().Errorf("expected amount 100.00, got %s", amount.String())
 			}
@@ -359,7 +592,7 @@ func (s *AccountHandlerSuite) TestTransfer_Success() {
 			return &models.Transfer{
 				ID:                  uuid.New(),
 				FromAccountID:       fromAccountID,
-				ToAccountID:         toAccountID,
+				ToAccountID:         &toAccountID,
 				Amount:              amount,
 				Description:         "Transfer to savings",
 				Status:              models.TransferStatusCompleted,
@@ -394,6 +627,45 @@ func (s *AccountHandlerSuite) TestTransfer_Success() {
 	s.Equal(http.StatusOK, rec.Code)
 }
 
+func (s *AccountHandlerSuite) TestTransfer_HeldForReview() {
+	fromAccountID := uuid.New()
+	toAccountID := uuid.New()
+	idempotencyKey := uuid.New().String()
+
+	reqBody := dto.TransferRequest{
+		ToAccountID: toAccountID.String(),
+		Amount:      "30000.00",
+		Description: "House deposit",
+	}
+
+	s.auditLogger.EXPECT().
+		LogTransferInitiated(gomock.Any(), gomock.Any(), fromAccountID, toAccountID, "30000.00", idempotencyKey, s.testUserID).
+		Times(1)
+	s.mockAccountService.EXPECT().
+		TransferBetweenAccounts(gomock.Any(), fromAccountID, toAccountID, gomock.Any(), "House deposit", idempotencyKey, s.testUserID).
+		Return(&models.Transfer{
+			ID:            uuid.New(),
+			FromAccountID: fromAccountID,
+			ToAccountID:   &toAccountID,
+			Amount:        decimal.NewFromInt(30000),
+			Status:        models.TransferStatusHeldForReview,
+		}, nil)
+	s.auditLogger.EXPECT().LogTransferCompleted(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.metricsCollector.EXPECT().
+		IncrementCounter("transfers_total", map[string]string{"status": "held_for_review"}).
+		Times(1)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+fromAccountID.String()+"/transfer", reqBody, s.testUserID, "user")
+	c.SetParamNames("accountId")
+	c.SetParamValues(fromAccountID.String())
+	c.Request().Header.Set("Idempotency-Key", idempotencyKey)
+
+	err := s.handler.Transfer(c)
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, rec.Code)
+	s.Contains(rec.Body.String(), `"status":"held_for_review"`)
+}
+
 func (s *AccountHandlerSuite) TestTransfer_SameAccount() {
 	fromAccountID := uuid.New()
 	idempotencyKey := uuid.New().String()
@@ -411,8 +683,8 @@ func (s *AccountHandlerSuite) TestTransfer_SameAccount() {
 
 	// Expect service call that returns error
 	s.mockAccountService.EXPECT().
-		TransferBetweenAccounts(fromAccountID, fromAccountID, gomock.Any(), "Transfer", idempotencyKey, s.testUserID).
-		DoAndReturn(func(_ uuid.UUID, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ uuid.UUID) (*models.Transfer, error) {
+		TransferBetweenAccounts(gomock.Any(), fromAccountID, fromAccountID, gomock.Any(), "Transfer", idempotencyKey, s.testUserID).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ uuid.UUID) (*models.Transfer, error) {
 			if !amount.Equal(decimal.NewFromFloat(100.00)) {
 				s.T().Errorf("expected amount 100.00, got %s", amount.String())
 			}
@@ -465,7 +737,7 @@ func (s *AccountHandlerSuite) TestTransfer_WithIdempotencyKey_Success() {
 	expectedTransfer := &models.Transfer{
 		ID:                  transferID,
 		FromAccountID:       fromAccountID,
-		ToAccountID:         toAccountID,
+		ToAccountID:         &toAccountID,
 		Amount:              decimal.NewFromFloat(150.00),
 		Description:         "Payment with idempotency",
 		IdempotencyKey:      idempotencyKey,
@@ -481,8 +753,8 @@ func (s *AccountHandlerSuite) TestTransfer_WithIdempotencyKey_Success() {
 
 	// Expect service call
 	s.mockAccountService.EXPECT().
-		TransferBetweenAccounts(fromAccountID, toAccountID, gomock.Any(), "Payment with idempotency", idempotencyKey, s.testUserID).
-		DoAndReturn(func(_ uuid.UUID, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ uuid.UUID) (*models.Transfer, error) {
+		TransferBetweenAccounts(gomock.Any(), fromAccountID, toAccountID, gomock.Any(), "Payment with idempotency", idempotencyKey, s.testUserID).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ uuid.UUID, amount decimal.Decimal, _ string, _ string, _ uuid.UUID) (*models.Transfer, error) {
 			if !amount.Equal(decimal.NewFromFloat(150.00)) {
 				s.T().Errorf("expected amount 150.00, got %s", amount.String())
 			}
@@ -551,7 +823,7 @@ func (s *AccountHandlerSuite) TestTransfer_DuplicateIdempotencyKey_CompletedTran
 	existingTransfer := &models.Transfer{
 		ID:                  transferID,
 		FromAccountID:       fromAccountID,
-		ToAccountID:         toAccountID,
+		ToAccountID:         &toAccountID,
 		Amount:              decimal.NewFromFloat(150.00),
 		Description:         "Duplicate request",
 		IdempotencyKey:      idempotencyKey,
@@ -567,7 +839,7 @@ func (s *AccountHandlerSuite) TestTransfer_DuplicateIdempotencyKey_CompletedTran
 
 	// Expect service call that returns existing completed transfer
 	s.mockAccountService.EXPECT().
-		TransferBetweenAccounts(fromAccountID, toAccountID, gomock.Any(), "Duplicate request", idempotencyKey, s.testUserID).
+		TransferBetweenAccounts(gomock.Any(), fromAccountID, toAccountID, gomock.Any(), "Duplicate request", idempotencyKey, s.testUserID).
 		Return(existingTransfer, nil)
 
 	// Expect audit log for transfer completion
@@ -621,7 +893,7 @@ func (s *AccountHandlerSuite) TestTransfer_DuplicateIdempotencyKey_PendingTransf
 
 	// Expect service call that returns pending error
 	s.mockAccountService.EXPECT().
-		TransferBetweenAccounts(fromAccountID, toAccountID, gomock.Any(), "Pending duplicate", idempotencyKey, s.testUserID).
+		TransferBetweenAccounts(gomock.Any(), fromAccountID, toAccountID, gomock.Any(), "Pending duplicate", idempotencyKey, s.testUserID).
 		Return(nil, services.ErrTransferPending)
 
 	// Expect metrics calls for failed transfer
@@ -671,7 +943,7 @@ func (s *AccountHandlerSuite) TestTransfer_DuplicateIdempotencyKey_FailedTransfe
 
 	// Expect service call that returns failed error
 	s.mockAccountService.EXPECT().
-		TransferBetweenAccounts(fromAccountID, toAccountID, gomock.Any(), "Failed duplicate", idempotencyKey, s.testUserID).
+		TransferBetweenAccounts(gomock.Any(), fromAccountID, toAccountID, gomock.Any(), "Failed duplicate", idempotencyKey, s.testUserID).
 		Return(nil, services.ErrTransferFailed)
 
 	// Expect metrics calls for failed transfer
@@ -734,7 +1006,7 @@ func (s *AccountHandlerSuite) TestGetTransferHistory_Success() {
 		{
 			ID:            transferID1,
 			FromAccountID: accountID1,
-			ToAccountID:   accountID2,
+			ToAccountID:   &accountID2,
 			Amount:        decimal.NewFromFloat(100.00),
 			Description:   "Transfer 1",
 			Status:        models.TransferStatusCompleted,
@@ -742,7 +1014,7 @@ func (s *AccountHandlerSuite) TestGetTransferHistory_Success() {
 		{
 			ID:            transferID2,
 			FromAccountID: accountID2,
-			ToAccountID:   accountID1,
+			ToAccountID:   &accountID1,
 			Amount:        decimal.NewFromFloat(50.00),
 			Description:   "Transfer 2",
 			Status:        models.TransferStatusCompleted,
@@ -751,7 +1023,7 @@ func (s *AccountHandlerSuite) TestGetTransferHistory_Success() {
 
 	filters := models.TransferFilters{}
 	s.mockAccountService.EXPECT().
-		GetUserTransfers(s.testUserID, filters, 0, 20).
+		GetUserTransfers(gomock.Any(), s.testUserID, filters, 0, 20).
 		Return(expectedTransfers, int64(2), nil)
 
 	c, rec := s.createContextWithAuth("GET", "/api/v1/transfers", nil, s.testUserID, "user")
@@ -777,7 +1049,7 @@ func (s *AccountHandlerSuite) TestGetTransferHistory_WithStatusFilter() {
 		{
 			ID:            transferID,
 			FromAccountID: accountID1,
-			ToAccountID:   accountID2,
+			ToAccountID:   &accountID2,
 			Amount:        decimal.NewFromFloat(100.00),
 			Description:   "Completed transfer",
 			Status:        models.TransferStatusCompleted,
@@ -786,7 +1058,7 @@ func (s *AccountHandlerSuite) TestGetTransferHistory_WithStatusFilter() {
 
 	filters := models.TransferFilters{Status: models.TransferStatusCompleted}
 	s.mockAccountService.EXPECT().
-		GetUserTransfers(s.testUserID, filters, 0, 20).
+		GetUserTransfers(gomock.Any(), s.testUserID, filters, 0, 20).
 		Return(expectedTransfers, int64(1), nil)
 
 	c, rec := s.createContextWithAuth("GET", "/api/v1/transfers?status=completed", nil, s.testUserID, "user")
@@ -817,7 +1089,7 @@ func (s *AccountHandlerSuite) TestGetAllAccounts_AdminSuccess() {
 
 	filters := models.AccountFilters{}
 	s.mockAccountService.EXPECT().
-		GetAllAccounts(filters, 0, 20).
+		GetAllAccounts(gomock.Any(), filters, 0, 20).
 		Return(expectedAccounts, int64(1), nil)
 
 	c, rec := s.createContextWithAuth("GET", "/admin/accounts", nil, s.testAdminID, "admin")
@@ -840,7 +1112,7 @@ func (s *AccountHandlerSuite) TestGetAllAccounts_NonAdminFails() {
 
 	// Mock service call since handler will proceed without middleware protection
 	s.mockAccountService.EXPECT().
-		GetAllAccounts(gomock.Any(), gomock.Any(), gomock.Any()).
+		GetAllAccounts(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]models.Account{}, int64(0), nil)
 
 	err := s.handler.GetAllAccounts(c)
@@ -861,7 +1133,7 @@ func (s *AccountHandlerSuite) TestGetAccountByIDAdmin_Success() {
 
 	var nilUserID *uuid.UUID
 	s.mockAccountService.EXPECT().
-		GetAccountByID(accountID, nilUserID).
+		GetAccountByID(gomock.Any(), accountID, nilUserID).
 		Return(expectedAccount, nil)
 
 	c, rec := s.createContextWithAuth("GET", "/admin/accounts/"+accountID.String(), nil, s.testAdminID, "admin")
@@ -892,7 +1164,7 @@ func (s *AccountHandlerSuite) TestGetUserAccountsAdmin_Success() {
 	}
 
 	s.mockAccountService.EXPECT().
-		GetUserAccounts(userID).
+		GetUserAccounts(gomock.Any(), userID).
 		Return(expectedAccounts, nil)
 
 	c, rec := s.createContextWithAuth("GET", "/admin/users/"+userID.String()+"/accounts", nil, s.testAdminID, "admin")
@@ -914,7 +1186,7 @@ func (s *AccountHandlerSuite) TestCloseAccount_Success() {
 	accountID := uuid.New()
 
 	s.mockAccountService.EXPECT().
-		CloseAccount(accountID, s.testUserID).
+		CloseAccount(gomock.Any(), accountID, s.testUserID).
 		Return(nil)
 
 	c, rec := s.createContextWithAuth("DELETE", "/accounts/"+accountID.String(), nil, s.testUserID, "user")
@@ -930,7 +1202,7 @@ func (s *AccountHandlerSuite) TestCloseAccount_NonZeroBalance() {
 	accountID := uuid.New()
 
 	s.mockAccountService.EXPECT().
-		CloseAccount(accountID, s.testUserID).
+		CloseAccount(gomock.Any(), accountID, s.testUserID).
 		Return(services.ErrAccountClosureNotAllowed)
 
 	c, rec := s.createContextWithAuth("DELETE", "/accounts/"+accountID.String(), nil, s.testUserID, "user")
@@ -1079,7 +1351,38 @@ func (s *AccountHandlerSuite) TestInitiateExternalTransfer_InsufficientFunds() {
 	var errorResp ErrorResponse
 	err = json.Unmarshal(rec.Body.Bytes(), &errorResp)
 	s.NoError(err)
-	s.Equal("TRANSFER_004", errorResp.Error.Code)
+	s.Equal("TRANSFER_005", errorResp.Error.Code)
+}
+
+func (s *AccountHandlerSuite) TestInitiateExternalTransfer_UnverifiedPayee() {
+	fromAccountID := uuid.New()
+	toExternalAccountID := uuid.New()
+	idempotencyKey := uuid.New().String()
+
+	reqBody := dto.InitiateExternalTransferRequest{
+		ToExternalAccountID: toExternalAccountID.String(),
+		Amount:              "500.00",
+		Description:         "Rent",
+		TransferType:        "standard",
+	}
+
+	s.mockAccountService.EXPECT().
+		InitiateExternalTransfer(gomock.Any(), s.testUserID, fromAccountID, toExternalAccountID, gomock.Any(), reqBody.Description, reqBody.TransferType, idempotencyKey).
+		Return(nil, services.ErrExternalAccountNotVerified)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/"+fromAccountID.String()+"/external-transfer", reqBody, s.testUserID, "user")
+	c.SetParamNames("accountId")
+	c.SetParamValues(fromAccountID.String())
+	c.Request().Header.Set("Idempotency-Key", idempotencyKey)
+
+	err := s.handler.InitiateExternalTransfer(c)
+	s.NoError(err)
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var errorResp ErrorResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &errorResp)
+	s.NoError(err)
+	s.Equal("PAYEE_002", errorResp.Error.Code)
 }
 
 func (s *AccountHandlerSuite) TestInitiateExternalTransfer_MissingIdempotencyKey() {
@@ -1100,3 +1403,269 @@ func (s *AccountHandlerSuite) TestInitiateExternalTransfer_MissingIdempotencyKey
 	s.Equal(http.StatusBadRequest, rec.Code)
 	s.Contains(rec.Body.String(), "Idempotency-Key header is required")
 }
+
+func (s *AccountHandlerSuite) TestVerifyExternalAccount_Success() {
+	externalAccountID := uuid.New()
+	reqBody := dto.VerifyExternalAccountRequest{Amount1: "0.12", Amount2: "0.34"}
+
+	verifiedAccount := &models.ExternalAccount{
+		ID:                 externalAccountID,
+		UserID:             s.testUserID,
+		Nickname:           "Landlord",
+		AccountNumberMask:  "3210",
+		VerificationStatus: models.ExternalAccountStatusVerified,
+	}
+
+	s.mockExternalAccountSvc.EXPECT().
+		VerifyMicroDeposits(gomock.Any(), s.testUserID, externalAccountID, decimal.RequireFromString("0.12"), decimal.RequireFromString("0.34")).
+		Return(verifiedAccount, nil)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/external/"+externalAccountID.String()+"/verify", reqBody, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.VerifyExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp dto.ExternalAccountResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &resp)
+	s.NoError(err)
+	s.Equal(externalAccountID, resp.ID)
+	s.Equal(models.ExternalAccountStatusVerified, resp.VerificationStatus)
+}
+
+func (s *AccountHandlerSuite) TestVerifyExternalAccount_Mismatch() {
+	externalAccountID := uuid.New()
+	reqBody := dto.VerifyExternalAccountRequest{Amount1: "0.12", Amount2: "0.99"}
+
+	s.mockExternalAccountSvc.EXPECT().
+		VerifyMicroDeposits(gomock.Any(), s.testUserID, externalAccountID, gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("%w: 2 attempts remaining", services.ErrMicroDepositMismatch))
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/external/"+externalAccountID.String()+"/verify", reqBody, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.VerifyExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var errorResp ErrorResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &errorResp)
+	s.NoError(err)
+	s.Equal("PAYEE_003", errorResp.Error.Code)
+	s.Contains(rec.Body.String(), "2 attempts remaining")
+}
+
+func (s *AccountHandlerSuite) TestVerifyExternalAccount_Locked() {
+	externalAccountID := uuid.New()
+	reqBody := dto.VerifyExternalAccountRequest{Amount1: "0.12", Amount2: "0.99"}
+
+	s.mockExternalAccountSvc.EXPECT().
+		VerifyMicroDeposits(gomock.Any(), s.testUserID, externalAccountID, gomock.Any(), gomock.Any()).
+		Return(nil, services.ErrExternalAccountLocked)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/external/"+externalAccountID.String()+"/verify", reqBody, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.VerifyExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+	s.Contains(rec.Body.String(), "PAYEE_004")
+}
+
+func (s *AccountHandlerSuite) TestVerifyExternalAccount_InvalidAmount() {
+	externalAccountID := uuid.New()
+	reqBody := dto.VerifyExternalAccountRequest{Amount1: "twelve", Amount2: "0.34"}
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/external/"+externalAccountID.String()+"/verify", reqBody, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.VerifyExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *AccountHandlerSuite) TestSendMicroDeposits_NotFound() {
+	externalAccountID := uuid.New()
+
+	s.mockExternalAccountSvc.EXPECT().
+		SendMicroDeposits(gomock.Any(), s.testUserID, externalAccountID).
+		Return(nil, services.ErrExternalAccountNotFound)
+
+	c, rec := s.createContextWithAuth("POST", "/accounts/external/"+externalAccountID.String()+"/micro-deposits", nil, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.SendMicroDeposits(c)
+	s.NoError(err)
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "PAYEE_001")
+}
+
+func (s *AccountHandlerSuite) TestListExternalAccounts_Success() {
+	accounts := []models.ExternalAccount{
+		{ID: uuid.New(), UserID: s.testUserID, Nickname: "Landlord", AccountNumberMask: "1111", VerificationStatus: models.ExternalAccountStatusVerified},
+		{ID: uuid.New(), UserID: s.testUserID, Nickname: "Sister", AccountNumberMask: "2222", VerificationStatus: models.ExternalAccountStatusMicroDepositsSent},
+	}
+
+	s.mockExternalAccountSvc.EXPECT().
+		List(gomock.Any(), s.testUserID).
+		Return(accounts, nil)
+
+	c, rec := s.createContextWithAuth("GET", "/customers/me/external-accounts", nil, s.testUserID, "user")
+
+	err := s.handler.ListExternalAccounts(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp dto.ExternalAccountListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(2, resp.Total)
+	s.Equal("Landlord", resp.ExternalAccounts[0].Nickname)
+	s.Equal(models.ExternalAccountStatusMicroDepositsSent, resp.ExternalAccounts[1].VerificationStatus)
+}
+
+func (s *AccountHandlerSuite) TestGetExternalAccount_WithStats() {
+	externalAccountID := uuid.New()
+	lastTransferAt := time.Now()
+	account := &models.ExternalAccount{ID: externalAccountID, UserID: s.testUserID, Nickname: "Landlord", VerificationStatus: models.ExternalAccountStatusVerified}
+
+	s.mockExternalAccountSvc.EXPECT().
+		Get(gomock.Any(), s.testUserID, externalAccountID).
+		Return(account, nil)
+	s.mockExternalAccountSvc.EXPECT().
+		GetTransferStats(gomock.Any(), s.testUserID, externalAccountID).
+		Return(&models.ExternalAccountTransferStats{
+			TotalTransfers:     3,
+			CompletedTransfers: 2,
+			PendingTransfers:   1,
+			TotalAmountSent:    decimal.NewFromFloat(1250.5),
+			LastTransferAt:     &lastTransferAt,
+		}, nil)
+
+	c, rec := s.createContextWithAuth("GET", "/customers/me/external-accounts/"+externalAccountID.String(), nil, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.GetExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp dto.ExternalAccountDetailResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Equal(externalAccountID, resp.ID)
+	s.Equal(int64(3), resp.Stats.TotalTransfers)
+	s.Equal(int64(1), resp.Stats.PendingTransfers)
+	s.Equal("1250.50", resp.Stats.TotalAmountSent)
+	s.NotNil(resp.Stats.LastTransferAt)
+}
+
+func (s *AccountHandlerSuite) TestGetExternalAccount_NotFound() {
+	externalAccountID := uuid.New()
+
+	s.mockExternalAccountSvc.EXPECT().
+		Get(gomock.Any(), s.testUserID, externalAccountID).
+		Return(nil, services.ErrExternalAccountNotFound)
+
+	c, rec := s.createContextWithAuth("GET", "/customers/me/external-accounts/"+externalAccountID.String(), nil, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.GetExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "PAYEE_001")
+}
+
+func (s *AccountHandlerSuite) TestUpdateExternalAccount_Success() {
+	externalAccountID := uuid.New()
+	reqBody := dto.UpdateExternalAccountRequest{Nickname: "New Landlord"}
+
+	s.mockExternalAccountSvc.EXPECT().
+		UpdateNickname(gomock.Any(), s.testUserID, externalAccountID, "New Landlord").
+		Return(&models.ExternalAccount{ID: externalAccountID, UserID: s.testUserID, Nickname: "New Landlord"}, nil)
+
+	c, rec := s.createContextWithAuth("PATCH", "/customers/me/external-accounts/"+externalAccountID.String(), reqBody, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.UpdateExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), "New Landlord")
+}
+
+func (s *AccountHandlerSuite) TestUpdateExternalAccount_ValidationFailure() {
+	externalAccountID := uuid.New()
+	reqBody := dto.UpdateExternalAccountRequest{Nickname: ""}
+
+	c, rec := s.createContextWithAuth("PATCH", "/customers/me/external-accounts/"+externalAccountID.String(), reqBody, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.UpdateExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *AccountHandlerSuite) TestDeleteExternalAccount_Success() {
+	externalAccountID := uuid.New()
+
+	s.mockExternalAccountSvc.EXPECT().
+		Delete(gomock.Any(), s.testUserID, externalAccountID).
+		Return(nil)
+
+	c, rec := s.createContextWithAuth("DELETE", "/customers/me/external-accounts/"+externalAccountID.String(), nil, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.DeleteExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *AccountHandlerSuite) TestDeleteExternalAccount_PendingTransfers() {
+	externalAccountID := uuid.New()
+
+	s.mockExternalAccountSvc.EXPECT().
+		Delete(gomock.Any(), s.testUserID, externalAccountID).
+		Return(fmt.Errorf("%w: 1 still in flight", services.ErrExternalAccountHasPendingTransfers))
+
+	c, rec := s.createContextWithAuth("DELETE", "/customers/me/external-accounts/"+externalAccountID.String(), nil, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.DeleteExternalAccount(c)
+	s.NoError(err)
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "PAYEE_006")
+}
+
+func (s *AccountHandlerSuite) TestGetExternalAccountTransfers_Success() {
+	externalAccountID := uuid.New()
+	transfers := []models.Transfer{
+		{ID: uuid.New(), ToExternalAccountID: &externalAccountID, Amount: decimal.NewFromInt(50), Status: models.TransferStatusCompleted},
+	}
+
+	s.mockExternalAccountSvc.EXPECT().
+		GetTransferHistory(gomock.Any(), s.testUserID, externalAccountID, 10, 10).
+		Return(transfers, int64(11), nil)
+
+	c, rec := s.createContextWithAuth("GET", "/customers/me/external-accounts/"+externalAccountID.String()+"/transfers?page=2&limit=10", nil, s.testUserID, "user")
+	c.SetParamNames("externalAccountId")
+	c.SetParamValues(externalAccountID.String())
+
+	err := s.handler.GetExternalAccountTransfers(c)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var resp dto.TransferHistoryResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	s.Len(resp.Transfers, 1)
+	s.Equal(int64(11), resp.Pagination.Total)
+	s.Equal(2, resp.Pagination.Page)
+}
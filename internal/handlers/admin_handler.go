@@ -45,7 +45,7 @@ func (h *AdminHandler) UnlockUser(c echo.Context) error {
 		return SendError(c, errors.CustomerInvalidID, errors.WithDetails("User ID must be a valid UUID"))
 	}
 
-	user, err := h.userRepo.GetByID(userID)
+	user, err := h.userRepo.GetByID(c.Request().Context(), userID)
 	if err != nil {
 		if err == repositories.ErrUserNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -53,7 +53,7 @@ func (h *AdminHandler) UnlockUser(c echo.Context) error {
 		return SendSystemError(c, err)
 	}
 
-	if err := h.userRepo.UnlockAccount(userID); err != nil {
+	if err := h.userRepo.UnlockAccount(c.Request().Context(), userID); err != nil {
 		return SendSystemError(c, err)
 	}
 
@@ -98,7 +98,7 @@ func (h *AdminHandler) ListUsers(c echo.Context) error {
 
 	offset := (page - 1) * limit
 
-	users, total, err := h.userRepo.ListUsers(offset, limit)
+	users, total, err := h.userRepo.ListUsers(c.Request().Context(), offset, limit)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -149,7 +149,7 @@ func (h *AdminHandler) GetUserByID(c echo.Context) error {
 		return SendError(c, errors.CustomerInvalidID, errors.WithDetails("User ID must be a valid UUID"))
 	}
 
-	user, err := h.userRepo.GetByID(userID)
+	user, err := h.userRepo.GetByID(c.Request().Context(), userID)
 	if err != nil {
 		if err == repositories.ErrUserNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -200,7 +200,7 @@ func (h *AdminHandler) DeleteUser(c echo.Context) error {
 		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Cannot delete your own account"))
 	}
 
-	user, err := h.userRepo.GetByID(userID)
+	user, err := h.userRepo.GetByID(c.Request().Context(), userID)
 	if err != nil {
 		if err == repositories.ErrUserNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -208,7 +208,7 @@ func (h *AdminHandler) DeleteUser(c echo.Context) error {
 		return SendSystemError(c, err)
 	}
 
-	if err := h.userRepo.Delete(userID); err != nil {
+	if err := h.userRepo.Delete(c.Request().Context(), userID); err != nil {
 		return SendSystemError(c, err)
 	}
 
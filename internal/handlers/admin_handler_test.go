@@ -75,9 +75,9 @@ func (s *AdminHandlerSuite) TestUnlockUser() {
 			contextUserID:  adminUser.ID,
 			expectedStatus: http.StatusOK,
 			setupMocks: func() {
-				s.userRepo.EXPECT().GetByID(lockedUser.ID).Return(lockedUser, nil).Times(1)
-				s.userRepo.EXPECT().UnlockAccount(lockedUser.ID).Return(nil).Times(1)
-				s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+				s.userRepo.EXPECT().GetByID(gomock.Any(), lockedUser.ID).Return(lockedUser, nil).Times(1)
+				s.userRepo.EXPECT().UnlockAccount(gomock.Any(), lockedUser.ID).Return(nil).Times(1)
+				s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
@@ -95,7 +95,7 @@ func (s *AdminHandlerSuite) TestUnlockUser() {
 			expectedStatus: http.StatusNotFound,
 			expectedError:  "not found",
 			setupMocks: func() {
-				s.userRepo.EXPECT().GetByID(gomock.Any()).Return(nil, repositories.ErrUserNotFound).Times(1)
+				s.userRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrUserNotFound).Times(1)
 			},
 		},
 	}
@@ -171,7 +171,7 @@ func (s *AdminHandlerSuite) TestListUsers() {
 					s.createTestUser(models.RoleCustomer),
 					s.createTestUser(models.RoleAdmin),
 				}
-				s.userRepo.EXPECT().ListUsers(0, 20).Return(users, int64(len(users)), nil).Times(1)
+				s.userRepo.EXPECT().ListUsers(gomock.Any(), 0, 20).Return(users, int64(len(users)), nil).Times(1)
 				return users
 			},
 		},
@@ -188,7 +188,7 @@ func (s *AdminHandlerSuite) TestListUsers() {
 					s.createTestUser(models.RoleCustomer),
 					s.createTestUser(models.RoleCustomer),
 				}
-				s.userRepo.EXPECT().ListUsers(0, 3).Return(users, int64(len(users)), nil).Times(1)
+				s.userRepo.EXPECT().ListUsers(gomock.Any(), 0, 3).Return(users, int64(len(users)), nil).Times(1)
 				return users
 			},
 		},
@@ -253,7 +253,7 @@ func (s *AdminHandlerSuite) TestGetUserByID() {
 			contextUserID:  adminUser.ID,
 			expectedStatus: http.StatusOK,
 			setupMocks: func() {
-				s.userRepo.EXPECT().GetByID(testUser.ID).Return(testUser, nil).Times(1)
+				s.userRepo.EXPECT().GetByID(gomock.Any(), testUser.ID).Return(testUser, nil).Times(1)
 			},
 		},
 		{
@@ -271,7 +271,7 @@ func (s *AdminHandlerSuite) TestGetUserByID() {
 			expectedStatus: http.StatusNotFound,
 			expectedError:  "not found",
 			setupMocks: func() {
-				s.userRepo.EXPECT().GetByID(gomock.Any()).Return(nil, repositories.ErrUserNotFound).Times(1)
+				s.userRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrUserNotFound).Times(1)
 			},
 		},
 	}
@@ -354,9 +354,9 @@ func (s *AdminHandlerSuite) TestDeleteUser() {
 			contextUserID:  adminUser.ID,
 			expectedStatus: http.StatusOK,
 			setupMocks: func() {
-				s.userRepo.EXPECT().GetByID(userToDelete.ID).Return(userToDelete, nil).Times(1)
-				s.userRepo.EXPECT().Delete(userToDelete.ID).Return(nil).Times(1)
-				s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+				s.userRepo.EXPECT().GetByID(gomock.Any(), userToDelete.ID).Return(userToDelete, nil).Times(1)
+				s.userRepo.EXPECT().Delete(gomock.Any(), userToDelete.ID).Return(nil).Times(1)
+				s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
@@ -374,7 +374,7 @@ func (s *AdminHandlerSuite) TestDeleteUser() {
 			expectedStatus: http.StatusNotFound,
 			expectedError:  "not found",
 			setupMocks: func() {
-				s.userRepo.EXPECT().GetByID(gomock.Any()).Return(nil, repositories.ErrUserNotFound).Times(1)
+				s.userRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrUserNotFound).Times(1)
 			},
 		},
 	}
@@ -48,7 +48,7 @@ func (h *AuthHandler) Register(c echo.Context) error {
 	ipAddress := getClientIP(c)
 	userAgent := c.Request().UserAgent()
 
-	user, err := h.authService.Register(&req, ipAddress, userAgent)
+	user, err := h.authService.Register(c.Request().Context(), &req, ipAddress, userAgent)
 	if err != nil {
 		if err == services.ErrUserAlreadyExists {
 			return SendError(c, errors.CustomerAlreadyExists)
@@ -98,7 +98,7 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	ipAddress := getClientIP(c)
 	userAgent := c.Request().UserAgent()
 
-	tokens, err := h.authService.Login(&req, ipAddress, userAgent)
+	tokens, err := h.authService.Login(c.Request().Context(), &req, ipAddress, userAgent)
 	if err != nil {
 		if err == services.ErrAccountLocked {
 			return SendError(c, errors.AuthAccountLocked)
@@ -140,7 +140,7 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	ipAddress := getClientIP(c)
 	userAgent := c.Request().UserAgent()
 
-	tokens, err := h.authService.RefreshTokens(req.RefreshToken, ipAddress, userAgent)
+	tokens, err := h.authService.RefreshTokens(c.Request().Context(), req.RefreshToken, ipAddress, userAgent)
 	if err != nil {
 		if err == services.ErrInvalidRefreshToken {
 			return SendError(c, errors.AuthInvalidTokenFormat, errors.WithDetails("Invalid or expired refresh token"))
@@ -176,7 +176,7 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 	ipAddress := getClientIP(c)
 	userAgent := c.Request().UserAgent()
 
-	if err := h.authService.Logout(accessToken, ipAddress, userAgent); err != nil {
+	if err := h.authService.Logout(c.Request().Context(), accessToken, ipAddress, userAgent); err != nil {
 		// Security: Always return success to prevent information leakage about system internals
 	}
 
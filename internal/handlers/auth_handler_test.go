@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -71,7 +72,7 @@ func (s *AuthHandlerSuite) TestRegister() {
 
 		// Setup mock expectations
 		s.authService.EXPECT().
-			Register(gomock.Any(), gomock.Any(), gomock.Any()).
+			Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(expectedUser, nil).
 			Times(1)
 
@@ -106,7 +107,7 @@ func (s *AuthHandlerSuite) TestRegister() {
 
 		// Setup mock expectations - return duplicate user error
 		s.authService.EXPECT().
-			Register(gomock.Any(), gomock.Any(), gomock.Any()).
+			Register(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, services.ErrUserAlreadyExists).
 			Times(1)
 
@@ -204,8 +205,8 @@ func (s *AuthHandlerSuite) TestLogin() {
 
 		// Setup mock expectations
 		s.authService.EXPECT().
-			Login(gomock.Any(), gomock.Any(), gomock.Any()).
-			DoAndReturn(func(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+			Login(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
 				s.Equal(email, req.Email)
 				s.Equal(password, req.Password)
 				return expectedTokens, nil
@@ -245,7 +246,7 @@ func (s *AuthHandlerSuite) TestLogin() {
 
 		// Setup mock expectations - return invalid credentials error
 		s.authService.EXPECT().
-			Login(gomock.Any(), gomock.Any(), gomock.Any()).
+			Login(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, services.ErrInvalidCredentials).
 			Times(1)
 
@@ -280,7 +281,7 @@ func (s *AuthHandlerSuite) TestLogin() {
 
 		// Setup mock expectations - return invalid credentials error
 		s.authService.EXPECT().
-			Login(gomock.Any(), gomock.Any(), gomock.Any()).
+			Login(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, services.ErrInvalidCredentials).
 			Times(1)
 
@@ -315,7 +316,7 @@ func (s *AuthHandlerSuite) TestLogin() {
 
 		// Setup mock expectations - return account locked error
 		s.authService.EXPECT().
-			Login(gomock.Any(), gomock.Any(), gomock.Any()).
+			Login(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, services.ErrAccountLocked).
 			Times(1)
 
@@ -360,7 +361,7 @@ func (s *AuthHandlerSuite) TestRefreshToken() {
 
 		// Setup mock expectations
 		s.authService.EXPECT().
-			RefreshTokens(refreshToken, gomock.Any(), gomock.Any()).
+			RefreshTokens(gomock.Any(), refreshToken, gomock.Any(), gomock.Any()).
 			Return(expectedTokens, nil).
 			Times(1)
 
@@ -394,7 +395,7 @@ func (s *AuthHandlerSuite) TestRefreshToken() {
 
 		// Setup mock expectations - return invalid refresh token error
 		s.authService.EXPECT().
-			RefreshTokens(gomock.Any(), gomock.Any(), gomock.Any()).
+			RefreshTokens(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, services.ErrInvalidRefreshToken).
 			Times(1)
 
@@ -442,7 +443,7 @@ func (s *AuthHandlerSuite) TestLogout() {
 
 		// Setup mock expectations
 		s.authService.EXPECT().
-			Logout(accessToken, gomock.Any(), gomock.Any()).
+			Logout(gomock.Any(), accessToken, gomock.Any(), gomock.Any()).
 			Return(nil).
 			Times(1)
 
@@ -509,7 +510,7 @@ func (s *AuthHandlerSuite) TestLogout() {
 		// Setup mock expectations - service returns error but handler still returns success
 		// Security: Always return success to prevent information leakage
 		s.authService.EXPECT().
-			Logout(accessToken, gomock.Any(), gomock.Any()).
+			Logout(gomock.Any(), accessToken, gomock.Any(), gomock.Any()).
 			Return(services.ErrInvalidToken).
 			Times(1)
 
@@ -0,0 +1,332 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// ComplianceHandler handles admin review of Currency Transaction Reports and structuring alerts
+type ComplianceHandler struct {
+	complianceService services.ComplianceServiceInterface
+}
+
+// NewComplianceHandler creates a new compliance handler
+func NewComplianceHandler(complianceService services.ComplianceServiceInterface) *ComplianceHandler {
+	return &ComplianceHandler{
+		complianceService: complianceService,
+	}
+}
+
+// ListReports lists compliance reports
+// @Summary List compliance reports (admin)
+// @Description Lists CTR and structuring reports, newest business day first. Without a status filter all statuses are returned.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param type query string false "Filter by report type (ctr, structuring)"
+// @Param status query string false "Filter by status (pending_review, approved, rejected, submitted)"
+// @Param user_id query string false "Filter by customer ID (UUID)"
+// @Param business_date query string false "Filter by business day (YYYY-MM-DD)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.ComplianceReportListResponse "Reports retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid filter or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/compliance/reports [get]
+func (h *ComplianceHandler) ListReports(c echo.Context) error {
+	var filters models.ComplianceReportFilters
+
+	switch reportType := c.QueryParam("type"); reportType {
+	case "":
+	case models.ComplianceReportTypeCTR, models.ComplianceReportTypeStructuring:
+		filters.ReportType = reportType
+	default:
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("type: must be one of ctr, structuring"))
+	}
+
+	switch status := c.QueryParam("status"); status {
+	case "":
+	case models.ComplianceReportStatusPendingReview, models.ComplianceReportStatusApproved,
+		models.ComplianceReportStatusRejected, models.ComplianceReportStatusSubmitted:
+		filters.Status = status
+	default:
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("status: must be one of pending_review, approved, rejected, submitted"))
+	}
+
+	if userIDParam := c.QueryParam("user_id"); userIDParam != "" {
+		userID, err := uuid.Parse(userIDParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("user_id: must be a valid UUID"))
+		}
+		filters.UserID = &userID
+	}
+
+	if dateParam := c.QueryParam("business_date"); dateParam != "" {
+		businessDate, err := time.Parse("2006-01-02", dateParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("business_date: must be a date in YYYY-MM-DD format"))
+		}
+		filters.BusinessDate = &businessDate
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	reports, total, err := h.complianceService.ListReports(c.Request().Context(), filters, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.ComplianceReportListResponse{
+		Reports: make([]dto.ComplianceReportResponse, len(reports)),
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+	for i := range reports {
+		response.Reports[i] = toComplianceReportResponse(&reports[i])
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GenerateReports runs the CTR and structuring checks for a business day
+// @Summary Generate compliance reports (admin)
+// @Description Aggregates each customer's cash and transfer activity for the business day and records CTR and structuring reports for review. Reports that already exist for the day are left unchanged, so the call can be repeated. The previous business day is also generated automatically.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.GenerateComplianceReportsRequest true "Business day to generate"
+// @Success 200 {object} dto.GenerateComplianceReportsResponse "Reports generated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/compliance/reports/generate [post]
+func (h *ComplianceHandler) GenerateReports(c echo.Context) error {
+	var req dto.GenerateComplianceReportsRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	businessDate, err := time.Parse("2006-01-02", req.BusinessDate)
+	if err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("business_date: must be a date in YYYY-MM-DD format"))
+	}
+
+	result, err := h.complianceService.GenerateReports(c.Request().Context(), businessDate)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetReport returns a single compliance report
+// @Summary Get compliance report (admin)
+// @Description Returns a report with the totals and transactions it covers, its review and its submission attempts.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Report ID (UUID)"
+// @Success 200 {object} dto.ComplianceReportResponse "Report retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid report ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "COMPLIANCE_001 - Report not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/compliance/reports/{id} [get]
+func (h *ComplianceHandler) GetReport(c echo.Context) error {
+	reportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid report ID"))
+	}
+
+	report, err := h.complianceService.GetReport(c.Request().Context(), reportID)
+	if err != nil {
+		return mapComplianceErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toComplianceReportResponse(report))
+}
+
+// GetReportFile returns the report file filed with the regulator
+// @Summary Get compliance report file (admin)
+// @Description Returns the report in the regulator file format documented in docs/compliance-reports.md. Reports that have not been reviewed have an empty review section.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Report ID (UUID)"
+// @Success 200 {object} dto.ComplianceReportFile "Report file"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid report ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "COMPLIANCE_001 - Report not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/compliance/reports/{id}/file [get]
+func (h *ComplianceHandler) GetReportFile(c echo.Context) error {
+	reportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid report ID"))
+	}
+
+	file, err := h.complianceService.GetReportFile(c.Request().Context(), reportID)
+	if err != nil {
+		return mapComplianceErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, file)
+}
+
+// ApproveReport approves a report for filing
+// @Summary Approve compliance report (admin)
+// @Description Approves a pending report. It is filed with the regulator report endpoint on the next submission run and retried until accepted.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID (UUID)"
+// @Param request body dto.ReviewComplianceReportRequest true "Review note"
+// @Success 200 {object} dto.ComplianceReportResponse "Report approved"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "COMPLIANCE_001 - Report not found"
+// @Failure 409 {object} errors.ErrorResponse "COMPLIANCE_002 - Report has already been reviewed"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/compliance/reports/{id}/approve [post]
+func (h *ComplianceHandler) ApproveReport(c echo.Context) error {
+	return h.review(c, h.complianceService.ApproveReport)
+}
+
+// RejectReport dismisses a report without filing it
+// @Summary Reject compliance report (admin)
+// @Description Rejects a pending report, for example when the activity has a known legitimate explanation. The report is kept but never filed.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Report ID (UUID)"
+// @Param request body dto.ReviewComplianceReportRequest true "Review note"
+// @Success 200 {object} dto.ComplianceReportResponse "Report rejected"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "COMPLIANCE_001 - Report not found"
+// @Failure 409 {object} errors.ErrorResponse "COMPLIANCE_002 - Report has already been reviewed"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/compliance/reports/{id}/reject [post]
+func (h *ComplianceHandler) RejectReport(c echo.Context) error {
+	return h.review(c, h.complianceService.RejectReport)
+}
+
+type complianceReviewFunc func(ctx context.Context, adminID, reportID uuid.UUID, note string) (*models.ComplianceReport, error)
+
+func (h *ComplianceHandler) review(c echo.Context, reviewFn complianceReviewFunc) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	reportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid report ID"))
+	}
+
+	var req dto.ReviewComplianceReportRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	report, err := reviewFn(c.Request().Context(), adminID, reportID, req.Note)
+	if err != nil {
+		return mapComplianceErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toComplianceReportResponse(report))
+}
+
+func mapComplianceErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrComplianceReportNotFound):
+		return SendError(c, errors.ComplianceReportNotFound)
+	case stderrors.Is(err, services.ErrComplianceReportNotPendingReview):
+		return SendError(c, errors.ComplianceReportNotPendingReview)
+	}
+	return SendSystemError(c, err)
+}
+
+func toComplianceReportResponse(report *models.ComplianceReport) dto.ComplianceReportResponse {
+	// Details hold the covered transactions in the report file layout.
+	var details struct {
+		Transactions []dto.ComplianceReportTransaction `json:"transactions"`
+	}
+	if raw, err := json.Marshal(report.Details); err == nil {
+		_ = json.Unmarshal(raw, &details)
+	}
+	if details.Transactions == nil {
+		details.Transactions = []dto.ComplianceReportTransaction{}
+	}
+
+	return dto.ComplianceReportResponse{
+		ID:           report.ID,
+		ReportType:   report.ReportType,
+		UserID:       report.UserID,
+		BusinessDate: report.BusinessDate.Format("2006-01-02"),
+		WindowStart:  report.WindowStart.Format("2006-01-02"),
+		Totals: dto.ComplianceReportTotals{
+			CashIn:           report.CashInAmount.StringFixed(2),
+			CashOut:          report.CashOutAmount.StringFixed(2),
+			TransferIn:       report.TransferInAmount.StringFixed(2),
+			TransferOut:      report.TransferOutAmount.StringFixed(2),
+			TotalIn:          report.TotalIn().StringFixed(2),
+			TotalOut:         report.TotalOut().StringFixed(2),
+			TransactionCount: report.TransactionCount,
+		},
+		Transactions:         details.Transactions,
+		Status:               report.Status,
+		ReviewedBy:           report.ReviewedBy,
+		ReviewedAt:           report.ReviewedAt,
+		ReviewNote:           report.ReviewNote,
+		SubmissionAttempts:   report.SubmissionAttempts,
+		NextSubmissionAt:     report.NextSubmissionAt,
+		SubmittedAt:          report.SubmittedAt,
+		SubmissionStatusCode: report.SubmissionStatusCode,
+		SubmissionResponse:   report.SubmissionResponse,
+		LastError:            report.LastError,
+		CreatedAt:            report.CreatedAt,
+	}
+}
@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComplianceHandlerSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	complianceService *service_mocks.MockComplianceServiceInterface
+	handler           *ComplianceHandler
+	echo              *echo.Echo
+	adminID           uuid.UUID
+}
+
+func (s *ComplianceHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.complianceService = service_mocks.NewMockComplianceServiceInterface(s.ctrl)
+	s.handler = NewComplianceHandler(s.complianceService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *ComplianceHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestComplianceHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ComplianceHandlerSuite))
+}
+
+func (s *ComplianceHandlerSuite) newContext(method, target, body string, reportID string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	if reportID != "" {
+		c.SetParamNames("id")
+		c.SetParamValues(reportID)
+	}
+	return c, rec
+}
+
+func (s *ComplianceHandlerSuite) TestListReports_Filters() {
+	userID := uuid.New()
+	businessDate := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	filters := models.ComplianceReportFilters{
+		ReportType:   models.ComplianceReportTypeCTR,
+		Status:       models.ComplianceReportStatusPendingReview,
+		UserID:       &userID,
+		BusinessDate: &businessDate,
+	}
+	reports := []models.ComplianceReport{{
+		ID:           uuid.New(),
+		ReportType:   models.ComplianceReportTypeCTR,
+		UserID:       userID,
+		BusinessDate: businessDate,
+		WindowStart:  businessDate,
+		CashInAmount: decimal.NewFromInt(12000),
+		Status:       models.ComplianceReportStatusPendingReview,
+		Details: models.JSONBMap{"transactions": []interface{}{map[string]interface{}{
+			"transaction_id": uuid.NewString(), "amount": "12000.00", "direction": "in", "category": "cash",
+		}}},
+	}}
+	s.complianceService.EXPECT().ListReports(gomock.Any(), filters, 20, 20).Return(reports, int64(21), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/compliance/reports?type=ctr&status=pending_review&user_id="+userID.String()+"&business_date=2026-03-06&page=2", "", "")
+	s.Require().NoError(s.handler.ListReports(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.ComplianceReportListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Reports, 1)
+	s.Equal("2026-03-06", response.Reports[0].BusinessDate)
+	s.Equal("12000.00", response.Reports[0].Totals.TotalIn)
+	s.Require().Len(response.Reports[0].Transactions, 1)
+	s.Equal("12000.00", response.Reports[0].Transactions[0].Amount)
+	s.Equal(int64(21), response.Pagination.Total)
+}
+
+func (s *ComplianceHandlerSuite) TestListReports_InvalidFilters() {
+	for _, query := range []string{"type=sar", "status=filed", "user_id=abc", "business_date=03-06-2026", "limit=101"} {
+		s.Run(query, func() {
+			c, rec := s.newContext(http.MethodGet, "/admin/compliance/reports?"+query, "", "")
+			s.Require().NoError(s.handler.ListReports(c))
+
+			s.Equal(http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func (s *ComplianceHandlerSuite) TestGenerateReports() {
+	businessDate := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	result := &dto.GenerateComplianceReportsResponse{BusinessDate: "2026-03-06", CustomersScanned: 4, CTRReports: 1}
+	s.complianceService.EXPECT().GenerateReports(gomock.Any(), businessDate).Return(result, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/compliance/reports/generate", `{"business_date":"2026-03-06"}`, "")
+	s.Require().NoError(s.handler.GenerateReports(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"ctr_reports":1`)
+}
+
+func (s *ComplianceHandlerSuite) TestGenerateReports_InvalidDate() {
+	c, rec := s.newContext(http.MethodPost, "/admin/compliance/reports/generate", `{"business_date":"yesterday"}`, "")
+	s.Require().NoError(s.handler.GenerateReports(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ComplianceHandlerSuite) TestGetReportFile_NotFound() {
+	id := uuid.New()
+	s.complianceService.EXPECT().GetReportFile(gomock.Any(), id).Return(nil, services.ErrComplianceReportNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/compliance/reports/"+id.String()+"/file", "", id.String())
+	s.Require().NoError(s.handler.GetReportFile(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "COMPLIANCE_001")
+}
+
+func (s *ComplianceHandlerSuite) TestApproveReport() {
+	id := uuid.New()
+	note := "verified with branch"
+
+	testCases := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{"approved", nil, http.StatusOK},
+		{"already reviewed", services.ErrComplianceReportNotPendingReview, http.StatusConflict},
+		{"not found", services.ErrComplianceReportNotFound, http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var report *models.ComplianceReport
+			if tc.serviceErr == nil {
+				report = &models.ComplianceReport{ID: id, Status: models.ComplianceReportStatusApproved, ReviewedBy: &s.adminID, ReviewNote: &note}
+			}
+			s.complianceService.EXPECT().ApproveReport(gomock.Any(), s.adminID, id, note).Return(report, tc.serviceErr)
+
+			c, rec := s.newContext(http.MethodPost, "/admin/compliance/reports/"+id.String()+"/approve", `{"note":"`+note+`"}`, id.String())
+			s.Require().NoError(s.handler.ApproveReport(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *ComplianceHandlerSuite) TestRejectReport() {
+	id := uuid.New()
+	report := &models.ComplianceReport{ID: id, Status: models.ComplianceReportStatusRejected}
+	s.complianceService.EXPECT().RejectReport(gomock.Any(), s.adminID, id, "payroll deposit").Return(report, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/compliance/reports/"+id.String()+"/reject", `{"note":"payroll deposit"}`, id.String())
+	s.Require().NoError(s.handler.RejectReport(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.ComplianceReportResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(models.ComplianceReportStatusRejected, response.Status)
+	s.NotNil(response.Transactions)
+}
+
+func (s *ComplianceHandlerSuite) TestReviewReport_MissingNote() {
+	id := uuid.New()
+	c, rec := s.newContext(http.MethodPost, "/admin/compliance/reports/"+id.String()+"/approve", `{}`, id.String())
+	s.Require().NoError(s.handler.ApproveReport(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -91,7 +91,7 @@ func (h *CustomerHandler) SearchCustomers(c echo.Context) error {
 
 	h.logger.LogCustomerSearchStarted(ctx, req.Query, string(searchType), adminUserID)
 
-	results, total, err := h.searchService.SearchCustomers(req.Query, searchType, req.Offset, req.Limit)
+	results, total, err := h.searchService.SearchCustomers(ctx, req.Query, searchType, req.Offset, req.Limit)
 	duration := time.Since(startTime)
 
 	if err != nil {
@@ -162,7 +162,7 @@ func (h *CustomerHandler) GetCustomerProfile(c echo.Context) error {
 		return SendError(c, errors.CustomerInvalidID)
 	}
 
-	customer, err := h.profileService.GetCustomerProfile(customerID)
+	customer, err := h.profileService.GetCustomerProfile(c.Request().Context(), customerID)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -192,7 +192,7 @@ func (h *CustomerHandler) GetMyProfile(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
-	customer, err := h.profileService.GetCustomerProfile(userID)
+	customer, err := h.profileService.GetCustomerProfile(c.Request().Context(), userID)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -240,7 +240,7 @@ func (h *CustomerHandler) CreateCustomer(c echo.Context) error {
 		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails(err.Error()))
 	}
 
-	customer, tempPassword, err := h.profileService.CreateCustomer(req.Email, req.FirstName, req.LastName, models.RoleCustomer)
+	customer, tempPassword, err := h.profileService.CreateCustomer(ctx, req.Email, req.FirstName, req.LastName, models.RoleCustomer)
 	if err != nil {
 		if err == services.ErrEmailAlreadyExists {
 			return SendError(c, errors.CustomerAlreadyExists)
@@ -317,7 +317,7 @@ func (h *CustomerHandler) UpdateCustomerProfile(c echo.Context) error {
 		return SendError(c, errors.ValidationGeneral, errors.WithDetails("At least one field must be provided for update"))
 	}
 
-	err = h.profileService.UpdateCustomerProfile(customerID, updates)
+	err = h.profileService.UpdateCustomerProfile(c.Request().Context(), customerID, updates)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -360,7 +360,7 @@ func (h *CustomerHandler) UpdateMyEmail(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
-	err = h.profileService.UpdateCustomerEmail(userID, req.NewEmail)
+	err = h.profileService.UpdateCustomerEmail(c.Request().Context(), userID, req.NewEmail)
 	if err != nil {
 		if err == services.ErrEmailAlreadyExists {
 			return SendError(c, errors.CustomerAlreadyExists)
@@ -398,7 +398,7 @@ func (h *CustomerHandler) DeleteCustomer(c echo.Context) error {
 		return SendError(c, errors.CustomerInvalidID)
 	}
 
-	err = h.profileService.DeleteCustomer(customerID, "Admin deletion")
+	err = h.profileService.DeleteCustomer(c.Request().Context(), customerID, "Admin deletion")
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -435,7 +435,7 @@ func (h *CustomerHandler) GetCustomerAccounts(c echo.Context) error {
 		return SendError(c, errors.CustomerInvalidID)
 	}
 
-	accounts, err := h.accountService.GetCustomerAccounts(customerID)
+	accounts, err := h.accountService.GetCustomerAccounts(c.Request().Context(), customerID)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -466,7 +466,7 @@ func (h *CustomerHandler) GetMyAccounts(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
-	accounts, err := h.accountService.GetCustomerAccounts(userID)
+	accounts, err := h.accountService.GetCustomerAccounts(c.Request().Context(), userID)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -522,7 +522,7 @@ func (h *CustomerHandler) CreateAccountForCustomer(c echo.Context) error {
 	ipAddress := c.RealIP()
 	userAgent := c.Request().UserAgent()
 
-	account, err := h.accountService.CreateAccountForCustomer(customerID, adminID, req.AccountType, ipAddress, userAgent)
+	account, err := h.accountService.CreateAccountForCustomer(c.Request().Context(), customerID, adminID, req.AccountType, ipAddress, userAgent)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -579,7 +579,7 @@ func (h *CustomerHandler) TransferAccountOwnership(c echo.Context) error {
 	ipAddress := c.RealIP()
 	userAgent := c.Request().UserAgent()
 
-	err = h.accountService.TransferAccountOwnership(accountID, req.FromCustomerID, req.ToCustomerID, adminID, ipAddress, userAgent)
+	err = h.accountService.TransferAccountOwnership(c.Request().Context(), accountID, req.FromCustomerID, req.ToCustomerID, adminID, ipAddress, userAgent)
 	if err != nil {
 		if err == services.ErrAccountNotFound {
 			return SendError(c, errors.AccountNotFound)
@@ -618,8 +618,8 @@ func (h *CustomerHandler) GetCustomerActivity(c echo.Context) error {
 	}
 
 	// Parse query parameters
-	limit := 50  // default
-	offset := 0  // default
+	limit := 50 // default
+	offset := 0 // default
 	if limitParam := c.QueryParam("limit"); limitParam != "" {
 		if l, err := strconv.Atoi(limitParam); err == nil && l > 0 {
 			limit = l
@@ -631,7 +631,7 @@ func (h *CustomerHandler) GetCustomerActivity(c echo.Context) error {
 		}
 	}
 
-	activities, total, err := h.auditService.GetCustomerActivity(customerID, nil, nil, limit, offset)
+	activities, total, err := h.auditService.GetCustomerActivity(c.Request().Context(), customerID, nil, nil, limit, offset)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -663,8 +663,8 @@ func (h *CustomerHandler) GetMyActivity(c echo.Context) error {
 	}
 
 	// Parse query parameters
-	limit := 50  // default
-	offset := 0  // default
+	limit := 50 // default
+	offset := 0 // default
 	if limitParam := c.QueryParam("limit"); limitParam != "" {
 		if l, err := strconv.Atoi(limitParam); err == nil && l > 0 {
 			limit = l
@@ -676,7 +676,7 @@ func (h *CustomerHandler) GetMyActivity(c echo.Context) error {
 		}
 	}
 
-	activities, total, err := h.auditService.GetCustomerActivity(userID, nil, nil, limit, offset)
+	activities, total, err := h.auditService.GetCustomerActivity(c.Request().Context(), userID, nil, nil, limit, offset)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -715,7 +715,7 @@ func (h *CustomerHandler) ResetCustomerPassword(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
-	tempPassword, err := h.passwordService.AdminResetPassword(customerID, adminID)
+	tempPassword, err := h.passwordService.AdminResetPassword(c.Request().Context(), customerID, adminID)
 	if err != nil {
 		if err == services.ErrCustomerNotFound {
 			return SendError(c, errors.CustomerNotFound)
@@ -760,7 +760,7 @@ func (h *CustomerHandler) UpdateMyPassword(c echo.Context) error {
 		return SendError(c, errors.AuthMissingToken)
 	}
 
-	err = h.passwordService.CustomerUpdatePassword(userID, req.CurrentPassword, req.NewPassword)
+	err = h.passwordService.CustomerUpdatePassword(c.Request().Context(), userID, req.CurrentPassword, req.NewPassword)
 	if err != nil {
 		if err == services.ErrCurrentPasswordWrong {
 			return SendError(c, errors.AuthInvalidCredentials)
@@ -88,7 +88,7 @@ func (s *CustomerHandlerTestSuite) TestSearchCustomers_SuccessfulSearchWithResul
 
 	// Service expectations
 	s.mockSearchService.EXPECT().
-		SearchCustomers("john@example.com", models.SearchTypeEmail, 0, 10).
+		SearchCustomers(gomock.Any(), "john@example.com", models.SearchTypeEmail, 0, 10).
 		Return(results, int64(1), nil)
 
 	// Metrics expectations
@@ -173,7 +173,7 @@ func (s *CustomerHandlerTestSuite) TestSearchCustomers_ServiceError() {
 
 	// Service expectations
 	s.mockSearchService.EXPECT().
-		SearchCustomers("test@example.com", models.SearchTypeEmail, 0, 10).
+		SearchCustomers(gomock.Any(), "test@example.com", models.SearchTypeEmail, 0, 10).
 		Return(nil, int64(0), errors.New("database error"))
 
 	// Metrics expectations
@@ -217,7 +217,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_AdminRetrievesCustomer
 		Role:      models.RoleCustomer,
 	}
 	s.mockProfileService.EXPECT().
-		GetCustomerProfile(customerID).
+		GetCustomerProfile(gomock.Any(), customerID).
 		Return(user, nil)
 
 	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
@@ -256,7 +256,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerRetrievesOwnPr
 		Role:      models.RoleCustomer,
 	}
 	s.mockProfileService.EXPECT().
-		GetCustomerProfile(customerID).
+		GetCustomerProfile(gomock.Any(), customerID).
 		Return(user, nil)
 
 	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
@@ -298,7 +298,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerCannotAccessOt
 		Role:      models.RoleCustomer,
 	}
 	s.mockProfileService.EXPECT().
-		GetCustomerProfile(otherCustomerID).
+		GetCustomerProfile(gomock.Any(), otherCustomerID).
 		Return(expectedCustomer, nil)
 
 	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
@@ -353,7 +353,7 @@ func (s *CustomerHandlerTestSuite) TestGetCustomerProfile_CustomerNotFound() {
 
 	// Setup mock expectations
 	s.mockProfileService.EXPECT().
-		GetCustomerProfile(customerID).
+		GetCustomerProfile(gomock.Any(), customerID).
 		Return(nil, services.ErrCustomerNotFound)
 
 	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
@@ -374,17 +374,17 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_Successful() {
 	adminID := uuid.New()
 	requestBody := `{
 		"email": "newcustomer@example.com",
-		"first_name": "Jane",
-		"last_name": "Smith",
+		"firstName": "Jane",
+		"lastName": "Smith",
 		"phone_number": "+14155552671",
-		"date_of_birth": "1990-01-15",
+		"dateOfBirth": "1990-01-15",
 		"address": "123 Main St",
 		"city": "San Francisco",
 		"state": "CA",
 		"zip_code": "94102",
 		"ssn": "123456789",
-		"employment_status": "employed",
-		"annual_income": "75000"
+		"employmentStatus": "employed",
+		"annualIncome": "75000"
 	}`
 
 	e := echo.New()
@@ -410,7 +410,7 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_Successful() {
 
 	// Service expectations
 	s.mockProfileService.EXPECT().
-		CreateCustomer("newcustomer@example.com", "Jane", "Smith", models.RoleCustomer).
+		CreateCustomer(gomock.Any(), "newcustomer@example.com", "Jane", "Smith", models.RoleCustomer).
 		Return(user, "TempPass123!", nil)
 
 	// Metrics and logger expectations
@@ -435,12 +435,12 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_InvalidEmail() {
 	adminID := uuid.New()
 	requestBody := `{
 		"email": "invalid-email",
-		"first_name": "Jane",
-		"last_name": "Smith",
-		"date_of_birth": "1990-01-15",
+		"firstName": "Jane",
+		"lastName": "Smith",
+		"dateOfBirth": "1990-01-15",
 		"ssn": "123456789",
-		"employment_status": "employed",
-		"annual_income": "75000"
+		"employmentStatus": "employed",
+		"annualIncome": "75000"
 	}`
 
 	e := echo.New()
@@ -495,12 +495,12 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_EmailAlreadyExists() {
 	adminID := uuid.New()
 	requestBody := `{
 		"email": "existing@example.com",
-		"first_name": "Jane",
-		"last_name": "Smith",
-		"date_of_birth": "1990-01-15",
+		"firstName": "Jane",
+		"lastName": "Smith",
+		"dateOfBirth": "1990-01-15",
 		"ssn": "123456789",
-		"employment_status": "employed",
-		"annual_income": "75000"
+		"employmentStatus": "employed",
+		"annualIncome": "75000"
 	}`
 
 	e := echo.New()
@@ -516,7 +516,7 @@ func (s *CustomerHandlerTestSuite) TestCreateCustomer_EmailAlreadyExists() {
 
 	// Setup mock expectations
 	s.mockProfileService.EXPECT().
-		CreateCustomer("existing@example.com", "Jane", "Smith", models.RoleCustomer).
+		CreateCustomer(gomock.Any(), "existing@example.com", "Jane", "Smith", models.RoleCustomer).
 		Return(nil, "", services.ErrEmailAlreadyExists)
 
 	handler := NewCustomerHandler(s.mockSearchService, s.mockProfileService, s.mockAccountService, s.mockPasswordService, s.mockAuditService, s.logger, s.mockMetrics)
@@ -0,0 +1,358 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// CustomerWebhookHandler lets customers manage webhook subscriptions for their account events
+type CustomerWebhookHandler struct {
+	customerWebhookService services.CustomerWebhookServiceInterface
+}
+
+// NewCustomerWebhookHandler creates a new customer webhook handler
+func NewCustomerWebhookHandler(customerWebhookService services.CustomerWebhookServiceInterface) *CustomerWebhookHandler {
+	return &CustomerWebhookHandler{
+		customerWebhookService: customerWebhookService,
+	}
+}
+
+// CreateSubscription subscribes an endpoint to account events.
+// @Summary Create a webhook subscription
+// @Description Subscribe an HTTPS endpoint to transaction.posted, transfer.completed, transfer.failed or balance.low events. Deliveries are signed with HMAC-SHA256 using the returned secret, which is not shown again. URLs pointing at private, loopback or link-local addresses are rejected, and redirects are not followed.
+// @Tags Customers
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateWebhookSubscriptionRequest true "Subscription details"
+// @Success 201 {object} dto.CreateWebhookSubscriptionResponse "Subscription created"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 422 {object} errors.ErrorResponse "SUBSCRIPTION_003 - Subscription limit reached"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks [post]
+func (h *CustomerWebhookHandler) CreateSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.CreateWebhookSubscriptionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	subscription, err := h.customerWebhookService.CreateSubscription(c.Request().Context(), userID, &req)
+	if err != nil {
+		return mapCustomerWebhookErr(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.CreateWebhookSubscriptionResponse{
+		WebhookSubscriptionResponse: *toWebhookSubscriptionResponse(subscription),
+		Secret:                      subscription.Secret,
+	})
+}
+
+// ListSubscriptions lists the authenticated user's webhook subscriptions.
+// @Summary List my webhook subscriptions
+// @Description Retrieve all webhook subscriptions of the authenticated user, including whether each is active or was disabled after repeated delivery failures
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.WebhookSubscriptionListResponse "List of subscriptions"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks [get]
+func (h *CustomerWebhookHandler) ListSubscriptions(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	subscriptions, err := h.customerWebhookService.ListSubscriptions(c.Request().Context(), userID)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.WebhookSubscriptionListResponse{
+		Subscriptions: make([]dto.WebhookSubscriptionResponse, 0, len(subscriptions)),
+		Total:         len(subscriptions),
+	}
+	for i := range subscriptions {
+		response.Subscriptions = append(response.Subscriptions, *toWebhookSubscriptionResponse(&subscriptions[i]))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetSubscription retrieves a webhook subscription.
+// @Summary Get a webhook subscription
+// @Description Retrieve a single webhook subscription owned by the authenticated user
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Success 200 {object} dto.WebhookSubscriptionResponse "Subscription details"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid subscription ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "SUBSCRIPTION_001 - Subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks/{id} [get]
+func (h *CustomerWebhookHandler) GetSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	subscriptionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid subscription ID"))
+	}
+
+	subscription, err := h.customerWebhookService.GetSubscription(c.Request().Context(), userID, subscriptionID)
+	if err != nil {
+		return mapCustomerWebhookErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toWebhookSubscriptionResponse(subscription))
+}
+
+// UpdateSubscription changes a webhook subscription.
+// @Summary Update a webhook subscription
+// @Description Change the URL, event types or low balance threshold of a subscription, or disable it. Setting status to active re-enables a subscription disabled after repeated delivery failures.
+// @Tags Customers
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Param request body dto.UpdateWebhookSubscriptionRequest true "Fields to change"
+// @Success 200 {object} dto.WebhookSubscriptionResponse "Subscription updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request body"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "SUBSCRIPTION_001 - Subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks/{id} [patch]
+func (h *CustomerWebhookHandler) UpdateSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	subscriptionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid subscription ID"))
+	}
+
+	var req dto.UpdateWebhookSubscriptionRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	subscription, err := h.customerWebhookService.UpdateSubscription(c.Request().Context(), userID, subscriptionID, &req)
+	if err != nil {
+		return mapCustomerWebhookErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toWebhookSubscriptionResponse(subscription))
+}
+
+// DeleteSubscription removes a webhook subscription.
+// @Summary Delete a webhook subscription
+// @Description Remove a subscription owned by the authenticated user together with its delivery log. Queued deliveries are dropped.
+// @Tags Customers
+// @Security BearerAuth
+// @Param id path string true "Subscription ID (UUID)"
+// @Success 204 "Subscription removed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid subscription ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "SUBSCRIPTION_001 - Subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks/{id} [delete]
+func (h *CustomerWebhookHandler) DeleteSubscription(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	subscriptionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid subscription ID"))
+	}
+
+	if err := h.customerWebhookService.DeleteSubscription(c.Request().Context(), userID, subscriptionID); err != nil {
+		return mapCustomerWebhookErr(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListDeliveries retrieves the delivery log of a subscription.
+// @Summary List webhook deliveries
+// @Description Retrieve the paginated delivery log of a subscription, newest first, with the status, attempt count and last response of each delivery
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Results per page (max 100)" default(20)
+// @Success 200 {object} dto.WebhookDeliveryListResponse "Delivery log with pagination"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "SUBSCRIPTION_001 - Subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks/{id}/deliveries [get]
+func (h *CustomerWebhookHandler) ListDeliveries(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	subscriptionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid subscription ID"))
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	deliveries, total, err := h.customerWebhookService.ListDeliveries(c.Request().Context(), userID, subscriptionID, (page-1)*limit, limit)
+	if err != nil {
+		return mapCustomerWebhookErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.WebhookDeliveryListResponse{
+		Deliveries: deliveries,
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	})
+}
+
+// Redeliver queues a delivery to be sent again.
+// @Summary Redeliver a webhook
+// @Description Queue a sent or permanently failed delivery to be sent again with the same event ID and a fresh set of retries
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Param deliveryId path string true "Delivery ID (UUID)"
+// @Success 202 {object} models.WebhookDelivery "Redelivery queued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid subscription or delivery ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "SUBSCRIPTION_001 - Subscription not found, SUBSCRIPTION_002 - Delivery not found"
+// @Failure 409 {object} errors.ErrorResponse "SUBSCRIPTION_004 - Delivery is still being retried"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *CustomerWebhookHandler) Redeliver(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	subscriptionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid subscription ID"))
+	}
+
+	deliveryID, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid delivery ID"))
+	}
+
+	delivery, err := h.customerWebhookService.Redeliver(c.Request().Context(), userID, subscriptionID, deliveryID)
+	if err != nil {
+		return mapCustomerWebhookErr(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, delivery)
+}
+
+// Ping sends a test event to a subscription.
+// @Summary Send a test webhook
+// @Description Send a signed webhook.ping event to the subscription's URL immediately and return the logged delivery with the endpoint's status code. Pings are not retried.
+// @Tags Customers
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Subscription ID (UUID)"
+// @Success 200 {object} models.WebhookDelivery "Ping sent; status is sent or failed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid subscription ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 404 {object} errors.ErrorResponse "SUBSCRIPTION_001 - Subscription not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /customers/me/webhooks/{id}/ping [post]
+func (h *CustomerWebhookHandler) Ping(c echo.Context) error {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	subscriptionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid subscription ID"))
+	}
+
+	delivery, err := h.customerWebhookService.Ping(c.Request().Context(), userID, subscriptionID)
+	if err != nil {
+		return mapCustomerWebhookErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, delivery)
+}
+
+func mapCustomerWebhookErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrWebhookSubscriptionNotFound):
+		return SendError(c, errors.SubscriptionNotFound)
+	case stderrors.Is(err, services.ErrWebhookDeliveryNotFound):
+		return SendError(c, errors.SubscriptionDeliveryNotFound)
+	case stderrors.Is(err, services.ErrWebhookSubscriptionLimitReached):
+		return SendError(c, errors.SubscriptionLimitReached, errors.WithDetails(err.Error()))
+	case stderrors.Is(err, services.ErrWebhookDeliveryInProgress):
+		return SendError(c, errors.SubscriptionDeliveryInProgress)
+	case stderrors.Is(err, services.ErrInvalidLowBalanceThreshold), stderrors.Is(err, services.ErrInvalidWebhookURL):
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+	return SendSystemError(c, err)
+}
+
+func toWebhookSubscriptionResponse(subscription *models.WebhookSubscription) *dto.WebhookSubscriptionResponse {
+	return &dto.WebhookSubscriptionResponse{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		Description:         subscription.Description,
+		EventTypes:          subscription.EventTypeList(),
+		LowBalanceThreshold: subscription.LowBalanceThreshold.StringFixed(2),
+		Status:              subscription.Status,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		DisabledAt:          subscription.DisabledAt,
+		DisabledReason:      subscription.DisabledReason,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomerWebhookHandlerSuite struct {
+	suite.Suite
+	ctrl                   *gomock.Controller
+	customerWebhookService *service_mocks.MockCustomerWebhookServiceInterface
+	handler                *CustomerWebhookHandler
+	echo                   *echo.Echo
+	userID                 uuid.UUID
+}
+
+func (s *CustomerWebhookHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.customerWebhookService = service_mocks.NewMockCustomerWebhookServiceInterface(s.ctrl)
+	s.handler = NewCustomerWebhookHandler(s.customerWebhookService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.userID = uuid.New()
+}
+
+func (s *CustomerWebhookHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestCustomerWebhookHandlerSuite(t *testing.T) {
+	suite.Run(t, new(CustomerWebhookHandlerSuite))
+}
+
+func (s *CustomerWebhookHandlerSuite) newContext(method, target, body string, params ...string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.userID)
+	var names, values []string
+	for i := 0; i+1 < len(params); i += 2 {
+		names = append(names, params[i])
+		values = append(values, params[i+1])
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+	return c, rec
+}
+
+func (s *CustomerWebhookHandlerSuite) TestCreateSubscription_ReturnsSecret() {
+	subscription := &models.WebhookSubscription{
+		ID:                  uuid.New(),
+		UserID:              s.userID,
+		URL:                 "https://example.com/hooks",
+		Secret:              "whsec_abc",
+		LowBalanceThreshold: decimal.NewFromInt(100),
+		Status:              models.WebhookSubscriptionStatusActive,
+	}
+	subscription.SetEventTypes([]string{models.EventTypeTransactionPosted, models.WebhookEventBalanceLow})
+	s.customerWebhookService.EXPECT().CreateSubscription(gomock.Any(), s.userID, gomock.Any()).Return(subscription, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/customers/me/webhooks",
+		`{"url":"https://example.com/hooks","event_types":["transaction.posted","balance.low"],"low_balance_threshold":"100"}`)
+	s.Require().NoError(s.handler.CreateSubscription(c))
+
+	s.Equal(http.StatusCreated, rec.Code)
+	var response dto.CreateWebhookSubscriptionResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal("whsec_abc", response.Secret)
+	s.Equal([]string{models.EventTypeTransactionPosted, models.WebhookEventBalanceLow}, response.EventTypes)
+	s.Equal("100.00", response.LowBalanceThreshold)
+}
+
+func (s *CustomerWebhookHandlerSuite) TestCreateSubscription_InvalidEventType() {
+	c, rec := s.newContext(http.MethodPost, "/customers/me/webhooks", `{"url":"https://example.com/hooks","event_types":["account.closed"]}`)
+	s.Require().NoError(s.handler.CreateSubscription(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *CustomerWebhookHandlerSuite) TestCreateSubscription_LimitReached() {
+	s.customerWebhookService.EXPECT().CreateSubscription(gomock.Any(), s.userID, gomock.Any()).Return(nil, services.ErrWebhookSubscriptionLimitReached)
+
+	c, rec := s.newContext(http.MethodPost, "/customers/me/webhooks", `{"url":"https://example.com/hooks","event_types":["transfer.failed"]}`)
+	s.Require().NoError(s.handler.CreateSubscription(c))
+
+	s.Equal(http.StatusUnprocessableEntity, rec.Code)
+	s.Contains(rec.Body.String(), "SUBSCRIPTION_003")
+}
+
+func (s *CustomerWebhookHandlerSuite) TestListSubscriptions_OmitsSecret() {
+	subscription := models.WebhookSubscription{ID: uuid.New(), URL: "https://example.com/hooks", Secret: "whsec_abc", EventTypes: models.EventTypeTransferFailed}
+	s.customerWebhookService.EXPECT().ListSubscriptions(gomock.Any(), s.userID).Return([]models.WebhookSubscription{subscription}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/customers/me/webhooks", "")
+	s.Require().NoError(s.handler.ListSubscriptions(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.NotContains(rec.Body.String(), "whsec_abc")
+	var response dto.WebhookSubscriptionListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(1, response.Total)
+}
+
+func (s *CustomerWebhookHandlerSuite) TestGetSubscription_NotFound() {
+	subscriptionID := uuid.New()
+	s.customerWebhookService.EXPECT().GetSubscription(gomock.Any(), s.userID, subscriptionID).Return(nil, services.ErrWebhookSubscriptionNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/customers/me/webhooks/"+subscriptionID.String(), "", "id", subscriptionID.String())
+	s.Require().NoError(s.handler.GetSubscription(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "SUBSCRIPTION_001")
+}
+
+func (s *CustomerWebhookHandlerSuite) TestGetSubscription_InvalidID() {
+	c, rec := s.newContext(http.MethodGet, "/customers/me/webhooks/abc", "", "id", "abc")
+	s.Require().NoError(s.handler.GetSubscription(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *CustomerWebhookHandlerSuite) TestDeleteSubscription() {
+	subscriptionID := uuid.New()
+	s.customerWebhookService.EXPECT().DeleteSubscription(gomock.Any(), s.userID, subscriptionID).Return(nil)
+
+	c, rec := s.newContext(http.MethodDelete, "/customers/me/webhooks/"+subscriptionID.String(), "", "id", subscriptionID.String())
+	s.Require().NoError(s.handler.DeleteSubscription(c))
+
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *CustomerWebhookHandlerSuite) TestListDeliveries() {
+	subscriptionID := uuid.New()
+	deliveries := []models.WebhookDelivery{{ID: uuid.New(), SubscriptionID: subscriptionID, Status: models.WebhookStatusSent}}
+	s.customerWebhookService.EXPECT().ListDeliveries(gomock.Any(), s.userID, subscriptionID, 10, 10).Return(deliveries, int64(11), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/customers/me/webhooks/"+subscriptionID.String()+"/deliveries?page=2&limit=10", "", "id", subscriptionID.String())
+	s.Require().NoError(s.handler.ListDeliveries(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.WebhookDeliveryListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Deliveries, 1)
+	s.Equal(int64(11), response.Pagination.Total)
+}
+
+func (s *CustomerWebhookHandlerSuite) TestRedeliver_InProgress() {
+	subscriptionID, deliveryID := uuid.New(), uuid.New()
+	s.customerWebhookService.EXPECT().Redeliver(gomock.Any(), s.userID, subscriptionID, deliveryID).Return(nil, services.ErrWebhookDeliveryInProgress)
+
+	c, rec := s.newContext(http.MethodPost, "/customers/me/webhooks/redeliver", "", "id", subscriptionID.String(), "deliveryId", deliveryID.String())
+	s.Require().NoError(s.handler.Redeliver(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "SUBSCRIPTION_004")
+}
+
+func (s *CustomerWebhookHandlerSuite) TestRedeliver_Accepted() {
+	subscriptionID, deliveryID := uuid.New(), uuid.New()
+	delivery := &models.WebhookDelivery{ID: deliveryID, SubscriptionID: subscriptionID, Status: models.WebhookStatusPending}
+	s.customerWebhookService.EXPECT().Redeliver(gomock.Any(), s.userID, subscriptionID, deliveryID).Return(delivery, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/customers/me/webhooks/redeliver", "", "id", subscriptionID.String(), "deliveryId", deliveryID.String())
+	s.Require().NoError(s.handler.Redeliver(c))
+
+	s.Equal(http.StatusAccepted, rec.Code)
+}
+
+func (s *CustomerWebhookHandlerSuite) TestPing() {
+	subscriptionID := uuid.New()
+	statusCode := http.StatusOK
+	responseBody := "internal page"
+	delivery := &models.WebhookDelivery{ID: uuid.New(), EventType: models.WebhookEventPing, Status: models.WebhookStatusSent, ResponseStatusCode: &statusCode, ResponseBody: &responseBody}
+	s.customerWebhookService.EXPECT().Ping(gomock.Any(), s.userID, subscriptionID).Return(delivery, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/customers/me/webhooks/ping", "", "id", subscriptionID.String())
+	s.Require().NoError(s.handler.Ping(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), models.WebhookEventPing)
+	s.Contains(rec.Body.String(), `"response_status_code":200`)
+	s.NotContains(rec.Body.String(), responseBody, "the endpoint's response is never returned")
+}
+
+func (s *CustomerWebhookHandlerSuite) TestCreateSubscription_DisallowedURL() {
+	s.customerWebhookService.EXPECT().CreateSubscription(gomock.Any(), s.userID, gomock.Any()).
+		Return(nil, fmt.Errorf("%w: URL must use https", services.ErrInvalidWebhookURL))
+
+	c, rec := s.newContext(http.MethodPost, "/customers/me/webhooks",
+		`{"url":"http://example.com/hooks","event_types":["transaction.posted"]}`)
+	s.Require().NoError(s.handler.CreateSubscription(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(rec.Body.String(), "must use https")
+}
@@ -66,7 +66,7 @@ func (h *DevHandler) GenerateTestData(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid account ID")
 	}
 
-	account, err := h.accountRepo.GetByID(accountID)
+	account, err := h.accountRepo.GetByID(c.Request().Context(), accountID)
 	if err != nil {
 		if err == repositories.ErrAccountNotFound {
 			return echo.NewHTTPError(http.StatusNotFound, "account not found")
@@ -108,7 +108,7 @@ func (h *DevHandler) GenerateTestData(c echo.Context) error {
 
 	created := 0
 	for _, txn := range transactions {
-		if err := h.transactionRepo.Create(txn); err != nil {
+		if err := h.transactionRepo.Create(c.Request().Context(), txn); err != nil {
 			continue
 		}
 		created++
@@ -156,7 +156,7 @@ func (h *DevHandler) ClearTestData(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid account ID")
 	}
 
-	account, err := h.accountRepo.GetByID(accountID)
+	account, err := h.accountRepo.GetByID(c.Request().Context(), accountID)
 	if err != nil {
 		if err == repositories.ErrAccountNotFound {
 			return echo.NewHTTPError(http.StatusNotFound, "account not found")
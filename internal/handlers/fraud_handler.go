@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// FraudHandler handles admin configuration of fraud screening rules and review of their decisions
+type FraudHandler struct {
+	fraudService services.FraudScreeningServiceInterface
+}
+
+// NewFraudHandler creates a new fraud handler
+func NewFraudHandler(fraudService services.FraudScreeningServiceInterface) *FraudHandler {
+	return &FraudHandler{
+		fraudService: fraudService,
+	}
+}
+
+// ListRules lists the fraud screening rules
+// @Summary List fraud rules (admin)
+// @Description Lists every screening rule with the settings it currently runs with. Rules that were never edited show their built-in defaults.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.FraudRuleListResponse "Rules retrieved successfully"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud/rules [get]
+func (h *FraudHandler) ListRules(c echo.Context) error {
+	rules, err := h.fraudService.ListRules(c.Request().Context())
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.FraudRuleListResponse{Rules: rules})
+}
+
+// UpdateRule changes a fraud screening rule
+// @Summary Update fraud rule (admin)
+// @Description Enables or disables a rule or changes its action, score or parameters. Omitted fields keep their current value and params are merged into the current parameters. The change applies to the next screened money movement.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param name path string true "Rule name"
+// @Param request body dto.UpdateFraudRuleRequest true "Rule settings"
+// @Success 200 {object} dto.FraudRuleResponse "Rule updated"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request or parameter"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "FRAUD_002 - Rule not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud/rules/{name} [put]
+func (h *FraudHandler) UpdateRule(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.UpdateFraudRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	rule, err := h.fraudService.UpdateRule(c.Request().Context(), adminID, c.Param("name"), &req)
+	if err != nil {
+		return mapFraudErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, rule)
+}
+
+// ListDecisions lists fraud screening decisions
+// @Summary List fraud decisions (admin)
+// @Description Lists screening decisions, newest first, with the result of every rule that ran.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param outcome query string false "Filter by outcome (allow, review, block)"
+// @Param operation query string false "Filter by operation (internal_transfer, external_transfer, transaction)"
+// @Param user_id query string false "Filter by customer ID (UUID)"
+// @Param account_id query string false "Filter by source account ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.FraudDecisionListResponse "Decisions retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid filter or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud/decisions [get]
+func (h *FraudHandler) ListDecisions(c echo.Context) error {
+	var filters models.FraudDecisionFilters
+
+	switch outcome := c.QueryParam("outcome"); outcome {
+	case "":
+	case models.FraudOutcomeAllow, models.FraudOutcomeReview, models.FraudOutcomeBlock:
+		filters.Outcome = outcome
+	default:
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("outcome: must be one of allow, review, block"))
+	}
+
+	switch operation := c.QueryParam("operation"); operation {
+	case "":
+	case models.FraudOperationInternalTransfer, models.FraudOperationExternalTransfer, models.FraudOperationTransaction:
+		filters.Operation = operation
+	default:
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("operation: must be one of internal_transfer, external_transfer, transaction"))
+	}
+
+	if userIDParam := c.QueryParam("user_id"); userIDParam != "" {
+		userID, err := uuid.Parse(userIDParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("user_id: must be a valid UUID"))
+		}
+		filters.UserID = &userID
+	}
+
+	if accountIDParam := c.QueryParam("account_id"); accountIDParam != "" {
+		accountID, err := uuid.Parse(accountIDParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("account_id: must be a valid UUID"))
+		}
+		filters.AccountID = &accountID
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	decisions, total, err := h.fraudService.ListDecisions(c.Request().Context(), filters, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.FraudDecisionListResponse{
+		Decisions: make([]dto.FraudDecisionResponse, len(decisions)),
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+	for i := range decisions {
+		response.Decisions[i] = toFraudDecisionResponse(&decisions[i])
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetDecision returns a fraud screening decision
+// @Summary Get fraud decision (admin)
+// @Description Returns a screening decision with the result and reason of every rule that ran.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Decision ID (UUID)"
+// @Success 200 {object} dto.FraudDecisionResponse "Decision retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid decision ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "FRAUD_003 - Decision not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/fraud/decisions/{id} [get]
+func (h *FraudHandler) GetDecision(c echo.Context) error {
+	decisionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid decision ID"))
+	}
+
+	decision, err := h.fraudService.GetDecision(c.Request().Context(), decisionID)
+	if err != nil {
+		return mapFraudErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toFraudDecisionResponse(decision))
+}
+
+func mapFraudErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrFraudRuleNotFound):
+		return SendError(c, errors.FraudRuleNotFound)
+	case stderrors.Is(err, services.ErrFraudDecisionNotFound):
+		return SendError(c, errors.FraudDecisionNotFound)
+	case stderrors.Is(err, services.ErrInvalidFraudRuleConfig):
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+	return SendSystemError(c, err)
+}
+
+func toFraudDecisionResponse(decision *models.FraudDecision) dto.FraudDecisionResponse {
+	// RuleResults hold the per-rule results under "rules".
+	var results struct {
+		Rules []dto.FraudRuleResult `json:"rules"`
+	}
+	if raw, err := json.Marshal(decision.RuleResults); err == nil {
+		_ = json.Unmarshal(raw, &results)
+	}
+	if results.Rules == nil {
+		results.Rules = []dto.FraudRuleResult{}
+	}
+
+	return dto.FraudDecisionResponse{
+		ID:                    decision.ID,
+		UserID:                decision.UserID,
+		Operation:             decision.Operation,
+		AccountID:             decision.AccountID,
+		CounterpartyAccountID: decision.CounterpartyAccountID,
+		ExternalAccountID:     decision.ExternalAccountID,
+		Amount:                decision.Amount.StringFixed(2),
+		Outcome:               decision.Outcome,
+		Score:                 decision.Score,
+		Rules:                 results.Rules,
+		ResourceType:          decision.ResourceType,
+		ResourceID:            decision.ResourceID,
+		CreatedAt:             decision.CreatedAt,
+	}
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type FraudHandlerSuite struct {
+	suite.Suite
+	ctrl         *gomock.Controller
+	fraudService *service_mocks.MockFraudScreeningServiceInterface
+	handler      *FraudHandler
+	echo         *echo.Echo
+	adminID      uuid.UUID
+}
+
+func (s *FraudHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.fraudService = service_mocks.NewMockFraudScreeningServiceInterface(s.ctrl)
+	s.handler = NewFraudHandler(s.fraudService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *FraudHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestFraudHandlerSuite(t *testing.T) {
+	suite.Run(t, new(FraudHandlerSuite))
+}
+
+func (s *FraudHandlerSuite) newContext(method, target, body string, paramName, paramValue string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	if paramName != "" {
+		c.SetParamNames(paramName)
+		c.SetParamValues(paramValue)
+	}
+	return c, rec
+}
+
+func (s *FraudHandlerSuite) TestListRules() {
+	s.fraudService.EXPECT().ListRules(gomock.Any()).Return([]dto.FraudRuleResponse{
+		{Name: services.FraudRuleVelocity, Enabled: true, Action: models.FraudOutcomeReview, Score: 40,
+			Params: map[string]interface{}{"max_count": 5}},
+	}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/fraud/rules", "", "", "")
+	s.Require().NoError(s.handler.ListRules(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.FraudRuleListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Rules, 1)
+	s.Equal(services.FraudRuleVelocity, response.Rules[0].Name)
+}
+
+func (s *FraudHandlerSuite) TestUpdateRule() {
+	s.fraudService.EXPECT().UpdateRule(gomock.Any(), s.adminID, services.FraudRuleVelocity, gomock.Any()).DoAndReturn(
+		func(_ interface{}, _ uuid.UUID, name string, req *dto.UpdateFraudRuleRequest) (*dto.FraudRuleResponse, error) {
+			s.False(*req.Enabled)
+			s.Equal(float64(3), req.Params["max_count"])
+			return &dto.FraudRuleResponse{Name: name, Enabled: false, Customized: true}, nil
+		})
+
+	c, rec := s.newContext(http.MethodPut, "/admin/fraud/rules/velocity", `{"enabled":false,"params":{"max_count":3}}`, "name", services.FraudRuleVelocity)
+	s.Require().NoError(s.handler.UpdateRule(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"customized":true`)
+}
+
+func (s *FraudHandlerSuite) TestUpdateRule_Errors() {
+	testCases := []struct {
+		name           string
+		body           string
+		serviceErr     error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"invalid action", `{"action":"freeze"}`, nil, http.StatusBadRequest, "VALIDATION_001"},
+		{"score out of range", `{"score":101}`, nil, http.StatusBadRequest, "VALIDATION_001"},
+		{"unknown rule", `{"enabled":true}`, services.ErrFraudRuleNotFound, http.StatusNotFound, "FRAUD_002"},
+		{"invalid parameter", `{"params":{"bogus":1}}`, fmt.Errorf("%w: unknown parameter \"bogus\"", services.ErrInvalidFraudRuleConfig), http.StatusBadRequest, "VALIDATION_001"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.serviceErr != nil {
+				s.fraudService.EXPECT().UpdateRule(gomock.Any(), s.adminID, "velocity", gomock.Any()).Return(nil, tc.serviceErr)
+			}
+
+			c, rec := s.newContext(http.MethodPut, "/admin/fraud/rules/velocity", tc.body, "name", "velocity")
+			s.Require().NoError(s.handler.UpdateRule(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+			s.Contains(rec.Body.String(), tc.expectedCode)
+		})
+	}
+}
+
+func (s *FraudHandlerSuite) TestListDecisions_Filters() {
+	userID := uuid.New()
+	filters := models.FraudDecisionFilters{
+		Outcome:   models.FraudOutcomeReview,
+		Operation: models.FraudOperationExternalTransfer,
+		UserID:    &userID,
+	}
+	decisions := []models.FraudDecision{{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Operation: models.FraudOperationExternalTransfer,
+		AccountID: uuid.New(),
+		Amount:    decimal.NewFromInt(750),
+		Outcome:   models.FraudOutcomeReview,
+		Score:     30,
+		RuleResults: models.JSONBMap{"rules": []interface{}{map[string]interface{}{
+			"rule": "new_payee", "outcome": "review", "score": 30, "triggered": true, "reason": "first transfer to this payee",
+		}}},
+	}}
+	s.fraudService.EXPECT().ListDecisions(gomock.Any(), filters, 0, 20).Return(decisions, int64(1), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/fraud/decisions?outcome=review&operation=external_transfer&user_id="+userID.String(), "", "", "")
+	s.Require().NoError(s.handler.ListDecisions(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.FraudDecisionListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Decisions, 1)
+	s.Equal("750.00", response.Decisions[0].Amount)
+	s.Require().Len(response.Decisions[0].Rules, 1)
+	s.Equal("new_payee", response.Decisions[0].Rules[0].Rule)
+	s.True(response.Decisions[0].Rules[0].Triggered)
+}
+
+func (s *FraudHandlerSuite) TestListDecisions_InvalidFilters() {
+	for _, query := range []string{"outcome=deny", "operation=wire", "user_id=abc", "account_id=abc", "limit=101"} {
+		s.Run(query, func() {
+			c, rec := s.newContext(http.MethodGet, "/admin/fraud/decisions?"+query, "", "", "")
+			s.Require().NoError(s.handler.ListDecisions(c))
+
+			s.Equal(http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func (s *FraudHandlerSuite) TestGetDecision() {
+	id := uuid.New()
+	s.fraudService.EXPECT().GetDecision(gomock.Any(), id).Return(&models.FraudDecision{ID: id, Outcome: models.FraudOutcomeAllow}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/fraud/decisions/"+id.String(), "", "id", id.String())
+	s.Require().NoError(s.handler.GetDecision(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.FraudDecisionResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.NotNil(response.Rules)
+}
+
+func (s *FraudHandlerSuite) TestGetDecision_NotFound() {
+	id := uuid.New()
+	s.fraudService.EXPECT().GetDecision(gomock.Any(), id).Return(nil, services.ErrFraudDecisionNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/fraud/decisions/"+id.String(), "", "id", id.String())
+	s.Require().NoError(s.handler.GetDecision(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "FRAUD_003")
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/array/banking-api/internal/leader"
+	"github.com/array/banking-api/internal/lifecycle"
 	"github.com/array/banking-api/internal/services"
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
@@ -14,23 +16,29 @@ import (
 type HealthCheckHandler struct {
 	db              *gorm.DB
 	northwindClient services.NorthwindClientInterface
+	leadership      leader.StatusReporter
+	readiness       lifecycle.ReadinessReporter
 }
 
-// NewHealthCheckHandler creates a new health check handler
-func NewHealthCheckHandler(db *gorm.DB, northwindClient services.NorthwindClientInterface) *HealthCheckHandler {
+// NewHealthCheckHandler creates a new health check handler. With leadership set, the response
+// reports which instance leads the singleton background jobs. Without readiness the instance is
+// always reported ready.
+func NewHealthCheckHandler(db *gorm.DB, northwindClient services.NorthwindClientInterface, leadership leader.StatusReporter, readiness lifecycle.ReadinessReporter) *HealthCheckHandler {
 	return &HealthCheckHandler{
 		db:              db,
 		northwindClient: northwindClient,
+		leadership:      leadership,
+		readiness:       readiness,
 	}
 }
 
 // HealthCheck adds the health check endpoint
 // @Summary Health check
-// @Description Check the health of the API and its dependencies (e.g., database, external banking partners)
+// @Description Check the health of the API and its dependencies (e.g., database, external banking partners). With leader election enabled it also reports this instance and the instance leading the singleton background jobs; leadership does not affect the status.
 // @Tags Health
 // @Produce json
-// @Success 200 {object} object{status=string,timestamp=string,dependencies=object{database=string,external_partner_api=string}} "API is healthy"
-// @Failure 503 {object} object{status=string,timestamp=string,dependencies=object{database=string,external_partner_api=string}} "API is unhealthy"
+// @Success 200 {object} object{status=string,timestamp=string,dependencies=object{database=string,external_partner_api=string},leader=leader.Status} "API is healthy"
+// @Failure 503 {object} object{status=string,timestamp=string,dependencies=object{database=string,external_partner_api=string},leader=leader.Status} "API is unhealthy"
 // @Router /health [get]
 func (h *HealthCheckHandler) HealthCheck(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
@@ -64,9 +72,36 @@ func (h *HealthCheckHandler) HealthCheck(c echo.Context) error {
 		statusCode = http.StatusServiceUnavailable
 	}
 
-	return c.JSON(statusCode, map[string]interface{}{
+	response := map[string]interface{}{
 		"status":       status,
 		"timestamp":    time.Now().UTC().Format(time.RFC3339),
 		"dependencies": dependencies,
+	}
+	if h.leadership != nil {
+		response["leader"] = h.leadership.Status(ctx)
+	}
+
+	return c.JSON(statusCode, response)
+}
+
+// ReadinessCheck reports whether this instance should receive traffic
+// @Summary Readiness check
+// @Description Report whether this instance should receive traffic. It fails as soon as the instance starts shutting down, while in-flight requests and background work drain, so load balancers stop routing to it. Dependencies are not checked; use /health for them.
+// @Tags Health
+// @Produce json
+// @Success 200 {object} object{status=string,timestamp=string} "Instance is ready"
+// @Failure 503 {object} object{status=string,timestamp=string} "Instance is starting or shutting down"
+// @Router /health/ready [get]
+func (h *HealthCheckHandler) ReadinessCheck(c echo.Context) error {
+	status := "ready"
+	statusCode := http.StatusOK
+	if h.readiness != nil && !h.readiness.Ready() {
+		status = "not_ready"
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	return c.JSON(statusCode, map[string]interface{}{
+		"status":    status,
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
 	})
 }
@@ -1,12 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/array/banking-api/internal/leader"
 	"github.com/array/banking-api/internal/services/service_mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
@@ -38,7 +40,7 @@ func (s *HealthHandlerSuite) SetupTest() {
 	s.db = db
 
 	s.northwindClient = service_mocks.NewMockNorthwindClientInterface(s.ctrl)
-	s.handler = NewHealthCheckHandler(s.db, s.northwindClient)
+	s.handler = NewHealthCheckHandler(s.db, s.northwindClient, nil, nil)
 }
 
 func (s *HealthHandlerSuite) TearDownTest() {
@@ -53,6 +55,18 @@ func TestHealthHandlerSuite(t *testing.T) {
 	suite.Run(t, new(HealthHandlerSuite))
 }
 
+type fakeLeaderStatus leader.Status
+
+type fakeReadiness bool
+
+func (f fakeReadiness) Ready() bool {
+	return bool(f)
+}
+
+func (f fakeLeaderStatus) Status(context.Context) leader.Status {
+	return leader.Status(f)
+}
+
 func (s *HealthHandlerSuite) TestHealthCheck_AllHealthy() {
 	s.northwindClient.EXPECT().HealthCheck(gomock.Any()).Return(nil).Times(1)
 
@@ -146,3 +160,57 @@ func (s *HealthHandlerSuite) TestHealthCheck_AllUnhealthy() {
 	s.Equal("error", dependencies["database"])
 	s.Equal("error", dependencies["external_partner_api"])
 }
+
+func (s *HealthHandlerSuite) TestHealthCheck_ReportsLeader() {
+	s.handler = NewHealthCheckHandler(s.db, s.northwindClient, fakeLeaderStatus{Instance: "api-2", Leader: "api-1"}, nil)
+	s.northwindClient.EXPECT().HealthCheck(gomock.Any()).Return(nil).Times(1)
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	rec := httptest.NewRecorder()
+	c := s.e.NewContext(req, rec)
+
+	err := s.handler.HealthCheck(c)
+
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	s.Require().NoError(err)
+
+	s.Equal("ok", response["status"])
+	leaderStatus := response["leader"].(map[string]interface{})
+	s.Equal("api-2", leaderStatus["instance"])
+	s.Equal(false, leaderStatus["is_leader"])
+	s.Equal("api-1", leaderStatus["leader"])
+}
+
+func (s *HealthHandlerSuite) TestReadinessCheck_Ready() {
+	s.handler = NewHealthCheckHandler(s.db, s.northwindClient, nil, fakeReadiness(true))
+
+	req := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
+	rec := httptest.NewRecorder()
+	c := s.e.NewContext(req, rec)
+
+	s.Require().NoError(s.handler.ReadinessCheck(c))
+	s.Equal(http.StatusOK, rec.Code)
+
+	var response map[string]interface{}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal("ready", response["status"])
+}
+
+func (s *HealthHandlerSuite) TestReadinessCheck_ShuttingDown() {
+	s.handler = NewHealthCheckHandler(s.db, s.northwindClient, nil, fakeReadiness(false))
+
+	req := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
+	rec := httptest.NewRecorder()
+	c := s.e.NewContext(req, rec)
+
+	s.Require().NoError(s.handler.ReadinessCheck(c))
+	s.Equal(http.StatusServiceUnavailable, rec.Code)
+
+	var response map[string]interface{}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal("not_ready", response["status"])
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// InboundCreditHandler handles admin review of inbound partner credits
+type InboundCreditHandler struct {
+	inboundCreditService services.InboundCreditServiceInterface
+}
+
+// NewInboundCreditHandler creates a new inbound credit handler
+func NewInboundCreditHandler(inboundCreditService services.InboundCreditServiceInterface) *InboundCreditHandler {
+	return &InboundCreditHandler{
+		inboundCreditService: inboundCreditService,
+	}
+}
+
+// ListInboundCredits lists inbound credits, optionally filtered by status
+// @Summary List inbound credits (admin)
+// @Description Lists credits received from banking partners, oldest first. Filter by status=suspense to work the suspense queue.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status (received, posted, suspense, returning, returned)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.InboundCreditListResponse "Inbound credits retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid status or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/inbound-credits [get]
+func (h *InboundCreditHandler) ListInboundCredits(c echo.Context) error {
+	status := c.QueryParam("status")
+	switch status {
+	case "", models.InboundCreditStatusReceived, models.InboundCreditStatusPosted,
+		models.InboundCreditStatusSuspense, models.InboundCreditStatusReturning, models.InboundCreditStatusReturned:
+	default:
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("status: must be one of received, posted, suspense, returning, returned"))
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	credits, total, err := h.inboundCreditService.List(c.Request().Context(), status, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.InboundCreditListResponse{
+		Credits: make([]dto.InboundCreditResponse, len(credits)),
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+	for i := range credits {
+		response.Credits[i] = toInboundCreditResponse(&credits[i])
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ResolveInboundCredit posts a suspense credit to a chosen account
+// @Summary Resolve suspense credit (admin)
+// @Description Posts a credit held in suspense to the given account through the normal transaction path.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Inbound credit ID (UUID)"
+// @Param request body dto.ResolveInboundCreditRequest true "Target account and resolution note"
+// @Success 200 {object} dto.InboundCreditResponse "Credit posted"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "INBOUND_001 - Credit not found, ACCOUNT_001 - Account not found"
+// @Failure 409 {object} errors.ErrorResponse "INBOUND_002 - Credit is not in suspense"
+// @Failure 422 {object} errors.ErrorResponse "ACCOUNT_002 - Account is not active"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/inbound-credits/{id}/resolve [post]
+func (h *InboundCreditHandler) ResolveInboundCredit(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	creditID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid inbound credit ID"))
+	}
+
+	var req dto.ResolveInboundCreditRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	credit, err := h.inboundCreditService.Resolve(c.Request().Context(), adminID, creditID, req.AccountNumber, req.Note)
+	if err != nil {
+		return mapInboundCreditErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toInboundCreditResponse(credit))
+}
+
+// ReturnInboundCredit returns a suspense credit to the sender
+// @Summary Return suspense credit (admin)
+// @Description Sends a credit held in suspense back to the originator through Northwind, from the configured settlement account. If Northwind cannot be reached the credit stays returning and the return can be retried.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Inbound credit ID (UUID)"
+// @Param request body dto.ReturnInboundCreditRequest true "Resolution note"
+// @Success 200 {object} dto.InboundCreditResponse "Credit returned"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "INBOUND_001 - Credit not found"
+// @Failure 409 {object} errors.ErrorResponse "INBOUND_002 - Credit is not in suspense"
+// @Failure 422 {object} errors.ErrorResponse "INBOUND_003 - Credit cannot be returned"
+// @Failure 503 {object} errors.ErrorResponse "SYSTEM_003 - External bank unavailable"
+// @Router /admin/inbound-credits/{id}/return [post]
+func (h *InboundCreditHandler) ReturnInboundCredit(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	creditID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid inbound credit ID"))
+	}
+
+	var req dto.ReturnInboundCreditRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	credit, err := h.inboundCreditService.Return(c.Request().Context(), adminID, creditID, req.Note)
+	if err != nil {
+		return mapInboundCreditErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toInboundCreditResponse(credit))
+}
+
+func mapInboundCreditErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrInboundCreditNotFound):
+		return SendError(c, errors.InboundCreditNotFound)
+	case stderrors.Is(err, services.ErrInboundCreditNotInSuspense):
+		return SendError(c, errors.InboundCreditInvalidState)
+	case stderrors.Is(err, services.ErrInboundCreditNotReturnable):
+		return SendError(c, errors.InboundCreditNotReturnable, errors.WithDetails(err.Error()))
+	case stderrors.Is(err, services.ErrInboundCreditReturnFailed):
+		return SendError(c, errors.SystemServiceUnavailable)
+	case stderrors.Is(err, services.ErrAccountNotFound):
+		return SendError(c, errors.AccountNotFound)
+	case stderrors.Is(err, services.ErrAccountNotActive):
+		return SendError(c, errors.AccountInactive)
+	}
+	return SendSystemError(c, err)
+}
+
+func toInboundCreditResponse(credit *models.InboundCredit) dto.InboundCreditResponse {
+	return dto.InboundCreditResponse{
+		ID:                 credit.ID,
+		EventID:            credit.EventID,
+		ExternalTransferID: credit.ExternalTransferID,
+		AccountNumber:      credit.AccountNumber,
+		Amount:             credit.Amount.StringFixed(2),
+		Currency:           credit.Currency,
+		SenderName:         credit.SenderName,
+		Reference:          credit.Reference,
+		Status:             credit.Status,
+		SuspenseReason:     credit.SuspenseReason,
+		AccountID:          credit.AccountID,
+		TransactionID:      credit.TransactionID,
+		ReturnTransferID:   credit.ReturnTransferID,
+		ResolvedBy:         credit.ResolvedBy,
+		ResolvedAt:         credit.ResolvedAt,
+		ResolutionNote:     credit.ResolutionNote,
+		CreatedAt:          credit.CreatedAt,
+	}
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type InboundCreditHandlerSuite struct {
+	suite.Suite
+	ctrl                 *gomock.Controller
+	inboundCreditService *service_mocks.MockInboundCreditServiceInterface
+	handler              *InboundCreditHandler
+	echo                 *echo.Echo
+	adminID              uuid.UUID
+}
+
+func (s *InboundCreditHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.inboundCreditService = service_mocks.NewMockInboundCreditServiceInterface(s.ctrl)
+	s.handler = NewInboundCreditHandler(s.inboundCreditService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *InboundCreditHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestInboundCreditHandlerSuite(t *testing.T) {
+	suite.Run(t, new(InboundCreditHandlerSuite))
+}
+
+func (s *InboundCreditHandlerSuite) newContext(method, target string, body interface{}) (echo.Context, *httptest.ResponseRecorder) {
+	var reader *bytes.Reader
+	if body != nil {
+		raw, _ := json.Marshal(body)
+		reader = bytes.NewReader(raw)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	return c, rec
+}
+
+func (s *InboundCreditHandlerSuite) TestListInboundCredits() {
+	reason := "no account matches account number"
+	credits := []models.InboundCredit{{
+		ID:             uuid.New(),
+		EventID:        "evt_1",
+		AccountNumber:  "9999999999",
+		Amount:         decimal.NewFromInt(40),
+		Currency:       "USD",
+		Status:         models.InboundCreditStatusSuspense,
+		SuspenseReason: &reason,
+	}}
+	s.inboundCreditService.EXPECT().List(gomock.Any(), models.InboundCreditStatusSuspense, 20, 20).Return(credits, int64(21), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/inbound-credits?status=suspense&page=2", nil)
+	s.Require().NoError(s.handler.ListInboundCredits(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.InboundCreditListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Len(response.Credits, 1)
+	s.Equal("40.00", response.Credits[0].Amount)
+	s.Equal(reason, *response.Credits[0].SuspenseReason)
+	s.Equal(int64(21), response.Pagination.Total)
+	s.Equal(2, response.Pagination.Page)
+}
+
+func (s *InboundCreditHandlerSuite) TestListInboundCredits_InvalidStatus() {
+	c, rec := s.newContext(http.MethodGet, "/admin/inbound-credits?status=bogus", nil)
+	s.Require().NoError(s.handler.ListInboundCredits(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *InboundCreditHandlerSuite) TestResolveInboundCredit() {
+	creditID := uuid.New()
+
+	testCases := []struct {
+		name           string
+		body           interface{}
+		serviceErr     error
+		expectCall     bool
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"success", dto.ResolveInboundCreditRequest{AccountNumber: "1012345678", Note: "confirmed"}, nil, true, http.StatusOK, ""},
+		{"missing note", dto.ResolveInboundCreditRequest{AccountNumber: "1012345678"}, nil, false, http.StatusBadRequest, "VALIDATION_001"},
+		{"not found", dto.ResolveInboundCreditRequest{AccountNumber: "1012345678", Note: "n"}, services.ErrInboundCreditNotFound, true, http.StatusNotFound, "INBOUND_001"},
+		{"not in suspense", dto.ResolveInboundCreditRequest{AccountNumber: "1012345678", Note: "n"}, services.ErrInboundCreditNotInSuspense, true, http.StatusConflict, "INBOUND_002"},
+		{"account not found", dto.ResolveInboundCreditRequest{AccountNumber: "0", Note: "n"}, services.ErrAccountNotFound, true, http.StatusNotFound, "ACCOUNT_001"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.expectCall {
+				var credit *models.InboundCredit
+				if tc.serviceErr == nil {
+					credit = &models.InboundCredit{ID: creditID, Status: models.InboundCreditStatusPosted}
+				}
+				s.inboundCreditService.EXPECT().
+					Resolve(gomock.Any(), s.adminID, creditID, gomock.Any(), gomock.Any()).
+					Return(credit, tc.serviceErr)
+			}
+
+			c, rec := s.newContext(http.MethodPost, fmt.Sprintf("/admin/inbound-credits/%s/resolve", creditID), tc.body)
+			c.SetParamNames("id")
+			c.SetParamValues(creditID.String())
+			s.Require().NoError(s.handler.ResolveInboundCredit(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				s.Contains(rec.Body.String(), tc.expectedCode)
+			}
+		})
+	}
+}
+
+func (s *InboundCreditHandlerSuite) TestReturnInboundCredit() {
+	creditID := uuid.New()
+
+	testCases := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"success", nil, http.StatusOK, ""},
+		{"not returnable", fmt.Errorf("%w: sender account is unknown", services.ErrInboundCreditNotReturnable), http.StatusUnprocessableEntity, "INBOUND_003"},
+		{"partner failure", services.ErrInboundCreditReturnFailed, http.StatusServiceUnavailable, "SYSTEM_003"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var credit *models.InboundCredit
+			if tc.serviceErr == nil {
+				credit = &models.InboundCredit{ID: creditID, Status: models.InboundCreditStatusReturned}
+			}
+			s.inboundCreditService.EXPECT().
+				Return(gomock.Any(), s.adminID, creditID, "wrong beneficiary").
+				Return(credit, tc.serviceErr)
+
+			c, rec := s.newContext(http.MethodPost, fmt.Sprintf("/admin/inbound-credits/%s/return", creditID),
+				dto.ReturnInboundCreditRequest{Note: "wrong beneficiary"})
+			c.SetParamNames("id")
+			c.SetParamValues(creditID.String())
+			s.Require().NoError(s.handler.ReturnInboundCredit(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				s.Contains(rec.Body.String(), tc.expectedCode)
+			}
+		})
+	}
+}
+
+func (s *InboundCreditHandlerSuite) TestReturnInboundCredit_InvalidID() {
+	c, rec := s.newContext(http.MethodPost, "/admin/inbound-credits/bad/return", dto.ReturnInboundCreditRequest{Note: "n"})
+	c.SetParamNames("id")
+	c.SetParamValues("bad")
+	s.Require().NoError(s.handler.ReturnInboundCredit(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/scheduler"
+	"github.com/labstack/echo/v4"
+)
+
+// JobHandler handles admin operations on scheduled background jobs
+type JobHandler struct {
+	scheduler scheduler.SchedulerInterface
+}
+
+// NewJobHandler creates a new job handler
+func NewJobHandler(jobScheduler scheduler.SchedulerInterface) *JobHandler {
+	return &JobHandler{
+		scheduler: jobScheduler,
+	}
+}
+
+// ListJobs lists the registered background jobs
+// @Summary List background jobs (admin)
+// @Description Lists every registered background job with its schedule, timeout, pause state and most recent run. Running and next_run_at describe the instance that served the request.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.JobListResponse "Jobs retrieved successfully"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/jobs [get]
+func (h *JobHandler) ListJobs(c echo.Context) error {
+	jobs, err := h.scheduler.ListJobs(c.Request().Context())
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.JobListResponse{Jobs: jobs})
+}
+
+// ListRuns lists a job's run history
+// @Summary List background job runs (admin)
+// @Description Lists a job's runs on every instance, newest first, with their trigger, outcome, duration, counts and error.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param name path string true "Job name"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.JobRunListResponse "Job runs retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "JOB_001 - Job not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/jobs/{name}/runs [get]
+func (h *JobHandler) ListRuns(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	runs, total, err := h.scheduler.ListRuns(c.Request().Context(), c.Param("name"), (page-1)*limit, limit)
+	if err != nil {
+		return mapJobErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.JobRunListResponse{
+		Runs: runs,
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	})
+}
+
+// TriggerJob runs a job now
+// @Summary Run background job now (admin)
+// @Description Starts a run of the job on the instance that receives the request, even when the job is paused, and returns the run as recorded at its start. Follow its outcome in the job's run history. Singleton jobs can only be run on the leader instance.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 202 {object} models.JobRun "Job run started"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "JOB_001 - Job not found"
+// @Failure 409 {object} errors.ErrorResponse "JOB_002 - Job already running; JOB_003 - Singleton job and this instance is not the leader"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Failure 503 {object} errors.ErrorResponse "SYSTEM_003 - Instance is shutting down"
+// @Router /admin/jobs/{name}/run [post]
+func (h *JobHandler) TriggerJob(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	run, err := h.scheduler.TriggerJob(c.Request().Context(), adminID, c.Param("name"))
+	if err != nil {
+		return mapJobErr(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, run)
+}
+
+// PauseJob stops a job running on its schedule
+// @Summary Pause background job (admin)
+// @Description Stops the job running on its schedule on every instance. A run in progress finishes, and the job can still be run on demand.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {object} dto.JobResponse "Job paused"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "JOB_001 - Job not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/jobs/{name}/pause [post]
+func (h *JobHandler) PauseJob(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	job, err := h.scheduler.PauseJob(c.Request().Context(), adminID, c.Param("name"))
+	if err != nil {
+		return mapJobErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+// ResumeJob returns a paused job to its schedule
+// @Summary Resume background job (admin)
+// @Description Returns a paused job to its schedule on every instance.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {object} dto.JobResponse "Job resumed"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "JOB_001 - Job not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/jobs/{name}/resume [post]
+func (h *JobHandler) ResumeJob(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	job, err := h.scheduler.ResumeJob(c.Request().Context(), adminID, c.Param("name"))
+	if err != nil {
+		return mapJobErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, job)
+}
+
+func mapJobErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, scheduler.ErrJobNotFound):
+		return SendError(c, errors.JobNotFound)
+	case stderrors.Is(err, scheduler.ErrJobRunning):
+		return SendError(c, errors.JobAlreadyRunning)
+	case stderrors.Is(err, scheduler.ErrNotLeader):
+		return SendError(c, errors.JobNotLeader)
+	case stderrors.Is(err, scheduler.ErrDraining):
+		return SendError(c, errors.SystemServiceUnavailable)
+	}
+	return SendSystemError(c, err)
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/scheduler"
+	"github.com/array/banking-api/internal/scheduler/scheduler_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type JobHandlerSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	scheduler *scheduler_mocks.MockSchedulerInterface
+	handler   *JobHandler
+	echo      *echo.Echo
+	adminID   uuid.UUID
+}
+
+func (s *JobHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.scheduler = scheduler_mocks.NewMockSchedulerInterface(s.ctrl)
+	s.handler = NewJobHandler(s.scheduler)
+	s.echo = echo.New()
+	s.adminID = uuid.New()
+}
+
+func (s *JobHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestJobHandlerSuite(t *testing.T) {
+	suite.Run(t, new(JobHandlerSuite))
+}
+
+func (s *JobHandlerSuite) newContext(method, target, name string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	if name != "" {
+		c.SetParamNames("name")
+		c.SetParamValues(name)
+	}
+	return c, rec
+}
+
+func (s *JobHandlerSuite) TestListJobs() {
+	s.scheduler.EXPECT().ListJobs(gomock.Any()).Return([]dto.JobResponse{
+		{Name: "transfer-monitor", Schedule: "@every 30s", Paused: true},
+		{Name: "outbox-relay", Schedule: "@every 5s"},
+	}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/jobs", "")
+	s.Require().NoError(s.handler.ListJobs(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.JobListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Jobs, 2)
+	s.Equal("transfer-monitor", response.Jobs[0].Name)
+	s.True(response.Jobs[0].Paused)
+}
+
+func (s *JobHandlerSuite) TestListRuns_Paginates() {
+	runs := []models.JobRun{{ID: uuid.New(), JobName: "outbox-relay", Status: models.JobRunStatusSucceeded}}
+	s.scheduler.EXPECT().ListRuns(gomock.Any(), "outbox-relay", 10, 10).Return(runs, int64(11), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/jobs/outbox-relay/runs?page=2&limit=10", "outbox-relay")
+	s.Require().NoError(s.handler.ListRuns(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.JobRunListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Runs, 1)
+	s.Equal(runs[0].ID, response.Runs[0].ID)
+	s.Equal(int64(11), response.Pagination.Total)
+	s.Equal(2, response.Pagination.Page)
+}
+
+func (s *JobHandlerSuite) TestListRuns_InvalidLimit() {
+	c, rec := s.newContext(http.MethodGet, "/admin/jobs/outbox-relay/runs?limit=500", "outbox-relay")
+	s.Require().NoError(s.handler.ListRuns(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *JobHandlerSuite) TestListRuns_UnknownJob() {
+	s.scheduler.EXPECT().ListRuns(gomock.Any(), "missing", 0, 20).Return(nil, int64(0), scheduler.ErrJobNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/jobs/missing/runs", "missing")
+	s.Require().NoError(s.handler.ListRuns(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "JOB_001")
+}
+
+func (s *JobHandlerSuite) TestTriggerJob_Accepted() {
+	run := &models.JobRun{ID: uuid.New(), JobName: "transfer-monitor", Trigger: models.JobRunTriggerManual, Status: models.JobRunStatusRunning}
+	s.scheduler.EXPECT().TriggerJob(gomock.Any(), s.adminID, "transfer-monitor").Return(run, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/jobs/transfer-monitor/run", "transfer-monitor")
+	s.Require().NoError(s.handler.TriggerJob(c))
+
+	s.Equal(http.StatusAccepted, rec.Code)
+	var response models.JobRun
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(run.ID, response.ID)
+	s.Equal(models.JobRunStatusRunning, response.Status)
+}
+
+func (s *JobHandlerSuite) TestTriggerJob_AlreadyRunning() {
+	s.scheduler.EXPECT().TriggerJob(gomock.Any(), s.adminID, "transfer-monitor").Return(nil, scheduler.ErrJobRunning)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/jobs/transfer-monitor/run", "transfer-monitor")
+	s.Require().NoError(s.handler.TriggerJob(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "JOB_002")
+}
+
+func (s *JobHandlerSuite) TestTriggerJob_NotLeader() {
+	s.scheduler.EXPECT().TriggerJob(gomock.Any(), s.adminID, "regulator-webhooks").Return(nil, scheduler.ErrNotLeader)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/jobs/regulator-webhooks/run", "regulator-webhooks")
+	s.Require().NoError(s.handler.TriggerJob(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "JOB_003")
+}
+
+func (s *JobHandlerSuite) TestPauseJob() {
+	s.scheduler.EXPECT().PauseJob(gomock.Any(), s.adminID, "outbox-relay").Return(&dto.JobResponse{Name: "outbox-relay", Paused: true, PausedBy: &s.adminID}, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/jobs/outbox-relay/pause", "outbox-relay")
+	s.Require().NoError(s.handler.PauseJob(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.JobResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.True(response.Paused)
+}
+
+func (s *JobHandlerSuite) TestResumeJob_SystemError() {
+	s.scheduler.EXPECT().ResumeJob(gomock.Any(), s.adminID, "outbox-relay").Return(nil, errors.New("database unavailable"))
+
+	c, rec := s.newContext(http.MethodPost, "/admin/jobs/outbox-relay/resume", "outbox-relay")
+	s.Require().NoError(s.handler.ResumeJob(c))
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
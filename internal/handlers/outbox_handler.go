@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+// OutboxHandler exposes the delivery state of the domain event outbox to admins and lets them
+// skip an event a consumer cannot handle and replay it later
+type OutboxHandler struct {
+	outboxRelayService services.OutboxRelayServiceInterface
+}
+
+// NewOutboxHandler creates a new outbox handler
+func NewOutboxHandler(outboxRelayService services.OutboxRelayServiceInterface) *OutboxHandler {
+	return &OutboxHandler{
+		outboxRelayService: outboxRelayService,
+	}
+}
+
+// ListConsumers lists outbox consumers and their delivery progress
+// @Summary List outbox consumers (admin)
+// @Description Lists each in-process consumer of domain events with its checkpoint, the number of events it has not yet handled, and the last delivery error while it is backing off.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.OutboxConsumerListResponse "Outbox consumers retrieved successfully"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/outbox/consumers [get]
+func (h *OutboxHandler) ListConsumers(c echo.Context) error {
+	consumers, err := h.outboxRelayService.ListConsumers(c.Request().Context())
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.OutboxConsumerListResponse{Consumers: consumers})
+}
+
+// ListUndeliveredEvents lists events a consumer has not yet handled
+// @Summary List undelivered outbox events (admin)
+// @Description Lists the domain events after the consumer's checkpoint, oldest first. The first event is the one being retried when the consumer is failing.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param consumer path string true "Consumer name"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.OutboxEventListResponse "Undelivered events retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "OUTBOX_001 - Outbox consumer not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/outbox/consumers/{consumer}/events [get]
+func (h *OutboxHandler) ListUndeliveredEvents(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	events, total, err := h.outboxRelayService.ListUndeliveredEvents(c.Request().Context(), c.Param("consumer"), (page-1)*limit, limit)
+	if err != nil {
+		if stderrors.Is(err, services.ErrOutboxConsumerNotFound) {
+			return SendError(c, errors.OutboxConsumerNotFound)
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.OutboxEventListResponse{
+		Events: events,
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	})
+}
+
+// SkipEvent dead-letters the event a consumer is stuck on
+// @Summary Skip outbox event (admin)
+// @Description Dead-letters the consumer's next undelivered event so delivery continues with the one after it. The sequence must be that event's, so only the event that was inspected is skipped. Skipped events can be replayed from the dead letters.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param consumer path string true "Consumer name"
+// @Param request body dto.SkipOutboxEventRequest true "Sequence of the event to skip"
+// @Success 200 {object} models.OutboxDeadLetter "Event skipped"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "OUTBOX_001 - Outbox consumer not found"
+// @Failure 409 {object} errors.ErrorResponse "OUTBOX_002 - Event is not the consumer's next undelivered event"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/outbox/consumers/{consumer}/skip [post]
+func (h *OutboxHandler) SkipEvent(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.SkipOutboxEventRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	deadLetter, err := h.outboxRelayService.SkipEvent(c.Request().Context(), adminID, c.Param("consumer"), req.EventSequence)
+	if err != nil {
+		return mapOutboxErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, deadLetter)
+}
+
+// ListDeadLetters lists events a consumer gave up on or an admin skipped
+// @Summary List outbox dead letters (admin)
+// @Description Lists the consumer's dead-lettered events that have not been replayed, oldest first, with the reason, attempts and last delivery error.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param consumer path string true "Consumer name"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.OutboxDeadLetterListResponse "Dead letters retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "OUTBOX_001 - Outbox consumer not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/outbox/consumers/{consumer}/dead-letters [get]
+func (h *OutboxHandler) ListDeadLetters(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	deadLetters, total, err := h.outboxRelayService.ListDeadLetters(c.Request().Context(), c.Param("consumer"), (page-1)*limit, limit)
+	if err != nil {
+		return mapOutboxErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.OutboxDeadLetterListResponse{
+		DeadLetters: deadLetters,
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	})
+}
+
+// ReplayDeadLetter hands a dead-lettered event to its consumer again
+// @Summary Replay outbox dead letter (admin)
+// @Description Delivers a dead-lettered event to its consumer once more. On success the dead letter is marked replayed; if the consumer fails it stays listed and the error is returned.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param consumer path string true "Consumer name"
+// @Param sequence path int true "Event sequence"
+// @Success 200 {object} models.OutboxDeadLetter "Dead letter replayed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid event sequence"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "OUTBOX_001 - Outbox consumer not found"
+// @Failure 404 {object} errors.ErrorResponse "OUTBOX_003 - Outbox dead letter not found"
+// @Failure 409 {object} errors.ErrorResponse "OUTBOX_004 - Outbox dead letter already replayed"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Failure 503 {object} errors.ErrorResponse "SYSTEM_003 - Consumer failed to handle the event"
+// @Router /admin/outbox/consumers/{consumer}/dead-letters/{sequence}/replay [post]
+func (h *OutboxHandler) ReplayDeadLetter(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	sequence, err := strconv.ParseInt(c.Param("sequence"), 10, 64)
+	if err != nil || sequence < 1 {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid event sequence"))
+	}
+
+	deadLetter, err := h.outboxRelayService.ReplayDeadLetter(c.Request().Context(), adminID, c.Param("consumer"), sequence)
+	if err != nil {
+		return mapOutboxErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, deadLetter)
+}
+
+func mapOutboxErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrOutboxConsumerNotFound):
+		return SendError(c, errors.OutboxConsumerNotFound)
+	case stderrors.Is(err, services.ErrOutboxEventNotNext):
+		return SendError(c, errors.OutboxEventNotNext)
+	case stderrors.Is(err, services.ErrOutboxDeadLetterNotFound):
+		return SendError(c, errors.OutboxDeadLetterNotFound)
+	case stderrors.Is(err, services.ErrOutboxDeadLetterReplayed):
+		return SendError(c, errors.OutboxDeadLetterReplayed)
+	case stderrors.Is(err, services.ErrOutboxReplayFailed):
+		return SendError(c, errors.SystemServiceUnavailable, errors.WithDetails(err.Error()))
+	default:
+		return SendSystemError(c, err)
+	}
+}
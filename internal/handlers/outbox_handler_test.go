@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxHandlerSuite struct {
+	suite.Suite
+	ctrl               *gomock.Controller
+	outboxRelayService *service_mocks.MockOutboxRelayServiceInterface
+	handler            *OutboxHandler
+	echo               *echo.Echo
+	adminID            uuid.UUID
+}
+
+func (s *OutboxHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.outboxRelayService = service_mocks.NewMockOutboxRelayServiceInterface(s.ctrl)
+	s.handler = NewOutboxHandler(s.outboxRelayService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *OutboxHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestOutboxHandlerSuite(t *testing.T) {
+	suite.Run(t, new(OutboxHandlerSuite))
+}
+
+func (s *OutboxHandlerSuite) newContext(target, consumer string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	if consumer != "" {
+		c.SetParamNames("consumer")
+		c.SetParamValues(consumer)
+	}
+	return c, rec
+}
+
+func (s *OutboxHandlerSuite) newAdminContext(method, target, body string, paramNames []string, paramValues ...string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	c.SetParamNames(paramNames...)
+	c.SetParamValues(paramValues...)
+	return c, rec
+}
+
+func (s *OutboxHandlerSuite) TestListConsumers() {
+	lastError := "queue unavailable"
+	s.outboxRelayService.EXPECT().ListConsumers(gomock.Any()).Return([]dto.OutboxConsumerStatus{
+		{Consumer: services.OutboxConsumerRegulatorWebhooks, LastEventSequence: 4, Undelivered: 2, Attempts: 3, LastError: &lastError},
+	}, nil)
+
+	c, rec := s.newContext("/admin/outbox/consumers", "")
+	s.Require().NoError(s.handler.ListConsumers(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.OutboxConsumerListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Consumers, 1)
+	s.Equal(int64(2), response.Consumers[0].Undelivered)
+	s.Equal(lastError, *response.Consumers[0].LastError)
+}
+
+func (s *OutboxHandlerSuite) TestListConsumers_ServiceError() {
+	s.outboxRelayService.EXPECT().ListConsumers(gomock.Any()).Return(nil, errors.New("database is down"))
+
+	c, rec := s.newContext("/admin/outbox/consumers", "")
+	s.Require().NoError(s.handler.ListConsumers(c))
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func (s *OutboxHandlerSuite) TestListUndeliveredEvents() {
+	events := []models.OutboxEvent{{ID: 5, EventID: uuid.New(), EventType: models.EventTypeTransferCompleted, AggregateType: models.AggregateTypeTransfer, AggregateID: uuid.New()}}
+	s.outboxRelayService.EXPECT().ListUndeliveredEvents(gomock.Any(), services.OutboxConsumerAudit, 10, 10).Return(events, int64(11), nil)
+
+	c, rec := s.newContext("/admin/outbox/consumers/audit/events?page=2&limit=10", services.OutboxConsumerAudit)
+	s.Require().NoError(s.handler.ListUndeliveredEvents(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.OutboxEventListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Events, 1)
+	s.Equal(int64(5), response.Events[0].ID)
+	s.Equal(int64(11), response.Pagination.Total)
+}
+
+func (s *OutboxHandlerSuite) TestListUndeliveredEvents_UnknownConsumer() {
+	s.outboxRelayService.EXPECT().ListUndeliveredEvents(gomock.Any(), "unknown", 0, 20).Return(nil, int64(0), services.ErrOutboxConsumerNotFound)
+
+	c, rec := s.newContext("/admin/outbox/consumers/unknown/events", "unknown")
+	s.Require().NoError(s.handler.ListUndeliveredEvents(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "OUTBOX_001")
+}
+
+func (s *OutboxHandlerSuite) TestListUndeliveredEvents_InvalidLimit() {
+	c, rec := s.newContext("/admin/outbox/consumers/audit/events?limit=500", services.OutboxConsumerAudit)
+	s.Require().NoError(s.handler.ListUndeliveredEvents(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *OutboxHandlerSuite) TestSkipEvent() {
+	deadLetter := &models.OutboxDeadLetter{Consumer: services.OutboxConsumerAudit, EventID: 7, Reason: models.OutboxDeadLetterReasonSkipped, SkippedBy: &s.adminID}
+	s.outboxRelayService.EXPECT().SkipEvent(gomock.Any(), s.adminID, services.OutboxConsumerAudit, int64(7)).Return(deadLetter, nil)
+
+	c, rec := s.newAdminContext(http.MethodPost, "/admin/outbox/consumers/audit/skip", `{"event_sequence":7}`, []string{"consumer"}, services.OutboxConsumerAudit)
+	s.Require().NoError(s.handler.SkipEvent(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response models.OutboxDeadLetter
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(int64(7), response.EventID)
+	s.Equal(models.OutboxDeadLetterReasonSkipped, response.Reason)
+}
+
+func (s *OutboxHandlerSuite) TestSkipEvent_MissingSequence() {
+	c, rec := s.newAdminContext(http.MethodPost, "/admin/outbox/consumers/audit/skip", `{}`, []string{"consumer"}, services.OutboxConsumerAudit)
+	s.Require().NoError(s.handler.SkipEvent(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *OutboxHandlerSuite) TestSkipEvent_NotNext() {
+	s.outboxRelayService.EXPECT().SkipEvent(gomock.Any(), s.adminID, services.OutboxConsumerAudit, int64(7)).Return(nil, services.ErrOutboxEventNotNext)
+
+	c, rec := s.newAdminContext(http.MethodPost, "/admin/outbox/consumers/audit/skip", `{"event_sequence":7}`, []string{"consumer"}, services.OutboxConsumerAudit)
+	s.Require().NoError(s.handler.SkipEvent(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "OUTBOX_002")
+}
+
+func (s *OutboxHandlerSuite) TestListDeadLetters() {
+	deadLetters := []models.OutboxDeadLetter{{Consumer: services.OutboxConsumerAudit, EventID: 7, Reason: models.OutboxDeadLetterReasonMaxAttempts, Attempts: 10}}
+	s.outboxRelayService.EXPECT().ListDeadLetters(gomock.Any(), services.OutboxConsumerAudit, 0, 20).Return(deadLetters, int64(1), nil)
+
+	c, rec := s.newContext("/admin/outbox/consumers/audit/dead-letters", services.OutboxConsumerAudit)
+	s.Require().NoError(s.handler.ListDeadLetters(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.OutboxDeadLetterListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.DeadLetters, 1)
+	s.Equal(10, response.DeadLetters[0].Attempts)
+	s.Equal(int64(1), response.Pagination.Total)
+}
+
+func (s *OutboxHandlerSuite) TestReplayDeadLetter() {
+	replayedAt := time.Now()
+	deadLetter := &models.OutboxDeadLetter{Consumer: services.OutboxConsumerAudit, EventID: 7, ReplayedBy: &s.adminID, ReplayedAt: &replayedAt}
+	s.outboxRelayService.EXPECT().ReplayDeadLetter(gomock.Any(), s.adminID, services.OutboxConsumerAudit, int64(7)).Return(deadLetter, nil)
+
+	c, rec := s.newAdminContext(http.MethodPost, "/admin/outbox/consumers/audit/dead-letters/7/replay", "", []string{"consumer", "sequence"}, services.OutboxConsumerAudit, "7")
+	s.Require().NoError(s.handler.ReplayDeadLetter(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"replayed_at"`)
+}
+
+func (s *OutboxHandlerSuite) TestReplayDeadLetter_InvalidSequence() {
+	c, rec := s.newAdminContext(http.MethodPost, "/admin/outbox/consumers/audit/dead-letters/abc/replay", "", []string{"consumer", "sequence"}, services.OutboxConsumerAudit, "abc")
+	s.Require().NoError(s.handler.ReplayDeadLetter(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *OutboxHandlerSuite) TestReplayDeadLetter_Errors() {
+	cases := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"not found", services.ErrOutboxDeadLetterNotFound, http.StatusNotFound, "OUTBOX_003"},
+		{"already replayed", services.ErrOutboxDeadLetterReplayed, http.StatusConflict, "OUTBOX_004"},
+		{"consumer failed", fmt.Errorf("%w: payload rejected", services.ErrOutboxReplayFailed), http.StatusServiceUnavailable, "SYSTEM_003"},
+	}
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.outboxRelayService.EXPECT().ReplayDeadLetter(gomock.Any(), s.adminID, services.OutboxConsumerAudit, int64(7)).Return(nil, tc.err)
+
+			c, rec := s.newAdminContext(http.MethodPost, "/admin/outbox/consumers/audit/dead-letters/7/replay", "", []string{"consumer", "sequence"}, services.OutboxConsumerAudit, "7")
+			s.Require().NoError(s.handler.ReplayDeadLetter(c))
+
+			s.Equal(tc.status, rec.Code)
+			s.Contains(rec.Body.String(), tc.code)
+		})
+	}
+}
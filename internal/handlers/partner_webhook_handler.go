@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/signature"
+	"github.com/labstack/echo/v4"
+)
+
+// Northwind webhook signature headers
+const (
+	NorthwindSignatureHeader = "X-Northwind-Signature"
+	NorthwindTimestampHeader = "X-Northwind-Timestamp"
+)
+
+// maxWebhookBodyBytes bounds the size of partner webhook payloads read into memory
+const maxWebhookBodyBytes = 1 << 20
+
+// PartnerWebhookHandler handles signed webhooks pushed by banking partners
+type PartnerWebhookHandler struct {
+	inboundCreditService   services.InboundCreditServiceInterface
+	transferMonitorService services.TransferMonitorServiceInterface
+	northwindSecret        string
+	tolerance              time.Duration
+	now                    func() time.Time
+	logger                 *slog.Logger
+}
+
+// NewPartnerWebhookHandler creates a new partner webhook handler. An empty secret rejects
+// every delivery rather than accepting unsigned payloads.
+func NewPartnerWebhookHandler(
+	inboundCreditService services.InboundCreditServiceInterface,
+	transferMonitorService services.TransferMonitorServiceInterface,
+	northwindSecret string,
+	tolerance time.Duration,
+) *PartnerWebhookHandler {
+	return &PartnerWebhookHandler{
+		inboundCreditService:   inboundCreditService,
+		transferMonitorService: transferMonitorService,
+		northwindSecret:        northwindSecret,
+		tolerance:              tolerance,
+		now:                    time.Now,
+		logger:                 slog.Default().With("handler", "PartnerWebhookHandler"),
+	}
+}
+
+// NorthwindWebhook receives signed event notifications from Northwind
+// @Summary Receive Northwind webhook
+// @Description Public endpoint for Northwind event notifications (credit.received and transfer.status_changed). The body is signed with HMAC-SHA256 over "<timestamp>.<body>" using the shared webhook secret; the hex signature and Unix timestamp are sent in the X-Northwind-Signature and X-Northwind-Timestamp headers. Events are deduplicated on their ID, so redeliveries are acknowledged without being applied twice. Credits that cannot be matched to an active account are acknowledged and held in suspense for admin review. Transfer status changes are applied only as forward transitions, so out-of-order deliveries are acknowledged as duplicates.
+// @Tags Partners
+// @Accept json
+// @Produce json
+// @Param X-Northwind-Signature header string true "Hex HMAC-SHA256 signature"
+// @Param X-Northwind-Timestamp header string true "Unix timestamp used in the signature"
+// @Param request body dto.NorthwindWebhookEvent true "Webhook event"
+// @Success 200 {object} dto.NorthwindWebhookAck "Event accepted"
+// @Failure 400 {object} errors.ErrorResponse "WEBHOOK_003 - Malformed payload"
+// @Failure 401 {object} errors.ErrorResponse "WEBHOOK_001 - Invalid signature, WEBHOOK_002 - Stale timestamp"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_004 - Webhook secret not configured"
+// @Failure 503 {object} errors.ErrorResponse "SYSTEM_003 - Credit not recorded; redeliver the event"
+// @Router /partners/northwind/webhooks [post]
+func (h *PartnerWebhookHandler) NorthwindWebhook(c echo.Context) error {
+	if h.northwindSecret == "" {
+		h.logger.Error("rejecting Northwind webhook: webhook secret is not configured")
+		return SendError(c, errors.SystemConfigurationError)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxWebhookBodyBytes))
+	if err != nil {
+		return SendError(c, errors.WebhookInvalidPayload, errors.WithDetails("Unable to read request body"))
+	}
+
+	err = signature.Verify(
+		h.northwindSecret,
+		c.Request().Header.Get(NorthwindTimestampHeader),
+		c.Request().Header.Get(NorthwindSignatureHeader),
+		body,
+		h.tolerance,
+		h.now(),
+	)
+	if err != nil {
+		h.logger.Warn("rejected Northwind webhook", "error", err, "remote_ip", c.RealIP())
+		if stderrors.Is(err, signature.ErrTimestampOutOfTolerance) {
+			return SendError(c, errors.WebhookStaleTimestamp)
+		}
+		return SendError(c, errors.WebhookInvalidSignature)
+	}
+
+	var event dto.NorthwindWebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return SendError(c, errors.WebhookInvalidPayload, errors.WithDetails("Body is not valid JSON"))
+	}
+	if event.ID == "" || event.Type == "" {
+		return SendError(c, errors.WebhookInvalidPayload, errors.WithDetails("id and type are required"))
+	}
+
+	switch event.Type {
+	case dto.NorthwindEventCreditReceived:
+		return h.handleCreditReceived(c, &event)
+	case dto.NorthwindEventTransferStatusChanged:
+		return h.handleTransferStatusChanged(c, &event)
+	default:
+		// Acknowledge unknown event types so Northwind does not keep retrying them
+		h.logger.Info("ignoring unsupported Northwind event", "event_id", event.ID, "type", event.Type)
+		return c.JSON(http.StatusOK, dto.NorthwindWebhookAck{EventID: event.ID, Status: "ignored"})
+	}
+}
+
+func (h *PartnerWebhookHandler) handleCreditReceived(c echo.Context, event *dto.NorthwindWebhookEvent) error {
+	var data dto.NorthwindCreditReceivedData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return SendError(c, errors.WebhookInvalidPayload, errors.WithDetails("Invalid credit.received data"))
+	}
+
+	credit, duplicate, err := h.inboundCreditService.ProcessNorthwindCredit(c.Request().Context(), event.ID, &data)
+	if err != nil {
+		if stderrors.Is(err, services.ErrInboundCreditNotRecorded) {
+			// Nothing was recorded; Northwind redelivers the event and it is posted then
+			h.logger.Warn("inbound credit not recorded, asking Northwind to redeliver", "event_id", event.ID, "error", err)
+			return SendError(c, errors.SystemServiceUnavailable)
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.NorthwindWebhookAck{
+		EventID:   event.ID,
+		Status:    credit.Status,
+		Duplicate: duplicate,
+	})
+}
+
+func (h *PartnerWebhookHandler) handleTransferStatusChanged(c echo.Context, event *dto.NorthwindWebhookEvent) error {
+	var data dto.NorthwindTransferStatusData
+	if err := json.Unmarshal(event.Data, &data); err != nil || data.TransferID == "" || data.Status == "" {
+		return SendError(c, errors.WebhookInvalidPayload, errors.WithDetails("Invalid transfer.status_changed data"))
+	}
+
+	transfer, applied, err := h.transferMonitorService.HandleStatusCallback(c.Request().Context(), &data)
+	if err != nil {
+		if stderrors.Is(err, services.ErrTransferNotFound) {
+			// Transfers we do not track (such as micro-deposits) are acknowledged and dropped
+			h.logger.Info("ignoring status callback for unknown transfer", "event_id", event.ID, "external_transfer_id", data.TransferID)
+			return c.JSON(http.StatusOK, dto.NorthwindWebhookAck{EventID: event.ID, Status: "ignored"})
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.NorthwindWebhookAck{
+		EventID:   event.ID,
+		Status:    transfer.Status,
+		Duplicate: !applied,
+	})
+}
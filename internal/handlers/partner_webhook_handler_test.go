@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/northwindtest"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+const testWebhookSecret = "whsec_test"
+
+type PartnerWebhookHandlerSuite struct {
+	suite.Suite
+	ctrl                   *gomock.Controller
+	inboundCreditService   *service_mocks.MockInboundCreditServiceInterface
+	transferMonitorService *service_mocks.MockTransferMonitorServiceInterface
+	handler                *PartnerWebhookHandler
+	echo                   *echo.Echo
+	sender                 *northwindtest.WebhookSender
+}
+
+func (s *PartnerWebhookHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.inboundCreditService = service_mocks.NewMockInboundCreditServiceInterface(s.ctrl)
+	s.transferMonitorService = service_mocks.NewMockTransferMonitorServiceInterface(s.ctrl)
+	s.handler = NewPartnerWebhookHandler(s.inboundCreditService, s.transferMonitorService, testWebhookSecret, 5*time.Minute)
+	s.echo = echo.New()
+	s.sender = northwindtest.NewWebhookSender("", testWebhookSecret)
+}
+
+func (s *PartnerWebhookHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestPartnerWebhookHandlerSuite(t *testing.T) {
+	suite.Run(t, new(PartnerWebhookHandlerSuite))
+}
+
+func (s *PartnerWebhookHandlerSuite) creditBody(eventID string) []byte {
+	event, err := northwindtest.NewEvent(eventID, dto.NorthwindEventCreditReceived, dto.NorthwindCreditReceivedData{
+		TransferID:    "nw_tr_1",
+		AccountNumber: "1012345678",
+		Amount:        "50.00",
+		Currency:      "USD",
+	})
+	s.Require().NoError(err)
+	body, err := json.Marshal(event)
+	s.Require().NoError(err)
+	return body
+}
+
+func (s *PartnerWebhookHandlerSuite) statusBody(eventID string, data interface{}) []byte {
+	event, err := northwindtest.NewEvent(eventID, dto.NorthwindEventTransferStatusChanged, data)
+	s.Require().NoError(err)
+	body, err := json.Marshal(event)
+	s.Require().NoError(err)
+	return body
+}
+
+func (s *PartnerWebhookHandlerSuite) serve(req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	s.Require().NoError(s.handler.NorthwindWebhook(c))
+	return rec
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_CreditPosted() {
+	s.inboundCreditService.EXPECT().
+		ProcessNorthwindCredit(gomock.Any(), "evt_1", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, eventID string, data *dto.NorthwindCreditReceivedData) (*models.InboundCredit, bool, error) {
+			s.Equal("1012345678", data.AccountNumber)
+			s.Equal("50.00", data.Amount)
+			return &models.InboundCredit{ID: uuid.New(), Status: models.InboundCreditStatusPosted}, false, nil
+		})
+
+	req, err := s.sender.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	var ack dto.NorthwindWebhookAck
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &ack))
+	s.Equal("evt_1", ack.EventID)
+	s.Equal(models.InboundCreditStatusPosted, ack.Status)
+	s.False(ack.Duplicate)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_DuplicateAcknowledged() {
+	s.inboundCreditService.EXPECT().
+		ProcessNorthwindCredit(gomock.Any(), "evt_1", gomock.Any()).
+		Return(&models.InboundCredit{Status: models.InboundCreditStatusSuspense}, true, nil)
+
+	req, err := s.sender.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"duplicate":true`)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_InvalidSignature() {
+	forger := northwindtest.NewWebhookSender("", "wrong-secret")
+	req, err := forger.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+	s.Contains(rec.Body.String(), "WEBHOOK_001")
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_MissingSignature() {
+	req, err := s.sender.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	req.Header.Del(northwindtest.SignatureHeader)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+	s.Contains(rec.Body.String(), "WEBHOOK_001")
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_StaleTimestamp() {
+	s.sender.Now = func() time.Time { return time.Now().Add(-10 * time.Minute) }
+	req, err := s.sender.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+	s.Contains(rec.Body.String(), "WEBHOOK_002")
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_MalformedPayload() {
+	req, err := s.sender.NewRequest(context.Background(), []byte(`{"type":"credit.received"}`))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(rec.Body.String(), "WEBHOOK_003")
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_UnknownEventIgnored() {
+	event, err := northwindtest.NewEvent("evt_2", "account.updated", map[string]string{})
+	s.Require().NoError(err)
+	body, _ := json.Marshal(event)
+
+	req, err := s.sender.NewRequest(context.Background(), body)
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"status":"ignored"`)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_SecretNotConfigured() {
+	handler := NewPartnerWebhookHandler(s.inboundCreditService, s.transferMonitorService, "", 5*time.Minute)
+	req, err := s.sender.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	rec := httptest.NewRecorder()
+
+	s.Require().NoError(handler.NorthwindWebhook(s.echo.NewContext(req, rec)))
+	s.Equal(http.StatusInternalServerError, rec.Code)
+	s.Contains(rec.Body.String(), "SYSTEM_004")
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_ServiceError() {
+	s.inboundCreditService.EXPECT().
+		ProcessNorthwindCredit(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false, errors.New("db down"))
+
+	req, err := s.sender.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_CreditNotRecordedAsksForRedelivery() {
+	s.inboundCreditService.EXPECT().
+		ProcessNorthwindCredit(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, false, fmt.Errorf("%w: db down", services.ErrInboundCreditNotRecorded))
+
+	req, err := s.sender.NewRequest(context.Background(), s.creditBody("evt_1"))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusServiceUnavailable, rec.Code)
+	s.Contains(rec.Body.String(), "SYSTEM_003")
+}
+
+// TestNorthwindWebhook_DrivenByTestDouble delivers over HTTP the way Northwind would
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_DrivenByTestDouble() {
+	s.echo.POST(northwindtest.WebhookPath, s.handler.NorthwindWebhook)
+	server := httptest.NewServer(s.echo)
+	defer server.Close()
+
+	s.inboundCreditService.EXPECT().
+		ProcessNorthwindCredit(gomock.Any(), "evt_http", gomock.Any()).
+		Return(&models.InboundCredit{Status: models.InboundCreditStatusPosted}, false, nil)
+
+	sender := northwindtest.NewWebhookSender(server.URL, testWebhookSecret)
+	ack, err := sender.SendCredit(context.Background(), "evt_http", dto.NorthwindCreditReceivedData{
+		AccountNumber: "1012345678",
+		Amount:        "10.00",
+	})
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusPosted, ack.Status)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_TransferStatusApplied() {
+	s.transferMonitorService.EXPECT().
+		HandleStatusCallback(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, data *dto.NorthwindTransferStatusData) (*models.Transfer, bool, error) {
+			s.Equal("nw_txn_1", data.TransferID)
+			s.Equal(models.TransferStatusCompleted, data.Status)
+			return &models.Transfer{ID: uuid.New(), Status: models.TransferStatusCompleted}, true, nil
+		})
+
+	req, err := s.sender.NewRequest(context.Background(), s.statusBody("evt_s1", dto.NorthwindTransferStatusData{
+		TransferID: "nw_txn_1",
+		Status:     models.TransferStatusCompleted,
+	}))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	var ack dto.NorthwindWebhookAck
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &ack))
+	s.Equal(models.TransferStatusCompleted, ack.Status)
+	s.False(ack.Duplicate)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_TransferStatusOutOfOrder() {
+	s.transferMonitorService.EXPECT().
+		HandleStatusCallback(gomock.Any(), gomock.Any()).
+		Return(&models.Transfer{Status: models.TransferStatusCompleted}, false, nil)
+
+	req, err := s.sender.NewRequest(context.Background(), s.statusBody("evt_s2", dto.NorthwindTransferStatusData{
+		TransferID: "nw_txn_1",
+		Status:     models.TransferStatusProcessing,
+	}))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"duplicate":true`)
+	s.Contains(rec.Body.String(), `"status":"completed"`)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_TransferStatusUnknownTransfer() {
+	s.transferMonitorService.EXPECT().
+		HandleStatusCallback(gomock.Any(), gomock.Any()).
+		Return(nil, false, services.ErrTransferNotFound)
+
+	req, err := s.sender.NewRequest(context.Background(), s.statusBody("evt_s3", dto.NorthwindTransferStatusData{
+		TransferID: "nw_md_1",
+		Status:     models.TransferStatusCompleted,
+	}))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"status":"ignored"`)
+}
+
+func (s *PartnerWebhookHandlerSuite) TestNorthwindWebhook_TransferStatusInvalidData() {
+	req, err := s.sender.NewRequest(context.Background(), s.statusBody("evt_s4", map[string]string{"status": "completed"}))
+	s.Require().NoError(err)
+	rec := s.serve(req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Contains(rec.Body.String(), "WEBHOOK_003")
+}
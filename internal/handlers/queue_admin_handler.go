@@ -0,0 +1,490 @@
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// QueueAdminHandler handles admin operations on the transaction processing queue
+type QueueAdminHandler struct {
+	queueAdminService services.QueueAdminServiceInterface
+}
+
+// NewQueueAdminHandler creates a new queue admin handler
+func NewQueueAdminHandler(queueAdminService services.QueueAdminServiceInterface) *QueueAdminHandler {
+	return &QueueAdminHandler{
+		queueAdminService: queueAdminService,
+	}
+}
+
+// GetMetrics returns processing queue metrics
+// @Summary Get processing queue metrics (admin)
+// @Description Returns queue depth by status, the age of the oldest pending item, the average processing time of completed items and whether the workers are paused.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.QueueMetrics "Queue metrics retrieved successfully"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/metrics [get]
+func (h *QueueAdminHandler) GetMetrics(c echo.Context) error {
+	metrics, err := h.queueAdminService.GetMetrics(c.Request().Context())
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, metrics)
+}
+
+// ListItems lists processing queue items
+// @Summary List processing queue items (admin)
+// @Description Lists processing queue items, oldest first, with their last error. Without a status filter only failed and dead-lettered items are returned.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status (pending, processing, completed, failed, cancelled, dead_lettered)"
+// @Param transaction_id query string false "Filter by transaction ID (UUID)"
+// @Param operation query string false "Filter by operation (process, reverse)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.QueueItemListResponse "Queue items retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid filter or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/items [get]
+func (h *QueueAdminHandler) ListItems(c echo.Context) error {
+	filters := models.ProcessingQueueFilters{
+		Statuses: []string{models.QueueStatusFailed, models.QueueStatusDeadLettered},
+	}
+
+	switch status := c.QueryParam("status"); status {
+	case "":
+	case models.QueueStatusPending, models.QueueStatusProcessing, models.QueueStatusCompleted,
+		models.QueueStatusFailed, models.QueueStatusCancelled, models.QueueStatusDeadLettered:
+		filters.Statuses = []string{status}
+	default:
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("status: must be one of pending, processing, completed, failed, cancelled, dead_lettered"))
+	}
+
+	if transactionIDParam := c.QueryParam("transaction_id"); transactionIDParam != "" {
+		transactionID, err := uuid.Parse(transactionIDParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("transaction_id: must be a valid UUID"))
+		}
+		filters.TransactionID = &transactionID
+	}
+
+	switch operation := c.QueryParam("operation"); operation {
+	case "", models.QueueOperationProcess, models.QueueOperationReverse:
+		filters.Operation = operation
+	default:
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("operation: must be one of process, reverse"))
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	items, total, err := h.queueAdminService.ListItems(c.Request().Context(), filters, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.QueueItemListResponse{
+		Items: make([]dto.QueueItemResponse, len(items)),
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+	for i := range items {
+		response.Items[i] = toQueueItemResponse(&items[i])
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetItem returns a single processing queue item
+// @Summary Get processing queue item (admin)
+// @Description Returns a queue item with its retry count, lease and last error.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Queue item ID (UUID)"
+// @Success 200 {object} dto.QueueItemResponse "Queue item retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid queue item ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "QUEUE_001 - Queue item not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/items/{id} [get]
+func (h *QueueAdminHandler) GetItem(c echo.Context) error {
+	itemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid queue item ID"))
+	}
+
+	item, err := h.queueAdminService.GetItem(c.Request().Context(), itemID)
+	if err != nil {
+		return mapQueueAdminErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toQueueItemResponse(item))
+}
+
+// RetryItem requeues a queue item straight away
+// @Summary Retry processing queue item (admin)
+// @Description Returns a pending, failed or dead-lettered item to the queue with a fresh set of retries. It is claimed on the next worker tick.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Queue item ID (UUID)"
+// @Success 202 {object} dto.QueueItemResponse "Queue item requeued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid queue item ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "QUEUE_001 - Queue item not found"
+// @Failure 409 {object} errors.ErrorResponse "QUEUE_002 - Queue item is processing, completed or cancelled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/items/{id}/retry [post]
+func (h *QueueAdminHandler) RetryItem(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	itemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid queue item ID"))
+	}
+
+	item, err := h.queueAdminService.RetryItem(c.Request().Context(), adminID, itemID)
+	if err != nil {
+		return mapQueueAdminErr(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, toQueueItemResponse(item))
+}
+
+// RescheduleItem requeues a queue item at a given time
+// @Summary Reschedule processing queue item (admin)
+// @Description Returns a pending, failed or dead-lettered item to the queue with a fresh set of retries, to be claimed at the given time.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Queue item ID (UUID)"
+// @Param request body dto.RescheduleQueueItemRequest true "New scheduled time"
+// @Success 202 {object} dto.QueueItemResponse "Queue item rescheduled"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "QUEUE_001 - Queue item not found"
+// @Failure 409 {object} errors.ErrorResponse "QUEUE_002 - Queue item is processing, completed or cancelled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/items/{id}/reschedule [post]
+func (h *QueueAdminHandler) RescheduleItem(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	itemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid queue item ID"))
+	}
+
+	var req dto.RescheduleQueueItemRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	item, err := h.queueAdminService.RescheduleItem(c.Request().Context(), adminID, itemID, req.ScheduledAt)
+	if err != nil {
+		return mapQueueAdminErr(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, toQueueItemResponse(item))
+}
+
+// RetryItems requeues queue items in bulk
+// @Summary Bulk retry processing queue items (admin)
+// @Description Requeues the listed items, or the oldest 100 in the given status (failed or dead_lettered), with a fresh set of retries. With scheduled_at the items are rescheduled to that time. Items that cannot be requeued are reported as skipped.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.RetryQueueItemsRequest true "Item IDs or status to retry"
+// @Success 202 {object} dto.RetryQueueItemsResponse "Queue items requeued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/items/retry [post]
+func (h *QueueAdminHandler) RetryItems(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.RetryQueueItemsRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	result, err := h.queueAdminService.RetryItems(c.Request().Context(), adminID, &req)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, result)
+}
+
+// CancelItem cancels a queue item
+// @Summary Cancel processing queue item (admin)
+// @Description Cancels a pending, failed or dead-lettered item with a note so it is never processed. The queued transaction is left unchanged.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Queue item ID (UUID)"
+// @Param request body dto.CloseQueueItemRequest true "Cancellation note"
+// @Success 200 {object} dto.QueueItemResponse "Queue item cancelled"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "QUEUE_001 - Queue item not found"
+// @Failure 409 {object} errors.ErrorResponse "QUEUE_002 - Queue item is processing, completed or cancelled"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/items/{id}/cancel [post]
+func (h *QueueAdminHandler) CancelItem(c echo.Context) error {
+	return h.closeItem(c, h.queueAdminService.CancelItem)
+}
+
+// DeadLetterItem moves a queue item to the dead-letter state
+// @Summary Dead-letter processing queue item (admin)
+// @Description Parks a pending or failed item in the dead-letter state with a note. Workers leave it there until it is retried or cancelled.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Queue item ID (UUID)"
+// @Param request body dto.CloseQueueItemRequest true "Dead-letter note"
+// @Success 200 {object} dto.QueueItemResponse "Queue item dead-lettered"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "QUEUE_001 - Queue item not found"
+// @Failure 409 {object} errors.ErrorResponse "QUEUE_002 - Queue item is not pending or failed"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/items/{id}/dead-letter [post]
+func (h *QueueAdminHandler) DeadLetterItem(c echo.Context) error {
+	return h.closeItem(c, h.queueAdminService.DeadLetterItem)
+}
+
+func (h *QueueAdminHandler) closeItem(c echo.Context, closeFn func(ctx context.Context, adminID, itemID uuid.UUID, note string) (*models.ProcessingQueueItem, error)) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	itemID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid queue item ID"))
+	}
+
+	var req dto.CloseQueueItemRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	item, err := closeFn(c.Request().Context(), adminID, itemID, req.Note)
+	if err != nil {
+		return mapQueueAdminErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toQueueItemResponse(item))
+}
+
+// GetWorkerStatus returns whether the queue workers are paused
+// @Summary Get processing queue worker status (admin)
+// @Description Returns whether the processing queue workers are paused, by whom and why.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} models.ProcessingQueueControl "Worker status retrieved successfully"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/workers [get]
+func (h *QueueAdminHandler) GetWorkerStatus(c echo.Context) error {
+	control, err := h.queueAdminService.GetWorkerStatus(c.Request().Context())
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, control)
+}
+
+// PauseWorkers stops the queue workers from claiming items
+// @Summary Pause processing queue workers (admin)
+// @Description Stops every API instance's workers from claiming new queue items. Items already being processed finish, and expired leases are still returned to the queue.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.PauseQueueWorkersRequest true "Reason for pausing"
+// @Success 200 {object} models.ProcessingQueueControl "Workers paused"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/workers/pause [post]
+func (h *QueueAdminHandler) PauseWorkers(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.PauseQueueWorkersRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	control, err := h.queueAdminService.PauseWorkers(c.Request().Context(), adminID, req.Reason)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, control)
+}
+
+// ResumeWorkers lets the queue workers claim items again
+// @Summary Resume processing queue workers (admin)
+// @Description Lets the processing queue workers claim items again.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} models.ProcessingQueueControl "Workers resumed"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/workers/resume [post]
+func (h *QueueAdminHandler) ResumeWorkers(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	control, err := h.queueAdminService.ResumeWorkers(c.Request().Context(), adminID)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, control)
+}
+
+// CleanupCompleted deletes old completed queue items
+// @Summary Clean up completed processing queue items (admin)
+// @Description Deletes completed queue items processed more than the given number of hours ago. Failed, cancelled and dead-lettered items are kept.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CleanupQueueRequest true "Age of completed items to delete"
+// @Success 200 {object} dto.CleanupQueueResponse "Completed items deleted"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/queue/cleanup [post]
+func (h *QueueAdminHandler) CleanupCompleted(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.CleanupQueueRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	olderThan := time.Duration(req.OlderThanHours) * time.Hour
+	deleted, err := h.queueAdminService.CleanupCompleted(c.Request().Context(), adminID, olderThan)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.CleanupQueueResponse{Deleted: deleted})
+}
+
+func mapQueueAdminErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrQueueItemNotFound):
+		return SendError(c, errors.QueueItemNotFound)
+	case stderrors.Is(err, services.ErrQueueItemInvalidState):
+		return SendError(c, errors.QueueItemInvalidState, errors.WithDetails(err.Error()))
+	}
+	return SendSystemError(c, err)
+}
+
+func toQueueItemResponse(item *models.ProcessingQueueItem) dto.QueueItemResponse {
+	return dto.QueueItemResponse{
+		ID:             item.ID,
+		TransactionID:  item.TransactionID,
+		Operation:      item.Operation,
+		Priority:       item.Priority,
+		Status:         item.Status,
+		RetryCount:     item.RetryCount,
+		MaxRetries:     item.MaxRetries,
+		ScheduledAt:    item.ScheduledAt,
+		LockedBy:       item.LockedBy,
+		LeaseExpiresAt: item.LeaseExpiresAt,
+		ProcessedAt:    item.ProcessedAt,
+		ErrorMessage:   item.ErrorMessage,
+		CreatedAt:      item.CreatedAt,
+		UpdatedAt:      item.UpdatedAt,
+	}
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type QueueAdminHandlerSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	queueAdminService *service_mocks.MockQueueAdminServiceInterface
+	handler           *QueueAdminHandler
+	echo              *echo.Echo
+	adminID           uuid.UUID
+}
+
+func (s *QueueAdminHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.queueAdminService = service_mocks.NewMockQueueAdminServiceInterface(s.ctrl)
+	s.handler = NewQueueAdminHandler(s.queueAdminService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *QueueAdminHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestQueueAdminHandlerSuite(t *testing.T) {
+	suite.Run(t, new(QueueAdminHandlerSuite))
+}
+
+func (s *QueueAdminHandlerSuite) newContext(method, target, body string, itemID string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	if itemID != "" {
+		c.SetParamNames("id")
+		c.SetParamValues(itemID)
+	}
+	return c, rec
+}
+
+func (s *QueueAdminHandlerSuite) TestGetMetrics() {
+	oldest := "2m30s"
+	s.queueAdminService.EXPECT().GetMetrics(gomock.Any()).Return(&dto.QueueMetrics{
+		PendingCount:      12,
+		DeadLetteredCount: 3,
+		AvgProcessingMs:   85.5,
+		OldestPending:     &oldest,
+		Paused:            true,
+	}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/queue/metrics", "", "")
+	s.Require().NoError(s.handler.GetMetrics(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.QueueMetrics
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(int64(12), response.PendingCount)
+	s.Equal(int64(3), response.DeadLetteredCount)
+	s.Equal(oldest, *response.OldestPending)
+	s.True(response.Paused)
+}
+
+func (s *QueueAdminHandlerSuite) TestListItems_DefaultsToFailedAndDeadLettered() {
+	items := []models.ProcessingQueueItem{{ID: uuid.New(), Status: models.QueueStatusFailed, ErrorMessage: "max retries exceeded"}}
+	filters := models.ProcessingQueueFilters{Statuses: []string{models.QueueStatusFailed, models.QueueStatusDeadLettered}}
+	s.queueAdminService.EXPECT().ListItems(gomock.Any(), filters, 0, 20).Return(items, int64(1), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/queue/items", "", "")
+	s.Require().NoError(s.handler.ListItems(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.QueueItemListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Items, 1)
+	s.Equal("max retries exceeded", response.Items[0].ErrorMessage)
+	s.Equal(int64(1), response.Pagination.Total)
+}
+
+func (s *QueueAdminHandlerSuite) TestListItems_Filters() {
+	transactionID := uuid.New()
+	filters := models.ProcessingQueueFilters{
+		Statuses:      []string{models.QueueStatusPending},
+		TransactionID: &transactionID,
+		Operation:     models.QueueOperationReverse,
+	}
+	s.queueAdminService.EXPECT().ListItems(gomock.Any(), filters, 10, 10).Return(nil, int64(0), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/queue/items?status=pending&operation=reverse&transaction_id="+transactionID.String()+"&page=2&limit=10", "", "")
+	s.Require().NoError(s.handler.ListItems(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *QueueAdminHandlerSuite) TestListItems_InvalidFilters() {
+	for _, query := range []string{"status=lost", "operation=validate", "transaction_id=nope", "limit=101", "page=0"} {
+		c, rec := s.newContext(http.MethodGet, "/admin/queue/items?"+query, "", "")
+		s.Require().NoError(s.handler.ListItems(c))
+
+		s.Equal(http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func (s *QueueAdminHandlerSuite) TestGetItem_NotFound() {
+	id := uuid.New()
+	s.queueAdminService.EXPECT().GetItem(gomock.Any(), id).Return(nil, services.ErrQueueItemNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/queue/items/"+id.String(), "", id.String())
+	s.Require().NoError(s.handler.GetItem(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "QUEUE_001")
+}
+
+func (s *QueueAdminHandlerSuite) TestGetItem_InvalidID() {
+	c, rec := s.newContext(http.MethodGet, "/admin/queue/items/nope", "", "nope")
+	s.Require().NoError(s.handler.GetItem(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *QueueAdminHandlerSuite) TestRetryItem() {
+	id := uuid.New()
+
+	testCases := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{"accepted", nil, http.StatusAccepted},
+		{"invalid state", fmt.Errorf("%w: status is processing", services.ErrQueueItemInvalidState), http.StatusConflict},
+		{"not found", services.ErrQueueItemNotFound, http.StatusNotFound},
+		{"system error", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var item *models.ProcessingQueueItem
+			if tc.serviceErr == nil {
+				item = &models.ProcessingQueueItem{ID: id, Status: models.QueueStatusPending}
+			}
+			s.queueAdminService.EXPECT().RetryItem(gomock.Any(), s.adminID, id).Return(item, tc.serviceErr)
+
+			c, rec := s.newContext(http.MethodPost, "/admin/queue/items/"+id.String()+"/retry", "", id.String())
+			s.Require().NoError(s.handler.RetryItem(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *QueueAdminHandlerSuite) TestRescheduleItem() {
+	id := uuid.New()
+	scheduledAt := time.Date(2026, 3, 7, 9, 0, 0, 0, time.UTC)
+	s.queueAdminService.EXPECT().RescheduleItem(gomock.Any(), s.adminID, id, scheduledAt).
+		Return(&models.ProcessingQueueItem{ID: id, Status: models.QueueStatusPending, ScheduledAt: scheduledAt}, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/items/"+id.String()+"/reschedule", `{"scheduled_at":"2026-03-07T09:00:00Z"}`, id.String())
+	s.Require().NoError(s.handler.RescheduleItem(c))
+
+	s.Equal(http.StatusAccepted, rec.Code)
+	s.Contains(rec.Body.String(), "2026-03-07T09:00:00Z")
+}
+
+func (s *QueueAdminHandlerSuite) TestRescheduleItem_MissingTime() {
+	id := uuid.New()
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/items/"+id.String()+"/reschedule", `{}`, id.String())
+	s.Require().NoError(s.handler.RescheduleItem(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *QueueAdminHandlerSuite) TestRetryItems_ByStatus() {
+	id := uuid.New()
+	result := &dto.RetryQueueItemsResponse{Requeued: []uuid.UUID{id}, Skipped: []dto.QueueItemRetrySkip{}}
+	s.queueAdminService.EXPECT().RetryItems(gomock.Any(), s.adminID, &dto.RetryQueueItemsRequest{Status: models.QueueStatusDeadLettered}).Return(result, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/items/retry", `{"status":"dead_lettered"}`, "")
+	s.Require().NoError(s.handler.RetryItems(c))
+
+	s.Equal(http.StatusAccepted, rec.Code)
+	s.Contains(rec.Body.String(), id.String())
+}
+
+func (s *QueueAdminHandlerSuite) TestRetryItems_InvalidRequest() {
+	id := uuid.New()
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"empty", `{}`},
+		{"both ids and status", `{"item_ids":["` + id.String() + `"],"status":"failed"}`},
+		{"unsupported status", `{"status":"completed"}`},
+		{"malformed", `{`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			c, rec := s.newContext(http.MethodPost, "/admin/queue/items/retry", tc.body, "")
+			s.Require().NoError(s.handler.RetryItems(c))
+
+			s.Equal(http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func (s *QueueAdminHandlerSuite) TestCancelItem() {
+	id := uuid.New()
+	s.queueAdminService.EXPECT().CancelItem(gomock.Any(), s.adminID, id, "Duplicate").
+		Return(&models.ProcessingQueueItem{ID: id, Status: models.QueueStatusCancelled}, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/items/"+id.String()+"/cancel", `{"note":"Duplicate"}`, id.String())
+	s.Require().NoError(s.handler.CancelItem(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), models.QueueStatusCancelled)
+}
+
+func (s *QueueAdminHandlerSuite) TestCancelItem_NoteRequired() {
+	id := uuid.New()
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/items/"+id.String()+"/cancel", `{}`, id.String())
+	s.Require().NoError(s.handler.CancelItem(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *QueueAdminHandlerSuite) TestDeadLetterItem_InvalidState() {
+	id := uuid.New()
+	s.queueAdminService.EXPECT().DeadLetterItem(gomock.Any(), s.adminID, id, "Investigating").
+		Return(nil, fmt.Errorf("%w: status is completed", services.ErrQueueItemInvalidState))
+
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/items/"+id.String()+"/dead-letter", `{"note":"Investigating"}`, id.String())
+	s.Require().NoError(s.handler.DeadLetterItem(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "QUEUE_002")
+	s.Contains(rec.Body.String(), "status is completed")
+}
+
+func (s *QueueAdminHandlerSuite) TestPauseAndResumeWorkers() {
+	s.queueAdminService.EXPECT().PauseWorkers(gomock.Any(), s.adminID, "Ledger migration").
+		Return(&models.ProcessingQueueControl{Paused: true, PausedBy: &s.adminID, PauseReason: "Ledger migration"}, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/workers/pause", `{"reason":"Ledger migration"}`, "")
+	s.Require().NoError(s.handler.PauseWorkers(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"paused":true`)
+
+	s.queueAdminService.EXPECT().ResumeWorkers(gomock.Any(), s.adminID).Return(models.NewProcessingQueueControl(), nil)
+
+	c, rec = s.newContext(http.MethodPost, "/admin/queue/workers/resume", "", "")
+	s.Require().NoError(s.handler.ResumeWorkers(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"paused":false`)
+}
+
+func (s *QueueAdminHandlerSuite) TestPauseWorkers_ReasonRequired() {
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/workers/pause", `{}`, "")
+	s.Require().NoError(s.handler.PauseWorkers(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *QueueAdminHandlerSuite) TestCleanupCompleted() {
+	s.queueAdminService.EXPECT().CleanupCompleted(gomock.Any(), s.adminID, 168*time.Hour).Return(int64(250), nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/cleanup", `{"older_than_hours":168}`, "")
+	s.Require().NoError(s.handler.CleanupCompleted(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"deleted":250}`, rec.Body.String())
+}
+
+func (s *QueueAdminHandlerSuite) TestCleanupCompleted_InvalidAge() {
+	c, rec := s.newContext(http.MethodPost, "/admin/queue/cleanup", `{"older_than_hours":0}`, "")
+	s.Require().NoError(s.handler.CleanupCompleted(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
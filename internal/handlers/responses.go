@@ -1,8 +1,6 @@
 package handlers
 
 import (
-	"net/http"
-
 	"github.com/array/banking-api/internal/errors"
 	"github.com/labstack/echo/v4"
 )
@@ -70,5 +68,5 @@ func SendError(c echo.Context, code errors.ErrorCode, opts ...errors.ErrorOption
 func SendSystemError(c echo.Context, err error) error {
 	traceID := getTraceID(c)
 	errorResponse, _ := errors.WrapSystemError(err, traceID)
-	return c.JSON(http.StatusInternalServerError, errorResponse)
+	return c.JSON(errorResponse.GetHTTPStatus(), errorResponse)
 }
@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// SanctionsHandler handles admin review of sanctions screening matches and the loaded list
+type SanctionsHandler struct {
+	sanctionsService services.SanctionsScreeningServiceInterface
+}
+
+// NewSanctionsHandler creates a new sanctions handler
+func NewSanctionsHandler(sanctionsService services.SanctionsScreeningServiceInterface) *SanctionsHandler {
+	return &SanctionsHandler{
+		sanctionsService: sanctionsService,
+	}
+}
+
+// GetListStatus returns the sanctions list in use
+// @Summary Get sanctions list status (admin)
+// @Description Returns the version and size of the loaded sanctions list and when customers and payees were last rescreened against it.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.SanctionsListStatusResponse "List status retrieved successfully"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/sanctions/list [get]
+func (h *SanctionsHandler) GetListStatus(c echo.Context) error {
+	load, err := h.sanctionsService.GetListStatus(c.Request().Context())
+	if err != nil {
+		if stderrors.Is(err, services.ErrSanctionsListNotLoaded) {
+			return c.JSON(http.StatusOK, dto.SanctionsListStatusResponse{Loaded: false})
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toSanctionsListStatusResponse(load))
+}
+
+// Rescreen screens every customer and payee against the loaded list
+// @Summary Rescreen customers and payees (admin)
+// @Description Screens every customer and payee against the loaded sanctions list now. Rescreens run automatically when the list file changes; this is for recovering from a failed screening.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.SanctionsListStatusResponse "Rescreen completed"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 409 {object} errors.ErrorResponse "SANCTIONS_005 - Sanctions list not loaded"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/sanctions/rescreen [post]
+func (h *SanctionsHandler) Rescreen(c echo.Context) error {
+	load, err := h.sanctionsService.Rescreen(c.Request().Context())
+	if err != nil {
+		return mapSanctionsErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toSanctionsListStatusResponse(load))
+}
+
+// ListMatches lists sanctions screening matches
+// @Summary List sanctions matches (admin)
+// @Description Lists potential sanctions list matches of customer and payee names, newest first.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status (open, cleared, confirmed)"
+// @Param subject_type query string false "Filter by subject type (user, external_account)"
+// @Param user_id query string false "Filter by customer ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.SanctionsMatchListResponse "Matches retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid filter or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/sanctions/matches [get]
+func (h *SanctionsHandler) ListMatches(c echo.Context) error {
+	var filters models.SanctionsMatchFilters
+
+	switch status := c.QueryParam("status"); status {
+	case "":
+	case models.SanctionsMatchStatusOpen, models.SanctionsMatchStatusCleared, models.SanctionsMatchStatusConfirmed:
+		filters.Status = status
+	default:
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("status: must be one of open, cleared, confirmed"))
+	}
+
+	switch subjectType := c.QueryParam("subject_type"); subjectType {
+	case "":
+	case models.SanctionsSubjectUser, models.SanctionsSubjectExternalAccount:
+		filters.SubjectType = subjectType
+	default:
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("subject_type: must be one of user, external_account"))
+	}
+
+	if userIDParam := c.QueryParam("user_id"); userIDParam != "" {
+		userID, err := uuid.Parse(userIDParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("user_id: must be a valid UUID"))
+		}
+		filters.UserID = &userID
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	matches, total, err := h.sanctionsService.ListMatches(c.Request().Context(), filters, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.SanctionsMatchListResponse{
+		Matches: make([]dto.SanctionsMatchResponse, len(matches)),
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+	for i := range matches {
+		response.Matches[i] = toSanctionsMatchResponse(&matches[i])
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetMatch returns a sanctions screening match
+// @Summary Get sanctions match (admin)
+// @Description Returns a potential sanctions list match with the screened name and the list entry it resembles.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Match ID (UUID)"
+// @Success 200 {object} dto.SanctionsMatchResponse "Match retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid match ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "SANCTIONS_003 - Match not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/sanctions/matches/{id} [get]
+func (h *SanctionsHandler) GetMatch(c echo.Context) error {
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid match ID"))
+	}
+
+	match, err := h.sanctionsService.GetMatch(c.Request().Context(), matchID)
+	if err != nil {
+		return mapSanctionsErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toSanctionsMatchResponse(match))
+}
+
+// ClearMatch dismisses a sanctions match as a false positive
+// @Summary Clear sanctions match (admin)
+// @Description Dismisses an open match as a false positive. The customer or payee is released once none of its matches remain open or confirmed. The same list entry is not raised again for the same name.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID (UUID)"
+// @Param request body dto.ReviewSanctionsMatchRequest true "Review note"
+// @Success 200 {object} dto.SanctionsMatchResponse "Match cleared"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Note is required, VALIDATION_003 - Invalid match ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "SANCTIONS_003 - Match not found"
+// @Failure 409 {object} errors.ErrorResponse "SANCTIONS_004 - Match already reviewed"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/sanctions/matches/{id}/clear [post]
+func (h *SanctionsHandler) ClearMatch(c echo.Context) error {
+	return h.review(c, h.sanctionsService.ClearMatch)
+}
+
+// ConfirmMatch records a sanctions match as a true match
+// @Summary Confirm sanctions match (admin)
+// @Description Records an open match as a true match. The customer or payee stays blocked.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Match ID (UUID)"
+// @Param request body dto.ReviewSanctionsMatchRequest true "Review note"
+// @Success 200 {object} dto.SanctionsMatchResponse "Match confirmed"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Note is required, VALIDATION_003 - Invalid match ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "SANCTIONS_003 - Match not found"
+// @Failure 409 {object} errors.ErrorResponse "SANCTIONS_004 - Match already reviewed"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/sanctions/matches/{id}/confirm [post]
+func (h *SanctionsHandler) ConfirmMatch(c echo.Context) error {
+	return h.review(c, h.sanctionsService.ConfirmMatch)
+}
+
+type sanctionsReviewFunc func(ctx context.Context, adminID, matchID uuid.UUID, note string) (*models.SanctionsMatch, error)
+
+func (h *SanctionsHandler) review(c echo.Context, reviewFn sanctionsReviewFunc) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	matchID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid match ID"))
+	}
+
+	var req dto.ReviewSanctionsMatchRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	match, err := reviewFn(c.Request().Context(), adminID, matchID, req.Note)
+	if err != nil {
+		return mapSanctionsErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toSanctionsMatchResponse(match))
+}
+
+func mapSanctionsErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrSanctionsMatchNotFound):
+		return SendError(c, errors.SanctionsMatchNotFound)
+	case stderrors.Is(err, services.ErrSanctionsMatchNotOpen):
+		return SendError(c, errors.SanctionsMatchNotOpen, errors.WithDetails(err.Error()))
+	case stderrors.Is(err, services.ErrSanctionsListNotLoaded):
+		return SendError(c, errors.SanctionsListNotLoaded)
+	}
+	return SendSystemError(c, err)
+}
+
+func toSanctionsMatchResponse(match *models.SanctionsMatch) dto.SanctionsMatchResponse {
+	return dto.SanctionsMatchResponse{
+		ID:           match.ID,
+		SubjectType:  match.SubjectType,
+		SubjectID:    match.SubjectID,
+		UserID:       match.UserID,
+		ScreenedName: match.ScreenedName,
+		EntryID:      match.EntryID,
+		EntryName:    match.EntryName,
+		EntryType:    match.EntryType,
+		Programs:     match.Programs,
+		Similarity:   match.Similarity,
+		Action:       match.Action,
+		Status:       match.Status,
+		ListVersion:  match.ListVersion,
+		ReviewedBy:   match.ReviewedBy,
+		ReviewedAt:   match.ReviewedAt,
+		ReviewNote:   match.ReviewNote,
+		CreatedAt:    match.CreatedAt,
+	}
+}
+
+func toSanctionsListStatusResponse(load *models.SanctionsListLoad) dto.SanctionsListStatusResponse {
+	return dto.SanctionsListStatusResponse{
+		Loaded:        true,
+		Version:       load.Version,
+		EntryCount:    load.EntryCount,
+		LoadedAt:      &load.LoadedAt,
+		RescreenedAt:  load.RescreenedAt,
+		SubjectsCount: load.SubjectsCount,
+		MatchesCount:  load.MatchesCount,
+	}
+}
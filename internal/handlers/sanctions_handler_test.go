@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type SanctionsHandlerSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	sanctionsService *service_mocks.MockSanctionsScreeningServiceInterface
+	handler          *SanctionsHandler
+	echo             *echo.Echo
+	adminID          uuid.UUID
+}
+
+func (s *SanctionsHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.sanctionsService = service_mocks.NewMockSanctionsScreeningServiceInterface(s.ctrl)
+	s.handler = NewSanctionsHandler(s.sanctionsService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *SanctionsHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestSanctionsHandlerSuite(t *testing.T) {
+	suite.Run(t, new(SanctionsHandlerSuite))
+}
+
+func (s *SanctionsHandlerSuite) newContext(method, target, body string, paramName, paramValue string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	if paramName != "" {
+		c.SetParamNames(paramName)
+		c.SetParamValues(paramValue)
+	}
+	return c, rec
+}
+
+func (s *SanctionsHandlerSuite) openMatch() *models.SanctionsMatch {
+	return &models.SanctionsMatch{
+		ID:           uuid.New(),
+		SubjectType:  models.SanctionsSubjectUser,
+		SubjectID:    uuid.New(),
+		ScreenedName: "Maria Santos",
+		EntryID:      "7000",
+		EntryName:    "SANTOS, Maria",
+		EntryType:    "individual",
+		Similarity:   100,
+		Action:       models.SanctionsMatchActionBlock,
+		Status:       models.SanctionsMatchStatusOpen,
+	}
+}
+
+func (s *SanctionsHandlerSuite) TestGetListStatus() {
+	rescreenedAt := time.Now()
+	s.sanctionsService.EXPECT().GetListStatus(gomock.Any()).Return(&models.SanctionsListLoad{
+		Version: "abc123", EntryCount: 4, LoadedAt: time.Now(), RescreenedAt: &rescreenedAt, SubjectsCount: 10, MatchesCount: 1,
+	}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/sanctions/list", "", "", "")
+	s.Require().NoError(s.handler.GetListStatus(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.SanctionsListStatusResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.True(response.Loaded)
+	s.Equal("abc123", response.Version)
+	s.Equal(10, response.SubjectsCount)
+	s.NotNil(response.RescreenedAt)
+}
+
+func (s *SanctionsHandlerSuite) TestGetListStatus_NotLoaded() {
+	s.sanctionsService.EXPECT().GetListStatus(gomock.Any()).Return(nil, services.ErrSanctionsListNotLoaded)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/sanctions/list", "", "", "")
+	s.Require().NoError(s.handler.GetListStatus(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"loaded":false`)
+}
+
+func (s *SanctionsHandlerSuite) TestRescreen_NotLoaded() {
+	s.sanctionsService.EXPECT().Rescreen(gomock.Any()).Return(nil, services.ErrSanctionsListNotLoaded)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/sanctions/rescreen", "", "", "")
+	s.Require().NoError(s.handler.Rescreen(c))
+
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Contains(rec.Body.String(), "SANCTIONS_005")
+}
+
+func (s *SanctionsHandlerSuite) TestListMatches_Filters() {
+	userID := uuid.New()
+	filters := models.SanctionsMatchFilters{
+		Status:      models.SanctionsMatchStatusOpen,
+		SubjectType: models.SanctionsSubjectExternalAccount,
+		UserID:      &userID,
+	}
+	s.sanctionsService.EXPECT().ListMatches(gomock.Any(), filters, 20, 20).Return([]models.SanctionsMatch{*s.openMatch()}, int64(21), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/sanctions/matches?status=open&subject_type=external_account&page=2&user_id="+userID.String(), "", "", "")
+	s.Require().NoError(s.handler.ListMatches(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.SanctionsMatchListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Matches, 1)
+	s.Equal("SANTOS, Maria", response.Matches[0].EntryName)
+	s.Equal(int64(21), response.Pagination.Total)
+}
+
+func (s *SanctionsHandlerSuite) TestListMatches_InvalidFilters() {
+	for _, query := range []string{"status=pending", "subject_type=account", "user_id=abc", "page=0", "limit=101"} {
+		s.Run(query, func() {
+			c, rec := s.newContext(http.MethodGet, "/admin/sanctions/matches?"+query, "", "", "")
+			s.Require().NoError(s.handler.ListMatches(c))
+
+			s.Equal(http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func (s *SanctionsHandlerSuite) TestGetMatch_NotFound() {
+	id := uuid.New()
+	s.sanctionsService.EXPECT().GetMatch(gomock.Any(), id).Return(nil, services.ErrSanctionsMatchNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/sanctions/matches/"+id.String(), "", "id", id.String())
+	s.Require().NoError(s.handler.GetMatch(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "SANCTIONS_003")
+}
+
+func (s *SanctionsHandlerSuite) TestClearMatch() {
+	match := s.openMatch()
+	s.sanctionsService.EXPECT().ClearMatch(gomock.Any(), s.adminID, match.ID, "Different date of birth").DoAndReturn(
+		func(_ interface{}, adminID, _ uuid.UUID, note string) (*models.SanctionsMatch, error) {
+			match.Clear(adminID, note)
+			return match, nil
+		})
+
+	c, rec := s.newContext(http.MethodPost, "/admin/sanctions/matches/"+match.ID.String()+"/clear", `{"note":"Different date of birth"}`, "id", match.ID.String())
+	s.Require().NoError(s.handler.ClearMatch(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.SanctionsMatchResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(models.SanctionsMatchStatusCleared, response.Status)
+	s.Equal(s.adminID, *response.ReviewedBy)
+}
+
+func (s *SanctionsHandlerSuite) TestConfirmMatch() {
+	match := s.openMatch()
+	s.sanctionsService.EXPECT().ConfirmMatch(gomock.Any(), s.adminID, match.ID, "Confirmed").DoAndReturn(
+		func(_ interface{}, adminID, _ uuid.UUID, note string) (*models.SanctionsMatch, error) {
+			match.Confirm(adminID, note)
+			return match, nil
+		})
+
+	c, rec := s.newContext(http.MethodPost, "/admin/sanctions/matches/"+match.ID.String()+"/confirm", `{"note":"Confirmed"}`, "id", match.ID.String())
+	s.Require().NoError(s.handler.ConfirmMatch(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"status":"confirmed"`)
+}
+
+func (s *SanctionsHandlerSuite) TestReviewMatch_Errors() {
+	id := uuid.New()
+	testCases := []struct {
+		name           string
+		param          string
+		body           string
+		serviceErr     error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"invalid id", "abc", `{"note":"ok"}`, nil, http.StatusBadRequest, "VALIDATION_003"},
+		{"missing note", id.String(), `{}`, nil, http.StatusBadRequest, "VALIDATION_001"},
+		{"not found", id.String(), `{"note":"ok"}`, services.ErrSanctionsMatchNotFound, http.StatusNotFound, "SANCTIONS_003"},
+		{"already reviewed", id.String(), `{"note":"ok"}`, fmt.Errorf("%w: status is cleared", services.ErrSanctionsMatchNotOpen), http.StatusConflict, "SANCTIONS_004"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.serviceErr != nil {
+				s.sanctionsService.EXPECT().ClearMatch(gomock.Any(), s.adminID, id, "ok").Return(nil, tc.serviceErr)
+			}
+
+			c, rec := s.newContext(http.MethodPost, "/admin/sanctions/matches/"+tc.param+"/clear", tc.body, "id", tc.param)
+			s.Require().NoError(s.handler.ClearMatch(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+			s.Contains(rec.Body.String(), tc.expectedCode)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// StuckTransferHandler handles the admin queue of external transfers that never resolved
+type StuckTransferHandler struct {
+	transferMonitorService services.TransferMonitorServiceInterface
+}
+
+// NewStuckTransferHandler creates a new stuck transfer handler
+func NewStuckTransferHandler(transferMonitorService services.TransferMonitorServiceInterface) *StuckTransferHandler {
+	return &StuckTransferHandler{
+		transferMonitorService: transferMonitorService,
+	}
+}
+
+// ListStuckTransfers lists escalated external transfers
+// @Summary List stuck external transfers (admin)
+// @Description Lists external transfers that stayed pending past the maximum age without a status callback or a resolved poll. They are no longer polled until requeued.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.TransferHistoryResponse "Stuck transfers retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transfers/stuck [get]
+func (h *StuckTransferHandler) ListStuckTransfers(c echo.Context) error {
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	transfers, total, err := h.transferMonitorService.ListEscalatedTransfers(c.Request().Context(), (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.TransferHistoryResponse{
+		Transfers: transfers,
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	})
+}
+
+// RequeueStuckTransfer returns a stuck transfer to status polling
+// @Summary Requeue stuck external transfer (admin)
+// @Description Clears the escalation and backoff of a stuck transfer so the transfer monitor checks it with Northwind on its next run.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param transferId path string true "Transfer ID (UUID)"
+// @Success 200 {object} models.Transfer "Transfer requeued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid transfer ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "TRANSFER_004 - Transfer not found"
+// @Failure 409 {object} errors.ErrorResponse "TRANSFER_007 - Transfer is not in the stuck queue"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transfers/{transferId}/requeue [post]
+func (h *StuckTransferHandler) RequeueStuckTransfer(c echo.Context) error {
+	transferID, err := uuid.Parse(c.Param("transferId"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid transfer ID"))
+	}
+
+	transfer, err := h.transferMonitorService.RequeueEscalatedTransfer(c.Request().Context(), transferID)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, services.ErrTransferNotFound):
+			return SendError(c, errors.TransferNotFound)
+		case stderrors.Is(err, services.ErrTransferNotEscalated):
+			return SendError(c, errors.TransferNotEscalated)
+		}
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, transfer)
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type StuckTransferHandlerSuite struct {
+	suite.Suite
+	ctrl                   *gomock.Controller
+	transferMonitorService *service_mocks.MockTransferMonitorServiceInterface
+	handler                *StuckTransferHandler
+	echo                   *echo.Echo
+}
+
+func (s *StuckTransferHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.transferMonitorService = service_mocks.NewMockTransferMonitorServiceInterface(s.ctrl)
+	s.handler = NewStuckTransferHandler(s.transferMonitorService)
+	s.echo = echo.New()
+}
+
+func (s *StuckTransferHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestStuckTransferHandlerSuite(t *testing.T) {
+	suite.Run(t, new(StuckTransferHandlerSuite))
+}
+
+func (s *StuckTransferHandlerSuite) newContext(method, target string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", uuid.New())
+	return c, rec
+}
+
+func (s *StuckTransferHandlerSuite) TestListStuckTransfers() {
+	escalatedAt := time.Now()
+	transfers := []models.Transfer{{ID: uuid.New(), Status: models.TransferStatusProcessing, EscalatedAt: &escalatedAt}}
+	s.transferMonitorService.EXPECT().ListEscalatedTransfers(gomock.Any(), 10, 10).Return(transfers, int64(11), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/transfers/stuck?page=2&limit=10")
+	s.Require().NoError(s.handler.ListStuckTransfers(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.TransferHistoryResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Len(response.Transfers, 1)
+	s.NotNil(response.Transfers[0].EscalatedAt)
+	s.Equal(int64(11), response.Pagination.Total)
+}
+
+func (s *StuckTransferHandlerSuite) TestListStuckTransfers_InvalidLimit() {
+	c, rec := s.newContext(http.MethodGet, "/admin/transfers/stuck?limit=500")
+	s.Require().NoError(s.handler.ListStuckTransfers(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *StuckTransferHandlerSuite) TestRequeueStuckTransfer() {
+	transferID := uuid.New()
+
+	testCases := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"success", nil, http.StatusOK, ""},
+		{"not found", services.ErrTransferNotFound, http.StatusNotFound, "TRANSFER_004"},
+		{"not escalated", services.ErrTransferNotEscalated, http.StatusConflict, "TRANSFER_007"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var transfer *models.Transfer
+			if tc.serviceErr == nil {
+				transfer = &models.Transfer{ID: transferID, Status: models.TransferStatusProcessing}
+			}
+			s.transferMonitorService.EXPECT().RequeueEscalatedTransfer(gomock.Any(), transferID).Return(transfer, tc.serviceErr)
+
+			c, rec := s.newContext(http.MethodPost, "/admin/transfers/"+transferID.String()+"/requeue")
+			c.SetParamNames("transferId")
+			c.SetParamValues(transferID.String())
+			s.Require().NoError(s.handler.RequeueStuckTransfer(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				s.Contains(rec.Body.String(), tc.expectedCode)
+			}
+		})
+	}
+}
+
+func (s *StuckTransferHandlerSuite) TestRequeueStuckTransfer_InvalidID() {
+	c, rec := s.newContext(http.MethodPost, "/admin/transfers/bad/requeue")
+	c.SetParamNames("transferId")
+	c.SetParamValues("bad")
+	s.Require().NoError(s.handler.RequeueStuckTransfer(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -116,7 +116,7 @@ func (h *TransactionHandler) ListTransactions(c echo.Context) error {
 		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid account ID"))
 	}
 
-	account, err := h.accountRepo.GetByID(accountID)
+	account, err := h.accountRepo.GetByID(c.Request().Context(), accountID)
 	if err != nil {
 		if err == repositories.ErrAccountNotFound {
 			return SendError(c, errors.AccountNotFound)
@@ -148,7 +148,7 @@ func (h *TransactionHandler) ListTransactions(c echo.Context) error {
 		storeCursorTransactionIdForExclusion(c, cursorID)
 	}
 
-	transactions, total, err := h.transactionRepo.GetWithFilters(filters)
+	transactions, total, err := h.transactionRepo.GetWithFilters(c.Request().Context(), filters)
 	if err != nil {
 		return SendSystemError(c, err)
 	}
@@ -347,7 +347,7 @@ func (h *TransactionHandler) GetTransaction(c echo.Context) error {
 		return SendError(c, errors.AccountInvalidNumber, errors.WithDetails("Account ID must be a valid UUID"))
 	}
 
-	account, err := h.accountRepo.GetByID(accountID)
+	account, err := h.accountRepo.GetByID(c.Request().Context(), accountID)
 	if err != nil {
 		if err == repositories.ErrAccountNotFound {
 			return SendError(c, errors.AccountNotFound)
@@ -365,7 +365,7 @@ func (h *TransactionHandler) GetTransaction(c echo.Context) error {
 		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Transaction ID must be a valid UUID"))
 	}
 
-	transaction, err := h.transactionRepo.GetByID(transactionID)
+	transaction, err := h.transactionRepo.GetByID(c.Request().Context(), transactionID)
 	if err != nil {
 		if err == repositories.ErrTransactionNotFound {
 			return SendError(c, errors.TransactionNotFound)
@@ -124,11 +124,11 @@ func (s *TransactionHandlerTestSuite) TestListTransactions_FirstPage() {
 
 	// Setup expectations
 	s.mockAccountRepo.EXPECT().
-		GetByID(s.accountID).
+		GetByID(gomock.Any(), s.accountID).
 		Return(account, nil)
 
 	s.mockTransactionRepo.EXPECT().
-		GetWithFilters(gomock.Any()).
+		GetWithFilters(gomock.Any(), gomock.Any()).
 		Return(transactions, int64(21), nil)
 
 	// Create request
@@ -202,11 +202,11 @@ func (s *TransactionHandlerTestSuite) TestListTransactions_WithCursor() {
 
 	// Setup expectations
 	s.mockAccountRepo.EXPECT().
-		GetByID(s.accountID).
+		GetByID(gomock.Any(), s.accountID).
 		Return(account, nil)
 
 	s.mockTransactionRepo.EXPECT().
-		GetWithFilters(gomock.Any()).
+		GetWithFilters(gomock.Any(), gomock.Any()).
 		Return(transactions, int64(30), nil)
 
 	// Create cursor
@@ -258,11 +258,11 @@ func (s *TransactionHandlerTestSuite) TestListTransactions_EmptyResults() {
 
 	// Setup expectations - return empty results
 	s.mockAccountRepo.EXPECT().
-		GetByID(s.accountID).
+		GetByID(gomock.Any(), s.accountID).
 		Return(account, nil)
 
 	s.mockTransactionRepo.EXPECT().
-		GetWithFilters(gomock.Any()).
+		GetWithFilters(gomock.Any(), gomock.Any()).
 		Return([]models.Transaction{}, int64(0), nil)
 
 	// Create request
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// TransferReviewHandler handles the admin queue of transfers held for manual review
+type TransferReviewHandler struct {
+	reviewService  services.TransferReviewServiceInterface
+	accountService services.AccountServiceInterface
+}
+
+// NewTransferReviewHandler creates a new transfer review handler
+func NewTransferReviewHandler(reviewService services.TransferReviewServiceInterface, accountService services.AccountServiceInterface) *TransferReviewHandler {
+	return &TransferReviewHandler{
+		reviewService:  reviewService,
+		accountService: accountService,
+	}
+}
+
+// ListReviews lists transfers held for review
+// @Summary List transfer reviews (admin)
+// @Description Lists transfers held for manual review, earliest SLA deadline first. Funds of pending reviews are reserved on the source account.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status (pending, approved, rejected)"
+// @Param user_id query string false "Filter by customer ID (UUID)"
+// @Param type query string false "Filter by transfer type (internal, external)"
+// @Param overdue query bool false "Only pending reviews past their SLA deadline"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.TransferReviewListResponse "Reviews retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid filter or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transfer-reviews [get]
+func (h *TransferReviewHandler) ListReviews(c echo.Context) error {
+	var filters models.TransferReviewFilters
+	now := time.Now()
+
+	switch status := c.QueryParam("status"); status {
+	case "":
+	case models.TransferReviewStatusPending, models.TransferReviewStatusApproved, models.TransferReviewStatusRejected:
+		filters.Status = status
+	default:
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("status: must be one of pending, approved, rejected"))
+	}
+
+	if userIDParam := c.QueryParam("user_id"); userIDParam != "" {
+		userID, err := uuid.Parse(userIDParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("user_id: must be a valid UUID"))
+		}
+		filters.UserID = &userID
+	}
+
+	switch transferType := c.QueryParam("type"); transferType {
+	case "":
+	case "internal", "external":
+		external := transferType == "external"
+		filters.External = &external
+	default:
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("type: must be one of internal, external"))
+	}
+
+	if overdueParam := c.QueryParam("overdue"); overdueParam != "" {
+		overdue, err := strconv.ParseBool(overdueParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("overdue: must be true or false"))
+		}
+		if overdue {
+			filters.OverdueAt = &now
+		}
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	reviews, total, err := h.reviewService.ListReviews(c.Request().Context(), filters, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.TransferReviewListResponse{
+		Reviews: make([]dto.TransferReviewResponse, len(reviews)),
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+	for i := range reviews {
+		response.Reviews[i] = toTransferReviewResponse(&reviews[i], now)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetReview returns a held transfer with the customer's recent activity
+// @Summary Get transfer review (admin)
+// @Description Returns a transfer held for review, the triggers that held it and the customer's recent transfers and transactions.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Review ID (UUID)"
+// @Success 200 {object} dto.TransferReviewDetailResponse "Review retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid review ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "REVIEW_001 - Review not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transfer-reviews/{id} [get]
+func (h *TransferReviewHandler) GetReview(c echo.Context) error {
+	reviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid review ID"))
+	}
+
+	ctx := c.Request().Context()
+	review, err := h.reviewService.GetReview(ctx, reviewID)
+	if err != nil {
+		return mapTransferReviewErr(c, err)
+	}
+
+	activity, err := h.reviewService.GetCustomerActivity(ctx, review.UserID)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.TransferReviewDetailResponse{
+		TransferReviewResponse: toTransferReviewResponse(review, time.Now()),
+		Activity:               activity,
+	})
+}
+
+// ApproveReview releases a held transfer
+// @Summary Approve held transfer (admin)
+// @Description Executes a transfer held for review. Internal transfers complete immediately; external transfers are submitted to the banking partner. A note is required.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID (UUID)"
+// @Param request body dto.DecideTransferReviewRequest true "Review note"
+// @Success 200 {object} dto.TransferReviewResponse "Transfer approved"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Note is required, VALIDATION_003 - Invalid review ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "REVIEW_001 - Review not found"
+// @Failure 409 {object} errors.ErrorResponse "REVIEW_002 - Review already decided"
+// @Failure 422 {object} errors.ErrorResponse "ACCOUNT_002 - Destination account no longer active"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transfer-reviews/{id}/approve [post]
+func (h *TransferReviewHandler) ApproveReview(c echo.Context) error {
+	return h.decide(c, h.accountService.ApproveHeldTransfer)
+}
+
+// RejectReview rejects a held transfer
+// @Summary Reject held transfer (admin)
+// @Description Fails a transfer held for review and credits the reserved funds back to the source account. A note is required.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Review ID (UUID)"
+// @Param request body dto.DecideTransferReviewRequest true "Review note"
+// @Success 200 {object} dto.TransferReviewResponse "Transfer rejected"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Note is required, VALIDATION_003 - Invalid review ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "REVIEW_001 - Review not found"
+// @Failure 409 {object} errors.ErrorResponse "REVIEW_002 - Review already decided"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/transfer-reviews/{id}/reject [post]
+func (h *TransferReviewHandler) RejectReview(c echo.Context) error {
+	return h.decide(c, h.reviewService.Reject)
+}
+
+type transferReviewDecisionFunc func(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error)
+
+func (h *TransferReviewHandler) decide(c echo.Context, decideFn transferReviewDecisionFunc) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	reviewID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid review ID"))
+	}
+
+	var req dto.DecideTransferReviewRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	review, err := decideFn(c.Request().Context(), adminID, reviewID, req.Note)
+	if err != nil {
+		return mapTransferReviewErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toTransferReviewResponse(review, time.Now()))
+}
+
+func mapTransferReviewErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrTransferReviewNotFound):
+		return SendError(c, errors.TransferReviewNotFound)
+	case stderrors.Is(err, services.ErrTransferReviewDecided):
+		return SendError(c, errors.TransferReviewDecided)
+	case stderrors.Is(err, services.ErrAccountNotActive):
+		return SendError(c, errors.AccountInactive, errors.WithDetails("Destination account is no longer active; reject the transfer to release the funds"))
+	}
+	return SendSystemError(c, err)
+}
+
+func toTransferReviewResponse(review *models.TransferReview, now time.Time) dto.TransferReviewResponse {
+	// Reasons hold the trigger results under "reasons".
+	var reasons struct {
+		Reasons []dto.TransferReviewReason `json:"reasons"`
+	}
+	if raw, err := json.Marshal(review.Reasons); err == nil {
+		_ = json.Unmarshal(raw, &reasons)
+	}
+	if reasons.Reasons == nil {
+		reasons.Reasons = []dto.TransferReviewReason{}
+	}
+
+	return dto.TransferReviewResponse{
+		ID:         review.ID,
+		TransferID: review.TransferID,
+		UserID:     review.UserID,
+		Status:     review.Status,
+		Reasons:    reasons.Reasons,
+		Transfer:   review.Transfer,
+		DueAt:      review.DueAt,
+		Overdue:    review.IsOverdue(now),
+		ReviewedBy: review.ReviewedBy,
+		ReviewedAt: review.ReviewedAt,
+		ReviewNote: review.ReviewNote,
+		CreatedAt:  review.CreatedAt,
+	}
+}
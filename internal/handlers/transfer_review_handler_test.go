@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransferReviewHandlerSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	reviewService  *service_mocks.MockTransferReviewServiceInterface
+	accountService *service_mocks.MockAccountServiceInterface
+	handler        *TransferReviewHandler
+	echo           *echo.Echo
+	adminID        uuid.UUID
+}
+
+func (s *TransferReviewHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reviewService = service_mocks.NewMockTransferReviewServiceInterface(s.ctrl)
+	s.accountService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
+	s.handler = NewTransferReviewHandler(s.reviewService, s.accountService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *TransferReviewHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestTransferReviewHandlerSuite(t *testing.T) {
+	suite.Run(t, new(TransferReviewHandlerSuite))
+}
+
+func (s *TransferReviewHandlerSuite) newContext(method, target, body string, paramName, paramValue string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	if paramName != "" {
+		c.SetParamNames(paramName)
+		c.SetParamValues(paramValue)
+	}
+	return c, rec
+}
+
+func (s *TransferReviewHandlerSuite) pendingReview(dueAt time.Time) *models.TransferReview {
+	transferID := uuid.New()
+	return &models.TransferReview{
+		ID:         uuid.New(),
+		TransferID: transferID,
+		UserID:     uuid.New(),
+		Status:     models.TransferReviewStatusPending,
+		Reasons: models.JSONBMap{"reasons": []dto.TransferReviewReason{
+			{Trigger: services.TransferReviewTriggerAmountThreshold, Reason: "amount 30000.00 is at or above the review threshold of 25000.00"},
+		}},
+		DueAt: dueAt,
+		Transfer: models.Transfer{
+			ID:     transferID,
+			Amount: decimal.NewFromInt(30000),
+			Status: models.TransferStatusHeldForReview,
+		},
+	}
+}
+
+func (s *TransferReviewHandlerSuite) TestListReviews_Filters() {
+	userID := uuid.New()
+	external := true
+	filters := models.TransferReviewFilters{
+		Status:   models.TransferReviewStatusPending,
+		UserID:   &userID,
+		External: &external,
+	}
+	s.reviewService.EXPECT().ListReviews(gomock.Any(), filters, 20, 20).Return([]models.TransferReview{*s.pendingReview(time.Now().Add(-time.Hour))}, int64(21), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/transfer-reviews?status=pending&type=external&page=2&user_id="+userID.String(), "", "", "")
+	s.Require().NoError(s.handler.ListReviews(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.TransferReviewListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Reviews, 1)
+	s.True(response.Reviews[0].Overdue)
+	s.Require().Len(response.Reviews[0].Reasons, 1)
+	s.Equal(services.TransferReviewTriggerAmountThreshold, response.Reviews[0].Reasons[0].Trigger)
+	s.Equal(models.TransferStatusHeldForReview, response.Reviews[0].Transfer.Status)
+	s.Equal(int64(21), response.Pagination.Total)
+}
+
+func (s *TransferReviewHandlerSuite) TestListReviews_Overdue() {
+	s.reviewService.EXPECT().ListReviews(gomock.Any(), gomock.Any(), 0, 20).DoAndReturn(
+		func(_ interface{}, filters models.TransferReviewFilters, _, _ int) ([]models.TransferReview, int64, error) {
+			s.Require().NotNil(filters.OverdueAt)
+			s.WithinDuration(time.Now(), *filters.OverdueAt, time.Minute)
+			return nil, 0, nil
+		})
+
+	c, rec := s.newContext(http.MethodGet, "/admin/transfer-reviews?overdue=true", "", "", "")
+	s.Require().NoError(s.handler.ListReviews(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"reviews":[]`)
+}
+
+func (s *TransferReviewHandlerSuite) TestListReviews_InvalidFilters() {
+	for _, query := range []string{"status=open", "type=wire", "user_id=abc", "overdue=maybe", "page=0", "limit=101"} {
+		s.Run(query, func() {
+			c, rec := s.newContext(http.MethodGet, "/admin/transfer-reviews?"+query, "", "", "")
+			s.Require().NoError(s.handler.ListReviews(c))
+
+			s.Equal(http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func (s *TransferReviewHandlerSuite) TestGetReview_IncludesActivity() {
+	review := s.pendingReview(time.Now().Add(time.Hour))
+	s.reviewService.EXPECT().GetReview(gomock.Any(), review.ID).Return(review, nil)
+	s.reviewService.EXPECT().GetCustomerActivity(gomock.Any(), review.UserID).Return(&dto.TransferReviewActivity{
+		RecentTransfers:    []models.Transfer{review.Transfer},
+		RecentTransactions: []models.Transaction{{ID: uuid.New()}},
+	}, nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/transfer-reviews/"+review.ID.String(), "", "id", review.ID.String())
+	s.Require().NoError(s.handler.GetReview(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.TransferReviewDetailResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(review.ID, response.ID)
+	s.False(response.Overdue)
+	s.Require().NotNil(response.Activity)
+	s.Len(response.Activity.RecentTransfers, 1)
+	s.Len(response.Activity.RecentTransactions, 1)
+}
+
+func (s *TransferReviewHandlerSuite) TestGetReview_NotFound() {
+	id := uuid.New()
+	s.reviewService.EXPECT().GetReview(gomock.Any(), id).Return(nil, services.ErrTransferReviewNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/transfer-reviews/"+id.String(), "", "id", id.String())
+	s.Require().NoError(s.handler.GetReview(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "REVIEW_001")
+}
+
+func (s *TransferReviewHandlerSuite) TestApproveReview() {
+	review := s.pendingReview(time.Now().Add(time.Hour))
+	s.accountService.EXPECT().ApproveHeldTransfer(gomock.Any(), s.adminID, review.ID, "Customer confirmed by phone").DoAndReturn(
+		func(_ interface{}, adminID, _ uuid.UUID, note string) (*models.TransferReview, error) {
+			reviewedAt := time.Now()
+			review.Status = models.TransferReviewStatusApproved
+			review.ReviewedBy = &adminID
+			review.ReviewedAt = &reviewedAt
+			review.ReviewNote = note
+			review.Transfer.Status = models.TransferStatusCompleted
+			return review, nil
+		})
+
+	c, rec := s.newContext(http.MethodPost, "/admin/transfer-reviews/"+review.ID.String()+"/approve", `{"note":"Customer confirmed by phone"}`, "id", review.ID.String())
+	s.Require().NoError(s.handler.ApproveReview(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.TransferReviewResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(models.TransferReviewStatusApproved, response.Status)
+	s.Equal(s.adminID, *response.ReviewedBy)
+	s.Equal(models.TransferStatusCompleted, response.Transfer.Status)
+}
+
+func (s *TransferReviewHandlerSuite) TestRejectReview() {
+	review := s.pendingReview(time.Now().Add(time.Hour))
+	s.reviewService.EXPECT().Reject(gomock.Any(), s.adminID, review.ID, "Customer did not confirm").DoAndReturn(
+		func(_ interface{}, _, _ uuid.UUID, note string) (*models.TransferReview, error) {
+			review.Status = models.TransferReviewStatusRejected
+			review.ReviewNote = note
+			review.Transfer.Status = models.TransferStatusFailed
+			return review, nil
+		})
+
+	c, rec := s.newContext(http.MethodPost, "/admin/transfer-reviews/"+review.ID.String()+"/reject", `{"note":"Customer did not confirm"}`, "id", review.ID.String())
+	s.Require().NoError(s.handler.RejectReview(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"status":"rejected"`)
+}
+
+func (s *TransferReviewHandlerSuite) TestApproveReview_Errors() {
+	id := uuid.New()
+	testCases := []struct {
+		name           string
+		param          string
+		body           string
+		serviceErr     error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"invalid id", "abc", `{"note":"ok"}`, nil, http.StatusBadRequest, "VALIDATION_003"},
+		{"missing note", id.String(), `{}`, nil, http.StatusBadRequest, "VALIDATION_001"},
+		{"not found", id.String(), `{"note":"ok"}`, services.ErrTransferReviewNotFound, http.StatusNotFound, "REVIEW_001"},
+		{"already decided", id.String(), `{"note":"ok"}`, services.ErrTransferReviewDecided, http.StatusConflict, "REVIEW_002"},
+		{"destination inactive", id.String(), `{"note":"ok"}`, services.ErrAccountNotActive, http.StatusUnprocessableEntity, "ACCOUNT_002"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.serviceErr != nil {
+				s.accountService.EXPECT().ApproveHeldTransfer(gomock.Any(), s.adminID, id, "ok").Return(nil, tc.serviceErr)
+			}
+
+			c, rec := s.newContext(http.MethodPost, "/admin/transfer-reviews/"+tc.param+"/approve", tc.body, "id", tc.param)
+			s.Require().NoError(s.handler.ApproveReview(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+			s.Contains(rec.Body.String(), tc.expectedCode)
+		})
+	}
+}
@@ -57,7 +57,7 @@ func (h *AdminHandler) createAuditLog(adminID uuid.UUID, action, targetUserID st
 		Metadata:  m,
 	}
 
-	if err := h.auditRepo.Create(log); err != nil {
+	if err := h.auditRepo.Create(c.Request().Context(), log); err != nil {
 		// Audit logging failure should not block the operation
 		// Log error to monitoring system in production
 		_ = err
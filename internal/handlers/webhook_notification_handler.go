@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// WebhookNotificationHandler handles the admin dead-letter queue of regulator webhook notifications
+type WebhookNotificationHandler struct {
+	webhookService services.WebhookServiceInterface
+}
+
+// NewWebhookNotificationHandler creates a new webhook notification handler
+func NewWebhookNotificationHandler(webhookService services.WebhookServiceInterface) *WebhookNotificationHandler {
+	return &WebhookNotificationHandler{
+		webhookService: webhookService,
+	}
+}
+
+// ListNotifications lists regulator webhook notifications
+// @Summary List regulator webhook notifications (admin)
+// @Description Lists regulator webhook notifications, oldest first, with the regulator's last response. Without a status filter only failed and dead-lettered notifications are returned.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param status query string false "Filter by status (pending, sent, failed, dead_lettered, resolved)"
+// @Param transfer_id query string false "Filter by transfer ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} dto.WebhookNotificationListResponse "Notifications retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid filter or pagination parameters"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/webhooks/notifications [get]
+func (h *WebhookNotificationHandler) ListNotifications(c echo.Context) error {
+	filters := models.WebhookNotificationFilters{
+		Statuses: []string{models.WebhookStatusFailed, models.WebhookStatusDeadLettered},
+	}
+
+	switch status := c.QueryParam("status"); status {
+	case "":
+	case models.WebhookStatusPending, models.WebhookStatusSent, models.WebhookStatusFailed,
+		models.WebhookStatusDeadLettered, models.WebhookStatusResolved:
+		filters.Statuses = []string{status}
+	default:
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("status: must be one of pending, sent, failed, dead_lettered, resolved"))
+	}
+
+	if transferIDParam := c.QueryParam("transfer_id"); transferIDParam != "" {
+		transferID, err := uuid.Parse(transferIDParam)
+		if err != nil {
+			return SendError(c, errors.ValidationGeneral, errors.WithDetails("transfer_id: must be a valid UUID"))
+		}
+		filters.TransferID = &transferID
+	}
+
+	page := getIntParam(c, "page", 1)
+	limit := getIntParam(c, "limit", 20)
+
+	if page < 1 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("page: must be greater than 0"))
+	}
+	if limit < 1 || limit > 100 {
+		return SendError(c, errors.ValidationGeneral,
+			errors.WithDetails("limit: must be between 1 and 100"))
+	}
+
+	notifications, total, err := h.webhookService.ListNotifications(c.Request().Context(), filters, (page-1)*limit, limit)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	response := dto.WebhookNotificationListResponse{
+		Notifications: make([]dto.WebhookNotificationResponse, len(notifications)),
+		Pagination: dto.PaginationMeta{
+			Page:  page,
+			Limit: limit,
+			Total: total,
+		},
+	}
+	for i := range notifications {
+		response.Notifications[i] = toWebhookNotificationResponse(&notifications[i])
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetNotification returns a single regulator webhook notification
+// @Summary Get regulator webhook notification (admin)
+// @Description Returns a notification with its attempts, last error and the regulator's last response status and body.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Notification ID (UUID)"
+// @Success 200 {object} dto.WebhookNotificationResponse "Notification retrieved successfully"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid notification ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "NOTIFICATION_001 - Notification not found"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/webhooks/notifications/{id} [get]
+func (h *WebhookNotificationHandler) GetNotification(c echo.Context) error {
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid notification ID"))
+	}
+
+	notification, err := h.webhookService.GetNotification(c.Request().Context(), notificationID)
+	if err != nil {
+		return mapWebhookNotificationErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toWebhookNotificationResponse(notification))
+}
+
+// ReplayNotification requeues a failed or dead-lettered notification
+// @Summary Replay regulator webhook notification (admin)
+// @Description Returns a failed or dead-lettered notification to the delivery queue with a fresh set of attempts. It is sent on the next webhook run.
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Notification ID (UUID)"
+// @Success 202 {object} dto.WebhookNotificationResponse "Notification requeued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_003 - Invalid notification ID"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "NOTIFICATION_001 - Notification not found"
+// @Failure 409 {object} errors.ErrorResponse "NOTIFICATION_002 - Notification is not failed or dead-lettered"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/webhooks/notifications/{id}/replay [post]
+func (h *WebhookNotificationHandler) ReplayNotification(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid notification ID"))
+	}
+
+	notification, err := h.webhookService.ReplayNotification(c.Request().Context(), adminID, notificationID)
+	if err != nil {
+		return mapWebhookNotificationErr(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, toWebhookNotificationResponse(notification))
+}
+
+// ReplayNotifications requeues notifications in bulk
+// @Summary Bulk replay regulator webhook notifications (admin)
+// @Description Requeues the listed notifications, or the oldest 100 in the given status (failed or dead_lettered). Notifications that are not failed or dead-lettered are reported as skipped.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.ReplayWebhookNotificationsRequest true "Notification IDs or status to replay"
+// @Success 202 {object} dto.ReplayWebhookNotificationsResponse "Notifications requeued"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/webhooks/notifications/replay [post]
+func (h *WebhookNotificationHandler) ReplayNotifications(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	var req dto.ReplayWebhookNotificationsRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	result, err := h.webhookService.ReplayNotifications(c.Request().Context(), adminID, &req)
+	if err != nil {
+		return SendSystemError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, result)
+}
+
+// ResolveNotification closes a notification without delivering it
+// @Summary Resolve regulator webhook notification (admin)
+// @Description Marks a failed or dead-lettered notification as resolved with a note, for example when the transfer was reported to the regulator through another channel. It is not retried.
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Notification ID (UUID)"
+// @Param request body dto.ResolveWebhookNotificationRequest true "Resolution note"
+// @Success 200 {object} dto.WebhookNotificationResponse "Notification resolved"
+// @Failure 400 {object} errors.ErrorResponse "VALIDATION_001 - Invalid request"
+// @Failure 401 {object} errors.ErrorResponse "AUTH_002 - Missing or invalid authentication"
+// @Failure 403 {object} errors.ErrorResponse "AUTH_005 - Requires admin role"
+// @Failure 404 {object} errors.ErrorResponse "NOTIFICATION_001 - Notification not found"
+// @Failure 409 {object} errors.ErrorResponse "NOTIFICATION_002 - Notification is not failed or dead-lettered"
+// @Failure 500 {object} errors.ErrorResponse "SYSTEM_001 - Internal server error"
+// @Router /admin/webhooks/notifications/{id}/resolve [post]
+func (h *WebhookNotificationHandler) ResolveNotification(c echo.Context) error {
+	adminID, err := getUserIDFromContext(c)
+	if err != nil {
+		return SendError(c, errors.AuthMissingToken)
+	}
+
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return SendError(c, errors.ValidationInvalidFormat, errors.WithDetails("Invalid notification ID"))
+	}
+
+	var req dto.ResolveWebhookNotificationRequest
+	if err := c.Bind(&req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails("Invalid request body"))
+	}
+
+	if err := c.Validate(req); err != nil {
+		return SendError(c, errors.ValidationGeneral, errors.WithDetails(err.Error()))
+	}
+
+	notification, err := h.webhookService.ResolveNotification(c.Request().Context(), adminID, notificationID, req.Note)
+	if err != nil {
+		return mapWebhookNotificationErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toWebhookNotificationResponse(notification))
+}
+
+func mapWebhookNotificationErr(c echo.Context, err error) error {
+	switch {
+	case stderrors.Is(err, services.ErrWebhookNotificationNotFound):
+		return SendError(c, errors.NotificationNotFound)
+	case stderrors.Is(err, services.ErrWebhookNotificationInvalidState):
+		return SendError(c, errors.NotificationInvalidState)
+	}
+	return SendSystemError(c, err)
+}
+
+func toWebhookNotificationResponse(notification *models.WebhookNotification) dto.WebhookNotificationResponse {
+	return dto.WebhookNotificationResponse{
+		ID:                 notification.ID,
+		TransferID:         notification.TransferID,
+		TransferStatus:     notification.Transfer.Status,
+		URL:                notification.URL,
+		Status:             notification.Status,
+		Attempts:           notification.Attempts,
+		LastAttemptAt:      notification.LastAttemptAt,
+		NextAttemptAt:      notification.NextAttemptAt,
+		ResponseStatusCode: notification.ResponseStatusCode,
+		ResponseBody:       notification.ResponseBody,
+		LastError:          notification.LastError,
+		DeadLetteredAt:     notification.DeadLetteredAt,
+		ResolvedBy:         notification.ResolvedBy,
+		ResolvedAt:         notification.ResolvedAt,
+		ResolutionNote:     notification.ResolutionNote,
+		CreatedAt:          notification.CreatedAt,
+		UpdatedAt:          notification.UpdatedAt,
+	}
+}
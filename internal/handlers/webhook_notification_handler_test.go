@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookNotificationHandlerSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	webhookService *service_mocks.MockWebhookServiceInterface
+	handler        *WebhookNotificationHandler
+	echo           *echo.Echo
+	adminID        uuid.UUID
+}
+
+func (s *WebhookNotificationHandlerSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.webhookService = service_mocks.NewMockWebhookServiceInterface(s.ctrl)
+	s.handler = NewWebhookNotificationHandler(s.webhookService)
+	s.echo = echo.New()
+	s.echo.Validator = &CustomValidator{validator: validator.New()}
+	s.adminID = uuid.New()
+}
+
+func (s *WebhookNotificationHandlerSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestWebhookNotificationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(WebhookNotificationHandlerSuite))
+}
+
+func (s *WebhookNotificationHandlerSuite) newContext(method, target, body string, notificationID string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.Set("user_id", s.adminID)
+	if notificationID != "" {
+		c.SetParamNames("id")
+		c.SetParamValues(notificationID)
+	}
+	return c, rec
+}
+
+func (s *WebhookNotificationHandlerSuite) TestListNotifications_DefaultsToFailedAndDeadLettered() {
+	responseBody := `{"error":"unavailable"}`
+	notifications := []models.WebhookNotification{{ID: uuid.New(), Status: models.WebhookStatusDeadLettered, ResponseBody: &responseBody}}
+	filters := models.WebhookNotificationFilters{Statuses: []string{models.WebhookStatusFailed, models.WebhookStatusDeadLettered}}
+	s.webhookService.EXPECT().ListNotifications(gomock.Any(), filters, 0, 20).Return(notifications, int64(1), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/webhooks/notifications", "", "")
+	s.Require().NoError(s.handler.ListNotifications(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.WebhookNotificationListResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Require().Len(response.Notifications, 1)
+	s.Equal(responseBody, *response.Notifications[0].ResponseBody)
+}
+
+func (s *WebhookNotificationHandlerSuite) TestListNotifications_Filters() {
+	transferID := uuid.New()
+	filters := models.WebhookNotificationFilters{Statuses: []string{models.WebhookStatusResolved}, TransferID: &transferID}
+	s.webhookService.EXPECT().ListNotifications(gomock.Any(), filters, 10, 10).Return(nil, int64(0), nil)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/webhooks/notifications?status=resolved&transfer_id="+transferID.String()+"&page=2&limit=10", "", "")
+	s.Require().NoError(s.handler.ListNotifications(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *WebhookNotificationHandlerSuite) TestListNotifications_InvalidStatus() {
+	c, rec := s.newContext(http.MethodGet, "/admin/webhooks/notifications?status=lost", "", "")
+	s.Require().NoError(s.handler.ListNotifications(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *WebhookNotificationHandlerSuite) TestGetNotification_NotFound() {
+	id := uuid.New()
+	s.webhookService.EXPECT().GetNotification(gomock.Any(), id).Return(nil, services.ErrWebhookNotificationNotFound)
+
+	c, rec := s.newContext(http.MethodGet, "/admin/webhooks/notifications/"+id.String(), "", id.String())
+	s.Require().NoError(s.handler.GetNotification(c))
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "NOTIFICATION_001")
+}
+
+func (s *WebhookNotificationHandlerSuite) TestReplayNotification() {
+	id := uuid.New()
+
+	testCases := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{"accepted", nil, http.StatusAccepted},
+		{"invalid state", services.ErrWebhookNotificationInvalidState, http.StatusConflict},
+		{"not found", services.ErrWebhookNotificationNotFound, http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var notification *models.WebhookNotification
+			if tc.serviceErr == nil {
+				notification = &models.WebhookNotification{ID: id, Status: models.WebhookStatusPending}
+			}
+			s.webhookService.EXPECT().ReplayNotification(gomock.Any(), s.adminID, id).Return(notification, tc.serviceErr)
+
+			c, rec := s.newContext(http.MethodPost, "/admin/webhooks/notifications/"+id.String()+"/replay", "", id.String())
+			s.Require().NoError(s.handler.ReplayNotification(c))
+
+			s.Equal(tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func (s *WebhookNotificationHandlerSuite) TestReplayNotifications_ByIDs() {
+	id := uuid.New()
+	result := &dto.ReplayWebhookNotificationsResponse{Replayed: []uuid.UUID{id}, Skipped: []dto.WebhookNotificationReplaySkip{}}
+	s.webhookService.EXPECT().ReplayNotifications(gomock.Any(), s.adminID, &dto.ReplayWebhookNotificationsRequest{NotificationIDs: []uuid.UUID{id}}).Return(result, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/webhooks/notifications/replay", `{"notification_ids":["`+id.String()+`"]}`, "")
+	s.Require().NoError(s.handler.ReplayNotifications(c))
+
+	s.Equal(http.StatusAccepted, rec.Code)
+	s.Contains(rec.Body.String(), id.String())
+}
+
+func (s *WebhookNotificationHandlerSuite) TestReplayNotifications_InvalidRequest() {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"empty", `{}`},
+		{"ids and status", `{"notification_ids":["` + uuid.NewString() + `"],"status":"dead_lettered"}`},
+		{"status not replayable", `{"status":"sent"}`},
+		{"invalid id", `{"notification_ids":["abc"]}`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			c, rec := s.newContext(http.MethodPost, "/admin/webhooks/notifications/replay", tc.body, "")
+			s.Require().NoError(s.handler.ReplayNotifications(c))
+
+			s.Equal(http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func (s *WebhookNotificationHandlerSuite) TestResolveNotification() {
+	id := uuid.New()
+	note := "reported through the regulator portal"
+	notification := &models.WebhookNotification{ID: id, Status: models.WebhookStatusResolved, ResolvedBy: &s.adminID, ResolutionNote: &note}
+	s.webhookService.EXPECT().ResolveNotification(gomock.Any(), s.adminID, id, note).Return(notification, nil)
+
+	c, rec := s.newContext(http.MethodPost, "/admin/webhooks/notifications/"+id.String()+"/resolve", `{"note":"`+note+`"}`, id.String())
+	s.Require().NoError(s.handler.ResolveNotification(c))
+
+	s.Equal(http.StatusOK, rec.Code)
+	var response dto.WebhookNotificationResponse
+	s.NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(models.WebhookStatusResolved, response.Status)
+	s.Equal(note, *response.ResolutionNote)
+}
+
+func (s *WebhookNotificationHandlerSuite) TestResolveNotification_MissingNote() {
+	id := uuid.New()
+	c, rec := s.newContext(http.MethodPost, "/admin/webhooks/notifications/"+id.String()+"/resolve", `{}`, id.String())
+	s.Require().NoError(s.handler.ResolveNotification(c))
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,260 @@
+// Package leader elects one API instance to run the background work that must not run on every
+// replica at once, such as sending regulator webhooks. Leadership is a lock held for the life of a
+// database session: when the leader stops, crashes or loses its connection the lock is released and
+// another instance takes over on its next attempt.
+package leader
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// releaseTimeout bounds releasing the lock on shutdown, after the elector's context is cancelled.
+const releaseTimeout = 5 * time.Second
+
+var isLeaderGauge = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "leader_election_is_leader",
+		Help: "Whether this instance holds the leadership lock (1) or not (0)",
+	},
+	[]string{"lock"},
+)
+
+// Session is a database session that can hold the leadership lock. The lock lives only as long as
+// the session, so closing the session always gives it up.
+type Session interface {
+	// TryLock takes the lock if nobody holds it, without waiting
+	TryLock(ctx context.Context) (bool, error)
+	// Ping checks the session, and with it the lock, is still alive
+	Ping(ctx context.Context) error
+	// Unlock releases the lock
+	Unlock(ctx context.Context) error
+	// Close ends the session, releasing the lock if it is still held
+	Close() error
+}
+
+// Locker opens sessions on one named lock and reports who holds it.
+type Locker interface {
+	// Open starts a session identified as identity
+	Open(ctx context.Context, identity string) (Session, error)
+	// Holder returns the identity of the session holding the lock, or "" when it is free
+	Holder(ctx context.Context) (string, error)
+	// Name identifies the lock in logs and metrics
+	Name() string
+}
+
+// Status reports leadership as seen by this instance.
+type Status struct {
+	Instance string `json:"instance"`
+	IsLeader bool   `json:"is_leader"`
+	Leader   string `json:"leader,omitempty"` // Instance holding the lock, when known
+}
+
+// StatusReporter reports leadership for health output.
+type StatusReporter interface {
+	Status(ctx context.Context) Status
+}
+
+// Elector campaigns for leadership and holds it until its context is cancelled or its session is
+// lost. A follower retries every retryInterval; the leader checks its session every
+// keepaliveInterval and steps down as soon as a check fails, which bounds how long a leader whose
+// session was cut off can go on believing it leads.
+type Elector struct {
+	locker            Locker
+	identity          string
+	retryInterval     time.Duration
+	keepaliveInterval time.Duration
+	isLeader          atomic.Bool
+	logger            *slog.Logger
+
+	mu       sync.Mutex
+	term     context.Context // Cancelled when this instance steps down
+	endTerm  context.CancelFunc
+	nextTerm chan struct{} // Closed when this instance next takes the lead
+}
+
+func NewElector(locker Locker, identity string, retryInterval, keepaliveInterval time.Duration) *Elector {
+	term, endTerm := context.WithCancel(context.Background())
+	endTerm()
+	return &Elector{
+		locker:            locker,
+		identity:          identity,
+		retryInterval:     retryInterval,
+		keepaliveInterval: keepaliveInterval,
+		logger:            slog.Default().With("component", "LeaderElector", "lock", locker.Name()),
+		term:              term,
+		endTerm:           endTerm,
+		nextTerm:          make(chan struct{}),
+	}
+}
+
+// IsLeader reports whether this instance currently holds the lock.
+func (e *Elector) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+// LeaderContext returns a context for the current term of leadership. It is cancelled as soon as
+// this instance steps down, and is already cancelled when it does not lead, so work that must only
+// run on the leader can derive its context from it and stop with the term.
+func (e *Elector) LeaderContext() context.Context {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.term
+}
+
+// NextTerm returns a channel that is closed when this instance next takes the lead, so work that
+// must happen once per term can wait for it instead of polling IsLeader.
+func (e *Elector) NextTerm() <-chan struct{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.nextTerm
+}
+
+// Status reports whether this instance leads and, when it does not, which instance does.
+func (e *Elector) Status(ctx context.Context) Status {
+	status := Status{Instance: e.identity, IsLeader: e.IsLeader()}
+	if status.IsLeader {
+		status.Leader = e.identity
+		return status
+	}
+
+	holder, err := e.locker.Holder(ctx)
+	if err != nil {
+		e.logger.WarnContext(ctx, "failed to look up leader", "error", err)
+		return status
+	}
+	status.Leader = holder
+	return status
+}
+
+// Run campaigns for leadership until ctx is cancelled, then gives it up so another instance can
+// take over straight away.
+func (e *Elector) Run(ctx context.Context) {
+	for {
+		session := e.campaign(ctx)
+		if session == nil {
+			return
+		}
+		e.lead(ctx, session)
+
+		// Leadership was lost with the session. Wait before campaigning again so a follower
+		// with a healthy connection gets the first chance at the lock.
+		if !sleep(ctx, e.retryInterval) {
+			return
+		}
+	}
+}
+
+// campaign tries to take the lock every retryInterval and returns the session holding it, or nil
+// once ctx is cancelled.
+func (e *Elector) campaign(ctx context.Context) Session {
+	var session Session
+	for {
+		if session == nil {
+			opened, err := e.locker.Open(ctx, e.identity)
+			if err != nil {
+				e.logger.ErrorContext(ctx, "failed to open leader election session", "error", err)
+			} else {
+				session = opened
+			}
+		}
+
+		if session != nil {
+			acquired, err := session.TryLock(ctx)
+			switch {
+			case err != nil:
+				e.logger.ErrorContext(ctx, "failed to try leadership lock", "error", err)
+				e.close(session)
+				session = nil
+			case acquired:
+				return session
+			}
+		}
+
+		if !sleep(ctx, e.retryInterval) {
+			if session != nil {
+				e.close(session)
+			}
+			return nil
+		}
+	}
+}
+
+// lead holds leadership until the session fails a keepalive or ctx is cancelled.
+func (e *Elector) lead(ctx context.Context, session Session) {
+	e.setLeader(true)
+	e.logger.InfoContext(ctx, "acquired leadership", "instance", e.identity)
+
+	ticker := time.NewTicker(e.keepaliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			e.setLeader(false)
+			releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+			if err := session.Unlock(releaseCtx); err != nil {
+				e.logger.ErrorContext(releaseCtx, "failed to release leadership lock", "error", err)
+			}
+			cancel()
+			e.close(session)
+			e.logger.Info("released leadership", "instance", e.identity)
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(ctx, e.keepaliveInterval)
+			err := session.Ping(pingCtx)
+			cancel()
+			if err != nil && ctx.Err() == nil {
+				e.setLeader(false)
+				e.logger.ErrorContext(ctx, "lost leadership, session keepalive failed", "instance", e.identity, "error", err)
+				e.close(session)
+				return
+			}
+		}
+	}
+}
+
+func (e *Elector) setLeader(leader bool) {
+	// Leadership is stored under the lock so that by the time a new term is announced, IsLeader
+	// already reports it
+	e.mu.Lock()
+	e.isLeader.Store(leader)
+	if leader {
+		e.term, e.endTerm = context.WithCancel(context.Background())
+		close(e.nextTerm)
+		e.nextTerm = make(chan struct{})
+	} else {
+		e.endTerm()
+	}
+	e.mu.Unlock()
+
+	value := 0.0
+	if leader {
+		value = 1
+	}
+	isLeaderGauge.WithLabelValues(e.locker.Name()).Set(value)
+}
+
+func (e *Elector) close(session Session) {
+	if err := session.Close(); err != nil {
+		e.logger.Warn("failed to close leader election session", "error", err)
+	}
+}
+
+// sleep waits for d, returning false if ctx is cancelled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
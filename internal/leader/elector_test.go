@@ -0,0 +1,235 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testRetryInterval     = 10 * time.Millisecond
+	testKeepaliveInterval = 10 * time.Millisecond
+	testFailoverWait      = 2 * time.Second
+)
+
+// fakeLocker behaves like a session-level advisory lock: the lock belongs to one session and is
+// released when that session unlocks, closes or is killed.
+type fakeLocker struct {
+	mu     sync.Mutex
+	holder *fakeSession
+}
+
+func (l *fakeLocker) Name() string {
+	return "test-lock"
+}
+
+func (l *fakeLocker) Open(_ context.Context, identity string) (Session, error) {
+	return &fakeSession{locker: l, identity: identity}, nil
+}
+
+func (l *fakeLocker) Holder(context.Context) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.holder == nil {
+		return "", nil
+	}
+	return l.holder.identity, nil
+}
+
+// session returns the session holding the lock
+func (l *fakeLocker) session() *fakeSession {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.holder
+}
+
+type fakeSession struct {
+	locker   *fakeLocker
+	identity string
+	dead     bool
+}
+
+func (s *fakeSession) TryLock(context.Context) (bool, error) {
+	s.locker.mu.Lock()
+	defer s.locker.mu.Unlock()
+	if s.dead {
+		return false, errors.New("connection reset")
+	}
+	if s.locker.holder == nil {
+		s.locker.holder = s
+	}
+	return s.locker.holder == s, nil
+}
+
+func (s *fakeSession) Ping(context.Context) error {
+	s.locker.mu.Lock()
+	defer s.locker.mu.Unlock()
+	if s.dead {
+		return errors.New("connection reset")
+	}
+	return nil
+}
+
+func (s *fakeSession) Unlock(context.Context) error {
+	s.locker.mu.Lock()
+	defer s.locker.mu.Unlock()
+	if s.locker.holder == s {
+		s.locker.holder = nil
+	}
+	return nil
+}
+
+func (s *fakeSession) Close() error {
+	s.kill()
+	return nil
+}
+
+// kill ends the session the way a dropped connection or terminated backend would
+func (s *fakeSession) kill() {
+	s.locker.mu.Lock()
+	defer s.locker.mu.Unlock()
+	s.dead = true
+	if s.locker.holder == s {
+		s.locker.holder = nil
+	}
+}
+
+type ElectorTestSuite struct {
+	suite.Suite
+	locker *fakeLocker
+}
+
+func (s *ElectorTestSuite) SetupTest() {
+	s.locker = &fakeLocker{}
+}
+
+func TestElectorTestSuite(t *testing.T) {
+	suite.Run(t, new(ElectorTestSuite))
+}
+
+// start runs an elector for identity until the returned stop function is called
+func (s *ElectorTestSuite) start(identity string, retryInterval time.Duration) (*Elector, func()) {
+	elector := NewElector(s.locker, identity, retryInterval, testKeepaliveInterval)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		elector.Run(ctx)
+	}()
+	return elector, func() {
+		cancel()
+		<-done
+	}
+}
+
+func (s *ElectorTestSuite) TestOnlyOneInstanceLeads() {
+	first, stopFirst := s.start("api-1", testRetryInterval)
+	defer stopFirst()
+	s.Eventually(first.IsLeader, testFailoverWait, time.Millisecond)
+
+	second, stopSecond := s.start("api-2", testRetryInterval)
+	defer stopSecond()
+
+	// Give the second instance several attempts at the lock
+	time.Sleep(10 * testRetryInterval)
+	s.True(first.IsLeader())
+	s.False(second.IsLeader())
+
+	status := second.Status(context.Background())
+	s.Equal(Status{Instance: "api-2", IsLeader: false, Leader: "api-1"}, status)
+}
+
+func (s *ElectorTestSuite) TestFailover_LeaderSessionLost() {
+	// The old leader waits a retry interval before campaigning again; make it long so the
+	// follower's takeover is deterministic
+	first, stopFirst := s.start("api-1", time.Minute)
+	defer stopFirst()
+	s.Eventually(first.IsLeader, testFailoverWait, time.Millisecond)
+
+	second, stopSecond := s.start("api-2", testRetryInterval)
+	defer stopSecond()
+
+	// The leader's connection drops; Postgres releases the lock with the session
+	s.locker.session().kill()
+
+	s.Eventually(second.IsLeader, testFailoverWait, time.Millisecond)
+	s.Eventually(func() bool { return !first.IsLeader() }, testFailoverWait, time.Millisecond)
+	s.Equal("api-2", second.Status(context.Background()).Leader)
+
+	// The old leader now follows and reports the new one
+	s.Equal("api-2", first.Status(context.Background()).Leader)
+}
+
+func (s *ElectorTestSuite) TestLeaderContext_CancelledOnStepDown() {
+	elector, stop := s.start("api-1", time.Minute)
+	defer stop()
+	s.Error(NewElector(s.locker, "api-2", testRetryInterval, testKeepaliveInterval).LeaderContext().Err())
+
+	s.Eventually(elector.IsLeader, testFailoverWait, time.Millisecond)
+	term := elector.LeaderContext()
+	s.NoError(term.Err())
+
+	s.locker.session().kill()
+
+	select {
+	case <-term.Done():
+	case <-time.After(testFailoverWait):
+		s.FailNow("leader context was not cancelled after losing the session")
+	}
+	s.False(elector.IsLeader())
+	s.Error(elector.LeaderContext().Err())
+}
+
+func (s *ElectorTestSuite) TestNextTerm_ClosedWhenLeadershipTaken() {
+	elector := NewElector(s.locker, "api-1", testRetryInterval, testKeepaliveInterval)
+	next := elector.NextTerm()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		elector.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	select {
+	case <-next:
+	case <-time.After(testFailoverWait):
+		s.FailNow("next term was not announced after taking the lead")
+	}
+	s.True(elector.IsLeader())
+	s.NoError(elector.LeaderContext().Err())
+
+	// The channel for the following term stays open while this one lasts
+	select {
+	case <-elector.NextTerm():
+		s.Fail("following term announced while still leading")
+	default:
+	}
+}
+
+func (s *ElectorTestSuite) TestFailover_LeaderShutsDown() {
+	first, stopFirst := s.start("api-1", testRetryInterval)
+	s.Eventually(first.IsLeader, testFailoverWait, time.Millisecond)
+
+	second, stopSecond := s.start("api-2", testRetryInterval)
+	defer stopSecond()
+
+	stopFirst()
+	s.False(first.IsLeader())
+
+	s.Eventually(second.IsLeader, testFailoverWait, time.Millisecond)
+}
+
+func (s *ElectorTestSuite) TestStatus_NoLeader() {
+	elector := NewElector(s.locker, "api-1", testRetryInterval, testKeepaliveInterval)
+
+	s.Equal(Status{Instance: "api-1"}, elector.Status(context.Background()))
+}
@@ -0,0 +1,102 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"hash/fnv"
+)
+
+// PostgresLocker holds leadership as a session-level Postgres advisory lock
+// (pg_try_advisory_lock). Each session runs on a connection taken out of the pool for its whole
+// life and is named after its instance through application_name, so any instance can find the
+// holder in pg_locks and pg_stat_activity.
+type PostgresLocker struct {
+	db   *sql.DB
+	name string
+	key  int64
+}
+
+// NewPostgresLocker creates a locker for the named lock. The advisory lock key is derived from the
+// name, so every instance configured with the same name contends for the same lock.
+func NewPostgresLocker(db *sql.DB, name string) *PostgresLocker {
+	hash := fnv.New64a()
+	hash.Write([]byte(name))
+	return &PostgresLocker{db: db, name: name, key: int64(hash.Sum64())}
+}
+
+func (l *PostgresLocker) Name() string {
+	return l.name
+}
+
+// Open takes a dedicated connection for the session and names it after identity.
+func (l *PostgresLocker) Open(ctx context.Context, identity string) (Session, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open leader election connection: %w", err)
+	}
+
+	session := &postgresSession{conn: conn, key: l.key}
+	if _, err := conn.ExecContext(ctx, "SELECT set_config('application_name', $1, false)", identity); err != nil {
+		session.Close()
+		return nil, fmt.Errorf("failed to name leader election session: %w", err)
+	}
+	return session, nil
+}
+
+// Holder finds the session holding the lock. A bigint advisory key is stored in pg_locks split
+// across classid (high 32 bits) and objid (low 32 bits), with objsubid 1.
+func (l *PostgresLocker) Holder(ctx context.Context) (string, error) {
+	var holder string
+	err := l.db.QueryRowContext(ctx, `
+		SELECT a.application_name
+		FROM pg_locks l
+		JOIN pg_stat_activity a ON a.pid = l.pid
+		WHERE l.locktype = 'advisory' AND l.granted
+			AND l.classid::bigint = $1 AND l.objid::bigint = $2 AND l.objsubid = 1`,
+		int64(uint64(l.key)>>32), int64(uint64(l.key)&0xffffffff),
+	).Scan(&holder)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to look up leadership lock holder: %w", err)
+	}
+	return holder, nil
+}
+
+type postgresSession struct {
+	conn *sql.Conn
+	key  int64
+}
+
+func (s *postgresSession) TryLock(ctx context.Context) (bool, error) {
+	var acquired bool
+	if err := s.conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", s.key).Scan(&acquired); err != nil {
+		return false, fmt.Errorf("failed to try advisory lock: %w", err)
+	}
+	return acquired, nil
+}
+
+func (s *postgresSession) Ping(ctx context.Context) error {
+	return s.conn.PingContext(ctx)
+}
+
+func (s *postgresSession) Unlock(ctx context.Context) error {
+	if _, err := s.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", s.key); err != nil {
+		return fmt.Errorf("failed to release advisory lock: %w", err)
+	}
+	return nil
+}
+
+// Close discards the connection rather than returning it to the pool, so the session ends and
+// Postgres releases any lock it still holds.
+func (s *postgresSession) Close() error {
+	err := s.conn.Raw(func(any) error { return driver.ErrBadConn })
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return nil
+	}
+	return err
+}
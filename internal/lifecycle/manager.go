@@ -0,0 +1,215 @@
+// Package lifecycle runs the API's shutdown sequence. On SIGTERM or SIGINT the instance reports
+// itself not ready, keeps serving for a moment so load balancers stop routing to it, and then
+// runs the registered shutdown stages in order: draining requests and background work, stopping
+// background loops, and finally releasing resources such as the database.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// shutdownSignals are sent by an operator's Ctrl-C and by orchestrators stopping the instance.
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// ReadinessReporter reports whether the instance should receive traffic.
+type ReadinessReporter interface {
+	Ready() bool
+}
+
+// StopFunc stops one part of the application, giving up when ctx expires.
+type StopFunc func(ctx context.Context) error
+
+type stage struct {
+	name    string
+	timeout time.Duration
+	stop    StopFunc
+}
+
+// Manager tracks readiness and background loops, and shuts the application down in the order its
+// stages were added. A stage that fails or runs out of time is logged and the sequence carries on,
+// so later stages such as closing the database always run.
+type Manager struct {
+	readinessDelay time.Duration
+	logger         *slog.Logger
+
+	ready  atomic.Bool
+	failed chan error
+
+	mu     sync.Mutex
+	stages []stage
+
+	loopCtx    context.Context
+	cancelLoop context.CancelFunc
+	loops      sync.WaitGroup
+}
+
+// NewManager creates a manager that waits readinessDelay between reporting not ready and running
+// the first shutdown stage.
+func NewManager(readinessDelay time.Duration) *Manager {
+	loopCtx, cancelLoop := context.WithCancel(context.Background())
+	return &Manager{
+		readinessDelay: readinessDelay,
+		logger:         slog.Default().With("component", "Lifecycle"),
+		failed:         make(chan error, 1),
+		loopCtx:        loopCtx,
+		cancelLoop:     cancelLoop,
+	}
+}
+
+// Ready reports whether the instance has started and is not shutting down.
+func (m *Manager) Ready() bool {
+	return m.ready.Load()
+}
+
+// MarkReady reports the instance ready once it is serving.
+func (m *Manager) MarkReady() {
+	m.ready.Store(true)
+	m.logger.Info("instance ready")
+}
+
+// Go runs a background loop until StopLoops cancels its context.
+func (m *Manager) Go(name string, run func(ctx context.Context)) {
+	m.loops.Add(1)
+	go func() {
+		defer m.loops.Done()
+		run(m.loopCtx)
+		m.logger.Info("background loop stopped", "loop", name)
+	}()
+}
+
+// StopLoops cancels the context passed to background loops and waits for them to return, or for
+// ctx to expire.
+func (m *Manager) StopLoops(ctx context.Context) error {
+	m.cancelLoop()
+	return WaitFor(ctx, m.loops.Wait)
+}
+
+// OnShutdown adds a stage to the end of the shutdown sequence. With a timeout the stage's context
+// expires after it; stop should return once the context is done.
+func (m *Manager) OnShutdown(name string, timeout time.Duration, stop StopFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stages = append(m.stages, stage{name: name, timeout: timeout, stop: stop})
+}
+
+// Fail starts shutdown because part of the application cannot carry on, such as a server that
+// failed to listen. Only the first failure is kept.
+func (m *Manager) Fail(err error) {
+	select {
+	case m.failed <- err:
+	default:
+	}
+}
+
+// Wait blocks until SIGTERM or SIGINT is received or Fail is called, returning the failure. Once
+// Wait has returned, a second signal exits the process straight away for when shutdown hangs.
+func (m *Manager) Wait() error {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, shutdownSignals...)
+
+	var err error
+	select {
+	case sig := <-quit:
+		m.logger.Info("shutdown signal received", "signal", sig.String())
+	case err = <-m.failed:
+		m.logger.Error("shutting down after failure", "error", err)
+	}
+
+	go func() {
+		sig := <-quit
+		m.logger.Error("second shutdown signal received, exiting immediately", "signal", sig.String())
+		os.Exit(1)
+	}()
+	return err
+}
+
+// Shutdown reports the instance not ready, waits out the readiness delay, and runs every stage in
+// order. It returns the errors of the stages that failed.
+func (m *Manager) Shutdown() error {
+	started := time.Now()
+	m.ready.Store(false)
+	m.logger.Info("shutdown started, instance no longer ready", "readiness_delay", m.readinessDelay)
+	time.Sleep(m.readinessDelay)
+
+	m.mu.Lock()
+	stages := append([]stage(nil), m.stages...)
+	m.mu.Unlock()
+
+	var errs []error
+	for _, s := range stages {
+		if err := m.runStage(s); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		m.logger.Error("shutdown finished with errors", "duration_ms", time.Since(started).Milliseconds(), "error", err)
+	} else {
+		m.logger.Info("shutdown complete", "duration_ms", time.Since(started).Milliseconds())
+	}
+	return err
+}
+
+func (m *Manager) runStage(s stage) error {
+	ctx := context.Background()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	started := time.Now()
+	m.logger.Info("shutdown stage started", "stage", s.name, "timeout", s.timeout)
+	err := s.stop(ctx)
+	durationMs := time.Since(started).Milliseconds()
+	if err != nil {
+		m.logger.Error("shutdown stage failed", "stage", s.name, "duration_ms", durationMs, "error", err)
+		return err
+	}
+	m.logger.Info("shutdown stage finished", "stage", s.name, "duration_ms", durationMs)
+	return nil
+}
+
+// All returns a stop function running every stop concurrently under the same context, for stages
+// whose parts do not depend on each other.
+func All(stops ...StopFunc) StopFunc {
+	return func(ctx context.Context) error {
+		errs := make([]error, len(stops))
+		var wg sync.WaitGroup
+		for i, stop := range stops {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = stop(ctx)
+			}()
+		}
+		wg.Wait()
+		return errors.Join(errs...)
+	}
+}
+
+// WaitFor calls wait, which blocks until something has stopped, and returns once it does or ctx
+// expires.
+func WaitFor(ctx context.Context, wait func()) error {
+	done := make(chan struct{})
+	go func() {
+		wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
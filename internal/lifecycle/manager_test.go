@@ -0,0 +1,130 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ManagerTestSuite struct {
+	suite.Suite
+	manager *Manager
+}
+
+func (s *ManagerTestSuite) SetupTest() {
+	s.manager = NewManager(0)
+}
+
+func TestManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(ManagerTestSuite))
+}
+
+func (s *ManagerTestSuite) TestShutdown_RunsStagesInOrderAfterLeavingReadiness() {
+	s.manager.MarkReady()
+	s.True(s.manager.Ready())
+
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) StopFunc {
+		return func(context.Context) error {
+			// Readiness has failed before the first stage runs
+			s.False(s.manager.Ready())
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return nil
+		}
+	}
+	s.manager.OnShutdown("http server", time.Second, record("http server"))
+	s.manager.OnShutdown("background loops", time.Second, record("background loops"))
+	s.manager.OnShutdown("database", 0, record("database"))
+
+	s.NoError(s.manager.Shutdown())
+	s.Equal([]string{"http server", "background loops", "database"}, order)
+}
+
+func (s *ManagerTestSuite) TestShutdown_CarriesOnPastFailedAndTimedOutStages() {
+	databaseClosed := false
+	s.manager.OnShutdown("queue drain", 20*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	s.manager.OnShutdown("tracing", 0, func(context.Context) error {
+		return errors.New("collector unreachable")
+	})
+	s.manager.OnShutdown("database", 0, func(context.Context) error {
+		databaseClosed = true
+		return nil
+	})
+
+	err := s.manager.Shutdown()
+
+	s.ErrorIs(err, context.DeadlineExceeded)
+	s.ErrorContains(err, "queue drain")
+	s.ErrorContains(err, "collector unreachable")
+	s.True(databaseClosed)
+}
+
+func (s *ManagerTestSuite) TestStopLoops_CancelsAndWaitsForLoops() {
+	stopped := make(chan struct{})
+	s.manager.Go("queue", func(ctx context.Context) {
+		<-ctx.Done()
+		// Loops may take a moment to wind down after cancellation
+		time.Sleep(20 * time.Millisecond)
+		close(stopped)
+	})
+
+	s.NoError(s.manager.StopLoops(context.Background()))
+	select {
+	case <-stopped:
+	default:
+		s.Fail("StopLoops returned before the loop stopped")
+	}
+}
+
+func (s *ManagerTestSuite) TestStopLoops_GivesUpAtDeadline() {
+	release := make(chan struct{})
+	defer close(release)
+	s.manager.Go("stuck", func(context.Context) {
+		<-release
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	s.ErrorIs(s.manager.StopLoops(ctx), context.DeadlineExceeded)
+}
+
+func (s *ManagerTestSuite) TestWait_ReturnsFirstFailure() {
+	s.manager.Fail(errors.New("listen tcp :8080: address already in use"))
+	s.manager.Fail(errors.New("metrics listener failed"))
+
+	s.EqualError(s.manager.Wait(), "listen tcp :8080: address already in use")
+}
+
+func (s *ManagerTestSuite) TestAll_RunsConcurrentlyAndJoinsErrors() {
+	started := make(chan struct{}, 2)
+	both := func(err error) StopFunc {
+		return func(ctx context.Context) error {
+			started <- struct{}{}
+			// Each part only finishes once the other has started
+			for len(started) < 2 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(time.Millisecond):
+				}
+			}
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := All(both(nil), both(errors.New("job runs still in progress")))(ctx)
+
+	s.EqualError(err, "job runs still in progress")
+}
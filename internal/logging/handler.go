@@ -0,0 +1,142 @@
+// Package logging builds the slog pipeline shared by the API, background workers and GORM:
+// one output format, per-package log levels and redaction of PII and credentials.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/array/banking-api/internal/config"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Handler redacts every record and drops records below the level configured for the package
+// that logged them. Packages are matched by their last path element, e.g. "services".
+type Handler struct {
+	next          slog.Handler
+	redactor      *Redactor
+	level         slog.Level
+	packageLevels map[string]slog.Level
+	minLevel      slog.Level // Lowest of level and packageLevels; cheap pre-filter for Enabled
+	packages      *sync.Map  // Record PC -> package name
+}
+
+// NewHandler builds the handler described by cfg, writing to w.
+func NewHandler(cfg config.LoggingConfig, w io.Writer) (*Handler, error) {
+	level, err := parseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	minLevel := level
+	packageLevels := make(map[string]slog.Level, len(cfg.PackageLevels))
+	for pkg, value := range cfg.PackageLevels {
+		packageLevel, err := parseLevel(value)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", pkg, err)
+		}
+		packageLevels[pkg] = packageLevel
+		minLevel = min(minLevel, packageLevel)
+	}
+
+	options := &slog.HandlerOptions{Level: minLevel}
+	var next slog.Handler
+	switch cfg.Format {
+	case FormatJSON:
+		next = slog.NewJSONHandler(w, options)
+	case FormatText, "":
+		next = slog.NewTextHandler(w, options)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", cfg.Format)
+	}
+
+	return &Handler{
+		next:          next,
+		redactor:      NewRedactor(cfg.RedactKeys),
+		level:         level,
+		packageLevels: packageLevels,
+		minLevel:      minLevel,
+		packages:      &sync.Map{},
+	}, nil
+}
+
+func (h *Handler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.minLevel
+}
+
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level < h.levelFor(record.PC) {
+		return nil
+	}
+
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.String(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactor.Attr(attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = h.redactor.Attr(attr)
+	}
+	clone := *h
+	clone.next = h.next.WithAttrs(redacted)
+	return &clone
+}
+
+func (h *Handler) WithGroup(name string) slog.Handler {
+	clone := *h
+	clone.next = h.next.WithGroup(name)
+	return &clone
+}
+
+// levelFor returns the level configured for the package containing pc.
+func (h *Handler) levelFor(pc uintptr) slog.Level {
+	if len(h.packageLevels) == 0 || pc == 0 {
+		return h.level
+	}
+
+	pkg, ok := h.packages.Load(pc)
+	if !ok {
+		frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+		pkg = packageName(frame.Function)
+		h.packages.Store(pc, pkg)
+	}
+	if level, ok := h.packageLevels[pkg.(string)]; ok {
+		return level
+	}
+	return h.level
+}
+
+// packageName extracts the package name from a function name such as
+// "github.com/array/banking-api/internal/services.(*accountService).Transfer".
+func packageName(function string) string {
+	name := function
+	if slash := strings.LastIndex(name, "/"); slash >= 0 {
+		name = name[slash+1:]
+	}
+	if dot := strings.Index(name, "."); dot >= 0 {
+		name = name[:dot]
+	}
+	return name
+}
+
+func parseLevel(value string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q: %w", value, err)
+	}
+	return level, nil
+}
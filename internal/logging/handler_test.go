@@ -0,0 +1,80 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	buf bytes.Buffer
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.buf.Reset()
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) newLogger(cfg config.LoggingConfig) *slog.Logger {
+	handler, err := NewHandler(cfg, &s.buf)
+	s.Require().NoError(err)
+	return slog.New(handler)
+}
+
+func (s *HandlerTestSuite) TestRedactsRecordAndLoggerAttrs() {
+	logger := s.newLogger(config.LoggingConfig{Level: "info", Format: FormatJSON}).
+		With("user_email", "jane@example.com")
+
+	logger.Info("login for jane@example.com", "password", "hunter2", "account_number", "1012345678")
+
+	var entry map[string]string
+	s.Require().NoError(json.Unmarshal(s.buf.Bytes(), &entry))
+	s.Equal("login for j***@example.com", entry["msg"])
+	s.Equal("j***@example.com", entry["user_email"])
+	s.Equal(RedactedValue, entry["password"])
+	s.Equal("****5678", entry["account_number"])
+}
+
+func (s *HandlerTestSuite) TestPackageLevels() {
+	logger := s.newLogger(config.LoggingConfig{
+		Level:         "warn",
+		PackageLevels: map[string]string{"logging": "debug"},
+	})
+
+	// This test file is in the logging package, so its debug output is kept
+	logger.Debug("package override applies")
+	s.Contains(s.buf.String(), "package override applies")
+
+	s.buf.Reset()
+	logger = s.newLogger(config.LoggingConfig{
+		Level:         "debug",
+		PackageLevels: map[string]string{"logging": "error"},
+	})
+	logger.Warn("below package level")
+	s.Empty(s.buf.String())
+}
+
+func (s *HandlerTestSuite) TestInvalidConfig() {
+	_, err := NewHandler(config.LoggingConfig{Level: "verbose"}, &s.buf)
+	s.Error(err)
+
+	_, err = NewHandler(config.LoggingConfig{Level: "info", PackageLevels: map[string]string{"services": "loud"}}, &s.buf)
+	s.Error(err)
+
+	_, err = NewHandler(config.LoggingConfig{Level: "info", Format: "xml"}, &s.buf)
+	s.Error(err)
+}
+
+func (s *HandlerTestSuite) TestPackageName() {
+	s.Equal("services", packageName("github.com/array/banking-api/internal/services.(*accountService).Transfer"))
+	s.Equal("main", packageName("main.main"))
+	s.Equal("database", packageName("github.com/array/banking-api/internal/database.(*GormLogger).Trace"))
+}
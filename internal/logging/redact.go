@@ -0,0 +1,194 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces secrets and other values that must never appear in logs.
+const RedactedValue = "***REDACTED***"
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// JWTs and bearer credentials, e.g. in a logged Authorization header or error message
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)
+	bearerPattern = regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/\-]+=*`)
+)
+
+// Account numbers are 10-17 digits and routing numbers 9; shorter runs such as counts are left
+// alone.
+const (
+	minAccountNumberDigits = 9
+	maxAccountNumberDigits = 17
+)
+
+type redaction int
+
+const (
+	redactSecret redaction = iota
+	redactAccountNumber
+	redactEmail
+)
+
+// defaultKeyRules redacts attributes by key, matched case-insensitively against the end of the
+// key with separators removed, so "refresh_token" and "newPassword" are both covered.
+var defaultKeyRules = map[string]redaction{
+	"password":      redactSecret,
+	"passwordhash":  redactSecret,
+	"token":         redactSecret,
+	"secret":        redactSecret,
+	"apikey":        redactSecret,
+	"authorization": redactSecret,
+	"cookie":        redactSecret,
+	"accountnumber": redactAccountNumber,
+	"routingnumber": redactAccountNumber,
+	"email":         redactEmail,
+}
+
+// Redactor removes PII and credentials from log attributes. Known keys are redacted or masked
+// outright; every other string value, including error messages, is scanned for emails, account
+// and routing numbers and tokens.
+type Redactor struct {
+	keyRules map[string]redaction
+}
+
+// NewRedactor returns a Redactor with the default rules plus extraKeys, whose values are always
+// replaced with RedactedValue.
+func NewRedactor(extraKeys []string) *Redactor {
+	rules := make(map[string]redaction, len(defaultKeyRules)+len(extraKeys))
+	for key, rule := range defaultKeyRules {
+		rules[key] = rule
+	}
+	for _, key := range extraKeys {
+		rules[normalizeKey(key)] = redactSecret
+	}
+	return &Redactor{keyRules: rules}
+}
+
+// Attr returns a copy of attr with sensitive values redacted, descending into groups.
+func (r *Redactor) Attr(attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+
+	if value.Kind() == slog.KindGroup {
+		attrs := value.Group()
+		redacted := make([]slog.Attr, len(attrs))
+		for i, a := range attrs {
+			redacted[i] = r.Attr(a)
+		}
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(redacted...)}
+	}
+
+	if rule, ok := r.ruleFor(attr.Key); ok {
+		return slog.String(attr.Key, applyRule(rule, valueString(value)))
+	}
+
+	switch value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, r.String(value.String()))
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			return slog.String(attr.Key, r.String(err.Error()))
+		}
+		if s, ok := value.Any().(fmt.Stringer); ok {
+			return slog.String(attr.Key, r.String(s.String()))
+		}
+	}
+	return slog.Attr{Key: attr.Key, Value: value}
+}
+
+// String masks emails, account and routing numbers and tokens found anywhere in s.
+func (r *Redactor) String(s string) string {
+	s = jwtPattern.ReplaceAllString(s, RedactedValue)
+	s = bearerPattern.ReplaceAllString(s, "Bearer "+RedactedValue)
+	s = emailPattern.ReplaceAllStringFunc(s, MaskEmail)
+	return maskDigitRuns(s)
+}
+
+// maskDigitRuns masks standalone runs of account or routing number length. Runs that are part of
+// a larger token, such as a UUID segment, a decimal amount or an identifier, are left alone.
+func maskDigitRuns(s string) string {
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(s); {
+		if !isDigit(s[i]) {
+			i++
+			continue
+		}
+		end := i
+		for end < len(s) && isDigit(s[end]) {
+			end++
+		}
+		length := end - i
+		standalone := (i == 0 || !isTokenByte(s[i-1])) && (end == len(s) || !isTokenByte(s[end]))
+		if standalone && length >= minAccountNumberDigits && length <= maxAccountNumberDigits {
+			b.WriteString(s[last:i])
+			b.WriteString(MaskAccountNumber(s[i:end]))
+			last = end
+		}
+		i = end
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isTokenByte(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-' || c == '_' || c == '.'
+}
+
+func (r *Redactor) ruleFor(key string) (redaction, bool) {
+	normalized := normalizeKey(key)
+	for suffix, rule := range r.keyRules {
+		if strings.HasSuffix(normalized, suffix) {
+			return rule, true
+		}
+	}
+	return 0, false
+}
+
+func applyRule(rule redaction, value string) string {
+	switch rule {
+	case redactAccountNumber:
+		return MaskAccountNumber(value)
+	case redactEmail:
+		return emailPattern.ReplaceAllStringFunc(value, MaskEmail)
+	default:
+		return RedactedValue
+	}
+}
+
+func valueString(value slog.Value) string {
+	if err, ok := value.Any().(error); ok && value.Kind() == slog.KindAny {
+		return err.Error()
+	}
+	return value.String()
+}
+
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "", ".", "").Replace(key))
+}
+
+// MaskAccountNumber keeps the last four digits of an account or routing number.
+func MaskAccountNumber(accountNumber string) string {
+	if len(accountNumber) <= 4 {
+		return "****"
+	}
+	return "****" + accountNumber[len(accountNumber)-4:]
+}
+
+// MaskEmail keeps the first character of the local part and the domain, e.g. j***@example.com.
+func MaskEmail(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" {
+		return RedactedValue
+	}
+	return local[:1] + "***@" + domain
+}
@@ -33,7 +33,7 @@ func RequireAuth(tokenService services.TokenServiceInterface, blacklistedTokenRe
 				return handlers.SendError(c, errors.AuthInvalidTokenFormat)
 			}
 
-			blacklistedToken, err := blacklistedTokenRepo.GetByJTI(claims.ID)
+			blacklistedToken, err := blacklistedTokenRepo.GetByJTI(c.Request().Context(), claims.ID)
 			if err == nil && blacklistedToken != nil {
 				return handlers.SendError(c, errors.AuthInvalidTokenFormat, errors.WithDetails("Token has been revoked"))
 			}
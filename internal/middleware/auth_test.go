@@ -76,7 +76,7 @@ func (s *AuthMiddlewareSuite) TestRequireAuth_ValidToken() {
 		Role:  models.RoleCustomer,
 	}
 
-	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(gomock.Any()).Return(nil, nil)
+	s.mockBlacklistedTokenRepo.EXPECT().GetByJTI(gomock.Any(), gomock.Any()).Return(nil, nil)
 
 	token, _, err := s.tokenService.GenerateAccessToken(user)
 	s.NoError(err)
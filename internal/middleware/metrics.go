@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/handlers"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// unmatchedRoute labels requests that matched no route, so scanners probing random paths do not
+// create a series per path.
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests by method, route template and status",
+		},
+		[]string{"method", "route", "status"},
+	)
+	httpRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency in seconds by method and route template",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "route"},
+	)
+	httpRequestsInFlight = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Current number of HTTP requests being served",
+		},
+	)
+)
+
+// HTTPMetrics records the rate, errors and latency of every request, labelled by the route
+// template (for example /api/v1/accounts/:accountId) rather than the raw path. Errors returned by
+// handlers are rendered here so the recorded status is the one the client receives.
+func HTTPMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			httpRequestsInFlight.Inc()
+			defer httpRequestsInFlight.Dec()
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			route := routeTemplate(c)
+			method := c.Request().Method
+
+			httpRequestsTotal.WithLabelValues(method, route, strconv.Itoa(c.Response().Status)).Inc()
+			httpRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
+
+// routeTemplate returns the template of the route that served the request. Requests that matched
+// no route, including those answered by a group's /* catch-all, share unmatchedRoute.
+func routeTemplate(c echo.Context) string {
+	route := c.Path()
+	if route == "" || c.Response().Status == http.StatusNotFound && strings.HasSuffix(route, "/*") {
+		return unmatchedRoute
+	}
+	return route
+}
+
+// RequireMetricsToken protects the metrics endpoint with a static bearer token shared with the
+// scraper.
+func RequireMetricsToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authHeader := c.Request().Header.Get("Authorization")
+			if authHeader == "" {
+				return handlers.SendError(c, errors.AuthMissingToken)
+			}
+
+			presented, found := strings.CutPrefix(authHeader, "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+				return handlers.SendError(c, errors.AuthInvalidTokenFormat)
+			}
+
+			return next(c)
+		}
+	}
+}
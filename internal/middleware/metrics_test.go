@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/suite"
+)
+
+// MetricsTestSuite defines the test suite for the HTTP metrics and metrics token middleware
+type MetricsTestSuite struct {
+	suite.Suite
+	echo *echo.Echo
+}
+
+// SetupTest runs before each test
+func (s *MetricsTestSuite) SetupTest() {
+	s.echo = echo.New()
+	s.echo.HTTPErrorHandler = CustomHTTPErrorHandler
+	s.echo.Use(HTTPMetrics())
+	s.echo.GET("/accounts/:accountId", func(c echo.Context) error {
+		if c.Param("accountId") == "missing" {
+			return echo.NewHTTPError(http.StatusNotFound, "account not found")
+		}
+		return c.NoContent(http.StatusOK)
+	})
+	// Groups with middleware register a /* catch-all that serves their 404s
+	s.echo.Group("/api", SecurityHeaders()).GET("/health", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+}
+
+// TestMetricsTestSuite runs the test suite
+func TestMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(MetricsTestSuite))
+}
+
+func (s *MetricsTestSuite) serve(method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+// TestHTTPMetrics_LabelsByRouteTemplate tests that requests to different IDs share one series
+func (s *MetricsTestSuite) TestHTTPMetrics_LabelsByRouteTemplate() {
+	counter := httpRequestsTotal.WithLabelValues(http.MethodGet, "/accounts/:accountId", "200")
+	before := testutil.ToFloat64(counter)
+
+	s.serve(http.MethodGet, "/accounts/1")
+	s.serve(http.MethodGet, "/accounts/2")
+
+	s.Equal(before+2, testutil.ToFloat64(counter))
+}
+
+// TestHTTPMetrics_RecordsRenderedErrorStatus tests that handler errors are recorded with the status sent
+func (s *MetricsTestSuite) TestHTTPMetrics_RecordsRenderedErrorStatus() {
+	counter := httpRequestsTotal.WithLabelValues(http.MethodGet, "/accounts/:accountId", "404")
+	before := testutil.ToFloat64(counter)
+
+	rec := s.serve(http.MethodGet, "/accounts/missing")
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Contains(rec.Body.String(), "account not found")
+	s.Equal(before+1, testutil.ToFloat64(counter))
+}
+
+// TestHTTPMetrics_UnmatchedRoute tests that unknown paths are collapsed into one series
+func (s *MetricsTestSuite) TestHTTPMetrics_UnmatchedRoute() {
+	counter := httpRequestsTotal.WithLabelValues(http.MethodGet, unmatchedRoute, "404")
+	before := testutil.ToFloat64(counter)
+
+	s.serve(http.MethodGet, "/wp-admin/setup.php")
+	s.serve(http.MethodGet, "/.env")
+	s.serve(http.MethodGet, "/api/unknown")
+
+	s.Equal(before+3, testutil.ToFloat64(counter))
+	s.Zero(testutil.ToFloat64(httpRequestsInFlight))
+}
+
+// TestRequireMetricsToken tests that only the configured bearer token can scrape
+func (s *MetricsTestSuite) TestRequireMetricsToken() {
+	s.echo.GET("/metrics", func(c echo.Context) error {
+		return c.String(http.StatusOK, "metrics")
+	}, RequireMetricsToken("scrape-secret"))
+
+	tests := []struct {
+		name          string
+		authorization string
+		expected      int
+	}{
+		{"missing token", "", http.StatusUnauthorized},
+		{"wrong token", "Bearer other-secret", http.StatusUnauthorized},
+		{"not a bearer token", "scrape-secret", http.StatusUnauthorized},
+		{"valid token", "Bearer scrape-secret", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			s.echo.ServeHTTP(rec, req)
+
+			s.Equal(tt.expected, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/labstack/echo/v4"
+)
+
+// ClientInfo stores the caller's IP address and user agent in the request context, where services
+// read them when recording audit entries.
+func ClientInfo() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := requestctx.WithClient(req.Context(), c.RealIP(), req.UserAgent())
+			c.SetRequest(req.WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// RequestTimeout gives each request's context a deadline, so database work and outbound calls
+// for a request that has taken too long are cancelled instead of running on after the client has
+// given up. routeTimeouts overrides defaultTimeout by route template, keyed either as
+// "METHOD /route" or as "/route" for every method; a zero timeout leaves the request unbounded.
+func RequestTimeout(defaultTimeout time.Duration, routeTimeouts map[string]time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			timeout := defaultTimeout
+			if override, ok := routeTimeouts[c.Request().Method+" "+c.Path()]; ok {
+				timeout = override
+			} else if override, ok := routeTimeouts[c.Path()]; ok {
+				timeout = override
+			}
+			if timeout <= 0 {
+				return next(c)
+			}
+
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/errors"
+	"github.com/array/banking-api/internal/handlers"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// RequestContextTestSuite defines the test suite for the client info and request timeout middleware
+type RequestContextTestSuite struct {
+	suite.Suite
+	echo *echo.Echo
+}
+
+// SetupTest runs before each test
+func (s *RequestContextTestSuite) SetupTest() {
+	s.echo = echo.New()
+	s.echo.HTTPErrorHandler = CustomHTTPErrorHandler
+}
+
+// TestRequestContextTestSuite runs the test suite
+func TestRequestContextTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestContextTestSuite))
+}
+
+// remainingTime serves a request and returns how long the handler's context had left, or zero
+// when it had no deadline
+func (s *RequestContextTestSuite) remainingTime(method, path string) time.Duration {
+	var remaining time.Duration
+	handler := func(c echo.Context) error {
+		if deadline, ok := c.Request().Context().Deadline(); ok {
+			remaining = time.Until(deadline)
+		}
+		return c.NoContent(http.StatusOK)
+	}
+	s.echo.GET("/accounts/:accountId", handler)
+	s.echo.POST("/accounts/:accountId/statements", handler)
+	s.echo.GET("/accounts/:accountId/statements", handler)
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	s.Equal(http.StatusOK, rec.Code)
+	return remaining
+}
+
+// TestClientInfo_StoresCaller tests that the caller's IP and user agent are available to services
+func (s *RequestContextTestSuite) TestClientInfo_StoresCaller() {
+	s.echo.Use(ClientInfo())
+	s.echo.GET("/", func(c echo.Context) error {
+		s.Equal("203.0.113.7", requestctx.IPAddress(c.Request().Context()))
+		s.Equal("banking-app/2.1", requestctx.UserAgent(c.Request().Context()))
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderXRealIP, "203.0.113.7")
+	req.Header.Set("User-Agent", "banking-app/2.1")
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+// TestRequestTimeout_DefaultDeadline tests that routes without an override get the default deadline
+func (s *RequestContextTestSuite) TestRequestTimeout_DefaultDeadline() {
+	s.echo.Use(RequestTimeout(5*time.Second, map[string]time.Duration{
+		"POST /accounts/:accountId/statements": time.Minute,
+	}))
+
+	remaining := s.remainingTime(http.MethodGet, "/accounts/123")
+	s.Greater(remaining, 4*time.Second)
+	s.LessOrEqual(remaining, 5*time.Second)
+}
+
+// TestRequestTimeout_RouteOverrides tests that overrides match by method and route, then by route alone
+func (s *RequestContextTestSuite) TestRequestTimeout_RouteOverrides() {
+	s.echo.Use(RequestTimeout(5*time.Second, map[string]time.Duration{
+		"POST /accounts/:accountId/statements": time.Minute,
+		"/accounts/:accountId/statements":      30 * time.Second,
+	}))
+
+	s.Greater(s.remainingTime(http.MethodPost, "/accounts/123/statements"), 59*time.Second)
+
+	remaining := s.remainingTime(http.MethodGet, "/accounts/123/statements")
+	s.Greater(remaining, 29*time.Second)
+	s.LessOrEqual(remaining, 30*time.Second)
+}
+
+// TestRequestTimeout_ZeroDisables tests that a zero timeout leaves the request without a deadline
+func (s *RequestContextTestSuite) TestRequestTimeout_ZeroDisables() {
+	s.echo.Use(RequestTimeout(5*time.Second, map[string]time.Duration{
+		"/accounts/:accountId": 0,
+	}))
+
+	s.Zero(s.remainingTime(http.MethodGet, "/accounts/123"))
+}
+
+// TestRequestTimeout_ExpiredDeadlineReturnsGatewayTimeout tests that work cut off by the deadline
+// is reported to the client as a timeout
+func (s *RequestContextTestSuite) TestRequestTimeout_ExpiredDeadlineReturnsGatewayTimeout() {
+	s.echo.Use(RequestTimeout(10*time.Millisecond, nil))
+	s.echo.GET("/slow", func(c echo.Context) error {
+		<-c.Request().Context().Done()
+		return handlers.SendSystemError(c, c.Request().Context().Err())
+	})
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/slow", nil))
+
+	s.Equal(http.StatusGatewayTimeout, rec.Code)
+	var response errors.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	s.Equal(string(errors.SystemRequestTimeout), response.Error.Code)
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -13,7 +14,9 @@ const (
 )
 
 // RequestID is a middleware that generates a unique trace ID for each request
-// and sets it in both the response header and the request context
+// and sets it in both the response header and the request context.
+// When Tracing runs first, the OpenTelemetry trace ID is used so error responses
+// and logs can be looked up in the tracing backend.
 func RequestID() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -22,7 +25,11 @@ func RequestID() echo.MiddlewareFunc {
 
 			traceID := req.Header.Get(TraceIDHeader)
 			if traceID == "" {
-				traceID = uuid.New().String()
+				if spanContext := trace.SpanContextFromContext(req.Context()); spanContext.HasTraceID() {
+					traceID = spanContext.TraceID().String()
+				} else {
+					traceID = uuid.New().String()
+				}
 			}
 
 			c.Set(TraceIDContextKey, traceID)
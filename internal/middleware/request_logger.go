@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// RequestLogger writes one structured line per request through logger, at info for successful
+// requests, warn for client errors and error for server errors. Handler errors are rendered first
+// so the logged status is the one the client receives. It must run inside RequestID.
+func RequestLogger(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			req := c.Request()
+			res := c.Response()
+			attrs := []slog.Attr{
+				slog.String("trace_id", GetTraceID(c)),
+				slog.String("method", req.Method),
+				slog.String("route", routeTemplate(c)),
+				slog.String("path", req.URL.Path),
+				slog.Int("status", res.Status),
+				slog.Int64("latency_ms", time.Since(start).Milliseconds()),
+				slog.Int64("bytes_out", res.Size),
+				slog.String("remote_ip", c.RealIP()),
+				slog.String("user_agent", req.UserAgent()),
+			}
+			if userID, ok := c.Get("user_id").(uuid.UUID); ok {
+				attrs = append(attrs, slog.String("user_id", userID.String()))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.Any("error", err))
+			}
+
+			level := slog.LevelInfo
+			switch {
+			case res.Status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case res.Status >= http.StatusBadRequest:
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(req.Context(), level, "http request", attrs...)
+
+			return err
+		}
+	}
+}
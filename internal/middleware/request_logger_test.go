@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+// RequestLoggerTestSuite defines the test suite for the request logger middleware
+type RequestLoggerTestSuite struct {
+	suite.Suite
+	echo   *echo.Echo
+	buf    bytes.Buffer
+	userID uuid.UUID
+}
+
+// SetupTest runs before each test
+func (s *RequestLoggerTestSuite) SetupTest() {
+	s.buf.Reset()
+	s.userID = uuid.New()
+
+	s.echo = echo.New()
+	s.echo.HTTPErrorHandler = CustomHTTPErrorHandler
+	s.echo.Use(RequestID())
+	s.echo.Use(RequestLogger(slog.New(slog.NewJSONHandler(&s.buf, nil))))
+	s.echo.GET("/accounts/:accountId", func(c echo.Context) error {
+		c.Set("user_id", s.userID)
+		if c.Param("accountId") == "missing" {
+			return echo.NewHTTPError(http.StatusNotFound, "account not found")
+		}
+		return c.String(http.StatusOK, "ok")
+	})
+}
+
+// TestRequestLoggerTestSuite runs the test suite
+func TestRequestLoggerTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestLoggerTestSuite))
+}
+
+func (s *RequestLoggerTestSuite) serve(path string) (*httptest.ResponseRecorder, map[string]interface{}) {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set(TraceIDHeader, "trace-123")
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	var entry map[string]interface{}
+	s.Require().NoError(json.Unmarshal(s.buf.Bytes(), &entry))
+	return rec, entry
+}
+
+// TestRequestLogger_LogsRequest tests the fields written for a successful request
+func (s *RequestLoggerTestSuite) TestRequestLogger_LogsRequest() {
+	_, entry := s.serve("/accounts/123")
+
+	s.Equal("INFO", entry["level"])
+	s.Equal("trace-123", entry["trace_id"])
+	s.Equal(s.userID.String(), entry["user_id"])
+	s.Equal("/accounts/:accountId", entry["route"])
+	s.Equal("/accounts/123", entry["path"])
+	s.Equal(float64(http.StatusOK), entry["status"])
+	s.Contains(entry, "latency_ms")
+}
+
+// TestRequestLogger_ClientErrorLoggedAtWarn tests that handler errors are logged with the rendered status
+func (s *RequestLoggerTestSuite) TestRequestLogger_ClientErrorLoggedAtWarn() {
+	rec, entry := s.serve("/accounts/missing")
+
+	s.Equal(http.StatusNotFound, rec.Code)
+	s.Equal("WARN", entry["level"])
+	s.Equal(float64(http.StatusNotFound), entry["status"])
+	s.Contains(entry["error"], "account not found")
+}
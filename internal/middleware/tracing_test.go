@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	testTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+)
+
+// TracingTestSuite defines the test suite for the tracing middleware
+type TracingTestSuite struct {
+	suite.Suite
+	echo             *echo.Echo
+	recorder         *tracetest.SpanRecorder
+	previousProvider trace.TracerProvider
+}
+
+// SetupTest installs a recording tracer provider and a server with tracing enabled
+func (s *TracingTestSuite) SetupTest() {
+	s.recorder = tracetest.NewSpanRecorder()
+	s.previousProvider = otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	s.echo = echo.New()
+	s.echo.HTTPErrorHandler = CustomHTTPErrorHandler
+	s.echo.Use(Tracing())
+	s.echo.Use(RequestID())
+	s.echo.GET("/accounts/:accountId", func(c echo.Context) error {
+		if c.Param("accountId") == "broken" {
+			return errors.New("database unavailable")
+		}
+		return c.NoContent(http.StatusOK)
+	})
+}
+
+// TearDownTest restores the global tracer provider
+func (s *TracingTestSuite) TearDownTest() {
+	otel.SetTracerProvider(s.previousProvider)
+}
+
+// TestTracingTestSuite runs the test suite
+func TestTracingTestSuite(t *testing.T) {
+	suite.Run(t, new(TracingTestSuite))
+}
+
+// TestTracing_ContinuesInboundTrace tests that a traceparent header parents the server span
+func (s *TracingTestSuite) TestTracing_ContinuesInboundTrace() {
+	req := httptest.NewRequest(http.MethodGet, "/accounts/123", nil)
+	req.Header.Set("traceparent", testTraceParent)
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	spans := s.recorder.Ended()
+	s.Require().Len(spans, 1)
+	span := spans[0]
+	s.Equal("GET /accounts/:accountId", span.Name())
+	s.Equal(trace.SpanKindServer, span.SpanKind())
+	s.Equal(testTraceID, span.SpanContext().TraceID().String())
+	s.True(span.Parent().IsRemote())
+	s.Contains(span.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+
+	// The trace ID doubles as the request's trace ID
+	s.Equal(testTraceID, rec.Header().Get(TraceIDHeader))
+}
+
+// TestTracing_ErrorResponseCarriesTraceID tests that server errors mark the span and expose its trace ID
+func (s *TracingTestSuite) TestTracing_ErrorResponseCarriesTraceID() {
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/accounts/broken", nil))
+
+	spans := s.recorder.Ended()
+	s.Require().Len(spans, 1)
+	traceID := spans[0].SpanContext().TraceID().String()
+	s.Equal(codes.Error, spans[0].Status().Code)
+	s.Equal(http.StatusInternalServerError, rec.Code)
+	s.Contains(rec.Body.String(), traceID)
+}
+
+// TestTracing_KeepsClientTraceIDHeader tests that an explicit X-Trace-ID is kept and linked to the span
+func (s *TracingTestSuite) TestTracing_KeepsClientTraceIDHeader() {
+	req := httptest.NewRequest(http.MethodGet, "/accounts/123", nil)
+	req.Header.Set(TraceIDHeader, "client-trace-id")
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	s.Equal("client-trace-id", rec.Header().Get(TraceIDHeader))
+	spans := s.recorder.Ended()
+	s.Require().Len(spans, 1)
+	s.Contains(spans[0].Attributes(), attribute.String("request.trace_id", "client-trace-id"))
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Compliance report types
+const (
+	ComplianceReportTypeCTR         = "ctr"         // Currency Transaction Report: daily total above the threshold
+	ComplianceReportTypeStructuring = "structuring" // Repeated near-threshold transactions across days or accounts
+)
+
+// Compliance report statuses
+const (
+	ComplianceReportStatusPendingReview = "pending_review" // Generated; awaiting admin review
+	ComplianceReportStatusApproved      = "approved"       // Approved for filing; queued for submission
+	ComplianceReportStatusRejected      = "rejected"       // Dismissed by an admin; never filed
+	ComplianceReportStatusSubmitted     = "submitted"      // Accepted by the regulator report endpoint
+)
+
+// Compliance activity categories
+const (
+	ComplianceActivityCash     = "cash"     // Deposits, withdrawals and other postings not tied to a transfer
+	ComplianceActivityTransfer = "transfer" // External transfers and inbound partner credits
+)
+
+// ComplianceReport is a Currency Transaction Report or structuring alert for one customer.
+// Reports are generated per business day and filed with the regulator only after an admin
+// approves them. A customer has at most one report of each type per business day.
+type ComplianceReport struct {
+	ID                   uuid.UUID       `gorm:"type:uuid;primary_key"`
+	ReportType           string          `gorm:"type:varchar(20);not null;uniqueIndex:idx_compliance_reports_subject_day"`
+	UserID               uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_compliance_reports_subject_day;index"`
+	BusinessDate         time.Time       `gorm:"type:date;not null;uniqueIndex:idx_compliance_reports_subject_day;index"`
+	WindowStart          time.Time       `gorm:"type:date;not null"` // First business day covered; equals BusinessDate for CTRs
+	CashInAmount         decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+	CashOutAmount        decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+	TransferInAmount     decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+	TransferOutAmount    decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+	TransactionCount     int             `gorm:"not null;default:0"`
+	Details              JSONBMap        `gorm:"type:jsonb"` // Covered transactions and the figures that triggered the report
+	Status               string          `gorm:"type:varchar(20);not null;default:'pending_review';index"`
+	ReviewedBy           *uuid.UUID      `gorm:"type:uuid"`
+	ReviewedAt           *time.Time
+	ReviewNote           *string    `gorm:"type:text"`
+	SubmissionAttempts   int        `gorm:"not null;default:0"`
+	NextSubmissionAt     *time.Time `gorm:"index"`
+	SubmittedAt          *time.Time
+	SubmissionStatusCode *int
+	SubmissionResponse   *string `gorm:"type:text"`
+	LastError            *string `gorm:"type:text"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+
+	// Associations
+	User User `gorm:"foreignKey:UserID"`
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (r *ComplianceReport) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	if r.Status == "" {
+		r.Status = ComplianceReportStatusPendingReview
+	}
+	return
+}
+
+// TotalIn returns the customer's inbound cash and transfer total.
+func (r *ComplianceReport) TotalIn() decimal.Decimal {
+	return r.CashInAmount.Add(r.TransferInAmount)
+}
+
+// TotalOut returns the customer's outbound cash and transfer total.
+func (r *ComplianceReport) TotalOut() decimal.Decimal {
+	return r.CashOutAmount.Add(r.TransferOutAmount)
+}
+
+// IsPendingReview reports whether the report is awaiting an admin decision.
+func (r *ComplianceReport) IsPendingReview() bool {
+	return r.Status == ComplianceReportStatusPendingReview
+}
+
+// Approve records the reviewing admin and queues the report for submission.
+func (r *ComplianceReport) Approve(adminID uuid.UUID, note string) {
+	r.review(adminID, note)
+	r.Status = ComplianceReportStatusApproved
+	r.NextSubmissionAt = r.ReviewedAt
+}
+
+// Reject records the reviewing admin and closes the report without filing it.
+func (r *ComplianceReport) Reject(adminID uuid.UUID, note string) {
+	r.review(adminID, note)
+	r.Status = ComplianceReportStatusRejected
+}
+
+func (r *ComplianceReport) review(adminID uuid.UUID, note string) {
+	now := time.Now()
+	r.ReviewedBy = &adminID
+	r.ReviewedAt = &now
+	r.ReviewNote = &note
+}
+
+// ComplianceActivity is one completed transaction considered by the compliance checks,
+// with the transfer or inbound credit it belongs to.
+type ComplianceActivity struct {
+	TransactionID      uuid.UUID
+	AccountID          uuid.UUID
+	UserID             uuid.UUID
+	TransactionType    string
+	Amount             decimal.Decimal
+	CreatedAt          time.Time
+	TransferID         *uuid.UUID
+	InboundCreditID    *uuid.UUID
+	CounterpartyUserID *uuid.UUID // Owner of the other account of an internal transfer
+}
+
+// Category classifies the transaction as cash-like or transfer activity.
+func (a *ComplianceActivity) Category() string {
+	if a.TransferID != nil || a.InboundCreditID != nil {
+		return ComplianceActivityTransfer
+	}
+	return ComplianceActivityCash
+}
+
+// IsOwnAccountMove reports whether the transaction moves money between two accounts of the
+// same customer, which is not reportable activity.
+func (a *ComplianceActivity) IsOwnAccountMove() bool {
+	return a.CounterpartyUserID != nil && *a.CounterpartyUserID == a.UserID
+}
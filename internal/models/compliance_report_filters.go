@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ComplianceReportFilters contains filter criteria for compliance report queries
+type ComplianceReportFilters struct {
+	ReportType   string
+	Status       string
+	UserID       *uuid.UUID
+	BusinessDate *time.Time
+}
@@ -4,23 +4,52 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
+// External account verification statuses
+const (
+	ExternalAccountStatusUnverified        = "unverified"          // Registered, ownership not yet proven
+	ExternalAccountStatusMicroDepositsSent = "micro_deposits_sent" // Two small deposits sent, awaiting confirmation
+	ExternalAccountStatusVerified          = "verified"            // Amounts confirmed by the customer
+	ExternalAccountStatusFailed            = "failed"              // Partner rejected the micro-deposits; may be re-sent
+	ExternalAccountStatusLocked            = "locked"              // Too many incorrect confirmation attempts
+
+	MaxMicroDepositVerificationAttempts = 3
+	MicroDepositCount                   = 2
+
+	// UnverifiedExternalTransferWindow is the period over which transfers to an unverified payee
+	// count towards UnverifiedExternalTransferLimit.
+	UnverifiedExternalTransferWindow = 30 * 24 * time.Hour
+)
+
+// UnverifiedExternalTransferLimit is the maximum total that may be sent to a payee that has not
+// completed micro-deposit verification within UnverifiedExternalTransferWindow.
+var UnverifiedExternalTransferLimit = decimal.NewFromInt(100)
+
 // ExternalAccount represents a registered payee account at an external bank (like Northwind).
 // This allows users to save beneficiary details for future transfers.
 type ExternalAccount struct {
-	ID                uuid.UUID `gorm:"type:uuid;primary_key;"`
-	UserID            uuid.UUID `gorm:"type:uuid;not null;index"`
-	User              User      `gorm:"foreignKey:UserID"`
-	ExternalAccountID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"` // The ID from the external system.
-	Nickname          string    `gorm:"type:varchar(100);not null"`
-	AccountNumberMask string    `gorm:"type:varchar(4);not null"` // Store only the last 4 digits for display.
-	NameOnAccount     string    `gorm:"type:varchar(255);not null"`
-	BankName          string    `gorm:"type:varchar(100);not null"`
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
-	DeletedAt         gorm.DeletedAt `gorm:"index"`
+	ID                   uuid.UUID       `gorm:"type:uuid;primary_key;"`
+	UserID               uuid.UUID       `gorm:"type:uuid;not null;index"`
+	User                 User            `gorm:"foreignKey:UserID"`
+	ExternalAccountID    uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex"` // The ID from the external system.
+	Nickname             string          `gorm:"type:varchar(100);not null"`
+	AccountNumberMask    string          `gorm:"type:varchar(4);not null"` // Store only the last 4 digits for display.
+	NameOnAccount        string          `gorm:"type:varchar(255);not null"`
+	BankName             string          `gorm:"type:varchar(100);not null"`
+	VerificationStatus   string          `gorm:"type:varchar(30);not null;default:'unverified';index"`
+	VerificationAttempts int             `gorm:"not null;default:0"`
+	MicroDepositAmount1  decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+	MicroDepositAmount2  decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+	MicroDepositsSent    int             `gorm:"not null;default:0"` // How many of the current deposits the partner has accepted
+	MicroDepositsSentAt  *time.Time
+	VerifiedAt           *time.Time
+	SanctionsStatus      string `gorm:"type:varchar(20);not null;default:'clear';index"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	DeletedAt            gorm.DeletedAt `gorm:"index"`
 }
 
 // BeforeCreate will set a UUID rather than an integer ID.
@@ -28,5 +57,96 @@ func (a *ExternalAccount) BeforeCreate(tx *gorm.DB) (err error) {
 	if a.ID == uuid.Nil {
 		a.ID = uuid.New()
 	}
+	if a.VerificationStatus == "" {
+		a.VerificationStatus = ExternalAccountStatusUnverified
+	}
 	return
 }
+
+// IsVerified reports whether the payee has completed micro-deposit verification.
+func (a *ExternalAccount) IsVerified() bool {
+	return a.VerificationStatus == ExternalAccountStatusVerified
+}
+
+// IsLocked reports whether verification has been locked after too many failed attempts.
+func (a *ExternalAccount) IsLocked() bool {
+	return a.VerificationStatus == ExternalAccountStatusLocked
+}
+
+// IsSanctionsHeld reports whether a sanctions match restricts the payee.
+func (a *ExternalAccount) IsSanctionsHeld() bool {
+	return SanctionsHeld(a.SanctionsStatus)
+}
+
+// CanSendMicroDeposits reports whether micro-deposits may be (re)sent to the payee.
+func (a *ExternalAccount) CanSendMicroDeposits() bool {
+	return a.VerificationStatus == ExternalAccountStatusUnverified ||
+		a.VerificationStatus == ExternalAccountStatusFailed
+}
+
+// RemainingVerificationAttempts returns how many confirmation attempts are left.
+func (a *ExternalAccount) RemainingVerificationAttempts() int {
+	remaining := MaxMicroDepositVerificationAttempts - a.VerificationAttempts
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// MatchesMicroDeposits reports whether the supplied amounts match the deposits sent,
+// in either order.
+func (a *ExternalAccount) MatchesMicroDeposits(amount1, amount2 decimal.Decimal) bool {
+	return (amount1.Equal(a.MicroDepositAmount1) && amount2.Equal(a.MicroDepositAmount2)) ||
+		(amount1.Equal(a.MicroDepositAmount2) && amount2.Equal(a.MicroDepositAmount1))
+}
+
+// MicroDepositAmounts returns the amounts of the current micro-deposits, in the order they are sent.
+func (a *ExternalAccount) MicroDepositAmounts() []decimal.Decimal {
+	return []decimal.Decimal{a.MicroDepositAmount1, a.MicroDepositAmount2}
+}
+
+// HasMicroDepositAmounts reports whether amounts were already generated for the payee. A send that
+// stopped part-way, or whose deposits were all accepted but whose status was not saved, resumes
+// with these amounts rather than paying out new ones the customer would not see.
+func (a *ExternalAccount) HasMicroDepositAmounts() bool {
+	return !a.MicroDepositAmount1.IsZero()
+}
+
+// ApplyVerificationResult transitions the payee after a confirmation attempt that has already been
+// counted in VerificationAttempts: to verified on a match, or to locked once the attempt limit is
+// reached.
+func (a *ExternalAccount) ApplyVerificationResult(matched bool) {
+	if matched {
+		now := time.Now()
+		a.VerificationStatus = ExternalAccountStatusVerified
+		a.VerifiedAt = &now
+		return
+	}
+	if a.VerificationAttempts >= MaxMicroDepositVerificationAttempts {
+		a.VerificationStatus = ExternalAccountStatusLocked
+	}
+}
+
+// CanReceiveTransfer reports whether a transfer of the given amount may be sent to the payee.
+// Verified payees have no payee-level limit; a single transfer to an unverified payee is capped at
+// UnverifiedExternalTransferLimit; locked payees cannot receive funds. The running total over
+// UnverifiedExternalTransferWindow is enforced when the transfer is debited.
+func (a *ExternalAccount) CanReceiveTransfer(amount decimal.Decimal) bool {
+	if a.IsVerified() {
+		return true
+	}
+	if a.IsLocked() {
+		return false
+	}
+	return amount.LessThanOrEqual(UnverifiedExternalTransferLimit)
+}
+
+// ExternalAccountTransferStats summarises transfer usage for a single payee.
+type ExternalAccountTransferStats struct {
+	TotalTransfers     int64
+	CompletedTransfers int64
+	PendingTransfers   int64 // Pending or processing with the partner
+	FailedTransfers    int64
+	TotalAmountSent    decimal.Decimal // Sum of completed transfers only
+	LastTransferAt     *time.Time
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+// ExternalAccountTestSuite is the test suite for ExternalAccount model
+type ExternalAccountTestSuite struct {
+	suite.Suite
+}
+
+// TestExternalAccountTestSuite runs the test suite
+func TestExternalAccountTestSuite(t *testing.T) {
+	suite.Run(t, new(ExternalAccountTestSuite))
+}
+
+func (s *ExternalAccountTestSuite) newSentAccount() *ExternalAccount {
+	return &ExternalAccount{
+		VerificationStatus:  ExternalAccountStatusMicroDepositsSent,
+		MicroDepositAmount1: decimal.RequireFromString("0.07"),
+		MicroDepositAmount2: decimal.RequireFromString("0.42"),
+	}
+}
+
+func (s *ExternalAccountTestSuite) TestHasMicroDepositAmounts() {
+	s.False((&ExternalAccount{}).HasMicroDepositAmounts())
+
+	account := s.newSentAccount()
+	account.MicroDepositsSent = 1
+	s.True(account.HasMicroDepositAmounts())
+
+	account.MicroDepositsSent = MicroDepositCount
+	s.True(account.HasMicroDepositAmounts())
+}
+
+func (s *ExternalAccountTestSuite) TestMatchesMicroDeposits() {
+	account := s.newSentAccount()
+
+	s.True(account.MatchesMicroDeposits(decimal.RequireFromString("0.07"), decimal.RequireFromString("0.42")))
+	s.True(account.MatchesMicroDeposits(decimal.RequireFromString("0.42"), decimal.RequireFromString("0.07")))
+	s.True(account.MatchesMicroDeposits(decimal.RequireFromString("0.070"), decimal.RequireFromString("0.42")))
+	s.False(account.MatchesMicroDeposits(decimal.RequireFromString("0.07"), decimal.RequireFromString("0.07")))
+	s.False(account.MatchesMicroDeposits(decimal.RequireFromString("0.08"), decimal.RequireFromString("0.42")))
+}
+
+func (s *ExternalAccountTestSuite) TestApplyVerificationResult_Match() {
+	account := s.newSentAccount()
+	account.VerificationAttempts = 1
+
+	account.ApplyVerificationResult(true)
+
+	s.True(account.IsVerified())
+	s.NotNil(account.VerifiedAt)
+}
+
+func (s *ExternalAccountTestSuite) TestApplyVerificationResult_LocksAtMax() {
+	account := s.newSentAccount()
+
+	for i := 1; i < MaxMicroDepositVerificationAttempts; i++ {
+		account.VerificationAttempts = i
+		account.ApplyVerificationResult(false)
+		s.False(account.IsLocked())
+		s.Equal(MaxMicroDepositVerificationAttempts-i, account.RemainingVerificationAttempts())
+	}
+
+	account.VerificationAttempts = MaxMicroDepositVerificationAttempts
+	account.ApplyVerificationResult(false)
+	s.True(account.IsLocked())
+	s.Equal(0, account.RemainingVerificationAttempts())
+}
+
+func (s *ExternalAccountTestSuite) TestCanReceiveTransfer() {
+	account := s.newSentAccount()
+	overLimit := UnverifiedExternalTransferLimit.Add(decimal.RequireFromString("0.01"))
+
+	s.True(account.CanReceiveTransfer(UnverifiedExternalTransferLimit))
+	s.False(account.CanReceiveTransfer(overLimit))
+
+	account.VerificationStatus = ExternalAccountStatusVerified
+	s.True(account.CanReceiveTransfer(overLimit))
+
+	account.VerificationStatus = ExternalAccountStatusLocked
+	s.False(account.CanReceiveTransfer(decimal.RequireFromString("1.00")))
+}
+
+func (s *ExternalAccountTestSuite) TestCanSendMicroDeposits() {
+	account := &ExternalAccount{VerificationStatus: ExternalAccountStatusUnverified}
+	s.True(account.CanSendMicroDeposits())
+
+	account.VerificationStatus = ExternalAccountStatusFailed
+	s.True(account.CanSendMicroDeposits())
+
+	account.VerificationStatus = ExternalAccountStatusMicroDepositsSent
+	s.False(account.CanSendMicroDeposits())
+
+	account.VerificationStatus = ExternalAccountStatusVerified
+	s.False(account.CanSendMicroDeposits())
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Fraud screening outcomes, from least to most severe
+const (
+	FraudOutcomeAllow  = "allow"  // Money movement proceeds
+	FraudOutcomeReview = "review" // Money movement proceeds and is flagged for an analyst
+	FraudOutcomeBlock  = "block"  // Money movement is declined
+)
+
+// Screened money movements
+const (
+	FraudOperationInternalTransfer = "internal_transfer" // TransferBetweenAccounts
+	FraudOperationExternalTransfer = "external_transfer" // InitiateExternalTransfer
+	FraudOperationTransaction      = "transaction"       // PerformTransaction debits
+)
+
+// FraudOutcomeSeverity orders outcomes so the most severe one across rules wins.
+func FraudOutcomeSeverity(outcome string) int {
+	switch outcome {
+	case FraudOutcomeBlock:
+		return 2
+	case FraudOutcomeReview:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// FraudRule holds the admin-editable settings of a screening rule. A rule that has never been
+// edited has no row and runs with the defaults built into the API.
+type FraudRule struct {
+	Name      string     `gorm:"type:varchar(50);primary_key"`
+	Enabled   bool       `gorm:"not null"`
+	Action    string     `gorm:"type:varchar(10);not null"` // Outcome when the rule triggers; allow only adds the score
+	Score     int        `gorm:"not null;default:0"`        // Risk score added when the rule triggers
+	Params    JSONBMap   `gorm:"type:jsonb"`                // Rule-specific thresholds
+	UpdatedBy *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FraudDecision records the screening of one money movement and the result of every rule,
+// so a review or block can be explained later.
+type FraudDecision struct {
+	ID                    uuid.UUID       `gorm:"type:uuid;primary_key"`
+	UserID                uuid.UUID       `gorm:"type:uuid;not null;index"`
+	Operation             string          `gorm:"type:varchar(20);not null"`
+	AccountID             uuid.UUID       `gorm:"type:uuid;not null;index"`
+	CounterpartyAccountID *uuid.UUID      `gorm:"type:uuid"` // Destination account of an internal transfer
+	ExternalAccountID     *uuid.UUID      `gorm:"type:uuid"` // Payee of an external transfer
+	Amount                decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Outcome               string          `gorm:"type:varchar(10);not null;index"`
+	Score                 int             `gorm:"not null;default:0"`
+	RuleResults           JSONBMap        `gorm:"type:jsonb"`       // {"rules": [{rule, outcome, score, reason}]}
+	ResourceType          *string         `gorm:"type:varchar(20)"` // transfer or transaction, once the movement is recorded
+	ResourceID            *uuid.UUID      `gorm:"type:uuid;index"`
+	CreatedAt             time.Time       `gorm:"index"`
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (d *FraudDecision) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return
+}
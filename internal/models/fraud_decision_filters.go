@@ -0,0 +1,13 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// FraudDecisionFilters contains filter criteria for fraud decision queries
+type FraudDecisionFilters struct {
+	Outcome   string
+	Operation string
+	UserID    *uuid.UUID
+	AccountID *uuid.UUID
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Inbound credit statuses
+const (
+	InboundCreditStatusReceived  = "received"  // Recorded, credit not yet posted
+	InboundCreditStatusPosted    = "posted"    // Credited to a customer account
+	InboundCreditStatusSuspense  = "suspense"  // Unmatched or invalid; awaiting admin review
+	InboundCreditStatusReturning = "returning" // Return claimed by an admin; outcome with the partner not yet known
+	InboundCreditStatusReturned  = "returned"  // Sent back to the originator by an admin
+)
+
+// InboundCredit records a credit notification received from a banking partner (like Northwind).
+// The partner event ID is unique so redelivered notifications are never posted twice.
+type InboundCredit struct {
+	ID                 uuid.UUID       `gorm:"type:uuid;primary_key;"`
+	EventID            string          `gorm:"type:varchar(255);not null;uniqueIndex"`
+	ExternalTransferID string          `gorm:"type:varchar(255);index"`
+	AccountNumber      string          `gorm:"type:varchar(20);not null;index"`
+	Amount             decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Currency           string          `gorm:"type:varchar(3);not null"`
+	SenderName         string          `gorm:"type:varchar(255)"`
+	SenderAccountID    string          `gorm:"type:varchar(255)"` // Originator's account at the partner, used for returns
+	Reference          string          `gorm:"type:varchar(255)"`
+	Status             string          `gorm:"type:varchar(20);not null;default:'received';index"`
+	SuspenseReason     *string         `gorm:"type:text"`
+	AccountID          *uuid.UUID      `gorm:"type:uuid;index"`
+	TransactionID      *uuid.UUID      `gorm:"type:uuid"`
+	ReturnTransferID   *string         `gorm:"type:varchar(255)"`
+	ResolvedBy         *uuid.UUID      `gorm:"type:uuid"`
+	ResolvedAt         *time.Time
+	ResolutionNote     *string `gorm:"type:text"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (c *InboundCredit) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	if c.Status == "" {
+		c.Status = InboundCreditStatusReceived
+	}
+	return
+}
+
+// MarkPosted records that the credit was posted to the given account.
+func (c *InboundCredit) MarkPosted(accountID, transactionID uuid.UUID) {
+	c.Status = InboundCreditStatusPosted
+	c.AccountID = &accountID
+	c.TransactionID = &transactionID
+}
+
+// MarkSuspense parks the credit for admin review with the reason it could not be posted.
+func (c *InboundCredit) MarkSuspense(reason string) {
+	c.Status = InboundCreditStatusSuspense
+	c.SuspenseReason = &reason
+}
+
+// Resolve records the admin who actioned a suspense item.
+func (c *InboundCredit) Resolve(adminID uuid.UUID, note string) {
+	now := time.Now()
+	c.ResolvedBy = &adminID
+	c.ResolvedAt = &now
+	c.ResolutionNote = &note
+}
+
+// IsInSuspense reports whether the credit is awaiting admin review.
+func (c *InboundCredit) IsInSuspense() bool {
+	return c.Status == InboundCreditStatusSuspense
+}
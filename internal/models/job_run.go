@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	JobRunStatusRunning   = "running"
+	JobRunStatusSucceeded = "succeeded"
+	JobRunStatusFailed    = "failed"
+	JobRunStatusTimedOut  = "timed_out"
+	// Set on runs still marked running long after they should have finished, because the
+	// instance running them stopped before recording the outcome
+	JobRunStatusAbandoned = "abandoned"
+
+	JobRunTriggerSchedule = "schedule"
+	JobRunTriggerManual   = "manual"
+)
+
+// JobRun records one run of a scheduled background job.
+type JobRun struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	JobName     string     `gorm:"type:varchar(100);not null;index:idx_job_runs_job_started,priority:1" json:"job_name"`
+	Trigger     string     `gorm:"type:varchar(20);not null" json:"trigger"`
+	TriggeredBy *uuid.UUID `gorm:"type:uuid" json:"triggered_by,omitempty"` // Admin who ran the job manually
+	Instance    string     `gorm:"type:varchar(100);not null" json:"instance"`
+	Status      string     `gorm:"type:varchar(20);not null;default:'running'" json:"status"`
+	StartedAt   time.Time  `gorm:"not null;index:idx_job_runs_job_started,priority:2" json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	DurationMs  *int64     `json:"duration_ms,omitempty"`
+	Counts      JSONBMap   `gorm:"type:text" json:"counts,omitempty"` // What the run did, e.g. {"delivered": 12}
+	Error       string     `gorm:"type:text" json:"error,omitempty"`
+}
+
+func (*JobRun) TableName() string {
+	return "job_runs"
+}
+
+func (r *JobRun) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// ScheduledJob holds the operator state of a registered job, shared by every instance. A job
+// without a row is running on its schedule.
+type ScheduledJob struct {
+	Name      string     `gorm:"type:varchar(100);primary_key" json:"name"`
+	Paused    bool       `gorm:"not null;default:false" json:"paused"`
+	PausedBy  *uuid.UUID `gorm:"type:uuid" json:"paused_by,omitempty"`
+	PausedAt  *time.Time `json:"paused_at,omitempty"`
+	UpdatedAt time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+func (*ScheduledJob) TableName() string {
+	return "scheduled_jobs"
+}
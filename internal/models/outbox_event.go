@@ -0,0 +1,134 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Domain event types written to the outbox
+const (
+	EventTypeTransferCompleted = "transfer.completed"
+	EventTypeTransferFailed    = "transfer.failed"
+	EventTypeTransactionPosted = "transaction.posted"
+)
+
+// Outbox aggregate types
+const (
+	AggregateTypeTransfer    = "transfer"
+	AggregateTypeTransaction = "transaction"
+)
+
+// OutboxEvent is a domain event written in the same database transaction as the state change it
+// describes. The auto-incrementing ID orders the log so each consumer can checkpoint its position.
+type OutboxEvent struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement" json:"sequence"`
+	EventID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"event_id"` // Stable ID consumers use to deduplicate redeliveries
+	EventType     string    `gorm:"type:varchar(100);not null;index" json:"event_type"`
+	AggregateType string    `gorm:"type:varchar(50);not null" json:"aggregate_type"`
+	AggregateID   uuid.UUID `gorm:"type:uuid;not null;index" json:"aggregate_id"`
+	Payload       JSONBMap  `gorm:"type:jsonb" json:"payload"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// BeforeCreate assigns the event ID.
+func (e *OutboxEvent) BeforeCreate(tx *gorm.DB) (err error) {
+	if e.EventID == uuid.Nil {
+		e.EventID = uuid.New()
+	}
+	return
+}
+
+// NewTransferEvent builds the event recording a transfer reaching its current terminal status.
+func NewTransferEvent(transfer *Transfer) *OutboxEvent {
+	eventType := EventTypeTransferCompleted
+	if transfer.Status == TransferStatusFailed {
+		eventType = EventTypeTransferFailed
+	}
+
+	payload := JSONBMap{
+		"transfer_id":     transfer.ID.String(),
+		"status":          transfer.Status,
+		"amount":          transfer.Amount.String(),
+		"from_account_id": transfer.FromAccountID.String(),
+		"external":        transfer.IsExternal(),
+	}
+	if transfer.ToAccountID != nil {
+		payload["to_account_id"] = transfer.ToAccountID.String()
+	}
+	if transfer.ToExternalAccountID != nil {
+		payload["to_external_account_id"] = transfer.ToExternalAccountID.String()
+	}
+	if transfer.ExternalTransferID != nil {
+		payload["external_transfer_id"] = *transfer.ExternalTransferID
+	}
+	if transfer.ErrorMessage != nil {
+		payload["reason"] = *transfer.ErrorMessage
+	}
+
+	return &OutboxEvent{
+		EventType:     eventType,
+		AggregateType: AggregateTypeTransfer,
+		AggregateID:   transfer.ID,
+		Payload:       payload,
+	}
+}
+
+// NewTransactionPostedEvent builds the event recording a completed transaction changing an
+// account balance. Pending transactions have not moved money and return nil, which
+// appendOutboxEvents skips.
+func NewTransactionPostedEvent(transaction *Transaction) *OutboxEvent {
+	if transaction.Status != TransactionStatusCompleted {
+		return nil
+	}
+
+	return &OutboxEvent{
+		EventType:     EventTypeTransactionPosted,
+		AggregateType: AggregateTypeTransaction,
+		AggregateID:   transaction.ID,
+		Payload: JSONBMap{
+			"transaction_id":   transaction.ID.String(),
+			"account_id":       transaction.AccountID.String(),
+			"transaction_type": transaction.TransactionType,
+			"amount":           transaction.Amount.String(),
+			"balance_before":   transaction.BalanceBefore.String(),
+			"balance_after":    transaction.BalanceAfter.String(),
+			"description":      transaction.Description,
+			"reference":        transaction.Reference,
+		},
+	}
+}
+
+// OutboxCheckpoint is a consumer's position in the outbox. Events up to and including
+// LastEventID have been handled; a failing event is retried with backoff until it succeeds.
+type OutboxCheckpoint struct {
+	Consumer      string     `gorm:"type:varchar(100);primaryKey" json:"consumer"`
+	LastEventID   int64      `gorm:"not null;default:0" json:"last_event_sequence"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"` // Consecutive failures on the next event
+	LastError     *string    `gorm:"type:text" json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// Reasons a consumer stopped retrying an event
+const (
+	OutboxDeadLetterReasonMaxAttempts = "max_attempts"
+	OutboxDeadLetterReasonSkipped     = "skipped"
+)
+
+// OutboxDeadLetter records an event a consumer stopped retrying, either after too many failures or
+// because an admin skipped it. The consumer's checkpoint has moved past the event, so it is only
+// delivered again if an admin replays it.
+type OutboxDeadLetter struct {
+	Consumer   string      `gorm:"type:varchar(100);primaryKey" json:"consumer"`
+	EventID    int64       `gorm:"primaryKey;autoIncrement:false" json:"event_sequence"`
+	Event      OutboxEvent `gorm:"foreignKey:EventID" json:"event"`
+	Reason     string      `gorm:"type:varchar(20);not null" json:"reason"`
+	Attempts   int         `gorm:"not null;default:0" json:"attempts"`
+	LastError  *string     `gorm:"type:text" json:"last_error,omitempty"`
+	SkippedBy  *uuid.UUID  `gorm:"type:uuid" json:"skipped_by,omitempty"`
+	ReplayedBy *uuid.UUID  `gorm:"type:uuid" json:"replayed_by,omitempty"`
+	ReplayedAt *time.Time  `json:"replayed_at,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
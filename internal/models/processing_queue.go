@@ -16,24 +16,30 @@ const (
 	QueueStatusCompleted  = "completed"
 	QueueStatusFailed     = "failed"
 
+	// Set by operators; workers never claim items in these statuses
+	QueueStatusCancelled    = "cancelled"
+	QueueStatusDeadLettered = "dead_lettered"
+
 	QueuePriorityNormal = 100
 	QueuePriorityHigh   = 200
 )
 
 type ProcessingQueueItem struct {
-	ID            uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
-	TransactionID uuid.UUID  `gorm:"type:uuid;not null;index:idx_processing_queue_transaction" json:"transaction_id"`
-	Operation     string     `gorm:"type:varchar(50);not null" json:"operation"`
-	Priority      int        `gorm:"not null;default:100;index:idx_processing_queue_status,priority:2" json:"priority"`
-	Status        string     `gorm:"type:varchar(20);not null;default:'pending';index:idx_processing_queue_status,priority:1" json:"status"`
-	RetryCount    int        `gorm:"not null;default:0" json:"retry_count"`
-	MaxRetries    int        `gorm:"not null;default:3" json:"max_retries"`
-	ScheduledAt   time.Time  `gorm:"not null;index:idx_processing_queue_status,priority:3" json:"scheduled_at"`
-	ProcessedAt   *time.Time `json:"processed_at,omitempty"`
-	ErrorMessage  string     `gorm:"type:text" json:"error_message,omitempty"`
-	Metadata      string     `gorm:"type:jsonb" json:"metadata,omitempty"`
-	CreatedAt     time.Time  `gorm:"not null" json:"created_at"`
-	UpdatedAt     time.Time  `gorm:"not null" json:"updated_at"`
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	TransactionID  uuid.UUID  `gorm:"type:uuid;not null;index:idx_processing_queue_transaction" json:"transaction_id"`
+	Operation      string     `gorm:"type:varchar(50);not null" json:"operation"`
+	Priority       int        `gorm:"not null;default:100;index:idx_processing_queue_status,priority:2" json:"priority"`
+	Status         string     `gorm:"type:varchar(20);not null;default:'pending';index:idx_processing_queue_status,priority:1" json:"status"`
+	RetryCount     int        `gorm:"not null;default:0" json:"retry_count"`
+	MaxRetries     int        `gorm:"not null;default:3" json:"max_retries"`
+	ScheduledAt    time.Time  `gorm:"not null;index:idx_processing_queue_status,priority:3" json:"scheduled_at"`
+	LockedBy       string     `gorm:"type:varchar(100)" json:"locked_by,omitempty"`                       // Worker holding the lease while processing
+	LeaseExpiresAt *time.Time `gorm:"index:idx_processing_queue_lease" json:"lease_expires_at,omitempty"` // Reaped back to pending once passed
+	ProcessedAt    *time.Time `json:"processed_at,omitempty"`
+	ErrorMessage   string     `gorm:"type:text" json:"error_message,omitempty"`
+	Metadata       string     `gorm:"type:jsonb" json:"metadata,omitempty"`
+	CreatedAt      time.Time  `gorm:"not null" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"not null" json:"updated_at"`
 
 	Transaction Transaction `gorm:"foreignKey:TransactionID;constraint:OnDelete:CASCADE" json:"-"`
 }
@@ -57,3 +63,46 @@ func (q *ProcessingQueueItem) CalculateNextScheduledTime() time.Time {
 func (q *ProcessingQueueItem) CanRetry() bool {
 	return q.RetryCount < q.MaxRetries
 }
+
+// CanRequeue reports whether an operator may return the item to the queue. Items being processed
+// are owned by a worker's lease, and completed and cancelled items are final.
+func (q *ProcessingQueueItem) CanRequeue() bool {
+	switch q.Status {
+	case QueueStatusPending, QueueStatusFailed, QueueStatusDeadLettered:
+		return true
+	}
+	return false
+}
+
+// CanCancel reports whether an operator may cancel the item.
+func (q *ProcessingQueueItem) CanCancel() bool {
+	return q.CanRequeue()
+}
+
+// CanDeadLetter reports whether an operator may park the item in the dead-letter state.
+func (q *ProcessingQueueItem) CanDeadLetter() bool {
+	return q.Status == QueueStatusPending || q.Status == QueueStatusFailed
+}
+
+// processingQueueControlID is the primary key of the single processing queue control row
+const processingQueueControlID = 1
+
+// ProcessingQueueControl holds runtime switches shared by every worker on the queue. There is a
+// single row; while Paused is set no worker claims new items.
+type ProcessingQueueControl struct {
+	ID          int        `gorm:"primary_key" json:"-"`
+	Paused      bool       `gorm:"not null;default:false" json:"paused"`
+	PausedBy    *uuid.UUID `gorm:"type:uuid" json:"paused_by,omitempty"`
+	PausedAt    *time.Time `json:"paused_at,omitempty"`
+	PauseReason string     `gorm:"type:text" json:"pause_reason,omitempty"`
+	UpdatedAt   time.Time  `gorm:"not null" json:"updated_at"`
+}
+
+func (*ProcessingQueueControl) TableName() string {
+	return "transaction_processing_queue_control"
+}
+
+// NewProcessingQueueControl returns the control row in its default, running state.
+func NewProcessingQueueControl() *ProcessingQueueControl {
+	return &ProcessingQueueControl{ID: processingQueueControlID}
+}
@@ -0,0 +1,10 @@
+package models
+
+import "github.com/google/uuid"
+
+// ProcessingQueueFilters contains filter criteria for processing queue item queries
+type ProcessingQueueFilters struct {
+	Statuses      []string
+	TransactionID *uuid.UUID
+	Operation     string
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Sanctions screening status of a customer or payee
+const (
+	SanctionsStatusClear         = "clear"          // No unresolved list matches
+	SanctionsStatusPendingReview = "pending_review" // Possible match awaiting an admin decision
+	SanctionsStatusBlocked       = "blocked"        // Strong or confirmed match
+)
+
+// Screened subjects
+const (
+	SanctionsSubjectUser            = "user"
+	SanctionsSubjectExternalAccount = "external_account"
+)
+
+// Sanctions match review statuses
+const (
+	SanctionsMatchStatusOpen      = "open"      // Awaiting an admin decision
+	SanctionsMatchStatusCleared   = "cleared"   // False positive; not raised again for the same list entry
+	SanctionsMatchStatusConfirmed = "confirmed" // True match; the subject stays blocked
+)
+
+// Action a potential match places on its subject
+const (
+	SanctionsMatchActionReview = "review"
+	SanctionsMatchActionBlock  = "block"
+)
+
+// SanctionsHeld reports whether a screening status restricts the subject. An empty status is
+// treated as clear.
+func SanctionsHeld(status string) bool {
+	return status == SanctionsStatusPendingReview || status == SanctionsStatusBlocked
+}
+
+// SanctionsMatch records a potential match between a customer or payee name and a sanctions
+// list entry.
+type SanctionsMatch struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key"`
+	SubjectType  string     `gorm:"type:varchar(20);not null;index:idx_sanctions_matches_subject"`
+	SubjectID    uuid.UUID  `gorm:"type:uuid;not null;index:idx_sanctions_matches_subject"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null;index"` // Customer the subject is or belongs to
+	ScreenedName string     `gorm:"type:varchar(255);not null"`
+	EntryID      string     `gorm:"type:varchar(20);not null"` // ent_num of the list entry
+	EntryName    string     `gorm:"type:varchar(350);not null"`
+	EntryType    string     `gorm:"type:varchar(20)"` // individual, or empty for entities
+	Programs     string     `gorm:"type:varchar(200)"`
+	Similarity   int        `gorm:"not null"` // Percent, 0-100
+	Action       string     `gorm:"type:varchar(10);not null"`
+	Status       string     `gorm:"type:varchar(20);not null;default:'open';index"`
+	ListVersion  string     `gorm:"type:varchar(64);not null"` // SHA-256 of the list file the match was found in
+	ReviewedBy   *uuid.UUID `gorm:"type:uuid"`
+	ReviewedAt   *time.Time
+	ReviewNote   string `gorm:"type:text"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (m *SanctionsMatch) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	if m.Status == "" {
+		m.Status = SanctionsMatchStatusOpen
+	}
+	return
+}
+
+// IsOpen reports whether the match still awaits an admin decision.
+func (m *SanctionsMatch) IsOpen() bool {
+	return m.Status == SanctionsMatchStatusOpen
+}
+
+// Clear records the reviewing admin and dismisses the match as a false positive.
+func (m *SanctionsMatch) Clear(adminID uuid.UUID, note string) {
+	m.review(adminID, note)
+	m.Status = SanctionsMatchStatusCleared
+}
+
+// Confirm records the reviewing admin and keeps the subject blocked.
+func (m *SanctionsMatch) Confirm(adminID uuid.UUID, note string) {
+	m.review(adminID, note)
+	m.Status = SanctionsMatchStatusConfirmed
+}
+
+func (m *SanctionsMatch) review(adminID uuid.UUID, note string) {
+	now := time.Now()
+	m.ReviewedBy = &adminID
+	m.ReviewedAt = &now
+	m.ReviewNote = note
+}
+
+// SanctionsStatusFor derives a subject's screening status from its matches: blocked while any
+// match is confirmed or is an open strong match, pending review while any match is open.
+func SanctionsStatusFor(matches []SanctionsMatch) string {
+	status := SanctionsStatusClear
+	for _, match := range matches {
+		switch {
+		case match.Status == SanctionsMatchStatusConfirmed,
+			match.IsOpen() && match.Action == SanctionsMatchActionBlock:
+			return SanctionsStatusBlocked
+		case match.IsOpen():
+			status = SanctionsStatusPendingReview
+		}
+	}
+	return status
+}
+
+// SanctionsListLoad records a version of the sanctions list the API has loaded and whether
+// existing customers and payees have been rescreened against it.
+type SanctionsListLoad struct {
+	Version       string `gorm:"type:varchar(64);primary_key"` // SHA-256 of the file
+	EntryCount    int    `gorm:"not null"`
+	LoadedAt      time.Time
+	RescreenedAt  *time.Time
+	SubjectsCount int `gorm:"not null;default:0"` // Customers and payees screened by the rescreen
+	MatchesCount  int `gorm:"not null;default:0"` // New matches the rescreen raised
+}
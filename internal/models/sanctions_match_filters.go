@@ -0,0 +1,12 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// SanctionsMatchFilters contains filter criteria for sanctions match queries
+type SanctionsMatchFilters struct {
+	Status      string
+	SubjectType string
+	UserID      *uuid.UUID
+}
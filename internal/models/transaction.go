@@ -17,6 +17,12 @@ const (
 	TransactionStatusCompleted = "completed"
 	TransactionStatusFailed    = "failed"
 	TransactionStatusReversed  = "reversed"
+
+	// Recorded in Metadata["failure_reason"] when queued processing fails a transaction
+	TransactionFailureInsufficientFunds  = "insufficient_funds"
+	TransactionFailureAccountNotActive   = "account_not_active"
+	TransactionFailureDuplicateReference = "duplicate_reference"
+	TransactionFailureProcessingFailed   = "processing_failed"
 )
 
 var (
@@ -151,6 +157,21 @@ func (t *Transaction) Fail() {
 	t.ProcessedAt = &now
 }
 
+// FailWithReason marks the transaction as failed and records why in its metadata
+func (t *Transaction) FailWithReason(reason string) {
+	t.Fail()
+	if t.Metadata == nil {
+		t.Metadata = JSONBMap{}
+	}
+	t.Metadata["failure_reason"] = reason
+}
+
+// FailureReason returns the reason recorded by FailWithReason, if any
+func (t *Transaction) FailureReason() string {
+	reason, _ := t.Metadata["failure_reason"].(string)
+	return reason
+}
+
 // Reverse marks the transaction as reversed
 func (t *Transaction) Reverse() {
 	t.Status = TransactionStatusReversed
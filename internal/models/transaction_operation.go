@@ -0,0 +1,52 @@
+package models
+
+const (
+	TransactionOperationQueued     = "queued"
+	TransactionOperationProcessing = "processing"
+	TransactionOperationCompleted  = "completed"
+	TransactionOperationFailed     = "failed"
+	TransactionOperationCancelled  = "cancelled" // An operator cancelled the queue item
+	TransactionOperationHeld       = "held"      // An operator parked the queue item in the dead-letter state
+)
+
+// TransactionOperation tracks a transaction submitted for asynchronous processing. The operation
+// is identified by the transaction's ID.
+type TransactionOperation struct {
+	Transaction *Transaction
+	QueueItem   *ProcessingQueueItem // Latest queue item for the transaction; nil once cleaned up
+}
+
+// Status derives the operation status from the transaction and, while it is pending, from its
+// queue item.
+func (o *TransactionOperation) Status() string {
+	switch o.Transaction.Status {
+	case TransactionStatusCompleted, TransactionStatusReversed:
+		return TransactionOperationCompleted
+	case TransactionStatusFailed:
+		return TransactionOperationFailed
+	}
+
+	if o.QueueItem == nil {
+		return TransactionOperationQueued
+	}
+	switch o.QueueItem.Status {
+	case QueueStatusProcessing:
+		return TransactionOperationProcessing
+	case QueueStatusCancelled:
+		return TransactionOperationCancelled
+	case QueueStatusDeadLettered:
+		return TransactionOperationHeld
+	default:
+		return TransactionOperationQueued
+	}
+}
+
+// IsFinished reports whether the operation will not change without operator action.
+func (o *TransactionOperation) IsFinished() bool {
+	switch o.Status() {
+	case TransactionOperationQueued, TransactionOperationProcessing:
+		return false
+	default:
+		return true
+	}
+}
@@ -234,6 +234,50 @@ func TestTransaction_Fail(t *testing.T) {
 	assert.True(t, time.Now().Sub(*txn.ProcessedAt) < time.Second)
 }
 
+func TestTransaction_FailWithReason(t *testing.T) {
+	txn := Transaction{
+		Status: TransactionStatusPending,
+	}
+
+	txn.FailWithReason(TransactionFailureInsufficientFunds)
+
+	assert.Equal(t, TransactionStatusFailed, txn.Status)
+	assert.NotNil(t, txn.ProcessedAt)
+	assert.Equal(t, TransactionFailureInsufficientFunds, txn.FailureReason())
+	assert.Empty(t, (&Transaction{}).FailureReason())
+}
+
+func TestTransactionOperation_Status(t *testing.T) {
+	tests := []struct {
+		name              string
+		transactionStatus string
+		queueStatus       string // Empty means the queue item is gone
+		want              string
+		finished          bool
+	}{
+		{"waiting in queue", TransactionStatusPending, QueueStatusPending, TransactionOperationQueued, false},
+		{"claimed by a worker", TransactionStatusPending, QueueStatusProcessing, TransactionOperationProcessing, false},
+		{"queue item cleaned up", TransactionStatusPending, "", TransactionOperationQueued, false},
+		{"cancelled by an operator", TransactionStatusPending, QueueStatusCancelled, TransactionOperationCancelled, true},
+		{"dead-lettered by an operator", TransactionStatusPending, QueueStatusDeadLettered, TransactionOperationHeld, true},
+		{"completed", TransactionStatusCompleted, QueueStatusCompleted, TransactionOperationCompleted, true},
+		{"reversed after completing", TransactionStatusReversed, "", TransactionOperationCompleted, true},
+		{"failed", TransactionStatusFailed, QueueStatusFailed, TransactionOperationFailed, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := &TransactionOperation{Transaction: &Transaction{Status: tt.transactionStatus}}
+			if tt.queueStatus != "" {
+				operation.QueueItem = &ProcessingQueueItem{Status: tt.queueStatus}
+			}
+
+			assert.Equal(t, tt.want, operation.Status())
+			assert.Equal(t, tt.finished, operation.IsFinished())
+		})
+	}
+}
+
 func TestTransaction_Reverse(t *testing.T) {
 	txn := Transaction{
 		Status: TransactionStatusCompleted,
@@ -11,9 +11,11 @@ import (
 )
 
 const (
-	TransferStatusPending   = "pending"
-	TransferStatusCompleted = "completed"
-	TransferStatusFailed    = "failed"
+	TransferStatusPending       = "pending"
+	TransferStatusProcessing    = "processing"      // External transfer accepted by the partner and in flight
+	TransferStatusHeldForReview = "held_for_review" // Funds reserved; waiting for an admin to approve or reject
+	TransferStatusCompleted     = "completed"
+	TransferStatusFailed        = "failed"
 )
 
 var (
@@ -41,6 +43,13 @@ type Transfer struct {
 	CompletedAt           *time.Time      `json:"completed_at,omitempty"`
 	FailedAt              *time.Time      `json:"failed_at,omitempty"`
 
+	// Status reconciliation for external transfers. Northwind pushes status callbacks; polling
+	// is a fallback that backs off per transfer and escalates transfers that stay unresolved.
+	StatusCheckAttempts int        `gorm:"not null;default:0" json:"-"`
+	NextStatusCheckAt   *time.Time `gorm:"index" json:"-"`
+	LastStatusCheckAt   *time.Time `json:"-"`
+	EscalatedAt         *time.Time `gorm:"index" json:"escalated_at,omitempty"` // Set when the transfer is moved to the admin stuck queue
+
 	// Associations
 	FromAccount         Account          `gorm:"foreignKey:FromAccountID" json:"-"`
 	ToAccount           *Account         `gorm:"foreignKey:ToAccountID" json:"-"`
@@ -119,6 +128,11 @@ func (t *Transfer) IsPending() bool {
 	return t.Status == TransferStatusPending
 }
 
+// IsHeldForReview returns true if the transfer is waiting in the manual review queue
+func (t *Transfer) IsHeldForReview() bool {
+	return t.Status == TransferStatusHeldForReview
+}
+
 // IsCompleted returns true if the transfer is completed
 func (t *Transfer) IsCompleted() bool {
 	return t.Status == TransferStatusCompleted
@@ -146,12 +160,60 @@ func (t *Transfer) Fail(errorMessage string) {
 	t.ErrorMessage = &errorMessage
 }
 
+// IsExternal returns true if the transfer is to an external account
+func (t *Transfer) IsExternal() bool {
+	return t.ToExternalAccountID != nil
+}
+
+// IsTerminal returns true if the transfer has completed or failed
+func (t *Transfer) IsTerminal() bool {
+	return t.Status == TransferStatusCompleted || t.Status == TransferStatusFailed
+}
+
+// IsEscalated returns true if the transfer is waiting in the admin stuck queue
+func (t *Transfer) IsEscalated() bool {
+	return t.EscalatedAt != nil
+}
+
+// ScheduleNextStatusCheck records a status check that did not resolve the transfer and
+// schedules the next one with exponential backoff: base, 2*base, 4*base, ... capped at max.
+func (t *Transfer) ScheduleNextStatusCheck(now time.Time, base, max time.Duration) {
+	t.StatusCheckAttempts++
+	t.LastStatusCheckAt = &now
+
+	delay := base
+	for i := 1; i < t.StatusCheckAttempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+
+	next := now.Add(delay)
+	t.NextStatusCheckAt = &next
+}
+
+// Escalate moves the transfer to the admin stuck queue; polling stops until it is re-queued
+func (t *Transfer) Escalate(now time.Time) {
+	t.EscalatedAt = &now
+	t.NextStatusCheckAt = nil
+}
+
+// ResetStatusChecks clears escalation and backoff so the transfer is checked on the next poll
+func (t *Transfer) ResetStatusChecks() {
+	t.EscalatedAt = nil
+	t.StatusCheckAttempts = 0
+	t.NextStatusCheckAt = nil
+}
+
 // CanTransitionTo checks if a transfer can transition to a new status
 func (t *Transfer) CanTransitionTo(newStatus string) bool {
 	validTransitions := map[string][]string{
-		TransferStatusPending:   {TransferStatusCompleted, TransferStatusFailed},
-		TransferStatusCompleted: {},
-		TransferStatusFailed:    {},
+		TransferStatusPending:       {TransferStatusProcessing, TransferStatusCompleted, TransferStatusFailed},
+		TransferStatusProcessing:    {TransferStatusCompleted, TransferStatusFailed},
+		TransferStatusHeldForReview: {TransferStatusPending, TransferStatusCompleted, TransferStatusFailed},
+		TransferStatusCompleted:     {},
+		TransferStatusFailed:        {},
 	}
 
 	allowedStatuses, exists := validTransitions[t.Status]
@@ -172,7 +234,7 @@ func (t *Transfer) TableName() string {
 // IsValidTransferStatus checks if the transfer status is valid
 func IsValidTransferStatus(status string) bool {
 	switch status {
-	case TransferStatusPending, TransferStatusCompleted, TransferStatusFailed:
+	case TransferStatusPending, TransferStatusProcessing, TransferStatusHeldForReview, TransferStatusCompleted, TransferStatusFailed:
 		return true
 	default:
 		return false
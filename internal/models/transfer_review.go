@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Transfer review statuses
+const (
+	TransferReviewStatusPending  = "pending"  // Funds reserved; awaiting an admin decision
+	TransferReviewStatusApproved = "approved" // Transfer released for execution
+	TransferReviewStatusRejected = "rejected" // Reserved funds returned to the customer
+)
+
+// TransferReview holds a transfer in the manual review queue. The source account is debited when
+// the transfer is held, so the funds stay reserved until an admin approves or rejects it.
+type TransferReview struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key"`
+	TransferID   uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID       uuid.UUID  `gorm:"type:uuid;not null;index"` // Customer who requested the transfer
+	Status       string     `gorm:"type:varchar(20);not null;default:'pending';index"`
+	Reasons      JSONBMap   `gorm:"type:jsonb"`       // {"reasons": [{trigger, reason}]}
+	TransferType string     `gorm:"type:varchar(20)"` // Partner transfer type, needed to submit an approved external transfer
+	DueAt        time.Time  `gorm:"not null;index"`   // SLA deadline for the decision
+	ReviewedBy   *uuid.UUID `gorm:"type:uuid"`
+	ReviewedAt   *time.Time
+	ReviewNote   string `gorm:"type:text"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	// Associations
+	Transfer Transfer `gorm:"foreignKey:TransferID"`
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (r *TransferReview) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	if r.Status == "" {
+		r.Status = TransferReviewStatusPending
+	}
+	return
+}
+
+// IsPending reports whether the review still awaits an admin decision.
+func (r *TransferReview) IsPending() bool {
+	return r.Status == TransferReviewStatusPending
+}
+
+// IsOverdue reports whether a pending review has passed its SLA deadline.
+func (r *TransferReview) IsOverdue(now time.Time) bool {
+	return r.IsPending() && now.After(r.DueAt)
+}
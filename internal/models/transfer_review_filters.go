@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransferReviewFilters contains filter criteria for transfer review queries
+type TransferReviewFilters struct {
+	Status    string
+	UserID    *uuid.UUID
+	External  *bool      // Only external (true) or internal (false) transfers
+	OverdueAt *time.Time // Only pending reviews whose SLA deadline passed before this time
+}
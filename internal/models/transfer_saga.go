@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Transfer saga steps. Each step is persisted before the next side effect so a crash can be
+// resumed or compensated by the recovery worker.
+const (
+	TransferSagaStepDebitPosted      = "debit_posted"      // Source account debited; partner not yet called (or outcome unknown)
+	TransferSagaStepPartnerSubmitted = "partner_submitted" // Partner accepted the transfer and it is in flight
+	TransferSagaStepPartnerConfirmed = "partner_confirmed" // Partner reported the transfer completed
+	TransferSagaStepCompensated      = "compensated"       // Debit reversed after a failed submission or partner failure
+)
+
+// TransferSaga tracks the steps of an external transfer so the debit is never lost. There is one
+// saga per transfer, and compensation is applied at most once.
+type TransferSaga struct {
+	ID                        uuid.UUID  `gorm:"type:uuid;primary_key;"`
+	TransferID                uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex"`
+	Step                      string     `gorm:"type:varchar(30);not null;index"`
+	TransferType              string     `gorm:"type:varchar(20)"` // Partner transfer type, needed to resubmit after a crash
+	DebitTransactionID        *uuid.UUID `gorm:"type:uuid"`
+	CompensationTransactionID *uuid.UUID `gorm:"type:uuid"`
+	LastError                 *string    `gorm:"type:text"`
+	RecoveryAttempts          int        `gorm:"not null;default:0"`
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+	CompletedAt               *time.Time // Set when the saga reaches partner_confirmed or compensated
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (s *TransferSaga) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	if s.Step == "" {
+		s.Step = TransferSagaStepDebitPosted
+	}
+	return
+}
+
+// IsFinished returns true once the saga has been confirmed or compensated.
+func (s *TransferSaga) IsFinished() bool {
+	return s.Step == TransferSagaStepPartnerConfirmed || s.Step == TransferSagaStepCompensated
+}
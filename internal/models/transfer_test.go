@@ -51,7 +51,7 @@ func TestTransferTestSuite(t *testing.T) {
 func (s *TransferTestSuite) TestTransfer_BeforeCreate_GeneratesID() {
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -67,7 +67,7 @@ func (s *TransferTestSuite) TestTransfer_BeforeCreate_GeneratesID() {
 func (s *TransferTestSuite) TestTransfer_BeforeCreate_SetsDefaultStatus() {
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -82,7 +82,7 @@ func (s *TransferTestSuite) TestTransfer_BeforeCreate_SetsDefaultStatus() {
 func (s *TransferTestSuite) TestTransfer_BeforeCreate_SetsTimestamps() {
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -105,7 +105,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_ValidTransfer() {
 	transfer := &Transfer{
 		ID:             uuid.New(),
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -119,7 +119,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_ValidTransfer() {
 // TestTransfer_Validate_MissingFromAccountID tests validation with missing from account
 func (s *TransferTestSuite) TestTransfer_Validate_MissingFromAccountID() {
 	transfer := &Transfer{
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -143,7 +143,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_MissingToAccountID() {
 
 	err := transfer.Validate()
 	require.Error(s.T(), err)
-	assert.Contains(s.T(), err.Error(), "to account ID is required")
+	assert.Contains(s.T(), err.Error(), "either to_account_id or to_external_account_id is required")
 }
 
 // TestTransfer_Validate_SameFromAndToAccount tests validation with same accounts
@@ -151,7 +151,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_SameFromAndToAccount() {
 	accountID := uuid.New()
 	transfer := &Transfer{
 		FromAccountID:  accountID,
-		ToAccountID:    accountID,
+		ToAccountID:    &accountID,
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -167,7 +167,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_SameFromAndToAccount() {
 func (s *TransferTestSuite) TestTransfer_Validate_ZeroAmount() {
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.Zero,
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -183,7 +183,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_ZeroAmount() {
 func (s *TransferTestSuite) TestTransfer_Validate_NegativeAmount() {
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(-100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -199,7 +199,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_NegativeAmount() {
 func (s *TransferTestSuite) TestTransfer_Validate_MissingDescription() {
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		IdempotencyKey: uuid.New().String(),
 		Status:         TransferStatusPending,
@@ -214,7 +214,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_MissingDescription() {
 func (s *TransferTestSuite) TestTransfer_Validate_MissingIdempotencyKey() {
 	transfer := &Transfer{
 		FromAccountID: uuid.New(),
-		ToAccountID:   uuid.New(),
+		ToAccountID:   newUUIDPtr(),
 		Amount:        decimal.NewFromFloat(100.00),
 		Description:   gofakeit.Sentence(5),
 		Status:        TransferStatusPending,
@@ -229,7 +229,7 @@ func (s *TransferTestSuite) TestTransfer_Validate_MissingIdempotencyKey() {
 func (s *TransferTestSuite) TestTransfer_Validate_InvalidStatus() {
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -275,7 +275,7 @@ func (s *TransferTestSuite) TestTransfer_Complete() {
 
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -295,7 +295,7 @@ func (s *TransferTestSuite) TestTransfer_Fail() {
 	errorMsg := "insufficient funds"
 	transfer := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -328,6 +328,14 @@ func (s *TransferTestSuite) TestTransfer_CanTransitionTo() {
 	transfer.Status = TransferStatusFailed
 	assert.False(s.T(), transfer.CanTransitionTo(TransferStatusCompleted))
 	assert.False(s.T(), transfer.CanTransitionTo(TransferStatusPending))
+
+	// Held transfers are approved (pending or completed) or rejected (failed)
+	transfer.Status = TransferStatusHeldForReview
+	assert.True(s.T(), transfer.IsHeldForReview())
+	assert.True(s.T(), transfer.CanTransitionTo(TransferStatusPending))
+	assert.True(s.T(), transfer.CanTransitionTo(TransferStatusCompleted))
+	assert.True(s.T(), transfer.CanTransitionTo(TransferStatusFailed))
+	assert.False(s.T(), transfer.CanTransitionTo(TransferStatusProcessing))
 }
 
 // TestIsValidTransferStatus tests status validation function
@@ -335,6 +343,7 @@ func (s *TransferTestSuite) TestIsValidTransferStatus() {
 	assert.True(s.T(), IsValidTransferStatus(TransferStatusPending))
 	assert.True(s.T(), IsValidTransferStatus(TransferStatusCompleted))
 	assert.True(s.T(), IsValidTransferStatus(TransferStatusFailed))
+	assert.True(s.T(), IsValidTransferStatus(TransferStatusHeldForReview))
 	assert.False(s.T(), IsValidTransferStatus("invalid"))
 	assert.False(s.T(), IsValidTransferStatus(""))
 }
@@ -345,7 +354,7 @@ func (s *TransferTestSuite) TestTransfer_UniqueIdempotencyKey() {
 
 	transfer1 := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(100.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: idempotencyKey,
@@ -358,7 +367,7 @@ func (s *TransferTestSuite) TestTransfer_UniqueIdempotencyKey() {
 	// Attempt to create transfer with same idempotency key
 	transfer2 := &Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    newUUIDPtr(),
 		Amount:         decimal.NewFromFloat(200.00),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: idempotencyKey,
@@ -368,3 +377,41 @@ func (s *TransferTestSuite) TestTransfer_UniqueIdempotencyKey() {
 	err = s.db.Create(transfer2).Error
 	require.Error(s.T(), err)
 }
+
+func (s *TransferTestSuite) TestTransfer_ScheduleNextStatusCheck_BacksOff() {
+	transfer := &Transfer{Status: TransferStatusProcessing}
+	now := time.Now()
+
+	transfer.ScheduleNextStatusCheck(now, 30*time.Second, 10*time.Minute)
+	assert.Equal(s.T(), 1, transfer.StatusCheckAttempts)
+	assert.Equal(s.T(), now.Add(30*time.Second), *transfer.NextStatusCheckAt)
+	assert.Equal(s.T(), now, *transfer.LastStatusCheckAt)
+
+	transfer.ScheduleNextStatusCheck(now, 30*time.Second, 10*time.Minute)
+	assert.Equal(s.T(), now.Add(time.Minute), *transfer.NextStatusCheckAt)
+
+	transfer.StatusCheckAttempts = 40
+	transfer.ScheduleNextStatusCheck(now, 30*time.Second, 10*time.Minute)
+	assert.Equal(s.T(), now.Add(10*time.Minute), *transfer.NextStatusCheckAt)
+}
+
+func (s *TransferTestSuite) TestTransfer_EscalateAndReset() {
+	transfer := &Transfer{Status: TransferStatusProcessing}
+	now := time.Now()
+	transfer.ScheduleNextStatusCheck(now, 30*time.Second, 10*time.Minute)
+
+	transfer.Escalate(now)
+	assert.True(s.T(), transfer.IsEscalated())
+	assert.Nil(s.T(), transfer.NextStatusCheckAt)
+
+	transfer.ResetStatusChecks()
+	assert.False(s.T(), transfer.IsEscalated())
+	assert.Equal(s.T(), 0, transfer.StatusCheckAttempts)
+	assert.Nil(s.T(), transfer.NextStatusCheckAt)
+}
+
+// newUUIDPtr returns a pointer to a freshly generated UUID
+func newUUIDPtr() *uuid.UUID {
+	id := uuid.New()
+	return &id
+}
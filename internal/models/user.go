@@ -31,6 +31,7 @@ type User struct {
 	FailedLoginAttempts int            `gorm:"default:0" json:"-"`
 	LockedAt            *time.Time     `gorm:"index" json:"locked_at,omitempty"`
 	LastLoginAt         *time.Time     `gorm:"index" json:"last_login_at,omitempty"`
+	SanctionsStatus     string         `gorm:"type:varchar(20);not null;default:'clear';index" json:"-"`
 	CreatedAt           time.Time      `gorm:"not null" json:"created_at"`
 	UpdatedAt           time.Time      `gorm:"not null" json:"updated_at"`
 	DeletedAt           gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
@@ -99,13 +100,13 @@ func (u *User) IsLocked() bool {
 	return u.LockedAt != nil
 }
 
-func (u *User) Lock() {
+func (u *User) LockAccount() {
 	now := time.Now()
 	u.LockedAt = &now
 	u.FailedLoginAttempts = MaxFailedLoginAttempts
 }
 
-func (u *User) Unlock() {
+func (u *User) UnlockAccount() {
 	u.LockedAt = nil
 	u.FailedLoginAttempts = 0
 }
@@ -113,7 +114,7 @@ func (u *User) Unlock() {
 func (u *User) IncrementFailedAttempts() {
 	u.FailedLoginAttempts++
 	if u.FailedLoginAttempts >= MaxFailedLoginAttempts {
-		u.Lock()
+		u.LockAccount()
 	}
 }
 
@@ -134,6 +135,11 @@ func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
 }
 
+// IsSanctionsHeld reports whether a sanctions match restricts the user.
+func (u *User) IsSanctionsHeld() bool {
+	return SanctionsHeld(u.SanctionsStatus)
+}
+
 func (u *User) IsCustomer() bool {
 	return u.Role == RoleCustomer
 }
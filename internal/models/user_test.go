@@ -132,7 +132,7 @@ func TestUser_Lock(t *testing.T) {
 		FailedLoginAttempts: 2,
 	}
 	
-	user.Lock()
+	user.LockAccount()
 	
 	assert.NotNil(t, user.LockedAt)
 	assert.Equal(t, 3, user.FailedLoginAttempts)
@@ -146,7 +146,7 @@ func TestUser_Unlock(t *testing.T) {
 		LockedAt:           &now,
 	}
 	
-	user.Unlock()
+	user.UnlockAccount()
 	
 	assert.Nil(t, user.LockedAt)
 	assert.Equal(t, 0, user.FailedLoginAttempts)
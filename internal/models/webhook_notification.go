@@ -8,10 +8,12 @@ import (
 )
 
 const (
-	WebhookStatusPending = "pending"
-	WebhookStatusSent    = "sent"
-	WebhookStatusFailed  = "failed"
-	WebhookMaxAttempts   = 5
+	WebhookStatusPending      = "pending"
+	WebhookStatusSent         = "sent"
+	WebhookStatusFailed       = "failed"
+	WebhookStatusDeadLettered = "dead_lettered" // Retries exhausted; awaiting admin replay or resolution
+	WebhookStatusResolved     = "resolved"      // Closed by an admin without a successful delivery
+	WebhookMaxAttempts        = 5
 )
 
 // WebhookNotification stores the state of an outgoing webhook to a regulator.
@@ -27,6 +29,11 @@ type WebhookNotification struct {
 	NextAttemptAt      *time.Time `gorm:"index"`
 	ResponseBody       *string    `gorm:"type:text"`
 	ResponseStatusCode *int
+	LastError          *string `gorm:"type:text"`
+	DeadLetteredAt     *time.Time
+	ResolvedBy         *uuid.UUID `gorm:"type:uuid"`
+	ResolvedAt         *time.Time
+	ResolutionNote     *string `gorm:"type:text"`
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
@@ -42,3 +49,36 @@ func (w *WebhookNotification) BeforeCreate(tx *gorm.DB) (err error) {
 	}
 	return
 }
+
+// MarkDeadLettered parks a notification whose retries are exhausted until an admin acts on it.
+func (w *WebhookNotification) MarkDeadLettered() {
+	now := time.Now()
+	w.Status = WebhookStatusDeadLettered
+	w.DeadLetteredAt = &now
+	w.NextAttemptAt = nil
+}
+
+// CanReplay reports whether the notification is failing or dead-lettered and may be sent again.
+func (w *WebhookNotification) CanReplay() bool {
+	return w.Status == WebhookStatusFailed || w.Status == WebhookStatusDeadLettered
+}
+
+// Replay returns the notification to the delivery queue with a fresh set of attempts.
+// The last response and error are kept for inspection until the next attempt overwrites them.
+func (w *WebhookNotification) Replay() {
+	now := time.Now()
+	w.Status = WebhookStatusPending
+	w.Attempts = 0
+	w.NextAttemptAt = &now
+	w.DeadLetteredAt = nil
+}
+
+// Resolve closes the notification without delivering it and records the admin who did so.
+func (w *WebhookNotification) Resolve(adminID uuid.UUID, note string) {
+	now := time.Now()
+	w.Status = WebhookStatusResolved
+	w.NextAttemptAt = nil
+	w.ResolvedBy = &adminID
+	w.ResolvedAt = &now
+	w.ResolutionNote = &note
+}
@@ -0,0 +1,9 @@
+package models
+
+import "github.com/google/uuid"
+
+// WebhookNotificationFilters contains filter criteria for regulator webhook notification queries
+type WebhookNotificationFilters struct {
+	Statuses   []string
+	TransferID *uuid.UUID
+}
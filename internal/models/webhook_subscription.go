@@ -0,0 +1,131 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Webhook subscription statuses
+const (
+	WebhookSubscriptionStatusActive   = "active"
+	WebhookSubscriptionStatusDisabled = "disabled"
+)
+
+// Event types customers can subscribe to. balance.low is derived from transaction.posted when a
+// debit takes the balance below the subscription's threshold; webhook.ping is only sent on request.
+const (
+	WebhookEventBalanceLow = "balance.low"
+	WebhookEventPing       = "webhook.ping"
+)
+
+// WebhookSubscriptionEventTypes lists the event types a subscription can select.
+var WebhookSubscriptionEventTypes = []string{
+	EventTypeTransactionPosted,
+	EventTypeTransferCompleted,
+	EventTypeTransferFailed,
+	WebhookEventBalanceLow,
+}
+
+// WebhookSubscriptionFailureLimit is the number of consecutive failed delivery attempts after
+// which a subscription is disabled. Any successful delivery resets the count.
+const WebhookSubscriptionFailureLimit = 15
+
+// WebhookSubscription is a customer's endpoint for account event callbacks. Deliveries are signed
+// with the subscription's own secret, which is only returned when the subscription is created.
+type WebhookSubscription struct {
+	ID                  uuid.UUID       `gorm:"type:uuid;primary_key;"`
+	UserID              uuid.UUID       `gorm:"type:uuid;not null;index"`
+	URL                 string          `gorm:"type:varchar(512);not null"`
+	Description         string          `gorm:"type:varchar(255)"`
+	EventTypes          string          `gorm:"type:varchar(255);not null"` // Comma separated
+	Secret              string          `gorm:"type:varchar(100);not null"`
+	LowBalanceThreshold decimal.Decimal `gorm:"type:decimal(15,2);not null;default:0"`
+	Status              string          `gorm:"type:varchar(20);not null;default:'active';index"`
+	ConsecutiveFailures int             `gorm:"not null;default:0"`
+	DisabledAt          *time.Time
+	DisabledReason      *string `gorm:"type:text"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (s *WebhookSubscription) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	if s.Status == "" {
+		s.Status = WebhookSubscriptionStatusActive
+	}
+	return
+}
+
+// EventTypeList returns the subscribed event types.
+func (s *WebhookSubscription) EventTypeList() []string {
+	if s.EventTypes == "" {
+		return []string{}
+	}
+	return strings.Split(s.EventTypes, ",")
+}
+
+// SetEventTypes replaces the subscribed event types.
+func (s *WebhookSubscription) SetEventTypes(eventTypes []string) {
+	s.EventTypes = strings.Join(eventTypes, ",")
+}
+
+// Subscribes returns true if the subscription selected the event type.
+func (s *WebhookSubscription) Subscribes(eventType string) bool {
+	for _, t := range s.EventTypeList() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive returns true if events are being delivered to the subscription.
+func (s *WebhookSubscription) IsActive() bool {
+	return s.Status == WebhookSubscriptionStatusActive
+}
+
+// WebhookDelivery is one event sent, or to be sent, to a subscription. It doubles as the delivery
+// log: the outcome of the latest attempt is kept on the record. Status and retry scheduling follow
+// WebhookNotification, using the WebhookStatus constants and WebhookMaxAttempts.
+type WebhookDelivery struct {
+	ID                 uuid.UUID           `gorm:"type:uuid;primary_key;" json:"id"`
+	SubscriptionID     uuid.UUID           `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_deliveries_subscription_event" json:"subscription_id"`
+	Subscription       WebhookSubscription `gorm:"foreignKey:SubscriptionID" json:"-"`
+	EventID            uuid.UUID           `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_deliveries_subscription_event" json:"event_id"` // Sent to the customer, who deduplicates on it
+	EventType          string              `gorm:"type:varchar(100);not null" json:"event_type"`
+	Payload            JSONBMap            `gorm:"type:jsonb" json:"payload"`
+	Status             string              `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	Attempts           int                 `gorm:"not null;default:0" json:"attempts"`
+	LastAttemptAt      *time.Time          `json:"last_attempt_at,omitempty"`
+	NextAttemptAt      *time.Time          `gorm:"index" json:"next_attempt_at,omitempty"`
+	ResponseStatusCode *int                `json:"response_status_code,omitempty"`
+	ResponseBody       *string             `gorm:"type:text" json:"-"` // Truncated; for support only, never returned to customers
+	LastError          *string             `gorm:"type:text" json:"last_error,omitempty"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+}
+
+// BeforeCreate will set a UUID rather than an integer ID.
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	if d.EventID == uuid.Nil {
+		d.EventID = uuid.New()
+	}
+	if d.Status == "" {
+		d.Status = WebhookStatusPending
+	}
+	if d.NextAttemptAt == nil {
+		now := time.Now()
+		d.NextAttemptAt = &now
+	}
+	return
+}
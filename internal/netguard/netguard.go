@@ -0,0 +1,105 @@
+// Package netguard restricts outbound requests to destinations chosen by customers, such as
+// webhook URLs, to public https endpoints, so they cannot be used to reach the cloud metadata
+// service, the metrics port or anything else on the internal network.
+//
+// CheckURL rejects URLs up front. Control is installed on the dialer and checks every address
+// actually connected to, after DNS resolution, so a host name that later resolves to an internal
+// address (DNS rebinding) is refused as well.
+package netguard
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+var (
+	ErrInsecureScheme     = errors.New("URL must use https")
+	ErrMissingHost        = errors.New("URL has no host")
+	ErrDisallowedAddress  = errors.New("destination address is not allowed")
+	ErrDisallowedHostname = errors.New("destination host is not allowed")
+)
+
+// Special-purpose ranges not covered by the netip.Addr predicates
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "This network"
+	netip.MustParsePrefix("100.64.0.0/10"), // Shared address space for carrier-grade NAT
+	netip.MustParsePrefix("198.18.0.0/15"), // Benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, which can translate to an internal IPv4 address
+}
+
+// Allowed reports whether addr is a public unicast address. Loopback, private (including IPv6
+// unique local), link-local, multicast, unspecified and other reserved addresses are not
+// allowed; IPv4-mapped IPv6 addresses are judged by the IPv4 address.
+func Allowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckURL reports whether raw is an https URL whose host is not an internal address or name.
+// Host names are resolved only when connecting, where Control checks them.
+func CheckURL(raw string) error {
+	u, err := parseHTTPS(raw)
+	if err != nil {
+		return err
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return ErrMissingHost
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !Allowed(addr) {
+			return fmt.Errorf("%w: %s", ErrDisallowedAddress, host)
+		}
+		return nil
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(host, "."))
+	if name == "localhost" || strings.HasSuffix(name, ".localhost") || strings.HasSuffix(name, ".internal") || strings.HasSuffix(name, ".local") {
+		return fmt.Errorf("%w: %s", ErrDisallowedHostname, host)
+	}
+	return nil
+}
+
+// CheckScheme reports whether raw is an https URL, leaving its host to Control.
+func CheckScheme(raw string) error {
+	_, err := parseHTTPS(raw)
+	return err
+}
+
+func parseHTTPS(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(u.Scheme, "https") {
+		return nil, ErrInsecureScheme
+	}
+	return u, nil
+}
+
+// Control is a net.Dialer Control function that refuses to connect to addresses that are not
+// Allowed. It is called with the resolved address of every connection attempt.
+func Control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if addr, err := netip.ParseAddr(host); err != nil || !Allowed(addr) {
+		return fmt.Errorf("%w: %s", ErrDisallowedAddress, host)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package netguard
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NetguardTestSuite struct {
+	suite.Suite
+}
+
+func TestNetguardTestSuite(t *testing.T) {
+	suite.Run(t, new(NetguardTestSuite))
+}
+
+func (s *NetguardTestSuite) TestAllowed() {
+	for _, addr := range []string{"93.184.216.34", "8.8.8.8", "2606:4700:4700::1111"} {
+		s.True(Allowed(netip.MustParseAddr(addr)), addr)
+	}
+
+	for _, addr := range []string{
+		"127.0.0.1",        // Loopback
+		"10.1.2.3",         // Private
+		"172.16.0.1",       // Private
+		"192.168.1.1",      // Private
+		"169.254.169.254",  // Link-local; cloud metadata
+		"100.64.0.1",       // Carrier-grade NAT
+		"0.0.0.0",          // Unspecified
+		"0.1.2.3",          // "This network"
+		"224.0.0.1",        // Multicast
+		"255.255.255.255",  // Broadcast
+		"::1",              // Loopback
+		"fd00::1",          // Unique local
+		"fe80::1",          // Link-local
+		"::ffff:127.0.0.1", // IPv4-mapped loopback
+		"::ffff:10.0.0.1",  // IPv4-mapped private
+		"64:ff9b::a00:1",   // NAT64 of 10.0.0.1
+	} {
+		s.False(Allowed(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func (s *NetguardTestSuite) TestCheckURL() {
+	s.NoError(CheckURL("https://hooks.example.com/banking"))
+	s.NoError(CheckURL("https://93.184.216.34:8443/hooks"))
+
+	s.ErrorIs(CheckURL("http://hooks.example.com/banking"), ErrInsecureScheme)
+	s.ErrorIs(CheckURL("ftp://hooks.example.com"), ErrInsecureScheme)
+	s.ErrorIs(CheckURL("https:///path"), ErrMissingHost)
+	s.ErrorIs(CheckURL("https://169.254.169.254/latest/meta-data"), ErrDisallowedAddress)
+	s.ErrorIs(CheckURL("https://[::1]:9090/metrics"), ErrDisallowedAddress)
+	s.ErrorIs(CheckURL("https://localhost/admin"), ErrDisallowedHostname)
+	s.ErrorIs(CheckURL("https://Metadata.Google.Internal/"), ErrDisallowedHostname)
+}
+
+func (s *NetguardTestSuite) TestCheckScheme() {
+	s.NoError(CheckScheme("https://127.0.0.1/hooks"), "the host is left to Control")
+	s.ErrorIs(CheckScheme("http://hooks.example.com"), ErrInsecureScheme)
+}
+
+func (s *NetguardTestSuite) TestControl_RefusesResolvedInternalAddress() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	defer listener.Close()
+
+	// The dialer checks the address it connects to, whatever name it was resolved from
+	dialer := &net.Dialer{Control: Control}
+	_, err = dialer.DialContext(context.Background(), "tcp", listener.Addr().String())
+	s.ErrorIs(err, ErrDisallowedAddress)
+
+	s.NoError(Control("tcp", "93.184.216.34:443", nil))
+	s.ErrorIs(Control("tcp6", "[fd00::1]:443", nil), ErrDisallowedAddress)
+}
@@ -0,0 +1,59 @@
+package northwindtest
+
+import (
+	"net/http/httptest"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+)
+
+// Server runs a Simulator on a local httptest server for integration tests
+type Server struct {
+	*Simulator
+	URL string
+
+	server *httptest.Server
+}
+
+// NewServer starts a simulator listening on a random local port. Call Close when done.
+func NewServer(opts Options) *Server {
+	sim := NewSimulator(opts)
+	server := httptest.NewServer(sim)
+
+	return &Server{
+		Simulator: sim,
+		URL:       server.URL,
+		server:    server,
+	}
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// NorthwindConfig returns client settings that point at the simulator, with short retry delays
+func (s *Server) NorthwindConfig() config.NorthwindConfig {
+	return config.NorthwindConfig{
+		BaseURL:        s.URL + APIPrefix,
+		APIKey:         s.opts.APIKey,
+		Timeout:        5 * time.Second,
+		MaxRetries:     2,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  10 * time.Millisecond,
+	}
+}
+
+// RegulatorConfig returns regulator client settings that point at the simulator, signing with
+// the first regulator secret
+func (s *Server) RegulatorConfig() config.RegulatorConfig {
+	cfg := config.RegulatorConfig{
+		WebhookURL:    s.URL + RegulatorPath,
+		WebhookAPIKey: s.opts.RegulatorAPIKey,
+		ReportURL:     s.URL + RegulatorReportsPath,
+	}
+	if len(s.opts.RegulatorSecrets) > 0 {
+		cfg.WebhookSigningSecret = s.opts.RegulatorSecrets[0]
+	}
+	return cfg
+}
@@ -0,0 +1,734 @@
+package northwindtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/regulatorwebhook"
+	"github.com/array/banking-api/internal/signature"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Simulator routes
+const (
+	APIPrefix            = "/api/v1"             // Northwind partner API, the client's base URL
+	RegulatorPath        = "/regulator/webhooks" // Regulator webhook receiver
+	RegulatorReportsPath = "/regulator/reports"  // Regulator compliance report receiver
+	ControlPrefix        = "/_sim"               // Scenario scripting and state inspection
+)
+
+// ErrTransferNotFound is returned when scripting a transfer the simulator does not know
+var ErrTransferNotFound = errors.New("simulated transfer not found")
+
+// Duration is a time.Duration that reads and writes JSON as a string such as "1.5s"
+type Duration time.Duration
+
+// MarshalJSON encodes the duration as a Go duration string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON accepts a Go duration string or a number of nanoseconds
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case string:
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(parsed)
+	case float64:
+		*d = Duration(time.Duration(v))
+	default:
+		return fmt.Errorf("invalid duration %s", string(data))
+	}
+	return nil
+}
+
+// Scenario controls how the simulated partners behave. The zero value accepts every request
+// immediately and completes every transfer on its next status check.
+type Scenario struct {
+	Latency           Duration `json:"latency"`            // Added before every Northwind API response
+	CompletionDelay   Duration `json:"completion_delay"`   // Time a transfer stays processing before it resolves
+	FailureRate       float64  `json:"failure_rate"`       // Probability (0-1) that a transfer resolves as failed
+	FailureReason     string   `json:"failure_reason"`     // Reason reported for failed transfers
+	Outage            bool     `json:"outage"`             // Every Northwind API call returns 503
+	InsufficientFunds bool     `json:"insufficient_funds"` // New transfers are rejected with insufficient_funds
+	RegulatorOutage   bool     `json:"regulator_outage"`   // The regulator webhook and report receivers return 503
+}
+
+// SimulatedAccount is an external account registered with the simulator
+type SimulatedAccount struct {
+	ID uuid.UUID `json:"id"`
+	dto.NorthwindCreateAccountRequest
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SimulatedTransfer is a transfer initiated with the simulator
+type SimulatedTransfer struct {
+	dto.NorthwindGetTransferResponse
+	TransferType   string     `json:"transfer_type"`
+	IdempotencyKey string     `json:"idempotency_key,omitempty"`
+	FailureReason  string     `json:"failure_reason,omitempty"`
+	ResolveAt      time.Time  `json:"resolve_at"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty"`
+	Notified       bool       `json:"notified"` // Status callback delivered to the API
+
+	willFail bool // Outcome is drawn at creation so a seeded run is reproducible
+}
+
+// RegulatorNotification is a notification received by the simulated regulator
+type RegulatorNotification struct {
+	ReceivedAt time.Time                        `json:"received_at"`
+	Attempt    int                              `json:"attempt"` // From the attempt header; 0 when missing
+	Payload    dto.RegulatorNotificationPayload `json:"payload"`
+}
+
+// RegulatorReport is a compliance report file received by the simulated regulator
+type RegulatorReport struct {
+	ReceivedAt time.Time                `json:"received_at"`
+	File       dto.ComplianceReportFile `json:"file"`
+}
+
+// WebhookDelivery records an attempt to push an event to the API
+type WebhookDelivery struct {
+	EventID     string                   `json:"event_id"`
+	Type        string                   `json:"type"`
+	TransferID  string                   `json:"transfer_id,omitempty"`
+	Status      string                   `json:"status,omitempty"`
+	Ack         *dto.NorthwindWebhookAck `json:"ack,omitempty"`
+	Error       string                   `json:"error,omitempty"`
+	AttemptedAt time.Time                `json:"attempted_at"`
+}
+
+// State is a snapshot of everything the simulator has seen
+type State struct {
+	Scenario               Scenario                `json:"scenario"`
+	Accounts               []SimulatedAccount      `json:"accounts"`
+	Transfers              []SimulatedTransfer     `json:"transfers"`
+	RegulatorNotifications []RegulatorNotification `json:"regulator_notifications"`
+	RegulatorReports       []RegulatorReport       `json:"regulator_reports"`
+	WebhookDeliveries      []WebhookDelivery       `json:"webhook_deliveries"`
+}
+
+// Options configures a Simulator
+type Options struct {
+	APIKey           string         // Required X-Api-Key for Northwind API calls; empty accepts any
+	RegulatorAPIKey  string         // Required X-Api-Key for regulator webhooks; empty accepts any
+	RegulatorSecrets []string       // Regulator webhooks must be signed with one of these and not replayed; empty accepts unsigned
+	Webhooks         *WebhookSender // Pushes status changes and credits to the API; nil disables pushes
+	Scenario         Scenario
+	Seed             uint64           // Seeds failure draws; zero picks a random seed
+	Now              func() time.Time // Defaults to time.Now
+}
+
+// Simulator implements the Northwind accounts, transfers and health API, and the regulator
+// webhook receiver, as a single http.Handler. Transfers start as processing and resolve once
+// their completion delay has elapsed; resolved transfers are reported to the API by Advance.
+type Simulator struct {
+	mu                     sync.Mutex
+	opts                   Options
+	scenario               Scenario
+	accounts               map[uuid.UUID]*SimulatedAccount
+	transfers              map[string]*SimulatedTransfer
+	transfersByKey         map[string]*SimulatedTransfer
+	regulatorNotifications []RegulatorNotification
+	regulatorReports       []RegulatorReport
+	regulatorVerifier      *regulatorwebhook.Verifier
+	deliveries             []WebhookDelivery
+	rand                   *rand.Rand
+	now                    func() time.Time
+	mux                    *http.ServeMux
+	logger                 *slog.Logger
+}
+
+// NewSimulator creates a simulator with the given options
+func NewSimulator(opts Options) *Simulator {
+	seed := opts.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	s := &Simulator{
+		opts:     opts,
+		scenario: opts.Scenario,
+		rand:     rand.New(rand.NewPCG(seed, seed)),
+		now:      now,
+		logger:   slog.Default().With("component", "partnersim"),
+	}
+	if len(opts.RegulatorSecrets) > 0 {
+		s.regulatorVerifier = regulatorwebhook.NewVerifier(opts.RegulatorSecrets, signature.DefaultTolerance)
+		s.regulatorVerifier.Now = now
+	}
+	s.resetLocked()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+APIPrefix+"/health", s.partnerAPI(s.handleHealth))
+	mux.HandleFunc("POST "+APIPrefix+"/accounts", s.partnerAPI(s.handleCreateAccount))
+	mux.HandleFunc("POST "+APIPrefix+"/transfers", s.partnerAPI(s.handleInitiateTransfer))
+	mux.HandleFunc("GET "+APIPrefix+"/transfers/{id}", s.partnerAPI(s.handleGetTransfer))
+	mux.HandleFunc("POST "+RegulatorPath, s.handleRegulatorWebhook)
+	mux.HandleFunc("POST "+RegulatorReportsPath, s.handleRegulatorReport)
+	mux.HandleFunc("GET "+ControlPrefix+"/state", s.handleState)
+	mux.HandleFunc("GET "+ControlPrefix+"/scenario", s.handleGetScenario)
+	mux.HandleFunc("PUT "+ControlPrefix+"/scenario", s.handlePutScenario)
+	mux.HandleFunc("POST "+ControlPrefix+"/reset", s.handleReset)
+	mux.HandleFunc("POST "+ControlPrefix+"/advance", s.handleAdvance)
+	mux.HandleFunc("POST "+ControlPrefix+"/transfers/{id}/complete", s.handleResolve(dto.NorthwindTransferStatusCompleted))
+	mux.HandleFunc("POST "+ControlPrefix+"/transfers/{id}/fail", s.handleResolve(dto.NorthwindTransferStatusFailed))
+	mux.HandleFunc("POST "+ControlPrefix+"/credits", s.handleSendCredit)
+	s.mux = mux
+
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Simulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Scenario returns the active scenario
+func (s *Simulator) Scenario() Scenario {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scenario
+}
+
+// SetScenario replaces the active scenario. Transfers already in flight keep their outcome.
+func (s *Simulator) SetScenario(scenario Scenario) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scenario = scenario
+}
+
+// Reset clears all accounts, transfers and recorded notifications and restores the initial scenario
+func (s *Simulator) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resetLocked()
+}
+
+func (s *Simulator) resetLocked() {
+	s.scenario = s.opts.Scenario
+	s.accounts = make(map[uuid.UUID]*SimulatedAccount)
+	s.transfers = make(map[string]*SimulatedTransfer)
+	s.transfersByKey = make(map[string]*SimulatedTransfer)
+	s.regulatorNotifications = nil
+	s.regulatorReports = nil
+	s.deliveries = nil
+}
+
+// State returns a snapshot of the simulator, with accounts and transfers in creation order
+func (s *Simulator) State() State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := State{
+		Scenario:               s.scenario,
+		Accounts:               make([]SimulatedAccount, 0, len(s.accounts)),
+		Transfers:              make([]SimulatedTransfer, 0, len(s.transfers)),
+		RegulatorNotifications: append([]RegulatorNotification{}, s.regulatorNotifications...),
+		RegulatorReports:       append([]RegulatorReport{}, s.regulatorReports...),
+		WebhookDeliveries:      append([]WebhookDelivery{}, s.deliveries...),
+	}
+	for _, account := range s.accounts {
+		state.Accounts = append(state.Accounts, *account)
+	}
+	for _, transfer := range s.transfers {
+		state.Transfers = append(state.Transfers, *transfer)
+	}
+	sort.Slice(state.Accounts, func(i, j int) bool { return state.Accounts[i].CreatedAt.Before(state.Accounts[j].CreatedAt) })
+	sort.Slice(state.Transfers, func(i, j int) bool { return state.Transfers[i].CreatedAt.Before(state.Transfers[j].CreatedAt) })
+
+	return state
+}
+
+// Transfer returns a snapshot of a single transfer
+func (s *Simulator) Transfer(id string) (SimulatedTransfer, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	transfer, ok := s.transfers[id]
+	if !ok {
+		return SimulatedTransfer{}, false
+	}
+	return *transfer, true
+}
+
+// RegulatorNotifications returns the notifications received by the simulated regulator
+func (s *Simulator) RegulatorNotifications() []RegulatorNotification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RegulatorNotification{}, s.regulatorNotifications...)
+}
+
+// RegulatorReports returns the compliance report files received by the simulated regulator
+func (s *Simulator) RegulatorReports() []RegulatorReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RegulatorReport{}, s.regulatorReports...)
+}
+
+// ResolveTransfer forces a processing transfer to completed or failed now, regardless of its
+// scripted outcome. The status callback is delivered on the next Advance.
+func (s *Simulator) ResolveTransfer(id, status, reason string) error {
+	if status != dto.NorthwindTransferStatusCompleted && status != dto.NorthwindTransferStatusFailed {
+		return fmt.Errorf("cannot resolve transfer to status %q", status)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	transfer, ok := s.transfers[id]
+	if !ok {
+		return ErrTransferNotFound
+	}
+	if transfer.ResolvedAt != nil {
+		return fmt.Errorf("transfer %s is already %s", id, transfer.Status)
+	}
+
+	transfer.willFail = status == dto.NorthwindTransferStatusFailed
+	if reason != "" {
+		transfer.FailureReason = reason
+	}
+	s.resolveLocked(transfer, s.now())
+	return nil
+}
+
+// Advance resolves transfers whose completion delay has elapsed and pushes a
+// transfer.status_changed event to the API for every resolved transfer not yet acknowledged.
+// Failed deliveries are retried on the next call with the same event ID. It returns the number
+// of events delivered.
+func (s *Simulator) Advance(ctx context.Context) int {
+	s.mu.Lock()
+	now := s.now()
+	s.resolveDueLocked(now)
+
+	var pending []SimulatedTransfer
+	if s.opts.Webhooks != nil {
+		for _, transfer := range s.transfers {
+			if transfer.ResolvedAt != nil && !transfer.Notified {
+				pending = append(pending, *transfer)
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(pending, func(i, j int) bool { return pending[i].ResolvedAt.Before(*pending[j].ResolvedAt) })
+
+	delivered := 0
+	for _, transfer := range pending {
+		eventID := fmt.Sprintf("evt_%s_%s", transfer.ID, transfer.Status)
+		ack, err := s.opts.Webhooks.SendTransferStatus(ctx, eventID, dto.NorthwindTransferStatusData{
+			TransferID: transfer.ID,
+			Status:     transfer.Status,
+			Reason:     transfer.FailureReason,
+			OccurredAt: *transfer.ResolvedAt,
+		})
+
+		delivery := WebhookDelivery{
+			EventID:     eventID,
+			Type:        dto.NorthwindEventTransferStatusChanged,
+			TransferID:  transfer.ID,
+			Status:      transfer.Status,
+			Ack:         ack,
+			AttemptedAt: s.now(),
+		}
+		if err != nil {
+			delivery.Error = err.Error()
+			s.logger.Warn("failed to deliver transfer status callback", "transfer_id", transfer.ID, "error", err)
+		}
+
+		s.mu.Lock()
+		s.deliveries = append(s.deliveries, delivery)
+		if err == nil {
+			if current, ok := s.transfers[transfer.ID]; ok {
+				current.Notified = true
+			}
+			delivered++
+		}
+		s.mu.Unlock()
+	}
+
+	return delivered
+}
+
+// Run calls Advance every interval until ctx is cancelled
+func (s *Simulator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Advance(ctx)
+		}
+	}
+}
+
+// SendCredit pushes a credit.received event to the API, as Northwind does when money arrives
+// for one of our accounts.
+func (s *Simulator) SendCredit(ctx context.Context, eventID string, credit dto.NorthwindCreditReceivedData) (*dto.NorthwindWebhookAck, error) {
+	if s.opts.Webhooks == nil {
+		return nil, errors.New("webhook delivery is not configured")
+	}
+
+	ack, err := s.opts.Webhooks.SendCredit(ctx, eventID, credit)
+
+	delivery := WebhookDelivery{
+		EventID:     eventID,
+		Type:        dto.NorthwindEventCreditReceived,
+		Ack:         ack,
+		AttemptedAt: s.now(),
+	}
+	if ack != nil {
+		delivery.EventID = ack.EventID
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+
+	s.mu.Lock()
+	s.deliveries = append(s.deliveries, delivery)
+	s.mu.Unlock()
+
+	return ack, err
+}
+
+func (s *Simulator) resolveDueLocked(now time.Time) {
+	for _, transfer := range s.transfers {
+		if transfer.ResolvedAt == nil && !now.Before(transfer.ResolveAt) {
+			s.resolveLocked(transfer, now)
+		}
+	}
+}
+
+func (s *Simulator) resolveLocked(transfer *SimulatedTransfer, now time.Time) {
+	transfer.ResolvedAt = &now
+	if transfer.willFail {
+		transfer.Status = dto.NorthwindTransferStatusFailed
+		if transfer.FailureReason == "" {
+			transfer.FailureReason = "Simulated failure"
+		}
+		return
+	}
+	transfer.Status = dto.NorthwindTransferStatusCompleted
+	transfer.FailureReason = ""
+}
+
+// partnerAPI applies the scenario's latency and outage, and API key authentication, to a
+// Northwind API handler.
+func (s *Simulator) partnerAPI(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		scenario := s.Scenario()
+
+		if scenario.Latency > 0 {
+			timer := time.NewTimer(time.Duration(scenario.Latency))
+			select {
+			case <-r.Context().Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+
+		if scenario.Outage {
+			writePartnerError(w, http.StatusServiceUnavailable, "unavailable", "Northwind is temporarily unavailable")
+			return
+		}
+		if s.opts.APIKey != "" && r.Header.Get("X-Api-Key") != s.opts.APIKey {
+			writePartnerError(w, http.StatusUnauthorized, "unauthorized", "Invalid API key")
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (s *Simulator) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Simulator) handleCreateAccount(w http.ResponseWriter, r *http.Request) {
+	var req dto.NorthwindCreateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", "Body is not valid JSON")
+		return
+	}
+	if req.AccountNumber == "" || req.RoutingNumber == "" {
+		writePartnerError(w, http.StatusUnprocessableEntity, "validation_error", "account_number and routing_number are required")
+		return
+	}
+
+	account := &SimulatedAccount{
+		ID:                            uuid.New(),
+		NorthwindCreateAccountRequest: req,
+		CreatedAt:                     s.now(),
+	}
+
+	s.mu.Lock()
+	s.accounts[account.ID] = account
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, dto.NorthwindExternalAccountResponse{ID: account.ID})
+}
+
+func (s *Simulator) handleInitiateTransfer(w http.ResponseWriter, r *http.Request) {
+	var req dto.NorthwindInitiateTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", "Body is not valid JSON")
+		return
+	}
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || !amount.IsPositive() || req.SourceAccountID == "" || req.DestinationAccountID == "" {
+		writePartnerError(w, http.StatusUnprocessableEntity, "validation_error", "source_account_id, destination_account_id and a positive amount are required")
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.transfersByKey[key]; ok && key != "" {
+		writeJSON(w, http.StatusCreated, dto.NorthwindInitiateTransferResponse{ID: existing.ID, Status: existing.Status})
+		return
+	}
+
+	if s.scenario.InsufficientFunds {
+		writePartnerError(w, http.StatusBadRequest, "insufficient_funds", "Source account has insufficient funds")
+		return
+	}
+
+	now := s.now()
+	transfer := &SimulatedTransfer{
+		NorthwindGetTransferResponse: dto.NorthwindGetTransferResponse{
+			ID:                   "nw_tr_" + uuid.NewString(),
+			SourceAccountID:      req.SourceAccountID,
+			DestinationAccountID: req.DestinationAccountID,
+			Amount:               amount.StringFixed(2),
+			Direction:            req.Direction,
+			Status:               dto.NorthwindTransferStatusProcessing,
+			CreatedAt:            now,
+		},
+		TransferType:   req.TransferType,
+		IdempotencyKey: key,
+		ResolveAt:      now.Add(time.Duration(s.scenario.CompletionDelay)),
+		willFail:       s.rand.Float64() < s.scenario.FailureRate,
+	}
+	if transfer.willFail {
+		transfer.FailureReason = s.scenario.FailureReason
+	}
+
+	s.transfers[transfer.ID] = transfer
+	if key != "" {
+		s.transfersByKey[key] = transfer
+	}
+
+	writeJSON(w, http.StatusCreated, dto.NorthwindInitiateTransferResponse{ID: transfer.ID, Status: transfer.Status})
+}
+
+func (s *Simulator) handleGetTransfer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	transfer, ok := s.transfers[r.PathValue("id")]
+	if !ok {
+		writePartnerError(w, http.StatusNotFound, "not_found", "Transfer not found")
+		return
+	}
+
+	// Resolve lazily so polling sees the outcome even when Advance is not running
+	s.resolveDueLocked(s.now())
+
+	writeJSON(w, http.StatusOK, transfer.NorthwindGetTransferResponse)
+}
+
+func (s *Simulator) handleRegulatorWebhook(w http.ResponseWriter, r *http.Request) {
+	body, ok := s.readRegulatorRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var payload dto.RegulatorNotificationPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", "Body is not valid JSON")
+		return
+	}
+
+	attempt, _ := strconv.Atoi(r.Header.Get(regulatorwebhook.AttemptHeader))
+
+	s.mu.Lock()
+	s.regulatorNotifications = append(s.regulatorNotifications, RegulatorNotification{
+		ReceivedAt: s.now(),
+		Attempt:    attempt,
+		Payload:    payload,
+	})
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusAccepted, map[string]string{"status": "received"})
+}
+
+func (s *Simulator) handleRegulatorReport(w http.ResponseWriter, r *http.Request) {
+	body, ok := s.readRegulatorRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var file dto.ComplianceReportFile
+	if err := json.Unmarshal(body, &file); err != nil {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", "Body is not valid JSON")
+		return
+	}
+	if file.Format != dto.ComplianceReportFileFormat {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", "Unsupported report format")
+		return
+	}
+
+	s.mu.Lock()
+	s.regulatorReports = append(s.regulatorReports, RegulatorReport{
+		ReceivedAt: s.now(),
+		File:       file,
+	})
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusAccepted, map[string]string{"status": "received", "report_id": file.ReportID.String()})
+}
+
+// readRegulatorRequest applies the regulator outage scenario, API key and signature checks,
+// and returns the body. It writes the error response and returns false when the request is refused.
+func (s *Simulator) readRegulatorRequest(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if s.Scenario().RegulatorOutage {
+		writePartnerError(w, http.StatusServiceUnavailable, "unavailable", "Regulator is temporarily unavailable")
+		return nil, false
+	}
+	if s.opts.RegulatorAPIKey != "" && r.Header.Get("X-Api-Key") != s.opts.RegulatorAPIKey {
+		writePartnerError(w, http.StatusUnauthorized, "unauthorized", "Invalid API key")
+		return nil, false
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", "Failed to read body")
+		return nil, false
+	}
+	if s.regulatorVerifier != nil {
+		if _, err := s.regulatorVerifier.Verify(r.Header, body); err != nil {
+			if errors.Is(err, regulatorwebhook.ErrReplayedEvent) {
+				writePartnerError(w, http.StatusConflict, "replayed_event", "Event has already been received")
+				return nil, false
+			}
+			writePartnerError(w, http.StatusUnauthorized, "invalid_signature", err.Error())
+			return nil, false
+		}
+	}
+	return body, true
+}
+
+func (s *Simulator) handleState(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.State())
+}
+
+func (s *Simulator) handleGetScenario(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.Scenario())
+}
+
+func (s *Simulator) handlePutScenario(w http.ResponseWriter, r *http.Request) {
+	var scenario Scenario
+	if err := json.NewDecoder(r.Body).Decode(&scenario); err != nil {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", err.Error())
+		return
+	}
+	if scenario.FailureRate < 0 || scenario.FailureRate > 1 {
+		writePartnerError(w, http.StatusUnprocessableEntity, "validation_error", "failure_rate must be between 0 and 1")
+		return
+	}
+
+	s.SetScenario(scenario)
+	writeJSON(w, http.StatusOK, scenario)
+}
+
+func (s *Simulator) handleReset(w http.ResponseWriter, r *http.Request) {
+	s.Reset()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Simulator) handleAdvance(w http.ResponseWriter, r *http.Request) {
+	delivered := s.Advance(r.Context())
+	writeJSON(w, http.StatusOK, map[string]int{"delivered": delivered})
+}
+
+func (s *Simulator) handleResolve(status string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Reason string `json:"reason"`
+		}
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writePartnerError(w, http.StatusBadRequest, "validation_error", "Body is not valid JSON")
+				return
+			}
+		}
+
+		id := r.PathValue("id")
+		if err := s.ResolveTransfer(id, status, body.Reason); err != nil {
+			if errors.Is(err, ErrTransferNotFound) {
+				writePartnerError(w, http.StatusNotFound, "not_found", err.Error())
+				return
+			}
+			writePartnerError(w, http.StatusConflict, "invalid_state", err.Error())
+			return
+		}
+
+		transfer, _ := s.Transfer(id)
+		writeJSON(w, http.StatusOK, transfer)
+	}
+}
+
+func (s *Simulator) handleSendCredit(w http.ResponseWriter, r *http.Request) {
+	var credit dto.NorthwindCreditReceivedData
+	if err := json.NewDecoder(r.Body).Decode(&credit); err != nil {
+		writePartnerError(w, http.StatusBadRequest, "validation_error", "Body is not valid JSON")
+		return
+	}
+
+	ack, err := s.SendCredit(r.Context(), "", credit)
+	if err != nil {
+		writePartnerError(w, http.StatusBadGateway, "delivery_failed", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, ack)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writePartnerError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, dto.NorthwindErrorResponse{Code: code, Message: message})
+}
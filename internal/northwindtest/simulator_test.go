@@ -0,0 +1,332 @@
+package northwindtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/regulatorwebhook"
+	"github.com/array/banking-api/internal/services"
+	"github.com/array/banking-api/internal/signature"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeClock is a manually advanced clock for scripting completion delays
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+type SimulatorTestSuite struct {
+	suite.Suite
+	clock  *fakeClock
+	server *Server
+	client services.NorthwindClientInterface
+}
+
+func TestSimulatorTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulatorTestSuite))
+}
+
+func (s *SimulatorTestSuite) SetupTest() {
+	s.clock = &fakeClock{now: time.Now()}
+	s.server = NewServer(Options{
+		APIKey:           "nw-key",
+		RegulatorAPIKey:  "reg-key",
+		RegulatorSecrets: []string{"reg-secret"},
+		Seed:             1,
+		Now:              s.clock.Now,
+		Scenario:         Scenario{CompletionDelay: Duration(time.Minute)},
+	})
+	s.client = services.NewNorthwindClient(s.server.NorthwindConfig(), nil)
+}
+
+func (s *SimulatorTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *SimulatorTestSuite) initiate(key string) *dto.NorthwindInitiateTransferResponse {
+	resp, err := s.client.InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{
+		SourceAccountID:      "1012345678",
+		DestinationAccountID: uuid.NewString(),
+		Amount:               "25.00",
+		Direction:            "debit",
+		TransferType:         "standard",
+		IdempotencyKey:       key,
+	})
+	s.Require().NoError(err)
+	return resp
+}
+
+func (s *SimulatorTestSuite) TestHealthAndAccounts() {
+	s.NoError(s.client.HealthCheck(context.Background()))
+
+	resp, err := s.client.CreateExternalAccount(context.Background(), &dto.NorthwindCreateAccountRequest{
+		AccountNumber: "123456789",
+		RoutingNumber: "021000021",
+		AccountType:   "checking",
+		Currency:      "USD",
+		NameOnAccount: "Jane Doe",
+	})
+	s.Require().NoError(err)
+
+	state := s.server.State()
+	s.Require().Len(state.Accounts, 1)
+	s.Equal(resp.ID, state.Accounts[0].ID)
+	s.Equal("123456789", state.Accounts[0].AccountNumber)
+}
+
+func (s *SimulatorTestSuite) TestRejectsWrongAPIKey() {
+	cfg := s.server.NorthwindConfig()
+	cfg.APIKey = "wrong"
+	err := services.NewNorthwindClient(cfg, nil).HealthCheck(context.Background())
+
+	var nwErr *services.NorthwindError
+	s.Require().ErrorAs(err, &nwErr)
+	s.Equal(http.StatusUnauthorized, nwErr.StatusCode)
+}
+
+func (s *SimulatorTestSuite) TestTransferCompletesAfterDelay() {
+	created := s.initiate("key-1")
+	s.Equal(dto.NorthwindTransferStatusProcessing, created.Status)
+
+	got, err := s.client.GetTransfer(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Equal(dto.NorthwindTransferStatusProcessing, got.Status)
+
+	s.clock.Add(time.Minute)
+	got, err = s.client.GetTransfer(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Equal(dto.NorthwindTransferStatusCompleted, got.Status)
+	s.Equal("25.00", got.Amount)
+}
+
+func (s *SimulatorTestSuite) TestIdempotencyKeyDeduplicates() {
+	first := s.initiate("key-1")
+	second := s.initiate("key-1")
+	third := s.initiate("key-2")
+
+	s.Equal(first.ID, second.ID)
+	s.NotEqual(first.ID, third.ID)
+	s.Len(s.server.State().Transfers, 2)
+}
+
+func (s *SimulatorTestSuite) TestFailureRate() {
+	s.server.SetScenario(Scenario{FailureRate: 1, FailureReason: "account closed"})
+	created := s.initiate("")
+
+	got, err := s.client.GetTransfer(context.Background(), created.ID)
+	s.Require().NoError(err)
+	s.Equal(dto.NorthwindTransferStatusFailed, got.Status)
+
+	transfer, ok := s.server.Transfer(created.ID)
+	s.Require().True(ok)
+	s.Equal("account closed", transfer.FailureReason)
+}
+
+func (s *SimulatorTestSuite) TestOutage() {
+	s.server.SetScenario(Scenario{Outage: true})
+
+	err := s.client.HealthCheck(context.Background())
+	s.ErrorIs(err, services.ErrNorthwindUnavailable)
+
+	s.server.SetScenario(Scenario{})
+	s.NoError(s.client.HealthCheck(context.Background()))
+}
+
+func (s *SimulatorTestSuite) TestInsufficientFunds() {
+	s.server.SetScenario(Scenario{InsufficientFunds: true})
+
+	_, err := s.client.InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{
+		SourceAccountID:      "1012345678",
+		DestinationAccountID: uuid.NewString(),
+		Amount:               "25.00",
+	})
+	s.ErrorIs(err, services.ErrNorthwindInsufficientFunds)
+}
+
+func (s *SimulatorTestSuite) TestNotFoundAndValidation() {
+	_, err := s.client.GetTransfer(context.Background(), "nw_tr_missing")
+	s.ErrorIs(err, services.ErrNorthwindNotFound)
+
+	_, err = s.client.InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{Amount: "-1"})
+	s.ErrorIs(err, services.ErrNorthwindValidation)
+}
+
+func (s *SimulatorTestSuite) TestLatency() {
+	s.server.SetScenario(Scenario{Latency: Duration(20 * time.Millisecond)})
+
+	start := time.Now()
+	s.NoError(s.client.HealthCheck(context.Background()))
+	s.GreaterOrEqual(time.Since(start), 20*time.Millisecond)
+}
+
+func (s *SimulatorTestSuite) TestRegulatorReceiver() {
+	client := services.NewRegulatorClient(s.server.RegulatorConfig())
+	payload := &dto.RegulatorNotificationPayload{EventID: "evt_" + uuid.NewString(), TransferID: uuid.New(), Status: "completed", Amount: "25.00", Currency: "USD"}
+
+	status, _, err := client.SendTransferNotification(context.Background(), payload, 1)
+	s.Require().NoError(err)
+	s.Equal(http.StatusAccepted, status)
+
+	notifications := s.server.RegulatorNotifications()
+	s.Require().Len(notifications, 1)
+	s.Equal(payload.TransferID, notifications[0].Payload.TransferID)
+	s.Equal(payload.EventID, notifications[0].Payload.EventID)
+	s.Equal(1, notifications[0].Attempt)
+
+	s.server.SetScenario(Scenario{RegulatorOutage: true})
+	status, _, err = client.SendTransferNotification(context.Background(), payload, 2)
+	s.Error(err)
+	s.Equal(http.StatusServiceUnavailable, status)
+}
+
+func (s *SimulatorTestSuite) TestRegulatorReceiver_RejectsUnsignedAndReplayed() {
+	cfg := s.server.RegulatorConfig()
+	cfg.WebhookSigningSecret = ""
+	_, _, err := services.NewRegulatorClient(cfg).SendTransferNotification(context.Background(), &dto.RegulatorNotificationPayload{EventID: "evt_unsigned", TransferID: uuid.New(), Status: "completed"}, 1)
+	s.Error(err)
+
+	body := []byte(`{"event_id":"evt_replayed","transfer_id":"` + uuid.NewString() + `","status":"completed"}`)
+	send := func() int {
+		req, err := http.NewRequest(http.MethodPost, s.server.URL+RegulatorPath, bytes.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("X-Api-Key", "reg-key")
+		regulatorwebhook.SetHeaders(req.Header, "evt_replayed", body, []string{"reg-secret"}, s.clock.Now())
+		resp, err := http.DefaultClient.Do(req)
+		s.Require().NoError(err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	s.Equal(http.StatusAccepted, send())
+	s.Equal(http.StatusConflict, send())
+	s.Len(s.server.RegulatorNotifications(), 1)
+
+	// A retry of the same event is signed afresh and accepted; deduplicating it is up to the regulator
+	s.clock.Add(time.Minute)
+	s.Equal(http.StatusAccepted, send())
+	s.Len(s.server.RegulatorNotifications(), 2)
+}
+
+func (s *SimulatorTestSuite) TestRegulatorReportReceiver() {
+	client := services.NewRegulatorClient(s.server.RegulatorConfig())
+	file := &dto.ComplianceReportFile{Format: dto.ComplianceReportFileFormat, ReportID: uuid.New(), ReportType: "ctr", Threshold: "10000.00"}
+
+	status, _, err := client.SubmitComplianceReport(context.Background(), file, 1)
+	s.Require().NoError(err)
+	s.Equal(http.StatusAccepted, status)
+
+	reports := s.server.RegulatorReports()
+	s.Require().Len(reports, 1)
+	s.Equal(file.ReportID, reports[0].File.ReportID)
+	s.Equal("rpt_"+file.ReportID.String(), reports[0].File.EventID)
+	s.Len(s.server.State().RegulatorReports, 1)
+
+	status, _, err = client.SubmitComplianceReport(context.Background(), &dto.ComplianceReportFile{Format: "v0", ReportID: uuid.New()}, 1)
+	s.Error(err)
+	s.Equal(http.StatusBadRequest, status)
+}
+
+func (s *SimulatorTestSuite) TestControlAPI() {
+	created := s.initiate("")
+
+	req, _ := http.NewRequest(http.MethodPut, s.server.URL+ControlPrefix+"/scenario", strings.NewReader(`{"latency":"5ms","failure_rate":0.5}`))
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+	s.Equal(Duration(5*time.Millisecond), s.server.Scenario().Latency)
+	s.Equal(0.5, s.server.Scenario().FailureRate)
+
+	resp, err = http.Post(s.server.URL+ControlPrefix+"/transfers/"+created.ID+"/fail", "application/json", strings.NewReader(`{"reason":"manual"}`))
+	s.Require().NoError(err)
+	resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(s.server.URL + ControlPrefix + "/state")
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	var state State
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&state))
+	s.Require().Len(state.Transfers, 1)
+	s.Equal(dto.NorthwindTransferStatusFailed, state.Transfers[0].Status)
+	s.Equal("manual", state.Transfers[0].FailureReason)
+
+	resp, err = http.Post(s.server.URL+ControlPrefix+"/transfers/nw_tr_missing/complete", "application/json", nil)
+	s.Require().NoError(err)
+	resp.Body.Close()
+	s.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (s *SimulatorTestSuite) TestAdvancePushesSignedStatusCallbacks() {
+	var mu sync.Mutex
+	var received []dto.NorthwindTransferStatusData
+	failNext := true
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body json.RawMessage
+		s.Require().NoError(json.NewDecoder(r.Body).Decode(&body))
+		s.NoError(signature.Verify("whsec", r.Header.Get(TimestampHeader), r.Header.Get(SignatureHeader), body, time.Minute, time.Now()))
+
+		mu.Lock()
+		defer mu.Unlock()
+		if failNext {
+			failNext = false
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var event dto.NorthwindWebhookEvent
+		s.Require().NoError(json.Unmarshal(body, &event))
+		var data dto.NorthwindTransferStatusData
+		s.Require().NoError(json.Unmarshal(event.Data, &data))
+		received = append(received, data)
+		json.NewEncoder(w).Encode(dto.NorthwindWebhookAck{EventID: event.ID, Status: data.Status})
+	}))
+	defer api.Close()
+
+	server := NewServer(Options{
+		Webhooks: NewWebhookSender(api.URL, "whsec"),
+		Now:      s.clock.Now,
+		Scenario: Scenario{CompletionDelay: Duration(time.Minute)},
+	})
+	defer server.Close()
+	s.client = services.NewNorthwindClient(server.NorthwindConfig(), nil)
+	created := s.initiate("")
+
+	s.Equal(0, server.Advance(context.Background()))
+
+	s.clock.Add(time.Minute)
+	s.Equal(0, server.Advance(context.Background()), "first delivery is rejected")
+	s.Equal(1, server.Advance(context.Background()), "failed delivery is retried")
+	s.Equal(0, server.Advance(context.Background()), "acknowledged callbacks are not resent")
+
+	s.Require().Len(received, 1)
+	s.Equal(created.ID, received[0].TransferID)
+	s.Equal(dto.NorthwindTransferStatusCompleted, received[0].Status)
+
+	deliveries := server.State().WebhookDeliveries
+	s.Require().Len(deliveries, 2)
+	s.Equal(deliveries[0].EventID, deliveries[1].EventID, "retries reuse the event ID")
+	s.NotEmpty(deliveries[0].Error)
+}
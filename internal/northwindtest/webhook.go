@@ -0,0 +1,145 @@
+// Package northwindtest provides test doubles for Northwind, the external banking partner,
+// and for the regulator.
+//
+// WebhookSender plays Northwind's side of the webhook contract: it builds events, signs them
+// with the shared secret and posts them to /api/v1/partners/northwind/webhooks. It can target
+// an httptest server in tests or a locally running API.
+//
+// Simulator implements the Northwind API and the regulator webhook receiver with scriptable
+// scenarios. Server embeds it in tests; cmd/partnersim runs it as a standalone binary.
+package northwindtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/signature"
+	"github.com/google/uuid"
+)
+
+// WebhookPath is the API path Northwind webhooks are delivered to
+const WebhookPath = "/api/v1/partners/northwind/webhooks"
+
+// Signature headers, mirrored from the handler so the double has no dependency on it
+const (
+	SignatureHeader = "X-Northwind-Signature"
+	TimestampHeader = "X-Northwind-Timestamp"
+)
+
+// WebhookSender signs and delivers Northwind webhook events
+type WebhookSender struct {
+	BaseURL string
+	Secret  string
+	Client  *http.Client
+	Now     func() time.Time // Override to send stale or future timestamps
+}
+
+// NewWebhookSender creates a sender that delivers to baseURL (for example "http://localhost:8080")
+func NewWebhookSender(baseURL, secret string) *WebhookSender {
+	return &WebhookSender{
+		BaseURL: baseURL,
+		Secret:  secret,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+		Now:     time.Now,
+	}
+}
+
+// NewEvent wraps data in a webhook envelope. An empty eventID is replaced with a random one.
+func NewEvent(eventID, eventType string, data interface{}) (*dto.NorthwindWebhookEvent, error) {
+	if eventID == "" {
+		eventID = "evt_" + uuid.NewString()
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data: %w", err)
+	}
+
+	return &dto.NorthwindWebhookEvent{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      raw,
+	}, nil
+}
+
+// NewRequest builds a signed webhook request for an already encoded body
+func (s *WebhookSender) NewRequest(ctx context.Context, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.BaseURL+WebhookPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, sig := signature.Sign(s.Secret, body, s.Now())
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, sig)
+
+	return req, nil
+}
+
+// Send signs and delivers event, returning the API's acknowledgement
+func (s *WebhookSender) Send(ctx context.Context, event *dto.NorthwindWebhookEvent) (*dto.NorthwindWebhookAck, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	req, err := s.NewRequest(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deliver webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webhook rejected with status %d", resp.StatusCode)
+	}
+
+	var ack dto.NorthwindWebhookAck
+	if err := json.NewDecoder(resp.Body).Decode(&ack); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook acknowledgement: %w", err)
+	}
+
+	return &ack, nil
+}
+
+// SendCredit delivers a credit.received event. Reusing an eventID simulates a redelivery.
+func (s *WebhookSender) SendCredit(ctx context.Context, eventID string, credit dto.NorthwindCreditReceivedData) (*dto.NorthwindWebhookAck, error) {
+	if credit.TransferID == "" {
+		credit.TransferID = uuid.NewString()
+	}
+	if credit.Currency == "" {
+		credit.Currency = "USD"
+	}
+
+	event, err := NewEvent(eventID, dto.NorthwindEventCreditReceived, credit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Send(ctx, event)
+}
+
+// SendTransferStatus delivers a transfer.status_changed event for an outbound transfer.
+func (s *WebhookSender) SendTransferStatus(ctx context.Context, eventID string, status dto.NorthwindTransferStatusData) (*dto.NorthwindWebhookAck, error) {
+	if status.OccurredAt.IsZero() {
+		status.OccurredAt = s.Now().UTC()
+	}
+
+	event, err := NewEvent(eventID, dto.NorthwindEventTransferStatusChanged, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Send(ctx, event)
+}
@@ -0,0 +1,113 @@
+// Package regulatorwebhook defines how transfer notifications and compliance reports sent to the
+// regulator are signed, and provides a Verifier the regulator, or a test double standing in for
+// it, can use to authenticate deliveries and reject replays.
+//
+// Each delivery carries the event ID in both the JSON body and the event ID header, the Unix
+// timestamp it was signed at, and an HMAC-SHA256 signature over "<timestamp>.<body>" (see package
+// signature). While the signing secret is being rotated the signature header holds one signature
+// per secret, comma separated. A retry keeps the event ID, so the regulator can discard an event it
+// already processed, but is signed afresh and carries the next number in the attempt header.
+package regulatorwebhook
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/array/banking-api/internal/signature"
+)
+
+// Delivery headers
+const (
+	EventIDHeader   = "X-Webhook-Event-Id"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+	AttemptHeader   = "X-Webhook-Attempt" // Informational; not covered by the signature
+)
+
+var (
+	ErrMissingEventID  = errors.New("missing webhook event ID")
+	ErrEventIDMismatch = errors.New("webhook event ID header does not match the signed body")
+	ErrReplayedEvent   = errors.New("webhook delivery already received")
+)
+
+// SetHeaders adds the event ID, timestamp and signature headers for a delivery of body, signed
+// with every non-empty secret.
+func SetHeaders(header http.Header, eventID string, body []byte, secrets []string, now time.Time) {
+	timestamp, sig := signature.SignAll(secrets, body, now)
+	header.Set(EventIDHeader, eventID)
+	header.Set(TimestampHeader, timestamp)
+	header.Set(SignatureHeader, sig)
+}
+
+// Verifier authenticates deliveries and remembers them for the tolerance window, rejecting a
+// delivery it has already accepted. A delivery is identified by its event ID and timestamp, so a
+// captured request cannot be replayed while a retry of the same event, signed at a later time,
+// is accepted; deduplicating events is left to the receiver. Older deliveries are rejected by
+// the timestamp check, so they are forgotten once they fall outside the window. It is safe for
+// concurrent use.
+type Verifier struct {
+	Now func() time.Time // Override to verify at a fixed time
+
+	secrets   []string
+	tolerance time.Duration
+
+	mu   sync.Mutex
+	seen map[string]time.Time // Event ID and timestamp to the time they can be forgotten
+}
+
+// NewVerifier creates a verifier accepting signatures made with any of the secrets. A zero
+// tolerance uses signature.DefaultTolerance.
+func NewVerifier(secrets []string, tolerance time.Duration) *Verifier {
+	if tolerance <= 0 {
+		tolerance = signature.DefaultTolerance
+	}
+	return &Verifier{
+		Now:       time.Now,
+		secrets:   secrets,
+		tolerance: tolerance,
+		seen:      make(map[string]time.Time),
+	}
+}
+
+// Verify checks the signature and timestamp of a delivery, confirms the event ID header matches
+// the event_id in the signed body, and rejects deliveries already accepted. It returns the event ID.
+func (v *Verifier) Verify(header http.Header, body []byte) (string, error) {
+	eventID := header.Get(EventIDHeader)
+	if eventID == "" {
+		return "", ErrMissingEventID
+	}
+
+	now := v.Now()
+	timestamp := header.Get(TimestampHeader)
+	if err := signature.VerifyAny(v.secrets, timestamp, header.Get(SignatureHeader), body, v.tolerance, now); err != nil {
+		return "", err
+	}
+
+	// The header is not signed; only the body binds the event ID to the signature
+	var envelope struct {
+		EventID string `json:"event_id"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.EventID != eventID {
+		return "", ErrEventIDMismatch
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for key, expiresAt := range v.seen {
+		if now.After(expiresAt) {
+			delete(v.seen, key)
+		}
+	}
+	key := eventID + "." + timestamp
+	if _, ok := v.seen[key]; ok {
+		return "", ErrReplayedEvent
+	}
+	// Timestamps up to tolerance in the future are accepted, so remember deliveries for twice as long
+	v.seen[key] = now.Add(2 * v.tolerance)
+
+	return eventID, nil
+}
@@ -0,0 +1,109 @@
+package regulatorwebhook
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/signature"
+	"github.com/stretchr/testify/suite"
+)
+
+type RegulatorWebhookTestSuite struct {
+	suite.Suite
+	now      time.Time
+	body     []byte
+	verifier *Verifier
+}
+
+func (s *RegulatorWebhookTestSuite) SetupTest() {
+	s.now = time.Unix(1_700_000_000, 0)
+	s.body = []byte(`{"event_id":"evt_1","transfer_id":"8f1c","status":"completed"}`)
+	s.verifier = NewVerifier([]string{"current", "previous"}, time.Minute)
+	s.verifier.Now = func() time.Time { return s.now }
+}
+
+func TestRegulatorWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(RegulatorWebhookTestSuite))
+}
+
+func (s *RegulatorWebhookTestSuite) signed(eventID string, body []byte, secrets ...string) http.Header {
+	header := http.Header{}
+	SetHeaders(header, eventID, body, secrets, s.now)
+	return header
+}
+
+func (s *RegulatorWebhookTestSuite) TestVerify_Success() {
+	eventID, err := s.verifier.Verify(s.signed("evt_1", s.body, "current"), s.body)
+	s.Require().NoError(err)
+	s.Equal("evt_1", eventID)
+}
+
+func (s *RegulatorWebhookTestSuite) TestVerify_AcceptsEitherSecretDuringRotation() {
+	receiverOnOldSecret := NewVerifier([]string{"previous"}, time.Minute)
+	receiverOnOldSecret.Now = s.verifier.Now
+
+	header := s.signed("evt_1", s.body, "current", "previous")
+	_, err := receiverOnOldSecret.Verify(header, s.body)
+	s.NoError(err)
+
+	_, err = s.verifier.Verify(s.signed("evt_2", []byte(`{"event_id":"evt_2"}`), "previous"), []byte(`{"event_id":"evt_2"}`))
+	s.NoError(err)
+}
+
+func (s *RegulatorWebhookTestSuite) TestVerify_RejectsReplay() {
+	header := s.signed("evt_1", s.body, "current")
+	_, err := s.verifier.Verify(header, s.body)
+	s.Require().NoError(err)
+
+	_, err = s.verifier.Verify(header, s.body)
+	s.ErrorIs(err, ErrReplayedEvent)
+}
+
+func (s *RegulatorWebhookTestSuite) TestVerify_ForgetsEventsOutsideWindow() {
+	_, err := s.verifier.Verify(s.signed("evt_1", s.body, "current"), s.body)
+	s.Require().NoError(err)
+
+	// The stale replay is rejected by its timestamp even once the ID has been forgotten
+	stale := s.signed("evt_1", s.body, "current")
+	s.now = s.now.Add(3 * time.Minute)
+	_, err = s.verifier.Verify(stale, s.body)
+	s.ErrorIs(err, signature.ErrTimestampOutOfTolerance)
+
+	next := []byte(`{"event_id":"evt_2"}`)
+	_, err = s.verifier.Verify(s.signed("evt_2", next, "current"), next)
+	s.Require().NoError(err)
+	s.Len(s.verifier.seen, 1, "expired deliveries are pruned")
+}
+
+func (s *RegulatorWebhookTestSuite) TestVerify_AcceptsRetryOfSameEvent() {
+	_, err := s.verifier.Verify(s.signed("evt_1", s.body, "current"), s.body)
+	s.Require().NoError(err)
+
+	s.now = s.now.Add(30 * time.Second)
+	retry := s.signed("evt_1", s.body, "current")
+	eventID, err := s.verifier.Verify(retry, s.body)
+	s.Require().NoError(err, "a retry is signed afresh")
+	s.Equal("evt_1", eventID)
+
+	_, err = s.verifier.Verify(retry, s.body)
+	s.ErrorIs(err, ErrReplayedEvent)
+}
+
+func (s *RegulatorWebhookTestSuite) TestVerify_RejectsInvalidDeliveries() {
+	_, err := s.verifier.Verify(http.Header{}, s.body)
+	s.ErrorIs(err, ErrMissingEventID)
+
+	_, err = s.verifier.Verify(s.signed("evt_1", s.body, "retired"), s.body)
+	s.ErrorIs(err, signature.ErrSignatureMismatch)
+
+	tampered := []byte(`{"event_id":"evt_1","transfer_id":"8f1c","status":"failed"}`)
+	_, err = s.verifier.Verify(s.signed("evt_1", s.body, "current"), tampered)
+	s.ErrorIs(err, signature.ErrSignatureMismatch)
+
+	// A valid body replayed under a fresh, unsigned event ID header is caught
+	header := s.signed("evt_1", s.body, "current")
+	header.Set(EventIDHeader, "evt_other")
+	_, err = s.verifier.Verify(header, s.body)
+	s.ErrorIs(err, ErrEventIDMismatch)
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -32,8 +33,8 @@ func NewAccountRepository(db *gorm.DB) AccountRepositoryInterface {
 }
 
 // Create creates a new account
-func (r *accountRepository) Create(account *models.Account) error {
-	if err := r.db.Create(account).Error; err != nil {
+func (r *accountRepository) Create(ctx context.Context, account *models.Account) error {
+	if err := r.db.WithContext(ctx).Create(account).Error; err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return ErrAccountNumberExists
 		}
@@ -43,9 +44,9 @@ func (r *accountRepository) Create(account *models.Account) error {
 }
 
 // GetByID retrieves an account by ID
-func (r *accountRepository) GetByID(id uuid.UUID) (*models.Account, error) {
+func (r *accountRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Account, error) {
 	account := &models.Account{ID: id}
-	if err := r.db.First(account).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(account).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrAccountNotFound
 		}
@@ -55,9 +56,9 @@ func (r *accountRepository) GetByID(id uuid.UUID) (*models.Account, error) {
 }
 
 // GetByAccountNumber retrieves an account by account number
-func (r *accountRepository) GetByAccountNumber(accountNumber string) (*models.Account, error) {
+func (r *accountRepository) GetByAccountNumber(ctx context.Context, accountNumber string) (*models.Account, error) {
 	var account models.Account
-	if err := r.db.Where("account_number = ?", accountNumber).First(&account).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("account_number = ?", accountNumber).First(&account).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrAccountNotFound
 		}
@@ -67,18 +68,18 @@ func (r *accountRepository) GetByAccountNumber(accountNumber string) (*models.Ac
 }
 
 // GetByUserID retrieves all accounts for a user
-func (r *accountRepository) GetByUserID(userID uuid.UUID) ([]models.Account, error) {
+func (r *accountRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.Account, error) {
 	var accounts []models.Account
-	if err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&accounts).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Find(&accounts).Error; err != nil {
 		return nil, fmt.Errorf("failed to get accounts for user: %w", err)
 	}
 	return accounts, nil
 }
 
 // GetByUserIDAndType retrieves accounts for a user by type
-func (r *accountRepository) GetByUserIDAndType(userID uuid.UUID, accountType string) ([]models.Account, error) {
+func (r *accountRepository) GetByUserIDAndType(ctx context.Context, userID uuid.UUID, accountType string) ([]models.Account, error) {
 	var accounts []models.Account
-	if err := r.db.Where("user_id = ? AND account_type = ?", userID, accountType).
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND account_type = ?", userID, accountType).
 		Order("created_at DESC").Find(&accounts).Error; err != nil {
 		return nil, fmt.Errorf("failed to get accounts by type: %w", err)
 	}
@@ -86,15 +87,15 @@ func (r *accountRepository) GetByUserIDAndType(userID uuid.UUID, accountType str
 }
 
 // GetAll retrieves all accounts with pagination
-func (r *accountRepository) GetAll(offset, limit int) ([]models.Account, int64, error) {
+func (r *accountRepository) GetAll(ctx context.Context, offset, limit int) ([]models.Account, int64, error) {
 	var accounts []models.Account
 	var total int64
 
-	if err := r.db.Model(&models.Account{}).Count(&total).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&models.Account{}).Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count accounts: %w", err)
 	}
 
-	if err := r.db.Offset(offset).Limit(limit).
+	if err := r.db.WithContext(ctx).Offset(offset).Limit(limit).
 		Order("created_at DESC").Find(&accounts).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to get accounts: %w", err)
 	}
@@ -103,11 +104,11 @@ func (r *accountRepository) GetAll(offset, limit int) ([]models.Account, int64,
 }
 
 // GetAllWithFilters retrieves accounts with filters and pagination
-func (r *accountRepository) GetAllWithFilters(filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
+func (r *accountRepository) GetAllWithFilters(ctx context.Context, filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
 	var accounts []models.Account
 	var total int64
 
-	query := r.db.Model(&models.Account{})
+	query := r.db.WithContext(ctx).Model(&models.Account{})
 
 	if filters.UserID != nil {
 		query = query.Where("user_id = ?", *filters.UserID)
@@ -138,16 +139,16 @@ func (r *accountRepository) GetAllWithFilters(filters models.AccountFilters, off
 }
 
 // Update updates an account
-func (r *accountRepository) Update(account *models.Account) error {
-	if err := r.db.Save(account).Error; err != nil {
+func (r *accountRepository) Update(ctx context.Context, account *models.Account) error {
+	if err := r.db.WithContext(ctx).Save(account).Error; err != nil {
 		return fmt.Errorf("failed to update account: %w", err)
 	}
 	return nil
 }
 
 // Delete soft deletes an account
-func (r *accountRepository) Delete(id uuid.UUID) error {
-	result := r.db.Delete(&models.Account{ID: id})
+func (r *accountRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.Account{ID: id})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete account: %w", result.Error)
 	}
@@ -158,7 +159,7 @@ func (r *accountRepository) Delete(id uuid.UUID) error {
 }
 
 // GenerateUniqueAccountNumber generates a unique account number
-func (r *accountRepository) GenerateUniqueAccountNumber(accountType string) (string, error) {
+func (r *accountRepository) GenerateUniqueAccountNumber(ctx context.Context, accountType string) (string, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -170,7 +171,7 @@ func (r *accountRepository) GenerateUniqueAccountNumber(accountType string) (str
 		}
 
 		var count int64
-		if err := r.db.Model(&models.Account{}).
+		if err := r.db.WithContext(ctx).Model(&models.Account{}).
 			Where("account_number = ?", accountNumber).
 			Count(&count).Error; err != nil {
 			return "", fmt.Errorf("failed to check account number uniqueness: %w", err)
@@ -185,8 +186,8 @@ func (r *accountRepository) GenerateUniqueAccountNumber(accountType string) (str
 }
 
 // CreateWithTransaction creates an account with initial transactions in a database transaction
-func (r *accountRepository) CreateWithTransaction(account *models.Account, transactions []models.Transaction) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *accountRepository) CreateWithTransaction(ctx context.Context, account *models.Account, transactions []models.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(account).Error; err != nil {
 			return fmt.Errorf("failed to create account: %w", err)
 		}
@@ -198,6 +199,14 @@ func (r *accountRepository) CreateWithTransaction(account *models.Account, trans
 			if err := tx.Create(&transactions).Error; err != nil {
 				return fmt.Errorf("failed to create initial transactions: %w", err)
 			}
+
+			events := make([]*models.OutboxEvent, 0, len(transactions))
+			for i := range transactions {
+				events = append(events, models.NewTransactionPostedEvent(&transactions[i]))
+			}
+			if err := appendOutboxEvents(tx, events); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -205,8 +214,8 @@ func (r *accountRepository) CreateWithTransaction(account *models.Account, trans
 }
 
 // UpdateBalance updates account balance within a transaction
-func (r *accountRepository) UpdateBalance(accountID uuid.UUID, amount decimal.Decimal, transactionType string) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *accountRepository) UpdateBalance(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		account := &models.Account{ID: accountID}
 
 		// Row-level locking prevents concurrent balance modifications
@@ -242,9 +251,9 @@ func (r *accountRepository) UpdateBalance(accountID uuid.UUID, amount decimal.De
 }
 
 // GetAccountsByStatus retrieves accounts by status
-func (r *accountRepository) GetAccountsByStatus(status string, offset, limit int) ([]models.Account, error) {
+func (r *accountRepository) GetAccountsByStatus(ctx context.Context, status string, offset, limit int) ([]models.Account, error) {
 	var accounts []models.Account
-	if err := r.db.Where("status = ?", status).
+	if err := r.db.WithContext(ctx).Where("status = ?", status).
 		Offset(offset).Limit(limit).
 		Order("created_at DESC").Find(&accounts).Error; err != nil {
 		return nil, fmt.Errorf("failed to get accounts by status: %w", err)
@@ -253,12 +262,12 @@ func (r *accountRepository) GetAccountsByStatus(status string, offset, limit int
 }
 
 // GetTotalBalanceByUserID calculates the total balance across all accounts for a user
-func (r *accountRepository) GetTotalBalanceByUserID(userID uuid.UUID) (decimal.Decimal, error) {
+func (r *accountRepository) GetTotalBalanceByUserID(ctx context.Context, userID uuid.UUID) (decimal.Decimal, error) {
 	var result struct {
 		Total decimal.Decimal
 	}
 
-	if err := r.db.Model(&models.Account{}).
+	if err := r.db.WithContext(ctx).Model(&models.Account{}).
 		Select("COALESCE(SUM(balance), 0) as total").
 		Where("user_id = ? AND status = ?", userID, models.AccountStatusActive).
 		Scan(&result).Error; err != nil {
@@ -269,9 +278,9 @@ func (r *accountRepository) GetTotalBalanceByUserID(userID uuid.UUID) (decimal.D
 }
 
 // ExistsForUser checks if a user already has an account of the specified type
-func (r *accountRepository) ExistsForUser(userID uuid.UUID, accountType string) (bool, error) {
+func (r *accountRepository) ExistsForUser(ctx context.Context, userID uuid.UUID, accountType string) (bool, error) {
 	var count int64
-	if err := r.db.Model(&models.Account{}).
+	if err := r.db.WithContext(ctx).Model(&models.Account{}).
 		Where("user_id = ? AND account_type = ? AND status != ?",
 			userID, accountType, models.AccountStatusClosed).
 		Count(&count).Error; err != nil {
@@ -280,9 +289,10 @@ func (r *accountRepository) ExistsForUser(userID uuid.UUID, accountType string)
 	return count > 0, nil
 }
 
-// ExecuteAtomicTransfer performs an atomic account-to-account transfer with row locking
-func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (debitTxID, creditTxID uuid.UUID, err error) {
-	err = r.db.Transaction(func(tx *gorm.DB) error {
+// ExecuteAtomicTransfer performs an atomic account-to-account transfer with row locking, writing a
+// transaction.posted event for both legs
+func (r *accountRepository) ExecuteAtomicTransfer(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (debitTxID, creditTxID uuid.UUID, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Debit from source account with row locking
 		fromAcct := &models.Account{ID: fromAccountID}
 		if err := tx.Set("gorm:query_option", "FOR UPDATE").
@@ -301,7 +311,8 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 			return ErrInsufficientFunds
 		}
 
-		newFromBalance := fromAcct.Balance.Sub(amount)
+		fromBalanceBefore := fromAcct.Balance
+		newFromBalance := fromBalanceBefore.Sub(amount)
 		if err := tx.Model(fromAcct).Update("balance", newFromBalance).Error; err != nil {
 			return fmt.Errorf("failed to debit source account: %w", err)
 		}
@@ -310,7 +321,7 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 			AccountID:       fromAccountID,
 			TransactionType: models.TransactionTypeDebit,
 			Amount:          amount,
-			BalanceBefore:   fromAcct.Balance,
+			BalanceBefore:   fromBalanceBefore,
 			BalanceAfter:    newFromBalance,
 			Description:     fromDescription,
 			Status:          models.TransactionStatusCompleted,
@@ -336,7 +347,8 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 			return ErrAccountNotActive
 		}
 
-		newToBalance := toAcct.Balance.Add(amount)
+		toBalanceBefore := toAcct.Balance
+		newToBalance := toBalanceBefore.Add(amount)
 		if err := tx.Model(toAcct).Update("balance", newToBalance).Error; err != nil {
 			return fmt.Errorf("failed to credit destination account: %w", err)
 		}
@@ -345,7 +357,7 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 			AccountID:       toAccountID,
 			TransactionType: models.TransactionTypeCredit,
 			Amount:          amount,
-			BalanceBefore:   toAcct.Balance,
+			BalanceBefore:   toBalanceBefore,
 			BalanceAfter:    newToBalance,
 			Description:     toDescription,
 			Status:          models.TransactionStatusCompleted,
@@ -357,16 +369,19 @@ func (r *accountRepository) ExecuteAtomicTransfer(fromAccountID, toAccountID uui
 		}
 		creditTxID = creditTx.ID
 
-		return nil
+		return appendOutboxEvents(tx, []*models.OutboxEvent{
+			models.NewTransactionPostedEvent(debitTx),
+			models.NewTransactionPostedEvent(creditTx),
+		})
 	})
 
 	return debitTxID, creditTxID, err
 }
 
 // GetByUserIDExcludingStatus retrieves all accounts for a user excluding a specific status
-func (r *accountRepository) GetByUserIDExcludingStatus(userID uuid.UUID, excludeStatus string) ([]*models.Account, error) {
+func (r *accountRepository) GetByUserIDExcludingStatus(ctx context.Context, userID uuid.UUID, excludeStatus string) ([]*models.Account, error) {
 	var accounts []*models.Account
-	if err := r.db.Where("user_id = ? AND status != ?", userID, excludeStatus).
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND status != ?", userID, excludeStatus).
 		Order("created_at DESC").
 		Find(&accounts).Error; err != nil {
 		return nil, fmt.Errorf("failed to get accounts for user: %w", err)
@@ -375,8 +390,8 @@ func (r *accountRepository) GetByUserIDExcludingStatus(userID uuid.UUID, exclude
 }
 
 // UpdateOwnership updates the ownership of an account
-func (r *accountRepository) UpdateOwnership(accountID, newUserID uuid.UUID) error {
-	result := r.db.Model(&models.Account{ID: accountID}).
+func (r *accountRepository) UpdateOwnership(ctx context.Context, accountID, newUserID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Model(&models.Account{ID: accountID}).
 		Update("user_id", newUserID)
 
 	if result.Error != nil {
@@ -389,8 +404,8 @@ func (r *accountRepository) UpdateOwnership(accountID, newUserID uuid.UUID) erro
 }
 
 // SoftDeleteByUserID soft deletes all accounts for a user
-func (r *accountRepository) SoftDeleteByUserID(userID uuid.UUID) error {
-	result := r.db.Where("user_id = ?", userID).
+func (r *accountRepository) SoftDeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).
 		Delete(&models.Account{})
 
 	if result.Error != nil {
@@ -399,7 +414,7 @@ func (r *accountRepository) SoftDeleteByUserID(userID uuid.UUID) error {
 
 	// Also update status to inactive for deleted accounts (requires Unscoped to update soft-deleted records)
 	if result.RowsAffected > 0 {
-		r.db.Unscoped().Model(&models.Account{}).
+		r.db.WithContext(ctx).Unscoped().Model(&models.Account{}).
 			Where("user_id = ? AND deleted_at IS NOT NULL", userID).
 			Update("status", models.AccountStatusInactive)
 	}
@@ -408,9 +423,9 @@ func (r *accountRepository) SoftDeleteByUserID(userID uuid.UUID) error {
 }
 
 // CheckAccountNumberExists checks if an account number already exists
-func (r *accountRepository) CheckAccountNumberExists(accountNumber string) (bool, error) {
+func (r *accountRepository) CheckAccountNumberExists(ctx context.Context, accountNumber string) (bool, error) {
 	var count int64
-	if err := r.db.Model(&models.Account{}).
+	if err := r.db.WithContext(ctx).Model(&models.Account{}).
 		Where("account_number = ?", accountNumber).
 		Count(&count).Error; err != nil {
 		return false, fmt.Errorf("failed to check account number existence: %w", err)
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -57,7 +58,7 @@ func (s *AccountRepositorySuite) TestCreate() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, account.ID)
 	s.NotZero(account.CreatedAt)
@@ -74,7 +75,7 @@ func (s *AccountRepositorySuite) TestCreate_DuplicateAccountNumber() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account1)
+	err := s.repo.Create(context.Background(), account1)
 	s.NoError(err)
 
 	account2 := &models.Account{
@@ -86,7 +87,7 @@ func (s *AccountRepositorySuite) TestCreate_DuplicateAccountNumber() {
 		Currency:      "USD",
 	}
 
-	err = s.repo.Create(account2)
+	err = s.repo.Create(context.Background(), account2)
 	s.Error(err)
 	// Check for either PostgreSQL or SQLite duplicate error messages
 	s.True(strings.Contains(err.Error(), "duplicate key value") || strings.Contains(err.Error(), "UNIQUE constraint failed"),
@@ -104,18 +105,18 @@ func (s *AccountRepositorySuite) TestGetByID() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Test getting existing account
-	found, err := s.repo.GetByID(account.ID)
+	found, err := s.repo.GetByID(context.Background(), account.ID)
 	s.NoError(err)
 	s.NotNil(found)
 	s.Equal(account.ID, found.ID)
 	s.Equal(account.AccountNumber, found.AccountNumber)
 
 	// Test getting non-existent account
-	_, err = s.repo.GetByID(uuid.New())
+	_, err = s.repo.GetByID(context.Background(), uuid.New())
 	s.ErrorIs(err, ErrAccountNotFound)
 }
 
@@ -130,17 +131,17 @@ func (s *AccountRepositorySuite) TestGetByAccountNumber() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Test getting existing account
-	found, err := s.repo.GetByAccountNumber("1012345678")
+	found, err := s.repo.GetByAccountNumber(context.Background(), "1012345678")
 	s.NoError(err)
 	s.NotNil(found)
 	s.Equal(account.AccountNumber, found.AccountNumber)
 
 	// Test getting non-existent account
-	_, err = s.repo.GetByAccountNumber("9999999999")
+	_, err = s.repo.GetByAccountNumber(context.Background(), "9999999999")
 	s.ErrorIs(err, ErrAccountNotFound)
 }
 
@@ -155,7 +156,7 @@ func (s *AccountRepositorySuite) TestGetByUserID() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err := s.repo.Create(account1)
+	err := s.repo.Create(context.Background(), account1)
 	s.NoError(err)
 
 	account2 := &models.Account{
@@ -166,11 +167,11 @@ func (s *AccountRepositorySuite) TestGetByUserID() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account2)
+	err = s.repo.Create(context.Background(), account2)
 	s.NoError(err)
 
 	// Get all accounts for user
-	accounts, err := s.repo.GetByUserID(s.testUser.ID)
+	accounts, err := s.repo.GetByUserID(context.Background(), s.testUser.ID)
 	s.NoError(err)
 	s.Len(accounts, 2)
 
@@ -191,18 +192,18 @@ func (s *AccountRepositorySuite) TestUpdate() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Update account
 	account.Balance = decimal.NewFromFloat(2000.00)
 	account.Status = models.AccountStatusInactive
 
-	err = s.repo.Update(account)
+	err = s.repo.Update(context.Background(), account)
 	s.NoError(err)
 
 	// Verify update
-	updated, err := s.repo.GetByID(account.ID)
+	updated, err := s.repo.GetByID(context.Background(), account.ID)
 	s.NoError(err)
 	s.Equal(decimal.NewFromFloat(2000.00).String(), updated.Balance.String())
 	s.Equal(models.AccountStatusInactive, updated.Status)
@@ -219,36 +220,36 @@ func (s *AccountRepositorySuite) TestDelete() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Delete account
-	err = s.repo.Delete(account.ID)
+	err = s.repo.Delete(context.Background(), account.ID)
 	s.NoError(err)
 
 	// Verify deletion
-	_, err = s.repo.GetByID(account.ID)
+	_, err = s.repo.GetByID(context.Background(), account.ID)
 	s.ErrorIs(err, ErrAccountNotFound)
 }
 
 // Test GenerateUniqueAccountNumber functionality
 func (s *AccountRepositorySuite) TestGenerateUniqueAccountNumber() {
 	// Generate account number for checking account
-	accountNumber1, err := s.repo.GenerateUniqueAccountNumber(models.AccountTypeChecking)
+	accountNumber1, err := s.repo.GenerateUniqueAccountNumber(context.Background(), models.AccountTypeChecking)
 	s.NoError(err)
 	s.NotEmpty(accountNumber1)
 	s.Len(accountNumber1, 10)
 	s.Equal("1", string(accountNumber1[0])) // Checking accounts start with 1
 
 	// Generate account number for savings account
-	accountNumber2, err := s.repo.GenerateUniqueAccountNumber(models.AccountTypeSavings)
+	accountNumber2, err := s.repo.GenerateUniqueAccountNumber(context.Background(), models.AccountTypeSavings)
 	s.NoError(err)
 	s.NotEmpty(accountNumber2)
 	s.Len(accountNumber2, 10)
 	s.Equal("2", string(accountNumber2[0])) // Savings accounts start with 2
 
 	// Generate account number for money market account
-	accountNumber3, err := s.repo.GenerateUniqueAccountNumber(models.AccountTypeMoneyMarket)
+	accountNumber3, err := s.repo.GenerateUniqueAccountNumber(context.Background(), models.AccountTypeMoneyMarket)
 	s.NoError(err)
 	s.NotEmpty(accountNumber3)
 	s.Len(accountNumber3, 10)
@@ -283,12 +284,12 @@ func (s *AccountRepositorySuite) TestCreateWithTransaction() {
 		},
 	}
 
-	err := s.repo.CreateWithTransaction(account, transactions)
+	err := s.repo.CreateWithTransaction(context.Background(), account, transactions)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, account.ID)
 
 	// Verify account was created
-	foundAccount, err := s.repo.GetByID(account.ID)
+	foundAccount, err := s.repo.GetByID(context.Background(), account.ID)
 	s.NoError(err)
 	s.Equal(account.AccountNumber, foundAccount.AccountNumber)
 
@@ -297,6 +298,67 @@ func (s *AccountRepositorySuite) TestCreateWithTransaction() {
 	err = s.db.DB.Where("account_id = ?", account.ID).First(&foundTransaction).Error
 	s.NoError(err)
 	s.Equal(transactions[0].Reference, foundTransaction.Reference)
+
+	// Verify the deposit was posted to the outbox
+	var event models.OutboxEvent
+	err = s.db.DB.Where("event_type = ?", models.EventTypeTransactionPosted).First(&event).Error
+	s.NoError(err)
+	s.Equal(foundTransaction.ID, event.AggregateID)
+	s.Equal(account.ID.String(), event.Payload["account_id"])
+}
+
+// Test ExecuteAtomicTransfer functionality
+func (s *AccountRepositorySuite) TestExecuteAtomicTransfer_PostsBothLegs() {
+	from := &models.Account{
+		UserID:        s.testUser.ID,
+		AccountNumber: "1012345678",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(1000.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	to := &models.Account{
+		UserID:        s.testUser.ID,
+		AccountNumber: "2012345679",
+		AccountType:   models.AccountTypeSavings,
+		Balance:       decimal.NewFromFloat(50.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.repo.Create(context.Background(), from))
+	s.Require().NoError(s.repo.Create(context.Background(), to))
+
+	debitTxID, creditTxID, err := s.repo.ExecuteAtomicTransfer(context.Background(), from.ID, to.ID, decimal.NewFromFloat(200.00), "To savings", "From checking")
+	s.Require().NoError(err)
+
+	var events []models.OutboxEvent
+	s.Require().NoError(s.db.DB.Where("event_type = ?", models.EventTypeTransactionPosted).Order("id").Find(&events).Error)
+	s.Require().Len(events, 2)
+	s.Equal(debitTxID, events[0].AggregateID)
+	s.Equal(models.TransactionTypeDebit, events[0].Payload["transaction_type"])
+	s.Equal("1000", events[0].Payload["balance_before"])
+	s.Equal("800", events[0].Payload["balance_after"])
+	s.Equal(creditTxID, events[1].AggregateID)
+	s.Equal("250", events[1].Payload["balance_after"])
+}
+
+func (s *AccountRepositorySuite) TestExecuteAtomicTransfer_InsufficientFundsWritesNoEvents() {
+	from := &models.Account{
+		UserID:        s.testUser.ID,
+		AccountNumber: "1012345678",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(10.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.repo.Create(context.Background(), from))
+
+	_, _, err := s.repo.ExecuteAtomicTransfer(context.Background(), from.ID, uuid.New(), decimal.NewFromFloat(200.00), "To savings", "From checking")
+	s.ErrorIs(err, ErrInsufficientFunds)
+
+	var count int64
+	s.db.DB.Model(&models.OutboxEvent{}).Count(&count)
+	s.Equal(int64(0), count)
 }
 
 // Test UpdateBalance functionality
@@ -310,15 +372,15 @@ func (s *AccountRepositorySuite) TestUpdateBalance_Credit() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Credit operation
-	err = s.repo.UpdateBalance(account.ID, decimal.NewFromFloat(500.00), models.TransactionTypeCredit)
+	err = s.repo.UpdateBalance(context.Background(), account.ID, decimal.NewFromFloat(500.00), models.TransactionTypeCredit)
 	s.NoError(err)
 
 	// Verify balance
-	updated, err := s.repo.GetByID(account.ID)
+	updated, err := s.repo.GetByID(context.Background(), account.ID)
 	s.NoError(err)
 	s.Equal(decimal.NewFromFloat(1500.00).String(), updated.Balance.String())
 }
@@ -333,15 +395,15 @@ func (s *AccountRepositorySuite) TestUpdateBalance_Debit() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Debit operation
-	err = s.repo.UpdateBalance(account.ID, decimal.NewFromFloat(300.00), models.TransactionTypeDebit)
+	err = s.repo.UpdateBalance(context.Background(), account.ID, decimal.NewFromFloat(300.00), models.TransactionTypeDebit)
 	s.NoError(err)
 
 	// Verify balance
-	updated, err := s.repo.GetByID(account.ID)
+	updated, err := s.repo.GetByID(context.Background(), account.ID)
 	s.NoError(err)
 	s.Equal(decimal.NewFromFloat(700.00).String(), updated.Balance.String())
 }
@@ -356,15 +418,15 @@ func (s *AccountRepositorySuite) TestUpdateBalance_InsufficientFunds() {
 		Currency:      "USD",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Attempt debit with insufficient funds
-	err = s.repo.UpdateBalance(account.ID, decimal.NewFromFloat(500.00), models.TransactionTypeDebit)
+	err = s.repo.UpdateBalance(context.Background(), account.ID, decimal.NewFromFloat(500.00), models.TransactionTypeDebit)
 	s.ErrorIs(err, ErrInsufficientFunds)
 
 	// Verify balance unchanged
-	updated, err := s.repo.GetByID(account.ID)
+	updated, err := s.repo.GetByID(context.Background(), account.ID)
 	s.NoError(err)
 	s.Equal(decimal.NewFromFloat(100.00).String(), updated.Balance.String())
 }
@@ -380,7 +442,7 @@ func (s *AccountRepositorySuite) TestGetAccountsByStatus() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err := s.repo.Create(account1)
+	err := s.repo.Create(context.Background(), account1)
 	s.NoError(err)
 
 	// Create suspended account
@@ -392,17 +454,17 @@ func (s *AccountRepositorySuite) TestGetAccountsByStatus() {
 		Status:        models.AccountStatusInactive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account2)
+	err = s.repo.Create(context.Background(), account2)
 	s.NoError(err)
 
 	// Get active accounts
-	accounts, err := s.repo.GetAccountsByStatus(models.AccountStatusActive, 0, 10)
+	accounts, err := s.repo.GetAccountsByStatus(context.Background(), models.AccountStatusActive, 0, 10)
 	s.NoError(err)
 	s.Len(accounts, 1)
 	s.Equal(models.AccountStatusActive, accounts[0].Status)
 
 	// Get suspended accounts
-	accounts, err = s.repo.GetAccountsByStatus(models.AccountStatusInactive, 0, 10)
+	accounts, err = s.repo.GetAccountsByStatus(context.Background(), models.AccountStatusInactive, 0, 10)
 	s.NoError(err)
 	s.Len(accounts, 1)
 	s.Equal(models.AccountStatusInactive, accounts[0].Status)
@@ -419,7 +481,7 @@ func (s *AccountRepositorySuite) TestGetTotalBalanceByUserID() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err := s.repo.Create(account1)
+	err := s.repo.Create(context.Background(), account1)
 	s.NoError(err)
 
 	account2 := &models.Account{
@@ -430,16 +492,16 @@ func (s *AccountRepositorySuite) TestGetTotalBalanceByUserID() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account2)
+	err = s.repo.Create(context.Background(), account2)
 	s.NoError(err)
 
 	// Get total balance
-	total, err := s.repo.GetTotalBalanceByUserID(s.testUser.ID)
+	total, err := s.repo.GetTotalBalanceByUserID(context.Background(), s.testUser.ID)
 	s.NoError(err)
 	s.Equal(decimal.NewFromFloat(6000.00).String(), total.String())
 
 	// Test with non-existent user
-	total, err = s.repo.GetTotalBalanceByUserID(uuid.New())
+	total, err = s.repo.GetTotalBalanceByUserID(context.Background(), uuid.New())
 	s.NoError(err)
 	s.Equal(decimal.Zero.String(), total.String())
 }
@@ -455,21 +517,21 @@ func (s *AccountRepositorySuite) TestExistsForUser() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 
 	// Test exists for existing account type
-	exists, err := s.repo.ExistsForUser(s.testUser.ID, models.AccountTypeChecking)
+	exists, err := s.repo.ExistsForUser(context.Background(), s.testUser.ID, models.AccountTypeChecking)
 	s.NoError(err)
 	s.True(exists)
 
 	// Test does not exist for different account type
-	exists, err = s.repo.ExistsForUser(s.testUser.ID, models.AccountTypeSavings)
+	exists, err = s.repo.ExistsForUser(context.Background(), s.testUser.ID, models.AccountTypeSavings)
 	s.NoError(err)
 	s.False(exists)
 
 	// Test does not exist for non-existent user
-	exists, err = s.repo.ExistsForUser(uuid.New(), models.AccountTypeChecking)
+	exists, err = s.repo.ExistsForUser(context.Background(), uuid.New(), models.AccountTypeChecking)
 	s.NoError(err)
 	s.False(exists)
 }
@@ -496,7 +558,7 @@ func (s *AccountRepositorySuite) TestGetAll() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account1)
+	err = s.repo.Create(context.Background(), account1)
 	s.NoError(err)
 
 	account2 := &models.Account{
@@ -507,17 +569,17 @@ func (s *AccountRepositorySuite) TestGetAll() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account2)
+	err = s.repo.Create(context.Background(), account2)
 	s.NoError(err)
 
 	// Get all accounts
-	accounts, total, err := s.repo.GetAll(0, 10)
+	accounts, total, err := s.repo.GetAll(context.Background(), 0, 10)
 	s.NoError(err)
 	s.Len(accounts, 2)
 	s.Equal(int64(2), total)
 
 	// Test pagination
-	accounts, total, err = s.repo.GetAll(0, 1)
+	accounts, total, err = s.repo.GetAll(context.Background(), 0, 1)
 	s.NoError(err)
 	s.Len(accounts, 1)
 	s.Equal(int64(2), total)
@@ -545,7 +607,7 @@ func (s *AccountRepositorySuite) TestGetAllWithFilters() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account1)
+	err = s.repo.Create(context.Background(), account1)
 	s.NoError(err)
 
 	account2 := &models.Account{
@@ -556,7 +618,7 @@ func (s *AccountRepositorySuite) TestGetAllWithFilters() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account2)
+	err = s.repo.Create(context.Background(), account2)
 	s.NoError(err)
 
 	account3 := &models.Account{
@@ -567,7 +629,7 @@ func (s *AccountRepositorySuite) TestGetAllWithFilters() {
 		Status:        models.AccountStatusInactive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account3)
+	err = s.repo.Create(context.Background(), account3)
 	s.NoError(err)
 
 	// Test filter by user ID
@@ -575,7 +637,7 @@ func (s *AccountRepositorySuite) TestGetAllWithFilters() {
 	filters := models.AccountFilters{
 		UserID: &userID,
 	}
-	accounts, total, err := s.repo.GetAllWithFilters(filters, 0, 10)
+	accounts, total, err := s.repo.GetAllWithFilters(context.Background(), filters, 0, 10)
 	s.NoError(err)
 	s.Len(accounts, 2)
 	s.Equal(int64(2), total)
@@ -584,7 +646,7 @@ func (s *AccountRepositorySuite) TestGetAllWithFilters() {
 	filters = models.AccountFilters{
 		Status: models.AccountStatusActive,
 	}
-	accounts, total, err = s.repo.GetAllWithFilters(filters, 0, 10)
+	accounts, total, err = s.repo.GetAllWithFilters(context.Background(), filters, 0, 10)
 	s.NoError(err)
 	s.Len(accounts, 2)
 	s.Equal(int64(2), total)
@@ -593,7 +655,7 @@ func (s *AccountRepositorySuite) TestGetAllWithFilters() {
 	filters = models.AccountFilters{
 		AccountType: models.AccountTypeSavings,
 	}
-	accounts, total, err = s.repo.GetAllWithFilters(filters, 0, 10)
+	accounts, total, err = s.repo.GetAllWithFilters(context.Background(), filters, 0, 10)
 	s.NoError(err)
 	s.Len(accounts, 2)
 	s.Equal(int64(2), total)
@@ -604,7 +666,7 @@ func (s *AccountRepositorySuite) TestGetAllWithFilters() {
 		AccountType: models.AccountTypeSavings,
 		Status:      models.AccountStatusActive,
 	}
-	accounts, total, err = s.repo.GetAllWithFilters(filters, 0, 10)
+	accounts, total, err = s.repo.GetAllWithFilters(context.Background(), filters, 0, 10)
 	s.NoError(err)
 	s.Len(accounts, 0)
 	s.Equal(int64(0), total)
@@ -621,7 +683,7 @@ func (s *AccountRepositorySuite) TestGetByUserIDAndType() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err := s.repo.Create(account1)
+	err := s.repo.Create(context.Background(), account1)
 	s.NoError(err)
 
 	account2 := &models.Account{
@@ -632,23 +694,23 @@ func (s *AccountRepositorySuite) TestGetByUserIDAndType() {
 		Status:        models.AccountStatusActive,
 		Currency:      "USD",
 	}
-	err = s.repo.Create(account2)
+	err = s.repo.Create(context.Background(), account2)
 	s.NoError(err)
 
 	// Get checking accounts
-	accounts, err := s.repo.GetByUserIDAndType(s.testUser.ID, models.AccountTypeChecking)
+	accounts, err := s.repo.GetByUserIDAndType(context.Background(), s.testUser.ID, models.AccountTypeChecking)
 	s.NoError(err)
 	s.Len(accounts, 1)
 	s.Equal(models.AccountTypeChecking, accounts[0].AccountType)
 
 	// Get savings accounts
-	accounts, err = s.repo.GetByUserIDAndType(s.testUser.ID, models.AccountTypeSavings)
+	accounts, err = s.repo.GetByUserIDAndType(context.Background(), s.testUser.ID, models.AccountTypeSavings)
 	s.NoError(err)
 	s.Len(accounts, 1)
 	s.Equal(models.AccountTypeSavings, accounts[0].AccountType)
 
 	// Get non-existent account type
-	accounts, err = s.repo.GetByUserIDAndType(s.testUser.ID, models.AccountTypeMoneyMarket)
+	accounts, err = s.repo.GetByUserIDAndType(context.Background(), s.testUser.ID, models.AccountTypeMoneyMarket)
 	s.NoError(err)
 	s.Len(accounts, 0)
 }
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -23,12 +24,12 @@ func NewAuditLogRepository(db *gorm.DB) AuditLogRepositoryInterface {
 }
 
 // Create creates a new audit log entry
-func (r *AuditLogRepository) Create(log *models.AuditLog) error {
+func (r *AuditLogRepository) Create(ctx context.Context, log *models.AuditLog) error {
 	if log == nil {
 		return errors.New("audit log cannot be nil")
 	}
 
-	if err := r.db.Create(log).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(log).Error; err != nil {
 		return fmt.Errorf("failed to create audit log: %w", err)
 	}
 
@@ -36,9 +37,9 @@ func (r *AuditLogRepository) Create(log *models.AuditLog) error {
 }
 
 // GetByID retrieves an audit log by its ID
-func (r *AuditLogRepository) GetByID(id uuid.UUID) (*models.AuditLog, error) {
+func (r *AuditLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.AuditLog, error) {
 	log := &models.AuditLog{ID: id}
-	if err := r.db.First(log).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(log).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("audit log not found")
 		}
@@ -49,11 +50,11 @@ func (r *AuditLogRepository) GetByID(id uuid.UUID) (*models.AuditLog, error) {
 }
 
 // GetByUserID retrieves audit logs for a specific user
-func (r *AuditLogRepository) GetByUserID(userID uuid.UUID, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (r *AuditLogRepository) GetByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.AuditLog, int64, error) {
 	var logs []*models.AuditLog
 	var total int64
 
-	query := r.db.Model(&models.AuditLog{}).Where("user_id = ?", userID)
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{}).Where("user_id = ?", userID)
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
@@ -70,11 +71,11 @@ func (r *AuditLogRepository) GetByUserID(userID uuid.UUID, offset, limit int) ([
 }
 
 // GetByAction retrieves audit logs for a specific action
-func (r *AuditLogRepository) GetByAction(action string, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (r *AuditLogRepository) GetByAction(ctx context.Context, action string, offset, limit int) ([]*models.AuditLog, int64, error) {
 	var logs []*models.AuditLog
 	var total int64
 
-	query := r.db.Model(&models.AuditLog{}).Where("action = ?", action)
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{}).Where("action = ?", action)
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
@@ -91,11 +92,11 @@ func (r *AuditLogRepository) GetByAction(action string, offset, limit int) ([]*m
 }
 
 // GetByResource retrieves audit logs for a specific resource
-func (r *AuditLogRepository) GetByResource(resource, resourceID string, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (r *AuditLogRepository) GetByResource(ctx context.Context, resource, resourceID string, offset, limit int) ([]*models.AuditLog, int64, error) {
 	var logs []*models.AuditLog
 	var total int64
 
-	query := r.db.Model(&models.AuditLog{}).Where("resource = ? AND resource_id = ?", resource, resourceID)
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{}).Where("resource = ? AND resource_id = ?", resource, resourceID)
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
@@ -112,11 +113,11 @@ func (r *AuditLogRepository) GetByResource(resource, resourceID string, offset,
 }
 
 // GetByIPAddress retrieves audit logs from a specific IP address
-func (r *AuditLogRepository) GetByIPAddress(ipAddress string, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (r *AuditLogRepository) GetByIPAddress(ctx context.Context, ipAddress string, offset, limit int) ([]*models.AuditLog, int64, error) {
 	var logs []*models.AuditLog
 	var total int64
 
-	query := r.db.Model(&models.AuditLog{}).Where("ip_address = ?", ipAddress)
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{}).Where("ip_address = ?", ipAddress)
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
@@ -133,11 +134,11 @@ func (r *AuditLogRepository) GetByIPAddress(ipAddress string, offset, limit int)
 }
 
 // GetByTimeRange retrieves audit logs within a specific time range
-func (r *AuditLogRepository) GetByTimeRange(startTime, endTime time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (r *AuditLogRepository) GetByTimeRange(ctx context.Context, startTime, endTime time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
 	var logs []*models.AuditLog
 	var total int64
 
-	query := r.db.Model(&models.AuditLog{}).Where("created_at BETWEEN ? AND ?", startTime, endTime)
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{}).Where("created_at BETWEEN ? AND ?", startTime, endTime)
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
@@ -154,7 +155,7 @@ func (r *AuditLogRepository) GetByTimeRange(startTime, endTime time.Time, offset
 }
 
 // GetCustomerActivity retrieves activity logs for a specific customer with optional date filtering and pagination
-func (r *AuditLogRepository) GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (r *AuditLogRepository) GetCustomerActivity(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
 	if userID == uuid.Nil {
 		return nil, 0, errors.New("invalid user ID")
 	}
@@ -169,7 +170,7 @@ func (r *AuditLogRepository) GetCustomerActivity(userID uuid.UUID, startDate, en
 	var logs []*models.AuditLog
 	var total int64
 
-	query := r.db.Model(&models.AuditLog{}).Where("user_id = ?", userID)
+	query := r.db.WithContext(ctx).Model(&models.AuditLog{}).Where("user_id = ?", userID)
 
 	if startDate != nil {
 		query = query.Where("created_at >= ?", startDate)
@@ -193,10 +194,10 @@ func (r *AuditLogRepository) GetCustomerActivity(userID uuid.UUID, startDate, en
 }
 
 // GetFailedLoginAttempts retrieves failed login attempts for a specific email in a time window
-func (r *AuditLogRepository) GetFailedLoginAttempts(email string, since time.Time) (int64, error) {
+func (r *AuditLogRepository) GetFailedLoginAttempts(ctx context.Context, email string, since time.Time) (int64, error) {
 	var count int64
 
-	err := r.db.Model(&models.AuditLog{}).
+	err := r.db.WithContext(ctx).Model(&models.AuditLog{}).
 		Where("action = ? AND metadata->>'email' = ? AND created_at > ?",
 			models.AuditActionFailedLogin, email, since).
 		Count(&count).Error
@@ -209,10 +210,10 @@ func (r *AuditLogRepository) GetFailedLoginAttempts(email string, since time.Tim
 }
 
 // DeleteOlderThan removes audit logs older than the specified duration
-func (r *AuditLogRepository) DeleteOlderThan(duration time.Duration) (int64, error) {
+func (r *AuditLogRepository) DeleteOlderThan(ctx context.Context, duration time.Duration) (int64, error) {
 	cutoffTime := time.Now().Add(-duration)
 
-	result := r.db.Where("created_at < ?", cutoffTime).Delete(&models.AuditLog{})
+	result := r.db.WithContext(ctx).Where("created_at < ?", cutoffTime).Delete(&models.AuditLog{})
 
 	if result.Error != nil {
 		return 0, fmt.Errorf("failed to delete old audit logs: %w", result.Error)
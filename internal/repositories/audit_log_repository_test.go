@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -41,7 +42,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_Create() {
 		UserAgent:  "Mozilla/5.0",
 	}
 
-	err := s.repo.Create(log)
+	err := s.repo.Create(context.Background(), log)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, log.ID)
 	s.NotZero(log.CreatedAt)
@@ -57,7 +58,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_CreateWithoutUserID() {
 		UserAgent:  "Mozilla/5.0",
 	}
 
-	err := s.repo.Create(log)
+	err := s.repo.Create(context.Background(), log)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, log.ID)
 	s.Nil(log.UserID)
@@ -77,7 +78,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByUserID() {
 			IPAddress:  "192.168.1.1",
 			UserAgent:  "Mozilla/5.0",
 		}
-		err := s.repo.Create(log)
+		err := s.repo.Create(context.Background(), log)
 		s.NoError(err)
 	}
 
@@ -91,11 +92,11 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByUserID() {
 		IPAddress:  "192.168.1.2",
 		UserAgent:  "Chrome",
 	}
-	err := s.repo.Create(otherLog)
+	err := s.repo.Create(context.Background(), otherLog)
 	s.NoError(err)
 
 	// Get logs for first user
-	logs, total, err := s.repo.GetByUserID(userID, 0, 10)
+	logs, total, err := s.repo.GetByUserID(context.Background(), userID, 0, 10)
 	s.NoError(err)
 	s.Len(logs, 3)
 	s.Equal(int64(3), total)
@@ -120,25 +121,25 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByUserID_Pagination(
 			IPAddress:  "192.168.1.1",
 			UserAgent:  "Mozilla/5.0",
 		}
-		err := s.repo.Create(log)
+		err := s.repo.Create(context.Background(), log)
 		s.NoError(err)
 		time.Sleep(10 * time.Millisecond) // Ensure different timestamps
 	}
 
 	// Get first page
-	logs, total, err := s.repo.GetByUserID(userID, 0, 2)
+	logs, total, err := s.repo.GetByUserID(context.Background(), userID, 0, 2)
 	s.NoError(err)
 	s.Len(logs, 2)
 	s.Equal(int64(5), total)
 
 	// Get second page
-	logs, total, err = s.repo.GetByUserID(userID, 2, 2)
+	logs, total, err = s.repo.GetByUserID(context.Background(), userID, 2, 2)
 	s.NoError(err)
 	s.Len(logs, 2)
 	s.Equal(int64(5), total)
 
 	// Get third page (partial)
-	logs, total, err = s.repo.GetByUserID(userID, 4, 2)
+	logs, total, err = s.repo.GetByUserID(context.Background(), userID, 4, 2)
 	s.NoError(err)
 	s.Len(logs, 1)
 	s.Equal(int64(5), total)
@@ -157,7 +158,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByAction() {
 		IPAddress:  "192.168.1.1",
 		UserAgent:  "Mozilla/5.0",
 	}
-	err := s.repo.Create(loginLog1)
+	err := s.repo.Create(context.Background(), loginLog1)
 	s.NoError(err)
 
 	loginLog2 := &models.AuditLog{
@@ -168,7 +169,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByAction() {
 		IPAddress:  "192.168.1.2",
 		UserAgent:  "Chrome",
 	}
-	err = s.repo.Create(loginLog2)
+	err = s.repo.Create(context.Background(), loginLog2)
 	s.NoError(err)
 
 	updateLog := &models.AuditLog{
@@ -179,11 +180,11 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByAction() {
 		IPAddress:  "192.168.1.1",
 		UserAgent:  "Mozilla/5.0",
 	}
-	err = s.repo.Create(updateLog)
+	err = s.repo.Create(context.Background(), updateLog)
 	s.NoError(err)
 
 	// Get login actions
-	logs, total, err := s.repo.GetByAction(models.AuditActionLogin, 0, 10)
+	logs, total, err := s.repo.GetByAction(context.Background(), models.AuditActionLogin, 0, 10)
 	s.NoError(err)
 	s.Len(logs, 2)
 	s.Equal(int64(2), total)
@@ -193,7 +194,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByAction() {
 	}
 
 	// Get update actions
-	logs, total, err = s.repo.GetByAction(models.AuditActionUpdate, 0, 10)
+	logs, total, err = s.repo.GetByAction(context.Background(), models.AuditActionUpdate, 0, 10)
 	s.NoError(err)
 	s.Len(logs, 1)
 	s.Equal(int64(1), total)
@@ -215,7 +216,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByResource() {
 			IPAddress:  "192.168.1.1",
 			UserAgent:  "Mozilla/5.0",
 		}
-		err := s.repo.Create(log)
+		err := s.repo.Create(context.Background(), log)
 		s.NoError(err)
 	}
 
@@ -228,11 +229,11 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByResource() {
 		IPAddress:  "192.168.1.1",
 		UserAgent:  "Mozilla/5.0",
 	}
-	err := s.repo.Create(otherLog)
+	err := s.repo.Create(context.Background(), otherLog)
 	s.NoError(err)
 
 	// Get logs for specific resource
-	logs, total, err := s.repo.GetByResource("account", resourceID, 0, 10)
+	logs, total, err := s.repo.GetByResource(context.Background(), "account", resourceID, 0, 10)
 	s.NoError(err)
 	s.Len(logs, 3)
 	s.Equal(int64(3), total)
@@ -259,7 +260,7 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByTimeRange() {
 		IPAddress:  "192.168.1.1",
 		UserAgent:  "Mozilla/5.0",
 	}
-	err := s.repo.Create(log1)
+	err := s.repo.Create(context.Background(), log1)
 	s.NoError(err)
 
 	// Create today's log
@@ -271,17 +272,17 @@ func (s *AuditLogRepositorySuite) TestAuditLogRepository_GetByTimeRange() {
 		IPAddress:  "192.168.1.1",
 		UserAgent:  "Mozilla/5.0",
 	}
-	err = s.repo.Create(log2)
+	err = s.repo.Create(context.Background(), log2)
 	s.NoError(err)
 
 	// Get logs from yesterday to tomorrow
-	logs, total, err := s.repo.GetByTimeRange(yesterday, tomorrow, 0, 10)
+	logs, total, err := s.repo.GetByTimeRange(context.Background(), yesterday, tomorrow, 0, 10)
 	s.NoError(err)
 	s.GreaterOrEqual(len(logs), 2)
 	s.GreaterOrEqual(total, int64(2))
 
 	// Get logs from tomorrow (should be empty)
-	logs, total, err = s.repo.GetByTimeRange(tomorrow, tomorrow.Add(24*time.Hour), 0, 10)
+	logs, total, err = s.repo.GetByTimeRange(context.Background(), tomorrow, tomorrow.Add(24*time.Hour), 0, 10)
 	s.NoError(err)
 	s.Len(logs, 0)
 	s.Equal(int64(0), total)
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -22,15 +23,15 @@ func NewBlacklistedTokenRepository(db *gorm.DB) BlacklistedTokenRepositoryInterf
 }
 
 // Create adds a token to the blacklist
-func (r *blacklistedTokenRepository) Create(token *models.BlacklistedToken) error {
+func (r *blacklistedTokenRepository) Create(ctx context.Context, token *models.BlacklistedToken) error {
 	token.BlacklistedAt = time.Now()
-	return r.db.Create(token).Error
+	return r.db.WithContext(ctx).Create(token).Error
 }
 
 // GetByJTI retrieves a blacklisted token by its JTI
-func (r *blacklistedTokenRepository) GetByJTI(jti string) (*models.BlacklistedToken, error) {
+func (r *blacklistedTokenRepository) GetByJTI(ctx context.Context, jti string) (*models.BlacklistedToken, error) {
 	var token models.BlacklistedToken
-	err := r.db.Where("jti = ?", jti).First(&token).Error
+	err := r.db.WithContext(ctx).Where("jti = ?", jti).First(&token).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, ErrTokenNotFound
@@ -41,7 +42,7 @@ func (r *blacklistedTokenRepository) GetByJTI(jti string) (*models.BlacklistedTo
 }
 
 // DeleteExpired removes expired tokens from the blacklist
-func (r *blacklistedTokenRepository) DeleteExpired() (int64, error) {
-	result := r.db.Where("expires_at < ?", time.Now()).Delete(&models.BlacklistedToken{})
+func (r *blacklistedTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&models.BlacklistedToken{})
 	return result.RowsAffected, result.Error
 }
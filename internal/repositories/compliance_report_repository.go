@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrComplianceReportNotFound = errors.New("compliance report not found")
+
+type complianceReportRepository struct {
+	db *gorm.DB
+}
+
+func NewComplianceReportRepository(db *gorm.DB) ComplianceReportRepositoryInterface {
+	return &complianceReportRepository{db: db}
+}
+
+// Create inserts the report unless the customer already has one of the same type for the
+// business day, so regenerating a day never replaces a report under review.
+func (r *complianceReportRepository) Create(ctx context.Context, report *models.ComplianceReport) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create compliance report: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *complianceReportRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ComplianceReport, error) {
+	var report models.ComplianceReport
+	if err := r.db.WithContext(ctx).Preload("User").First(&report, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrComplianceReportNotFound
+		}
+		return nil, fmt.Errorf("failed to find compliance report: %w", err)
+	}
+	return &report, nil
+}
+
+// List returns reports matching the filters, newest business day first.
+func (r *complianceReportRepository) List(ctx context.Context, filters models.ComplianceReportFilters, offset, limit int) ([]models.ComplianceReport, int64, error) {
+	var reports []models.ComplianceReport
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.ComplianceReport{})
+	if filters.ReportType != "" {
+		query = query.Where("report_type = ?", filters.ReportType)
+	}
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+	if filters.BusinessDate != nil {
+		query = query.Where("business_date = ?", *filters.BusinessDate)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count compliance reports: %w", err)
+	}
+
+	if err := query.Order("business_date DESC, created_at ASC").Offset(offset).Limit(limit).Find(&reports).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list compliance reports: %w", err)
+	}
+
+	return reports, total, nil
+}
+
+func (r *complianceReportRepository) Update(ctx context.Context, report *models.ComplianceReport) error {
+	if err := r.db.WithContext(ctx).Omit("User").Save(report).Error; err != nil {
+		return fmt.Errorf("failed to update compliance report: %w", err)
+	}
+	return nil
+}
+
+// FindDueSubmissions returns approved reports whose next submission attempt is due, with their customer.
+func (r *complianceReportRepository) FindDueSubmissions(ctx context.Context, limit int) ([]models.ComplianceReport, error) {
+	var reports []models.ComplianceReport
+	err := r.db.WithContext(ctx).Preload("User").
+		Where("status = ? AND next_submission_at <= ?", models.ComplianceReportStatusApproved, time.Now()).
+		Order("next_submission_at ASC").
+		Limit(limit).
+		Find(&reports).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance reports due for submission: %w", err)
+	}
+	return reports, nil
+}
+
+// ListActivity returns completed transactions posted in [from, to), oldest first, with the
+// transfer or inbound credit each belongs to. Reversals of failed external transfers and the
+// debits they undo are left out, since no money moved.
+func (r *complianceReportRepository) ListActivity(ctx context.Context, from, to time.Time) ([]models.ComplianceActivity, error) {
+	var activity []models.ComplianceActivity
+	err := r.db.WithContext(ctx).Table("transactions AS t").
+		Select(`t.id AS transaction_id, t.account_id, a.user_id, t.transaction_type, t.amount, t.created_at,
+			tr.id AS transfer_id, ic.id AS inbound_credit_id,
+			CASE WHEN tr.from_account_id = t.account_id THEN ta.user_id ELSE fa.user_id END AS counterparty_user_id`).
+		Joins("JOIN accounts AS a ON a.id = t.account_id").
+		Joins("LEFT JOIN transfers AS tr ON tr.debit_transaction_id = t.id OR tr.credit_transaction_id = t.id").
+		Joins("LEFT JOIN accounts AS fa ON fa.id = tr.from_account_id").
+		Joins("LEFT JOIN accounts AS ta ON ta.id = tr.to_account_id").
+		Joins("LEFT JOIN inbound_credits AS ic ON ic.transaction_id = t.id").
+		Where("t.status = ? AND t.created_at >= ? AND t.created_at < ?", models.TransactionStatusCompleted, from.UTC(), to.UTC()).
+		Where("tr.id IS NULL OR tr.status <> ?", models.TransferStatusFailed).
+		Where("NOT EXISTS (SELECT 1 FROM transfers AS rv WHERE rv.reversal_transaction_id = t.id)").
+		Order("t.created_at ASC").
+		Scan(&activity).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list compliance activity: %w", err)
+	}
+	return activity, nil
+}
@@ -0,0 +1,242 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComplianceReportRepositoryTestSuite struct {
+	suite.Suite
+	db   *database.DB
+	repo ComplianceReportRepositoryInterface
+	user *models.User
+	day  time.Time
+}
+
+func (s *ComplianceReportRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewComplianceReportRepository(s.db.DB)
+	s.user = database.CreateTestUser(s.T(), s.db, "ctr@example.com")
+	s.day = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *ComplianceReportRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestComplianceReportRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ComplianceReportRepositoryTestSuite))
+}
+
+func (s *ComplianceReportRepositoryTestSuite) createAccount(user *models.User, number string) *models.Account {
+	account := &models.Account{
+		AccountNumber: number,
+		UserID:        user.ID,
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromInt(50000),
+	}
+	s.Require().NoError(s.db.Create(account).Error)
+	return account
+}
+
+func (s *ComplianceReportRepositoryTestSuite) createTransaction(account *models.Account, transactionType string, amount int64, at time.Time) *models.Transaction {
+	balanceAfter := decimal.NewFromInt(amount)
+	if transactionType == models.TransactionTypeDebit {
+		balanceAfter = balanceAfter.Neg()
+	}
+	transaction := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: transactionType,
+		Amount:          decimal.NewFromInt(amount),
+		BalanceBefore:   decimal.Zero,
+		BalanceAfter:    balanceAfter,
+		Description:     "test",
+		CreatedAt:       at,
+		UpdatedAt:       at,
+	}
+	s.Require().NoError(s.db.Create(transaction).Error)
+	return transaction
+}
+
+func (s *ComplianceReportRepositoryTestSuite) newReport(reportType string) *models.ComplianceReport {
+	return &models.ComplianceReport{
+		ReportType:   reportType,
+		UserID:       s.user.ID,
+		BusinessDate: s.day,
+		WindowStart:  s.day,
+		CashInAmount: decimal.NewFromInt(12000),
+		Details:      models.JSONBMap{"threshold": "10000.00"},
+	}
+}
+
+func (s *ComplianceReportRepositoryTestSuite) TestCreate_SkipsExistingReportForDay() {
+	report := s.newReport(models.ComplianceReportTypeCTR)
+	created, err := s.repo.Create(context.Background(), report)
+	s.Require().NoError(err)
+	s.True(created)
+	s.Equal(models.ComplianceReportStatusPendingReview, report.Status)
+
+	created, err = s.repo.Create(context.Background(), s.newReport(models.ComplianceReportTypeCTR))
+	s.NoError(err)
+	s.False(created)
+
+	created, err = s.repo.Create(context.Background(), s.newReport(models.ComplianceReportTypeStructuring))
+	s.NoError(err)
+	s.True(created)
+
+	_, total, err := s.repo.List(context.Background(), models.ComplianceReportFilters{}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(2), total)
+}
+
+func (s *ComplianceReportRepositoryTestSuite) TestGetByID() {
+	report := s.newReport(models.ComplianceReportTypeCTR)
+	_, err := s.repo.Create(context.Background(), report)
+	s.Require().NoError(err)
+
+	found, err := s.repo.GetByID(context.Background(), report.ID)
+	s.Require().NoError(err)
+	s.Equal(s.user.Email, found.User.Email)
+	s.True(found.CashInAmount.Equal(decimal.NewFromInt(12000)))
+	s.Equal("10000.00", found.Details["threshold"])
+
+	_, err = s.repo.GetByID(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrComplianceReportNotFound)
+}
+
+func (s *ComplianceReportRepositoryTestSuite) TestList_Filters() {
+	ctr := s.newReport(models.ComplianceReportTypeCTR)
+	_, err := s.repo.Create(context.Background(), ctr)
+	s.Require().NoError(err)
+	structuring := s.newReport(models.ComplianceReportTypeStructuring)
+	structuring.Status = models.ComplianceReportStatusRejected
+	_, err = s.repo.Create(context.Background(), structuring)
+	s.Require().NoError(err)
+
+	reports, total, err := s.repo.List(context.Background(), models.ComplianceReportFilters{ReportType: models.ComplianceReportTypeCTR}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(ctr.ID, reports[0].ID)
+
+	reports, _, err = s.repo.List(context.Background(), models.ComplianceReportFilters{Status: models.ComplianceReportStatusRejected, UserID: &s.user.ID}, 0, 10)
+	s.NoError(err)
+	s.Require().Len(reports, 1)
+	s.Equal(structuring.ID, reports[0].ID)
+
+	day := s.day
+	_, total, err = s.repo.List(context.Background(), models.ComplianceReportFilters{BusinessDate: &day}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(2), total)
+
+	otherDay := s.day.AddDate(0, 0, 1)
+	_, total, err = s.repo.List(context.Background(), models.ComplianceReportFilters{BusinessDate: &otherDay}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(0), total)
+}
+
+func (s *ComplianceReportRepositoryTestSuite) TestFindDueSubmissions() {
+	due := s.newReport(models.ComplianceReportTypeCTR)
+	_, err := s.repo.Create(context.Background(), due)
+	s.Require().NoError(err)
+	due.Approve(uuid.New(), "verified")
+	s.Require().NoError(s.repo.Update(context.Background(), due))
+
+	later := s.newReport(models.ComplianceReportTypeStructuring)
+	_, err = s.repo.Create(context.Background(), later)
+	s.Require().NoError(err)
+	later.Approve(uuid.New(), "verified")
+	next := time.Now().Add(time.Hour)
+	later.NextSubmissionAt = &next
+	s.Require().NoError(s.repo.Update(context.Background(), later))
+
+	reports, err := s.repo.FindDueSubmissions(context.Background(), 10)
+	s.NoError(err)
+	s.Require().Len(reports, 1)
+	s.Equal(due.ID, reports[0].ID)
+	s.Equal(s.user.Email, reports[0].User.Email)
+}
+
+func (s *ComplianceReportRepositoryTestSuite) TestListActivity_ClassifiesTransactions() {
+	other := database.CreateTestUser(s.T(), s.db, "other@example.com")
+	checking := s.createAccount(s.user, "1000000001")
+	savings := s.createAccount(s.user, "1000000003")
+	otherAccount := s.createAccount(other, "1000000002")
+	at := s.day.Add(15 * time.Hour)
+
+	deposit := s.createTransaction(checking, models.TransactionTypeCredit, 9000, at)
+
+	// Internal transfer between the customer's own accounts
+	ownDebit := s.createTransaction(checking, models.TransactionTypeDebit, 500, at)
+	ownCredit := s.createTransaction(savings, models.TransactionTypeCredit, 500, at)
+	s.Require().NoError(s.db.Create(&models.Transfer{
+		FromAccountID: checking.ID, ToAccountID: &savings.ID, Amount: decimal.NewFromInt(500),
+		Description: "test", IdempotencyKey: uuid.NewString(), Status: models.TransferStatusCompleted,
+		DebitTransactionID: &ownDebit.ID, CreditTransactionID: &ownCredit.ID,
+	}).Error)
+
+	// Transfer to another customer
+	sentDebit := s.createTransaction(checking, models.TransactionTypeDebit, 700, at)
+	sentCredit := s.createTransaction(otherAccount, models.TransactionTypeCredit, 700, at)
+	s.Require().NoError(s.db.Create(&models.Transfer{
+		FromAccountID: checking.ID, ToAccountID: &otherAccount.ID, Amount: decimal.NewFromInt(700),
+		Description: "test", IdempotencyKey: uuid.NewString(), Status: models.TransferStatusCompleted,
+		DebitTransactionID: &sentDebit.ID, CreditTransactionID: &sentCredit.ID,
+	}).Error)
+
+	// Failed external transfer and its reversal are left out
+	failedDebit := s.createTransaction(checking, models.TransactionTypeDebit, 4000, at)
+	reversal := s.createTransaction(checking, models.TransactionTypeCredit, 4000, at)
+	externalID := uuid.New()
+	s.Require().NoError(s.db.Create(&models.Transfer{
+		FromAccountID: checking.ID, ToExternalAccountID: &externalID, Amount: decimal.NewFromInt(4000),
+		Description: "test", IdempotencyKey: uuid.NewString(), Status: models.TransferStatusFailed,
+		DebitTransactionID: &failedDebit.ID, ReversalTransactionID: &reversal.ID,
+	}).Error)
+
+	// Inbound partner credit
+	creditTransaction := s.createTransaction(savings, models.TransactionTypeCredit, 3000, at)
+	s.Require().NoError(s.db.Create(&models.InboundCredit{
+		EventID: "evt_ctr", AccountNumber: savings.AccountNumber, Amount: decimal.NewFromInt(3000),
+		Currency: "USD", Status: models.InboundCreditStatusPosted, AccountID: &savings.ID, TransactionID: &creditTransaction.ID,
+	}).Error)
+
+	// Outside the range and not completed
+	s.createTransaction(checking, models.TransactionTypeCredit, 9500, s.day.Add(-time.Hour))
+	pending := s.createTransaction(checking, models.TransactionTypeCredit, 9500, at)
+	s.Require().NoError(s.db.Model(pending).Update("status", models.TransactionStatusPending).Error)
+
+	activity, err := s.repo.ListActivity(context.Background(), s.day, s.day.AddDate(0, 0, 1))
+	s.Require().NoError(err)
+
+	byID := make(map[uuid.UUID]*models.ComplianceActivity)
+	for i := range activity {
+		byID[activity[i].TransactionID] = &activity[i]
+	}
+	s.Len(byID, 6)
+
+	s.Equal(models.ComplianceActivityCash, byID[deposit.ID].Category())
+	s.Equal(s.user.ID, byID[deposit.ID].UserID)
+
+	s.True(byID[ownDebit.ID].IsOwnAccountMove())
+	s.True(byID[ownCredit.ID].IsOwnAccountMove())
+
+	s.Equal(models.ComplianceActivityTransfer, byID[sentDebit.ID].Category())
+	s.False(byID[sentDebit.ID].IsOwnAccountMove())
+	s.Equal(other.ID, *byID[sentDebit.ID].CounterpartyUserID)
+	s.Equal(other.ID, byID[sentCredit.ID].UserID)
+	s.Equal(s.user.ID, *byID[sentCredit.ID].CounterpartyUserID)
+
+	s.NotContains(byID, failedDebit.ID)
+	s.NotContains(byID, reversal.ID)
+	s.NotContains(byID, pending.ID)
+
+	s.Equal(models.ComplianceActivityTransfer, byID[creditTransaction.ID].Category())
+	s.NotNil(byID[creditTransaction.ID].InboundCreditID)
+}
@@ -1,34 +1,52 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/array/banking-api/internal/models"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type externalAccountRepository struct {
 	db *gorm.DB
 }
 
-var ErrExternalAccountNotFound = errors.New("external account not found")
+var (
+	ErrExternalAccountNotFound = errors.New("external account not found")
+	// ErrVerificationAttemptRefused is returned when the payee is no longer awaiting confirmation
+	// or has no attempts left, for example because concurrent attempts used them up.
+	ErrVerificationAttemptRefused = errors.New("verification attempt refused")
+	// ErrVerificationStatusChanged is returned when the payee's verification status changed after
+	// it was read.
+	ErrVerificationStatusChanged = errors.New("verification status changed")
+	// ErrUnverifiedPayeeLimitExceeded is returned when a transfer would take the total sent to an
+	// unverified payee over models.UnverifiedExternalTransferLimit.
+	ErrUnverifiedPayeeLimitExceeded = errors.New("unverified payee transfer limit exceeded")
+	// ErrExternalAccountHasTransfersInFlight is returned when a payee cannot be deleted because
+	// transfers to it are still pending or processing with the partner.
+	ErrExternalAccountHasTransfersInFlight = errors.New("external account has transfers in flight")
+)
 
 func NewExternalAccountRepository(db *gorm.DB) ExternalAccountRepositoryInterface {
 	return &externalAccountRepository{db: db}
 }
 
-func (r *externalAccountRepository) Create(account *models.ExternalAccount) error {
-	if err := r.db.Create(account).Error; err != nil {
+func (r *externalAccountRepository) Create(ctx context.Context, account *models.ExternalAccount) error {
+	if err := r.db.WithContext(ctx).Create(account).Error; err != nil {
 		return fmt.Errorf("failed to create external account: %w", err)
 	}
 	return nil
 }
 
-func (r *externalAccountRepository) GetByID(id uuid.UUID) (*models.ExternalAccount, error) {
+func (r *externalAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ExternalAccount, error) {
 	var account models.ExternalAccount
-	if err := r.db.First(&account, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&account, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrExternalAccountNotFound
 		}
@@ -37,10 +55,167 @@ func (r *externalAccountRepository) GetByID(id uuid.UUID) (*models.ExternalAccou
 	return &account, nil
 }
 
-func (r *externalAccountRepository) ListByUserID(userID uuid.UUID) ([]models.ExternalAccount, error) {
+func (r *externalAccountRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]models.ExternalAccount, error) {
 	var accounts []models.ExternalAccount
-	if err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&accounts).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Find(&accounts).Error; err != nil {
 		return nil, fmt.Errorf("failed to list external accounts for user: %w", err)
 	}
 	return accounts, nil
 }
+
+func (r *externalAccountRepository) Update(ctx context.Context, account *models.ExternalAccount) error {
+	if err := r.db.WithContext(ctx).Save(account).Error; err != nil {
+		return fmt.Errorf("failed to update external account: %w", err)
+	}
+	return nil
+}
+
+// UpdateNickname changes only the payee's nickname, so a rename cannot overwrite verification
+// progress written concurrently.
+func (r *externalAccountRepository) UpdateNickname(ctx context.Context, id uuid.UUID, nickname string) error {
+	result := r.db.WithContext(ctx).Model(&models.ExternalAccount{}).Where("id = ?", id).Update("nickname", nickname)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update external account nickname: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrExternalAccountNotFound
+	}
+	return nil
+}
+
+// UpdateMicroDeposits saves the payee's micro-deposit amounts and progress, its verification
+// status and attempt count.
+func (r *externalAccountRepository) UpdateMicroDeposits(ctx context.Context, account *models.ExternalAccount) error {
+	result := r.db.WithContext(ctx).Model(&models.ExternalAccount{}).Where("id = ?", account.ID).Updates(map[string]interface{}{
+		"micro_deposit_amount1":  account.MicroDepositAmount1,
+		"micro_deposit_amount2":  account.MicroDepositAmount2,
+		"micro_deposits_sent":    account.MicroDepositsSent,
+		"micro_deposits_sent_at": account.MicroDepositsSentAt,
+		"verification_status":    account.VerificationStatus,
+		"verification_attempts":  account.VerificationAttempts,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update external account micro-deposits: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrExternalAccountNotFound
+	}
+	return nil
+}
+
+// ClaimVerificationAttempt counts a confirmation attempt against a payee awaiting confirmation and
+// returns the attempt's number. The count is incremented only while attempts remain, so concurrent
+// requests cannot make more than maxAttempts guesses between them; ErrVerificationAttemptRefused
+// is returned once none are left.
+func (r *externalAccountRepository) ClaimVerificationAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (int, error) {
+	var attempt int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.ExternalAccount{}).
+			Where("id = ? AND verification_status = ? AND verification_attempts < ?", id, models.ExternalAccountStatusMicroDepositsSent, maxAttempts).
+			Update("verification_attempts", gorm.Expr("verification_attempts + 1"))
+		if result.Error != nil {
+			return fmt.Errorf("failed to count verification attempt: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrVerificationAttemptRefused
+		}
+		// The row stays locked by the increment until commit, so this reads this attempt's number
+		var account models.ExternalAccount
+		if err := tx.Select("verification_attempts").First(&account, "id = ?", id).Error; err != nil {
+			return fmt.Errorf("failed to read verification attempts: %w", err)
+		}
+		attempt = account.VerificationAttempts
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return attempt, nil
+}
+
+// UpdateVerificationStatus moves the payee to its verification status and verified time, provided
+// its status is still from. ErrVerificationStatusChanged is returned otherwise.
+func (r *externalAccountRepository) UpdateVerificationStatus(ctx context.Context, account *models.ExternalAccount, from string) error {
+	result := r.db.WithContext(ctx).Model(&models.ExternalAccount{}).
+		Where("id = ? AND verification_status = ?", account.ID, from).
+		Updates(map[string]interface{}{
+			"verification_status": account.VerificationStatus,
+			"verified_at":         account.VerifiedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update verification status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrVerificationStatusChanged
+	}
+	return nil
+}
+
+// Delete soft-deletes the external account; historical transfers keep their reference. The payee
+// row is locked while transfers still pending or processing are counted, and transfers to it are
+// created under the same lock, so none can be created between the check and the delete.
+// ErrExternalAccountHasTransfersInFlight is returned when any remain.
+func (r *externalAccountRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var account models.ExternalAccount
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&account, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrExternalAccountNotFound
+			}
+			return fmt.Errorf("failed to lock external account: %w", err)
+		}
+
+		var inFlight int64
+		if err := tx.Model(&models.Transfer{}).
+			Where("to_external_account_id = ? AND status IN ?", id, []string{models.TransferStatusPending, models.TransferStatusProcessing}).
+			Count(&inFlight).Error; err != nil {
+			return fmt.Errorf("failed to count transfers in flight: %w", err)
+		}
+		if inFlight > 0 {
+			return fmt.Errorf("%w: %d still in flight", ErrExternalAccountHasTransfersInFlight, inFlight)
+		}
+
+		result := tx.Delete(&models.ExternalAccount{}, "id = ?", id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete external account: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrExternalAccountNotFound
+		}
+		return nil
+	})
+}
+
+// checkUnverifiedPayeeLimit refuses an external transfer that would take the total sent to an
+// unverified payee within models.UnverifiedExternalTransferWindow over
+// models.UnverifiedExternalTransferLimit. Failed transfers do not count. The payee row stays locked
+// until tx commits, so concurrent transfers to the same payee are checked one after another.
+func checkUnverifiedPayeeLimit(tx *gorm.DB, transfer *models.Transfer) error {
+	if transfer.ToExternalAccountID == nil {
+		return nil
+	}
+
+	var payee models.ExternalAccount
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&payee, "id = ?", *transfer.ToExternalAccountID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrExternalAccountNotFound
+		}
+		return fmt.Errorf("failed to lock payee: %w", err)
+	}
+	if payee.IsVerified() {
+		return nil
+	}
+
+	var sent decimal.Decimal
+	if err := tx.Model(&models.Transfer{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("to_external_account_id = ? AND status <> ? AND created_at >= ?",
+			payee.ID, models.TransferStatusFailed, time.Now().Add(-models.UnverifiedExternalTransferWindow)).
+		Scan(&sent).Error; err != nil {
+		return fmt.Errorf("failed to total transfers to payee: %w", err)
+	}
+	if sent.Add(transfer.Amount).GreaterThan(models.UnverifiedExternalTransferLimit) {
+		return ErrUnverifiedPayeeLimitExceeded
+	}
+	return nil
+}
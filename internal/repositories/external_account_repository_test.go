@@ -1,12 +1,15 @@
 package repositories
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/array/banking-api/internal/database"
 	"github.com/array/banking-api/internal/models"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -41,7 +44,7 @@ func (s *ExternalAccountRepositoryTestSuite) TestCreate_Success() {
 		BankName:          "Test Bank",
 	}
 
-	err := s.repo.Create(account)
+	err := s.repo.Create(context.Background(), account)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, account.ID)
 
@@ -61,9 +64,9 @@ func (s *ExternalAccountRepositoryTestSuite) TestGetByID_Success() {
 		NameOnAccount:     gofakeit.Name(),
 		BankName:          "Test Bank",
 	}
-	s.NoError(s.repo.Create(account))
+	s.NoError(s.repo.Create(context.Background(), account))
 
-	found, err := s.repo.GetByID(account.ID)
+	found, err := s.repo.GetByID(context.Background(), account.ID)
 	s.NoError(err)
 	s.NotNil(found)
 	s.Equal(account.ID, found.ID)
@@ -71,7 +74,7 @@ func (s *ExternalAccountRepositoryTestSuite) TestGetByID_Success() {
 }
 
 func (s *ExternalAccountRepositoryTestSuite) TestGetByID_NotFound() {
-	found, err := s.repo.GetByID(uuid.New())
+	found, err := s.repo.GetByID(context.Background(), uuid.New())
 	s.Error(err)
 	s.Nil(found)
 	s.ErrorIs(err, ErrExternalAccountNotFound)
@@ -82,22 +85,22 @@ func (s *ExternalAccountRepositoryTestSuite) TestListByUserID() {
 	acc1 := &models.ExternalAccount{
 		UserID: s.user.ID, ExternalAccountID: uuid.New(), Nickname: "User1 Acc1", AccountNumberMask: "1111", NameOnAccount: gofakeit.Name(), BankName: "Test Bank 1",
 	}
-	s.NoError(s.repo.Create(acc1))
+	s.NoError(s.repo.Create(context.Background(), acc1))
 
 	acc2 := &models.ExternalAccount{
 		UserID: s.user.ID, ExternalAccountID: uuid.New(), Nickname: "User1 Acc2", AccountNumberMask: "2222", NameOnAccount: gofakeit.Name(), BankName: "Test Bank 2",
 	}
-	s.NoError(s.repo.Create(acc2))
+	s.NoError(s.repo.Create(context.Background(), acc2))
 
 	// Create 1 account for another user
 	otherUser := database.CreateTestUser(s.T(), s.db, gofakeit.Email())
 	acc3 := &models.ExternalAccount{
 		UserID: otherUser.ID, ExternalAccountID: uuid.New(), Nickname: "User2 Acc1", AccountNumberMask: "3333", NameOnAccount: gofakeit.Name(), BankName: "Test Bank 3",
 	}
-	s.NoError(s.repo.Create(acc3))
+	s.NoError(s.repo.Create(context.Background(), acc3))
 
 	// List accounts for the main user
-	accounts, err := s.repo.ListByUserID(s.user.ID)
+	accounts, err := s.repo.ListByUserID(context.Background(), s.user.ID)
 	s.NoError(err)
 	s.Len(accounts, 2)
 
@@ -109,8 +112,144 @@ func (s *ExternalAccountRepositoryTestSuite) TestListByUserID() {
 }
 
 func (s *ExternalAccountRepositoryTestSuite) TestListByUserID_NoAccounts() {
-	accounts, err := s.repo.ListByUserID(s.user.ID)
+	accounts, err := s.repo.ListByUserID(context.Background(), s.user.ID)
 	s.NoError(err)
 	s.Empty(accounts)
 	s.Len(accounts, 0)
 }
+
+func (s *ExternalAccountRepositoryTestSuite) TestUpdate_Success() {
+	account := &models.ExternalAccount{
+		UserID: s.user.ID, ExternalAccountID: uuid.New(), Nickname: "Before", AccountNumberMask: "4444", NameOnAccount: gofakeit.Name(), BankName: "Test Bank",
+	}
+	s.NoError(s.repo.Create(context.Background(), account))
+	s.Equal(models.ExternalAccountStatusUnverified, account.VerificationStatus)
+
+	account.Nickname = "After"
+	account.VerificationStatus = models.ExternalAccountStatusVerified
+	s.NoError(s.repo.Update(context.Background(), account))
+
+	found, err := s.repo.GetByID(context.Background(), account.ID)
+	s.NoError(err)
+	s.Equal("After", found.Nickname)
+	s.Equal(models.ExternalAccountStatusVerified, found.VerificationStatus)
+}
+
+func (s *ExternalAccountRepositoryTestSuite) TestDelete_SoftDeletes() {
+	account := &models.ExternalAccount{
+		UserID: s.user.ID, ExternalAccountID: uuid.New(), Nickname: "To Delete", AccountNumberMask: "5555", NameOnAccount: gofakeit.Name(), BankName: "Test Bank",
+	}
+	s.NoError(s.repo.Create(context.Background(), account))
+
+	s.NoError(s.repo.Delete(context.Background(), account.ID))
+
+	_, err := s.repo.GetByID(context.Background(), account.ID)
+	s.ErrorIs(err, ErrExternalAccountNotFound)
+
+	accounts, err := s.repo.ListByUserID(context.Background(), s.user.ID)
+	s.NoError(err)
+	s.Empty(accounts)
+
+	// Row is retained for transfer history
+	var count int64
+	s.NoError(s.db.Unscoped().Model(&models.ExternalAccount{}).Where("id = ?", account.ID).Count(&count).Error)
+	s.Equal(int64(1), count)
+}
+
+func (s *ExternalAccountRepositoryTestSuite) TestDelete_NotFound() {
+	err := s.repo.Delete(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrExternalAccountNotFound)
+}
+
+func (s *ExternalAccountRepositoryTestSuite) TestDelete_RefusedWithTransfersInFlight() {
+	account := &models.ExternalAccount{
+		UserID: s.user.ID, ExternalAccountID: uuid.New(), Nickname: "Landlord", AccountNumberMask: "7777", NameOnAccount: gofakeit.Name(), BankName: "Test Bank",
+	}
+	s.Require().NoError(s.repo.Create(context.Background(), account))
+	source := &models.Account{
+		UserID: s.user.ID, AccountNumber: "1012345678", AccountType: models.AccountTypeChecking,
+		Balance: decimal.NewFromInt(500), Status: models.AccountStatusActive, Currency: "USD",
+	}
+	s.Require().NoError(s.db.Create(source).Error)
+	transfer := &models.Transfer{
+		FromAccountID: source.ID, ToExternalAccountID: &account.ID, Amount: decimal.NewFromInt(50),
+		Description: "Rent", IdempotencyKey: uuid.NewString(), Status: models.TransferStatusProcessing,
+	}
+	s.Require().NoError(s.db.Create(transfer).Error)
+
+	err := s.repo.Delete(context.Background(), account.ID)
+	s.ErrorIs(err, ErrExternalAccountHasTransfersInFlight)
+	_, err = s.repo.GetByID(context.Background(), account.ID)
+	s.NoError(err)
+
+	// Once the transfer settles the payee can be removed
+	s.Require().NoError(s.db.Model(transfer).UpdateColumn("status", models.TransferStatusCompleted).Error)
+	s.NoError(s.repo.Delete(context.Background(), account.ID))
+}
+
+func (s *ExternalAccountRepositoryTestSuite) createSentAccount() *models.ExternalAccount {
+	account := &models.ExternalAccount{
+		UserID: s.user.ID, ExternalAccountID: uuid.New(), Nickname: "Pending", AccountNumberMask: "6666", NameOnAccount: gofakeit.Name(), BankName: "Test Bank",
+		VerificationStatus: models.ExternalAccountStatusMicroDepositsSent,
+	}
+	s.Require().NoError(s.repo.Create(context.Background(), account))
+	return account
+}
+
+func (s *ExternalAccountRepositoryTestSuite) TestClaimVerificationAttempt_RefusedOnceAttemptsUsed() {
+	account := s.createSentAccount()
+
+	for want := 1; want <= models.MaxMicroDepositVerificationAttempts; want++ {
+		attempt, err := s.repo.ClaimVerificationAttempt(context.Background(), account.ID, models.MaxMicroDepositVerificationAttempts)
+		s.Require().NoError(err)
+		s.Equal(want, attempt)
+	}
+
+	_, err := s.repo.ClaimVerificationAttempt(context.Background(), account.ID, models.MaxMicroDepositVerificationAttempts)
+	s.ErrorIs(err, ErrVerificationAttemptRefused)
+
+	found, err := s.repo.GetByID(context.Background(), account.ID)
+	s.Require().NoError(err)
+	s.Equal(models.MaxMicroDepositVerificationAttempts, found.VerificationAttempts)
+}
+
+func (s *ExternalAccountRepositoryTestSuite) TestClaimVerificationAttempt_RefusedWhenNotAwaitingConfirmation() {
+	account := s.createSentAccount()
+	s.Require().NoError(s.db.Model(account).Update("verification_status", models.ExternalAccountStatusVerified).Error)
+
+	_, err := s.repo.ClaimVerificationAttempt(context.Background(), account.ID, models.MaxMicroDepositVerificationAttempts)
+	s.ErrorIs(err, ErrVerificationAttemptRefused)
+}
+
+func (s *ExternalAccountRepositoryTestSuite) TestUpdateVerificationStatus_OnlyFromExpectedStatus() {
+	account := s.createSentAccount()
+
+	account.VerificationStatus = models.ExternalAccountStatusLocked
+	s.NoError(s.repo.UpdateVerificationStatus(context.Background(), account, models.ExternalAccountStatusMicroDepositsSent))
+
+	// A concurrent match that read the payee before it was locked does not verify it
+	now := time.Now()
+	account.VerificationStatus = models.ExternalAccountStatusVerified
+	account.VerifiedAt = &now
+	err := s.repo.UpdateVerificationStatus(context.Background(), account, models.ExternalAccountStatusMicroDepositsSent)
+	s.ErrorIs(err, ErrVerificationStatusChanged)
+
+	found, err := s.repo.GetByID(context.Background(), account.ID)
+	s.Require().NoError(err)
+	s.Equal(models.ExternalAccountStatusLocked, found.VerificationStatus)
+	s.Nil(found.VerifiedAt)
+}
+
+func (s *ExternalAccountRepositoryTestSuite) TestUpdateNickname_LeavesVerificationUntouched() {
+	account := s.createSentAccount()
+	_, err := s.repo.ClaimVerificationAttempt(context.Background(), account.ID, models.MaxMicroDepositVerificationAttempts)
+	s.Require().NoError(err)
+
+	// account still holds the attempt count read before the attempt
+	s.NoError(s.repo.UpdateNickname(context.Background(), account.ID, "Landlord"))
+
+	found, err := s.repo.GetByID(context.Background(), account.ID)
+	s.Require().NoError(err)
+	s.Equal("Landlord", found.Nickname)
+	s.Equal(1, found.VerificationAttempts)
+}
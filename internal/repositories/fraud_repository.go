@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrFraudDecisionNotFound = errors.New("fraud decision not found")
+
+type fraudRepository struct {
+	db *gorm.DB
+}
+
+func NewFraudRepository(db *gorm.DB) FraudRepositoryInterface {
+	return &fraudRepository{db: db}
+}
+
+// ListRules returns the rules admins have edited. Rules without a row run with their defaults.
+func (r *fraudRepository) ListRules(ctx context.Context) ([]models.FraudRule, error) {
+	var rules []models.FraudRule
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("failed to list fraud rules: %w", err)
+	}
+	return rules, nil
+}
+
+// SaveRule inserts or replaces the settings of a rule.
+func (r *fraudRepository) SaveRule(ctx context.Context, rule *models.FraudRule) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "action", "score", "params", "updated_by", "updated_at"}),
+	}).Create(rule).Error
+	if err != nil {
+		return fmt.Errorf("failed to save fraud rule: %w", err)
+	}
+	return nil
+}
+
+func (r *fraudRepository) CreateDecision(ctx context.Context, decision *models.FraudDecision) error {
+	if err := r.db.WithContext(ctx).Create(decision).Error; err != nil {
+		return fmt.Errorf("failed to create fraud decision: %w", err)
+	}
+	return nil
+}
+
+// AttachDecisionResource links a decision to the transfer or transaction it allowed.
+func (r *fraudRepository) AttachDecisionResource(ctx context.Context, id uuid.UUID, resourceType string, resourceID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Model(&models.FraudDecision{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"resource_type": resourceType,
+		"resource_id":   resourceID,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to attach fraud decision resource: %w", err)
+	}
+	return nil
+}
+
+func (r *fraudRepository) GetDecisionByID(ctx context.Context, id uuid.UUID) (*models.FraudDecision, error) {
+	var decision models.FraudDecision
+	if err := r.db.WithContext(ctx).First(&decision, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrFraudDecisionNotFound
+		}
+		return nil, fmt.Errorf("failed to find fraud decision: %w", err)
+	}
+	return &decision, nil
+}
+
+// ListDecisions returns decisions matching the filters, newest first.
+func (r *fraudRepository) ListDecisions(ctx context.Context, filters models.FraudDecisionFilters, offset, limit int) ([]models.FraudDecision, int64, error) {
+	var decisions []models.FraudDecision
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.FraudDecision{})
+	if filters.Outcome != "" {
+		query = query.Where("outcome = ?", filters.Outcome)
+	}
+	if filters.Operation != "" {
+		query = query.Where("operation = ?", filters.Operation)
+	}
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+	if filters.AccountID != nil {
+		query = query.Where("account_id = ?", *filters.AccountID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count fraud decisions: %w", err)
+	}
+
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&decisions).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list fraud decisions: %w", err)
+	}
+
+	return decisions, total, nil
+}
+
+// ListRecentDebits returns the user's completed debits across all of their accounts posted
+// since the given time, newest first.
+func (r *fraudRepository) ListRecentDebits(ctx context.Context, userID uuid.UUID, since time.Time) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+	err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Joins("JOIN accounts ON accounts.id = transactions.account_id").
+		Where("accounts.user_id = ? AND transactions.transaction_type = ? AND transactions.status = ? AND transactions.created_at >= ?",
+			userID, models.TransactionTypeDebit, models.TransactionStatusCompleted, since.UTC()).
+		Order("transactions.created_at DESC").
+		Find(&transactions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent debits: %w", err)
+	}
+	return transactions, nil
+}
+
+// CountTransfersToExternalAccount counts transfers to the payee that did not fail.
+func (r *fraudRepository) CountTransfersToExternalAccount(ctx context.Context, externalAccountID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Transfer{}).
+		Where("to_external_account_id = ? AND status <> ?", externalAccountID, models.TransferStatusFailed).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count transfers to external account: %w", err)
+	}
+	return count, nil
+}
+
+// ListRecentLogins returns the user's latest successful logins, newest first.
+func (r *fraudRepository) ListRecentLogins(ctx context.Context, userID uuid.UUID, limit int) ([]models.AuditLog, error) {
+	var logins []models.AuditLog
+	err := r.db.WithContext(ctx).Where("user_id = ? AND action = ?", userID, models.AuditActionLogin).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&logins).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent logins: %w", err)
+	}
+	return logins, nil
+}
@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type FraudRepositoryTestSuite struct {
+	suite.Suite
+	db      *database.DB
+	repo    FraudRepositoryInterface
+	user    *models.User
+	account *models.Account
+}
+
+func (s *FraudRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewFraudRepository(s.db.DB)
+	s.user = database.CreateTestUser(s.T(), s.db, "fraud@example.com")
+	s.account = s.createAccount(s.user, "1000000001")
+}
+
+func (s *FraudRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestFraudRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(FraudRepositoryTestSuite))
+}
+
+func (s *FraudRepositoryTestSuite) createAccount(user *models.User, number string) *models.Account {
+	account := &models.Account{
+		AccountNumber: number,
+		UserID:        user.ID,
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromInt(5000),
+	}
+	s.Require().NoError(s.db.Create(account).Error)
+	return account
+}
+
+func (s *FraudRepositoryTestSuite) createTransaction(account *models.Account, transactionType string, amount int64, at time.Time) *models.Transaction {
+	balanceAfter := decimal.NewFromInt(amount)
+	if transactionType == models.TransactionTypeDebit {
+		balanceAfter = balanceAfter.Neg()
+	}
+	transaction := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: transactionType,
+		Amount:          decimal.NewFromInt(amount),
+		BalanceBefore:   decimal.Zero,
+		BalanceAfter:    balanceAfter,
+		Description:     "test",
+		CreatedAt:       at,
+		UpdatedAt:       at,
+	}
+	s.Require().NoError(s.db.Create(transaction).Error)
+	return transaction
+}
+
+func (s *FraudRepositoryTestSuite) TestSaveRule_Upserts() {
+	adminID := uuid.New()
+	rule := &models.FraudRule{
+		Name: "velocity", Enabled: true, Action: models.FraudOutcomeReview, Score: 40,
+		Params: models.JSONBMap{"max_count": 5},
+	}
+	s.Require().NoError(s.repo.SaveRule(context.Background(), rule))
+
+	rule.Enabled = false
+	rule.Action = models.FraudOutcomeBlock
+	rule.Params = models.JSONBMap{"max_count": 3}
+	rule.UpdatedBy = &adminID
+	s.Require().NoError(s.repo.SaveRule(context.Background(), rule))
+
+	rules, err := s.repo.ListRules(context.Background())
+	s.Require().NoError(err)
+	s.Require().Len(rules, 1)
+	s.False(rules[0].Enabled)
+	s.Equal(models.FraudOutcomeBlock, rules[0].Action)
+	s.Equal(float64(3), rules[0].Params["max_count"])
+	s.Equal(adminID, *rules[0].UpdatedBy)
+}
+
+func (s *FraudRepositoryTestSuite) TestDecisions() {
+	review := &models.FraudDecision{
+		UserID: s.user.ID, Operation: models.FraudOperationTransaction, AccountID: s.account.ID,
+		Amount: decimal.NewFromInt(900), Outcome: models.FraudOutcomeReview, Score: 40,
+		RuleResults: models.JSONBMap{"rules": []interface{}{map[string]interface{}{"rule": "velocity", "triggered": true}}},
+	}
+	s.Require().NoError(s.repo.CreateDecision(context.Background(), review))
+	allow := &models.FraudDecision{
+		UserID: s.user.ID, Operation: models.FraudOperationInternalTransfer, AccountID: s.account.ID,
+		Amount: decimal.NewFromInt(10), Outcome: models.FraudOutcomeAllow,
+	}
+	s.Require().NoError(s.repo.CreateDecision(context.Background(), allow))
+
+	transactionID := uuid.New()
+	s.Require().NoError(s.repo.AttachDecisionResource(context.Background(), review.ID, "transaction", transactionID))
+
+	found, err := s.repo.GetDecisionByID(context.Background(), review.ID)
+	s.Require().NoError(err)
+	s.Equal("transaction", *found.ResourceType)
+	s.Equal(transactionID, *found.ResourceID)
+	s.Len(found.RuleResults["rules"], 1)
+
+	decisions, total, err := s.repo.ListDecisions(context.Background(), models.FraudDecisionFilters{Outcome: models.FraudOutcomeReview}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(review.ID, decisions[0].ID)
+
+	_, total, err = s.repo.ListDecisions(context.Background(), models.FraudDecisionFilters{UserID: &s.user.ID, AccountID: &s.account.ID}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(2), total)
+
+	_, total, err = s.repo.ListDecisions(context.Background(), models.FraudDecisionFilters{Operation: models.FraudOperationExternalTransfer}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(0), total)
+
+	_, err = s.repo.GetDecisionByID(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrFraudDecisionNotFound)
+}
+
+func (s *FraudRepositoryTestSuite) TestListRecentDebits() {
+	now := time.Now()
+	savings := s.createAccount(s.user, "1000000003")
+	other := s.createAccount(database.CreateTestUser(s.T(), s.db, "other@example.com"), "1000000002")
+
+	recent := s.createTransaction(s.account, models.TransactionTypeDebit, 100, now.Add(-time.Hour))
+	recentSavings := s.createTransaction(savings, models.TransactionTypeDebit, 200, now.Add(-time.Minute))
+	s.createTransaction(s.account, models.TransactionTypeCredit, 300, now.Add(-time.Minute))
+	s.createTransaction(s.account, models.TransactionTypeDebit, 400, now.AddDate(0, 0, -10))
+	s.createTransaction(other, models.TransactionTypeDebit, 500, now.Add(-time.Minute))
+	pending := s.createTransaction(s.account, models.TransactionTypeDebit, 600, now.Add(-time.Minute))
+	s.Require().NoError(s.db.Model(pending).Update("status", models.TransactionStatusPending).Error)
+
+	debits, err := s.repo.ListRecentDebits(context.Background(), s.user.ID, now.AddDate(0, 0, -1))
+	s.Require().NoError(err)
+	s.Require().Len(debits, 2)
+	s.Equal(recentSavings.ID, debits[0].ID)
+	s.Equal(recent.ID, debits[1].ID)
+}
+
+func (s *FraudRepositoryTestSuite) TestCountTransfersToExternalAccount() {
+	externalID := uuid.New()
+	for _, status := range []string{models.TransferStatusCompleted, models.TransferStatusPending, models.TransferStatusFailed} {
+		s.Require().NoError(s.db.Create(&models.Transfer{
+			FromAccountID: s.account.ID, ToExternalAccountID: &externalID, Amount: decimal.NewFromInt(50),
+			Description: "test", IdempotencyKey: uuid.NewString(), Status: status,
+		}).Error)
+	}
+
+	count, err := s.repo.CountTransfersToExternalAccount(context.Background(), externalID)
+	s.NoError(err)
+	s.Equal(int64(2), count)
+
+	count, err = s.repo.CountTransfersToExternalAccount(context.Background(), uuid.New())
+	s.NoError(err)
+	s.Equal(int64(0), count)
+}
+
+func (s *FraudRepositoryTestSuite) TestListRecentLogins() {
+	now := time.Now()
+	for i, ip := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"} {
+		s.Require().NoError(s.db.Create(&models.AuditLog{
+			UserID: &s.user.ID, Action: models.AuditActionLogin, Resource: "user", ResourceID: s.user.ID.String(),
+			IPAddress: ip, UserAgent: "test", CreatedAt: now.Add(time.Duration(i) * time.Minute),
+		}).Error)
+	}
+	s.Require().NoError(s.db.Create(&models.AuditLog{
+		UserID: &s.user.ID, Action: models.AuditActionFailedLogin, Resource: "user", ResourceID: s.user.ID.String(),
+		IPAddress: "10.0.0.9", UserAgent: "test", CreatedAt: now.Add(time.Hour),
+	}).Error)
+
+	logins, err := s.repo.ListRecentLogins(context.Background(), s.user.ID, 2)
+	s.Require().NoError(err)
+	s.Require().Len(logins, 2)
+	s.Equal("10.0.0.3", logins[0].IPAddress)
+	s.Equal("10.0.0.2", logins[1].IPAddress)
+}
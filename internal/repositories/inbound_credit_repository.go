@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInboundCreditNotFound  = errors.New("inbound credit not found")
+	ErrInboundCreditDuplicate = errors.New("inbound credit with this event ID already exists")
+	// ErrInboundCreditStatusChanged is returned when a credit is no longer in the status an
+	// update expected, for example because another admin resolved it first.
+	ErrInboundCreditStatusChanged = errors.New("inbound credit status changed")
+)
+
+type inboundCreditRepository struct {
+	db *gorm.DB
+}
+
+func NewInboundCreditRepository(db *gorm.DB) InboundCreditRepositoryInterface {
+	return &inboundCreditRepository{db: db}
+}
+
+// Create inserts the credit; a repeated partner event ID returns ErrInboundCreditDuplicate.
+func (r *inboundCreditRepository) Create(ctx context.Context, credit *models.InboundCredit) error {
+	if err := r.db.WithContext(ctx).Create(credit).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return ErrInboundCreditDuplicate
+		}
+		return fmt.Errorf("failed to create inbound credit: %w", err)
+	}
+	return nil
+}
+
+// CreatePosted posts the credit's transaction and records the credit as posted to it in a single
+// database transaction, so a credit is never on an account without its record or recorded without
+// being posted. A repeated partner event ID returns ErrInboundCreditDuplicate and posts nothing.
+func (r *inboundCreditRepository) CreatePosted(ctx context.Context, credit *models.InboundCredit, transaction *models.Transaction) error {
+	status := credit.Status
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := postTransaction(tx, transaction); err != nil {
+			return err
+		}
+		credit.MarkPosted(transaction.AccountID, transaction.ID)
+		if err := tx.Create(credit).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return ErrInboundCreditDuplicate
+			}
+			return fmt.Errorf("failed to create inbound credit: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		credit.Status, credit.AccountID, credit.TransactionID = status, nil, nil
+		return err
+	}
+	return nil
+}
+
+// ResolvePosted posts the transaction for a suspense credit and records the credit as posted to
+// it, with its resolution, in a single database transaction. The credit must still be in suspense;
+// otherwise ErrInboundCreditStatusChanged is returned and nothing is posted, so concurrent admin
+// actions cannot both act on the same credit.
+func (r *inboundCreditRepository) ResolvePosted(ctx context.Context, credit *models.InboundCredit, transaction *models.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := postTransaction(tx, transaction); err != nil {
+			return err
+		}
+		credit.MarkPosted(transaction.AccountID, transaction.ID)
+		return updateInboundCreditFromStatus(tx, credit, models.InboundCreditStatusSuspense)
+	})
+}
+
+// UpdateFromStatus saves the credit's status and outcome provided it is still in status from, and
+// returns ErrInboundCreditStatusChanged otherwise. Admin actions use it to claim a credit before
+// acting on it.
+func (r *inboundCreditRepository) UpdateFromStatus(ctx context.Context, credit *models.InboundCredit, from string) error {
+	return updateInboundCreditFromStatus(r.db.WithContext(ctx), credit, from)
+}
+
+func updateInboundCreditFromStatus(tx *gorm.DB, credit *models.InboundCredit, from string) error {
+	result := tx.Model(&models.InboundCredit{}).
+		Where("id = ? AND status = ?", credit.ID, from).
+		Updates(map[string]interface{}{
+			"status":             credit.Status,
+			"suspense_reason":    credit.SuspenseReason,
+			"account_id":         credit.AccountID,
+			"transaction_id":     credit.TransactionID,
+			"return_transfer_id": credit.ReturnTransferID,
+			"resolved_by":        credit.ResolvedBy,
+			"resolved_at":        credit.ResolvedAt,
+			"resolution_note":    credit.ResolutionNote,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update inbound credit: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrInboundCreditStatusChanged
+	}
+	return nil
+}
+
+func (r *inboundCreditRepository) Update(ctx context.Context, credit *models.InboundCredit) error {
+	if err := r.db.WithContext(ctx).Save(credit).Error; err != nil {
+		return fmt.Errorf("failed to update inbound credit: %w", err)
+	}
+	return nil
+}
+
+func (r *inboundCreditRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.InboundCredit, error) {
+	var credit models.InboundCredit
+	if err := r.db.WithContext(ctx).First(&credit, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInboundCreditNotFound
+		}
+		return nil, fmt.Errorf("failed to find inbound credit: %w", err)
+	}
+	return &credit, nil
+}
+
+func (r *inboundCreditRepository) GetByEventID(ctx context.Context, eventID string) (*models.InboundCredit, error) {
+	var credit models.InboundCredit
+	if err := r.db.WithContext(ctx).First(&credit, "event_id = ?", eventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInboundCreditNotFound
+		}
+		return nil, fmt.Errorf("failed to find inbound credit by event id: %w", err)
+	}
+	return &credit, nil
+}
+
+// ListByStatus returns credits in the given status (all statuses when empty), oldest first.
+func (r *inboundCreditRepository) ListByStatus(ctx context.Context, status string, offset, limit int) ([]models.InboundCredit, int64, error) {
+	var credits []models.InboundCredit
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.InboundCredit{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count inbound credits: %w", err)
+	}
+
+	if err := query.Order("created_at ASC").Offset(offset).Limit(limit).Find(&credits).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list inbound credits: %w", err)
+	}
+
+	return credits, total, nil
+}
@@ -0,0 +1,245 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type InboundCreditRepositoryTestSuite struct {
+	suite.Suite
+	db      *database.DB
+	repo    InboundCreditRepositoryInterface
+	account *models.Account
+}
+
+func (s *InboundCreditRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewInboundCreditRepository(s.db.DB)
+
+	user := database.CreateTestUser(s.T(), s.db, "inbound@example.com")
+	s.account = &models.Account{
+		UserID:        user.ID,
+		AccountNumber: "1012345678",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(100.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.db.Create(s.account).Error)
+}
+
+func (s *InboundCreditRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestInboundCreditRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InboundCreditRepositoryTestSuite))
+}
+
+func (s *InboundCreditRepositoryTestSuite) newCredit(eventID string) *models.InboundCredit {
+	return &models.InboundCredit{
+		EventID:       eventID,
+		AccountNumber: "1012345678",
+		Amount:        decimal.NewFromFloat(250.00),
+		Currency:      "USD",
+		SenderName:    "Jane Sender",
+	}
+}
+
+func (s *InboundCreditRepositoryTestSuite) newTransaction(credit *models.InboundCredit) *models.Transaction {
+	return &models.Transaction{
+		AccountID:       s.account.ID,
+		TransactionType: models.TransactionTypeCredit,
+		Amount:          credit.Amount,
+		Description:     "Inbound transfer from Jane Sender",
+		Reference:       "inbound-" + credit.EventID,
+	}
+}
+
+func (s *InboundCreditRepositoryTestSuite) balance() decimal.Decimal {
+	var account models.Account
+	s.Require().NoError(s.db.First(&account, "id = ?", s.account.ID).Error)
+	return account.Balance
+}
+
+func (s *InboundCreditRepositoryTestSuite) countTransactions() int64 {
+	var count int64
+	s.Require().NoError(s.db.Model(&models.Transaction{}).Where("account_id = ?", s.account.ID).Count(&count).Error)
+	return count
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestCreate_Success() {
+	credit := s.newCredit("evt_1")
+
+	err := s.repo.Create(context.Background(), credit)
+	s.NoError(err)
+	s.NotEqual(uuid.Nil, credit.ID)
+	s.Equal(models.InboundCreditStatusReceived, credit.Status)
+
+	found, err := s.repo.GetByID(context.Background(), credit.ID)
+	s.NoError(err)
+	s.Equal("evt_1", found.EventID)
+	s.True(found.Amount.Equal(credit.Amount))
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestCreate_DuplicateEventID() {
+	s.Require().NoError(s.repo.Create(context.Background(), s.newCredit("evt_dup")))
+
+	err := s.repo.Create(context.Background(), s.newCredit("evt_dup"))
+	s.ErrorIs(err, ErrInboundCreditDuplicate)
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestGetByEventID() {
+	credit := s.newCredit("evt_lookup")
+	s.Require().NoError(s.repo.Create(context.Background(), credit))
+
+	found, err := s.repo.GetByEventID(context.Background(), "evt_lookup")
+	s.NoError(err)
+	s.Equal(credit.ID, found.ID)
+
+	_, err = s.repo.GetByEventID(context.Background(), "evt_missing")
+	s.ErrorIs(err, ErrInboundCreditNotFound)
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestGetByID_NotFound() {
+	_, err := s.repo.GetByID(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrInboundCreditNotFound)
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestUpdate() {
+	credit := s.newCredit("evt_update")
+	s.Require().NoError(s.repo.Create(context.Background(), credit))
+
+	credit.MarkSuspense("no account matches account number")
+	s.NoError(s.repo.Update(context.Background(), credit))
+
+	found, err := s.repo.GetByID(context.Background(), credit.ID)
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusSuspense, found.Status)
+	s.Require().NotNil(found.SuspenseReason)
+	s.Equal("no account matches account number", *found.SuspenseReason)
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestListByStatus() {
+	for i, status := range []string{
+		models.InboundCreditStatusSuspense,
+		models.InboundCreditStatusPosted,
+		models.InboundCreditStatusSuspense,
+	} {
+		credit := s.newCredit(uuid.NewString())
+		credit.Status = status
+		s.Require().NoError(s.repo.Create(context.Background(), credit), "credit %d", i)
+	}
+
+	credits, total, err := s.repo.ListByStatus(context.Background(), models.InboundCreditStatusSuspense, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(2), total)
+	s.Len(credits, 2)
+	for _, credit := range credits {
+		s.Equal(models.InboundCreditStatusSuspense, credit.Status)
+	}
+
+	credits, total, err = s.repo.ListByStatus(context.Background(), "", 0, 1)
+	s.NoError(err)
+	s.Equal(int64(3), total)
+	s.Len(credits, 1)
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestCreatePosted_PostsCreditAndLedgerTogether() {
+	credit := s.newCredit("evt_post")
+	transaction := s.newTransaction(credit)
+
+	s.Require().NoError(s.repo.CreatePosted(context.Background(), credit, transaction))
+	s.Equal(models.InboundCreditStatusPosted, credit.Status)
+	s.Equal(&transaction.ID, credit.TransactionID)
+	s.True(transaction.BalanceAfter.Equal(decimal.NewFromFloat(350.00)))
+	s.True(s.balance().Equal(decimal.NewFromFloat(350.00)))
+
+	found, err := s.repo.GetByEventID(context.Background(), "evt_post")
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusPosted, found.Status)
+	s.Equal(&s.account.ID, found.AccountID)
+
+	var events int64
+	s.Require().NoError(s.db.Model(&models.OutboxEvent{}).Where("aggregate_id = ?", transaction.ID).Count(&events).Error)
+	s.Equal(int64(1), events)
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestCreatePosted_DuplicateRollsBackPosting() {
+	s.Require().NoError(s.repo.CreatePosted(context.Background(), s.newCredit("evt_dup"), s.newTransaction(s.newCredit("evt_dup"))))
+
+	credit := s.newCredit("evt_dup")
+	err := s.repo.CreatePosted(context.Background(), credit, s.newTransaction(credit))
+	s.ErrorIs(err, ErrInboundCreditDuplicate)
+	s.NotEqual(models.InboundCreditStatusPosted, credit.Status)
+	s.Nil(credit.TransactionID)
+	s.True(s.balance().Equal(decimal.NewFromFloat(350.00)))
+	s.Equal(int64(1), s.countTransactions())
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestCreatePosted_InactiveAccountPostsNothing() {
+	s.Require().NoError(s.db.Model(s.account).UpdateColumn("status", models.AccountStatusInactive).Error)
+
+	credit := s.newCredit("evt_inactive")
+	err := s.repo.CreatePosted(context.Background(), credit, s.newTransaction(credit))
+	s.ErrorIs(err, ErrAccountNotActive)
+
+	_, err = s.repo.GetByEventID(context.Background(), "evt_inactive")
+	s.ErrorIs(err, ErrInboundCreditNotFound)
+	s.Equal(int64(0), s.countTransactions())
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestResolvePosted() {
+	credit := s.newCredit("evt_resolve")
+	credit.MarkSuspense("no account matches account number")
+	s.Require().NoError(s.repo.Create(context.Background(), credit))
+
+	transaction := s.newTransaction(credit)
+	credit.Resolve(uuid.New(), "confirmed with sender")
+	s.Require().NoError(s.repo.ResolvePosted(context.Background(), credit, transaction))
+
+	found, err := s.repo.GetByID(context.Background(), credit.ID)
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusPosted, found.Status)
+	s.Equal(&transaction.ID, found.TransactionID)
+	s.NotNil(found.ResolvedBy)
+	s.True(s.balance().Equal(decimal.NewFromFloat(350.00)))
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestResolvePosted_NotInSuspensePostsNothing() {
+	credit := s.newCredit("evt_resolved_twice")
+	credit.MarkSuspense("no account matches account number")
+	s.Require().NoError(s.repo.Create(context.Background(), credit))
+	s.Require().NoError(s.repo.ResolvePosted(context.Background(), credit, s.newTransaction(credit)))
+
+	// A second admin acting on a stale copy of the credit
+	stale := s.newCredit("evt_resolved_twice")
+	stale.ID = credit.ID
+	stale.MarkSuspense("no account matches account number")
+	err := s.repo.ResolvePosted(context.Background(), stale, s.newTransaction(stale))
+	s.ErrorIs(err, ErrInboundCreditStatusChanged)
+	s.True(s.balance().Equal(decimal.NewFromFloat(350.00)))
+	s.Equal(int64(1), s.countTransactions())
+}
+
+func (s *InboundCreditRepositoryTestSuite) TestUpdateFromStatus() {
+	credit := s.newCredit("evt_return")
+	credit.MarkSuspense("no account matches account number")
+	s.Require().NoError(s.repo.Create(context.Background(), credit))
+
+	credit.Status = models.InboundCreditStatusReturning
+	s.NoError(s.repo.UpdateFromStatus(context.Background(), credit, models.InboundCreditStatusSuspense))
+
+	found, err := s.repo.GetByID(context.Background(), credit.ID)
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusReturning, found.Status)
+
+	err = s.repo.UpdateFromStatus(context.Background(), credit, models.InboundCreditStatusSuspense)
+	s.ErrorIs(err, ErrInboundCreditStatusChanged)
+}
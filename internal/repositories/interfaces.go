@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"time"
 
 	"github.com/array/banking-api/internal/models"
@@ -10,47 +11,50 @@ import (
 
 // AccountRepositoryInterface defines the contract for account repository operations
 type AccountRepositoryInterface interface {
-	Create(account *models.Account) error
-	GetByID(id uuid.UUID) (*models.Account, error)
-	GetByAccountNumber(accountNumber string) (*models.Account, error)
-	GetByUserID(userID uuid.UUID) ([]models.Account, error)
-	GetByUserIDAndType(userID uuid.UUID, accountType string) ([]models.Account, error)
-	GetByUserIDExcludingStatus(userID uuid.UUID, excludeStatus string) ([]*models.Account, error)
-	GetAll(offset, limit int) ([]models.Account, int64, error)
-	GetAllWithFilters(filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error)
-	Update(account *models.Account) error
-	UpdateOwnership(accountID, newUserID uuid.UUID) error
-	Delete(id uuid.UUID) error
-	SoftDeleteByUserID(userID uuid.UUID) error
-	CheckAccountNumberExists(accountNumber string) (bool, error)
-	GenerateUniqueAccountNumber(accountType string) (string, error)
-	CreateWithTransaction(account *models.Account, transactions []models.Transaction) error
-	UpdateBalance(accountID uuid.UUID, amount decimal.Decimal, transactionType string) error
-	GetAccountsByStatus(status string, offset, limit int) ([]models.Account, error)
-	GetTotalBalanceByUserID(userID uuid.UUID) (decimal.Decimal, error)
-	ExistsForUser(userID uuid.UUID, accountType string) (bool, error)
-	ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (debitTxID, creditTxID uuid.UUID, err error)
+	Create(ctx context.Context, account *models.Account) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Account, error)
+	GetByAccountNumber(ctx context.Context, accountNumber string) (*models.Account, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.Account, error)
+	GetByUserIDAndType(ctx context.Context, userID uuid.UUID, accountType string) ([]models.Account, error)
+	GetByUserIDExcludingStatus(ctx context.Context, userID uuid.UUID, excludeStatus string) ([]*models.Account, error)
+	GetAll(ctx context.Context, offset, limit int) ([]models.Account, int64, error)
+	GetAllWithFilters(ctx context.Context, filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error)
+	Update(ctx context.Context, account *models.Account) error
+	UpdateOwnership(ctx context.Context, accountID, newUserID uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	SoftDeleteByUserID(ctx context.Context, userID uuid.UUID) error
+	CheckAccountNumberExists(ctx context.Context, accountNumber string) (bool, error)
+	GenerateUniqueAccountNumber(ctx context.Context, accountType string) (string, error)
+	CreateWithTransaction(ctx context.Context, account *models.Account, transactions []models.Transaction) error
+	UpdateBalance(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType string) error
+	GetAccountsByStatus(ctx context.Context, status string, offset, limit int) ([]models.Account, error)
+	GetTotalBalanceByUserID(ctx context.Context, userID uuid.UUID) (decimal.Decimal, error)
+	ExistsForUser(ctx context.Context, userID uuid.UUID, accountType string) (bool, error)
+	ExecuteAtomicTransfer(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (debitTxID, creditTxID uuid.UUID, err error)
 }
 
 // TransactionRepositoryInterface defines the contract for transaction repository operations
 type TransactionRepositoryInterface interface {
-	Create(transaction *models.Transaction) error
-	GetByID(id uuid.UUID) (*models.Transaction, error)
-	GetByAccountID(accountID uuid.UUID, offset, limit int) ([]models.Transaction, int64, error)
-	GetByReference(reference string) (*models.Transaction, error)
-	GetRecentByAccountID(accountID uuid.UUID, limit int) ([]models.Transaction, error)
-	GetByDateRange(accountID uuid.UUID, startDate, endDate time.Time) ([]models.Transaction, error)
-	CreateBatch(transactions []models.Transaction) error
-	GetPendingTransactions(offset, limit int) ([]models.Transaction, error)
-	UpdateStatus(id uuid.UUID, status string) error
-	GetTotalsByAccountID(accountID uuid.UUID) (credits, debits int64, creditAmount, debitAmount string, err error)
+	Create(ctx context.Context, transaction *models.Transaction) error
+	Post(ctx context.Context, transaction *models.Transaction) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Transaction, error)
+	GetByAccountID(ctx context.Context, accountID uuid.UUID, offset, limit int) ([]models.Transaction, int64, error)
+	GetByReference(ctx context.Context, reference string) (*models.Transaction, error)
+	GetRecentByAccountID(ctx context.Context, accountID uuid.UUID, limit int) ([]models.Transaction, error)
+	GetByDateRange(ctx context.Context, accountID uuid.UUID, startDate, endDate time.Time) ([]models.Transaction, error)
+	CreateBatch(ctx context.Context, transactions []models.Transaction) error
+	GetPendingTransactions(ctx context.Context, offset, limit int) ([]models.Transaction, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, status string) error
+	GetTotalsByAccountID(ctx context.Context, accountID uuid.UUID) (credits, debits int64, creditAmount, debitAmount string, err error)
 
 	// Enhanced methods for category and filtering
-	GetByCategory(accountID uuid.UUID, category string, offset, limit int) ([]models.Transaction, int64, error)
-	GetWithFilters(filters models.TransactionFilters) ([]models.Transaction, int64, error)
-	UpdateWithOptimisticLock(transaction *models.Transaction, expectedVersion int) error
-	GetExpiredPendingTransactions(limit int) ([]models.Transaction, error)
-	GetCategorySummary(accountID uuid.UUID, startDate, endDate time.Time) ([]models.CategorySummary, error)
+	GetByCategory(ctx context.Context, accountID uuid.UUID, category string, offset, limit int) ([]models.Transaction, int64, error)
+	GetWithFilters(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, int64, error)
+	UpdateWithOptimisticLock(ctx context.Context, transaction *models.Transaction, expectedVersion int) error
+	CompletePending(ctx context.Context, transaction *models.Transaction, expectedVersion int, auditLog *models.AuditLog) error
+	FailPending(ctx context.Context, transaction *models.Transaction, expectedVersion int, reason string) error
+	GetExpiredPendingTransactions(ctx context.Context, limit int) ([]models.Transaction, error)
+	GetCategorySummary(ctx context.Context, accountID uuid.UUID, startDate, endDate time.Time) ([]models.CategorySummary, error)
 }
 
 // UserSearchCriteria defines search criteria for users
@@ -61,96 +65,242 @@ type UserSearchCriteria struct {
 
 // UserRepositoryInterface defines the contract for user repository operations
 type UserRepositoryInterface interface {
-	Create(user *models.User) error
-	GetByID(id uuid.UUID) (*models.User, error)
-	GetByIDActive(id uuid.UUID) (*models.User, error)
-	GetByEmail(email string) (*models.User, error)
-	GetByEmailExcluding(email string, excludeUserID uuid.UUID) (*models.User, error)
-	SearchUsers(criteria UserSearchCriteria, offset, limit int) ([]*models.User, int64, error)
-	Update(user *models.User) error
-	UpdateFields(userID uuid.UUID, fields map[string]interface{}) error
-	UpdateEmail(userID uuid.UUID, newEmail string) error
-	UpdatePasswordHash(userID uuid.UUID, passwordHash string) error
-	UpdateFailedLoginAttempts(user *models.User) error
-	ResetFailedLoginAttempts(userID uuid.UUID) error
-	UnlockAccount(userID uuid.UUID) error
-	Delete(userID uuid.UUID) error
-	ListUsers(offset, limit int) ([]*models.User, int64, error)
-	CountAccountsByUserID(userID uuid.UUID) (int64, error)
+	Create(ctx context.Context, user *models.User) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetByIDActive(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	GetByEmailExcluding(ctx context.Context, email string, excludeUserID uuid.UUID) (*models.User, error)
+	SearchUsers(ctx context.Context, criteria UserSearchCriteria, offset, limit int) ([]*models.User, int64, error)
+	Update(ctx context.Context, user *models.User) error
+	UpdateFields(ctx context.Context, userID uuid.UUID, fields map[string]interface{}) error
+	UpdateEmail(ctx context.Context, userID uuid.UUID, newEmail string) error
+	UpdatePasswordHash(ctx context.Context, userID uuid.UUID, passwordHash string) error
+	UpdateFailedLoginAttempts(ctx context.Context, user *models.User) error
+	ResetFailedLoginAttempts(ctx context.Context, userID uuid.UUID) error
+	UnlockAccount(ctx context.Context, userID uuid.UUID) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+	ListUsers(ctx context.Context, offset, limit int) ([]*models.User, int64, error)
+	CountAccountsByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 }
 
 // AuditLogRepositoryInterface defines the contract for audit log repository operations
 type AuditLogRepositoryInterface interface {
-	Create(log *models.AuditLog) error
-	GetByID(id uuid.UUID) (*models.AuditLog, error)
-	GetByUserID(userID uuid.UUID, offset, limit int) ([]*models.AuditLog, int64, error)
-	GetByAction(action string, offset, limit int) ([]*models.AuditLog, int64, error)
-	GetByResource(resource, resourceID string, offset, limit int) ([]*models.AuditLog, int64, error)
-	GetByIPAddress(ipAddress string, offset, limit int) ([]*models.AuditLog, int64, error)
-	GetByTimeRange(startTime, endTime time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
-	GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
-	GetFailedLoginAttempts(email string, since time.Time) (int64, error)
-	DeleteOlderThan(duration time.Duration) (int64, error)
+	Create(ctx context.Context, log *models.AuditLog) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.AuditLog, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.AuditLog, int64, error)
+	GetByAction(ctx context.Context, action string, offset, limit int) ([]*models.AuditLog, int64, error)
+	GetByResource(ctx context.Context, resource, resourceID string, offset, limit int) ([]*models.AuditLog, int64, error)
+	GetByIPAddress(ctx context.Context, ipAddress string, offset, limit int) ([]*models.AuditLog, int64, error)
+	GetByTimeRange(ctx context.Context, startTime, endTime time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
+	GetCustomerActivity(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
+	GetFailedLoginAttempts(ctx context.Context, email string, since time.Time) (int64, error)
+	DeleteOlderThan(ctx context.Context, duration time.Duration) (int64, error)
 }
 
 // ProcessingQueueRepositoryInterface defines the contract for transaction processing queue operations
 type ProcessingQueueRepositoryInterface interface {
-	Enqueue(transactionID uuid.UUID, operation string, priority int) error
-	FetchPending(limit int) ([]*models.ProcessingQueueItem, error)
-	MarkProcessing(queueItemID uuid.UUID) error
-	MarkCompleted(queueItemID uuid.UUID) error
-	MarkFailed(queueItemID uuid.UUID, errorMessage string) error
-	IncrementRetry(queueItemID uuid.UUID) error
-	GetPendingCount() (int64, error)
-	GetProcessingCount() (int64, error)
-	GetFailedCount() (int64, error)
-	GetCompletedCount() (int64, error)
-	GetAverageProcessingTime() (float64, error)
-	GetOldestPendingAge() (*string, error)
-	CleanupCompleted(olderThan time.Duration) (int64, error)
+	Enqueue(ctx context.Context, transactionID uuid.UUID, operation string, priority int) error
+	ClaimPending(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*models.ProcessingQueueItem, error)
+	ExtendLease(ctx context.Context, queueItemID uuid.UUID, workerID string, lease time.Duration) error
+	ReapExpiredLeases(ctx context.Context, now time.Time) (int64, []*models.ProcessingQueueItem, error)
+	MarkCompleted(ctx context.Context, queueItemID uuid.UUID, workerID string) error
+	MarkFailed(ctx context.Context, queueItemID uuid.UUID, workerID, errorMessage string) error
+	IncrementRetry(ctx context.Context, queueItemID uuid.UUID, workerID string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ProcessingQueueItem, error)
+	GetLatestByTransactionID(ctx context.Context, transactionID uuid.UUID) (*models.ProcessingQueueItem, error)
+	List(ctx context.Context, filters models.ProcessingQueueFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error)
+	Requeue(ctx context.Context, queueItemID uuid.UUID, expectedStatus string, scheduledAt time.Time) error
+	Close(ctx context.Context, queueItemID uuid.UUID, expectedStatus, status string) error
+	GetControl(ctx context.Context) (*models.ProcessingQueueControl, error)
+	SaveControl(ctx context.Context, control *models.ProcessingQueueControl) error
+	CountByStatus(ctx context.Context) (map[string]int64, error)
+	GetAverageProcessingTime(ctx context.Context) (float64, error)
+	GetOldestPendingAge(ctx context.Context) (*string, error)
+	CleanupCompleted(ctx context.Context, olderThan time.Duration) (int64, error)
 }
 
 // TransferRepositoryInterface defines the contract for transfer repository operations
 type TransferRepositoryInterface interface {
-	Create(transfer *models.Transfer) error
-	Update(transfer *models.Transfer) error
-	FindByID(id uuid.UUID) (*models.Transfer, error)
-	FindByIdempotencyKey(key string) (*models.Transfer, error)
-	FindByUserAccounts(accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error)
-	FindPendingExternal(limit int) ([]models.Transfer, error)
-	FindByUserAccountsWithFilters(accountIDs []uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error)
-	CountByUserAccounts(accountIDs []uuid.UUID) (int64, error)
+	Create(ctx context.Context, transfer *models.Transfer) error
+	Update(ctx context.Context, transfer *models.Transfer) error
+	UpdateWithEvents(ctx context.Context, transfer *models.Transfer, events ...*models.OutboxEvent) error
+	UpdateStatusFrom(ctx context.Context, transfer *models.Transfer, from string) error
+	UpdateStatusCheck(ctx context.Context, transfer *models.Transfer) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Transfer, error)
+	FindByIdempotencyKey(ctx context.Context, key string) (*models.Transfer, error)
+	FindByUserAccounts(ctx context.Context, accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error)
+	FindPendingExternal(ctx context.Context, dueBy time.Time, limit int) ([]models.Transfer, error)
+	CountPendingExternal(ctx context.Context) (pending, escalated int64, err error)
+	FindByExternalTransferID(ctx context.Context, externalTransferID string) (*models.Transfer, error)
+	FindEscalatedExternal(ctx context.Context, offset, limit int) ([]models.Transfer, int64, error)
+	FindByUserAccountsWithFilters(ctx context.Context, accountIDs []uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error)
+	CountByUserAccounts(ctx context.Context, accountIDs []uuid.UUID) (int64, error)
+	FindByExternalAccount(ctx context.Context, externalAccountID uuid.UUID, offset, limit int) ([]models.Transfer, int64, error)
+	GetExternalAccountStats(ctx context.Context, externalAccountID uuid.UUID) (*models.ExternalAccountTransferStats, error)
 }
 
 type RefreshTokenRepositoryInterface interface {
-	Create(token *models.RefreshToken) error
-	GetByID(id uuid.UUID) (*models.RefreshToken, error)
-	GetByTokenHash(tokenHash string) (*models.RefreshToken, error)
-	GetActiveByUserID(userID uuid.UUID) ([]*models.RefreshToken, error)
-	Update(token *models.RefreshToken) error
-	Revoke(tokenID uuid.UUID) error
-	RevokeAllForUser(userID uuid.UUID) error
-	DeleteExpired() (int64, error)
-	DeleteRevokedOlderThan(duration time.Duration) (int64, error)
+	Create(ctx context.Context, token *models.RefreshToken) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.RefreshToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*models.RefreshToken, error)
+	Update(ctx context.Context, token *models.RefreshToken) error
+	Revoke(ctx context.Context, tokenID uuid.UUID) error
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID) error
+	DeleteExpired(ctx context.Context) (int64, error)
+	DeleteRevokedOlderThan(ctx context.Context, duration time.Duration) (int64, error)
 }
 
 // BlacklistedTokenRepositoryInterface defines the contract for blacklisted token repository operations
 type BlacklistedTokenRepositoryInterface interface {
-	Create(token *models.BlacklistedToken) error
-	GetByJTI(jti string) (*models.BlacklistedToken, error)
-	DeleteExpired() (int64, error)
+	Create(ctx context.Context, token *models.BlacklistedToken) error
+	GetByJTI(ctx context.Context, jti string) (*models.BlacklistedToken, error)
+	DeleteExpired(ctx context.Context) (int64, error)
 }
 
 // ExternalAccountRepositoryInterface defines the contract for external account repository operations.
 type ExternalAccountRepositoryInterface interface {
-	Create(account *models.ExternalAccount) error
-	GetByID(id uuid.UUID) (*models.ExternalAccount, error)
-	ListByUserID(userID uuid.UUID) ([]models.ExternalAccount, error)
+	Create(ctx context.Context, account *models.ExternalAccount) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ExternalAccount, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]models.ExternalAccount, error)
+	Update(ctx context.Context, account *models.ExternalAccount) error
+	UpdateNickname(ctx context.Context, id uuid.UUID, nickname string) error
+	UpdateMicroDeposits(ctx context.Context, account *models.ExternalAccount) error
+	ClaimVerificationAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (int, error)
+	UpdateVerificationStatus(ctx context.Context, account *models.ExternalAccount, from string) error
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // WebhookNotificationRepositoryInterface defines the contract for webhook notification repository operations.
 type WebhookNotificationRepositoryInterface interface {
-	Create(notification *models.WebhookNotification) error
-	Update(notification *models.WebhookNotification) error
-	FindPending(limit int) ([]models.WebhookNotification, error)
+	Create(ctx context.Context, notification *models.WebhookNotification) error
+	Update(ctx context.Context, notification *models.WebhookNotification) error
+	FindPending(ctx context.Context, limit int) ([]models.WebhookNotification, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookNotification, error)
+	List(ctx context.Context, filters models.WebhookNotificationFilters, offset, limit int) ([]models.WebhookNotification, int64, error)
+	CountByStatus(ctx context.Context) (map[string]int64, error)
+}
+
+// InboundCreditRepositoryInterface defines the contract for inbound partner credit repository operations.
+type InboundCreditRepositoryInterface interface {
+	Create(ctx context.Context, credit *models.InboundCredit) error
+	CreatePosted(ctx context.Context, credit *models.InboundCredit, transaction *models.Transaction) error
+	ResolvePosted(ctx context.Context, credit *models.InboundCredit, transaction *models.Transaction) error
+	Update(ctx context.Context, credit *models.InboundCredit) error
+	UpdateFromStatus(ctx context.Context, credit *models.InboundCredit, from string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.InboundCredit, error)
+	GetByEventID(ctx context.Context, eventID string) (*models.InboundCredit, error)
+	ListByStatus(ctx context.Context, status string, offset, limit int) ([]models.InboundCredit, int64, error)
+}
+
+// TransferSagaRepositoryInterface defines the contract for external transfer saga persistence.
+// Every step change is applied atomically with the account and transfer updates it records.
+type TransferSagaRepositoryInterface interface {
+	BeginWithDebit(ctx context.Context, transfer *models.Transfer, transferType, debitDescription string) (*models.TransferSaga, error)
+	MarkSubmitted(ctx context.Context, transfer *models.Transfer) error
+	Confirm(ctx context.Context, transfer *models.Transfer) error
+	Compensate(ctx context.Context, transferID uuid.UUID, reason, reversalDescription string) (*models.Transfer, bool, error)
+	RecordRecoveryFailure(ctx context.Context, transferID uuid.UUID, reason string) error
+	GetByTransferID(ctx context.Context, transferID uuid.UUID) (*models.TransferSaga, error)
+	FindStalled(ctx context.Context, olderThan time.Time, limit int) ([]models.TransferSaga, error)
+}
+
+// OutboxRepositoryInterface defines the contract for the domain event outbox, its consumer checkpoints and dead letters.
+type OutboxRepositoryInterface interface {
+	Append(ctx context.Context, events ...*models.OutboxEvent) error
+	FindAfter(ctx context.Context, afterID int64, limit int) ([]models.OutboxEvent, error)
+	ListAfter(ctx context.Context, afterID int64, offset, limit int) ([]models.OutboxEvent, int64, error)
+	CountAfter(ctx context.Context, afterID int64) (int64, error)
+	GetCheckpoint(ctx context.Context, consumer string) (*models.OutboxCheckpoint, error)
+	SaveCheckpoint(ctx context.Context, checkpoint *models.OutboxCheckpoint) error
+	ListCheckpoints(ctx context.Context) ([]models.OutboxCheckpoint, error)
+	DeadLetter(ctx context.Context, checkpoint *models.OutboxCheckpoint, deadLetter *models.OutboxDeadLetter) error
+	ListDeadLetters(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxDeadLetter, int64, error)
+	CountDeadLetters(ctx context.Context, consumer string) (int64, error)
+	GetDeadLetter(ctx context.Context, consumer string, eventID int64) (*models.OutboxDeadLetter, error)
+	MarkDeadLetterReplayed(ctx context.Context, deadLetter *models.OutboxDeadLetter) error
+}
+
+// WebhookSubscriptionRepositoryInterface defines the contract for customer webhook subscriptions and their deliveries.
+type WebhookSubscriptionRepositoryInterface interface {
+	Create(ctx context.Context, subscription *models.WebhookSubscription) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookSubscription, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]models.WebhookSubscription, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	Update(ctx context.Context, subscription *models.WebhookSubscription) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, offset, limit int) ([]models.WebhookDelivery, int64, error)
+	FindPendingDeliveries(ctx context.Context, limit int) ([]models.WebhookDelivery, error)
+	CountPendingDeliveries(ctx context.Context) (int64, error)
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	RecordDeliveryAttempt(ctx context.Context, delivery *models.WebhookDelivery, succeeded bool) (disabled bool, err error)
+}
+
+// ComplianceReportRepositoryInterface defines the contract for CTR and structuring reports and the activity they cover.
+type ComplianceReportRepositoryInterface interface {
+	Create(ctx context.Context, report *models.ComplianceReport) (created bool, err error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ComplianceReport, error)
+	List(ctx context.Context, filters models.ComplianceReportFilters, offset, limit int) ([]models.ComplianceReport, int64, error)
+	Update(ctx context.Context, report *models.ComplianceReport) error
+	FindDueSubmissions(ctx context.Context, limit int) ([]models.ComplianceReport, error)
+	ListActivity(ctx context.Context, from, to time.Time) ([]models.ComplianceActivity, error)
+}
+
+// FraudRepositoryInterface defines the contract for fraud rule settings, screening decisions and
+// the customer history rules are evaluated against.
+type FraudRepositoryInterface interface {
+	ListRules(ctx context.Context) ([]models.FraudRule, error)
+	SaveRule(ctx context.Context, rule *models.FraudRule) error
+	CreateDecision(ctx context.Context, decision *models.FraudDecision) error
+	AttachDecisionResource(ctx context.Context, id uuid.UUID, resourceType string, resourceID uuid.UUID) error
+	GetDecisionByID(ctx context.Context, id uuid.UUID) (*models.FraudDecision, error)
+	ListDecisions(ctx context.Context, filters models.FraudDecisionFilters, offset, limit int) ([]models.FraudDecision, int64, error)
+	ListRecentDebits(ctx context.Context, userID uuid.UUID, since time.Time) ([]models.Transaction, error)
+	CountTransfersToExternalAccount(ctx context.Context, externalAccountID uuid.UUID) (int64, error)
+	ListRecentLogins(ctx context.Context, userID uuid.UUID, limit int) ([]models.AuditLog, error)
+}
+
+// SanctionsRepositoryInterface defines the contract for sanctions matches, loaded list versions
+// and the screening status of customers and payees.
+type SanctionsRepositoryInterface interface {
+	CreateMatch(ctx context.Context, match *models.SanctionsMatch) error
+	UpdateMatch(ctx context.Context, match *models.SanctionsMatch) error
+	GetMatchByID(ctx context.Context, id uuid.UUID) (*models.SanctionsMatch, error)
+	ListMatches(ctx context.Context, filters models.SanctionsMatchFilters, offset, limit int) ([]models.SanctionsMatch, int64, error)
+	ListMatchesForSubject(ctx context.Context, subjectType string, subjectID uuid.UUID) ([]models.SanctionsMatch, error)
+	UpdateSubjectStatus(ctx context.Context, subjectType string, subjectID uuid.UUID, status string) error
+	ListCustomersAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.User, error)
+	ListExternalAccountsAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.ExternalAccount, error)
+	GetListLoad(ctx context.Context, version string) (*models.SanctionsListLoad, error)
+	GetLatestListLoad(ctx context.Context) (*models.SanctionsListLoad, error)
+	SaveListLoad(ctx context.Context, load *models.SanctionsListLoad) error
+}
+
+// TransferReviewRepositoryInterface defines the contract for the manual review queue of held
+// transfers, including the atomic hold, approval and rejection of the reserved funds.
+type TransferReviewRepositoryInterface interface {
+	CreateHeld(ctx context.Context, transfer *models.Transfer, review *models.TransferReview, debitDescription string) error
+	Approve(ctx context.Context, reviewID, adminID uuid.UUID, note string) (*models.TransferReview, error)
+	Reject(ctx context.Context, reviewID, adminID uuid.UUID, note, reason, reversalDescription string) (*models.TransferReview, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.TransferReview, error)
+	GetByTransferID(ctx context.Context, transferID uuid.UUID) (*models.TransferReview, error)
+	List(ctx context.Context, filters models.TransferReviewFilters, offset, limit int) ([]models.TransferReview, int64, error)
+	CountReleasedTransfersToExternalAccount(ctx context.Context, externalAccountID uuid.UUID) (int64, error)
+	ListRecentTransfers(ctx context.Context, userID uuid.UUID, limit int) ([]models.Transfer, error)
+	ListRecentTransactions(ctx context.Context, userID uuid.UUID, limit int) ([]models.Transaction, error)
+}
+
+// JobRepositoryInterface defines the contract for the run history and operator state of scheduled
+// background jobs.
+type JobRepositoryInterface interface {
+	CreateRun(ctx context.Context, run *models.JobRun) error
+	FinishRun(ctx context.Context, run *models.JobRun) error
+	ListRuns(ctx context.Context, jobName string, offset, limit int) ([]models.JobRun, int64, error)
+	LatestRuns(ctx context.Context) (map[string]*models.JobRun, error)
+	AbandonRunsStartedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	DeleteRunsBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	GetStates(ctx context.Context) (map[string]*models.ScheduledJob, error)
+	SetPaused(ctx context.Context, jobName string, paused bool, adminID uuid.UUID) (*models.ScheduledJob, error)
 }
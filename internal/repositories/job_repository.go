@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type jobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) JobRepositoryInterface {
+	return &jobRepository{db: db}
+}
+
+// CreateRun records the start of a job run.
+func (r *jobRepository) CreateRun(ctx context.Context, run *models.JobRun) error {
+	if err := r.db.WithContext(ctx).Create(run).Error; err != nil {
+		return fmt.Errorf("failed to create job run: %w", err)
+	}
+	return nil
+}
+
+// FinishRun records the outcome of a job run.
+func (r *jobRepository) FinishRun(ctx context.Context, run *models.JobRun) error {
+	err := r.db.WithContext(ctx).Model(&models.JobRun{ID: run.ID}).Updates(map[string]interface{}{
+		"status":      run.Status,
+		"finished_at": run.FinishedAt,
+		"duration_ms": run.DurationMs,
+		"counts":      run.Counts,
+		"error":       run.Error,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to finish job run: %w", err)
+	}
+	return nil
+}
+
+// ListRuns returns a page of the job's runs, newest first, with the total count.
+func (r *jobRepository) ListRuns(ctx context.Context, jobName string, offset, limit int) ([]models.JobRun, int64, error) {
+	var runs []models.JobRun
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.JobRun{}).Where("job_name = ?", jobName)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count job runs: %w", err)
+	}
+	if err := query.Order("started_at DESC").Offset(offset).Limit(limit).Find(&runs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list job runs: %w", err)
+	}
+	return runs, total, nil
+}
+
+// LatestRuns returns the most recent run of each job that has run, keyed by job name.
+func (r *jobRepository) LatestRuns(ctx context.Context) (map[string]*models.JobRun, error) {
+	var runs []models.JobRun
+	err := r.db.WithContext(ctx).
+		Where("started_at = (SELECT MAX(latest.started_at) FROM job_runs latest WHERE latest.job_name = job_runs.job_name)").
+		Find(&runs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find latest job runs: %w", err)
+	}
+
+	latest := make(map[string]*models.JobRun, len(runs))
+	for i := range runs {
+		latest[runs[i].JobName] = &runs[i]
+	}
+	return latest, nil
+}
+
+// AbandonRunsStartedBefore marks runs still running that started before the cutoff as abandoned.
+func (r *jobRepository) AbandonRunsStartedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.JobRun{}).
+		Where("status = ? AND started_at < ?", models.JobRunStatusRunning, cutoff).
+		Updates(map[string]interface{}{
+			"status":      models.JobRunStatusAbandoned,
+			"finished_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to abandon job runs: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// DeleteRunsBefore deletes finished runs that started before the cutoff.
+func (r *jobRepository) DeleteRunsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("status <> ? AND started_at < ?", models.JobRunStatusRunning, cutoff).
+		Delete(&models.JobRun{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete job runs: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetStates returns the operator state of every job that has one, keyed by job name.
+func (r *jobRepository) GetStates(ctx context.Context) (map[string]*models.ScheduledJob, error) {
+	var jobs []models.ScheduledJob
+	if err := r.db.WithContext(ctx).Find(&jobs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get scheduled job states: %w", err)
+	}
+
+	states := make(map[string]*models.ScheduledJob, len(jobs))
+	for i := range jobs {
+		states[jobs[i].Name] = &jobs[i]
+	}
+	return states, nil
+}
+
+// SetPaused pauses or resumes the job, recording the admin who paused it.
+func (r *jobRepository) SetPaused(ctx context.Context, jobName string, paused bool, adminID uuid.UUID) (*models.ScheduledJob, error) {
+	job := &models.ScheduledJob{Name: jobName, Paused: paused}
+	if paused {
+		now := time.Now()
+		job.PausedBy = &adminID
+		job.PausedAt = &now
+	}
+
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(job).Error; err != nil {
+		return nil, fmt.Errorf("failed to save scheduled job state: %w", err)
+	}
+	return job, nil
+}
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type JobRepositoryTestSuite struct {
+	suite.Suite
+	db   *database.DB
+	repo JobRepositoryInterface
+}
+
+func (s *JobRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewJobRepository(s.db.DB)
+}
+
+func (s *JobRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestJobRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(JobRepositoryTestSuite))
+}
+
+func (s *JobRepositoryTestSuite) createRun(jobName, status string, startedAt time.Time) *models.JobRun {
+	run := &models.JobRun{
+		JobName:   jobName,
+		Trigger:   models.JobRunTriggerSchedule,
+		Instance:  "api-1",
+		Status:    status,
+		StartedAt: startedAt,
+	}
+	s.Require().NoError(s.repo.CreateRun(context.Background(), run))
+	return run
+}
+
+func (s *JobRepositoryTestSuite) TestFinishRun_RecordsOutcome() {
+	run := s.createRun("outbox-relay", models.JobRunStatusRunning, time.Now())
+
+	finishedAt := time.Now()
+	durationMs := int64(42)
+	run.Status = models.JobRunStatusSucceeded
+	run.FinishedAt = &finishedAt
+	run.DurationMs = &durationMs
+	run.Counts = models.JSONBMap{"delivered": 12}
+	s.Require().NoError(s.repo.FinishRun(context.Background(), run))
+
+	runs, total, err := s.repo.ListRuns(context.Background(), "outbox-relay", 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Require().Len(runs, 1)
+	s.Equal(models.JobRunStatusSucceeded, runs[0].Status)
+	s.Equal(int64(42), *runs[0].DurationMs)
+	s.Equal(float64(12), runs[0].Counts["delivered"])
+}
+
+func (s *JobRepositoryTestSuite) TestListRuns_NewestFirstForJob() {
+	now := time.Now()
+	older := s.createRun("outbox-relay", models.JobRunStatusSucceeded, now.Add(-time.Minute))
+	newer := s.createRun("outbox-relay", models.JobRunStatusFailed, now)
+	s.createRun("transfer-monitor", models.JobRunStatusSucceeded, now)
+
+	runs, total, err := s.repo.ListRuns(context.Background(), "outbox-relay", 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+	s.Require().Len(runs, 2)
+	s.Equal(newer.ID, runs[0].ID)
+	s.Equal(older.ID, runs[1].ID)
+}
+
+func (s *JobRepositoryTestSuite) TestLatestRuns_OnePerJob() {
+	now := time.Now()
+	s.createRun("outbox-relay", models.JobRunStatusSucceeded, now.Add(-time.Minute))
+	latestRelay := s.createRun("outbox-relay", models.JobRunStatusFailed, now)
+	latestMonitor := s.createRun("transfer-monitor", models.JobRunStatusSucceeded, now.Add(-time.Hour))
+
+	latest, err := s.repo.LatestRuns(context.Background())
+	s.Require().NoError(err)
+	s.Len(latest, 2)
+	s.Equal(latestRelay.ID, latest["outbox-relay"].ID)
+	s.Equal(latestMonitor.ID, latest["transfer-monitor"].ID)
+}
+
+func (s *JobRepositoryTestSuite) TestAbandonAndDeleteOldRuns() {
+	now := time.Now()
+	s.createRun("outbox-relay", models.JobRunStatusRunning, now.Add(-48*time.Hour))
+	s.createRun("outbox-relay", models.JobRunStatusSucceeded, now.Add(-48*time.Hour))
+	running := s.createRun("outbox-relay", models.JobRunStatusRunning, now)
+
+	abandoned, err := s.repo.AbandonRunsStartedBefore(context.Background(), now.Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Equal(int64(1), abandoned)
+
+	deleted, err := s.repo.DeleteRunsBefore(context.Background(), now.Add(-24*time.Hour))
+	s.Require().NoError(err)
+	s.Equal(int64(2), deleted) // The old run and the abandoned one
+
+	runs, _, err := s.repo.ListRuns(context.Background(), "outbox-relay", 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(runs, 1)
+	s.Equal(running.ID, runs[0].ID)
+}
+
+func (s *JobRepositoryTestSuite) TestSetPaused_UpsertsState() {
+	adminID := uuid.New()
+
+	job, err := s.repo.SetPaused(context.Background(), "outbox-relay", true, adminID)
+	s.Require().NoError(err)
+	s.True(job.Paused)
+	s.Equal(adminID, *job.PausedBy)
+
+	_, err = s.repo.SetPaused(context.Background(), "outbox-relay", false, adminID)
+	s.Require().NoError(err)
+
+	states, err := s.repo.GetStates(context.Background())
+	s.Require().NoError(err)
+	s.Require().Contains(states, "outbox-relay")
+	s.False(states["outbox-relay"].Paused)
+	s.Nil(states["outbox-relay"].PausedBy)
+}
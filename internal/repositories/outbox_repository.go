@@ -0,0 +1,200 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrOutboxCheckpointMoved    = errors.New("outbox checkpoint moved past this position")
+	ErrOutboxDeadLetterNotFound = errors.New("outbox dead letter not found")
+	ErrOutboxDeadLetterReplayed = errors.New("outbox dead letter already replayed")
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepositoryInterface {
+	return &outboxRepository{db: db}
+}
+
+// Append writes events outside of any other change. State changes should instead write their
+// events through the repository method that performs the change, so both commit together.
+func (r *outboxRepository) Append(ctx context.Context, events ...*models.OutboxEvent) error {
+	return appendOutboxEvents(r.db.WithContext(ctx), events)
+}
+
+// FindAfter returns up to limit events after the given sequence, in order.
+func (r *outboxRepository) FindAfter(ctx context.Context, afterID int64, limit int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	if err := r.db.WithContext(ctx).Where("id > ?", afterID).Order("id ASC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to find outbox events: %w", err)
+	}
+	return events, nil
+}
+
+// ListAfter returns a page of events after the given sequence with the total count, in order.
+func (r *outboxRepository) ListAfter(ctx context.Context, afterID int64, offset, limit int) ([]models.OutboxEvent, int64, error) {
+	var events []models.OutboxEvent
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("id > ?", afterID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count outbox events: %w", err)
+	}
+	if err := query.Order("id ASC").Offset(offset).Limit(limit).Find(&events).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+	return events, total, nil
+}
+
+// CountAfter returns how many events follow the given sequence.
+func (r *outboxRepository) CountAfter(ctx context.Context, afterID int64) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("id > ?", afterID).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count outbox events: %w", err)
+	}
+	return count, nil
+}
+
+// GetCheckpoint returns the consumer's checkpoint, creating it at the start of the log if the
+// consumer has never run.
+func (r *outboxRepository) GetCheckpoint(ctx context.Context, consumer string) (*models.OutboxCheckpoint, error) {
+	checkpoint := models.OutboxCheckpoint{Consumer: consumer}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&checkpoint).Error; err != nil {
+		return nil, fmt.Errorf("failed to create outbox checkpoint: %w", err)
+	}
+	if err := r.db.WithContext(ctx).First(&checkpoint, "consumer = ?", consumer).Error; err != nil {
+		return nil, fmt.Errorf("failed to find outbox checkpoint: %w", err)
+	}
+	return &checkpoint, nil
+}
+
+// SaveCheckpoint writes the checkpoint unless the stored position is already past it, so a relay
+// run racing an admin skip cannot move a consumer backwards. Returns ErrOutboxCheckpointMoved
+// when nothing was written.
+func (r *outboxRepository) SaveCheckpoint(ctx context.Context, checkpoint *models.OutboxCheckpoint) error {
+	return saveOutboxCheckpoint(r.db.WithContext(ctx), checkpoint, "last_event_id <= ?")
+}
+
+// DeadLetter records the event the consumer stopped retrying and saves the checkpoint that moves
+// past it, in one database transaction. Returns ErrOutboxCheckpointMoved, writing nothing, when
+// the stored checkpoint is already at or past the event.
+func (r *outboxRepository) DeadLetter(ctx context.Context, checkpoint *models.OutboxCheckpoint, deadLetter *models.OutboxDeadLetter) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(deadLetter).Error; err != nil {
+			return fmt.Errorf("failed to create outbox dead letter: %w", err)
+		}
+		return saveOutboxCheckpoint(tx, checkpoint, "last_event_id < ?")
+	})
+}
+
+// ListDeadLetters returns a page of the consumer's dead letters that have not been replayed, with
+// their events, oldest first.
+func (r *outboxRepository) ListDeadLetters(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxDeadLetter, int64, error) {
+	var deadLetters []models.OutboxDeadLetter
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.OutboxDeadLetter{}).Where("consumer = ? AND replayed_at IS NULL", consumer)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count outbox dead letters: %w", err)
+	}
+	if err := query.Preload("Event").Order("event_id ASC").Offset(offset).Limit(limit).Find(&deadLetters).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list outbox dead letters: %w", err)
+	}
+	return deadLetters, total, nil
+}
+
+// CountDeadLetters returns how many of the consumer's dead letters have not been replayed.
+func (r *outboxRepository) CountDeadLetters(ctx context.Context, consumer string) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.OutboxDeadLetter{}).
+		Where("consumer = ? AND replayed_at IS NULL", consumer).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count outbox dead letters: %w", err)
+	}
+	return count, nil
+}
+
+// GetDeadLetter returns the consumer's dead letter for the event with the given sequence.
+func (r *outboxRepository) GetDeadLetter(ctx context.Context, consumer string, eventID int64) (*models.OutboxDeadLetter, error) {
+	var deadLetter models.OutboxDeadLetter
+	if err := r.db.WithContext(ctx).Preload("Event").
+		First(&deadLetter, "consumer = ? AND event_id = ?", consumer, eventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOutboxDeadLetterNotFound
+		}
+		return nil, fmt.Errorf("failed to find outbox dead letter: %w", err)
+	}
+	return &deadLetter, nil
+}
+
+// MarkDeadLetterReplayed records a successful replay. Returns ErrOutboxDeadLetterReplayed when a
+// concurrent replay recorded it first.
+func (r *outboxRepository) MarkDeadLetterReplayed(ctx context.Context, deadLetter *models.OutboxDeadLetter) error {
+	result := r.db.WithContext(ctx).Model(&models.OutboxDeadLetter{}).
+		Where("consumer = ? AND event_id = ? AND replayed_at IS NULL", deadLetter.Consumer, deadLetter.EventID).
+		Updates(map[string]interface{}{
+			"replayed_by": deadLetter.ReplayedBy,
+			"replayed_at": deadLetter.ReplayedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update outbox dead letter: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrOutboxDeadLetterReplayed
+	}
+	return nil
+}
+
+// ListCheckpoints returns every consumer checkpoint ordered by consumer name.
+func (r *outboxRepository) ListCheckpoints(ctx context.Context) ([]models.OutboxCheckpoint, error) {
+	var checkpoints []models.OutboxCheckpoint
+	if err := r.db.WithContext(ctx).Order("consumer ASC").Find(&checkpoints).Error; err != nil {
+		return nil, fmt.Errorf("failed to list outbox checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+// saveOutboxCheckpoint writes the checkpoint if the stored position satisfies position, a condition
+// on last_event_id compared with the new position.
+func saveOutboxCheckpoint(tx *gorm.DB, checkpoint *models.OutboxCheckpoint, position string) error {
+	checkpoint.UpdatedAt = time.Now()
+	result := tx.Model(&models.OutboxCheckpoint{}).
+		Where("consumer = ?", checkpoint.Consumer).
+		Where(position, checkpoint.LastEventID).
+		Updates(map[string]interface{}{
+			"last_event_id":   checkpoint.LastEventID,
+			"attempts":        checkpoint.Attempts,
+			"last_error":      checkpoint.LastError,
+			"next_attempt_at": checkpoint.NextAttemptAt,
+			"updated_at":      checkpoint.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to save outbox checkpoint: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrOutboxCheckpointMoved
+	}
+	return nil
+}
+
+// appendOutboxEvents inserts events using the given handle, which is a transaction when called
+// from a repository method that also writes the state change the events describe.
+func appendOutboxEvents(tx *gorm.DB, events []*models.OutboxEvent) error {
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		if err := tx.Create(event).Error; err != nil {
+			return fmt.Errorf("failed to write outbox event: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+	db   *database.DB
+	repo OutboxRepositoryInterface
+}
+
+func (s *OutboxRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewOutboxRepository(s.db.DB)
+}
+
+func (s *OutboxRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestOutboxRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
+
+func (s *OutboxRepositoryTestSuite) appendEvents(n int) []*models.OutboxEvent {
+	events := make([]*models.OutboxEvent, n)
+	for i := range events {
+		events[i] = &models.OutboxEvent{
+			EventType:     models.EventTypeTransferCompleted,
+			AggregateType: models.AggregateTypeTransfer,
+			AggregateID:   uuid.New(),
+			Payload:       models.JSONBMap{"status": models.TransferStatusCompleted},
+		}
+	}
+	s.Require().NoError(s.repo.Append(context.Background(), events...))
+	return events
+}
+
+func (s *OutboxRepositoryTestSuite) TestAppend_AssignsSequenceAndEventID() {
+	events := s.appendEvents(2)
+
+	s.NotEqual(uuid.Nil, events[0].EventID)
+	s.NotEqual(events[0].EventID, events[1].EventID)
+	s.Greater(events[1].ID, events[0].ID)
+}
+
+func (s *OutboxRepositoryTestSuite) TestFindAfter_ReturnsEventsInOrder() {
+	events := s.appendEvents(3)
+
+	found, err := s.repo.FindAfter(context.Background(), events[0].ID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(found, 2)
+	s.Equal(events[1].ID, found[0].ID)
+	s.Equal(events[2].ID, found[1].ID)
+	s.Equal(models.TransferStatusCompleted, found[0].Payload["status"])
+
+	limited, err := s.repo.FindAfter(context.Background(), 0, 1)
+	s.Require().NoError(err)
+	s.Require().Len(limited, 1)
+	s.Equal(events[0].ID, limited[0].ID)
+}
+
+func (s *OutboxRepositoryTestSuite) TestListAfterAndCountAfter() {
+	events := s.appendEvents(5)
+
+	page, total, err := s.repo.ListAfter(context.Background(), events[1].ID, 1, 2)
+	s.Require().NoError(err)
+	s.Equal(int64(3), total)
+	s.Require().Len(page, 2)
+	s.Equal(events[3].ID, page[0].ID)
+	s.Equal(events[4].ID, page[1].ID)
+
+	count, err := s.repo.CountAfter(context.Background(), events[1].ID)
+	s.Require().NoError(err)
+	s.Equal(int64(3), count)
+}
+
+func (s *OutboxRepositoryTestSuite) TestGetCheckpoint_CreatesAtStartOfLog() {
+	checkpoint, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	s.Equal("audit", checkpoint.Consumer)
+	s.Zero(checkpoint.LastEventID)
+	s.Zero(checkpoint.Attempts)
+
+	again, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	s.Equal(checkpoint.Consumer, again.Consumer)
+
+	checkpoints, err := s.repo.ListCheckpoints(context.Background())
+	s.Require().NoError(err)
+	s.Len(checkpoints, 1)
+}
+
+func (s *OutboxRepositoryTestSuite) TestSaveCheckpoint_PersistsProgressPerConsumer() {
+	checkpoint, err := s.repo.GetCheckpoint(context.Background(), "metrics")
+	s.Require().NoError(err)
+
+	message := "connection refused"
+	next := time.Now().Add(time.Minute)
+	checkpoint.LastEventID = 7
+	checkpoint.Attempts = 2
+	checkpoint.LastError = &message
+	checkpoint.NextAttemptAt = &next
+	s.Require().NoError(s.repo.SaveCheckpoint(context.Background(), checkpoint))
+
+	stored, err := s.repo.GetCheckpoint(context.Background(), "metrics")
+	s.Require().NoError(err)
+	s.Equal(int64(7), stored.LastEventID)
+	s.Equal(2, stored.Attempts)
+	s.Equal(message, *stored.LastError)
+	s.NotNil(stored.NextAttemptAt)
+
+	other, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	s.Zero(other.LastEventID, "checkpoints are independent")
+
+	checkpoints, err := s.repo.ListCheckpoints(context.Background())
+	s.Require().NoError(err)
+	s.Require().Len(checkpoints, 2)
+	s.Equal("audit", checkpoints[0].Consumer)
+}
+
+func (s *OutboxRepositoryTestSuite) TestSaveCheckpoint_DoesNotMoveBackwards() {
+	checkpoint, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	checkpoint.LastEventID = 5
+	s.Require().NoError(s.repo.SaveCheckpoint(context.Background(), checkpoint))
+
+	stale := &models.OutboxCheckpoint{Consumer: "audit", LastEventID: 4}
+	s.ErrorIs(s.repo.SaveCheckpoint(context.Background(), stale), ErrOutboxCheckpointMoved)
+
+	stored, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	s.Equal(int64(5), stored.LastEventID)
+}
+
+func (s *OutboxRepositoryTestSuite) TestDeadLetter_AdvancesCheckpoint() {
+	events := s.appendEvents(2)
+	checkpoint, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+
+	message := "payload rejected"
+	checkpoint.LastEventID = events[0].ID
+	s.Require().NoError(s.repo.DeadLetter(context.Background(), checkpoint, &models.OutboxDeadLetter{
+		Consumer:  "audit",
+		EventID:   events[0].ID,
+		Reason:    models.OutboxDeadLetterReasonMaxAttempts,
+		Attempts:  10,
+		LastError: &message,
+	}))
+
+	stored, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	s.Equal(events[0].ID, stored.LastEventID)
+
+	deadLetter, err := s.repo.GetDeadLetter(context.Background(), "audit", events[0].ID)
+	s.Require().NoError(err)
+	s.Equal(10, deadLetter.Attempts)
+	s.Equal(message, *deadLetter.LastError)
+	s.Equal(events[0].EventID, deadLetter.Event.EventID)
+
+	count, err := s.repo.CountDeadLetters(context.Background(), "audit")
+	s.Require().NoError(err)
+	s.Equal(int64(1), count)
+	count, err = s.repo.CountDeadLetters(context.Background(), "metrics")
+	s.Require().NoError(err)
+	s.Zero(count, "dead letters are per consumer")
+}
+
+func (s *OutboxRepositoryTestSuite) TestDeadLetter_CheckpointAlreadyPastEvent() {
+	events := s.appendEvents(1)
+	checkpoint, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	checkpoint.LastEventID = events[0].ID
+	s.Require().NoError(s.repo.SaveCheckpoint(context.Background(), checkpoint))
+
+	err = s.repo.DeadLetter(context.Background(), checkpoint, &models.OutboxDeadLetter{
+		Consumer: "audit",
+		EventID:  events[0].ID,
+		Reason:   models.OutboxDeadLetterReasonSkipped,
+	})
+	s.ErrorIs(err, ErrOutboxCheckpointMoved)
+
+	_, err = s.repo.GetDeadLetter(context.Background(), "audit", events[0].ID)
+	s.ErrorIs(err, ErrOutboxDeadLetterNotFound, "the dead letter is rolled back with the checkpoint")
+}
+
+func (s *OutboxRepositoryTestSuite) TestListDeadLettersAndMarkReplayed() {
+	events := s.appendEvents(2)
+	checkpoint, err := s.repo.GetCheckpoint(context.Background(), "audit")
+	s.Require().NoError(err)
+	for _, event := range events {
+		checkpoint.LastEventID = event.ID
+		s.Require().NoError(s.repo.DeadLetter(context.Background(), checkpoint, &models.OutboxDeadLetter{
+			Consumer: "audit",
+			EventID:  event.ID,
+			Reason:   models.OutboxDeadLetterReasonSkipped,
+		}))
+	}
+
+	deadLetters, total, err := s.repo.ListDeadLetters(context.Background(), "audit", 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+	s.Require().Len(deadLetters, 2)
+	s.Equal(events[0].ID, deadLetters[0].EventID)
+	s.Equal(events[0].EventID, deadLetters[0].Event.EventID)
+
+	adminID := uuid.New()
+	now := time.Now()
+	deadLetters[0].ReplayedBy = &adminID
+	deadLetters[0].ReplayedAt = &now
+	s.Require().NoError(s.repo.MarkDeadLetterReplayed(context.Background(), &deadLetters[0]))
+	s.ErrorIs(s.repo.MarkDeadLetterReplayed(context.Background(), &deadLetters[0]), ErrOutboxDeadLetterReplayed)
+
+	deadLetters, total, err = s.repo.ListDeadLetters(context.Background(), "audit", 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total, "replayed dead letters are no longer listed")
+	s.Require().Len(deadLetters, 1)
+	s.Equal(events[1].ID, deadLetters[0].EventID)
+
+	replayed, err := s.repo.GetDeadLetter(context.Background(), "audit", events[0].ID)
+	s.Require().NoError(err)
+	s.Equal(&adminID, replayed.ReplayedBy)
+	s.NotNil(replayed.ReplayedAt)
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -8,10 +9,15 @@ import (
 	"github.com/array/banking-api/internal/models"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
 	ErrQueueItemNotFound = errors.New("queue item not found")
+	ErrQueueLeaseLost    = errors.New("queue item lease is no longer held by this worker")
+	// ErrQueueItemStateChanged is returned when an operator action lost a race with a worker or
+	// another operator and the item is no longer in the status the action expected.
+	ErrQueueItemStateChanged = errors.New("queue item status changed")
 )
 
 type processingQueueRepository struct {
@@ -24,167 +30,368 @@ func NewProcessingQueueRepository(db *gorm.DB) ProcessingQueueRepositoryInterfac
 	}
 }
 
-func (r *processingQueueRepository) Enqueue(transactionID uuid.UUID, operation string, priority int) error {
+func (r *processingQueueRepository) Enqueue(ctx context.Context, transactionID uuid.UUID, operation string, priority int) error {
 	item := &models.ProcessingQueueItem{
 		TransactionID: transactionID,
 		Operation:     operation,
 		Priority:      priority,
 	}
 
-	if err := r.db.Create(item).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(item).Error; err != nil {
 		return fmt.Errorf("failed to enqueue transaction: %w", err)
 	}
 
 	return nil
 }
 
-func (r *processingQueueRepository) FetchPending(limit int) ([]*models.ProcessingQueueItem, error) {
+// ClaimPending atomically claims up to limit due items for the worker, highest priority first.
+// Rows another worker is claiming are skipped rather than waited on, and the conditional update
+// guarantees an item is only ever handed to one worker. Claimed items hold a lease until
+// now+lease, which the worker extends while processing.
+func (r *processingQueueRepository) ClaimPending(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*models.ProcessingQueueItem, error) {
 	var items []*models.ProcessingQueueItem
 
-	err := r.db.Where("status = ? AND scheduled_at <= ?", models.QueueStatusPending, time.Now()).
-		Order("priority DESC, scheduled_at ASC").
-		Limit(limit).
-		Find(&items).Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		var ids []uuid.UUID
+		if err := tx.Model(&models.ProcessingQueueItem{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND scheduled_at <= ?", models.QueueStatusPending, now).
+			Order("priority DESC, scheduled_at ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
 
+		if err := tx.Model(&models.ProcessingQueueItem{}).
+			Where("id IN ? AND status = ?", ids, models.QueueStatusPending).
+			Updates(map[string]interface{}{
+				"status":           models.QueueStatusProcessing,
+				"locked_by":        workerID,
+				"lease_expires_at": now.Add(lease),
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id IN ? AND status = ? AND locked_by = ?", ids, models.QueueStatusProcessing, workerID).
+			Order("priority DESC, scheduled_at ASC").
+			Find(&items).Error
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch pending items: %w", err)
+		return nil, fmt.Errorf("failed to claim pending items: %w", err)
 	}
 
 	return items, nil
 }
 
-func (r *processingQueueRepository) MarkProcessing(queueItemID uuid.UUID) error {
-	result := r.db.Model(&models.ProcessingQueueItem{ID: queueItemID}).
-		Update("status", models.QueueStatusProcessing)
+// ExtendLease pushes the lease of an item the worker is processing to now+lease. It returns
+// ErrQueueLeaseLost when the item is no longer processing under this worker, for example after
+// the reaper returned it to the queue.
+func (r *processingQueueRepository) ExtendLease(ctx context.Context, queueItemID uuid.UUID, workerID string, lease time.Duration) error {
+	result := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
+		Where("id = ? AND status = ? AND locked_by = ?", queueItemID, models.QueueStatusProcessing, workerID).
+		Update("lease_expires_at", time.Now().Add(lease))
 
 	if result.Error != nil {
-		return fmt.Errorf("failed to mark item as processing: %w", result.Error)
+		return fmt.Errorf("failed to extend lease: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return ErrQueueItemNotFound
+		return ErrQueueLeaseLost
 	}
 
 	return nil
 }
 
-func (r *processingQueueRepository) MarkCompleted(queueItemID uuid.UUID) error {
-	now := time.Now()
-	result := r.db.Model(&models.ProcessingQueueItem{ID: queueItemID}).
+// ReapExpiredLeases returns processing items whose lease ran out before now to the queue. The
+// worker that claimed them is presumed dead, so the attempt counts as a retry; an item that keeps
+// crashing its worker is failed once it runs out of retries rather than requeued again. It returns
+// the number of items requeued and the items that were failed.
+func (r *processingQueueRepository) ReapExpiredLeases(ctx context.Context, now time.Time) (int64, []*models.ProcessingQueueItem, error) {
+	var (
+		requeued int64
+		failed   []*models.ProcessingQueueItem
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expired := "status = ? AND lease_expires_at < ?"
+
+		var ids []uuid.UUID
+		if err := tx.Model(&models.ProcessingQueueItem{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where(expired+" AND retry_count + 1 >= max_retries", models.QueueStatusProcessing, now).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if err := tx.Model(&models.ProcessingQueueItem{}).
+				Where("id IN ? AND "+expired, ids, models.QueueStatusProcessing, now).
+				Updates(map[string]interface{}{
+					"status":           models.QueueStatusFailed,
+					"error_message":    "lease expired after max retries",
+					"processed_at":     now,
+					"locked_by":        "",
+					"lease_expires_at": nil,
+					"retry_count":      gorm.Expr("retry_count + 1"),
+				}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("id IN ? AND status = ?", ids, models.QueueStatusFailed).
+				Find(&failed).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&models.ProcessingQueueItem{}).
+			Where(expired, models.QueueStatusProcessing, now).
+			Updates(map[string]interface{}{
+				"status":           models.QueueStatusPending,
+				"locked_by":        "",
+				"lease_expires_at": nil,
+				"retry_count":      gorm.Expr("retry_count + 1"),
+			})
+		requeued = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reap expired leases: %w", err)
+	}
+
+	return requeued, failed, nil
+}
+
+func (r *processingQueueRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ProcessingQueueItem, error) {
+	var item models.ProcessingQueueItem
+	if err := r.db.WithContext(ctx).First(&item, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrQueueItemNotFound
+		}
+		return nil, fmt.Errorf("failed to find queue item: %w", err)
+	}
+	return &item, nil
+}
+
+// GetLatestByTransactionID returns the most recently created queue item for a transaction.
+func (r *processingQueueRepository) GetLatestByTransactionID(ctx context.Context, transactionID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	var item models.ProcessingQueueItem
+	if err := r.db.WithContext(ctx).Where("transaction_id = ?", transactionID).
+		Order("created_at DESC").
+		First(&item).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrQueueItemNotFound
+		}
+		return nil, fmt.Errorf("failed to find queue item for transaction: %w", err)
+	}
+	return &item, nil
+}
+
+// List returns queue items matching the filters, oldest first.
+func (r *processingQueueRepository) List(ctx context.Context, filters models.ProcessingQueueFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
+	var items []models.ProcessingQueueItem
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{})
+	if len(filters.Statuses) > 0 {
+		query = query.Where("status IN ?", filters.Statuses)
+	}
+	if filters.TransactionID != nil {
+		query = query.Where("transaction_id = ?", *filters.TransactionID)
+	}
+	if filters.Operation != "" {
+		query = query.Where("operation = ?", filters.Operation)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count queue items: %w", err)
+	}
+
+	if err := query.Order("created_at ASC").Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list queue items: %w", err)
+	}
+
+	return items, total, nil
+}
+
+// Requeue returns an item that is still in expectedStatus to pending at scheduledAt with a fresh
+// set of retries. It returns ErrQueueItemStateChanged when the item moved on in the meantime.
+func (r *processingQueueRepository) Requeue(ctx context.Context, queueItemID uuid.UUID, expectedStatus string, scheduledAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
+		Where("id = ? AND status = ?", queueItemID, expectedStatus).
 		Updates(map[string]interface{}{
-			"status":       models.QueueStatusCompleted,
-			"processed_at": now,
+			"status":        models.QueueStatusPending,
+			"retry_count":   0,
+			"scheduled_at":  scheduledAt,
+			"error_message": "",
+			"processed_at":  nil,
 		})
 
 	if result.Error != nil {
-		return fmt.Errorf("failed to mark item as completed: %w", result.Error)
+		return fmt.Errorf("failed to requeue item: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return ErrQueueItemNotFound
+		return ErrQueueItemStateChanged
 	}
 
 	return nil
 }
 
-func (r *processingQueueRepository) MarkFailed(queueItemID uuid.UUID, errorMessage string) error {
-	now := time.Now()
-	result := r.db.Model(&models.ProcessingQueueItem{ID: queueItemID}).
+// Close moves an item that is still in expectedStatus to a final operator status such as
+// cancelled or dead_lettered. It returns ErrQueueItemStateChanged when the item moved on in the
+// meantime.
+func (r *processingQueueRepository) Close(ctx context.Context, queueItemID uuid.UUID, expectedStatus, status string) error {
+	result := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
+		Where("id = ? AND status = ?", queueItemID, expectedStatus).
 		Updates(map[string]interface{}{
-			"status":        models.QueueStatusFailed,
-			"error_message": errorMessage,
-			"processed_at":  now,
+			"status":       status,
+			"processed_at": time.Now(),
 		})
 
 	if result.Error != nil {
-		return fmt.Errorf("failed to mark item as failed: %w", result.Error)
+		return fmt.Errorf("failed to close queue item: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
-		return ErrQueueItemNotFound
+		return ErrQueueItemStateChanged
 	}
 
 	return nil
 }
 
-func (r *processingQueueRepository) IncrementRetry(queueItemID uuid.UUID) error {
-	item := &models.ProcessingQueueItem{ID: queueItemID}
-	if err := r.db.First(item).Error; err != nil {
+// GetControl returns the queue's runtime controls. A missing row means the queue is running.
+func (r *processingQueueRepository) GetControl(ctx context.Context) (*models.ProcessingQueueControl, error) {
+	control := models.NewProcessingQueueControl()
+	if err := r.db.WithContext(ctx).First(control, "id = ?", control.ID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return ErrQueueItemNotFound
+			return models.NewProcessingQueueControl(), nil
 		}
-		return fmt.Errorf("failed to find queue item: %w", err)
+		return nil, fmt.Errorf("failed to get queue control: %w", err)
 	}
+	return control, nil
+}
 
-	item.RetryCount++
-	item.ScheduledAt = item.CalculateNextScheduledTime()
-	item.Status = models.QueueStatusPending
-
-	if err := r.db.Save(&item).Error; err != nil {
-		return fmt.Errorf("failed to increment retry: %w", err)
+// SaveControl stores the queue's runtime controls.
+func (r *processingQueueRepository) SaveControl(ctx context.Context, control *models.ProcessingQueueControl) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(control).Error; err != nil {
+		return fmt.Errorf("failed to save queue control: %w", err)
 	}
-
 	return nil
 }
 
-func (r *processingQueueRepository) GetPendingCount() (int64, error) {
-	var count int64
-	err := r.db.Model(&models.ProcessingQueueItem{}).
-		Where("status = ?", models.QueueStatusPending).
-		Count(&count).Error
+// MarkCompleted completes an item the worker is processing. It returns ErrQueueLeaseLost when
+// the item is no longer processing under this worker, so a worker whose lease was reaped cannot
+// overwrite the outcome of the worker that claimed the item next.
+func (r *processingQueueRepository) MarkCompleted(ctx context.Context, queueItemID uuid.UUID, workerID string) error {
+	result := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
+		Where("id = ? AND status = ? AND locked_by = ?", queueItemID, models.QueueStatusProcessing, workerID).
+		UpdateColumns(map[string]interface{}{
+			"status":           models.QueueStatusCompleted,
+			"processed_at":     time.Now(),
+			"locked_by":        "",
+			"lease_expires_at": nil,
+		})
 
-	if err != nil {
-		return 0, fmt.Errorf("failed to count pending items: %w", err)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark item as completed: %w", result.Error)
 	}
 
-	return count, nil
+	if result.RowsAffected == 0 {
+		return ErrQueueLeaseLost
+	}
+
+	return nil
 }
 
-func (r *processingQueueRepository) GetProcessingCount() (int64, error) {
-	var count int64
-	err := r.db.Model(&models.ProcessingQueueItem{}).
-		Where("status = ?", models.QueueStatusProcessing).
-		Count(&count).Error
+// MarkFailed fails an item the worker is processing. It returns ErrQueueLeaseLost when the item
+// is no longer processing under this worker.
+func (r *processingQueueRepository) MarkFailed(ctx context.Context, queueItemID uuid.UUID, workerID, errorMessage string) error {
+	result := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
+		Where("id = ? AND status = ? AND locked_by = ?", queueItemID, models.QueueStatusProcessing, workerID).
+		UpdateColumns(map[string]interface{}{
+			"status":           models.QueueStatusFailed,
+			"error_message":    errorMessage,
+			"processed_at":     time.Now(),
+			"locked_by":        "",
+			"lease_expires_at": nil,
+		})
 
-	if err != nil {
-		return 0, fmt.Errorf("failed to count processing items: %w", err)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark item as failed: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrQueueLeaseLost
 	}
 
-	return count, nil
+	return nil
 }
 
-func (r *processingQueueRepository) GetFailedCount() (int64, error) {
-	var count int64
-	err := r.db.Model(&models.ProcessingQueueItem{}).
-		Where("status = ?", models.QueueStatusFailed).
-		Count(&count).Error
+// IncrementRetry returns an item the worker is processing to the queue, scheduled after a backoff
+// that grows with each retry. It returns ErrQueueLeaseLost when the item is no longer processing
+// under this worker, or was retried by someone else since it was read.
+func (r *processingQueueRepository) IncrementRetry(ctx context.Context, queueItemID uuid.UUID, workerID string) error {
+	item := &models.ProcessingQueueItem{}
+	if err := r.db.WithContext(ctx).First(item, "id = ?", queueItemID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrQueueItemNotFound
+		}
+		return fmt.Errorf("failed to find queue item: %w", err)
+	}
+
+	retryCount := item.RetryCount
+	item.RetryCount++
+	result := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
+		Where("id = ? AND status = ? AND locked_by = ? AND retry_count = ?",
+			queueItemID, models.QueueStatusProcessing, workerID, retryCount).
+		UpdateColumns(map[string]interface{}{
+			"status":           models.QueueStatusPending,
+			"retry_count":      item.RetryCount,
+			"scheduled_at":     item.CalculateNextScheduledTime(),
+			"locked_by":        "",
+			"lease_expires_at": nil,
+		})
 
-	if err != nil {
-		return 0, fmt.Errorf("failed to count failed items: %w", err)
+	if result.Error != nil {
+		return fmt.Errorf("failed to increment retry: %w", result.Error)
 	}
 
-	return count, nil
-}
+	if result.RowsAffected == 0 {
+		return ErrQueueLeaseLost
+	}
 
-func (r *processingQueueRepository) GetCompletedCount() (int64, error) {
-	var count int64
-	err := r.db.Model(&models.ProcessingQueueItem{}).
-		Where("status = ?", models.QueueStatusCompleted).
-		Count(&count).Error
+	return nil
+}
 
-	if err != nil {
-		return 0, fmt.Errorf("failed to count completed items: %w", err)
+// CountByStatus returns the number of queue items in each status that has any.
+func (r *processingQueueRepository) CountByStatus(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count queue items by status: %w", err)
 	}
 
-	return count, nil
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
 }
 
-func (r *processingQueueRepository) GetAverageProcessingTime() (float64, error) {
+func (r *processingQueueRepository) GetAverageProcessingTime(ctx context.Context) (float64, error) {
 	var result struct {
 		AvgMs float64
 	}
 
-	err := r.db.Model(&models.ProcessingQueueItem{}).
+	err := r.db.WithContext(ctx).Model(&models.ProcessingQueueItem{}).
 		Select("AVG(EXTRACT(EPOCH FROM (processed_at - created_at)) * 1000) as avg_ms").
 		Where("status = ? AND processed_at IS NOT NULL", models.QueueStatusCompleted).
 		Scan(&result).Error
@@ -196,10 +403,10 @@ func (r *processingQueueRepository) GetAverageProcessingTime() (float64, error)
 	return result.AvgMs, nil
 }
 
-func (r *processingQueueRepository) GetOldestPendingAge() (*string, error) {
+func (r *processingQueueRepository) GetOldestPendingAge(ctx context.Context) (*string, error) {
 	var item models.ProcessingQueueItem
 
-	err := r.db.Where("status = ?", models.QueueStatusPending).
+	err := r.db.WithContext(ctx).Where("status = ?", models.QueueStatusPending).
 		Order("created_at ASC").
 		First(&item).Error
 
@@ -214,10 +421,10 @@ func (r *processingQueueRepository) GetOldestPendingAge() (*string, error) {
 	return &age, nil
 }
 
-func (r *processingQueueRepository) CleanupCompleted(olderThan time.Duration) (int64, error) {
+func (r *processingQueueRepository) CleanupCompleted(ctx context.Context, olderThan time.Duration) (int64, error) {
 	cutoffTime := time.Now().Add(-olderThan)
 
-	result := r.db.Where("status = ? AND processed_at < ?", models.QueueStatusCompleted, cutoffTime).
+	result := r.db.WithContext(ctx).Where("status = ? AND processed_at < ?", models.QueueStatusCompleted, cutoffTime).
 		Delete(&models.ProcessingQueueItem{})
 
 	if result.Error != nil {
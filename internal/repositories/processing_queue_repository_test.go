@@ -0,0 +1,403 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProcessingQueueRepositoryTestSuite struct {
+	suite.Suite
+	db   *database.DB
+	repo ProcessingQueueRepositoryInterface
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	// Every connection to :memory: is a separate database, so competing workers share one
+	sqlDB, err := s.db.DB.DB()
+	s.Require().NoError(err)
+	sqlDB.SetMaxOpenConns(1)
+
+	s.repo = NewProcessingQueueRepository(s.db.DB)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestProcessingQueueRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessingQueueRepositoryTestSuite))
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) enqueue(priority int) uuid.UUID {
+	transactionID := uuid.New()
+	s.Require().NoError(s.repo.Enqueue(context.Background(), transactionID, models.QueueOperationProcess, priority))
+	return transactionID
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) item(id uuid.UUID) models.ProcessingQueueItem {
+	var item models.ProcessingQueueItem
+	s.Require().NoError(s.db.First(&item, "id = ?", id).Error)
+	return item
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestClaimPending_SetsLease() {
+	s.enqueue(models.QueuePriorityNormal)
+	high := s.enqueue(models.QueuePriorityHigh)
+
+	before := time.Now()
+	items, err := s.repo.ClaimPending(context.Background(), "worker-a", 10, 30*time.Second)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Equal(high, items[0].TransactionID) // Highest priority first
+
+	for _, item := range items {
+		s.Equal(models.QueueStatusProcessing, item.Status)
+		s.Equal("worker-a", item.LockedBy)
+		s.Require().NotNil(item.LeaseExpiresAt)
+		s.True(item.LeaseExpiresAt.After(before.Add(29 * time.Second)))
+	}
+
+	items, err = s.repo.ClaimPending(context.Background(), "worker-b", 10, 30*time.Second)
+	s.Require().NoError(err)
+	s.Empty(items)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestClaimPending_RespectsLimitAndSchedule() {
+	for i := 0; i < 3; i++ {
+		s.enqueue(models.QueuePriorityNormal)
+	}
+	future := s.enqueue(models.QueuePriorityHigh)
+	s.Require().NoError(s.db.Model(&models.ProcessingQueueItem{}).
+		Where("transaction_id = ?", future).
+		Update("scheduled_at", time.Now().Add(time.Hour)).Error)
+
+	items, err := s.repo.ClaimPending(context.Background(), "worker-a", 2, time.Minute)
+	s.Require().NoError(err)
+	s.Len(items, 2)
+
+	items, err = s.repo.ClaimPending(context.Background(), "worker-a", 10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	s.NotEqual(future, items[0].TransactionID)
+}
+
+// The test database is SQLite on a single connection, which ignores FOR UPDATE SKIP LOCKED and
+// serializes the workers' transactions. This covers the conditional claim update only; skipping
+// rows another worker has locked is a Postgres behaviour and is not exercised here.
+func (s *ProcessingQueueRepositoryTestSuite) TestClaimPending_CompetingWorkersClaimEachItemOnce() {
+	const itemCount = 60
+	for i := 0; i < itemCount; i++ {
+		s.enqueue(models.QueuePriorityNormal)
+	}
+
+	var (
+		mu      sync.Mutex
+		claimed = make(map[uuid.UUID]string)
+		dupes   []uuid.UUID
+		wg      sync.WaitGroup
+	)
+	for w := 0; w < 5; w++ {
+		workerID := fmt.Sprintf("worker-%d", w)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				items, err := s.repo.ClaimPending(context.Background(), workerID, 4, time.Minute)
+				if err != nil {
+					s.Fail("claim failed", err.Error())
+					return
+				}
+				if len(items) == 0 {
+					return
+				}
+
+				mu.Lock()
+				for _, item := range items {
+					if _, ok := claimed[item.ID]; ok {
+						dupes = append(dupes, item.ID)
+					}
+					claimed[item.ID] = workerID
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Empty(dupes)
+	s.Len(claimed, itemCount)
+	for id, workerID := range claimed {
+		s.Equal(workerID, s.item(id).LockedBy)
+	}
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestExtendLease() {
+	s.enqueue(models.QueuePriorityNormal)
+	items, err := s.repo.ClaimPending(context.Background(), "worker-a", 1, time.Second)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	id := items[0].ID
+
+	s.Require().NoError(s.repo.ExtendLease(context.Background(), id, "worker-a", time.Hour))
+	item := s.item(id)
+	s.True(item.LeaseExpiresAt.After(time.Now().Add(59 * time.Minute)))
+
+	s.ErrorIs(s.repo.ExtendLease(context.Background(), id, "worker-b", time.Hour), ErrQueueLeaseLost)
+
+	s.Require().NoError(s.repo.MarkCompleted(context.Background(), id, "worker-a"))
+	s.ErrorIs(s.repo.ExtendLease(context.Background(), id, "worker-a", time.Hour), ErrQueueLeaseLost)
+	item = s.item(id)
+	s.Empty(item.LockedBy)
+	s.Nil(item.LeaseExpiresAt)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestReapExpiredLeases() {
+	s.enqueue(models.QueuePriorityNormal)
+	s.enqueue(models.QueuePriorityNormal)
+	crashed, err := s.repo.ClaimPending(context.Background(), "worker-crashed", 1, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(crashed, 1)
+	alive, err := s.repo.ClaimPending(context.Background(), "worker-alive", 1, time.Hour)
+	s.Require().NoError(err)
+	s.Require().Len(alive, 1)
+
+	// The crashed worker's lease ran out; the live one is still being heartbeated
+	reaped, failed, err := s.repo.ReapExpiredLeases(context.Background(), time.Now().Add(2*time.Minute))
+	s.Require().NoError(err)
+	s.Equal(int64(1), reaped)
+	s.Empty(failed)
+
+	item := s.item(crashed[0].ID)
+	s.Equal(models.QueueStatusPending, item.Status)
+	s.Equal(1, item.RetryCount)
+	s.Empty(item.LockedBy)
+	s.Nil(item.LeaseExpiresAt)
+	s.Equal(models.QueueStatusProcessing, s.item(alive[0].ID).Status)
+
+	s.ErrorIs(s.repo.ExtendLease(context.Background(), crashed[0].ID, "worker-crashed", time.Minute), ErrQueueLeaseLost)
+
+	reclaimed, err := s.repo.ClaimPending(context.Background(), "worker-alive", 10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(reclaimed, 1)
+	s.Equal(crashed[0].ID, reclaimed[0].ID)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestReapExpiredLeases_FailsItemOutOfRetries() {
+	s.enqueue(models.QueuePriorityNormal)
+
+	// Each claim's worker crashes; the third expired lease uses up the last retry
+	var id uuid.UUID
+	for attempt := 1; attempt <= 3; attempt++ {
+		items, err := s.repo.ClaimPending(context.Background(), "worker-crashing", 1, time.Minute)
+		s.Require().NoError(err)
+		s.Require().Len(items, 1)
+		id = items[0].ID
+
+		reaped, failed, err := s.repo.ReapExpiredLeases(context.Background(), time.Now().Add(2*time.Minute))
+		s.Require().NoError(err)
+		if attempt < 3 {
+			s.Equal(int64(1), reaped)
+			s.Empty(failed)
+			continue
+		}
+		s.Zero(reaped)
+		s.Require().Len(failed, 1)
+		s.Equal(id, failed[0].ID)
+		s.Equal(models.QueueStatusFailed, failed[0].Status)
+	}
+
+	item := s.item(id)
+	s.Equal(models.QueueStatusFailed, item.Status)
+	s.Equal(3, item.RetryCount)
+	s.Equal("lease expired after max retries", item.ErrorMessage)
+	s.NotNil(item.ProcessedAt)
+	s.Empty(item.LockedBy)
+	s.Nil(item.LeaseExpiresAt)
+
+	items, err := s.repo.ClaimPending(context.Background(), "worker-alive", 10, time.Minute)
+	s.Require().NoError(err)
+	s.Empty(items)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestStaleWorkerCannotSettleReclaimedItem() {
+	s.enqueue(models.QueuePriorityNormal)
+	stale, err := s.repo.ClaimPending(context.Background(), "worker-stale", 1, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(stale, 1)
+	id := stale[0].ID
+
+	// The stale worker's lease is reaped and another worker claims the item
+	_, _, err = s.repo.ReapExpiredLeases(context.Background(), time.Now().Add(2*time.Minute))
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.Model(&models.ProcessingQueueItem{}).
+		Where("id = ?", id).
+		Update("scheduled_at", time.Now().Add(-time.Second)).Error)
+	current, err := s.repo.ClaimPending(context.Background(), "worker-current", 1, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(current, 1)
+	s.Require().Equal(id, current[0].ID)
+
+	s.ErrorIs(s.repo.MarkCompleted(context.Background(), id, "worker-stale"), ErrQueueLeaseLost)
+	s.ErrorIs(s.repo.MarkFailed(context.Background(), id, "worker-stale", "boom"), ErrQueueLeaseLost)
+	s.ErrorIs(s.repo.IncrementRetry(context.Background(), id, "worker-stale"), ErrQueueLeaseLost)
+
+	item := s.item(id)
+	s.Equal(models.QueueStatusProcessing, item.Status)
+	s.Equal("worker-current", item.LockedBy)
+	s.Equal(1, item.RetryCount)
+	s.Empty(item.ErrorMessage)
+
+	s.Require().NoError(s.repo.MarkCompleted(context.Background(), id, "worker-current"))
+	item = s.item(id)
+	s.Equal(models.QueueStatusCompleted, item.Status)
+	s.Empty(item.LockedBy)
+	s.Nil(item.LeaseExpiresAt)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestIncrementRetry() {
+	s.enqueue(models.QueuePriorityNormal)
+	items, err := s.repo.ClaimPending(context.Background(), "worker-a", 1, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(items, 1)
+	id := items[0].ID
+
+	before := time.Now()
+	s.Require().NoError(s.repo.IncrementRetry(context.Background(), id, "worker-a"))
+
+	item := s.item(id)
+	s.Equal(models.QueueStatusPending, item.Status)
+	s.Equal(1, item.RetryCount)
+	s.True(item.ScheduledAt.After(before.Add(time.Second)))
+	s.Empty(item.LockedBy)
+	s.Nil(item.LeaseExpiresAt)
+
+	// The item is back in the queue, so a second retry of the same attempt is refused
+	s.ErrorIs(s.repo.IncrementRetry(context.Background(), id, "worker-a"), ErrQueueLeaseLost)
+	s.ErrorIs(s.repo.IncrementRetry(context.Background(), uuid.New(), "worker-a"), ErrQueueItemNotFound)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) setStatus(transactionID uuid.UUID, status string) uuid.UUID {
+	var item models.ProcessingQueueItem
+	s.Require().NoError(s.db.First(&item, "transaction_id = ?", transactionID).Error)
+	s.Require().NoError(s.db.Model(&item).Update("status", status).Error)
+	return item.ID
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestListAndCountByStatus() {
+	failedTx := s.enqueue(models.QueuePriorityNormal)
+	failed := s.setStatus(failedTx, models.QueueStatusFailed)
+	s.setStatus(s.enqueue(models.QueuePriorityNormal), models.QueueStatusDeadLettered)
+	s.enqueue(models.QueuePriorityNormal)
+
+	items, total, err := s.repo.List(context.Background(), models.ProcessingQueueFilters{
+		Statuses: []string{models.QueueStatusFailed, models.QueueStatusDeadLettered},
+	}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+	s.Len(items, 2)
+
+	items, total, err = s.repo.List(context.Background(), models.ProcessingQueueFilters{TransactionID: &failedTx, Operation: models.QueueOperationProcess}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(failed, items[0].ID)
+
+	counts, err := s.repo.CountByStatus(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(1), counts[models.QueueStatusPending])
+	s.Equal(int64(1), counts[models.QueueStatusFailed])
+	s.Equal(int64(1), counts[models.QueueStatusDeadLettered])
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestGetLatestByTransactionID() {
+	transactionID := s.enqueue(models.QueuePriorityNormal)
+	first := s.setStatus(transactionID, models.QueueStatusFailed)
+	s.Require().NoError(s.db.Model(&models.ProcessingQueueItem{}).Where("id = ?", first).
+		Update("created_at", time.Now().Add(-time.Minute)).Error)
+	s.Require().NoError(s.repo.Enqueue(context.Background(), transactionID, models.QueueOperationProcess, models.QueuePriorityNormal))
+
+	item, err := s.repo.GetLatestByTransactionID(context.Background(), transactionID)
+	s.Require().NoError(err)
+	s.NotEqual(first, item.ID)
+	s.Equal(models.QueueStatusPending, item.Status)
+
+	_, err = s.repo.GetLatestByTransactionID(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrQueueItemNotFound)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestRequeue() {
+	id := s.setStatus(s.enqueue(models.QueuePriorityNormal), models.QueueStatusFailed)
+	s.Require().NoError(s.db.Model(&models.ProcessingQueueItem{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"retry_count": 3, "error_message": "max retries exceeded", "processed_at": time.Now()}).Error)
+
+	scheduledAt := time.Now().Add(-time.Second)
+	s.Require().NoError(s.repo.Requeue(context.Background(), id, models.QueueStatusFailed, scheduledAt))
+
+	item := s.item(id)
+	s.Equal(models.QueueStatusPending, item.Status)
+	s.Zero(item.RetryCount)
+	s.Empty(item.ErrorMessage)
+	s.Nil(item.ProcessedAt)
+
+	// The status moved on, so a second requeue expecting failed loses the race
+	s.ErrorIs(s.repo.Requeue(context.Background(), id, models.QueueStatusFailed, scheduledAt), ErrQueueItemStateChanged)
+
+	claimed, err := s.repo.ClaimPending(context.Background(), "worker-a", 10, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	s.Equal(id, claimed[0].ID)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestClose_NotClaimed() {
+	cancelledTx := s.enqueue(models.QueuePriorityHigh)
+	var item models.ProcessingQueueItem
+	s.Require().NoError(s.db.First(&item, "transaction_id = ?", cancelledTx).Error)
+
+	s.Require().NoError(s.repo.Close(context.Background(), item.ID, models.QueueStatusPending, models.QueueStatusCancelled))
+	closed := s.item(item.ID)
+	s.Equal(models.QueueStatusCancelled, closed.Status)
+	s.NotNil(closed.ProcessedAt)
+
+	s.ErrorIs(s.repo.Close(context.Background(), item.ID, models.QueueStatusPending, models.QueueStatusDeadLettered), ErrQueueItemStateChanged)
+
+	claimed, err := s.repo.ClaimPending(context.Background(), "worker-a", 10, time.Minute)
+	s.Require().NoError(err)
+	s.Empty(claimed)
+}
+
+func (s *ProcessingQueueRepositoryTestSuite) TestControl() {
+	control, err := s.repo.GetControl(context.Background())
+	s.Require().NoError(err)
+	s.False(control.Paused)
+
+	adminID := uuid.New()
+	now := time.Now()
+	control.Paused = true
+	control.PausedBy = &adminID
+	control.PausedAt = &now
+	control.PauseReason = "Ledger migration"
+	s.Require().NoError(s.repo.SaveControl(context.Background(), control))
+
+	control, err = s.repo.GetControl(context.Background())
+	s.Require().NoError(err)
+	s.True(control.Paused)
+	s.Equal(&adminID, control.PausedBy)
+	s.Equal("Ledger migration", control.PauseReason)
+
+	control.Paused = false
+	control.PausedBy = nil
+	s.Require().NoError(s.repo.SaveControl(context.Background(), control))
+
+	control, err = s.repo.GetControl(context.Background())
+	s.Require().NoError(err)
+	s.False(control.Paused)
+	s.Nil(control.PausedBy)
+}
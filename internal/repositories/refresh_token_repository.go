@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -27,12 +28,12 @@ func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepositoryInterface {
 }
 
 // Create creates a new refresh token in the database
-func (r *RefreshTokenRepository) Create(token *models.RefreshToken) error {
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *models.RefreshToken) error {
 	if token == nil {
 		return errors.New("refresh token cannot be nil")
 	}
 
-	if err := r.db.Create(token).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
 		return fmt.Errorf("failed to create refresh token: %w", err)
 	}
 
@@ -40,9 +41,9 @@ func (r *RefreshTokenRepository) Create(token *models.RefreshToken) error {
 }
 
 // GetByID retrieves a refresh token by its ID
-func (r *RefreshTokenRepository) GetByID(id uuid.UUID) (*models.RefreshToken, error) {
+func (r *RefreshTokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RefreshToken, error) {
 	token := &models.RefreshToken{ID: id}
-	if err := r.db.First(token).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(token).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrRefreshTokenNotFound
 		}
@@ -53,10 +54,10 @@ func (r *RefreshTokenRepository) GetByID(id uuid.UUID) (*models.RefreshToken, er
 }
 
 // GetByTokenHash retrieves a refresh token by its hash
-func (r *RefreshTokenRepository) GetByTokenHash(tokenHash string) (*models.RefreshToken, error) {
+func (r *RefreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
 	var token models.RefreshToken
 
-	if err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrRefreshTokenNotFound
 		}
@@ -67,10 +68,10 @@ func (r *RefreshTokenRepository) GetByTokenHash(tokenHash string) (*models.Refre
 }
 
 // GetActiveByUserID retrieves all active refresh tokens for a user
-func (r *RefreshTokenRepository) GetActiveByUserID(userID uuid.UUID) ([]*models.RefreshToken, error) {
+func (r *RefreshTokenRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*models.RefreshToken, error) {
 	var tokens []*models.RefreshToken
 
-	err := r.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+	err := r.db.WithContext(ctx).Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
 		Find(&tokens).Error
 
 	if err != nil {
@@ -81,12 +82,12 @@ func (r *RefreshTokenRepository) GetActiveByUserID(userID uuid.UUID) ([]*models.
 }
 
 // Update updates a refresh token in the database
-func (r *RefreshTokenRepository) Update(token *models.RefreshToken) error {
+func (r *RefreshTokenRepository) Update(ctx context.Context, token *models.RefreshToken) error {
 	if token == nil {
 		return errors.New("refresh token cannot be nil")
 	}
 
-	if err := r.db.Save(token).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(token).Error; err != nil {
 		return fmt.Errorf("failed to update refresh token: %w", err)
 	}
 
@@ -94,10 +95,10 @@ func (r *RefreshTokenRepository) Update(token *models.RefreshToken) error {
 }
 
 // Revoke revokes a specific refresh token
-func (r *RefreshTokenRepository) Revoke(tokenID uuid.UUID) error {
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, tokenID uuid.UUID) error {
 	now := time.Now()
 
-	result := r.db.Model(&models.RefreshToken{ID: tokenID}).
+	result := r.db.WithContext(ctx).Model(&models.RefreshToken{ID: tokenID}).
 		Where("revoked_at IS NULL").
 		Update("revoked_at", now)
 
@@ -113,10 +114,10 @@ func (r *RefreshTokenRepository) Revoke(tokenID uuid.UUID) error {
 }
 
 // RevokeAllForUser revokes all refresh tokens for a specific user
-func (r *RefreshTokenRepository) RevokeAllForUser(userID uuid.UUID) error {
+func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID uuid.UUID) error {
 	now := time.Now()
 
-	if err := r.db.Model(&models.RefreshToken{}).
+	if err := r.db.WithContext(ctx).Model(&models.RefreshToken{}).
 		Where("user_id = ? AND revoked_at IS NULL", userID).
 		Update("revoked_at", now).Error; err != nil {
 		return fmt.Errorf("failed to revoke all tokens for user: %w", err)
@@ -126,8 +127,8 @@ func (r *RefreshTokenRepository) RevokeAllForUser(userID uuid.UUID) error {
 }
 
 // DeleteExpired removes expired refresh tokens from the database
-func (r *RefreshTokenRepository) DeleteExpired() (int64, error) {
-	result := r.db.Where("expires_at < ?", time.Now()).Delete(&models.RefreshToken{})
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&models.RefreshToken{})
 
 	if result.Error != nil {
 		return 0, fmt.Errorf("failed to delete expired tokens: %w", result.Error)
@@ -137,10 +138,10 @@ func (r *RefreshTokenRepository) DeleteExpired() (int64, error) {
 }
 
 // DeleteRevokedOlderThan removes revoked tokens older than the specified duration
-func (r *RefreshTokenRepository) DeleteRevokedOlderThan(duration time.Duration) (int64, error) {
+func (r *RefreshTokenRepository) DeleteRevokedOlderThan(ctx context.Context, duration time.Duration) (int64, error) {
 	cutoffTime := time.Now().Add(-duration)
 
-	result := r.db.Where("revoked_at IS NOT NULL AND revoked_at < ?", cutoffTime).
+	result := r.db.WithContext(ctx).Where("revoked_at IS NOT NULL AND revoked_at < ?", cutoffTime).
 		Delete(&models.RefreshToken{})
 
 	if result.Error != nil {
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"crypto/sha256"
 	"fmt"
 	"testing"
@@ -46,7 +47,7 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_Create() {
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
 
-	err := s.repo.Create(token)
+	err := s.repo.Create(context.Background(), token)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, token.ID)
 	s.NotZero(token.CreatedAt)
@@ -62,11 +63,11 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_GetByTokenHash(
 		TokenHash: tokenHash,
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err := s.repo.Create(token)
+	err := s.repo.Create(context.Background(), token)
 	s.NoError(err)
 
 	// Get by token hash
-	foundToken, err := s.repo.GetByTokenHash(tokenHash)
+	foundToken, err := s.repo.GetByTokenHash(context.Background(), tokenHash)
 	s.NoError(err)
 	s.Equal(token.ID, foundToken.ID)
 	s.Equal(tokenHash, foundToken.TokenHash)
@@ -74,7 +75,7 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_GetByTokenHash(
 	s.False(foundToken.IsRevoked())
 
 	// Try to get non-existent token
-	_, err = s.repo.GetByTokenHash("non-existent-hash")
+	_, err = s.repo.GetByTokenHash(context.Background(), "non-existent-hash")
 	s.Error(err)
 	s.Contains(err.Error(), "not found")
 }
@@ -90,7 +91,7 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_GetActiveByUser
 			TokenHash: s.hashToken(fmt.Sprintf("token.%d", i)),
 			ExpiresAt: time.Now().Add(time.Duration(i+1) * 24 * time.Hour),
 		}
-		err := s.repo.Create(token)
+		err := s.repo.Create(context.Background(), token)
 		s.NoError(err)
 	}
 
@@ -100,10 +101,10 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_GetActiveByUser
 		TokenHash: s.hashToken("revoked.token"),
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err := s.repo.Create(revokedToken)
+	err := s.repo.Create(context.Background(), revokedToken)
 	s.NoError(err)
 	revokedToken.Revoke()
-	err = s.repo.Update(revokedToken)
+	err = s.repo.Update(context.Background(), revokedToken)
 	s.NoError(err)
 
 	// Create token for different user
@@ -112,11 +113,11 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_GetActiveByUser
 		TokenHash: s.hashToken("other.token"),
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err = s.repo.Create(otherToken)
+	err = s.repo.Create(context.Background(), otherToken)
 	s.NoError(err)
 
 	// Get active tokens for first user (should not include revoked)
-	tokens, err := s.repo.GetActiveByUserID(userID)
+	tokens, err := s.repo.GetActiveByUserID(context.Background(), userID)
 	s.NoError(err)
 	s.Len(tokens, 3) // Only the 3 active tokens
 
@@ -127,7 +128,7 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_GetActiveByUser
 	}
 
 	// Get tokens for other user
-	tokens, err = s.repo.GetActiveByUserID(otherUserID)
+	tokens, err = s.repo.GetActiveByUserID(context.Background(), otherUserID)
 	s.NoError(err)
 	s.Len(tokens, 1)
 }
@@ -141,16 +142,16 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_Update() {
 		TokenHash: s.hashToken("test.refresh.token"),
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err := s.repo.Create(token)
+	err := s.repo.Create(context.Background(), token)
 	s.NoError(err)
 
 	// Update token (revoke it)
 	token.Revoke()
-	err = s.repo.Update(token)
+	err = s.repo.Update(context.Background(), token)
 	s.NoError(err)
 
 	// Verify update
-	updatedToken, err := s.repo.GetByTokenHash(token.TokenHash)
+	updatedToken, err := s.repo.GetByTokenHash(context.Background(), token.TokenHash)
 	s.NoError(err)
 	s.True(updatedToken.IsRevoked())
 	s.NotNil(updatedToken.RevokedAt)
@@ -170,7 +171,7 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_RevokeAllForUse
 			TokenHash: hash,
 			ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 		}
-		err := s.repo.Create(token)
+		err := s.repo.Create(context.Background(), token)
 		s.NoError(err)
 	}
 
@@ -181,23 +182,23 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_RevokeAllForUse
 		TokenHash: otherTokenHash,
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err := s.repo.Create(otherToken)
+	err := s.repo.Create(context.Background(), otherToken)
 	s.NoError(err)
 
 	// Revoke all tokens for first user
-	err = s.repo.RevokeAllForUser(userID)
+	err = s.repo.RevokeAllForUser(context.Background(), userID)
 	s.NoError(err)
 
 	// Verify all tokens for first user are revoked
 	for _, hash := range tokenHashes {
-		token, err := s.repo.GetByTokenHash(hash)
+		token, err := s.repo.GetByTokenHash(context.Background(), hash)
 		s.NoError(err)
 		s.True(token.IsRevoked())
 		s.NotNil(token.RevokedAt)
 	}
 
 	// Verify other user's token is not affected
-	otherTokenAfter, err := s.repo.GetByTokenHash(otherTokenHash)
+	otherTokenAfter, err := s.repo.GetByTokenHash(context.Background(), otherTokenHash)
 	s.NoError(err)
 	s.False(otherTokenAfter.IsRevoked())
 	s.Nil(otherTokenAfter.RevokedAt)
@@ -212,7 +213,7 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_DeleteExpired()
 		TokenHash: s.hashToken("expired.token"),
 		ExpiresAt: time.Now().Add(-24 * time.Hour), // Already expired
 	}
-	err := s.repo.Create(expiredToken)
+	err := s.repo.Create(context.Background(), expiredToken)
 	s.NoError(err)
 	expiredHash := expiredToken.TokenHash
 
@@ -222,22 +223,22 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_DeleteExpired()
 		TokenHash: s.hashToken("valid.token"),
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err = s.repo.Create(validToken)
+	err = s.repo.Create(context.Background(), validToken)
 	s.NoError(err)
 	validHash := validToken.TokenHash
 
 	// Delete expired tokens
-	count, err := s.repo.DeleteExpired()
+	count, err := s.repo.DeleteExpired(context.Background())
 	s.NoError(err)
 	s.GreaterOrEqual(count, int64(1))
 
 	// Verify expired token is deleted
-	_, err = s.repo.GetByTokenHash(expiredHash)
+	_, err = s.repo.GetByTokenHash(context.Background(), expiredHash)
 	s.Error(err)
 	s.Contains(err.Error(), "not found")
 
 	// Verify valid token still exists
-	stillValid, err := s.repo.GetByTokenHash(validHash)
+	stillValid, err := s.repo.GetByTokenHash(context.Background(), validHash)
 	s.NoError(err)
 	s.Equal(validHash, stillValid.TokenHash)
 }
@@ -251,11 +252,11 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_DeleteRevokedOl
 		TokenHash: s.hashToken("old.revoked.token"),
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err := s.repo.Create(oldRevokedToken)
+	err := s.repo.Create(context.Background(), oldRevokedToken)
 	s.NoError(err)
 	oldRevokedToken.Revoke()
 	oldRevokedToken.RevokedAt = func(t time.Time) *time.Time { return &t }(time.Now().Add(-48 * time.Hour))
-	err = s.repo.Update(oldRevokedToken)
+	err = s.repo.Update(context.Background(), oldRevokedToken)
 	s.NoError(err)
 	oldRevokedHash := oldRevokedToken.TokenHash
 
@@ -265,10 +266,10 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_DeleteRevokedOl
 		TokenHash: s.hashToken("recent.revoked.token"),
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err = s.repo.Create(recentRevokedToken)
+	err = s.repo.Create(context.Background(), recentRevokedToken)
 	s.NoError(err)
 	recentRevokedToken.Revoke()
-	err = s.repo.Update(recentRevokedToken)
+	err = s.repo.Update(context.Background(), recentRevokedToken)
 	s.NoError(err)
 	recentRevokedHash := recentRevokedToken.TokenHash
 
@@ -278,27 +279,27 @@ func (s *RefreshTokenRepositorySuite) TestRefreshTokenRepository_DeleteRevokedOl
 		TokenHash: s.hashToken("active.token"),
 		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
 	}
-	err = s.repo.Create(activeToken)
+	err = s.repo.Create(context.Background(), activeToken)
 	s.NoError(err)
 	activeHash := activeToken.TokenHash
 
 	// Delete revoked tokens older than 24 hours
-	count, err := s.repo.DeleteRevokedOlderThan(24 * time.Hour)
+	count, err := s.repo.DeleteRevokedOlderThan(context.Background(), 24*time.Hour)
 	s.NoError(err)
 	s.GreaterOrEqual(count, int64(1))
 
 	// Verify old revoked token is deleted
-	_, err = s.repo.GetByTokenHash(oldRevokedHash)
+	_, err = s.repo.GetByTokenHash(context.Background(), oldRevokedHash)
 	s.Error(err)
 	s.Contains(err.Error(), "not found")
 
 	// Verify recent revoked token still exists
-	stillRevoked, err := s.repo.GetByTokenHash(recentRevokedHash)
+	stillRevoked, err := s.repo.GetByTokenHash(context.Background(), recentRevokedHash)
 	s.NoError(err)
 	s.True(stillRevoked.IsRevoked())
 
 	// Verify active token still exists
-	stillActive, err := s.repo.GetByTokenHash(activeHash)
+	stillActive, err := s.repo.GetByTokenHash(context.Background(), activeHash)
 	s.NoError(err)
 	s.False(stillActive.IsRevoked())
 }
@@ -5,6 +5,7 @@
 package repository_mocks
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -39,66 +40,66 @@ func (m *MockAccountRepositoryInterface) EXPECT() *MockAccountRepositoryInterfac
 }
 
 // CheckAccountNumberExists mocks base method.
-func (m *MockAccountRepositoryInterface) CheckAccountNumberExists(accountNumber string) (bool, error) {
+func (m *MockAccountRepositoryInterface) CheckAccountNumberExists(ctx context.Context, accountNumber string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckAccountNumberExists", accountNumber)
+	ret := m.ctrl.Call(m, "CheckAccountNumberExists", ctx, accountNumber)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckAccountNumberExists indicates an expected call of CheckAccountNumberExists.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) CheckAccountNumberExists(accountNumber interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) CheckAccountNumberExists(ctx, accountNumber interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccountNumberExists", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).CheckAccountNumberExists), accountNumber)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccountNumberExists", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).CheckAccountNumberExists), ctx, accountNumber)
 }
 
 // Create mocks base method.
-func (m *MockAccountRepositoryInterface) Create(account *models.Account) error {
+func (m *MockAccountRepositoryInterface) Create(ctx context.Context, account *models.Account) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", account)
+	ret := m.ctrl.Call(m, "Create", ctx, account)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) Create(account interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Create(ctx, account interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Create), account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Create), ctx, account)
 }
 
 // CreateWithTransaction mocks base method.
-func (m *MockAccountRepositoryInterface) CreateWithTransaction(account *models.Account, transactions []models.Transaction) error {
+func (m *MockAccountRepositoryInterface) CreateWithTransaction(ctx context.Context, account *models.Account, transactions []models.Transaction) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWithTransaction", account, transactions)
+	ret := m.ctrl.Call(m, "CreateWithTransaction", ctx, account, transactions)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateWithTransaction indicates an expected call of CreateWithTransaction.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) CreateWithTransaction(account, transactions interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) CreateWithTransaction(ctx, account, transactions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithTransaction", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).CreateWithTransaction), account, transactions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithTransaction", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).CreateWithTransaction), ctx, account, transactions)
 }
 
 // Delete mocks base method.
-func (m *MockAccountRepositoryInterface) Delete(id uuid.UUID) error {
+func (m *MockAccountRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) Delete(id interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Delete), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Delete), ctx, id)
 }
 
 // ExecuteAtomicTransfer mocks base method.
-func (m *MockAccountRepositoryInterface) ExecuteAtomicTransfer(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (uuid.UUID, uuid.UUID, error) {
+func (m *MockAccountRepositoryInterface) ExecuteAtomicTransfer(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, fromDescription, toDescription string) (uuid.UUID, uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecuteAtomicTransfer", fromAccountID, toAccountID, amount, fromDescription, toDescription)
+	ret := m.ctrl.Call(m, "ExecuteAtomicTransfer", ctx, fromAccountID, toAccountID, amount, fromDescription, toDescription)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(uuid.UUID)
 	ret2, _ := ret[2].(error)
@@ -106,60 +107,60 @@ func (m *MockAccountRepositoryInterface) ExecuteAtomicTransfer(fromAccountID, to
 }
 
 // ExecuteAtomicTransfer indicates an expected call of ExecuteAtomicTransfer.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) ExecuteAtomicTransfer(fromAccountID, toAccountID, amount, fromDescription, toDescription interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) ExecuteAtomicTransfer(ctx, fromAccountID, toAccountID, amount, fromDescription, toDescription interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAtomicTransfer", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ExecuteAtomicTransfer), fromAccountID, toAccountID, amount, fromDescription, toDescription)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAtomicTransfer", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ExecuteAtomicTransfer), ctx, fromAccountID, toAccountID, amount, fromDescription, toDescription)
 }
 
 // ExistsForUser mocks base method.
-func (m *MockAccountRepositoryInterface) ExistsForUser(userID uuid.UUID, accountType string) (bool, error) {
+func (m *MockAccountRepositoryInterface) ExistsForUser(ctx context.Context, userID uuid.UUID, accountType string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExistsForUser", userID, accountType)
+	ret := m.ctrl.Call(m, "ExistsForUser", ctx, userID, accountType)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExistsForUser indicates an expected call of ExistsForUser.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) ExistsForUser(userID, accountType interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) ExistsForUser(ctx, userID, accountType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsForUser", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ExistsForUser), userID, accountType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsForUser", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).ExistsForUser), ctx, userID, accountType)
 }
 
 // GenerateUniqueAccountNumber mocks base method.
-func (m *MockAccountRepositoryInterface) GenerateUniqueAccountNumber(accountType string) (string, error) {
+func (m *MockAccountRepositoryInterface) GenerateUniqueAccountNumber(ctx context.Context, accountType string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateUniqueAccountNumber", accountType)
+	ret := m.ctrl.Call(m, "GenerateUniqueAccountNumber", ctx, accountType)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateUniqueAccountNumber indicates an expected call of GenerateUniqueAccountNumber.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GenerateUniqueAccountNumber(accountType interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GenerateUniqueAccountNumber(ctx, accountType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUniqueAccountNumber", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GenerateUniqueAccountNumber), accountType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUniqueAccountNumber", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GenerateUniqueAccountNumber), ctx, accountType)
 }
 
 // GetAccountsByStatus mocks base method.
-func (m *MockAccountRepositoryInterface) GetAccountsByStatus(status string, offset, limit int) ([]models.Account, error) {
+func (m *MockAccountRepositoryInterface) GetAccountsByStatus(ctx context.Context, status string, offset, limit int) ([]models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountsByStatus", status, offset, limit)
+	ret := m.ctrl.Call(m, "GetAccountsByStatus", ctx, status, offset, limit)
 	ret0, _ := ret[0].([]models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAccountsByStatus indicates an expected call of GetAccountsByStatus.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetAccountsByStatus(status, offset, limit interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetAccountsByStatus(ctx, status, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountsByStatus", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetAccountsByStatus), status, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountsByStatus", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetAccountsByStatus), ctx, status, offset, limit)
 }
 
 // GetAll mocks base method.
-func (m *MockAccountRepositoryInterface) GetAll(offset, limit int) ([]models.Account, int64, error) {
+func (m *MockAccountRepositoryInterface) GetAll(ctx context.Context, offset, limit int) ([]models.Account, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", offset, limit)
+	ret := m.ctrl.Call(m, "GetAll", ctx, offset, limit)
 	ret0, _ := ret[0].([]models.Account)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -167,15 +168,15 @@ func (m *MockAccountRepositoryInterface) GetAll(offset, limit int) ([]models.Acc
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetAll(offset, limit interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetAll(ctx, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetAll), offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetAll), ctx, offset, limit)
 }
 
 // GetAllWithFilters mocks base method.
-func (m *MockAccountRepositoryInterface) GetAllWithFilters(filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
+func (m *MockAccountRepositoryInterface) GetAllWithFilters(ctx context.Context, filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllWithFilters", filters, offset, limit)
+	ret := m.ctrl.Call(m, "GetAllWithFilters", ctx, filters, offset, limit)
 	ret0, _ := ret[0].([]models.Account)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -183,155 +184,155 @@ func (m *MockAccountRepositoryInterface) GetAllWithFilters(filters models.Accoun
 }
 
 // GetAllWithFilters indicates an expected call of GetAllWithFilters.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetAllWithFilters(filters, offset, limit interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetAllWithFilters(ctx, filters, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWithFilters", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetAllWithFilters), filters, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWithFilters", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetAllWithFilters), ctx, filters, offset, limit)
 }
 
 // GetByAccountNumber mocks base method.
-func (m *MockAccountRepositoryInterface) GetByAccountNumber(accountNumber string) (*models.Account, error) {
+func (m *MockAccountRepositoryInterface) GetByAccountNumber(ctx context.Context, accountNumber string) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAccountNumber", accountNumber)
+	ret := m.ctrl.Call(m, "GetByAccountNumber", ctx, accountNumber)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAccountNumber indicates an expected call of GetByAccountNumber.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByAccountNumber(accountNumber interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByAccountNumber(ctx, accountNumber interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountNumber", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByAccountNumber), accountNumber)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountNumber", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByAccountNumber), ctx, accountNumber)
 }
 
 // GetByID mocks base method.
-func (m *MockAccountRepositoryInterface) GetByID(id uuid.UUID) (*models.Account, error) {
+func (m *MockAccountRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByID), ctx, id)
 }
 
 // GetByUserID mocks base method.
-func (m *MockAccountRepositoryInterface) GetByUserID(userID uuid.UUID) ([]models.Account, error) {
+func (m *MockAccountRepositoryInterface) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserID", userID)
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
 	ret0, _ := ret[0].([]models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByUserID(userID interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByUserID(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByUserID), ctx, userID)
 }
 
 // GetByUserIDAndType mocks base method.
-func (m *MockAccountRepositoryInterface) GetByUserIDAndType(userID uuid.UUID, accountType string) ([]models.Account, error) {
+func (m *MockAccountRepositoryInterface) GetByUserIDAndType(ctx context.Context, userID uuid.UUID, accountType string) ([]models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserIDAndType", userID, accountType)
+	ret := m.ctrl.Call(m, "GetByUserIDAndType", ctx, userID, accountType)
 	ret0, _ := ret[0].([]models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByUserIDAndType indicates an expected call of GetByUserIDAndType.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByUserIDAndType(userID, accountType interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByUserIDAndType(ctx, userID, accountType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDAndType", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByUserIDAndType), userID, accountType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDAndType", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByUserIDAndType), ctx, userID, accountType)
 }
 
 // GetByUserIDExcludingStatus mocks base method.
-func (m *MockAccountRepositoryInterface) GetByUserIDExcludingStatus(userID uuid.UUID, excludeStatus string) ([]*models.Account, error) {
+func (m *MockAccountRepositoryInterface) GetByUserIDExcludingStatus(ctx context.Context, userID uuid.UUID, excludeStatus string) ([]*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserIDExcludingStatus", userID, excludeStatus)
+	ret := m.ctrl.Call(m, "GetByUserIDExcludingStatus", ctx, userID, excludeStatus)
 	ret0, _ := ret[0].([]*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByUserIDExcludingStatus indicates an expected call of GetByUserIDExcludingStatus.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByUserIDExcludingStatus(userID, excludeStatus interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetByUserIDExcludingStatus(ctx, userID, excludeStatus interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDExcludingStatus", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByUserIDExcludingStatus), userID, excludeStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDExcludingStatus", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetByUserIDExcludingStatus), ctx, userID, excludeStatus)
 }
 
 // GetTotalBalanceByUserID mocks base method.
-func (m *MockAccountRepositoryInterface) GetTotalBalanceByUserID(userID uuid.UUID) (decimal.Decimal, error) {
+func (m *MockAccountRepositoryInterface) GetTotalBalanceByUserID(ctx context.Context, userID uuid.UUID) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalBalanceByUserID", userID)
+	ret := m.ctrl.Call(m, "GetTotalBalanceByUserID", ctx, userID)
 	ret0, _ := ret[0].(decimal.Decimal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTotalBalanceByUserID indicates an expected call of GetTotalBalanceByUserID.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) GetTotalBalanceByUserID(userID interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) GetTotalBalanceByUserID(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalBalanceByUserID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetTotalBalanceByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalBalanceByUserID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).GetTotalBalanceByUserID), ctx, userID)
 }
 
 // SoftDeleteByUserID mocks base method.
-func (m *MockAccountRepositoryInterface) SoftDeleteByUserID(userID uuid.UUID) error {
+func (m *MockAccountRepositoryInterface) SoftDeleteByUserID(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SoftDeleteByUserID", userID)
+	ret := m.ctrl.Call(m, "SoftDeleteByUserID", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SoftDeleteByUserID indicates an expected call of SoftDeleteByUserID.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) SoftDeleteByUserID(userID interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) SoftDeleteByUserID(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteByUserID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).SoftDeleteByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteByUserID", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).SoftDeleteByUserID), ctx, userID)
 }
 
 // Update mocks base method.
-func (m *MockAccountRepositoryInterface) Update(account *models.Account) error {
+func (m *MockAccountRepositoryInterface) Update(ctx context.Context, account *models.Account) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", account)
+	ret := m.ctrl.Call(m, "Update", ctx, account)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) Update(account interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) Update(ctx, account interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Update), account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).Update), ctx, account)
 }
 
 // UpdateBalance mocks base method.
-func (m *MockAccountRepositoryInterface) UpdateBalance(accountID uuid.UUID, amount decimal.Decimal, transactionType string) error {
+func (m *MockAccountRepositoryInterface) UpdateBalance(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBalance", accountID, amount, transactionType)
+	ret := m.ctrl.Call(m, "UpdateBalance", ctx, accountID, amount, transactionType)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateBalance indicates an expected call of UpdateBalance.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) UpdateBalance(accountID, amount, transactionType interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) UpdateBalance(ctx, accountID, amount, transactionType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalance", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).UpdateBalance), accountID, amount, transactionType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalance", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).UpdateBalance), ctx, accountID, amount, transactionType)
 }
 
 // UpdateOwnership mocks base method.
-func (m *MockAccountRepositoryInterface) UpdateOwnership(accountID, newUserID uuid.UUID) error {
+func (m *MockAccountRepositoryInterface) UpdateOwnership(ctx context.Context, accountID, newUserID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOwnership", accountID, newUserID)
+	ret := m.ctrl.Call(m, "UpdateOwnership", ctx, accountID, newUserID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateOwnership indicates an expected call of UpdateOwnership.
-func (mr *MockAccountRepositoryInterfaceMockRecorder) UpdateOwnership(accountID, newUserID interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryInterfaceMockRecorder) UpdateOwnership(ctx, accountID, newUserID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwnership", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).UpdateOwnership), accountID, newUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwnership", reflect.TypeOf((*MockAccountRepositoryInterface)(nil).UpdateOwnership), ctx, accountID, newUserID)
 }
 
 // MockTransactionRepositoryInterface is a mock of TransactionRepositoryInterface interface.
@@ -357,38 +358,66 @@ func (m *MockTransactionRepositoryInterface) EXPECT() *MockTransactionRepository
 	return m.recorder
 }
 
+// CompletePending mocks base method.
+func (m *MockTransactionRepositoryInterface) CompletePending(ctx context.Context, transaction *models.Transaction, expectedVersion int, auditLog *models.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletePending", ctx, transaction, expectedVersion, auditLog)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompletePending indicates an expected call of CompletePending.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) CompletePending(ctx, transaction, expectedVersion, auditLog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletePending", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).CompletePending), ctx, transaction, expectedVersion, auditLog)
+}
+
 // Create mocks base method.
-func (m *MockTransactionRepositoryInterface) Create(transaction *models.Transaction) error {
+func (m *MockTransactionRepositoryInterface) Create(ctx context.Context, transaction *models.Transaction) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", transaction)
+	ret := m.ctrl.Call(m, "Create", ctx, transaction)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) Create(transaction interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) Create(ctx, transaction interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).Create), transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).Create), ctx, transaction)
 }
 
 // CreateBatch mocks base method.
-func (m *MockTransactionRepositoryInterface) CreateBatch(transactions []models.Transaction) error {
+func (m *MockTransactionRepositoryInterface) CreateBatch(ctx context.Context, transactions []models.Transaction) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBatch", transactions)
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, transactions)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateBatch indicates an expected call of CreateBatch.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) CreateBatch(transactions interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) CreateBatch(ctx, transactions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).CreateBatch), ctx, transactions)
+}
+
+// FailPending mocks base method.
+func (m *MockTransactionRepositoryInterface) FailPending(ctx context.Context, transaction *models.Transaction, expectedVersion int, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailPending", ctx, transaction, expectedVersion, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailPending indicates an expected call of FailPending.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) FailPending(ctx, transaction, expectedVersion, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).CreateBatch), transactions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailPending", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).FailPending), ctx, transaction, expectedVersion, reason)
 }
 
 // GetByAccountID mocks base method.
-func (m *MockTransactionRepositoryInterface) GetByAccountID(accountID uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
+func (m *MockTransactionRepositoryInterface) GetByAccountID(ctx context.Context, accountID uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAccountID", accountID, offset, limit)
+	ret := m.ctrl.Call(m, "GetByAccountID", ctx, accountID, offset, limit)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -396,15 +425,15 @@ func (m *MockTransactionRepositoryInterface) GetByAccountID(accountID uuid.UUID,
 }
 
 // GetByAccountID indicates an expected call of GetByAccountID.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByAccountID(accountID, offset, limit interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByAccountID(ctx, accountID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByAccountID), accountID, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByAccountID), ctx, accountID, offset, limit)
 }
 
 // GetByCategory mocks base method.
-func (m *MockTransactionRepositoryInterface) GetByCategory(accountID uuid.UUID, category string, offset, limit int) ([]models.Transaction, int64, error) {
+func (m *MockTransactionRepositoryInterface) GetByCategory(ctx context.Context, accountID uuid.UUID, category string, offset, limit int) ([]models.Transaction, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByCategory", accountID, category, offset, limit)
+	ret := m.ctrl.Call(m, "GetByCategory", ctx, accountID, category, offset, limit)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -412,120 +441,120 @@ func (m *MockTransactionRepositoryInterface) GetByCategory(accountID uuid.UUID,
 }
 
 // GetByCategory indicates an expected call of GetByCategory.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByCategory(accountID, category, offset, limit interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByCategory(ctx, accountID, category, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCategory", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByCategory), accountID, category, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCategory", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByCategory), ctx, accountID, category, offset, limit)
 }
 
 // GetByDateRange mocks base method.
-func (m *MockTransactionRepositoryInterface) GetByDateRange(accountID uuid.UUID, startDate, endDate time.Time) ([]models.Transaction, error) {
+func (m *MockTransactionRepositoryInterface) GetByDateRange(ctx context.Context, accountID uuid.UUID, startDate, endDate time.Time) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByDateRange", accountID, startDate, endDate)
+	ret := m.ctrl.Call(m, "GetByDateRange", ctx, accountID, startDate, endDate)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByDateRange indicates an expected call of GetByDateRange.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByDateRange(accountID, startDate, endDate interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByDateRange(ctx, accountID, startDate, endDate interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDateRange", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByDateRange), accountID, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDateRange", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByDateRange), ctx, accountID, startDate, endDate)
 }
 
 // GetByID mocks base method.
-func (m *MockTransactionRepositoryInterface) GetByID(id uuid.UUID) (*models.Transaction, error) {
+func (m *MockTransactionRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(*models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByID), ctx, id)
 }
 
 // GetByReference mocks base method.
-func (m *MockTransactionRepositoryInterface) GetByReference(reference string) (*models.Transaction, error) {
+func (m *MockTransactionRepositoryInterface) GetByReference(ctx context.Context, reference string) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByReference", reference)
+	ret := m.ctrl.Call(m, "GetByReference", ctx, reference)
 	ret0, _ := ret[0].(*models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByReference indicates an expected call of GetByReference.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByReference(reference interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetByReference(ctx, reference interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReference", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByReference), reference)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReference", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetByReference), ctx, reference)
 }
 
 // GetCategorySummary mocks base method.
-func (m *MockTransactionRepositoryInterface) GetCategorySummary(accountID uuid.UUID, startDate, endDate time.Time) ([]models.CategorySummary, error) {
+func (m *MockTransactionRepositoryInterface) GetCategorySummary(ctx context.Context, accountID uuid.UUID, startDate, endDate time.Time) ([]models.CategorySummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCategorySummary", accountID, startDate, endDate)
+	ret := m.ctrl.Call(m, "GetCategorySummary", ctx, accountID, startDate, endDate)
 	ret0, _ := ret[0].([]models.CategorySummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCategorySummary indicates an expected call of GetCategorySummary.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetCategorySummary(accountID, startDate, endDate interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetCategorySummary(ctx, accountID, startDate, endDate interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorySummary", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetCategorySummary), accountID, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorySummary", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetCategorySummary), ctx, accountID, startDate, endDate)
 }
 
 // GetExpiredPendingTransactions mocks base method.
-func (m *MockTransactionRepositoryInterface) GetExpiredPendingTransactions(limit int) ([]models.Transaction, error) {
+func (m *MockTransactionRepositoryInterface) GetExpiredPendingTransactions(ctx context.Context, limit int) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExpiredPendingTransactions", limit)
+	ret := m.ctrl.Call(m, "GetExpiredPendingTransactions", ctx, limit)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetExpiredPendingTransactions indicates an expected call of GetExpiredPendingTransactions.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetExpiredPendingTransactions(limit interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetExpiredPendingTransactions(ctx, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredPendingTransactions", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetExpiredPendingTransactions), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredPendingTransactions", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetExpiredPendingTransactions), ctx, limit)
 }
 
 // GetPendingTransactions mocks base method.
-func (m *MockTransactionRepositoryInterface) GetPendingTransactions(offset, limit int) ([]models.Transaction, error) {
+func (m *MockTransactionRepositoryInterface) GetPendingTransactions(ctx context.Context, offset, limit int) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPendingTransactions", offset, limit)
+	ret := m.ctrl.Call(m, "GetPendingTransactions", ctx, offset, limit)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPendingTransactions indicates an expected call of GetPendingTransactions.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetPendingTransactions(offset, limit interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetPendingTransactions(ctx, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransactions", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetPendingTransactions), offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingTransactions", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetPendingTransactions), ctx, offset, limit)
 }
 
 // GetRecentByAccountID mocks base method.
-func (m *MockTransactionRepositoryInterface) GetRecentByAccountID(accountID uuid.UUID, limit int) ([]models.Transaction, error) {
+func (m *MockTransactionRepositoryInterface) GetRecentByAccountID(ctx context.Context, accountID uuid.UUID, limit int) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecentByAccountID", accountID, limit)
+	ret := m.ctrl.Call(m, "GetRecentByAccountID", ctx, accountID, limit)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecentByAccountID indicates an expected call of GetRecentByAccountID.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetRecentByAccountID(accountID, limit interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetRecentByAccountID(ctx, accountID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentByAccountID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetRecentByAccountID), accountID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentByAccountID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetRecentByAccountID), ctx, accountID, limit)
 }
 
 // GetTotalsByAccountID mocks base method.
-func (m *MockTransactionRepositoryInterface) GetTotalsByAccountID(accountID uuid.UUID) (int64, int64, string, string, error) {
+func (m *MockTransactionRepositoryInterface) GetTotalsByAccountID(ctx context.Context, accountID uuid.UUID) (int64, int64, string, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalsByAccountID", accountID)
+	ret := m.ctrl.Call(m, "GetTotalsByAccountID", ctx, accountID)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(string)
@@ -535,15 +564,15 @@ func (m *MockTransactionRepositoryInterface) GetTotalsByAccountID(accountID uuid
 }
 
 // GetTotalsByAccountID indicates an expected call of GetTotalsByAccountID.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetTotalsByAccountID(accountID interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetTotalsByAccountID(ctx, accountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalsByAccountID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetTotalsByAccountID), accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalsByAccountID", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetTotalsByAccountID), ctx, accountID)
 }
 
 // GetWithFilters mocks base method.
-func (m *MockTransactionRepositoryInterface) GetWithFilters(filters models.TransactionFilters) ([]models.Transaction, int64, error) {
+func (m *MockTransactionRepositoryInterface) GetWithFilters(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWithFilters", filters)
+	ret := m.ctrl.Call(m, "GetWithFilters", ctx, filters)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -551,37 +580,51 @@ func (m *MockTransactionRepositoryInterface) GetWithFilters(filters models.Trans
 }
 
 // GetWithFilters indicates an expected call of GetWithFilters.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetWithFilters(filters interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) GetWithFilters(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithFilters", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetWithFilters), filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithFilters", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).GetWithFilters), ctx, filters)
+}
+
+// Post mocks base method.
+func (m *MockTransactionRepositoryInterface) Post(ctx context.Context, transaction *models.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Post", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Post indicates an expected call of Post.
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) Post(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).Post), ctx, transaction)
 }
 
 // UpdateStatus mocks base method.
-func (m *MockTransactionRepositoryInterface) UpdateStatus(id uuid.UUID, status string) error {
+func (m *MockTransactionRepositoryInterface) UpdateStatus(ctx context.Context, id uuid.UUID, status string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", id, status)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) UpdateStatus(id, status interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) UpdateStatus(ctx, id, status interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).UpdateStatus), id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).UpdateStatus), ctx, id, status)
 }
 
 // UpdateWithOptimisticLock mocks base method.
-func (m *MockTransactionRepositoryInterface) UpdateWithOptimisticLock(transaction *models.Transaction, expectedVersion int) error {
+func (m *MockTransactionRepositoryInterface) UpdateWithOptimisticLock(ctx context.Context, transaction *models.Transaction, expectedVersion int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateWithOptimisticLock", transaction, expectedVersion)
+	ret := m.ctrl.Call(m, "UpdateWithOptimisticLock", ctx, transaction, expectedVersion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateWithOptimisticLock indicates an expected call of UpdateWithOptimisticLock.
-func (mr *MockTransactionRepositoryInterfaceMockRecorder) UpdateWithOptimisticLock(transaction, expectedVersion interface{}) *gomock.Call {
+func (mr *MockTransactionRepositoryInterfaceMockRecorder) UpdateWithOptimisticLock(ctx, transaction, expectedVersion interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithOptimisticLock", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).UpdateWithOptimisticLock), transaction, expectedVersion)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithOptimisticLock", reflect.TypeOf((*MockTransactionRepositoryInterface)(nil).UpdateWithOptimisticLock), ctx, transaction, expectedVersion)
 }
 
 // MockUserRepositoryInterface is a mock of UserRepositoryInterface interface.
@@ -608,112 +651,112 @@ func (m *MockUserRepositoryInterface) EXPECT() *MockUserRepositoryInterfaceMockR
 }
 
 // CountAccountsByUserID mocks base method.
-func (m *MockUserRepositoryInterface) CountAccountsByUserID(userID uuid.UUID) (int64, error) {
+func (m *MockUserRepositoryInterface) CountAccountsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountAccountsByUserID", userID)
+	ret := m.ctrl.Call(m, "CountAccountsByUserID", ctx, userID)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountAccountsByUserID indicates an expected call of CountAccountsByUserID.
-func (mr *MockUserRepositoryInterfaceMockRecorder) CountAccountsByUserID(userID interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) CountAccountsByUserID(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccountsByUserID", reflect.TypeOf((*MockUserRepositoryInterface)(nil).CountAccountsByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccountsByUserID", reflect.TypeOf((*MockUserRepositoryInterface)(nil).CountAccountsByUserID), ctx, userID)
 }
 
 // Create mocks base method.
-func (m *MockUserRepositoryInterface) Create(user *models.User) error {
+func (m *MockUserRepositoryInterface) Create(ctx context.Context, user *models.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", user)
+	ret := m.ctrl.Call(m, "Create", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockUserRepositoryInterfaceMockRecorder) Create(user interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) Create(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Create), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Create), ctx, user)
 }
 
 // Delete mocks base method.
-func (m *MockUserRepositoryInterface) Delete(userID uuid.UUID) error {
+func (m *MockUserRepositoryInterface) Delete(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", userID)
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockUserRepositoryInterfaceMockRecorder) Delete(userID interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Delete), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Delete), ctx, userID)
 }
 
 // GetByEmail mocks base method.
-func (m *MockUserRepositoryInterface) GetByEmail(email string) (*models.User, error) {
+func (m *MockUserRepositoryInterface) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByEmail", email)
+	ret := m.ctrl.Call(m, "GetByEmail", ctx, email)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByEmail indicates an expected call of GetByEmail.
-func (mr *MockUserRepositoryInterfaceMockRecorder) GetByEmail(email interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetByEmail(ctx, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByEmail), email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByEmail), ctx, email)
 }
 
 // GetByEmailExcluding mocks base method.
-func (m *MockUserRepositoryInterface) GetByEmailExcluding(email string, excludeUserID uuid.UUID) (*models.User, error) {
+func (m *MockUserRepositoryInterface) GetByEmailExcluding(ctx context.Context, email string, excludeUserID uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByEmailExcluding", email, excludeUserID)
+	ret := m.ctrl.Call(m, "GetByEmailExcluding", ctx, email, excludeUserID)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByEmailExcluding indicates an expected call of GetByEmailExcluding.
-func (mr *MockUserRepositoryInterfaceMockRecorder) GetByEmailExcluding(email, excludeUserID interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetByEmailExcluding(ctx, email, excludeUserID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmailExcluding", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByEmailExcluding), email, excludeUserID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmailExcluding", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByEmailExcluding), ctx, email, excludeUserID)
 }
 
 // GetByID mocks base method.
-func (m *MockUserRepositoryInterface) GetByID(id uuid.UUID) (*models.User, error) {
+func (m *MockUserRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockUserRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByID), ctx, id)
 }
 
 // GetByIDActive mocks base method.
-func (m *MockUserRepositoryInterface) GetByIDActive(id uuid.UUID) (*models.User, error) {
+func (m *MockUserRepositoryInterface) GetByIDActive(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByIDActive", id)
+	ret := m.ctrl.Call(m, "GetByIDActive", ctx, id)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByIDActive indicates an expected call of GetByIDActive.
-func (mr *MockUserRepositoryInterfaceMockRecorder) GetByIDActive(id interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetByIDActive(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDActive", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByIDActive), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDActive", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByIDActive), ctx, id)
 }
 
 // ListUsers mocks base method.
-func (m *MockUserRepositoryInterface) ListUsers(offset, limit int) ([]*models.User, int64, error) {
+func (m *MockUserRepositoryInterface) ListUsers(ctx context.Context, offset, limit int) ([]*models.User, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", offset, limit)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, offset, limit)
 	ret0, _ := ret[0].([]*models.User)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -721,29 +764,29 @@ func (m *MockUserRepositoryInterface) ListUsers(offset, limit int) ([]*models.Us
 }
 
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockUserRepositoryInterfaceMockRecorder) ListUsers(offset, limit interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) ListUsers(ctx, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepositoryInterface)(nil).ListUsers), offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepositoryInterface)(nil).ListUsers), ctx, offset, limit)
 }
 
 // ResetFailedLoginAttempts mocks base method.
-func (m *MockUserRepositoryInterface) ResetFailedLoginAttempts(userID uuid.UUID) error {
+func (m *MockUserRepositoryInterface) ResetFailedLoginAttempts(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetFailedLoginAttempts", userID)
+	ret := m.ctrl.Call(m, "ResetFailedLoginAttempts", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ResetFailedLoginAttempts indicates an expected call of ResetFailedLoginAttempts.
-func (mr *MockUserRepositoryInterfaceMockRecorder) ResetFailedLoginAttempts(userID interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) ResetFailedLoginAttempts(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetFailedLoginAttempts", reflect.TypeOf((*MockUserRepositoryInterface)(nil).ResetFailedLoginAttempts), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetFailedLoginAttempts", reflect.TypeOf((*MockUserRepositoryInterface)(nil).ResetFailedLoginAttempts), ctx, userID)
 }
 
 // SearchUsers mocks base method.
-func (m *MockUserRepositoryInterface) SearchUsers(criteria repositories.UserSearchCriteria, offset, limit int) ([]*models.User, int64, error) {
+func (m *MockUserRepositoryInterface) SearchUsers(ctx context.Context, criteria repositories.UserSearchCriteria, offset, limit int) ([]*models.User, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchUsers", criteria, offset, limit)
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, criteria, offset, limit)
 	ret0, _ := ret[0].([]*models.User)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -751,93 +794,93 @@ func (m *MockUserRepositoryInterface) SearchUsers(criteria repositories.UserSear
 }
 
 // SearchUsers indicates an expected call of SearchUsers.
-func (mr *MockUserRepositoryInterfaceMockRecorder) SearchUsers(criteria, offset, limit interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) SearchUsers(ctx, criteria, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserRepositoryInterface)(nil).SearchUsers), criteria, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserRepositoryInterface)(nil).SearchUsers), ctx, criteria, offset, limit)
 }
 
 // UnlockAccount mocks base method.
-func (m *MockUserRepositoryInterface) UnlockAccount(userID uuid.UUID) error {
+func (m *MockUserRepositoryInterface) UnlockAccount(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnlockAccount", userID)
+	ret := m.ctrl.Call(m, "UnlockAccount", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UnlockAccount indicates an expected call of UnlockAccount.
-func (mr *MockUserRepositoryInterfaceMockRecorder) UnlockAccount(userID interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) UnlockAccount(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UnlockAccount), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UnlockAccount), ctx, userID)
 }
 
 // Update mocks base method.
-func (m *MockUserRepositoryInterface) Update(user *models.User) error {
+func (m *MockUserRepositoryInterface) Update(ctx context.Context, user *models.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", user)
+	ret := m.ctrl.Call(m, "Update", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockUserRepositoryInterfaceMockRecorder) Update(user interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) Update(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Update), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Update), ctx, user)
 }
 
 // UpdateEmail mocks base method.
-func (m *MockUserRepositoryInterface) UpdateEmail(userID uuid.UUID, newEmail string) error {
+func (m *MockUserRepositoryInterface) UpdateEmail(ctx context.Context, userID uuid.UUID, newEmail string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEmail", userID, newEmail)
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, userID, newEmail)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateEmail indicates an expected call of UpdateEmail.
-func (mr *MockUserRepositoryInterfaceMockRecorder) UpdateEmail(userID, newEmail interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) UpdateEmail(ctx, userID, newEmail interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdateEmail), userID, newEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdateEmail), ctx, userID, newEmail)
 }
 
 // UpdateFailedLoginAttempts mocks base method.
-func (m *MockUserRepositoryInterface) UpdateFailedLoginAttempts(user *models.User) error {
+func (m *MockUserRepositoryInterface) UpdateFailedLoginAttempts(ctx context.Context, user *models.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFailedLoginAttempts", user)
+	ret := m.ctrl.Call(m, "UpdateFailedLoginAttempts", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateFailedLoginAttempts indicates an expected call of UpdateFailedLoginAttempts.
-func (mr *MockUserRepositoryInterfaceMockRecorder) UpdateFailedLoginAttempts(user interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) UpdateFailedLoginAttempts(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFailedLoginAttempts", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdateFailedLoginAttempts), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFailedLoginAttempts", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdateFailedLoginAttempts), ctx, user)
 }
 
 // UpdateFields mocks base method.
-func (m *MockUserRepositoryInterface) UpdateFields(userID uuid.UUID, fields map[string]interface{}) error {
+func (m *MockUserRepositoryInterface) UpdateFields(ctx context.Context, userID uuid.UUID, fields map[string]interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFields", userID, fields)
+	ret := m.ctrl.Call(m, "UpdateFields", ctx, userID, fields)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateFields indicates an expected call of UpdateFields.
-func (mr *MockUserRepositoryInterfaceMockRecorder) UpdateFields(userID, fields interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) UpdateFields(ctx, userID, fields interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFields", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdateFields), userID, fields)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFields", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdateFields), ctx, userID, fields)
 }
 
 // UpdatePasswordHash mocks base method.
-func (m *MockUserRepositoryInterface) UpdatePasswordHash(userID uuid.UUID, passwordHash string) error {
+func (m *MockUserRepositoryInterface) UpdatePasswordHash(ctx context.Context, userID uuid.UUID, passwordHash string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePasswordHash", userID, passwordHash)
+	ret := m.ctrl.Call(m, "UpdatePasswordHash", ctx, userID, passwordHash)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePasswordHash indicates an expected call of UpdatePasswordHash.
-func (mr *MockUserRepositoryInterfaceMockRecorder) UpdatePasswordHash(userID, passwordHash interface{}) *gomock.Call {
+func (mr *MockUserRepositoryInterfaceMockRecorder) UpdatePasswordHash(ctx, userID, passwordHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdatePasswordHash), userID, passwordHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdatePasswordHash), ctx, userID, passwordHash)
 }
 
 // MockAuditLogRepositoryInterface is a mock of AuditLogRepositoryInterface interface.
@@ -864,38 +907,38 @@ func (m *MockAuditLogRepositoryInterface) EXPECT() *MockAuditLogRepositoryInterf
 }
 
 // Create mocks base method.
-func (m *MockAuditLogRepositoryInterface) Create(log *models.AuditLog) error {
+func (m *MockAuditLogRepositoryInterface) Create(ctx context.Context, log *models.AuditLog) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", log)
+	ret := m.ctrl.Call(m, "Create", ctx, log)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) Create(log interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) Create(ctx, log interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).Create), log)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).Create), ctx, log)
 }
 
 // DeleteOlderThan mocks base method.
-func (m *MockAuditLogRepositoryInterface) DeleteOlderThan(duration time.Duration) (int64, error) {
+func (m *MockAuditLogRepositoryInterface) DeleteOlderThan(ctx context.Context, duration time.Duration) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOlderThan", duration)
+	ret := m.ctrl.Call(m, "DeleteOlderThan", ctx, duration)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteOlderThan indicates an expected call of DeleteOlderThan.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) DeleteOlderThan(duration interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) DeleteOlderThan(ctx, duration interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).DeleteOlderThan), duration)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).DeleteOlderThan), ctx, duration)
 }
 
 // GetByAction mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetByAction(action string, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (m *MockAuditLogRepositoryInterface) GetByAction(ctx context.Context, action string, offset, limit int) ([]*models.AuditLog, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAction", action, offset, limit)
+	ret := m.ctrl.Call(m, "GetByAction", ctx, action, offset, limit)
 	ret0, _ := ret[0].([]*models.AuditLog)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -903,30 +946,30 @@ func (m *MockAuditLogRepositoryInterface) GetByAction(action string, offset, lim
 }
 
 // GetByAction indicates an expected call of GetByAction.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByAction(action, offset, limit interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByAction(ctx, action, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAction", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByAction), action, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAction", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByAction), ctx, action, offset, limit)
 }
 
 // GetByID mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetByID(id uuid.UUID) (*models.AuditLog, error) {
+func (m *MockAuditLogRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.AuditLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(*models.AuditLog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByID), ctx, id)
 }
 
 // GetByIPAddress mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetByIPAddress(ipAddress string, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (m *MockAuditLogRepositoryInterface) GetByIPAddress(ctx context.Context, ipAddress string, offset, limit int) ([]*models.AuditLog, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByIPAddress", ipAddress, offset, limit)
+	ret := m.ctrl.Call(m, "GetByIPAddress", ctx, ipAddress, offset, limit)
 	ret0, _ := ret[0].([]*models.AuditLog)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -934,15 +977,15 @@ func (m *MockAuditLogRepositoryInterface) GetByIPAddress(ipAddress string, offse
 }
 
 // GetByIPAddress indicates an expected call of GetByIPAddress.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByIPAddress(ipAddress, offset, limit interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByIPAddress(ctx, ipAddress, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIPAddress", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByIPAddress), ipAddress, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIPAddress", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByIPAddress), ctx, ipAddress, offset, limit)
 }
 
 // GetByResource mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetByResource(resource, resourceID string, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (m *MockAuditLogRepositoryInterface) GetByResource(ctx context.Context, resource, resourceID string, offset, limit int) ([]*models.AuditLog, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByResource", resource, resourceID, offset, limit)
+	ret := m.ctrl.Call(m, "GetByResource", ctx, resource, resourceID, offset, limit)
 	ret0, _ := ret[0].([]*models.AuditLog)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -950,15 +993,15 @@ func (m *MockAuditLogRepositoryInterface) GetByResource(resource, resourceID str
 }
 
 // GetByResource indicates an expected call of GetByResource.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByResource(resource, resourceID, offset, limit interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByResource(ctx, resource, resourceID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByResource", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByResource), resource, resourceID, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByResource", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByResource), ctx, resource, resourceID, offset, limit)
 }
 
 // GetByTimeRange mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetByTimeRange(startTime, endTime time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (m *MockAuditLogRepositoryInterface) GetByTimeRange(ctx context.Context, startTime, endTime time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByTimeRange", startTime, endTime, offset, limit)
+	ret := m.ctrl.Call(m, "GetByTimeRange", ctx, startTime, endTime, offset, limit)
 	ret0, _ := ret[0].([]*models.AuditLog)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -966,15 +1009,15 @@ func (m *MockAuditLogRepositoryInterface) GetByTimeRange(startTime, endTime time
 }
 
 // GetByTimeRange indicates an expected call of GetByTimeRange.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByTimeRange(startTime, endTime, offset, limit interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByTimeRange(ctx, startTime, endTime, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTimeRange", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByTimeRange), startTime, endTime, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTimeRange", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByTimeRange), ctx, startTime, endTime, offset, limit)
 }
 
 // GetByUserID mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetByUserID(userID uuid.UUID, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (m *MockAuditLogRepositoryInterface) GetByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*models.AuditLog, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserID", userID, offset, limit)
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID, offset, limit)
 	ret0, _ := ret[0].([]*models.AuditLog)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -982,15 +1025,15 @@ func (m *MockAuditLogRepositoryInterface) GetByUserID(userID uuid.UUID, offset,
 }
 
 // GetByUserID indicates an expected call of GetByUserID.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByUserID(userID, offset, limit interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetByUserID(ctx, userID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByUserID), userID, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetByUserID), ctx, userID, offset, limit)
 }
 
 // GetCustomerActivity mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (m *MockAuditLogRepositoryInterface) GetCustomerActivity(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerActivity", userID, startDate, endDate, offset, limit)
+	ret := m.ctrl.Call(m, "GetCustomerActivity", ctx, userID, startDate, endDate, offset, limit)
 	ret0, _ := ret[0].([]*models.AuditLog)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -998,24 +1041,24 @@ func (m *MockAuditLogRepositoryInterface) GetCustomerActivity(userID uuid.UUID,
 }
 
 // GetCustomerActivity indicates an expected call of GetCustomerActivity.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetCustomerActivity(userID, startDate, endDate, offset, limit interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetCustomerActivity(ctx, userID, startDate, endDate, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerActivity", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetCustomerActivity), userID, startDate, endDate, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerActivity", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetCustomerActivity), ctx, userID, startDate, endDate, offset, limit)
 }
 
 // GetFailedLoginAttempts mocks base method.
-func (m *MockAuditLogRepositoryInterface) GetFailedLoginAttempts(email string, since time.Time) (int64, error) {
+func (m *MockAuditLogRepositoryInterface) GetFailedLoginAttempts(ctx context.Context, email string, since time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFailedLoginAttempts", email, since)
+	ret := m.ctrl.Call(m, "GetFailedLoginAttempts", ctx, email, since)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFailedLoginAttempts indicates an expected call of GetFailedLoginAttempts.
-func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetFailedLoginAttempts(email, since interface{}) *gomock.Call {
+func (mr *MockAuditLogRepositoryInterfaceMockRecorder) GetFailedLoginAttempts(ctx, email, since interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedLoginAttempts", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetFailedLoginAttempts), email, since)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedLoginAttempts", reflect.TypeOf((*MockAuditLogRepositoryInterface)(nil).GetFailedLoginAttempts), ctx, email, since)
 }
 
 // MockProcessingQueueRepositoryInterface is a mock of ProcessingQueueRepositoryInterface interface.
@@ -1041,194 +1084,268 @@ func (m *MockProcessingQueueRepositoryInterface) EXPECT() *MockProcessingQueueRe
 	return m.recorder
 }
 
+// ClaimPending mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) ClaimPending(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*models.ProcessingQueueItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", ctx, workerID, limit, lease)
+	ret0, _ := ret[0].([]*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPending indicates an expected call of ClaimPending.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) ClaimPending(ctx, workerID, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).ClaimPending), ctx, workerID, limit, lease)
+}
+
 // CleanupCompleted mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) CleanupCompleted(olderThan time.Duration) (int64, error) {
+func (m *MockProcessingQueueRepositoryInterface) CleanupCompleted(ctx context.Context, olderThan time.Duration) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CleanupCompleted", olderThan)
+	ret := m.ctrl.Call(m, "CleanupCompleted", ctx, olderThan)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CleanupCompleted indicates an expected call of CleanupCompleted.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) CleanupCompleted(olderThan interface{}) *gomock.Call {
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) CleanupCompleted(ctx, olderThan interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupCompleted", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).CleanupCompleted), olderThan)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupCompleted", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).CleanupCompleted), ctx, olderThan)
 }
 
-// Enqueue mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) Enqueue(transactionID uuid.UUID, operation string, priority int) error {
+// Close mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) Close(ctx context.Context, queueItemID uuid.UUID, expectedStatus, status string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Enqueue", transactionID, operation, priority)
+	ret := m.ctrl.Call(m, "Close", ctx, queueItemID, expectedStatus, status)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Enqueue indicates an expected call of Enqueue.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) Enqueue(transactionID, operation, priority interface{}) *gomock.Call {
+// Close indicates an expected call of Close.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) Close(ctx, queueItemID, expectedStatus, status interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).Enqueue), transactionID, operation, priority)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).Close), ctx, queueItemID, expectedStatus, status)
 }
 
-// FetchPending mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) FetchPending(limit int) ([]*models.ProcessingQueueItem, error) {
+// CountByStatus mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) CountByStatus(ctx context.Context) (map[string]int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchPending", limit)
-	ret0, _ := ret[0].([]*models.ProcessingQueueItem)
+	ret := m.ctrl.Call(m, "CountByStatus", ctx)
+	ret0, _ := ret[0].(map[string]int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FetchPending indicates an expected call of FetchPending.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) FetchPending(limit interface{}) *gomock.Call {
+// CountByStatus indicates an expected call of CountByStatus.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) CountByStatus(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPending", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).FetchPending), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).CountByStatus), ctx)
 }
 
-// GetAverageProcessingTime mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) GetAverageProcessingTime() (float64, error) {
+// Enqueue mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) Enqueue(ctx context.Context, transactionID uuid.UUID, operation string, priority int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAverageProcessingTime")
-	ret0, _ := ret[0].(float64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Enqueue", ctx, transactionID, operation, priority)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAverageProcessingTime indicates an expected call of GetAverageProcessingTime.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetAverageProcessingTime() *gomock.Call {
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) Enqueue(ctx, transactionID, operation, priority interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageProcessingTime", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetAverageProcessingTime))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).Enqueue), ctx, transactionID, operation, priority)
 }
 
-// GetCompletedCount mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) GetCompletedCount() (int64, error) {
+// ExtendLease mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) ExtendLease(ctx context.Context, queueItemID uuid.UUID, workerID string, lease time.Duration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompletedCount")
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "ExtendLease", ctx, queueItemID, workerID, lease)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendLease indicates an expected call of ExtendLease.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) ExtendLease(ctx, queueItemID, workerID, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendLease", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).ExtendLease), ctx, queueItemID, workerID, lease)
+}
+
+// GetAverageProcessingTime mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) GetAverageProcessingTime(ctx context.Context) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAverageProcessingTime", ctx)
+	ret0, _ := ret[0].(float64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCompletedCount indicates an expected call of GetCompletedCount.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetCompletedCount() *gomock.Call {
+// GetAverageProcessingTime indicates an expected call of GetAverageProcessingTime.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetAverageProcessingTime(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedCount", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetCompletedCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageProcessingTime", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetAverageProcessingTime), ctx)
 }
 
-// GetFailedCount mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) GetFailedCount() (int64, error) {
+// GetByID mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.ProcessingQueueItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFailedCount")
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFailedCount indicates an expected call of GetFailedCount.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetFailedCount() *gomock.Call {
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedCount", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetFailedCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetByID), ctx, id)
 }
 
-// GetOldestPendingAge mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) GetOldestPendingAge() (*string, error) {
+// GetControl mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) GetControl(ctx context.Context) (*models.ProcessingQueueControl, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOldestPendingAge")
-	ret0, _ := ret[0].(*string)
+	ret := m.ctrl.Call(m, "GetControl", ctx)
+	ret0, _ := ret[0].(*models.ProcessingQueueControl)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOldestPendingAge indicates an expected call of GetOldestPendingAge.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetOldestPendingAge() *gomock.Call {
+// GetControl indicates an expected call of GetControl.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetControl(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestPendingAge", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetOldestPendingAge))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControl", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetControl), ctx)
 }
 
-// GetPendingCount mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) GetPendingCount() (int64, error) {
+// GetLatestByTransactionID mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) GetLatestByTransactionID(ctx context.Context, transactionID uuid.UUID) (*models.ProcessingQueueItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPendingCount")
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetLatestByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPendingCount indicates an expected call of GetPendingCount.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetPendingCount() *gomock.Call {
+// GetLatestByTransactionID indicates an expected call of GetLatestByTransactionID.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetLatestByTransactionID(ctx, transactionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingCount", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetPendingCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestByTransactionID", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetLatestByTransactionID), ctx, transactionID)
 }
 
-// GetProcessingCount mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) GetProcessingCount() (int64, error) {
+// GetOldestPendingAge mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) GetOldestPendingAge(ctx context.Context) (*string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProcessingCount")
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetOldestPendingAge", ctx)
+	ret0, _ := ret[0].(*string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProcessingCount indicates an expected call of GetProcessingCount.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetProcessingCount() *gomock.Call {
+// GetOldestPendingAge indicates an expected call of GetOldestPendingAge.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) GetOldestPendingAge(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessingCount", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetProcessingCount))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestPendingAge", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).GetOldestPendingAge), ctx)
 }
 
 // IncrementRetry mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) IncrementRetry(queueItemID uuid.UUID) error {
+func (m *MockProcessingQueueRepositoryInterface) IncrementRetry(ctx context.Context, queueItemID uuid.UUID, workerID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncrementRetry", queueItemID)
+	ret := m.ctrl.Call(m, "IncrementRetry", ctx, queueItemID, workerID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // IncrementRetry indicates an expected call of IncrementRetry.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) IncrementRetry(queueItemID interface{}) *gomock.Call {
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) IncrementRetry(ctx, queueItemID, workerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRetry", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).IncrementRetry), ctx, queueItemID, workerID)
+}
+
+// List mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) List(ctx context.Context, filters models.ProcessingQueueFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.ProcessingQueueItem)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) List(ctx, filters, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRetry", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).IncrementRetry), queueItemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).List), ctx, filters, offset, limit)
 }
 
 // MarkCompleted mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) MarkCompleted(queueItemID uuid.UUID) error {
+func (m *MockProcessingQueueRepositoryInterface) MarkCompleted(ctx context.Context, queueItemID uuid.UUID, workerID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkCompleted", queueItemID)
+	ret := m.ctrl.Call(m, "MarkCompleted", ctx, queueItemID, workerID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkCompleted indicates an expected call of MarkCompleted.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkCompleted(queueItemID interface{}) *gomock.Call {
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkCompleted(ctx, queueItemID, workerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCompleted", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkCompleted), queueItemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCompleted", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkCompleted), ctx, queueItemID, workerID)
 }
 
 // MarkFailed mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) MarkFailed(queueItemID uuid.UUID, errorMessage string) error {
+func (m *MockProcessingQueueRepositoryInterface) MarkFailed(ctx context.Context, queueItemID uuid.UUID, workerID, errorMessage string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkFailed", queueItemID, errorMessage)
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, queueItemID, workerID, errorMessage)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkFailed indicates an expected call of MarkFailed.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkFailed(queueItemID, errorMessage interface{}) *gomock.Call {
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkFailed(ctx, queueItemID, workerID, errorMessage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkFailed), ctx, queueItemID, workerID, errorMessage)
+}
+
+// ReapExpiredLeases mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) ReapExpiredLeases(ctx context.Context, now time.Time) (int64, []*models.ProcessingQueueItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReapExpiredLeases", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]*models.ProcessingQueueItem)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReapExpiredLeases indicates an expected call of ReapExpiredLeases.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) ReapExpiredLeases(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapExpiredLeases", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).ReapExpiredLeases), ctx, now)
+}
+
+// Requeue mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) Requeue(ctx context.Context, queueItemID uuid.UUID, expectedStatus string, scheduledAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", ctx, queueItemID, expectedStatus, scheduledAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) Requeue(ctx, queueItemID, expectedStatus, scheduledAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkFailed), queueItemID, errorMessage)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).Requeue), ctx, queueItemID, expectedStatus, scheduledAt)
 }
 
-// MarkProcessing mocks base method.
-func (m *MockProcessingQueueRepositoryInterface) MarkProcessing(queueItemID uuid.UUID) error {
+// SaveControl mocks base method.
+func (m *MockProcessingQueueRepositoryInterface) SaveControl(ctx context.Context, control *models.ProcessingQueueControl) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkProcessing", queueItemID)
+	ret := m.ctrl.Call(m, "SaveControl", ctx, control)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkProcessing indicates an expected call of MarkProcessing.
-func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) MarkProcessing(queueItemID interface{}) *gomock.Call {
+// SaveControl indicates an expected call of SaveControl.
+func (mr *MockProcessingQueueRepositoryInterfaceMockRecorder) SaveControl(ctx, control interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkProcessing", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).MarkProcessing), queueItemID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveControl", reflect.TypeOf((*MockProcessingQueueRepositoryInterface)(nil).SaveControl), ctx, control)
 }
 
 // MockTransferRepositoryInterface is a mock of TransferRepositoryInterface interface.
@@ -1255,83 +1372,115 @@ func (m *MockTransferRepositoryInterface) EXPECT() *MockTransferRepositoryInterf
 }
 
 // CountByUserAccounts mocks base method.
-func (m *MockTransferRepositoryInterface) CountByUserAccounts(accountIDs []uuid.UUID) (int64, error) {
+func (m *MockTransferRepositoryInterface) CountByUserAccounts(ctx context.Context, accountIDs []uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByUserAccounts", accountIDs)
+	ret := m.ctrl.Call(m, "CountByUserAccounts", ctx, accountIDs)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountByUserAccounts indicates an expected call of CountByUserAccounts.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) CountByUserAccounts(accountIDs interface{}) *gomock.Call {
+func (mr *MockTransferRepositoryInterfaceMockRecorder) CountByUserAccounts(ctx, accountIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserAccounts", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).CountByUserAccounts), ctx, accountIDs)
+}
+
+// CountPendingExternal mocks base method.
+func (m *MockTransferRepositoryInterface) CountPendingExternal(ctx context.Context) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPendingExternal", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountPendingExternal indicates an expected call of CountPendingExternal.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) CountPendingExternal(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserAccounts", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).CountByUserAccounts), accountIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPendingExternal", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).CountPendingExternal), ctx)
 }
 
 // Create mocks base method.
-func (m *MockTransferRepositoryInterface) Create(transfer *models.Transfer) error {
+func (m *MockTransferRepositoryInterface) Create(ctx context.Context, transfer *models.Transfer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", transfer)
+	ret := m.ctrl.Call(m, "Create", ctx, transfer)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) Create(transfer interface{}) *gomock.Call {
+func (mr *MockTransferRepositoryInterfaceMockRecorder) Create(ctx, transfer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).Create), transfer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).Create), ctx, transfer)
 }
 
-// FindByID mocks base method.
-func (m *MockTransferRepositoryInterface) FindByID(id uuid.UUID) (*models.Transfer, error) {
+// FindByExternalAccount mocks base method.
+func (m *MockTransferRepositoryInterface) FindByExternalAccount(ctx context.Context, externalAccountID uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByExternalAccount", ctx, externalAccountID, offset, limit)
+	ret0, _ := ret[0].([]models.Transfer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByExternalAccount indicates an expected call of FindByExternalAccount.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByExternalAccount(ctx, externalAccountID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalAccount", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByExternalAccount), ctx, externalAccountID, offset, limit)
+}
+
+// FindByExternalTransferID mocks base method.
+func (m *MockTransferRepositoryInterface) FindByExternalTransferID(ctx context.Context, externalTransferID string) (*models.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", id)
+	ret := m.ctrl.Call(m, "FindByExternalTransferID", ctx, externalTransferID)
 	ret0, _ := ret[0].(*models.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByID indicates an expected call of FindByID.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByID(id interface{}) *gomock.Call {
+// FindByExternalTransferID indicates an expected call of FindByExternalTransferID.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByExternalTransferID(ctx, externalTransferID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByExternalTransferID", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByExternalTransferID), ctx, externalTransferID)
 }
 
-// FindByIdempotencyKey mocks base method.
-func (m *MockTransferRepositoryInterface) FindByIdempotencyKey(key string) (*models.Transfer, error) {
+// FindByID mocks base method.
+func (m *MockTransferRepositoryInterface) FindByID(ctx context.Context, id uuid.UUID) (*models.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByIdempotencyKey", key)
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
 	ret0, _ := ret[0].(*models.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByIdempotencyKey indicates an expected call of FindByIdempotencyKey.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByIdempotencyKey(key interface{}) *gomock.Call {
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdempotencyKey", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByIdempotencyKey), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByID), ctx, id)
 }
 
-// FindPendingExternal mocks base method.
-func (m *MockTransferRepositoryInterface) FindPendingExternal(limit int) ([]models.Transfer, error) {
+// FindByIdempotencyKey mocks base method.
+func (m *MockTransferRepositoryInterface) FindByIdempotencyKey(ctx context.Context, key string) (*models.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPendingExternal", limit)
-	ret0, _ := ret[0].([]models.Transfer)
+	ret := m.ctrl.Call(m, "FindByIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(*models.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindPendingExternal indicates an expected call of FindPendingExternal.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) FindPendingExternal(limit interface{}) *gomock.Call {
+// FindByIdempotencyKey indicates an expected call of FindByIdempotencyKey.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByIdempotencyKey(ctx, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingExternal", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindPendingExternal), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdempotencyKey", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByIdempotencyKey), ctx, key)
 }
 
 // FindByUserAccounts mocks base method.
-func (m *MockTransferRepositoryInterface) FindByUserAccounts(accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
+func (m *MockTransferRepositoryInterface) FindByUserAccounts(ctx context.Context, accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserAccounts", accountIDs, offset, limit)
+	ret := m.ctrl.Call(m, "FindByUserAccounts", ctx, accountIDs, offset, limit)
 	ret0, _ := ret[0].([]models.Transfer)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -1339,15 +1488,15 @@ func (m *MockTransferRepositoryInterface) FindByUserAccounts(accountIDs []uuid.U
 }
 
 // FindByUserAccounts indicates an expected call of FindByUserAccounts.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByUserAccounts(accountIDs, offset, limit interface{}) *gomock.Call {
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByUserAccounts(ctx, accountIDs, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAccounts", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByUserAccounts), accountIDs, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAccounts", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByUserAccounts), ctx, accountIDs, offset, limit)
 }
 
 // FindByUserAccountsWithFilters mocks base method.
-func (m *MockTransferRepositoryInterface) FindByUserAccountsWithFilters(accountIDs []uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
+func (m *MockTransferRepositoryInterface) FindByUserAccountsWithFilters(ctx context.Context, accountIDs []uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserAccountsWithFilters", accountIDs, filters, offset, limit)
+	ret := m.ctrl.Call(m, "FindByUserAccountsWithFilters", ctx, accountIDs, filters, offset, limit)
 	ret0, _ := ret[0].([]models.Transfer)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -1355,23 +1504,116 @@ func (m *MockTransferRepositoryInterface) FindByUserAccountsWithFilters(accountI
 }
 
 // FindByUserAccountsWithFilters indicates an expected call of FindByUserAccountsWithFilters.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByUserAccountsWithFilters(accountIDs, filters, offset, limit interface{}) *gomock.Call {
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindByUserAccountsWithFilters(ctx, accountIDs, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAccountsWithFilters", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByUserAccountsWithFilters), ctx, accountIDs, filters, offset, limit)
+}
+
+// FindEscalatedExternal mocks base method.
+func (m *MockTransferRepositoryInterface) FindEscalatedExternal(ctx context.Context, offset, limit int) ([]models.Transfer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEscalatedExternal", ctx, offset, limit)
+	ret0, _ := ret[0].([]models.Transfer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindEscalatedExternal indicates an expected call of FindEscalatedExternal.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindEscalatedExternal(ctx, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEscalatedExternal", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindEscalatedExternal), ctx, offset, limit)
+}
+
+// FindPendingExternal mocks base method.
+func (m *MockTransferRepositoryInterface) FindPendingExternal(ctx context.Context, dueBy time.Time, limit int) ([]models.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingExternal", ctx, dueBy, limit)
+	ret0, _ := ret[0].([]models.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingExternal indicates an expected call of FindPendingExternal.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) FindPendingExternal(ctx, dueBy, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingExternal", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindPendingExternal), ctx, dueBy, limit)
+}
+
+// GetExternalAccountStats mocks base method.
+func (m *MockTransferRepositoryInterface) GetExternalAccountStats(ctx context.Context, externalAccountID uuid.UUID) (*models.ExternalAccountTransferStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalAccountStats", ctx, externalAccountID)
+	ret0, _ := ret[0].(*models.ExternalAccountTransferStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalAccountStats indicates an expected call of GetExternalAccountStats.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) GetExternalAccountStats(ctx, externalAccountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAccountsWithFilters", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).FindByUserAccountsWithFilters), accountIDs, filters, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalAccountStats", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).GetExternalAccountStats), ctx, externalAccountID)
 }
 
 // Update mocks base method.
-func (m *MockTransferRepositoryInterface) Update(transfer *models.Transfer) error {
+func (m *MockTransferRepositoryInterface) Update(ctx context.Context, transfer *models.Transfer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", transfer)
+	ret := m.ctrl.Call(m, "Update", ctx, transfer)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTransferRepositoryInterfaceMockRecorder) Update(transfer interface{}) *gomock.Call {
+func (mr *MockTransferRepositoryInterfaceMockRecorder) Update(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).Update), ctx, transfer)
+}
+
+// UpdateStatusCheck mocks base method.
+func (m *MockTransferRepositoryInterface) UpdateStatusCheck(ctx context.Context, transfer *models.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusCheck", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatusCheck indicates an expected call of UpdateStatusCheck.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) UpdateStatusCheck(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusCheck", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).UpdateStatusCheck), ctx, transfer)
+}
+
+// UpdateStatusFrom mocks base method.
+func (m *MockTransferRepositoryInterface) UpdateStatusFrom(ctx context.Context, transfer *models.Transfer, from string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusFrom", ctx, transfer, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatusFrom indicates an expected call of UpdateStatusFrom.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) UpdateStatusFrom(ctx, transfer, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusFrom", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).UpdateStatusFrom), ctx, transfer, from)
+}
+
+// UpdateWithEvents mocks base method.
+func (m *MockTransferRepositoryInterface) UpdateWithEvents(ctx context.Context, transfer *models.Transfer, events ...*models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, transfer}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWithEvents", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithEvents indicates an expected call of UpdateWithEvents.
+func (mr *MockTransferRepositoryInterfaceMockRecorder) UpdateWithEvents(ctx, transfer interface{}, events ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).Update), transfer)
+	varargs := append([]interface{}{ctx, transfer}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithEvents", reflect.TypeOf((*MockTransferRepositoryInterface)(nil).UpdateWithEvents), varargs...)
 }
 
 // MockRefreshTokenRepositoryInterface is a mock of RefreshTokenRepositoryInterface interface.
@@ -1398,134 +1640,134 @@ func (m *MockRefreshTokenRepositoryInterface) EXPECT() *MockRefreshTokenReposito
 }
 
 // Create mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) Create(token *models.RefreshToken) error {
+func (m *MockRefreshTokenRepositoryInterface) Create(ctx context.Context, token *models.RefreshToken) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", token)
+	ret := m.ctrl.Call(m, "Create", ctx, token)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Create(token interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Create(ctx, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Create), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Create), ctx, token)
 }
 
 // DeleteExpired mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) DeleteExpired() (int64, error) {
+func (m *MockRefreshTokenRepositoryInterface) DeleteExpired(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteExpired")
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteExpired indicates an expected call of DeleteExpired.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) DeleteExpired() *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) DeleteExpired(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).DeleteExpired))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).DeleteExpired), ctx)
 }
 
 // DeleteRevokedOlderThan mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) DeleteRevokedOlderThan(duration time.Duration) (int64, error) {
+func (m *MockRefreshTokenRepositoryInterface) DeleteRevokedOlderThan(ctx context.Context, duration time.Duration) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRevokedOlderThan", duration)
+	ret := m.ctrl.Call(m, "DeleteRevokedOlderThan", ctx, duration)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteRevokedOlderThan indicates an expected call of DeleteRevokedOlderThan.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) DeleteRevokedOlderThan(duration interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) DeleteRevokedOlderThan(ctx, duration interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRevokedOlderThan", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).DeleteRevokedOlderThan), duration)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRevokedOlderThan", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).DeleteRevokedOlderThan), ctx, duration)
 }
 
 // GetActiveByUserID mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) GetActiveByUserID(userID uuid.UUID) ([]*models.RefreshToken, error) {
+func (m *MockRefreshTokenRepositoryInterface) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*models.RefreshToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActiveByUserID", userID)
+	ret := m.ctrl.Call(m, "GetActiveByUserID", ctx, userID)
 	ret0, _ := ret[0].([]*models.RefreshToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetActiveByUserID indicates an expected call of GetActiveByUserID.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) GetActiveByUserID(userID interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) GetActiveByUserID(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByUserID", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).GetActiveByUserID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByUserID", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).GetActiveByUserID), ctx, userID)
 }
 
 // GetByID mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) GetByID(id uuid.UUID) (*models.RefreshToken, error) {
+func (m *MockRefreshTokenRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.RefreshToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(*models.RefreshToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) GetByID(id interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).GetByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).GetByID), ctx, id)
 }
 
 // GetByTokenHash mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) GetByTokenHash(tokenHash string) (*models.RefreshToken, error) {
+func (m *MockRefreshTokenRepositoryInterface) GetByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByTokenHash", tokenHash)
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, tokenHash)
 	ret0, _ := ret[0].(*models.RefreshToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByTokenHash indicates an expected call of GetByTokenHash.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) GetByTokenHash(tokenHash interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) GetByTokenHash(ctx, tokenHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).GetByTokenHash), tokenHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).GetByTokenHash), ctx, tokenHash)
 }
 
 // Revoke mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) Revoke(tokenID uuid.UUID) error {
+func (m *MockRefreshTokenRepositoryInterface) Revoke(ctx context.Context, tokenID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Revoke", tokenID)
+	ret := m.ctrl.Call(m, "Revoke", ctx, tokenID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Revoke indicates an expected call of Revoke.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Revoke(tokenID interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Revoke(ctx, tokenID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Revoke), tokenID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Revoke), ctx, tokenID)
 }
 
 // RevokeAllForUser mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) RevokeAllForUser(userID uuid.UUID) error {
+func (m *MockRefreshTokenRepositoryInterface) RevokeAllForUser(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeAllForUser", userID)
+	ret := m.ctrl.Call(m, "RevokeAllForUser", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RevokeAllForUser indicates an expected call of RevokeAllForUser.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) RevokeAllForUser(userID interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) RevokeAllForUser(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllForUser", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).RevokeAllForUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllForUser", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).RevokeAllForUser), ctx, userID)
 }
 
 // Update mocks base method.
-func (m *MockRefreshTokenRepositoryInterface) Update(token *models.RefreshToken) error {
+func (m *MockRefreshTokenRepositoryInterface) Update(ctx context.Context, token *models.RefreshToken) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", token)
+	ret := m.ctrl.Call(m, "Update", ctx, token)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Update(token interface{}) *gomock.Call {
+func (mr *MockRefreshTokenRepositoryInterfaceMockRecorder) Update(ctx, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Update), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRefreshTokenRepositoryInterface)(nil).Update), ctx, token)
 }
 
 // MockBlacklistedTokenRepositoryInterface is a mock of BlacklistedTokenRepositoryInterface interface.
@@ -1552,45 +1794,1748 @@ func (m *MockBlacklistedTokenRepositoryInterface) EXPECT() *MockBlacklistedToken
 }
 
 // Create mocks base method.
-func (m *MockBlacklistedTokenRepositoryInterface) Create(token *models.BlacklistedToken) error {
+func (m *MockBlacklistedTokenRepositoryInterface) Create(ctx context.Context, token *models.BlacklistedToken) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", token)
+	ret := m.ctrl.Call(m, "Create", ctx, token)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockBlacklistedTokenRepositoryInterfaceMockRecorder) Create(token interface{}) *gomock.Call {
+func (mr *MockBlacklistedTokenRepositoryInterfaceMockRecorder) Create(ctx, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBlacklistedTokenRepositoryInterface)(nil).Create), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBlacklistedTokenRepositoryInterface)(nil).Create), ctx, token)
 }
 
 // DeleteExpired mocks base method.
-func (m *MockBlacklistedTokenRepositoryInterface) DeleteExpired() (int64, error) {
+func (m *MockBlacklistedTokenRepositoryInterface) DeleteExpired(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteExpired")
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteExpired indicates an expected call of DeleteExpired.
-func (mr *MockBlacklistedTokenRepositoryInterfaceMockRecorder) DeleteExpired() *gomock.Call {
+func (mr *MockBlacklistedTokenRepositoryInterfaceMockRecorder) DeleteExpired(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockBlacklistedTokenRepositoryInterface)(nil).DeleteExpired))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockBlacklistedTokenRepositoryInterface)(nil).DeleteExpired), ctx)
 }
 
 // GetByJTI mocks base method.
-func (m *MockBlacklistedTokenRepositoryInterface) GetByJTI(jti string) (*models.BlacklistedToken, error) {
+func (m *MockBlacklistedTokenRepositoryInterface) GetByJTI(ctx context.Context, jti string) (*models.BlacklistedToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByJTI", jti)
+	ret := m.ctrl.Call(m, "GetByJTI", ctx, jti)
 	ret0, _ := ret[0].(*models.BlacklistedToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByJTI indicates an expected call of GetByJTI.
-func (mr *MockBlacklistedTokenRepositoryInterfaceMockRecorder) GetByJTI(jti interface{}) *gomock.Call {
+func (mr *MockBlacklistedTokenRepositoryInterfaceMockRecorder) GetByJTI(ctx, jti interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByJTI", reflect.TypeOf((*MockBlacklistedTokenRepositoryInterface)(nil).GetByJTI), ctx, jti)
+}
+
+// MockExternalAccountRepositoryInterface is a mock of ExternalAccountRepositoryInterface interface.
+type MockExternalAccountRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalAccountRepositoryInterfaceMockRecorder
+}
+
+// MockExternalAccountRepositoryInterfaceMockRecorder is the mock recorder for MockExternalAccountRepositoryInterface.
+type MockExternalAccountRepositoryInterfaceMockRecorder struct {
+	mock *MockExternalAccountRepositoryInterface
+}
+
+// NewMockExternalAccountRepositoryInterface creates a new mock instance.
+func NewMockExternalAccountRepositoryInterface(ctrl *gomock.Controller) *MockExternalAccountRepositoryInterface {
+	mock := &MockExternalAccountRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockExternalAccountRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalAccountRepositoryInterface) EXPECT() *MockExternalAccountRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ClaimVerificationAttempt mocks base method.
+func (m *MockExternalAccountRepositoryInterface) ClaimVerificationAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimVerificationAttempt", ctx, id, maxAttempts)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimVerificationAttempt indicates an expected call of ClaimVerificationAttempt.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) ClaimVerificationAttempt(ctx, id, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimVerificationAttempt", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).ClaimVerificationAttempt), ctx, id, maxAttempts)
+}
+
+// Create mocks base method.
+func (m *MockExternalAccountRepositoryInterface) Create(ctx context.Context, account *models.ExternalAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) Create(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).Create), ctx, account)
+}
+
+// Delete mocks base method.
+func (m *MockExternalAccountRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockExternalAccountRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// ListByUserID mocks base method.
+func (m *MockExternalAccountRepositoryInterface) ListByUserID(ctx context.Context, userID uuid.UUID) ([]models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).ListByUserID), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockExternalAccountRepositoryInterface) Update(ctx context.Context, account *models.ExternalAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) Update(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).Update), ctx, account)
+}
+
+// UpdateMicroDeposits mocks base method.
+func (m *MockExternalAccountRepositoryInterface) UpdateMicroDeposits(ctx context.Context, account *models.ExternalAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMicroDeposits", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMicroDeposits indicates an expected call of UpdateMicroDeposits.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) UpdateMicroDeposits(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMicroDeposits", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).UpdateMicroDeposits), ctx, account)
+}
+
+// UpdateNickname mocks base method.
+func (m *MockExternalAccountRepositoryInterface) UpdateNickname(ctx context.Context, id uuid.UUID, nickname string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNickname", ctx, id, nickname)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNickname indicates an expected call of UpdateNickname.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) UpdateNickname(ctx, id, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNickname", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).UpdateNickname), ctx, id, nickname)
+}
+
+// UpdateVerificationStatus mocks base method.
+func (m *MockExternalAccountRepositoryInterface) UpdateVerificationStatus(ctx context.Context, account *models.ExternalAccount, from string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVerificationStatus", ctx, account, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVerificationStatus indicates an expected call of UpdateVerificationStatus.
+func (mr *MockExternalAccountRepositoryInterfaceMockRecorder) UpdateVerificationStatus(ctx, account, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerificationStatus", reflect.TypeOf((*MockExternalAccountRepositoryInterface)(nil).UpdateVerificationStatus), ctx, account, from)
+}
+
+// MockWebhookNotificationRepositoryInterface is a mock of WebhookNotificationRepositoryInterface interface.
+type MockWebhookNotificationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookNotificationRepositoryInterfaceMockRecorder
+}
+
+// MockWebhookNotificationRepositoryInterfaceMockRecorder is the mock recorder for MockWebhookNotificationRepositoryInterface.
+type MockWebhookNotificationRepositoryInterfaceMockRecorder struct {
+	mock *MockWebhookNotificationRepositoryInterface
+}
+
+// NewMockWebhookNotificationRepositoryInterface creates a new mock instance.
+func NewMockWebhookNotificationRepositoryInterface(ctrl *gomock.Controller) *MockWebhookNotificationRepositoryInterface {
+	mock := &MockWebhookNotificationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookNotificationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookNotificationRepositoryInterface) EXPECT() *MockWebhookNotificationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountByStatus mocks base method.
+func (m *MockWebhookNotificationRepositoryInterface) CountByStatus(ctx context.Context) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus", ctx)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus.
+func (mr *MockWebhookNotificationRepositoryInterfaceMockRecorder) CountByStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockWebhookNotificationRepositoryInterface)(nil).CountByStatus), ctx)
+}
+
+// Create mocks base method.
+func (m *MockWebhookNotificationRepositoryInterface) Create(ctx context.Context, notification *models.WebhookNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookNotificationRepositoryInterfaceMockRecorder) Create(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookNotificationRepositoryInterface)(nil).Create), ctx, notification)
+}
+
+// FindPending mocks base method.
+func (m *MockWebhookNotificationRepositoryInterface) FindPending(ctx context.Context, limit int) ([]models.WebhookNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, limit)
+	ret0, _ := ret[0].([]models.WebhookNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockWebhookNotificationRepositoryInterfaceMockRecorder) FindPending(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockWebhookNotificationRepositoryInterface)(nil).FindPending), ctx, limit)
+}
+
+// GetByID mocks base method.
+func (m *MockWebhookNotificationRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.WebhookNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWebhookNotificationRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWebhookNotificationRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWebhookNotificationRepositoryInterface) List(ctx context.Context, filters models.WebhookNotificationFilters, offset, limit int) ([]models.WebhookNotification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookNotification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookNotificationRepositoryInterfaceMockRecorder) List(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookNotificationRepositoryInterface)(nil).List), ctx, filters, offset, limit)
+}
+
+// Update mocks base method.
+func (m *MockWebhookNotificationRepositoryInterface) Update(ctx context.Context, notification *models.WebhookNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookNotificationRepositoryInterfaceMockRecorder) Update(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookNotificationRepositoryInterface)(nil).Update), ctx, notification)
+}
+
+// MockInboundCreditRepositoryInterface is a mock of InboundCreditRepositoryInterface interface.
+type MockInboundCreditRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboundCreditRepositoryInterfaceMockRecorder
+}
+
+// MockInboundCreditRepositoryInterfaceMockRecorder is the mock recorder for MockInboundCreditRepositoryInterface.
+type MockInboundCreditRepositoryInterfaceMockRecorder struct {
+	mock *MockInboundCreditRepositoryInterface
+}
+
+// NewMockInboundCreditRepositoryInterface creates a new mock instance.
+func NewMockInboundCreditRepositoryInterface(ctrl *gomock.Controller) *MockInboundCreditRepositoryInterface {
+	mock := &MockInboundCreditRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockInboundCreditRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboundCreditRepositoryInterface) EXPECT() *MockInboundCreditRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInboundCreditRepositoryInterface) Create(ctx context.Context, credit *models.InboundCredit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, credit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) Create(ctx, credit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).Create), ctx, credit)
+}
+
+// CreatePosted mocks base method.
+func (m *MockInboundCreditRepositoryInterface) CreatePosted(ctx context.Context, credit *models.InboundCredit, transaction *models.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePosted", ctx, credit, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePosted indicates an expected call of CreatePosted.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) CreatePosted(ctx, credit, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePosted", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).CreatePosted), ctx, credit, transaction)
+}
+
+// GetByEventID mocks base method.
+func (m *MockInboundCreditRepositoryInterface) GetByEventID(ctx context.Context, eventID string) (*models.InboundCredit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEventID", ctx, eventID)
+	ret0, _ := ret[0].(*models.InboundCredit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEventID indicates an expected call of GetByEventID.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) GetByEventID(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEventID", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).GetByEventID), ctx, eventID)
+}
+
+// GetByID mocks base method.
+func (m *MockInboundCreditRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.InboundCredit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.InboundCredit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// ListByStatus mocks base method.
+func (m *MockInboundCreditRepositoryInterface) ListByStatus(ctx context.Context, status string, offset, limit int) ([]models.InboundCredit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByStatus", ctx, status, offset, limit)
+	ret0, _ := ret[0].([]models.InboundCredit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByStatus indicates an expected call of ListByStatus.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) ListByStatus(ctx, status, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByStatus", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).ListByStatus), ctx, status, offset, limit)
+}
+
+// ResolvePosted mocks base method.
+func (m *MockInboundCreditRepositoryInterface) ResolvePosted(ctx context.Context, credit *models.InboundCredit, transaction *models.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePosted", ctx, credit, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolvePosted indicates an expected call of ResolvePosted.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) ResolvePosted(ctx, credit, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePosted", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).ResolvePosted), ctx, credit, transaction)
+}
+
+// Update mocks base method.
+func (m *MockInboundCreditRepositoryInterface) Update(ctx context.Context, credit *models.InboundCredit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, credit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) Update(ctx, credit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).Update), ctx, credit)
+}
+
+// UpdateFromStatus mocks base method.
+func (m *MockInboundCreditRepositoryInterface) UpdateFromStatus(ctx context.Context, credit *models.InboundCredit, from string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFromStatus", ctx, credit, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFromStatus indicates an expected call of UpdateFromStatus.
+func (mr *MockInboundCreditRepositoryInterfaceMockRecorder) UpdateFromStatus(ctx, credit, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFromStatus", reflect.TypeOf((*MockInboundCreditRepositoryInterface)(nil).UpdateFromStatus), ctx, credit, from)
+}
+
+// MockTransferSagaRepositoryInterface is a mock of TransferSagaRepositoryInterface interface.
+type MockTransferSagaRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferSagaRepositoryInterfaceMockRecorder
+}
+
+// MockTransferSagaRepositoryInterfaceMockRecorder is the mock recorder for MockTransferSagaRepositoryInterface.
+type MockTransferSagaRepositoryInterfaceMockRecorder struct {
+	mock *MockTransferSagaRepositoryInterface
+}
+
+// NewMockTransferSagaRepositoryInterface creates a new mock instance.
+func NewMockTransferSagaRepositoryInterface(ctrl *gomock.Controller) *MockTransferSagaRepositoryInterface {
+	mock := &MockTransferSagaRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferSagaRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferSagaRepositoryInterface) EXPECT() *MockTransferSagaRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// BeginWithDebit mocks base method.
+func (m *MockTransferSagaRepositoryInterface) BeginWithDebit(ctx context.Context, transfer *models.Transfer, transferType, debitDescription string) (*models.TransferSaga, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginWithDebit", ctx, transfer, transferType, debitDescription)
+	ret0, _ := ret[0].(*models.TransferSaga)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginWithDebit indicates an expected call of BeginWithDebit.
+func (mr *MockTransferSagaRepositoryInterfaceMockRecorder) BeginWithDebit(ctx, transfer, transferType, debitDescription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginWithDebit", reflect.TypeOf((*MockTransferSagaRepositoryInterface)(nil).BeginWithDebit), ctx, transfer, transferType, debitDescription)
+}
+
+// Compensate mocks base method.
+func (m *MockTransferSagaRepositoryInterface) Compensate(ctx context.Context, transferID uuid.UUID, reason, reversalDescription string) (*models.Transfer, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compensate", ctx, transferID, reason, reversalDescription)
+	ret0, _ := ret[0].(*models.Transfer)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Compensate indicates an expected call of Compensate.
+func (mr *MockTransferSagaRepositoryInterfaceMockRecorder) Compensate(ctx, transferID, reason, reversalDescription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compensate", reflect.TypeOf((*MockTransferSagaRepositoryInterface)(nil).Compensate), ctx, transferID, reason, reversalDescription)
+}
+
+// Confirm mocks base method.
+func (m *MockTransferSagaRepositoryInterface) Confirm(ctx context.Context, transfer *models.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockTransferSagaRepositoryInterfaceMockRecorder) Confirm(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockTransferSagaRepositoryInterface)(nil).Confirm), ctx, transfer)
+}
+
+// FindStalled mocks base method.
+func (m *MockTransferSagaRepositoryInterface) FindStalled(ctx context.Context, olderThan time.Time, limit int) ([]models.TransferSaga, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStalled", ctx, olderThan, limit)
+	ret0, _ := ret[0].([]models.TransferSaga)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStalled indicates an expected call of FindStalled.
+func (mr *MockTransferSagaRepositoryInterfaceMockRecorder) FindStalled(ctx, olderThan, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStalled", reflect.TypeOf((*MockTransferSagaRepositoryInterface)(nil).FindStalled), ctx, olderThan, limit)
+}
+
+// GetByTransferID mocks base method.
+func (m *MockTransferSagaRepositoryInterface) GetByTransferID(ctx context.Context, transferID uuid.UUID) (*models.TransferSaga, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTransferID", ctx, transferID)
+	ret0, _ := ret[0].(*models.TransferSaga)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTransferID indicates an expected call of GetByTransferID.
+func (mr *MockTransferSagaRepositoryInterfaceMockRecorder) GetByTransferID(ctx, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransferID", reflect.TypeOf((*MockTransferSagaRepositoryInterface)(nil).GetByTransferID), ctx, transferID)
+}
+
+// MarkSubmitted mocks base method.
+func (m *MockTransferSagaRepositoryInterface) MarkSubmitted(ctx context.Context, transfer *models.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSubmitted", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSubmitted indicates an expected call of MarkSubmitted.
+func (mr *MockTransferSagaRepositoryInterfaceMockRecorder) MarkSubmitted(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSubmitted", reflect.TypeOf((*MockTransferSagaRepositoryInterface)(nil).MarkSubmitted), ctx, transfer)
+}
+
+// RecordRecoveryFailure mocks base method.
+func (m *MockTransferSagaRepositoryInterface) RecordRecoveryFailure(ctx context.Context, transferID uuid.UUID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRecoveryFailure", ctx, transferID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordRecoveryFailure indicates an expected call of RecordRecoveryFailure.
+func (mr *MockTransferSagaRepositoryInterfaceMockRecorder) RecordRecoveryFailure(ctx, transferID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRecoveryFailure", reflect.TypeOf((*MockTransferSagaRepositoryInterface)(nil).RecordRecoveryFailure), ctx, transferID, reason)
+}
+
+// MockOutboxRepositoryInterface is a mock of OutboxRepositoryInterface interface.
+type MockOutboxRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryInterfaceMockRecorder
+}
+
+// MockOutboxRepositoryInterfaceMockRecorder is the mock recorder for MockOutboxRepositoryInterface.
+type MockOutboxRepositoryInterfaceMockRecorder struct {
+	mock *MockOutboxRepositoryInterface
+}
+
+// NewMockOutboxRepositoryInterface creates a new mock instance.
+func NewMockOutboxRepositoryInterface(ctrl *gomock.Controller) *MockOutboxRepositoryInterface {
+	mock := &MockOutboxRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepositoryInterface) EXPECT() *MockOutboxRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockOutboxRepositoryInterface) Append(ctx context.Context, events ...*models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Append", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) Append(ctx interface{}, events ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).Append), varargs...)
+}
+
+// CountAfter mocks base method.
+func (m *MockOutboxRepositoryInterface) CountAfter(ctx context.Context, afterID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAfter", ctx, afterID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAfter indicates an expected call of CountAfter.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) CountAfter(ctx, afterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAfter", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).CountAfter), ctx, afterID)
+}
+
+// CountDeadLetters mocks base method.
+func (m *MockOutboxRepositoryInterface) CountDeadLetters(ctx context.Context, consumer string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeadLetters", ctx, consumer)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeadLetters indicates an expected call of CountDeadLetters.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) CountDeadLetters(ctx, consumer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeadLetters", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).CountDeadLetters), ctx, consumer)
+}
+
+// DeadLetter mocks base method.
+func (m *MockOutboxRepositoryInterface) DeadLetter(ctx context.Context, checkpoint *models.OutboxCheckpoint, deadLetter *models.OutboxDeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetter", ctx, checkpoint, deadLetter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetter indicates an expected call of DeadLetter.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) DeadLetter(ctx, checkpoint, deadLetter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetter", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).DeadLetter), ctx, checkpoint, deadLetter)
+}
+
+// FindAfter mocks base method.
+func (m *MockOutboxRepositoryInterface) FindAfter(ctx context.Context, afterID int64, limit int) ([]models.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]models.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAfter indicates an expected call of FindAfter.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) FindAfter(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAfter", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).FindAfter), ctx, afterID, limit)
+}
+
+// GetCheckpoint mocks base method.
+func (m *MockOutboxRepositoryInterface) GetCheckpoint(ctx context.Context, consumer string) (*models.OutboxCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", ctx, consumer)
+	ret0, _ := ret[0].(*models.OutboxCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) GetCheckpoint(ctx, consumer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).GetCheckpoint), ctx, consumer)
+}
+
+// GetDeadLetter mocks base method.
+func (m *MockOutboxRepositoryInterface) GetDeadLetter(ctx context.Context, consumer string, eventID int64) (*models.OutboxDeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", ctx, consumer, eventID)
+	ret0, _ := ret[0].(*models.OutboxDeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) GetDeadLetter(ctx, consumer, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).GetDeadLetter), ctx, consumer, eventID)
+}
+
+// ListAfter mocks base method.
+func (m *MockOutboxRepositoryInterface) ListAfter(ctx context.Context, afterID int64, offset, limit int) ([]models.OutboxEvent, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAfter", ctx, afterID, offset, limit)
+	ret0, _ := ret[0].([]models.OutboxEvent)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAfter indicates an expected call of ListAfter.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) ListAfter(ctx, afterID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAfter", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).ListAfter), ctx, afterID, offset, limit)
+}
+
+// ListCheckpoints mocks base method.
+func (m *MockOutboxRepositoryInterface) ListCheckpoints(ctx context.Context) ([]models.OutboxCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCheckpoints", ctx)
+	ret0, _ := ret[0].([]models.OutboxCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCheckpoints indicates an expected call of ListCheckpoints.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) ListCheckpoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCheckpoints", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).ListCheckpoints), ctx)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockOutboxRepositoryInterface) ListDeadLetters(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxDeadLetter, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", ctx, consumer, offset, limit)
+	ret0, _ := ret[0].([]models.OutboxDeadLetter)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) ListDeadLetters(ctx, consumer, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).ListDeadLetters), ctx, consumer, offset, limit)
+}
+
+// MarkDeadLetterReplayed mocks base method.
+func (m *MockOutboxRepositoryInterface) MarkDeadLetterReplayed(ctx context.Context, deadLetter *models.OutboxDeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeadLetterReplayed", ctx, deadLetter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeadLetterReplayed indicates an expected call of MarkDeadLetterReplayed.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) MarkDeadLetterReplayed(ctx, deadLetter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeadLetterReplayed", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).MarkDeadLetterReplayed), ctx, deadLetter)
+}
+
+// SaveCheckpoint mocks base method.
+func (m *MockOutboxRepositoryInterface) SaveCheckpoint(ctx context.Context, checkpoint *models.OutboxCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCheckpoint", ctx, checkpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCheckpoint indicates an expected call of SaveCheckpoint.
+func (mr *MockOutboxRepositoryInterfaceMockRecorder) SaveCheckpoint(ctx, checkpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCheckpoint", reflect.TypeOf((*MockOutboxRepositoryInterface)(nil).SaveCheckpoint), ctx, checkpoint)
+}
+
+// MockWebhookSubscriptionRepositoryInterface is a mock of WebhookSubscriptionRepositoryInterface interface.
+type MockWebhookSubscriptionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionRepositoryInterfaceMockRecorder
+}
+
+// MockWebhookSubscriptionRepositoryInterfaceMockRecorder is the mock recorder for MockWebhookSubscriptionRepositoryInterface.
+type MockWebhookSubscriptionRepositoryInterfaceMockRecorder struct {
+	mock *MockWebhookSubscriptionRepositoryInterface
+}
+
+// NewMockWebhookSubscriptionRepositoryInterface creates a new mock instance.
+func NewMockWebhookSubscriptionRepositoryInterface(ctrl *gomock.Controller) *MockWebhookSubscriptionRepositoryInterface {
+	mock := &MockWebhookSubscriptionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionRepositoryInterface) EXPECT() *MockWebhookSubscriptionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountByUserID mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUserID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUserID indicates an expected call of CountByUserID.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) CountByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).CountByUserID), ctx, userID)
+}
+
+// CountPendingDeliveries mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) CountPendingDeliveries(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPendingDeliveries", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPendingDeliveries indicates an expected call of CountPendingDeliveries.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) CountPendingDeliveries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPendingDeliveries", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).CountPendingDeliveries), ctx)
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) Create(ctx context.Context, subscription *models.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) Create(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).Create), ctx, subscription)
+}
+
+// CreateDeliveries mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeliveries indicates an expected call of CreateDeliveries.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) CreateDeliveries(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveries", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).CreateDeliveries), ctx, deliveries)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).Delete), ctx, id)
+}
+
+// FindPendingDeliveries mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) FindPendingDeliveries(ctx context.Context, limit int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingDeliveries", ctx, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingDeliveries indicates an expected call of FindPendingDeliveries.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) FindPendingDeliveries(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingDeliveries", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).FindPendingDeliveries), ctx, limit)
+}
+
+// GetByID mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// GetDelivery mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) GetDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	ret0, _ := ret[0].(*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) GetDelivery(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).GetDelivery), ctx, id)
+}
+
+// ListByUserID mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) ListByUserID(ctx context.Context, userID uuid.UUID) ([]models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).ListByUserID), ctx, userID)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, subscriptionID, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) ListDeliveries(ctx, subscriptionID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).ListDeliveries), ctx, subscriptionID, offset, limit)
+}
+
+// RecordDeliveryAttempt mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) RecordDeliveryAttempt(ctx context.Context, delivery *models.WebhookDelivery, succeeded bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDeliveryAttempt", ctx, delivery, succeeded)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordDeliveryAttempt indicates an expected call of RecordDeliveryAttempt.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) RecordDeliveryAttempt(ctx, delivery, succeeded interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDeliveryAttempt", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).RecordDeliveryAttempt), ctx, delivery, succeeded)
+}
+
+// Update mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) Update(ctx context.Context, subscription *models.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) Update(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).Update), ctx, subscription)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockWebhookSubscriptionRepositoryInterface) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockWebhookSubscriptionRepositoryInterfaceMockRecorder) UpdateDelivery(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockWebhookSubscriptionRepositoryInterface)(nil).UpdateDelivery), ctx, delivery)
+}
+
+// MockComplianceReportRepositoryInterface is a mock of ComplianceReportRepositoryInterface interface.
+type MockComplianceReportRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockComplianceReportRepositoryInterfaceMockRecorder
+}
+
+// MockComplianceReportRepositoryInterfaceMockRecorder is the mock recorder for MockComplianceReportRepositoryInterface.
+type MockComplianceReportRepositoryInterfaceMockRecorder struct {
+	mock *MockComplianceReportRepositoryInterface
+}
+
+// NewMockComplianceReportRepositoryInterface creates a new mock instance.
+func NewMockComplianceReportRepositoryInterface(ctrl *gomock.Controller) *MockComplianceReportRepositoryInterface {
+	mock := &MockComplianceReportRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockComplianceReportRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComplianceReportRepositoryInterface) EXPECT() *MockComplianceReportRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockComplianceReportRepositoryInterface) Create(ctx context.Context, report *models.ComplianceReport) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, report)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockComplianceReportRepositoryInterfaceMockRecorder) Create(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockComplianceReportRepositoryInterface)(nil).Create), ctx, report)
+}
+
+// FindDueSubmissions mocks base method.
+func (m *MockComplianceReportRepositoryInterface) FindDueSubmissions(ctx context.Context, limit int) ([]models.ComplianceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueSubmissions", ctx, limit)
+	ret0, _ := ret[0].([]models.ComplianceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueSubmissions indicates an expected call of FindDueSubmissions.
+func (mr *MockComplianceReportRepositoryInterfaceMockRecorder) FindDueSubmissions(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueSubmissions", reflect.TypeOf((*MockComplianceReportRepositoryInterface)(nil).FindDueSubmissions), ctx, limit)
+}
+
+// GetByID mocks base method.
+func (m *MockComplianceReportRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.ComplianceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.ComplianceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockComplianceReportRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockComplianceReportRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockComplianceReportRepositoryInterface) List(ctx context.Context, filters models.ComplianceReportFilters, offset, limit int) ([]models.ComplianceReport, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.ComplianceReport)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockComplianceReportRepositoryInterfaceMockRecorder) List(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockComplianceReportRepositoryInterface)(nil).List), ctx, filters, offset, limit)
+}
+
+// ListActivity mocks base method.
+func (m *MockComplianceReportRepositoryInterface) ListActivity(ctx context.Context, from, to time.Time) ([]models.ComplianceActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivity", ctx, from, to)
+	ret0, _ := ret[0].([]models.ComplianceActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivity indicates an expected call of ListActivity.
+func (mr *MockComplianceReportRepositoryInterfaceMockRecorder) ListActivity(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivity", reflect.TypeOf((*MockComplianceReportRepositoryInterface)(nil).ListActivity), ctx, from, to)
+}
+
+// Update mocks base method.
+func (m *MockComplianceReportRepositoryInterface) Update(ctx context.Context, report *models.ComplianceReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockComplianceReportRepositoryInterfaceMockRecorder) Update(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockComplianceReportRepositoryInterface)(nil).Update), ctx, report)
+}
+
+// MockFraudRepositoryInterface is a mock of FraudRepositoryInterface interface.
+type MockFraudRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFraudRepositoryInterfaceMockRecorder
+}
+
+// MockFraudRepositoryInterfaceMockRecorder is the mock recorder for MockFraudRepositoryInterface.
+type MockFraudRepositoryInterfaceMockRecorder struct {
+	mock *MockFraudRepositoryInterface
+}
+
+// NewMockFraudRepositoryInterface creates a new mock instance.
+func NewMockFraudRepositoryInterface(ctrl *gomock.Controller) *MockFraudRepositoryInterface {
+	mock := &MockFraudRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockFraudRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFraudRepositoryInterface) EXPECT() *MockFraudRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AttachDecisionResource mocks base method.
+func (m *MockFraudRepositoryInterface) AttachDecisionResource(ctx context.Context, id uuid.UUID, resourceType string, resourceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachDecisionResource", ctx, id, resourceType, resourceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachDecisionResource indicates an expected call of AttachDecisionResource.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) AttachDecisionResource(ctx, id, resourceType, resourceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDecisionResource", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).AttachDecisionResource), ctx, id, resourceType, resourceID)
+}
+
+// CountTransfersToExternalAccount mocks base method.
+func (m *MockFraudRepositoryInterface) CountTransfersToExternalAccount(ctx context.Context, externalAccountID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTransfersToExternalAccount", ctx, externalAccountID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTransfersToExternalAccount indicates an expected call of CountTransfersToExternalAccount.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) CountTransfersToExternalAccount(ctx, externalAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTransfersToExternalAccount", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).CountTransfersToExternalAccount), ctx, externalAccountID)
+}
+
+// CreateDecision mocks base method.
+func (m *MockFraudRepositoryInterface) CreateDecision(ctx context.Context, decision *models.FraudDecision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDecision", ctx, decision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDecision indicates an expected call of CreateDecision.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) CreateDecision(ctx, decision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDecision", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).CreateDecision), ctx, decision)
+}
+
+// GetDecisionByID mocks base method.
+func (m *MockFraudRepositoryInterface) GetDecisionByID(ctx context.Context, id uuid.UUID) (*models.FraudDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecisionByID", ctx, id)
+	ret0, _ := ret[0].(*models.FraudDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecisionByID indicates an expected call of GetDecisionByID.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) GetDecisionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecisionByID", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).GetDecisionByID), ctx, id)
+}
+
+// ListDecisions mocks base method.
+func (m *MockFraudRepositoryInterface) ListDecisions(ctx context.Context, filters models.FraudDecisionFilters, offset, limit int) ([]models.FraudDecision, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDecisions", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.FraudDecision)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDecisions indicates an expected call of ListDecisions.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) ListDecisions(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDecisions", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).ListDecisions), ctx, filters, offset, limit)
+}
+
+// ListRecentDebits mocks base method.
+func (m *MockFraudRepositoryInterface) ListRecentDebits(ctx context.Context, userID uuid.UUID, since time.Time) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentDebits", ctx, userID, since)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentDebits indicates an expected call of ListRecentDebits.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) ListRecentDebits(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentDebits", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).ListRecentDebits), ctx, userID, since)
+}
+
+// ListRecentLogins mocks base method.
+func (m *MockFraudRepositoryInterface) ListRecentLogins(ctx context.Context, userID uuid.UUID, limit int) ([]models.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentLogins", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentLogins indicates an expected call of ListRecentLogins.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) ListRecentLogins(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentLogins", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).ListRecentLogins), ctx, userID, limit)
+}
+
+// ListRules mocks base method.
+func (m *MockFraudRepositoryInterface) ListRules(ctx context.Context) ([]models.FraudRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx)
+	ret0, _ := ret[0].([]models.FraudRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) ListRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).ListRules), ctx)
+}
+
+// SaveRule mocks base method.
+func (m *MockFraudRepositoryInterface) SaveRule(ctx context.Context, rule *models.FraudRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRule indicates an expected call of SaveRule.
+func (mr *MockFraudRepositoryInterfaceMockRecorder) SaveRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRule", reflect.TypeOf((*MockFraudRepositoryInterface)(nil).SaveRule), ctx, rule)
+}
+
+// MockSanctionsRepositoryInterface is a mock of SanctionsRepositoryInterface interface.
+type MockSanctionsRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSanctionsRepositoryInterfaceMockRecorder
+}
+
+// MockSanctionsRepositoryInterfaceMockRecorder is the mock recorder for MockSanctionsRepositoryInterface.
+type MockSanctionsRepositoryInterfaceMockRecorder struct {
+	mock *MockSanctionsRepositoryInterface
+}
+
+// NewMockSanctionsRepositoryInterface creates a new mock instance.
+func NewMockSanctionsRepositoryInterface(ctrl *gomock.Controller) *MockSanctionsRepositoryInterface {
+	mock := &MockSanctionsRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockSanctionsRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSanctionsRepositoryInterface) EXPECT() *MockSanctionsRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateMatch mocks base method.
+func (m *MockSanctionsRepositoryInterface) CreateMatch(ctx context.Context, match *models.SanctionsMatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMatch", ctx, match)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMatch indicates an expected call of CreateMatch.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) CreateMatch(ctx, match interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatch", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).CreateMatch), ctx, match)
+}
+
+// GetLatestListLoad mocks base method.
+func (m *MockSanctionsRepositoryInterface) GetLatestListLoad(ctx context.Context) (*models.SanctionsListLoad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestListLoad", ctx)
+	ret0, _ := ret[0].(*models.SanctionsListLoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestListLoad indicates an expected call of GetLatestListLoad.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) GetLatestListLoad(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestListLoad", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).GetLatestListLoad), ctx)
+}
+
+// GetListLoad mocks base method.
+func (m *MockSanctionsRepositoryInterface) GetListLoad(ctx context.Context, version string) (*models.SanctionsListLoad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListLoad", ctx, version)
+	ret0, _ := ret[0].(*models.SanctionsListLoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListLoad indicates an expected call of GetListLoad.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) GetListLoad(ctx, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListLoad", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).GetListLoad), ctx, version)
+}
+
+// GetMatchByID mocks base method.
+func (m *MockSanctionsRepositoryInterface) GetMatchByID(ctx context.Context, id uuid.UUID) (*models.SanctionsMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchByID", ctx, id)
+	ret0, _ := ret[0].(*models.SanctionsMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatchByID indicates an expected call of GetMatchByID.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) GetMatchByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchByID", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).GetMatchByID), ctx, id)
+}
+
+// ListCustomersAfter mocks base method.
+func (m *MockSanctionsRepositoryInterface) ListCustomersAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomersAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomersAfter indicates an expected call of ListCustomersAfter.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) ListCustomersAfter(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomersAfter", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).ListCustomersAfter), ctx, afterID, limit)
+}
+
+// ListExternalAccountsAfter mocks base method.
+func (m *MockSanctionsRepositoryInterface) ListExternalAccountsAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExternalAccountsAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExternalAccountsAfter indicates an expected call of ListExternalAccountsAfter.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) ListExternalAccountsAfter(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExternalAccountsAfter", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).ListExternalAccountsAfter), ctx, afterID, limit)
+}
+
+// ListMatches mocks base method.
+func (m *MockSanctionsRepositoryInterface) ListMatches(ctx context.Context, filters models.SanctionsMatchFilters, offset, limit int) ([]models.SanctionsMatch, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMatches", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.SanctionsMatch)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMatches indicates an expected call of ListMatches.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) ListMatches(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatches", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).ListMatches), ctx, filters, offset, limit)
+}
+
+// ListMatchesForSubject mocks base method.
+func (m *MockSanctionsRepositoryInterface) ListMatchesForSubject(ctx context.Context, subjectType string, subjectID uuid.UUID) ([]models.SanctionsMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMatchesForSubject", ctx, subjectType, subjectID)
+	ret0, _ := ret[0].([]models.SanctionsMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMatchesForSubject indicates an expected call of ListMatchesForSubject.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) ListMatchesForSubject(ctx, subjectType, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatchesForSubject", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).ListMatchesForSubject), ctx, subjectType, subjectID)
+}
+
+// SaveListLoad mocks base method.
+func (m *MockSanctionsRepositoryInterface) SaveListLoad(ctx context.Context, load *models.SanctionsListLoad) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveListLoad", ctx, load)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveListLoad indicates an expected call of SaveListLoad.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) SaveListLoad(ctx, load interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveListLoad", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).SaveListLoad), ctx, load)
+}
+
+// UpdateMatch mocks base method.
+func (m *MockSanctionsRepositoryInterface) UpdateMatch(ctx context.Context, match *models.SanctionsMatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMatch", ctx, match)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMatch indicates an expected call of UpdateMatch.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) UpdateMatch(ctx, match interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMatch", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).UpdateMatch), ctx, match)
+}
+
+// UpdateSubjectStatus mocks base method.
+func (m *MockSanctionsRepositoryInterface) UpdateSubjectStatus(ctx context.Context, subjectType string, subjectID uuid.UUID, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubjectStatus", ctx, subjectType, subjectID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubjectStatus indicates an expected call of UpdateSubjectStatus.
+func (mr *MockSanctionsRepositoryInterfaceMockRecorder) UpdateSubjectStatus(ctx, subjectType, subjectID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubjectStatus", reflect.TypeOf((*MockSanctionsRepositoryInterface)(nil).UpdateSubjectStatus), ctx, subjectType, subjectID, status)
+}
+
+// MockTransferReviewRepositoryInterface is a mock of TransferReviewRepositoryInterface interface.
+type MockTransferReviewRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferReviewRepositoryInterfaceMockRecorder
+}
+
+// MockTransferReviewRepositoryInterfaceMockRecorder is the mock recorder for MockTransferReviewRepositoryInterface.
+type MockTransferReviewRepositoryInterfaceMockRecorder struct {
+	mock *MockTransferReviewRepositoryInterface
+}
+
+// NewMockTransferReviewRepositoryInterface creates a new mock instance.
+func NewMockTransferReviewRepositoryInterface(ctrl *gomock.Controller) *MockTransferReviewRepositoryInterface {
+	mock := &MockTransferReviewRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferReviewRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferReviewRepositoryInterface) EXPECT() *MockTransferReviewRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockTransferReviewRepositoryInterface) Approve(ctx context.Context, reviewID, adminID uuid.UUID, note string) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, reviewID, adminID, note)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) Approve(ctx, reviewID, adminID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).Approve), ctx, reviewID, adminID, note)
+}
+
+// CountReleasedTransfersToExternalAccount mocks base method.
+func (m *MockTransferReviewRepositoryInterface) CountReleasedTransfersToExternalAccount(ctx context.Context, externalAccountID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReleasedTransfersToExternalAccount", ctx, externalAccountID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReleasedTransfersToExternalAccount indicates an expected call of CountReleasedTransfersToExternalAccount.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) CountReleasedTransfersToExternalAccount(ctx, externalAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReleasedTransfersToExternalAccount", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).CountReleasedTransfersToExternalAccount), ctx, externalAccountID)
+}
+
+// CreateHeld mocks base method.
+func (m *MockTransferReviewRepositoryInterface) CreateHeld(ctx context.Context, transfer *models.Transfer, review *models.TransferReview, debitDescription string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHeld", ctx, transfer, review, debitDescription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHeld indicates an expected call of CreateHeld.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) CreateHeld(ctx, transfer, review, debitDescription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHeld", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).CreateHeld), ctx, transfer, review, debitDescription)
+}
+
+// GetByID mocks base method.
+func (m *MockTransferReviewRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByTransferID mocks base method.
+func (m *MockTransferReviewRepositoryInterface) GetByTransferID(ctx context.Context, transferID uuid.UUID) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTransferID", ctx, transferID)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTransferID indicates an expected call of GetByTransferID.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) GetByTransferID(ctx, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTransferID", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).GetByTransferID), ctx, transferID)
+}
+
+// List mocks base method.
+func (m *MockTransferReviewRepositoryInterface) List(ctx context.Context, filters models.TransferReviewFilters, offset, limit int) ([]models.TransferReview, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.TransferReview)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) List(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).List), ctx, filters, offset, limit)
+}
+
+// ListRecentTransactions mocks base method.
+func (m *MockTransferReviewRepositoryInterface) ListRecentTransactions(ctx context.Context, userID uuid.UUID, limit int) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentTransactions", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentTransactions indicates an expected call of ListRecentTransactions.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) ListRecentTransactions(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentTransactions", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).ListRecentTransactions), ctx, userID, limit)
+}
+
+// ListRecentTransfers mocks base method.
+func (m *MockTransferReviewRepositoryInterface) ListRecentTransfers(ctx context.Context, userID uuid.UUID, limit int) ([]models.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentTransfers", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentTransfers indicates an expected call of ListRecentTransfers.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) ListRecentTransfers(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentTransfers", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).ListRecentTransfers), ctx, userID, limit)
+}
+
+// Reject mocks base method.
+func (m *MockTransferReviewRepositoryInterface) Reject(ctx context.Context, reviewID, adminID uuid.UUID, note, reason, reversalDescription string) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, reviewID, adminID, note, reason, reversalDescription)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockTransferReviewRepositoryInterfaceMockRecorder) Reject(ctx, reviewID, adminID, note, reason, reversalDescription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockTransferReviewRepositoryInterface)(nil).Reject), ctx, reviewID, adminID, note, reason, reversalDescription)
+}
+
+// MockJobRepositoryInterface is a mock of JobRepositoryInterface interface.
+type MockJobRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryInterfaceMockRecorder
+}
+
+// MockJobRepositoryInterfaceMockRecorder is the mock recorder for MockJobRepositoryInterface.
+type MockJobRepositoryInterfaceMockRecorder struct {
+	mock *MockJobRepositoryInterface
+}
+
+// NewMockJobRepositoryInterface creates a new mock instance.
+func NewMockJobRepositoryInterface(ctrl *gomock.Controller) *MockJobRepositoryInterface {
+	mock := &MockJobRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepositoryInterface) EXPECT() *MockJobRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AbandonRunsStartedBefore mocks base method.
+func (m *MockJobRepositoryInterface) AbandonRunsStartedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbandonRunsStartedBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbandonRunsStartedBefore indicates an expected call of AbandonRunsStartedBefore.
+func (mr *MockJobRepositoryInterfaceMockRecorder) AbandonRunsStartedBefore(ctx, cutoff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbandonRunsStartedBefore", reflect.TypeOf((*MockJobRepositoryInterface)(nil).AbandonRunsStartedBefore), ctx, cutoff)
+}
+
+// CreateRun mocks base method.
+func (m *MockJobRepositoryInterface) CreateRun(ctx context.Context, run *models.JobRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRun indicates an expected call of CreateRun.
+func (mr *MockJobRepositoryInterfaceMockRecorder) CreateRun(ctx, run interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRun", reflect.TypeOf((*MockJobRepositoryInterface)(nil).CreateRun), ctx, run)
+}
+
+// DeleteRunsBefore mocks base method.
+func (m *MockJobRepositoryInterface) DeleteRunsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRunsBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRunsBefore indicates an expected call of DeleteRunsBefore.
+func (mr *MockJobRepositoryInterfaceMockRecorder) DeleteRunsBefore(ctx, cutoff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRunsBefore", reflect.TypeOf((*MockJobRepositoryInterface)(nil).DeleteRunsBefore), ctx, cutoff)
+}
+
+// FinishRun mocks base method.
+func (m *MockJobRepositoryInterface) FinishRun(ctx context.Context, run *models.JobRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishRun indicates an expected call of FinishRun.
+func (mr *MockJobRepositoryInterfaceMockRecorder) FinishRun(ctx, run interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRun", reflect.TypeOf((*MockJobRepositoryInterface)(nil).FinishRun), ctx, run)
+}
+
+// GetStates mocks base method.
+func (m *MockJobRepositoryInterface) GetStates(ctx context.Context) (map[string]*models.ScheduledJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStates", ctx)
+	ret0, _ := ret[0].(map[string]*models.ScheduledJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStates indicates an expected call of GetStates.
+func (mr *MockJobRepositoryInterfaceMockRecorder) GetStates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStates", reflect.TypeOf((*MockJobRepositoryInterface)(nil).GetStates), ctx)
+}
+
+// LatestRuns mocks base method.
+func (m *MockJobRepositoryInterface) LatestRuns(ctx context.Context) (map[string]*models.JobRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestRuns", ctx)
+	ret0, _ := ret[0].(map[string]*models.JobRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestRuns indicates an expected call of LatestRuns.
+func (mr *MockJobRepositoryInterfaceMockRecorder) LatestRuns(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestRuns", reflect.TypeOf((*MockJobRepositoryInterface)(nil).LatestRuns), ctx)
+}
+
+// ListRuns mocks base method.
+func (m *MockJobRepositoryInterface) ListRuns(ctx context.Context, jobName string, offset, limit int) ([]models.JobRun, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", ctx, jobName, offset, limit)
+	ret0, _ := ret[0].([]models.JobRun)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockJobRepositoryInterfaceMockRecorder) ListRuns(ctx, jobName, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockJobRepositoryInterface)(nil).ListRuns), ctx, jobName, offset, limit)
+}
+
+// SetPaused mocks base method.
+func (m *MockJobRepositoryInterface) SetPaused(ctx context.Context, jobName string, paused bool, adminID uuid.UUID) (*models.ScheduledJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPaused", ctx, jobName, paused, adminID)
+	ret0, _ := ret[0].(*models.ScheduledJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPaused indicates an expected call of SetPaused.
+func (mr *MockJobRepositoryInterfaceMockRecorder) SetPaused(ctx, jobName, paused, adminID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByJTI", reflect.TypeOf((*MockBlacklistedTokenRepositoryInterface)(nil).GetByJTI), jti)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaused", reflect.TypeOf((*MockJobRepositoryInterface)(nil).SetPaused), ctx, jobName, paused, adminID)
 }
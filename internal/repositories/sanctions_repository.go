@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSanctionsMatchNotFound    = errors.New("sanctions match not found")
+	ErrSanctionsListLoadNotFound = errors.New("sanctions list load not found")
+)
+
+type sanctionsRepository struct {
+	db *gorm.DB
+}
+
+func NewSanctionsRepository(db *gorm.DB) SanctionsRepositoryInterface {
+	return &sanctionsRepository{db: db}
+}
+
+func (r *sanctionsRepository) CreateMatch(ctx context.Context, match *models.SanctionsMatch) error {
+	if err := r.db.WithContext(ctx).Create(match).Error; err != nil {
+		return fmt.Errorf("failed to create sanctions match: %w", err)
+	}
+	return nil
+}
+
+func (r *sanctionsRepository) UpdateMatch(ctx context.Context, match *models.SanctionsMatch) error {
+	if err := r.db.WithContext(ctx).Save(match).Error; err != nil {
+		return fmt.Errorf("failed to update sanctions match: %w", err)
+	}
+	return nil
+}
+
+func (r *sanctionsRepository) GetMatchByID(ctx context.Context, id uuid.UUID) (*models.SanctionsMatch, error) {
+	var match models.SanctionsMatch
+	if err := r.db.WithContext(ctx).First(&match, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSanctionsMatchNotFound
+		}
+		return nil, fmt.Errorf("failed to find sanctions match: %w", err)
+	}
+	return &match, nil
+}
+
+// ListMatches returns matches for the filters, newest first.
+func (r *sanctionsRepository) ListMatches(ctx context.Context, filters models.SanctionsMatchFilters, offset, limit int) ([]models.SanctionsMatch, int64, error) {
+	var matches []models.SanctionsMatch
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.SanctionsMatch{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.SubjectType != "" {
+		query = query.Where("subject_type = ?", filters.SubjectType)
+	}
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count sanctions matches: %w", err)
+	}
+
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&matches).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list sanctions matches: %w", err)
+	}
+
+	return matches, total, nil
+}
+
+// ListMatchesForSubject returns every match recorded for a customer or payee, in any status.
+func (r *sanctionsRepository) ListMatchesForSubject(ctx context.Context, subjectType string, subjectID uuid.UUID) ([]models.SanctionsMatch, error) {
+	var matches []models.SanctionsMatch
+	err := r.db.WithContext(ctx).Where("subject_type = ? AND subject_id = ?", subjectType, subjectID).
+		Order("created_at ASC").
+		Find(&matches).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sanctions matches for subject: %w", err)
+	}
+	return matches, nil
+}
+
+// UpdateSubjectStatus sets the screening status of a customer or payee without touching its
+// other columns.
+func (r *sanctionsRepository) UpdateSubjectStatus(ctx context.Context, subjectType string, subjectID uuid.UUID, status string) error {
+	var model interface{}
+	switch subjectType {
+	case models.SanctionsSubjectUser:
+		model = &models.User{}
+	case models.SanctionsSubjectExternalAccount:
+		model = &models.ExternalAccount{}
+	default:
+		return fmt.Errorf("unknown sanctions subject type %q", subjectType)
+	}
+
+	if err := r.db.WithContext(ctx).Model(model).Where("id = ?", subjectID).Update("sanctions_status", status).Error; err != nil {
+		return fmt.Errorf("failed to update sanctions status: %w", err)
+	}
+	return nil
+}
+
+// ListCustomersAfter returns up to limit customers with an ID greater than afterID, in ID
+// order, so a rescreen can page through all of them.
+func (r *sanctionsRepository) ListCustomersAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.User, error) {
+	var users []models.User
+	err := r.db.WithContext(ctx).Where("role = ? AND id > ?", models.RoleCustomer, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers: %w", err)
+	}
+	return users, nil
+}
+
+// ListExternalAccountsAfter returns up to limit payees with an ID greater than afterID, in
+// ID order.
+func (r *sanctionsRepository) ListExternalAccountsAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]models.ExternalAccount, error) {
+	var accounts []models.ExternalAccount
+	err := r.db.WithContext(ctx).Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&accounts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list external accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+func (r *sanctionsRepository) GetListLoad(ctx context.Context, version string) (*models.SanctionsListLoad, error) {
+	var load models.SanctionsListLoad
+	if err := r.db.WithContext(ctx).First(&load, "version = ?", version).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSanctionsListLoadNotFound
+		}
+		return nil, fmt.Errorf("failed to find sanctions list load: %w", err)
+	}
+	return &load, nil
+}
+
+// GetLatestListLoad returns the most recently loaded list version.
+func (r *sanctionsRepository) GetLatestListLoad(ctx context.Context) (*models.SanctionsListLoad, error) {
+	var load models.SanctionsListLoad
+	if err := r.db.WithContext(ctx).Order("loaded_at DESC").First(&load).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSanctionsListLoadNotFound
+		}
+		return nil, fmt.Errorf("failed to find latest sanctions list load: %w", err)
+	}
+	return &load, nil
+}
+
+func (r *sanctionsRepository) SaveListLoad(ctx context.Context, load *models.SanctionsListLoad) error {
+	if err := r.db.WithContext(ctx).Save(load).Error; err != nil {
+		return fmt.Errorf("failed to save sanctions list load: %w", err)
+	}
+	return nil
+}
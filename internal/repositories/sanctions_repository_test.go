@@ -0,0 +1,183 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SanctionsRepositoryTestSuite struct {
+	suite.Suite
+	db   *database.DB
+	repo SanctionsRepositoryInterface
+	user *models.User
+}
+
+func (s *SanctionsRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewSanctionsRepository(s.db.DB)
+	s.user = database.CreateTestUser(s.T(), s.db, "sanctions@example.com")
+}
+
+func (s *SanctionsRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestSanctionsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SanctionsRepositoryTestSuite))
+}
+
+func (s *SanctionsRepositoryTestSuite) createPayee(name string) *models.ExternalAccount {
+	payee := &models.ExternalAccount{
+		UserID:            s.user.ID,
+		ExternalAccountID: uuid.New(),
+		Nickname:          "Payee",
+		AccountNumberMask: "1234",
+		NameOnAccount:     name,
+		BankName:          "Test Bank",
+	}
+	s.Require().NoError(s.db.Create(payee).Error)
+	return payee
+}
+
+func (s *SanctionsRepositoryTestSuite) createMatch(subjectType string, subjectID uuid.UUID, status string, at time.Time) *models.SanctionsMatch {
+	match := &models.SanctionsMatch{
+		SubjectType:  subjectType,
+		SubjectID:    subjectID,
+		UserID:       s.user.ID,
+		ScreenedName: "Test User",
+		EntryID:      "7000",
+		EntryName:    "SANTOS, Maria",
+		Similarity:   90,
+		Action:       models.SanctionsMatchActionReview,
+		Status:       status,
+		ListVersion:  "v1",
+		CreatedAt:    at,
+	}
+	s.Require().NoError(s.repo.CreateMatch(context.Background(), match))
+	return match
+}
+
+func (s *SanctionsRepositoryTestSuite) TestMatches() {
+	now := time.Now()
+	payee := s.createPayee("Acme Supplies")
+	older := s.createMatch(models.SanctionsSubjectUser, s.user.ID, models.SanctionsMatchStatusOpen, now.Add(-time.Hour))
+	newer := s.createMatch(models.SanctionsSubjectUser, s.user.ID, models.SanctionsMatchStatusCleared, now)
+	s.createMatch(models.SanctionsSubjectExternalAccount, payee.ID, models.SanctionsMatchStatusOpen, now.Add(-time.Minute))
+
+	adminID := uuid.New()
+	older.Confirm(adminID, "Same date of birth")
+	s.Require().NoError(s.repo.UpdateMatch(context.Background(), older))
+
+	found, err := s.repo.GetMatchByID(context.Background(), older.ID)
+	s.Require().NoError(err)
+	s.Equal(models.SanctionsMatchStatusConfirmed, found.Status)
+	s.Equal(adminID, *found.ReviewedBy)
+	s.Equal("Same date of birth", found.ReviewNote)
+
+	subjectMatches, err := s.repo.ListMatchesForSubject(context.Background(), models.SanctionsSubjectUser, s.user.ID)
+	s.Require().NoError(err)
+	s.Require().Len(subjectMatches, 2)
+	s.Equal(older.ID, subjectMatches[0].ID)
+	s.Equal(newer.ID, subjectMatches[1].ID)
+
+	matches, total, err := s.repo.ListMatches(context.Background(), models.SanctionsMatchFilters{}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(3), total)
+	s.Equal(newer.ID, matches[0].ID)
+
+	_, total, err = s.repo.ListMatches(context.Background(), models.SanctionsMatchFilters{Status: models.SanctionsMatchStatusOpen}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(1), total)
+
+	_, total, err = s.repo.ListMatches(context.Background(), models.SanctionsMatchFilters{SubjectType: models.SanctionsSubjectUser, UserID: &s.user.ID}, 0, 10)
+	s.NoError(err)
+	s.Equal(int64(2), total)
+
+	_, err = s.repo.GetMatchByID(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrSanctionsMatchNotFound)
+}
+
+func (s *SanctionsRepositoryTestSuite) TestUpdateSubjectStatus() {
+	payee := s.createPayee("Acme Supplies")
+
+	s.Require().NoError(s.repo.UpdateSubjectStatus(context.Background(), models.SanctionsSubjectUser, s.user.ID, models.SanctionsStatusBlocked))
+	s.Require().NoError(s.repo.UpdateSubjectStatus(context.Background(), models.SanctionsSubjectExternalAccount, payee.ID, models.SanctionsStatusPendingReview))
+
+	var user models.User
+	s.Require().NoError(s.db.First(&user, "id = ?", s.user.ID).Error)
+	s.Equal(models.SanctionsStatusBlocked, user.SanctionsStatus)
+	s.Equal(s.user.FirstName, user.FirstName)
+
+	var account models.ExternalAccount
+	s.Require().NoError(s.db.First(&account, "id = ?", payee.ID).Error)
+	s.Equal(models.SanctionsStatusPendingReview, account.SanctionsStatus)
+
+	s.Error(s.repo.UpdateSubjectStatus(context.Background(), "account", s.user.ID, models.SanctionsStatusClear))
+}
+
+func (s *SanctionsRepositoryTestSuite) TestListCustomersAfter() {
+	database.CreateTestUser(s.T(), s.db, "second@example.com")
+	database.CreateTestUser(s.T(), s.db, "third@example.com")
+	database.CreateTestAdminUser(s.T(), s.db, "admin@example.com")
+
+	first, err := s.repo.ListCustomersAfter(context.Background(), uuid.Nil, 2)
+	s.Require().NoError(err)
+	s.Require().Len(first, 2)
+
+	rest, err := s.repo.ListCustomersAfter(context.Background(), first[1].ID, 2)
+	s.Require().NoError(err)
+	s.Require().Len(rest, 1)
+	s.True(first[1].ID.String() < rest[0].ID.String())
+	for _, user := range append(first, rest...) {
+		s.Equal(models.RoleCustomer, user.Role)
+	}
+}
+
+func (s *SanctionsRepositoryTestSuite) TestListExternalAccountsAfter() {
+	s.createPayee("First Payee")
+	s.createPayee("Second Payee")
+
+	first, err := s.repo.ListExternalAccountsAfter(context.Background(), uuid.Nil, 1)
+	s.Require().NoError(err)
+	s.Require().Len(first, 1)
+
+	rest, err := s.repo.ListExternalAccountsAfter(context.Background(), first[0].ID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(rest, 1)
+	s.NotEqual(first[0].ID, rest[0].ID)
+}
+
+func (s *SanctionsRepositoryTestSuite) TestListLoads() {
+	_, err := s.repo.GetLatestListLoad(context.Background())
+	s.ErrorIs(err, ErrSanctionsListLoadNotFound)
+
+	now := time.Now()
+	s.Require().NoError(s.repo.SaveListLoad(context.Background(), &models.SanctionsListLoad{Version: "v1", EntryCount: 10, LoadedAt: now.Add(-time.Hour)}))
+	latest := &models.SanctionsListLoad{Version: "v2", EntryCount: 12, LoadedAt: now}
+	s.Require().NoError(s.repo.SaveListLoad(context.Background(), latest))
+
+	rescreenedAt := now.Add(time.Minute)
+	latest.RescreenedAt = &rescreenedAt
+	latest.SubjectsCount = 5
+	latest.MatchesCount = 1
+	s.Require().NoError(s.repo.SaveListLoad(context.Background(), latest))
+
+	found, err := s.repo.GetLatestListLoad(context.Background())
+	s.Require().NoError(err)
+	s.Equal("v2", found.Version)
+	s.NotNil(found.RescreenedAt)
+	s.Equal(5, found.SubjectsCount)
+
+	found, err = s.repo.GetListLoad(context.Background(), "v1")
+	s.Require().NoError(err)
+	s.Nil(found.RescreenedAt)
+
+	_, err = s.repo.GetListLoad(context.Background(), "v3")
+	s.ErrorIs(err, ErrSanctionsListLoadNotFound)
+}
@@ -1,13 +1,16 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/array/banking-api/internal/models"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
@@ -26,18 +29,130 @@ func NewTransactionRepository(db *gorm.DB) TransactionRepositoryInterface {
 	}
 }
 
-// Create creates a new transaction
-func (r *transactionRepository) Create(transaction *models.Transaction) error {
-	if err := r.db.Create(transaction).Error; err != nil {
+// Create creates a new transaction and, once it is completed, its transaction.posted event
+func (r *transactionRepository) Create(ctx context.Context, transaction *models.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(transaction).Error; err != nil {
+			return fmt.Errorf("failed to create transaction: %w", err)
+		}
+		return appendOutboxEvents(tx, []*models.OutboxEvent{models.NewTransactionPostedEvent(transaction)})
+	})
+}
+
+// Post applies a credit or debit to its account's balance and records it as completed, with its
+// transaction.posted event, in a single database transaction.
+func (r *transactionRepository) Post(ctx context.Context, transaction *models.Transaction) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return postTransaction(tx, transaction)
+	})
+}
+
+// postTransaction applies a credit or debit to its account's balance and records it as completed
+// with its transaction.posted event, within tx. The account row is locked first, so the recorded
+// balances are the ones the transaction was applied to and concurrent postings cannot overwrite
+// each other.
+func postTransaction(tx *gorm.DB, transaction *models.Transaction) error {
+	if err := applyTransaction(tx, transaction); err != nil {
+		return err
+	}
+
+	transaction.Status = models.TransactionStatusCompleted
+	if err := tx.Create(transaction).Error; err != nil {
 		return fmt.Errorf("failed to create transaction: %w", err)
 	}
+	return appendOutboxEvents(tx, []*models.OutboxEvent{models.NewTransactionPostedEvent(transaction)})
+}
+
+// applyTransaction locks the transaction's account and applies the credit or debit to its balance
+// within tx, recording the balances before and after on the transaction.
+func applyTransaction(tx *gorm.DB, transaction *models.Transaction) error {
+	var account models.Account
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&account, "id = ?", transaction.AccountID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAccountNotFound
+		}
+		return fmt.Errorf("failed to lock account: %w", err)
+	}
+	if !account.IsActive() {
+		return ErrAccountNotActive
+	}
+
+	balanceBefore := account.Balance
+	balanceAfter := balanceBefore
+	switch transaction.TransactionType {
+	case models.TransactionTypeCredit:
+		balanceAfter = balanceBefore.Add(transaction.Amount)
+	case models.TransactionTypeDebit:
+		if balanceBefore.LessThan(transaction.Amount) {
+			return ErrInsufficientFunds
+		}
+		balanceAfter = balanceBefore.Sub(transaction.Amount)
+	default:
+		return fmt.Errorf("invalid transaction type: %s", transaction.TransactionType)
+	}
+
+	if err := tx.Model(&account).Update("balance", balanceAfter).Error; err != nil {
+		return fmt.Errorf("failed to update account balance: %w", err)
+	}
+
+	transaction.BalanceBefore = balanceBefore
+	transaction.BalanceAfter = balanceAfter
+	return nil
+}
+
+// CompletePending applies a queued transaction to its account's balance and completes it, with its
+// transaction.posted event and the audit log, in a single database transaction, as the synchronous
+// path records a posting. The transaction must still be pending at expectedVersion; otherwise
+// models.ErrOptimisticLockConflict is returned and nothing is applied, so a queue item processed
+// twice cannot move the balance twice.
+func (r *transactionRepository) CompletePending(ctx context.Context, transaction *models.Transaction, expectedVersion int, auditLog *models.AuditLog) error {
+	status, balanceBefore, balanceAfter, processedAt := transaction.Status, transaction.BalanceBefore, transaction.BalanceAfter, transaction.ProcessedAt
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := applyTransaction(tx, transaction); err != nil {
+			return err
+		}
+
+		transaction.Complete()
+		now := time.Now()
+		result := tx.Model(&models.Transaction{}).
+			Where("id = ? AND status = ? AND version = ?", transaction.ID, models.TransactionStatusPending, expectedVersion).
+			UpdateColumns(map[string]interface{}{
+				"status":         transaction.Status,
+				"balance_before": transaction.BalanceBefore,
+				"balance_after":  transaction.BalanceAfter,
+				"processed_at":   transaction.ProcessedAt,
+				"version":        expectedVersion + 1,
+				"updated_at":     now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to complete transaction: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return models.ErrOptimisticLockConflict
+		}
+		transaction.Version = expectedVersion + 1
+		transaction.UpdatedAt = now
+
+		if err := appendOutboxEvents(tx, []*models.OutboxEvent{models.NewTransactionPostedEvent(transaction)}); err != nil {
+			return err
+		}
+		if err := tx.Create(auditLog).Error; err != nil {
+			return fmt.Errorf("failed to create audit log: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		transaction.Status, transaction.BalanceBefore, transaction.BalanceAfter, transaction.ProcessedAt = status, balanceBefore, balanceAfter, processedAt
+		transaction.Version = expectedVersion
+		return err
+	}
 	return nil
 }
 
 // GetByID retrieves a transaction by ID
-func (r *transactionRepository) GetByID(id uuid.UUID) (*models.Transaction, error) {
+func (r *transactionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Transaction, error) {
 	transaction := &models.Transaction{ID: id}
-	if err := r.db.First(transaction).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(transaction).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrTransactionNotFound
 		}
@@ -47,17 +162,17 @@ func (r *transactionRepository) GetByID(id uuid.UUID) (*models.Transaction, erro
 }
 
 // GetByAccountID retrieves transactions for an account with pagination
-func (r *transactionRepository) GetByAccountID(accountID uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
+func (r *transactionRepository) GetByAccountID(ctx context.Context, accountID uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
 	var transactions []models.Transaction
 	var total int64
 
-	if err := r.db.Model(&models.Transaction{}).
+	if err := r.db.WithContext(ctx).Model(&models.Transaction{}).
 		Where("account_id = ?", accountID).
 		Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count transactions: %w", err)
 	}
 
-	if err := r.db.Where("account_id = ?", accountID).
+	if err := r.db.WithContext(ctx).Where("account_id = ?", accountID).
 		Offset(offset).Limit(limit).
 		Order("created_at DESC").
 		Find(&transactions).Error; err != nil {
@@ -68,9 +183,9 @@ func (r *transactionRepository) GetByAccountID(accountID uuid.UUID, offset, limi
 }
 
 // GetByReference retrieves a transaction by reference
-func (r *transactionRepository) GetByReference(reference string) (*models.Transaction, error) {
+func (r *transactionRepository) GetByReference(ctx context.Context, reference string) (*models.Transaction, error) {
 	var transaction models.Transaction
-	if err := r.db.Where("reference = ?", reference).First(&transaction).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("reference = ?", reference).First(&transaction).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrTransactionNotFound
 		}
@@ -80,9 +195,9 @@ func (r *transactionRepository) GetByReference(reference string) (*models.Transa
 }
 
 // GetRecentByAccountID retrieves recent transactions for an account
-func (r *transactionRepository) GetRecentByAccountID(accountID uuid.UUID, limit int) ([]models.Transaction, error) {
+func (r *transactionRepository) GetRecentByAccountID(ctx context.Context, accountID uuid.UUID, limit int) ([]models.Transaction, error) {
 	var transactions []models.Transaction
-	if err := r.db.Where("account_id = ?", accountID).
+	if err := r.db.WithContext(ctx).Where("account_id = ?", accountID).
 		Order("created_at DESC").
 		Limit(limit).
 		Find(&transactions).Error; err != nil {
@@ -92,9 +207,9 @@ func (r *transactionRepository) GetRecentByAccountID(accountID uuid.UUID, limit
 }
 
 // GetByDateRange retrieves transactions within a date range
-func (r *transactionRepository) GetByDateRange(accountID uuid.UUID, startDate, endDate time.Time) ([]models.Transaction, error) {
+func (r *transactionRepository) GetByDateRange(ctx context.Context, accountID uuid.UUID, startDate, endDate time.Time) ([]models.Transaction, error) {
 	var transactions []models.Transaction
-	if err := r.db.Where("account_id = ? AND created_at BETWEEN ? AND ?", accountID, startDate, endDate).
+	if err := r.db.WithContext(ctx).Where("account_id = ? AND created_at BETWEEN ? AND ?", accountID, startDate, endDate).
 		Order("created_at DESC").
 		Find(&transactions).Error; err != nil {
 		return nil, fmt.Errorf("failed to get transactions by date range: %w", err)
@@ -103,12 +218,12 @@ func (r *transactionRepository) GetByDateRange(accountID uuid.UUID, startDate, e
 }
 
 // CreateBatch creates multiple transactions in a single database transaction
-func (r *transactionRepository) CreateBatch(transactions []models.Transaction) error {
+func (r *transactionRepository) CreateBatch(ctx context.Context, transactions []models.Transaction) error {
 	if len(transactions) == 0 {
 		return nil
 	}
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(&transactions).Error; err != nil {
 			return fmt.Errorf("failed to create batch transactions: %w", err)
 		}
@@ -117,9 +232,9 @@ func (r *transactionRepository) CreateBatch(transactions []models.Transaction) e
 }
 
 // GetPendingTransactions retrieves all pending transactions
-func (r *transactionRepository) GetPendingTransactions(offset, limit int) ([]models.Transaction, error) {
+func (r *transactionRepository) GetPendingTransactions(ctx context.Context, offset, limit int) ([]models.Transaction, error) {
 	var transactions []models.Transaction
-	if err := r.db.Where("status = ?", models.TransactionStatusPending).
+	if err := r.db.WithContext(ctx).Where("status = ?", models.TransactionStatusPending).
 		Offset(offset).Limit(limit).
 		Order("created_at ASC").
 		Find(&transactions).Error; err != nil {
@@ -129,9 +244,9 @@ func (r *transactionRepository) GetPendingTransactions(offset, limit int) ([]mod
 }
 
 // UpdateStatus updates the status of a transaction
-func (r *transactionRepository) UpdateStatus(id uuid.UUID, status string) error {
+func (r *transactionRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status string) error {
 	now := time.Now()
-	result := r.db.Model(&models.Transaction{ID: id}).
+	result := r.db.WithContext(ctx).Model(&models.Transaction{ID: id}).
 		Updates(map[string]interface{}{
 			"status":       status,
 			"processed_at": now,
@@ -148,12 +263,12 @@ func (r *transactionRepository) UpdateStatus(id uuid.UUID, status string) error
 }
 
 // GetTotalsByAccountID calculates transaction totals for an account
-func (r *transactionRepository) GetTotalsByAccountID(accountID uuid.UUID) (credits, debits int64, creditAmount, debitAmount string, err error) {
+func (r *transactionRepository) GetTotalsByAccountID(ctx context.Context, accountID uuid.UUID) (credits, debits int64, creditAmount, debitAmount string, err error) {
 	var creditResult struct {
 		Count  int64
 		Amount string
 	}
-	if err := r.db.Model(&models.Transaction{}).
+	if err := r.db.WithContext(ctx).Model(&models.Transaction{}).
 		Select("COUNT(*) as count, COALESCE(SUM(amount), 0) as amount").
 		Where("account_id = ? AND transaction_type = ? AND status = ?",
 			accountID, models.TransactionTypeCredit, models.TransactionStatusCompleted).
@@ -165,7 +280,7 @@ func (r *transactionRepository) GetTotalsByAccountID(accountID uuid.UUID) (credi
 		Count  int64
 		Amount string
 	}
-	if err := r.db.Model(&models.Transaction{}).
+	if err := r.db.WithContext(ctx).Model(&models.Transaction{}).
 		Select("COUNT(*) as count, COALESCE(SUM(amount), 0) as amount").
 		Where("account_id = ? AND transaction_type = ? AND status = ?",
 			accountID, models.TransactionTypeDebit, models.TransactionStatusCompleted).
@@ -177,11 +292,11 @@ func (r *transactionRepository) GetTotalsByAccountID(accountID uuid.UUID) (credi
 }
 
 // GetByCategory retrieves transactions by category
-func (r *transactionRepository) GetByCategory(accountID uuid.UUID, category string, offset, limit int) ([]models.Transaction, int64, error) {
+func (r *transactionRepository) GetByCategory(ctx context.Context, accountID uuid.UUID, category string, offset, limit int) ([]models.Transaction, int64, error) {
 	var transactions []models.Transaction
 	var total int64
 
-	query := r.db.Model(&models.Transaction{}).
+	query := r.db.WithContext(ctx).Model(&models.Transaction{}).
 		Where("account_id = ? AND category = ?", accountID, category)
 
 	if err := query.Count(&total).Error; err != nil {
@@ -198,11 +313,11 @@ func (r *transactionRepository) GetByCategory(accountID uuid.UUID, category stri
 }
 
 // GetWithFilters retrieves transactions with multiple filters
-func (r *transactionRepository) GetWithFilters(filters models.TransactionFilters) ([]models.Transaction, int64, error) {
+func (r *transactionRepository) GetWithFilters(ctx context.Context, filters models.TransactionFilters) ([]models.Transaction, int64, error) {
 	var transactions []models.Transaction
 	var total int64
 
-	query := r.db.Model(&models.Transaction{})
+	query := r.db.WithContext(ctx).Model(&models.Transaction{})
 
 	if filters.AccountID != uuid.Nil {
 		query = query.Where("account_id = ?", filters.AccountID)
@@ -245,11 +360,21 @@ func (r *transactionRepository) GetWithFilters(filters models.TransactionFilters
 	return transactions, total, nil
 }
 
-// UpdateWithOptimisticLock updates a transaction with optimistic locking
-func (r *transactionRepository) UpdateWithOptimisticLock(transaction *models.Transaction, expectedVersion int) error {
-	result := r.db.Model(&models.Transaction{ID: transaction.ID}).
-		Where("version = ?", expectedVersion).
-		Updates(transaction)
+// UpdateWithOptimisticLock saves the transaction's status change, provided its version is still
+// expectedVersion, and bumps the version. ErrOptimisticLockConflict is returned otherwise.
+func (r *transactionRepository) UpdateWithOptimisticLock(ctx context.Context, transaction *models.Transaction, expectedVersion int) error {
+	now := time.Now()
+	result := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("id = ? AND version = ?", transaction.ID, expectedVersion).
+		UpdateColumns(map[string]interface{}{
+			"status":             transaction.Status,
+			"metadata":           transaction.Metadata,
+			"processed_at":       transaction.ProcessedAt,
+			"reversed_at":        transaction.ReversedAt,
+			"reversal_reference": transaction.ReversalReference,
+			"version":            expectedVersion + 1,
+			"updated_at":         now,
+		})
 
 	if result.Error != nil {
 		return fmt.Errorf("failed to update transaction with optimistic lock: %w", result.Error)
@@ -257,16 +382,51 @@ func (r *transactionRepository) UpdateWithOptimisticLock(transaction *models.Tra
 	if result.RowsAffected == 0 {
 		return models.ErrOptimisticLockConflict
 	}
+	transaction.Version = expectedVersion + 1
+	transaction.UpdatedAt = now
+
+	return nil
+}
+
+// FailPending fails a pending transaction with the given reason, provided it is still pending at
+// expectedVersion. ErrOptimisticLockConflict is returned, and the transaction left as it was, when
+// it has been completed, failed or otherwise changed since it was read.
+func (r *transactionRepository) FailPending(ctx context.Context, transaction *models.Transaction, expectedVersion int, reason string) error {
+	status, processedAt, metadata := transaction.Status, transaction.ProcessedAt, maps.Clone(transaction.Metadata)
+	restore := func() {
+		transaction.Status, transaction.ProcessedAt, transaction.Metadata = status, processedAt, metadata
+	}
+	transaction.FailWithReason(reason)
 
+	now := time.Now()
+	result := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("id = ? AND status = ? AND version = ?", transaction.ID, models.TransactionStatusPending, expectedVersion).
+		UpdateColumns(map[string]interface{}{
+			"status":       transaction.Status,
+			"metadata":     transaction.Metadata,
+			"processed_at": transaction.ProcessedAt,
+			"version":      expectedVersion + 1,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		restore()
+		return fmt.Errorf("failed to fail transaction: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		restore()
+		return models.ErrOptimisticLockConflict
+	}
+	transaction.Version = expectedVersion + 1
+	transaction.UpdatedAt = now
 	return nil
 }
 
 // GetExpiredPendingTransactions retrieves pending transactions that have expired
-func (r *transactionRepository) GetExpiredPendingTransactions(limit int) ([]models.Transaction, error) {
+func (r *transactionRepository) GetExpiredPendingTransactions(ctx context.Context, limit int) ([]models.Transaction, error) {
 	var transactions []models.Transaction
 	now := time.Now()
 
-	if err := r.db.Where("status = ? AND pending_until IS NOT NULL AND pending_until < ?",
+	if err := r.db.WithContext(ctx).Where("status = ? AND pending_until IS NOT NULL AND pending_until < ?",
 		models.TransactionStatusPending, now).
 		Limit(limit).
 		Order("pending_until ASC").
@@ -278,7 +438,7 @@ func (r *transactionRepository) GetExpiredPendingTransactions(limit int) ([]mode
 }
 
 // GetCategorySummary retrieves transaction summary grouped by category
-func (r *transactionRepository) GetCategorySummary(accountID uuid.UUID, startDate, endDate time.Time) ([]models.CategorySummary, error) {
+func (r *transactionRepository) GetCategorySummary(ctx context.Context, accountID uuid.UUID, startDate, endDate time.Time) ([]models.CategorySummary, error) {
 	var summaries []models.CategorySummary
 
 	query := `
@@ -296,7 +456,7 @@ func (r *transactionRepository) GetCategorySummary(accountID uuid.UUID, startDat
 		ORDER BY total_amount DESC
 	`
 
-	if err := r.db.Raw(query, accountID, startDate, endDate, models.TransactionStatusCompleted).
+	if err := r.db.WithContext(ctx).Raw(query, accountID, startDate, endDate, models.TransactionStatusCompleted).
 		Scan(&summaries).Error; err != nil {
 		return nil, fmt.Errorf("failed to get category summary: %w", err)
 	}
@@ -0,0 +1,257 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransactionRepositoryTestSuite struct {
+	suite.Suite
+	db          *database.DB
+	repo        TransactionRepositoryInterface
+	accountRepo AccountRepositoryInterface
+	user        *models.User
+}
+
+func (s *TransactionRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewTransactionRepository(s.db.DB)
+	s.accountRepo = NewAccountRepository(s.db.DB)
+	s.user = database.CreateTestUser(s.T(), s.db, "ledger@example.com")
+}
+
+func (s *TransactionRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestTransactionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactionRepositoryTestSuite))
+}
+
+func (s *TransactionRepositoryTestSuite) createAccount(number string, balance float64) *models.Account {
+	account := &models.Account{
+		UserID:        s.user.ID,
+		AccountNumber: number,
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(balance),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.db.Create(account).Error)
+	return account
+}
+
+func (s *TransactionRepositoryTestSuite) balance(accountID uuid.UUID) decimal.Decimal {
+	account, err := s.accountRepo.GetByID(context.Background(), accountID)
+	s.Require().NoError(err)
+	return account.Balance
+}
+
+// pending records a transaction as SubmitTransaction does, before a worker processes it
+func (s *TransactionRepositoryTestSuite) pending(accountID uuid.UUID, amount float64) *models.Transaction {
+	transaction := &models.Transaction{
+		AccountID:       accountID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromFloat(amount),
+		Description:     "ATM withdrawal",
+		Status:          models.TransactionStatusPending,
+		Reference:       models.GenerateTransactionReference(),
+	}
+	s.Require().NoError(s.repo.Create(context.Background(), transaction))
+	return transaction
+}
+
+func (s *TransactionRepositoryTestSuite) auditLog(transaction *models.Transaction) *models.AuditLog {
+	return &models.AuditLog{
+		UserID:     &s.user.ID,
+		Action:     "transaction.debit",
+		Resource:   "transaction",
+		ResourceID: transaction.ID.String(),
+	}
+}
+
+func (s *TransactionRepositoryTestSuite) postedEvents(transactionID uuid.UUID) []models.OutboxEvent {
+	var events []models.OutboxEvent
+	s.Require().NoError(s.db.Where("aggregate_id = ? AND event_type = ?", transactionID, models.EventTypeTransactionPosted).Find(&events).Error)
+	return events
+}
+
+func (s *TransactionRepositoryTestSuite) TestPost_AppliesBalanceAndRecordsTransaction() {
+	account := s.createAccount("1012345678", 1000)
+	transaction := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: models.TransactionTypeCredit,
+		Amount:          decimal.NewFromFloat(250),
+		Description:     "Deposit",
+		Reference:       models.GenerateTransactionReference(),
+	}
+
+	s.Require().NoError(s.repo.Post(context.Background(), transaction))
+
+	s.True(s.balance(account.ID).Equal(decimal.NewFromFloat(1250)))
+	stored, err := s.repo.GetByID(context.Background(), transaction.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransactionStatusCompleted, stored.Status)
+	s.True(stored.BalanceBefore.Equal(decimal.NewFromFloat(1000)))
+	s.True(stored.BalanceAfter.Equal(decimal.NewFromFloat(1250)))
+	s.Len(s.postedEvents(transaction.ID), 1)
+}
+
+func (s *TransactionRepositoryTestSuite) TestPost_InsufficientFundsWritesNothing() {
+	account := s.createAccount("1012345678", 50)
+	transaction := &models.Transaction{
+		AccountID:       account.ID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromFloat(100),
+		Description:     "Withdrawal",
+		Reference:       models.GenerateTransactionReference(),
+	}
+
+	s.ErrorIs(s.repo.Post(context.Background(), transaction), ErrInsufficientFunds)
+
+	s.True(s.balance(account.ID).Equal(decimal.NewFromFloat(50)))
+	var count int64
+	s.Require().NoError(s.db.Model(&models.Transaction{}).Where("account_id = ?", account.ID).Count(&count).Error)
+	s.Zero(count)
+}
+
+func (s *TransactionRepositoryTestSuite) TestCompletePending_MatchesSynchronousPosting() {
+	syncAccount := s.createAccount("1012345678", 1000)
+	asyncAccount := s.createAccount("1087654321", 1000)
+
+	// The synchronous path applies the debit and records it completed straight away
+	synchronous := &models.Transaction{
+		AccountID:       syncAccount.ID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromFloat(100),
+		Description:     "ATM withdrawal",
+		Status:          models.TransactionStatusCompleted,
+		Reference:       models.GenerateTransactionReference(),
+	}
+	s.Require().NoError(s.repo.Post(context.Background(), synchronous))
+
+	queued := s.pending(asyncAccount.ID, 100)
+	s.Empty(s.postedEvents(queued.ID))
+	s.Require().NoError(s.repo.CompletePending(context.Background(), queued, queued.Version, s.auditLog(queued)))
+
+	s.True(s.balance(syncAccount.ID).Equal(s.balance(asyncAccount.ID)))
+
+	stored, err := s.repo.GetByID(context.Background(), queued.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransactionStatusCompleted, stored.Status)
+	s.NotNil(stored.ProcessedAt)
+	s.True(stored.BalanceBefore.Equal(decimal.NewFromFloat(1000)))
+	s.True(stored.BalanceAfter.Equal(decimal.NewFromFloat(900)))
+	s.Equal(queued.Version, stored.Version)
+
+	syncEvents := s.postedEvents(synchronous.ID)
+	asyncEvents := s.postedEvents(queued.ID)
+	s.Require().Len(syncEvents, 1)
+	s.Require().Len(asyncEvents, 1)
+	for _, key := range []string{"transaction_type", "amount", "balance_before", "balance_after", "description"} {
+		s.Equal(syncEvents[0].Payload[key], asyncEvents[0].Payload[key], key)
+	}
+	s.Equal(asyncAccount.ID.String(), asyncEvents[0].Payload["account_id"])
+
+	var audit models.AuditLog
+	s.Require().NoError(s.db.Where("resource = ? AND resource_id = ?", "transaction", queued.ID.String()).First(&audit).Error)
+	s.Equal("transaction.debit", audit.Action)
+}
+
+func (s *TransactionRepositoryTestSuite) TestCompletePending_OnlyOnce() {
+	account := s.createAccount("1012345678", 1000)
+	queued := s.pending(account.ID, 100)
+	version := queued.Version
+	s.Require().NoError(s.repo.CompletePending(context.Background(), queued, version, s.auditLog(queued)))
+
+	// A second worker read the transaction before the first completed it
+	stale, err := s.repo.GetByID(context.Background(), queued.ID)
+	s.Require().NoError(err)
+	stale.Status = models.TransactionStatusPending
+	stale.Version = version
+	err = s.repo.CompletePending(context.Background(), stale, version, s.auditLog(stale))
+	s.ErrorIs(err, models.ErrOptimisticLockConflict)
+	s.Equal(models.TransactionStatusPending, stale.Status)
+
+	s.True(s.balance(account.ID).Equal(decimal.NewFromFloat(900)))
+	s.Len(s.postedEvents(queued.ID), 1)
+	var audits int64
+	s.Require().NoError(s.db.Model(&models.AuditLog{}).Where("resource_id = ?", queued.ID.String()).Count(&audits).Error)
+	s.Equal(int64(1), audits)
+}
+
+func (s *TransactionRepositoryTestSuite) TestCompletePending_InsufficientFundsWritesNothing() {
+	account := s.createAccount("1012345678", 50)
+	queued := s.pending(account.ID, 100)
+
+	err := s.repo.CompletePending(context.Background(), queued, queued.Version, s.auditLog(queued))
+	s.ErrorIs(err, ErrInsufficientFunds)
+	s.Equal(models.TransactionStatusPending, queued.Status)
+
+	stored, err := s.repo.GetByID(context.Background(), queued.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransactionStatusPending, stored.Status)
+	s.True(s.balance(account.ID).Equal(decimal.NewFromFloat(50)))
+	s.Empty(s.postedEvents(queued.ID))
+}
+
+func (s *TransactionRepositoryTestSuite) TestFailPending_FailsPendingTransaction() {
+	account := s.createAccount("1012345678", 1000)
+	queued := s.pending(account.ID, 100)
+	version := queued.Version
+
+	s.Require().NoError(s.repo.FailPending(context.Background(), queued, version, models.TransactionFailureProcessingFailed))
+	s.Equal(models.TransactionStatusFailed, queued.Status)
+	s.Equal(version+1, queued.Version)
+
+	stored, err := s.repo.GetByID(context.Background(), queued.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransactionStatusFailed, stored.Status)
+	s.Equal(models.TransactionFailureProcessingFailed, stored.FailureReason())
+	s.NotNil(stored.ProcessedAt)
+	s.Equal(version+1, stored.Version)
+	s.True(s.balance(account.ID).Equal(decimal.NewFromFloat(1000)))
+}
+
+func (s *TransactionRepositoryTestSuite) TestFailPending_LeavesCompletedTransaction() {
+	account := s.createAccount("1012345678", 1000)
+	queued := s.pending(account.ID, 100)
+	version := queued.Version
+	stale := *queued
+	s.Require().NoError(s.repo.CompletePending(context.Background(), queued, version, s.auditLog(queued)))
+
+	// A worker that read the transaction before it completed tries to fail it
+	err := s.repo.FailPending(context.Background(), &stale, version, models.TransactionFailureProcessingFailed)
+	s.ErrorIs(err, models.ErrOptimisticLockConflict)
+	s.Equal(models.TransactionStatusPending, stale.Status)
+	s.Empty(stale.FailureReason())
+
+	stored, err := s.repo.GetByID(context.Background(), queued.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransactionStatusCompleted, stored.Status)
+	s.True(s.balance(account.ID).Equal(decimal.NewFromFloat(900)))
+}
+
+func (s *TransactionRepositoryTestSuite) TestUpdateWithOptimisticLock_SavesStatusChangeOnce() {
+	account := s.createAccount("1012345678", 1000)
+	queued := s.pending(account.ID, 100)
+	s.Require().NoError(s.repo.CompletePending(context.Background(), queued, queued.Version, s.auditLog(queued)))
+
+	version := queued.Version
+	queued.Reverse()
+	s.Require().NoError(s.repo.UpdateWithOptimisticLock(context.Background(), queued, version))
+
+	stored, err := s.repo.GetByID(context.Background(), queued.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransactionStatusReversed, stored.Status)
+	s.NotNil(stored.ReversedAt)
+	s.Equal(version+1, stored.Version)
+
+	s.ErrorIs(s.repo.UpdateWithOptimisticLock(context.Background(), queued, version), models.ErrOptimisticLockConflict)
+}
@@ -1,17 +1,21 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/array/banking-api/internal/models"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 var (
 	ErrTransferNotFound             = errors.New("transfer not found")
 	ErrTransferIdempotencyKeyExists = errors.New("transfer with idempotency key already exists")
+	ErrTransferStatusChanged        = errors.New("transfer status changed")
 )
 
 // transferRepository implements TransferRepository interface
@@ -27,12 +31,12 @@ func NewTransferRepository(db *gorm.DB) TransferRepositoryInterface {
 }
 
 // Create creates a new transfer
-func (r *transferRepository) Create(transfer *models.Transfer) error {
+func (r *transferRepository) Create(ctx context.Context, transfer *models.Transfer) error {
 	if transfer == nil {
 		return errors.New("transfer cannot be nil")
 	}
 
-	if err := r.db.Create(transfer).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(transfer).Error; err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) || isDuplicateKeyError(err) {
 			return ErrTransferIdempotencyKeyExists
 		}
@@ -43,22 +47,73 @@ func (r *transferRepository) Create(transfer *models.Transfer) error {
 }
 
 // Update updates an existing transfer
-func (r *transferRepository) Update(transfer *models.Transfer) error {
+func (r *transferRepository) Update(ctx context.Context, transfer *models.Transfer) error {
 	if transfer == nil {
 		return errors.New("transfer cannot be nil")
 	}
 
-	if err := r.db.Save(transfer).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(transfer).Error; err != nil {
 		return fmt.Errorf("failed to update transfer: %w", err)
 	}
 
 	return nil
 }
 
+// UpdateWithEvents saves the transfer and writes the outbox events in one database transaction
+func (r *transferRepository) UpdateWithEvents(ctx context.Context, transfer *models.Transfer, events ...*models.OutboxEvent) error {
+	if transfer == nil {
+		return errors.New("transfer cannot be nil")
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(transfer).Error; err != nil {
+			return fmt.Errorf("failed to update transfer: %w", err)
+		}
+		return appendOutboxEvents(tx, events)
+	})
+}
+
+// UpdateStatusFrom writes the transfer's status only if the stored status is still from, so a
+// callback and a poll racing on the same transfer cannot overwrite each other. Returns
+// ErrTransferStatusChanged when the transfer has already moved on.
+func (r *transferRepository) UpdateStatusFrom(ctx context.Context, transfer *models.Transfer, from string) error {
+	result := r.db.WithContext(ctx).Model(&models.Transfer{}).
+		Where("id = ? AND status = ?", transfer.ID, from).
+		UpdateColumns(map[string]interface{}{"status": transfer.Status, "updated_at": time.Now()})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update transfer status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransferStatusChanged
+	}
+	return nil
+}
+
+// UpdateStatusCheck writes only the polling backoff and escalation columns, and only while the
+// transfer is still in the status it was read with. Returns ErrTransferStatusChanged otherwise.
+func (r *transferRepository) UpdateStatusCheck(ctx context.Context, transfer *models.Transfer) error {
+	result := r.db.WithContext(ctx).Model(&models.Transfer{}).
+		Where("id = ? AND status = ?", transfer.ID, transfer.Status).
+		UpdateColumns(map[string]interface{}{
+			"status_check_attempts": transfer.StatusCheckAttempts,
+			"next_status_check_at":  transfer.NextStatusCheckAt,
+			"last_status_check_at":  transfer.LastStatusCheckAt,
+			"escalated_at":          transfer.EscalatedAt,
+			"updated_at":            time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to record transfer status check: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransferStatusChanged
+	}
+	return nil
+}
+
 // FindByID retrieves a transfer by ID
-func (r *transferRepository) FindByID(id uuid.UUID) (*models.Transfer, error) {
+func (r *transferRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Transfer, error) {
 	transfer := &models.Transfer{ID: id}
-	if err := r.db.First(transfer).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(transfer).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrTransferNotFound
 		}
@@ -69,10 +124,10 @@ func (r *transferRepository) FindByID(id uuid.UUID) (*models.Transfer, error) {
 }
 
 // FindByIdempotencyKey retrieves a transfer by idempotency key
-func (r *transferRepository) FindByIdempotencyKey(key string) (*models.Transfer, error) {
+func (r *transferRepository) FindByIdempotencyKey(ctx context.Context, key string) (*models.Transfer, error) {
 	var transfer models.Transfer
 
-	if err := r.db.Where("idempotency_key = ?", key).First(&transfer).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("idempotency_key = ?", key).First(&transfer).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrTransferNotFound
 		}
@@ -82,13 +137,19 @@ func (r *transferRepository) FindByIdempotencyKey(key string) (*models.Transfer,
 	return &transfer, nil
 }
 
-// FindPendingExternal retrieves external transfers that are in a non-terminal state.
-func (r *transferRepository) FindPendingExternal(limit int) ([]models.Transfer, error) {
+// FindPendingExternal retrieves external transfers in a non-terminal state whose next status
+// check is due by the given time. Escalated transfers are excluded; they wait in the admin queue.
+// Transfers whose saga is still at debit_posted are excluded too: their submission outcome is
+// unknown and only the saga recovery worker may resubmit or compensate them.
+func (r *transferRepository) FindPendingExternal(ctx context.Context, dueBy time.Time, limit int) ([]models.Transfer, error) {
 	var transfers []models.Transfer
 	// 'processing' is a status from Northwind, 'pending' is our initial state before Northwind confirms.
-	pendingStatuses := []string{models.TransferStatusPending, "processing"}
+	pendingStatuses := []string{models.TransferStatusPending, models.TransferStatusProcessing}
 
-	err := r.db.Where("to_external_account_id IS NOT NULL AND status IN ?", pendingStatuses).
+	err := r.db.WithContext(ctx).Where("to_external_account_id IS NOT NULL AND status IN ?", pendingStatuses).
+		Where("escalated_at IS NULL").
+		Where("NOT EXISTS (SELECT 1 FROM transfer_sagas WHERE transfer_sagas.transfer_id = transfers.id AND transfer_sagas.step = ?)", models.TransferSagaStepDebitPosted).
+		Where("next_status_check_at IS NULL OR next_status_check_at <= ?", dueBy).
 		Limit(limit).
 		Order("created_at ASC"). // Process oldest first
 		Find(&transfers).Error
@@ -98,13 +159,71 @@ func (r *transferRepository) FindPendingExternal(limit int) ([]models.Transfer,
 	return transfers, nil
 }
 
+// CountPendingExternal counts external transfers still waiting for a final status from Northwind,
+// separating those escalated to the admin queue.
+func (r *transferRepository) CountPendingExternal(ctx context.Context) (pending, escalated int64, err error) {
+	var rows []struct {
+		Escalated bool
+		Count     int64
+	}
+	if err := r.db.WithContext(ctx).Model(&models.Transfer{}).
+		Select("escalated_at IS NOT NULL AS escalated, COUNT(*) AS count").
+		Where("to_external_account_id IS NOT NULL AND status IN ?", []string{models.TransferStatusPending, models.TransferStatusProcessing}).
+		Group("escalated_at IS NOT NULL").
+		Scan(&rows).Error; err != nil {
+		return 0, 0, fmt.Errorf("failed to count pending external transfers: %w", err)
+	}
+
+	for _, row := range rows {
+		if row.Escalated {
+			escalated = row.Count
+		} else {
+			pending = row.Count
+		}
+	}
+	return pending, escalated, nil
+}
+
+// FindByExternalTransferID retrieves a transfer by the ID assigned by the external provider
+func (r *transferRepository) FindByExternalTransferID(ctx context.Context, externalTransferID string) (*models.Transfer, error) {
+	var transfer models.Transfer
+	if err := r.db.WithContext(ctx).Where("external_transfer_id = ?", externalTransferID).First(&transfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to find transfer by external id: %w", err)
+	}
+
+	return &transfer, nil
+}
+
+// FindEscalatedExternal retrieves unresolved external transfers escalated to the admin queue, oldest first
+func (r *transferRepository) FindEscalatedExternal(ctx context.Context, offset, limit int) ([]models.Transfer, int64, error) {
+	var transfers []models.Transfer
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Transfer{}).
+		Where("to_external_account_id IS NOT NULL AND escalated_at IS NOT NULL").
+		Where("status IN ?", []string{models.TransferStatusPending, models.TransferStatusProcessing})
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count escalated transfers: %w", err)
+	}
+
+	if err := query.Order("escalated_at ASC").Offset(offset).Limit(limit).Find(&transfers).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to find escalated transfers: %w", err)
+	}
+
+	return transfers, total, nil
+}
+
 // FindByUserAccounts retrieves transfers involving any of the user's accounts
-func (r *transferRepository) FindByUserAccounts(accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
-	return r.FindByUserAccountsWithFilters(accountIDs, models.TransferFilters{}, offset, limit)
+func (r *transferRepository) FindByUserAccounts(ctx context.Context, accountIDs []uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
+	return r.FindByUserAccountsWithFilters(ctx, accountIDs, models.TransferFilters{}, offset, limit)
 }
 
 // FindByUserAccountsWithFilters retrieves transfers with filtering options
-func (r *transferRepository) FindByUserAccountsWithFilters(accountIDs []uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
+func (r *transferRepository) FindByUserAccountsWithFilters(ctx context.Context, accountIDs []uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
 	var transfers []models.Transfer
 	var total int64
 
@@ -112,7 +231,7 @@ func (r *transferRepository) FindByUserAccountsWithFilters(accountIDs []uuid.UUI
 		return transfers, 0, nil
 	}
 
-	query := r.db.Model(&models.Transfer{}).
+	query := r.db.WithContext(ctx).Model(&models.Transfer{}).
 		Where("from_account_id IN ? OR to_account_id IN ?", accountIDs, accountIDs)
 
 	if filters.Status != "" {
@@ -150,14 +269,14 @@ func (r *transferRepository) FindByUserAccountsWithFilters(accountIDs []uuid.UUI
 }
 
 // CountByUserAccounts counts transfers involving any of the user's accounts
-func (r *transferRepository) CountByUserAccounts(accountIDs []uuid.UUID) (int64, error) {
+func (r *transferRepository) CountByUserAccounts(ctx context.Context, accountIDs []uuid.UUID) (int64, error) {
 	var count int64
 
 	if len(accountIDs) == 0 {
 		return 0, nil
 	}
 
-	if err := r.db.Model(&models.Transfer{}).
+	if err := r.db.WithContext(ctx).Model(&models.Transfer{}).
 		Where("from_account_id IN ? OR to_account_id IN ?", accountIDs, accountIDs).
 		Count(&count).Error; err != nil {
 		return 0, fmt.Errorf("failed to count transfers by user accounts: %w", err)
@@ -165,3 +284,68 @@ func (r *transferRepository) CountByUserAccounts(accountIDs []uuid.UUID) (int64,
 
 	return count, nil
 }
+
+// FindByExternalAccount retrieves transfers sent to a specific external account (payee)
+func (r *transferRepository) FindByExternalAccount(ctx context.Context, externalAccountID uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
+	var transfers []models.Transfer
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Transfer{}).Where("to_external_account_id = ?", externalAccountID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count transfers for external account: %w", err)
+	}
+
+	if err := query.Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&transfers).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to find transfers for external account: %w", err)
+	}
+
+	return transfers, total, nil
+}
+
+// GetExternalAccountStats aggregates transfer counts and completed volume for a payee
+func (r *transferRepository) GetExternalAccountStats(ctx context.Context, externalAccountID uuid.UUID) (*models.ExternalAccountTransferStats, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+		Total  decimal.Decimal
+	}
+
+	if err := r.db.WithContext(ctx).Model(&models.Transfer{}).
+		Select("status, COUNT(*) AS count, COALESCE(SUM(amount), 0) AS total").
+		Where("to_external_account_id = ?", externalAccountID).
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate transfers for external account: %w", err)
+	}
+
+	stats := &models.ExternalAccountTransferStats{TotalAmountSent: decimal.Zero}
+	for _, row := range rows {
+		stats.TotalTransfers += row.Count
+		switch row.Status {
+		case models.TransferStatusCompleted:
+			stats.CompletedTransfers += row.Count
+			stats.TotalAmountSent = stats.TotalAmountSent.Add(row.Total)
+		case models.TransferStatusPending, models.TransferStatusProcessing:
+			stats.PendingTransfers += row.Count
+		case models.TransferStatusFailed:
+			stats.FailedTransfers += row.Count
+		}
+	}
+
+	if stats.TotalTransfers > 0 {
+		var latest models.Transfer
+		if err := r.db.WithContext(ctx).Select("created_at").
+			Where("to_external_account_id = ?", externalAccountID).
+			Order("created_at DESC").
+			First(&latest).Error; err != nil {
+			return nil, fmt.Errorf("failed to find latest transfer for external account: %w", err)
+		}
+		stats.LastTransferAt = &latest.CreatedAt
+	}
+
+	return stats, nil
+}
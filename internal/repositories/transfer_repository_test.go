@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -30,7 +31,7 @@ func (s *TransferRepositoryTestSuite) SetupTest() {
 	})
 	require.NoError(s.T(), err)
 
-	err = db.AutoMigrate(&models.Transfer{}, &models.Account{}, &models.User{}, &models.Transaction{})
+	err = db.AutoMigrate(&models.Transfer{}, &models.Account{}, &models.User{}, &models.Transaction{}, &models.OutboxEvent{}, &models.TransferSaga{})
 	require.NoError(s.T(), err)
 
 	s.db = db
@@ -52,9 +53,10 @@ func TestTransferRepositoryTestSuite(t *testing.T) {
 
 // Helper function to create a test transfer
 func (s *TransferRepositoryTestSuite) createTestTransfer() *models.Transfer {
+	toAccountID := uuid.New()
 	return &models.Transfer{
 		FromAccountID:  uuid.New(),
-		ToAccountID:    uuid.New(),
+		ToAccountID:    &toAccountID,
 		Amount:         decimal.NewFromFloat(gofakeit.Float64Range(10, 1000)),
 		Description:    gofakeit.Sentence(5),
 		IdempotencyKey: uuid.New().String(),
@@ -66,7 +68,7 @@ func (s *TransferRepositoryTestSuite) createTestTransfer() *models.Transfer {
 func (s *TransferRepositoryTestSuite) TestCreate_ValidTransfer() {
 	transfer := s.createTestTransfer()
 
-	err := s.repo.Create(transfer)
+	err := s.repo.Create(context.Background(), transfer)
 	require.NoError(s.T(), err)
 	assert.NotEqual(s.T(), uuid.Nil, transfer.ID)
 	assert.False(s.T(), transfer.CreatedAt.IsZero())
@@ -74,7 +76,7 @@ func (s *TransferRepositoryTestSuite) TestCreate_ValidTransfer() {
 
 // TestCreate_NilTransfer tests creating a nil transfer
 func (s *TransferRepositoryTestSuite) TestCreate_NilTransfer() {
-	err := s.repo.Create(nil)
+	err := s.repo.Create(context.Background(), nil)
 	require.Error(s.T(), err)
 	assert.Contains(s.T(), err.Error(), "transfer cannot be nil")
 }
@@ -86,13 +88,13 @@ func (s *TransferRepositoryTestSuite) TestCreate_DuplicateIdempotencyKey() {
 	transfer1 := s.createTestTransfer()
 	transfer1.IdempotencyKey = idempotencyKey
 
-	err := s.repo.Create(transfer1)
+	err := s.repo.Create(context.Background(), transfer1)
 	require.NoError(s.T(), err)
 
 	transfer2 := s.createTestTransfer()
 	transfer2.IdempotencyKey = idempotencyKey
 
-	err = s.repo.Create(transfer2)
+	err = s.repo.Create(context.Background(), transfer2)
 	require.Error(s.T(), err)
 	assert.Equal(s.T(), ErrTransferIdempotencyKeyExists, err)
 }
@@ -100,17 +102,17 @@ func (s *TransferRepositoryTestSuite) TestCreate_DuplicateIdempotencyKey() {
 // TestUpdate_ValidTransfer tests updating a transfer
 func (s *TransferRepositoryTestSuite) TestUpdate_ValidTransfer() {
 	transfer := s.createTestTransfer()
-	err := s.repo.Create(transfer)
+	err := s.repo.Create(context.Background(), transfer)
 	require.NoError(s.T(), err)
 
 	debitID := uuid.New()
 	creditID := uuid.New()
 	transfer.Complete(debitID, creditID)
 
-	err = s.repo.Update(transfer)
+	err = s.repo.Update(context.Background(), transfer)
 	require.NoError(s.T(), err)
 
-	retrieved, err := s.repo.FindByID(transfer.ID)
+	retrieved, err := s.repo.FindByID(context.Background(), transfer.ID)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), models.TransferStatusCompleted, retrieved.Status)
 	assert.NotNil(s.T(), retrieved.CompletedAt)
@@ -118,9 +120,96 @@ func (s *TransferRepositoryTestSuite) TestUpdate_ValidTransfer() {
 	assert.Equal(s.T(), creditID, *retrieved.CreditTransactionID)
 }
 
+// TestUpdateWithEvents_WritesEvents tests that the transfer and its events are saved together
+func (s *TransferRepositoryTestSuite) TestUpdateWithEvents_WritesEvents() {
+	transfer := s.createTestTransfer()
+	require.NoError(s.T(), s.repo.Create(context.Background(), transfer))
+
+	transfer.Complete(uuid.New(), uuid.New())
+	err := s.repo.UpdateWithEvents(context.Background(), transfer, models.NewTransferEvent(transfer))
+	require.NoError(s.T(), err)
+
+	retrieved, err := s.repo.FindByID(context.Background(), transfer.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransferStatusCompleted, retrieved.Status)
+
+	var events []models.OutboxEvent
+	require.NoError(s.T(), s.db.Find(&events).Error)
+	require.Len(s.T(), events, 1)
+	assert.Equal(s.T(), models.EventTypeTransferCompleted, events[0].EventType)
+	assert.Equal(s.T(), transfer.ID, events[0].AggregateID)
+	assert.NotEqual(s.T(), uuid.Nil, events[0].EventID)
+}
+
+// TestUpdateWithEvents_RollsBackOnEventFailure tests that the transfer update is not committed without its events
+func (s *TransferRepositoryTestSuite) TestUpdateWithEvents_RollsBackOnEventFailure() {
+	transfer := s.createTestTransfer()
+	require.NoError(s.T(), s.repo.Create(context.Background(), transfer))
+
+	existing := models.NewTransferEvent(transfer)
+	require.NoError(s.T(), s.db.Create(existing).Error)
+
+	transfer.Complete(uuid.New(), uuid.New())
+	duplicate := models.NewTransferEvent(transfer)
+	duplicate.EventID = existing.EventID
+	err := s.repo.UpdateWithEvents(context.Background(), transfer, duplicate)
+	require.Error(s.T(), err)
+
+	retrieved, err := s.repo.FindByID(context.Background(), transfer.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransferStatusPending, retrieved.Status)
+}
+
+// TestUpdateStatusFrom_Conditional tests that a status change only applies from the expected status
+func (s *TransferRepositoryTestSuite) TestUpdateStatusFrom_Conditional() {
+	transfer := s.createTestTransfer()
+	require.NoError(s.T(), s.repo.Create(context.Background(), transfer))
+
+	transfer.Status = models.TransferStatusProcessing
+	require.NoError(s.T(), s.repo.UpdateStatusFrom(context.Background(), transfer, models.TransferStatusPending))
+
+	// A stale writer that still believes the transfer is pending must not move it again
+	stale := *transfer
+	stale.Status = models.TransferStatusFailed
+	err := s.repo.UpdateStatusFrom(context.Background(), &stale, models.TransferStatusPending)
+	assert.ErrorIs(s.T(), err, ErrTransferStatusChanged)
+
+	retrieved, err := s.repo.FindByID(context.Background(), transfer.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), models.TransferStatusProcessing, retrieved.Status)
+}
+
+// TestUpdateStatusCheck_OnlyWritesPollColumns tests that recording a status check leaves the rest of the row alone
+func (s *TransferRepositoryTestSuite) TestUpdateStatusCheck_OnlyWritesPollColumns() {
+	transfer := s.createTestTransfer()
+	require.NoError(s.T(), s.repo.Create(context.Background(), transfer))
+
+	// The monitor read the transfer before a callback changed its description and status
+	polled := *transfer
+	require.NoError(s.T(), s.db.Model(&models.Transfer{}).Where("id = ?", transfer.ID).UpdateColumn("description", "updated elsewhere").Error)
+
+	polled.ScheduleNextStatusCheck(time.Now(), 30*time.Second, 10*time.Minute)
+	require.NoError(s.T(), s.repo.UpdateStatusCheck(context.Background(), &polled))
+
+	retrieved, err := s.repo.FindByID(context.Background(), transfer.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "updated elsewhere", retrieved.Description)
+	assert.Equal(s.T(), 1, retrieved.StatusCheckAttempts)
+	assert.NotNil(s.T(), retrieved.NextStatusCheckAt)
+
+	require.NoError(s.T(), s.db.Model(&models.Transfer{}).Where("id = ?", transfer.ID).UpdateColumn("status", models.TransferStatusCompleted).Error)
+	polled.Escalate(time.Now())
+	err = s.repo.UpdateStatusCheck(context.Background(), &polled)
+	assert.ErrorIs(s.T(), err, ErrTransferStatusChanged)
+
+	retrieved, err = s.repo.FindByID(context.Background(), transfer.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), retrieved.EscalatedAt)
+}
+
 // TestUpdate_NilTransfer tests updating a nil transfer
 func (s *TransferRepositoryTestSuite) TestUpdate_NilTransfer() {
-	err := s.repo.Update(nil)
+	err := s.repo.Update(context.Background(), nil)
 	require.Error(s.T(), err)
 	assert.Contains(s.T(), err.Error(), "transfer cannot be nil")
 }
@@ -128,10 +217,10 @@ func (s *TransferRepositoryTestSuite) TestUpdate_NilTransfer() {
 // TestFindByID_ExistingTransfer tests finding transfer by ID
 func (s *TransferRepositoryTestSuite) TestFindByID_ExistingTransfer() {
 	transfer := s.createTestTransfer()
-	err := s.repo.Create(transfer)
+	err := s.repo.Create(context.Background(), transfer)
 	require.NoError(s.T(), err)
 
-	retrieved, err := s.repo.FindByID(transfer.ID)
+	retrieved, err := s.repo.FindByID(context.Background(), transfer.ID)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), transfer.ID, retrieved.ID)
 	assert.Equal(s.T(), transfer.FromAccountID, retrieved.FromAccountID)
@@ -141,7 +230,7 @@ func (s *TransferRepositoryTestSuite) TestFindByID_ExistingTransfer() {
 
 // TestFindByID_NonExistingTransfer tests finding non-existing transfer
 func (s *TransferRepositoryTestSuite) TestFindByID_NonExistingTransfer() {
-	retrieved, err := s.repo.FindByID(uuid.New())
+	retrieved, err := s.repo.FindByID(context.Background(), uuid.New())
 	require.Error(s.T(), err)
 	assert.Nil(s.T(), retrieved)
 	assert.Equal(s.T(), ErrTransferNotFound, err)
@@ -150,10 +239,10 @@ func (s *TransferRepositoryTestSuite) TestFindByID_NonExistingTransfer() {
 // TestFindByIdempotencyKey_ExistingTransfer tests finding by idempotency key
 func (s *TransferRepositoryTestSuite) TestFindByIdempotencyKey_ExistingTransfer() {
 	transfer := s.createTestTransfer()
-	err := s.repo.Create(transfer)
+	err := s.repo.Create(context.Background(), transfer)
 	require.NoError(s.T(), err)
 
-	retrieved, err := s.repo.FindByIdempotencyKey(transfer.IdempotencyKey)
+	retrieved, err := s.repo.FindByIdempotencyKey(context.Background(), transfer.IdempotencyKey)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), transfer.ID, retrieved.ID)
 	assert.Equal(s.T(), transfer.IdempotencyKey, retrieved.IdempotencyKey)
@@ -161,7 +250,7 @@ func (s *TransferRepositoryTestSuite) TestFindByIdempotencyKey_ExistingTransfer(
 
 // TestFindByIdempotencyKey_NonExistingKey tests finding by non-existing key
 func (s *TransferRepositoryTestSuite) TestFindByIdempotencyKey_NonExistingKey() {
-	retrieved, err := s.repo.FindByIdempotencyKey(uuid.New().String())
+	retrieved, err := s.repo.FindByIdempotencyKey(context.Background(), uuid.New().String())
 	require.Error(s.T(), err)
 	assert.Nil(s.T(), retrieved)
 	assert.Equal(s.T(), ErrTransferNotFound, err)
@@ -176,25 +265,25 @@ func (s *TransferRepositoryTestSuite) TestFindByUserAccounts_WithTransfers() {
 	// Create transfers involving accountID1
 	transfer1 := s.createTestTransfer()
 	transfer1.FromAccountID = accountID1
-	transfer1.ToAccountID = accountID2
-	err := s.repo.Create(transfer1)
+	transfer1.ToAccountID = &accountID2
+	err := s.repo.Create(context.Background(), transfer1)
 	require.NoError(s.T(), err)
 
 	transfer2 := s.createTestTransfer()
 	transfer2.FromAccountID = accountID2
-	transfer2.ToAccountID = accountID1
-	err = s.repo.Create(transfer2)
+	transfer2.ToAccountID = &accountID1
+	err = s.repo.Create(context.Background(), transfer2)
 	require.NoError(s.T(), err)
 
 	// Create transfer not involving accountID1
 	transfer3 := s.createTestTransfer()
 	transfer3.FromAccountID = accountID2
-	transfer3.ToAccountID = accountID3
-	err = s.repo.Create(transfer3)
+	transfer3.ToAccountID = &accountID3
+	err = s.repo.Create(context.Background(), transfer3)
 	require.NoError(s.T(), err)
 
 	// Find transfers for accountID1
-	transfers, total, err := s.repo.FindByUserAccounts([]uuid.UUID{accountID1}, 0, 10)
+	transfers, total, err := s.repo.FindByUserAccounts(context.Background(), []uuid.UUID{accountID1}, 0, 10)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(2), total)
 	assert.Len(s.T(), transfers, 2)
@@ -204,7 +293,7 @@ func (s *TransferRepositoryTestSuite) TestFindByUserAccounts_WithTransfers() {
 func (s *TransferRepositoryTestSuite) TestFindByUserAccounts_NoTransfers() {
 	accountID := uuid.New()
 
-	transfers, total, err := s.repo.FindByUserAccounts([]uuid.UUID{accountID}, 0, 10)
+	transfers, total, err := s.repo.FindByUserAccounts(context.Background(), []uuid.UUID{accountID}, 0, 10)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(0), total)
 	assert.Len(s.T(), transfers, 0)
@@ -218,25 +307,25 @@ func (s *TransferRepositoryTestSuite) TestFindByUserAccounts_WithPagination() {
 	for i := 0; i < 5; i++ {
 		transfer := s.createTestTransfer()
 		transfer.FromAccountID = accountID
-		err := s.repo.Create(transfer)
+		err := s.repo.Create(context.Background(), transfer)
 		require.NoError(s.T(), err)
 		time.Sleep(1 * time.Millisecond) // Ensure different timestamps
 	}
 
 	// First page
-	transfers, total, err := s.repo.FindByUserAccounts([]uuid.UUID{accountID}, 0, 2)
+	transfers, total, err := s.repo.FindByUserAccounts(context.Background(), []uuid.UUID{accountID}, 0, 2)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(5), total)
 	assert.Len(s.T(), transfers, 2)
 
 	// Second page
-	transfers, total, err = s.repo.FindByUserAccounts([]uuid.UUID{accountID}, 2, 2)
+	transfers, total, err = s.repo.FindByUserAccounts(context.Background(), []uuid.UUID{accountID}, 2, 2)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(5), total)
 	assert.Len(s.T(), transfers, 2)
 
 	// Third page
-	transfers, total, err = s.repo.FindByUserAccounts([]uuid.UUID{accountID}, 4, 2)
+	transfers, total, err = s.repo.FindByUserAccounts(context.Background(), []uuid.UUID{accountID}, 4, 2)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(5), total)
 	assert.Len(s.T(), transfers, 1)
@@ -250,32 +339,32 @@ func (s *TransferRepositoryTestSuite) TestFindByUserAccounts_WithFilters() {
 	transfer1 := s.createTestTransfer()
 	transfer1.FromAccountID = accountID
 	transfer1.Status = models.TransferStatusPending
-	err := s.repo.Create(transfer1)
+	err := s.repo.Create(context.Background(), transfer1)
 	require.NoError(s.T(), err)
 
 	// Create completed transfer
 	transfer2 := s.createTestTransfer()
 	transfer2.FromAccountID = accountID
-	err = s.repo.Create(transfer2)
+	err = s.repo.Create(context.Background(), transfer2)
 	require.NoError(s.T(), err)
 	transfer2.Complete(uuid.New(), uuid.New())
-	err = s.repo.Update(transfer2)
+	err = s.repo.Update(context.Background(), transfer2)
 	require.NoError(s.T(), err)
 
 	// Create failed transfer
 	transfer3 := s.createTestTransfer()
 	transfer3.FromAccountID = accountID
-	err = s.repo.Create(transfer3)
+	err = s.repo.Create(context.Background(), transfer3)
 	require.NoError(s.T(), err)
 	transfer3.Fail("test error")
-	err = s.repo.Update(transfer3)
+	err = s.repo.Update(context.Background(), transfer3)
 	require.NoError(s.T(), err)
 
 	filters := models.TransferFilters{
 		Status: models.TransferStatusCompleted,
 	}
 
-	transfers, total, err := s.repo.FindByUserAccountsWithFilters([]uuid.UUID{accountID}, filters, 0, 10)
+	transfers, total, err := s.repo.FindByUserAccountsWithFilters(context.Background(), []uuid.UUID{accountID}, filters, 0, 10)
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(1), total)
 	assert.Len(s.T(), transfers, 1)
@@ -291,7 +380,7 @@ func (s *TransferRepositoryTestSuite) TestCountByUserAccounts() {
 	for i := 0; i < 3; i++ {
 		transfer := s.createTestTransfer()
 		transfer.FromAccountID = accountID1
-		err := s.repo.Create(transfer)
+		err := s.repo.Create(context.Background(), transfer)
 		require.NoError(s.T(), err)
 	}
 
@@ -299,22 +388,22 @@ func (s *TransferRepositoryTestSuite) TestCountByUserAccounts() {
 	for i := 0; i < 2; i++ {
 		transfer := s.createTestTransfer()
 		transfer.FromAccountID = accountID2
-		err := s.repo.Create(transfer)
+		err := s.repo.Create(context.Background(), transfer)
 		require.NoError(s.T(), err)
 	}
 
-	count, err := s.repo.CountByUserAccounts([]uuid.UUID{accountID1})
+	count, err := s.repo.CountByUserAccounts(context.Background(), []uuid.UUID{accountID1})
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(3), count)
 
-	count, err = s.repo.CountByUserAccounts([]uuid.UUID{accountID1, accountID2})
+	count, err = s.repo.CountByUserAccounts(context.Background(), []uuid.UUID{accountID1, accountID2})
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(5), count)
 }
 
 // TestCountByUserAccounts_NoAccounts tests counting with empty account list
 func (s *TransferRepositoryTestSuite) TestCountByUserAccounts_NoAccounts() {
-	count, err := s.repo.CountByUserAccounts([]uuid.UUID{})
+	count, err := s.repo.CountByUserAccounts(context.Background(), []uuid.UUID{})
 	require.NoError(s.T(), err)
 	assert.Equal(s.T(), int64(0), count)
 }
@@ -341,7 +430,7 @@ func (s *TransferRepositoryTestSuite) TestFindPendingExternal() {
 		IdempotencyKey:      uuid.New().String(),
 		Status:              models.TransferStatusPending,
 	}
-	s.NoError(s.repo.Create(pendingExt))
+	s.NoError(s.repo.Create(context.Background(), pendingExt))
 
 	// 2. Processing external transfer (should be found)
 	extID2 := "nw_txn_2"
@@ -354,39 +443,173 @@ func (s *TransferRepositoryTestSuite) TestFindPendingExternal() {
 		IdempotencyKey:      uuid.New().String(),
 		Status:              "processing", // Northwind status
 	}
-	s.NoError(s.repo.Create(processingExt))
+	s.NoError(s.repo.Create(context.Background(), processingExt))
 
 	// 3. Completed external transfer (should NOT be found)
 	completedExt := s.createTestTransfer()
+	completedExt.ToAccountID = nil
 	completedExt.ToExternalAccountID = &toAcctExternal.ID
 	completedExt.Status = models.TransferStatusCompleted
-	s.NoError(s.repo.Create(completedExt))
+	s.NoError(s.repo.Create(context.Background(), completedExt))
 
 	// 4. Failed external transfer (should NOT be found)
 	failedExt := s.createTestTransfer()
+	failedExt.ToAccountID = nil
 	failedExt.ToExternalAccountID = &toAcctExternal.ID
 	failedExt.Status = models.TransferStatusFailed
-	s.NoError(s.repo.Create(failedExt))
+	s.NoError(s.repo.Create(context.Background(), failedExt))
 
 	// 5. Pending internal transfer (should NOT be found)
 	pendingInt := s.createTestTransfer()
 	pendingInt.ToAccountID = &toAcctInternal.ID
 	pendingInt.Status = models.TransferStatusPending
-	s.NoError(s.repo.Create(pendingInt))
+	s.NoError(s.repo.Create(context.Background(), pendingInt))
+
+	// 6. Pending external transfer backing off until later (should NOT be found)
+	later := time.Now().Add(time.Hour)
+	backingOff := s.createTestTransfer()
+	backingOff.ToAccountID = nil
+	backingOff.ToExternalAccountID = &toAcctExternal.ID
+	backingOff.NextStatusCheckAt = &later
+	s.NoError(s.repo.Create(context.Background(), backingOff))
+
+	// 7. Pending external transfer escalated to the admin queue (should NOT be found)
+	escalatedAt := time.Now()
+	escalated := s.createTestTransfer()
+	escalated.ToAccountID = nil
+	escalated.ToExternalAccountID = &toAcctExternal.ID
+	escalated.EscalatedAt = &escalatedAt
+	s.NoError(s.repo.Create(context.Background(), escalated))
+
+	// 8. Pending external transfer whose saga has not reached the partner (should NOT be found)
+	unsubmitted := s.createTestTransfer()
+	unsubmitted.ToAccountID = nil
+	unsubmitted.ToExternalAccountID = &toAcctExternal.ID
+	s.NoError(s.repo.Create(context.Background(), unsubmitted))
+	s.NoError(s.db.Create(&models.TransferSaga{TransferID: unsubmitted.ID, Step: models.TransferSagaStepDebitPosted, TransferType: "standard"}).Error)
+
+	// 9. Submitted external transfer with a saga (should be found)
+	extID3 := "nw_txn_3"
+	submitted := &models.Transfer{
+		FromAccountID:       fromAcct.ID,
+		ToExternalAccountID: &toAcctExternal.ID,
+		ExternalTransferID:  &extID3,
+		Amount:              decimal.NewFromInt(100),
+		Description:         "submitted external",
+		IdempotencyKey:      uuid.New().String(),
+		Status:              models.TransferStatusPending,
+	}
+	s.NoError(s.repo.Create(context.Background(), submitted))
+	s.NoError(s.db.Create(&models.TransferSaga{TransferID: submitted.ID, Step: models.TransferSagaStepPartnerSubmitted, TransferType: "standard"}).Error)
 
 	// Execute the method
-	results, err := s.repo.FindPendingExternal(10)
+	results, err := s.repo.FindPendingExternal(context.Background(), time.Now(), 10)
 	s.NoError(err)
-	s.Len(results, 2)
+	s.Len(results, 3)
 
 	// Verify the correct transfers are returned
 	foundIDs := make(map[uuid.UUID]bool)
-	for _, t := range results {
-		foundIDs[t.ID] = true
+	for i := range results {
+		foundIDs[results[i].ID] = true
 	}
 	s.True(foundIDs[pendingExt.ID])
 	s.True(foundIDs[processingExt.ID])
 	s.False(foundIDs[completedExt.ID])
 	s.False(foundIDs[failedExt.ID])
 	s.False(foundIDs[pendingInt.ID])
+	s.False(foundIDs[backingOff.ID])
+	s.False(foundIDs[escalated.ID])
+	s.False(foundIDs[unsubmitted.ID])
+	s.True(foundIDs[submitted.ID])
+
+	// The backed-off transfer becomes due once its next check time passes
+	results, err = s.repo.FindPendingExternal(context.Background(), later.Add(time.Second), 10)
+	s.NoError(err)
+	s.Len(results, 4)
+
+	escalatedResults, total, err := s.repo.FindEscalatedExternal(context.Background(), 0, 10)
+	s.NoError(err)
+	s.Equal(int64(1), total)
+	s.Require().Len(escalatedResults, 1)
+	s.Equal(escalated.ID, escalatedResults[0].ID)
+
+	// Backed-off and unsubmitted transfers are still awaiting a final status
+	pending, escalatedCount, err := s.repo.CountPendingExternal(context.Background())
+	s.NoError(err)
+	s.Equal(int64(5), pending)
+	s.Equal(int64(1), escalatedCount)
+}
+
+func (s *TransferRepositoryTestSuite) TestFindByExternalTransferID() {
+	user := &models.User{Email: gofakeit.Email(), FirstName: "a", LastName: "b", PasswordHash: "c", Role: "customer"}
+	s.db.Create(user)
+	payee := &models.ExternalAccount{UserID: user.ID, ExternalAccountID: uuid.New(), Nickname: "ext", AccountNumberMask: "1234", NameOnAccount: "test", BankName: "nw"}
+	s.db.Create(payee)
+
+	externalID := "nw_txn_lookup"
+	transfer := s.createTestTransfer()
+	transfer.ToAccountID = nil
+	transfer.ToExternalAccountID = &payee.ID
+	transfer.ExternalTransferID = &externalID
+	s.NoError(s.repo.Create(context.Background(), transfer))
+
+	found, err := s.repo.FindByExternalTransferID(context.Background(), externalID)
+	s.NoError(err)
+	s.Equal(transfer.ID, found.ID)
+
+	_, err = s.repo.FindByExternalTransferID(context.Background(), "nw_txn_missing")
+	s.ErrorIs(err, ErrTransferNotFound)
+}
+
+func (s *TransferRepositoryTestSuite) TestFindByExternalAccount_And_Stats() {
+	user := &models.User{Email: gofakeit.Email(), FirstName: "a", LastName: "b", PasswordHash: "c", Role: "customer"}
+	s.db.Create(user)
+	payee := &models.ExternalAccount{UserID: user.ID, ExternalAccountID: uuid.New(), Nickname: "ext", AccountNumberMask: "1234", NameOnAccount: "test", BankName: "nw"}
+	s.db.Create(payee)
+	otherPayee := &models.ExternalAccount{UserID: user.ID, ExternalAccountID: uuid.New(), Nickname: "other", AccountNumberMask: "5678", NameOnAccount: "test", BankName: "nw"}
+	s.db.Create(otherPayee)
+
+	statuses := []string{
+		models.TransferStatusCompleted,
+		models.TransferStatusCompleted,
+		models.TransferStatusProcessing,
+		models.TransferStatusFailed,
+	}
+	for i, status := range statuses {
+		transfer := s.createTestTransfer()
+		transfer.ToAccountID = nil
+		transfer.ToExternalAccountID = &payee.ID
+		transfer.Amount = decimal.NewFromInt(int64(100 * (i + 1)))
+		transfer.Status = status
+		s.NoError(s.repo.Create(context.Background(), transfer))
+	}
+
+	// Transfer to another payee should not be counted
+	other := s.createTestTransfer()
+	other.ToAccountID = nil
+	other.ToExternalAccountID = &otherPayee.ID
+	other.Status = models.TransferStatusCompleted
+	s.NoError(s.repo.Create(context.Background(), other))
+
+	transfers, total, err := s.repo.FindByExternalAccount(context.Background(), payee.ID, 0, 2)
+	s.NoError(err)
+	s.Equal(int64(4), total)
+	s.Len(transfers, 2)
+
+	stats, err := s.repo.GetExternalAccountStats(context.Background(), payee.ID)
+	s.NoError(err)
+	s.Equal(int64(4), stats.TotalTransfers)
+	s.Equal(int64(2), stats.CompletedTransfers)
+	s.Equal(int64(1), stats.PendingTransfers)
+	s.Equal(int64(1), stats.FailedTransfers)
+	s.True(decimal.NewFromInt(300).Equal(stats.TotalAmountSent), "got %s", stats.TotalAmountSent)
+	s.NotNil(stats.LastTransferAt)
+}
+
+func (s *TransferRepositoryTestSuite) TestGetExternalAccountStats_NoTransfers() {
+	stats, err := s.repo.GetExternalAccountStats(context.Background(), uuid.New())
+	s.NoError(err)
+	s.Equal(int64(0), stats.TotalTransfers)
+	s.True(stats.TotalAmountSent.IsZero())
+	s.Nil(stats.LastTransferAt)
 }
@@ -0,0 +1,392 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTransferReviewNotFound = errors.New("transfer review not found")
+	ErrTransferReviewDecided  = errors.New("transfer review already decided")
+)
+
+type transferReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferReviewRepository(db *gorm.DB) TransferReviewRepositoryInterface {
+	return &transferReviewRepository{db: db}
+}
+
+// CreateHeld debits the source account, creates the transfer held for review and queues the
+// review in a single database transaction, so the funds are reserved for as long as the review
+// is pending.
+func (r *transferReviewRepository) CreateHeld(ctx context.Context, transfer *models.Transfer, review *models.TransferReview, debitDescription string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		account := &models.Account{}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(account, "id = ?", transfer.FromAccountID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to lock source account: %w", err)
+		}
+
+		if !account.IsActive() {
+			return ErrAccountNotActive
+		}
+		if account.Balance.LessThan(transfer.Amount) {
+			return ErrInsufficientFunds
+		}
+		if err := checkUnverifiedPayeeLimit(tx, transfer); err != nil {
+			return err
+		}
+
+		balanceBefore := account.Balance
+		newBalance := balanceBefore.Sub(transfer.Amount)
+		if err := tx.Model(account).Update("balance", newBalance).Error; err != nil {
+			return fmt.Errorf("failed to debit source account: %w", err)
+		}
+
+		debitTx := &models.Transaction{
+			AccountID:       account.ID,
+			TransactionType: models.TransactionTypeDebit,
+			Amount:          transfer.Amount,
+			BalanceBefore:   balanceBefore,
+			BalanceAfter:    newBalance,
+			Description:     debitDescription,
+			Status:          models.TransactionStatusCompleted,
+			Reference:       models.GenerateTransactionReference(),
+		}
+		if err := tx.Create(debitTx).Error; err != nil {
+			return fmt.Errorf("failed to create debit transaction: %w", err)
+		}
+		if err := appendOutboxEvents(tx, []*models.OutboxEvent{models.NewTransactionPostedEvent(debitTx)}); err != nil {
+			return err
+		}
+
+		transfer.Status = models.TransferStatusHeldForReview
+		transfer.DebitTransactionID = &debitTx.ID
+		if err := tx.Create(transfer).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return ErrTransferIdempotencyKeyExists
+			}
+			return fmt.Errorf("failed to create transfer: %w", err)
+		}
+
+		review.TransferID = transfer.ID
+		if err := tx.Omit("Transfer").Create(review).Error; err != nil {
+			return fmt.Errorf("failed to create transfer review: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		transfer.DebitTransactionID = nil
+		return err
+	}
+	review.Transfer = *transfer
+	return nil
+}
+
+// Approve claims a pending review and executes the held transfer. An internal transfer credits
+// the destination account and completes; an external transfer returns to pending with its saga at
+// debit_posted, ready to be submitted to the partner. A destination account that is no longer
+// active returns ErrAccountNotActive and leaves the review pending.
+func (r *transferReviewRepository) Approve(ctx context.Context, reviewID, adminID uuid.UUID, note string) (*models.TransferReview, error) {
+	var review *models.TransferReview
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		review, err = claimReview(tx, reviewID, models.TransferReviewStatusApproved, adminID, note)
+		if err != nil {
+			return err
+		}
+		transfer := &review.Transfer
+
+		if transfer.IsExternal() {
+			transfer.Status = models.TransferStatusPending
+			if err := releaseHeldTransfer(tx, transfer); err != nil {
+				return err
+			}
+			saga := &models.TransferSaga{
+				TransferID:         transfer.ID,
+				Step:               models.TransferSagaStepDebitPosted,
+				TransferType:       review.TransferType,
+				DebitTransactionID: transfer.DebitTransactionID,
+			}
+			if err := tx.Create(saga).Error; err != nil {
+				return fmt.Errorf("failed to create transfer saga: %w", err)
+			}
+			return nil
+		}
+
+		fromAccount := &models.Account{}
+		if err := tx.First(fromAccount, "id = ?", transfer.FromAccountID).Error; err != nil {
+			return fmt.Errorf("failed to find source account: %w", err)
+		}
+
+		toAccount := &models.Account{}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(toAccount, "id = ?", *transfer.ToAccountID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to lock destination account: %w", err)
+		}
+		if !toAccount.IsActive() {
+			return ErrAccountNotActive
+		}
+
+		balanceBefore := toAccount.Balance
+		newBalance := balanceBefore.Add(transfer.Amount)
+		if err := tx.Model(toAccount).Update("balance", newBalance).Error; err != nil {
+			return fmt.Errorf("failed to credit destination account: %w", err)
+		}
+
+		creditTx := &models.Transaction{
+			AccountID:       toAccount.ID,
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          transfer.Amount,
+			BalanceBefore:   balanceBefore,
+			BalanceAfter:    newBalance,
+			Description:     fmt.Sprintf("Transfer from %s: %s", fromAccount.AccountNumber, transfer.Description),
+			Status:          models.TransactionStatusCompleted,
+			Reference:       models.GenerateTransactionReference(),
+		}
+		if err := tx.Create(creditTx).Error; err != nil {
+			return fmt.Errorf("failed to create credit transaction: %w", err)
+		}
+
+		transfer.Complete(*transfer.DebitTransactionID, creditTx.ID)
+		if err := releaseHeldTransfer(tx, transfer); err != nil {
+			return err
+		}
+		return appendOutboxEvents(tx, []*models.OutboxEvent{
+			models.NewTransactionPostedEvent(creditTx),
+			models.NewTransferEvent(transfer),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// Reject claims a pending review, credits the reserved funds back to the source account and
+// fails the transfer with the rejection reason. The reversal is credited even if the account has
+// since been frozen.
+func (r *transferReviewRepository) Reject(ctx context.Context, reviewID, adminID uuid.UUID, note, reason, reversalDescription string) (*models.TransferReview, error) {
+	var review *models.TransferReview
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		review, err = claimReview(tx, reviewID, models.TransferReviewStatusRejected, adminID, note)
+		if err != nil {
+			return err
+		}
+		transfer := &review.Transfer
+
+		account := &models.Account{}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(account, "id = ?", transfer.FromAccountID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to lock source account: %w", err)
+		}
+
+		balanceBefore := account.Balance
+		newBalance := balanceBefore.Add(transfer.Amount)
+		if err := tx.Model(account).Update("balance", newBalance).Error; err != nil {
+			return fmt.Errorf("failed to credit source account: %w", err)
+		}
+
+		reversalTx := &models.Transaction{
+			AccountID:       account.ID,
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          transfer.Amount,
+			BalanceBefore:   balanceBefore,
+			BalanceAfter:    newBalance,
+			Description:     reversalDescription,
+			Status:          models.TransactionStatusCompleted,
+			Reference:       models.GenerateTransactionReference(),
+		}
+		if err := tx.Create(reversalTx).Error; err != nil {
+			return fmt.Errorf("failed to create reversal transaction: %w", err)
+		}
+
+		transfer.Fail(reason)
+		transfer.ReversalTransactionID = &reversalTx.ID
+		if err := releaseHeldTransfer(tx, transfer); err != nil {
+			return err
+		}
+		return appendOutboxEvents(tx, []*models.OutboxEvent{
+			models.NewTransactionPostedEvent(reversalTx),
+			models.NewTransferEvent(transfer),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (r *transferReviewRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.TransferReview, error) {
+	var review models.TransferReview
+	if err := r.db.WithContext(ctx).Preload("Transfer").First(&review, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to find transfer review: %w", err)
+	}
+	return &review, nil
+}
+
+func (r *transferReviewRepository) GetByTransferID(ctx context.Context, transferID uuid.UUID) (*models.TransferReview, error) {
+	var review models.TransferReview
+	if err := r.db.WithContext(ctx).Preload("Transfer").First(&review, "transfer_id = ?", transferID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to find transfer review: %w", err)
+	}
+	return &review, nil
+}
+
+// List returns reviews matching the filters with their transfers, earliest SLA deadline first.
+func (r *transferReviewRepository) List(ctx context.Context, filters models.TransferReviewFilters, offset, limit int) ([]models.TransferReview, int64, error) {
+	var reviews []models.TransferReview
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.TransferReview{})
+	if filters.Status != "" {
+		query = query.Where("status = ?", filters.Status)
+	}
+	if filters.UserID != nil {
+		query = query.Where("user_id = ?", *filters.UserID)
+	}
+	if filters.External != nil {
+		external := r.db.WithContext(ctx).Model(&models.Transfer{}).Select("id").Where("to_external_account_id IS NOT NULL")
+		if *filters.External {
+			query = query.Where("transfer_id IN (?)", external)
+		} else {
+			query = query.Where("transfer_id NOT IN (?)", external)
+		}
+	}
+	if filters.OverdueAt != nil {
+		query = query.Where("status = ? AND due_at < ?", models.TransferReviewStatusPending, filters.OverdueAt.UTC())
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count transfer reviews: %w", err)
+	}
+
+	if err := query.Preload("Transfer").Order("due_at ASC").Offset(offset).Limit(limit).Find(&reviews).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list transfer reviews: %w", err)
+	}
+
+	return reviews, total, nil
+}
+
+// CountReleasedTransfersToExternalAccount counts transfers to the payee that were released to
+// the partner: transfers that failed or are still held do not count.
+func (r *transferReviewRepository) CountReleasedTransfersToExternalAccount(ctx context.Context, externalAccountID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Transfer{}).
+		Where("to_external_account_id = ? AND status NOT IN ?", externalAccountID,
+			[]string{models.TransferStatusFailed, models.TransferStatusHeldForReview}).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count transfers to external account: %w", err)
+	}
+	return count, nil
+}
+
+// ListRecentTransfers returns the latest transfers out of or into the user's accounts, newest first.
+func (r *transferReviewRepository) ListRecentTransfers(ctx context.Context, userID uuid.UUID, limit int) ([]models.Transfer, error) {
+	var transfers []models.Transfer
+	accounts := r.db.WithContext(ctx).Model(&models.Account{}).Select("id").Where("user_id = ?", userID)
+	err := r.db.WithContext(ctx).Where("from_account_id IN (?) OR to_account_id IN (?)", accounts, accounts).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&transfers).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent transfers: %w", err)
+	}
+	return transfers, nil
+}
+
+// ListRecentTransactions returns the latest transactions across the user's accounts, newest first.
+func (r *transferReviewRepository) ListRecentTransactions(ctx context.Context, userID uuid.UUID, limit int) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+	err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Joins("JOIN accounts ON accounts.id = transactions.account_id").
+		Where("accounts.user_id = ?", userID).
+		Order("transactions.created_at DESC").
+		Limit(limit).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+// claimReview records the decision on a pending review with a conditional update, so concurrent
+// decisions apply exactly once, and returns the review with its held transfer.
+func claimReview(tx *gorm.DB, reviewID uuid.UUID, status string, adminID uuid.UUID, note string) (*models.TransferReview, error) {
+	now := time.Now()
+	result := tx.Model(&models.TransferReview{}).
+		Where("id = ? AND status = ?", reviewID, models.TransferReviewStatusPending).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewed_by": adminID,
+			"reviewed_at": now,
+			"review_note": note,
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update transfer review: %w", result.Error)
+	}
+
+	review := &models.TransferReview{}
+	if err := tx.Preload("Transfer").First(review, "id = ?", reviewID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to find transfer review: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrTransferReviewDecided
+	}
+	if !review.Transfer.IsHeldForReview() {
+		return nil, fmt.Errorf("%w: transfer %s is %s, not held for review", ErrTransferReviewDecided, review.Transfer.ID, review.Transfer.Status)
+	}
+	return review, nil
+}
+
+// releaseHeldTransfer writes the decided transfer with a conditional update, only while it is
+// still held for review, so a hold is released exactly once even if its review row was not the
+// one that serialized the decision. Returns ErrTransferReviewDecided otherwise.
+func releaseHeldTransfer(tx *gorm.DB, transfer *models.Transfer) error {
+	transfer.UpdatedAt = time.Now()
+	result := tx.Model(&models.Transfer{}).
+		Where("id = ? AND status = ?", transfer.ID, models.TransferStatusHeldForReview).
+		UpdateColumns(map[string]interface{}{
+			"status":                  transfer.Status,
+			"credit_transaction_id":   transfer.CreditTransactionID,
+			"reversal_transaction_id": transfer.ReversalTransactionID,
+			"completed_at":            transfer.CompletedAt,
+			"failed_at":               transfer.FailedAt,
+			"error_message":           transfer.ErrorMessage,
+			"updated_at":              transfer.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update transfer: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransferReviewDecided
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransferReviewRepositoryTestSuite struct {
+	suite.Suite
+	db          *database.DB
+	repo        TransferReviewRepositoryInterface
+	user        *models.User
+	admin       *models.User
+	account     *models.Account
+	destination *models.Account
+	payee       *models.ExternalAccount
+}
+
+func (s *TransferReviewRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewTransferReviewRepository(s.db.DB)
+
+	s.user = database.CreateTestUser(s.T(), s.db, "held@example.com")
+	s.admin = database.CreateTestAdminUser(s.T(), s.db, "reviewer@example.com")
+	s.account = s.createAccount("1012345678", 100)
+	s.destination = s.createAccount("1087654321", 10)
+	s.payee = &models.ExternalAccount{
+		UserID:             s.user.ID,
+		ExternalAccountID:  uuid.New(),
+		Nickname:           "Landlord",
+		AccountNumberMask:  "9012",
+		NameOnAccount:      "Jane Landlord",
+		BankName:           "Northwind Bank",
+		VerificationStatus: models.ExternalAccountStatusVerified,
+	}
+	s.Require().NoError(s.db.Create(s.payee).Error)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestTransferReviewRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferReviewRepositoryTestSuite))
+}
+
+func (s *TransferReviewRepositoryTestSuite) createAccount(number string, balance float64) *models.Account {
+	account := &models.Account{
+		UserID:        s.user.ID,
+		AccountNumber: number,
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(balance),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.db.Create(account).Error)
+	return account
+}
+
+func (s *TransferReviewRepositoryTestSuite) balance(accountID uuid.UUID) decimal.Decimal {
+	var account models.Account
+	s.Require().NoError(s.db.First(&account, "id = ?", accountID).Error)
+	return account.Balance
+}
+
+func (s *TransferReviewRepositoryTestSuite) hold(transfer *models.Transfer, dueAt time.Time) *models.TransferReview {
+	review := &models.TransferReview{
+		UserID:       s.user.ID,
+		Reasons:      models.JSONBMap{"reasons": []map[string]string{{"trigger": "amount_threshold", "reason": "large"}}},
+		TransferType: "standard",
+		DueAt:        dueAt,
+	}
+	s.Require().NoError(s.repo.CreateHeld(context.Background(), transfer, review, "Held transfer"))
+	return review
+}
+
+func (s *TransferReviewRepositoryTestSuite) internalTransfer(amount float64) *models.Transfer {
+	return &models.Transfer{
+		FromAccountID:  s.account.ID,
+		ToAccountID:    &s.destination.ID,
+		Amount:         decimal.NewFromFloat(amount),
+		Description:    "Savings",
+		IdempotencyKey: uuid.NewString(),
+	}
+}
+
+func (s *TransferReviewRepositoryTestSuite) externalTransfer(amount float64) *models.Transfer {
+	return &models.Transfer{
+		FromAccountID:       s.account.ID,
+		ToExternalAccountID: &s.payee.ID,
+		Amount:              decimal.NewFromFloat(amount),
+		Description:         "Rent",
+		IdempotencyKey:      uuid.NewString(),
+	}
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestCreateHeld_ReservesFunds() {
+	transfer := s.internalTransfer(40)
+	review := s.hold(transfer, time.Now().Add(time.Hour))
+
+	s.Equal(models.TransferStatusHeldForReview, transfer.Status)
+	s.Require().NotNil(transfer.DebitTransactionID)
+	s.Equal(transfer.ID, review.TransferID)
+	s.Equal(models.TransferReviewStatusPending, review.Status)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(60)))
+	s.True(s.balance(s.destination.ID).Equal(decimal.NewFromFloat(10)))
+
+	var events int64
+	s.Require().NoError(s.db.Model(&models.OutboxEvent{}).Where("event_type = ?", models.EventTypeTransactionPosted).Count(&events).Error)
+	s.Equal(int64(1), events)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestCreateHeld_InsufficientFunds() {
+	transfer := s.internalTransfer(150)
+	review := &models.TransferReview{UserID: s.user.ID, DueAt: time.Now()}
+
+	err := s.repo.CreateHeld(context.Background(), transfer, review, "Held transfer")
+	s.ErrorIs(err, ErrInsufficientFunds)
+	s.Nil(transfer.DebitTransactionID)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(100)))
+
+	var count int64
+	s.Require().NoError(s.db.Model(&models.TransferReview{}).Count(&count).Error)
+	s.Zero(count)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestApprove_InternalCompletesTransfer() {
+	review := s.hold(s.internalTransfer(40), time.Now().Add(time.Hour))
+
+	approved, err := s.repo.Approve(context.Background(), review.ID, s.admin.ID, "Customer confirmed by phone")
+	s.Require().NoError(err)
+	s.Equal(models.TransferReviewStatusApproved, approved.Status)
+	s.Equal("Customer confirmed by phone", approved.ReviewNote)
+	s.Equal(s.admin.ID, *approved.ReviewedBy)
+	s.Equal(models.TransferStatusCompleted, approved.Transfer.Status)
+	s.NotNil(approved.Transfer.CreditTransactionID)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(60)))
+	s.True(s.balance(s.destination.ID).Equal(decimal.NewFromFloat(50)))
+
+	var events int64
+	s.Require().NoError(s.db.Model(&models.OutboxEvent{}).Where("event_type = ?", models.EventTypeTransferCompleted).Count(&events).Error)
+	s.Equal(int64(1), events)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestApprove_InactiveDestinationLeavesReviewPending() {
+	review := s.hold(s.internalTransfer(40), time.Now().Add(time.Hour))
+	s.Require().NoError(s.db.Model(s.destination).Update("status", models.AccountStatusClosed).Error)
+
+	_, err := s.repo.Approve(context.Background(), review.ID, s.admin.ID, "Looks fine")
+	s.ErrorIs(err, ErrAccountNotActive)
+
+	stored, err := s.repo.GetByID(context.Background(), review.ID)
+	s.Require().NoError(err)
+	s.True(stored.IsPending())
+	s.Equal(models.TransferStatusHeldForReview, stored.Transfer.Status)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestApprove_ExternalStartsSaga() {
+	review := s.hold(s.externalTransfer(40), time.Now().Add(time.Hour))
+
+	approved, err := s.repo.Approve(context.Background(), review.ID, s.admin.ID, "Known payee")
+	s.Require().NoError(err)
+	s.Equal(models.TransferStatusPending, approved.Transfer.Status)
+
+	var saga models.TransferSaga
+	s.Require().NoError(s.db.First(&saga, "transfer_id = ?", review.TransferID).Error)
+	s.Equal(models.TransferSagaStepDebitPosted, saga.Step)
+	s.Equal("standard", saga.TransferType)
+	s.Equal(approved.Transfer.DebitTransactionID, saga.DebitTransactionID)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(60)))
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestReject_ReturnsFunds() {
+	review := s.hold(s.internalTransfer(40), time.Now().Add(time.Hour))
+
+	rejected, err := s.repo.Reject(context.Background(), review.ID, s.admin.ID, "Customer did not confirm", "Transfer rejected", "Reversal")
+	s.Require().NoError(err)
+	s.Equal(models.TransferReviewStatusRejected, rejected.Status)
+	s.Equal(models.TransferStatusFailed, rejected.Transfer.Status)
+	s.Equal("Transfer rejected", *rejected.Transfer.ErrorMessage)
+	s.NotNil(rejected.Transfer.ReversalTransactionID)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(100)))
+	s.True(s.balance(s.destination.ID).Equal(decimal.NewFromFloat(10)))
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestDecide_OnlyOnce() {
+	review := s.hold(s.internalTransfer(40), time.Now().Add(time.Hour))
+
+	_, err := s.repo.Reject(context.Background(), review.ID, s.admin.ID, "No", "Transfer rejected", "Reversal")
+	s.Require().NoError(err)
+
+	_, err = s.repo.Approve(context.Background(), review.ID, s.admin.ID, "Yes")
+	s.ErrorIs(err, ErrTransferReviewDecided)
+	_, err = s.repo.Reject(context.Background(), review.ID, s.admin.ID, "No", "Transfer rejected", "Reversal")
+	s.ErrorIs(err, ErrTransferReviewDecided)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(100)))
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestDecide_ApproveThenReject() {
+	review := s.hold(s.internalTransfer(40), time.Now().Add(time.Hour))
+
+	_, err := s.repo.Approve(context.Background(), review.ID, s.admin.ID, "Yes")
+	s.Require().NoError(err)
+
+	_, err = s.repo.Reject(context.Background(), review.ID, s.admin.ID, "No", "Transfer rejected", "Reversal")
+	s.ErrorIs(err, ErrTransferReviewDecided)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(60)))
+	s.True(s.balance(s.destination.ID).Equal(decimal.NewFromFloat(50)))
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestDecide_TransferNoLongerHeld() {
+	review := s.hold(s.internalTransfer(40), time.Now().Add(time.Hour))
+	s.Require().NoError(s.db.Model(&models.Transfer{}).Where("id = ?", review.TransferID).
+		UpdateColumn("status", models.TransferStatusFailed).Error)
+
+	_, err := s.repo.Reject(context.Background(), review.ID, s.admin.ID, "No", "Transfer rejected", "Reversal")
+	s.ErrorIs(err, ErrTransferReviewDecided)
+
+	stored, err := s.repo.GetByID(context.Background(), review.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransferReviewStatusPending, stored.Status)
+	s.True(s.balance(s.account.ID).Equal(decimal.NewFromFloat(60)))
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestDecide_NotFound() {
+	_, err := s.repo.Approve(context.Background(), uuid.New(), s.admin.ID, "Yes")
+	s.ErrorIs(err, ErrTransferReviewNotFound)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestList_Filters() {
+	now := time.Now()
+	overdue := s.hold(s.internalTransfer(10), now.Add(-time.Hour))
+	external := s.hold(s.externalTransfer(10), now.Add(time.Hour))
+	decided := s.hold(s.internalTransfer(10), now.Add(-2*time.Hour))
+	_, err := s.repo.Reject(context.Background(), decided.ID, s.admin.ID, "No", "Transfer rejected", "Reversal")
+	s.Require().NoError(err)
+
+	reviews, total, err := s.repo.List(context.Background(), models.TransferReviewFilters{}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(3), total)
+	s.Equal(decided.ID, reviews[0].ID) // Earliest deadline first
+	s.Equal(reviews[0].TransferID, reviews[0].Transfer.ID)
+
+	reviews, total, err = s.repo.List(context.Background(), models.TransferReviewFilters{OverdueAt: &now}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(overdue.ID, reviews[0].ID)
+
+	isExternal := true
+	reviews, total, err = s.repo.List(context.Background(), models.TransferReviewFilters{External: &isExternal}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(external.ID, reviews[0].ID)
+
+	_, total, err = s.repo.List(context.Background(), models.TransferReviewFilters{Status: models.TransferReviewStatusPending, UserID: &s.user.ID}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestCountReleasedTransfersToExternalAccount() {
+	held := s.externalTransfer(10)
+	s.hold(held, time.Now().Add(time.Hour))
+
+	count, err := s.repo.CountReleasedTransfersToExternalAccount(context.Background(), *held.ToExternalAccountID)
+	s.Require().NoError(err)
+	s.Zero(count)
+
+	review, err := s.repo.GetByTransferID(context.Background(), held.ID)
+	s.Require().NoError(err)
+	_, err = s.repo.Approve(context.Background(), review.ID, s.admin.ID, "Known payee")
+	s.Require().NoError(err)
+
+	count, err = s.repo.CountReleasedTransfersToExternalAccount(context.Background(), *held.ToExternalAccountID)
+	s.Require().NoError(err)
+	s.Equal(int64(1), count)
+}
+
+func (s *TransferReviewRepositoryTestSuite) TestListRecentActivity() {
+	s.hold(s.internalTransfer(10), time.Now().Add(time.Hour))
+
+	transfers, err := s.repo.ListRecentTransfers(context.Background(), s.user.ID, 10)
+	s.Require().NoError(err)
+	s.Len(transfers, 1)
+
+	transactions, err := s.repo.ListRecentTransactions(context.Background(), s.user.ID, 10)
+	s.Require().NoError(err)
+	s.Len(transactions, 1)
+
+	transfers, err = s.repo.ListRecentTransfers(context.Background(), s.admin.ID, 10)
+	s.Require().NoError(err)
+	s.Empty(transfers)
+}
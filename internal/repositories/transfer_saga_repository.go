@@ -0,0 +1,293 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTransferSagaNotFound  = errors.New("transfer saga not found")
+	ErrTransferSagaFinished  = errors.New("transfer saga already finished")
+	ErrTransferSagaConfirmed = errors.New("transfer saga already confirmed by partner")
+)
+
+type transferSagaRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferSagaRepository(db *gorm.DB) TransferSagaRepositoryInterface {
+	return &transferSagaRepository{db: db}
+}
+
+// BeginWithDebit debits the source account, creates the transfer and records the saga at
+// debit_posted in a single database transaction, so a debit never exists without a saga.
+func (r *transferSagaRepository) BeginWithDebit(ctx context.Context, transfer *models.Transfer, transferType, debitDescription string) (*models.TransferSaga, error) {
+	var saga *models.TransferSaga
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		account := &models.Account{ID: transfer.FromAccountID}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(account).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to lock source account: %w", err)
+		}
+
+		if !account.IsActive() {
+			return ErrAccountNotActive
+		}
+		if account.Balance.LessThan(transfer.Amount) {
+			return ErrInsufficientFunds
+		}
+		if err := checkUnverifiedPayeeLimit(tx, transfer); err != nil {
+			return err
+		}
+
+		balanceBefore := account.Balance
+		newBalance := balanceBefore.Sub(transfer.Amount)
+		if err := tx.Model(account).Update("balance", newBalance).Error; err != nil {
+			return fmt.Errorf("failed to debit source account: %w", err)
+		}
+
+		debitTx := &models.Transaction{
+			AccountID:       account.ID,
+			TransactionType: models.TransactionTypeDebit,
+			Amount:          transfer.Amount,
+			BalanceBefore:   balanceBefore,
+			BalanceAfter:    newBalance,
+			Description:     debitDescription,
+			Status:          models.TransactionStatusCompleted,
+			Reference:       models.GenerateTransactionReference(),
+		}
+		if err := tx.Create(debitTx).Error; err != nil {
+			return fmt.Errorf("failed to create debit transaction: %w", err)
+		}
+		if err := appendOutboxEvents(tx, []*models.OutboxEvent{models.NewTransactionPostedEvent(debitTx)}); err != nil {
+			return err
+		}
+
+		transfer.DebitTransactionID = &debitTx.ID
+		if err := tx.Create(transfer).Error; err != nil {
+			if isDuplicateKeyError(err) {
+				return ErrTransferIdempotencyKeyExists
+			}
+			return fmt.Errorf("failed to create transfer: %w", err)
+		}
+
+		saga = &models.TransferSaga{
+			TransferID:         transfer.ID,
+			Step:               models.TransferSagaStepDebitPosted,
+			TransferType:       transferType,
+			DebitTransactionID: &debitTx.ID,
+		}
+		if err := tx.Create(saga).Error; err != nil {
+			return fmt.Errorf("failed to create transfer saga: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		transfer.DebitTransactionID = nil
+		return nil, err
+	}
+	return saga, nil
+}
+
+// MarkSubmitted saves the transfer as accepted by the partner and advances the saga to
+// partner_submitted. Finished sagas are left untouched and return ErrTransferSagaFinished.
+func (r *transferSagaRepository) MarkSubmitted(ctx context.Context, transfer *models.Transfer) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := advanceSaga(tx, transfer.ID, models.TransferSagaStepPartnerSubmitted, []string{models.TransferSagaStepDebitPosted}, nil); err != nil {
+			return err
+		}
+		if err := tx.Save(transfer).Error; err != nil {
+			return fmt.Errorf("failed to update transfer: %w", err)
+		}
+		return nil
+	})
+}
+
+// Confirm saves the transfer as completed, advances the saga to partner_confirmed and writes the
+// transfer.completed outbox event. A compensated saga returns ErrTransferSagaFinished so the
+// reversal is never undone.
+func (r *transferSagaRepository) Confirm(ctx context.Context, transfer *models.Transfer) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		steps := []string{models.TransferSagaStepDebitPosted, models.TransferSagaStepPartnerSubmitted}
+		if err := advanceSaga(tx, transfer.ID, models.TransferSagaStepPartnerConfirmed, steps, map[string]interface{}{"completed_at": now}); err != nil {
+			if errors.Is(err, ErrTransferSagaFinished) && sagaAtStep(tx, transfer.ID, models.TransferSagaStepPartnerConfirmed) {
+				return nil // Repeated confirmation
+			}
+			return err
+		}
+		if err := tx.Save(transfer).Error; err != nil {
+			return fmt.Errorf("failed to update transfer: %w", err)
+		}
+		return appendOutboxEvents(tx, []*models.OutboxEvent{models.NewTransferEvent(transfer)})
+	})
+}
+
+// Compensate reverses the debit of an unfinished saga: it credits the source account, records the
+// reversal transaction, fails the transfer, marks the saga compensated and writes the
+// transfer.failed outbox event, all in one database transaction. The step change is claimed
+// first with a conditional update, so concurrent or repeated calls apply the reversal exactly
+// once; later calls return the transfer with applied set to false. A saga already confirmed by the partner returns ErrTransferSagaConfirmed.
+func (r *transferSagaRepository) Compensate(ctx context.Context, transferID uuid.UUID, reason, reversalDescription string) (transfer *models.Transfer, applied bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transfer = &models.Transfer{}
+		if err := tx.First(transfer, "id = ?", transferID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTransferNotFound
+			}
+			return fmt.Errorf("failed to find transfer: %w", err)
+		}
+
+		now := time.Now()
+		steps := []string{models.TransferSagaStepDebitPosted, models.TransferSagaStepPartnerSubmitted}
+		if err := advanceSaga(tx, transferID, models.TransferSagaStepCompensated, steps, map[string]interface{}{
+			"completed_at": now,
+			"last_error":   reason,
+		}); err != nil {
+			if errors.Is(err, ErrTransferSagaFinished) {
+				if sagaAtStep(tx, transferID, models.TransferSagaStepCompensated) {
+					return nil // Already compensated
+				}
+				return ErrTransferSagaConfirmed
+			}
+			return err
+		}
+
+		// The reversal is credited even if the account has since been frozen; the funds were ours
+		// to return the moment the partner leg failed.
+		account := &models.Account{ID: transfer.FromAccountID}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(account).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to lock source account: %w", err)
+		}
+
+		balanceBefore := account.Balance
+		newBalance := balanceBefore.Add(transfer.Amount)
+		if err := tx.Model(account).Update("balance", newBalance).Error; err != nil {
+			return fmt.Errorf("failed to credit source account: %w", err)
+		}
+
+		reversalTx := &models.Transaction{
+			AccountID:       account.ID,
+			TransactionType: models.TransactionTypeCredit,
+			Amount:          transfer.Amount,
+			BalanceBefore:   balanceBefore,
+			BalanceAfter:    newBalance,
+			Description:     reversalDescription,
+			Status:          models.TransactionStatusCompleted,
+			Reference:       models.GenerateTransactionReference(),
+		}
+		if err := tx.Create(reversalTx).Error; err != nil {
+			return fmt.Errorf("failed to create reversal transaction: %w", err)
+		}
+
+		if err := tx.Model(&models.TransferSaga{}).Where("transfer_id = ?", transferID).
+			Update("compensation_transaction_id", reversalTx.ID).Error; err != nil {
+			return fmt.Errorf("failed to update transfer saga: %w", err)
+		}
+
+		transfer.Fail(reason)
+		transfer.ReversalTransactionID = &reversalTx.ID
+		if err := tx.Save(transfer).Error; err != nil {
+			return fmt.Errorf("failed to update transfer: %w", err)
+		}
+		if err := appendOutboxEvents(tx, []*models.OutboxEvent{
+			models.NewTransactionPostedEvent(reversalTx),
+			models.NewTransferEvent(transfer),
+		}); err != nil {
+			return err
+		}
+
+		applied = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return transfer, applied, nil
+}
+
+// RecordRecoveryFailure notes a failed recovery attempt so stalled sagas can be monitored.
+func (r *transferSagaRepository) RecordRecoveryFailure(ctx context.Context, transferID uuid.UUID, reason string) error {
+	result := r.db.WithContext(ctx).Model(&models.TransferSaga{}).Where("transfer_id = ?", transferID).Updates(map[string]interface{}{
+		"recovery_attempts": gorm.Expr("recovery_attempts + 1"),
+		"last_error":        reason,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to record transfer saga recovery failure: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrTransferSagaNotFound
+	}
+	return nil
+}
+
+func (r *transferSagaRepository) GetByTransferID(ctx context.Context, transferID uuid.UUID) (*models.TransferSaga, error) {
+	var saga models.TransferSaga
+	if err := r.db.WithContext(ctx).First(&saga, "transfer_id = ?", transferID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferSagaNotFound
+		}
+		return nil, fmt.Errorf("failed to find transfer saga: %w", err)
+	}
+	return &saga, nil
+}
+
+// FindStalled returns sagas still at debit_posted that were last touched before the cutoff,
+// oldest first. These are transfers whose partner submission was interrupted.
+func (r *transferSagaRepository) FindStalled(ctx context.Context, olderThan time.Time, limit int) ([]models.TransferSaga, error) {
+	var sagas []models.TransferSaga
+	if err := r.db.WithContext(ctx).Where("step = ? AND updated_at < ?", models.TransferSagaStepDebitPosted, olderThan).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&sagas).Error; err != nil {
+		return nil, fmt.Errorf("failed to find stalled transfer sagas: %w", err)
+	}
+	return sagas, nil
+}
+
+// advanceSaga moves the saga to the given step only if it is currently in one of the allowed
+// steps. It returns ErrTransferSagaNotFound or ErrTransferSagaFinished when nothing was updated.
+func advanceSaga(tx *gorm.DB, transferID uuid.UUID, step string, from []string, extra map[string]interface{}) error {
+	updates := map[string]interface{}{"step": step, "updated_at": time.Now()}
+	for k, v := range extra {
+		updates[k] = v
+	}
+
+	result := tx.Model(&models.TransferSaga{}).
+		Where("transfer_id = ? AND step IN ?", transferID, from).
+		Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update transfer saga: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&models.TransferSaga{}).Where("transfer_id = ?", transferID).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to find transfer saga: %w", err)
+	}
+	if count == 0 {
+		return ErrTransferSagaNotFound
+	}
+	return ErrTransferSagaFinished
+}
+
+// sagaAtStep reports whether the transfer's saga is at the given step.
+func sagaAtStep(tx *gorm.DB, transferID uuid.UUID, step string) bool {
+	var count int64
+	tx.Model(&models.TransferSaga{}).Where("transfer_id = ? AND step = ?", transferID, step).Count(&count)
+	return count > 0
+}
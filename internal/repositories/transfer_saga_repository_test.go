@@ -0,0 +1,276 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransferSagaRepositoryTestSuite struct {
+	suite.Suite
+	db      *database.DB
+	repo    TransferSagaRepositoryInterface
+	account *models.Account
+	payee   *models.ExternalAccount
+}
+
+func (s *TransferSagaRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewTransferSagaRepository(s.db.DB)
+
+	user := database.CreateTestUser(s.T(), s.db, "saga@example.com")
+	s.account = &models.Account{
+		UserID:        user.ID,
+		AccountNumber: "1012345678",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(100.00),
+		Status:        models.AccountStatusActive,
+		Currency:      "USD",
+	}
+	s.Require().NoError(s.db.Create(s.account).Error)
+	s.payee = &models.ExternalAccount{
+		UserID:             user.ID,
+		ExternalAccountID:  uuid.New(),
+		Nickname:           "Landlord",
+		AccountNumberMask:  "9012",
+		NameOnAccount:      "Jane Landlord",
+		BankName:           "Northwind Bank",
+		VerificationStatus: models.ExternalAccountStatusVerified,
+	}
+	s.Require().NoError(s.db.Create(s.payee).Error)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestTransferSagaRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferSagaRepositoryTestSuite))
+}
+
+func (s *TransferSagaRepositoryTestSuite) newTransfer(amount float64) *models.Transfer {
+	return &models.Transfer{
+		FromAccountID:       s.account.ID,
+		ToExternalAccountID: &s.payee.ID,
+		Amount:              decimal.NewFromFloat(amount),
+		Description:         "Rent",
+		IdempotencyKey:      uuid.NewString(),
+		Status:              models.TransferStatusPending,
+	}
+}
+
+func (s *TransferSagaRepositoryTestSuite) balance() decimal.Decimal {
+	var account models.Account
+	s.Require().NoError(s.db.First(&account, "id = ?", s.account.ID).Error)
+	return account.Balance
+}
+
+func (s *TransferSagaRepositoryTestSuite) begin(amount float64) *models.Transfer {
+	transfer := s.newTransfer(amount)
+	_, err := s.repo.BeginWithDebit(context.Background(), transfer, "standard", "External Transfer to Landlord")
+	s.Require().NoError(err)
+	return transfer
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestBeginWithDebit_Success() {
+	transfer := s.newTransfer(40)
+
+	saga, err := s.repo.BeginWithDebit(context.Background(), transfer, "standard", "External Transfer to Landlord")
+	s.Require().NoError(err)
+	s.Equal(models.TransferSagaStepDebitPosted, saga.Step)
+	s.Equal("standard", saga.TransferType)
+	s.Equal(transfer.ID, saga.TransferID)
+	s.Require().NotNil(transfer.DebitTransactionID)
+	s.Equal(transfer.DebitTransactionID, saga.DebitTransactionID)
+	s.True(s.balance().Equal(decimal.NewFromFloat(60)))
+
+	var debit models.Transaction
+	s.Require().NoError(s.db.First(&debit, "id = ?", *transfer.DebitTransactionID).Error)
+	s.Equal(models.TransactionTypeDebit, debit.TransactionType)
+	s.True(debit.BalanceAfter.Equal(decimal.NewFromFloat(60)))
+
+	found, err := s.repo.GetByTransferID(context.Background(), transfer.ID)
+	s.Require().NoError(err)
+	s.Equal(saga.ID, found.ID)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestBeginWithDebit_InsufficientFundsRollsBack() {
+	transfer := s.newTransfer(150)
+
+	_, err := s.repo.BeginWithDebit(context.Background(), transfer, "standard", "External Transfer to Landlord")
+	s.ErrorIs(err, ErrInsufficientFunds)
+	s.Nil(transfer.DebitTransactionID)
+	s.True(s.balance().Equal(decimal.NewFromFloat(100)))
+
+	var count int64
+	s.db.Model(&models.Transfer{}).Count(&count)
+	s.Zero(count)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestBeginWithDebit_InactiveAccount() {
+	s.Require().NoError(s.db.Model(s.account).Update("status", models.AccountStatusInactive).Error)
+
+	_, err := s.repo.BeginWithDebit(context.Background(), s.newTransfer(10), "standard", "External Transfer")
+	s.ErrorIs(err, ErrAccountNotActive)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestMarkSubmitted() {
+	transfer := s.begin(25)
+	externalID := "nw_tr_1"
+	transfer.ExternalTransferID = &externalID
+	transfer.Status = models.TransferStatusProcessing
+
+	s.Require().NoError(s.repo.MarkSubmitted(context.Background(), transfer))
+
+	saga, err := s.repo.GetByTransferID(context.Background(), transfer.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransferSagaStepPartnerSubmitted, saga.Step)
+
+	var stored models.Transfer
+	s.Require().NoError(s.db.First(&stored, "id = ?", transfer.ID).Error)
+	s.Equal(models.TransferStatusProcessing, stored.Status)
+	s.Equal(externalID, *stored.ExternalTransferID)
+
+	s.ErrorIs(s.repo.MarkSubmitted(context.Background(), transfer), ErrTransferSagaFinished, "submission is recorded once")
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestCompensate_AppliesExactlyOnce() {
+	transfer := s.begin(25)
+	s.True(s.balance().Equal(decimal.NewFromFloat(75)))
+
+	failed, applied, err := s.repo.Compensate(context.Background(), transfer.ID, "account closed", "Reversal for failed transfer")
+	s.Require().NoError(err)
+	s.True(applied)
+	s.Equal(models.TransferStatusFailed, failed.Status)
+	s.Equal("account closed", *failed.ErrorMessage)
+	s.Require().NotNil(failed.ReversalTransactionID)
+	s.True(s.balance().Equal(decimal.NewFromFloat(100)))
+
+	saga, err := s.repo.GetByTransferID(context.Background(), transfer.ID)
+	s.Require().NoError(err)
+	s.Equal(models.TransferSagaStepCompensated, saga.Step)
+	s.Equal(failed.ReversalTransactionID, saga.CompensationTransactionID)
+	s.NotNil(saga.CompletedAt)
+
+	again, applied, err := s.repo.Compensate(context.Background(), transfer.ID, "account closed", "Reversal for failed transfer")
+	s.Require().NoError(err)
+	s.False(applied)
+	s.Equal(failed.ReversalTransactionID, again.ReversalTransactionID)
+	s.True(s.balance().Equal(decimal.NewFromFloat(100)), "balance is credited once")
+
+	var reversals int64
+	s.db.Model(&models.Transaction{}).Where("transaction_type = ?", models.TransactionTypeCredit).Count(&reversals)
+	s.Equal(int64(1), reversals)
+
+	var events []models.OutboxEvent
+	s.Require().NoError(s.db.Where("aggregate_id = ?", transfer.ID).Find(&events).Error)
+	s.Require().Len(events, 1, "the failure event is written once")
+	s.Equal(models.EventTypeTransferFailed, events[0].EventType)
+	s.Equal("account closed", events[0].Payload["reason"])
+
+	var posted []models.OutboxEvent
+	s.Require().NoError(s.db.Where("event_type = ?", models.EventTypeTransactionPosted).Order("id").Find(&posted).Error)
+	s.Require().Len(posted, 2, "the debit and the reversal are each posted once")
+	s.Equal(transfer.DebitTransactionID.String(), posted[0].Payload["transaction_id"])
+	s.Equal(failed.ReversalTransactionID.String(), posted[1].Payload["transaction_id"])
+	s.Equal("100", posted[1].Payload["balance_after"])
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestCompensate_AfterConfirmIsRejected() {
+	transfer := s.begin(25)
+	now := time.Now()
+	transfer.Status = models.TransferStatusCompleted
+	transfer.CompletedAt = &now
+	s.Require().NoError(s.repo.Confirm(context.Background(), transfer))
+	s.NoError(s.repo.Confirm(context.Background(), transfer), "confirmation is idempotent")
+
+	var events []models.OutboxEvent
+	s.Require().NoError(s.db.Where("aggregate_id = ?", transfer.ID).Find(&events).Error)
+	s.Require().Len(events, 1)
+	s.Equal(models.EventTypeTransferCompleted, events[0].EventType)
+
+	_, _, err := s.repo.Compensate(context.Background(), transfer.ID, "late failure", "Reversal")
+	s.ErrorIs(err, ErrTransferSagaConfirmed)
+	s.True(s.balance().Equal(decimal.NewFromFloat(75)))
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestConfirm_AfterCompensateIsRejected() {
+	transfer := s.begin(25)
+	_, _, err := s.repo.Compensate(context.Background(), transfer.ID, "rejected", "Reversal")
+	s.Require().NoError(err)
+
+	transfer.Status = models.TransferStatusCompleted
+	s.ErrorIs(s.repo.Confirm(context.Background(), transfer), ErrTransferSagaFinished)
+
+	var stored models.Transfer
+	s.Require().NoError(s.db.First(&stored, "id = ?", transfer.ID).Error)
+	s.Equal(models.TransferStatusFailed, stored.Status)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestCompensate_NoSaga() {
+	transfer := s.newTransfer(10)
+	s.Require().NoError(s.db.Create(transfer).Error)
+
+	_, _, err := s.repo.Compensate(context.Background(), transfer.ID, "failed", "Reversal")
+	s.ErrorIs(err, ErrTransferSagaNotFound)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestFindStalledAndRecordRecoveryFailure() {
+	stalled := s.begin(10)
+	submitted := s.begin(10)
+	externalID := "nw_tr_2"
+	submitted.ExternalTransferID = &externalID
+	s.Require().NoError(s.repo.MarkSubmitted(context.Background(), submitted))
+
+	sagas, err := s.repo.FindStalled(context.Background(), time.Now().Add(-time.Minute), 10)
+	s.Require().NoError(err)
+	s.Empty(sagas, "recent sagas are within the grace period")
+
+	sagas, err = s.repo.FindStalled(context.Background(), time.Now().Add(time.Second), 10)
+	s.Require().NoError(err)
+	s.Require().Len(sagas, 1)
+	s.Equal(stalled.ID, sagas[0].TransferID)
+
+	s.Require().NoError(s.repo.RecordRecoveryFailure(context.Background(), stalled.ID, "northwind is unavailable"))
+	saga, err := s.repo.GetByTransferID(context.Background(), stalled.ID)
+	s.Require().NoError(err)
+	s.Equal(1, saga.RecoveryAttempts)
+	s.Equal("northwind is unavailable", *saga.LastError)
+
+	s.ErrorIs(s.repo.RecordRecoveryFailure(context.Background(), uuid.New(), "x"), ErrTransferSagaNotFound)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestBeginWithDebit_UnverifiedPayeeCappedOverWindow() {
+	s.Require().NoError(s.db.Model(s.payee).Update("verification_status", models.ExternalAccountStatusMicroDepositsSent).Error)
+	s.Require().NoError(s.db.Model(s.account).Update("balance", decimal.NewFromFloat(1000)).Error)
+
+	s.begin(60)
+	failed := s.begin(30)
+	s.Require().NoError(s.db.Model(&models.Transfer{}).Where("id = ?", failed.ID).UpdateColumn("status", models.TransferStatusFailed).Error)
+	s.begin(30)
+
+	// 60 + 30 already sent; failed transfers do not count
+	_, err := s.repo.BeginWithDebit(context.Background(), s.newTransfer(20), "standard", "External Transfer to Landlord")
+	s.ErrorIs(err, ErrUnverifiedPayeeLimitExceeded)
+	s.True(s.balance().Equal(decimal.NewFromFloat(880)))
+
+	// Transfers older than the window no longer count
+	s.Require().NoError(s.db.Model(&models.Transfer{}).
+		Where("to_external_account_id = ?", s.payee.ID).
+		UpdateColumn("created_at", time.Now().Add(-models.UnverifiedExternalTransferWindow-time.Hour)).Error)
+	_, err = s.repo.BeginWithDebit(context.Background(), s.newTransfer(10), "standard", "External Transfer to Landlord")
+	s.NoError(err)
+}
+
+func (s *TransferSagaRepositoryTestSuite) TestBeginWithDebit_VerifiedPayeeNotCapped() {
+	s.Require().NoError(s.db.Model(s.account).Update("balance", decimal.NewFromFloat(1000)).Error)
+
+	s.begin(100)
+	s.begin(100)
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -29,12 +30,12 @@ func NewUserRepository(db *gorm.DB) UserRepositoryInterface {
 }
 
 // Create creates a new user in the database
-func (r *UserRepository) Create(user *models.User) error {
+func (r *UserRepository) Create(ctx context.Context, user *models.User) error {
 	if user == nil {
 		return errors.New("user cannot be nil")
 	}
 
-	if err := r.db.Create(user).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(user).Error; err != nil {
 		if isDuplicateKeyError(err) {
 			return ErrUserAlreadyExists
 		}
@@ -45,9 +46,9 @@ func (r *UserRepository) Create(user *models.User) error {
 }
 
 // GetByID retrieves a user by their ID
-func (r *UserRepository) GetByID(id uuid.UUID) (*models.User, error) {
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	user := &models.User{ID: id}
-	if err := r.db.First(user).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
 		}
@@ -58,9 +59,9 @@ func (r *UserRepository) GetByID(id uuid.UUID) (*models.User, error) {
 }
 
 // GetByIDActive retrieves an active (non-deleted) user by their ID
-func (r *UserRepository) GetByIDActive(id uuid.UUID) (*models.User, error) {
+func (r *UserRepository) GetByIDActive(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	user := &models.User{ID: id}
-	if err := r.db.First(user).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
 		}
@@ -71,10 +72,10 @@ func (r *UserRepository) GetByIDActive(id uuid.UUID) (*models.User, error) {
 }
 
 // GetByEmail retrieves a user by their email address
-func (r *UserRepository) GetByEmail(email string) (*models.User, error) {
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
 
-	if err := r.db.Where("email = ?", email).First(&user).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
 		}
@@ -85,12 +86,12 @@ func (r *UserRepository) GetByEmail(email string) (*models.User, error) {
 }
 
 // Update updates a user in the database
-func (r *UserRepository) Update(user *models.User) error {
+func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 	if user == nil {
 		return errors.New("user cannot be nil")
 	}
 
-	if err := r.db.Save(user).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(user).Error; err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
 
@@ -98,7 +99,7 @@ func (r *UserRepository) Update(user *models.User) error {
 }
 
 // UpdatePasswordHash atomically updates a user's password hash
-func (r *UserRepository) UpdatePasswordHash(userID uuid.UUID, passwordHash string) error {
+func (r *UserRepository) UpdatePasswordHash(ctx context.Context, userID uuid.UUID, passwordHash string) error {
 	if userID == uuid.Nil {
 		return errors.New("user ID cannot be nil")
 	}
@@ -107,7 +108,7 @@ func (r *UserRepository) UpdatePasswordHash(userID uuid.UUID, passwordHash strin
 		return errors.New("password hash cannot be empty")
 	}
 
-	result := r.db.Model(&models.User{ID: userID}).Update("password_hash", passwordHash)
+	result := r.db.WithContext(ctx).Model(&models.User{ID: userID}).Update("password_hash", passwordHash)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update password hash: %w", result.Error)
 	}
@@ -120,7 +121,7 @@ func (r *UserRepository) UpdatePasswordHash(userID uuid.UUID, passwordHash strin
 }
 
 // UpdateFailedLoginAttempts updates the failed login attempts and locked status
-func (r *UserRepository) UpdateFailedLoginAttempts(user *models.User) error {
+func (r *UserRepository) UpdateFailedLoginAttempts(ctx context.Context, user *models.User) error {
 	if user == nil {
 		return errors.New("user cannot be nil")
 	}
@@ -130,7 +131,7 @@ func (r *UserRepository) UpdateFailedLoginAttempts(user *models.User) error {
 		"locked_at":             user.LockedAt,
 	}
 
-	if err := r.db.Model(user).Updates(updates).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(user).Updates(updates).Error; err != nil {
 		return fmt.Errorf("failed to update login attempts: %w", err)
 	}
 
@@ -138,13 +139,13 @@ func (r *UserRepository) UpdateFailedLoginAttempts(user *models.User) error {
 }
 
 // ResetFailedLoginAttempts resets the failed login counter for a user
-func (r *UserRepository) ResetFailedLoginAttempts(userID uuid.UUID) error {
+func (r *UserRepository) ResetFailedLoginAttempts(ctx context.Context, userID uuid.UUID) error {
 	updates := map[string]interface{}{
 		"failed_login_attempts": 0,
 		"locked_at":             nil,
 	}
 
-	if err := r.db.Model(&models.User{ID: userID}).Updates(updates).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&models.User{ID: userID}).Updates(updates).Error; err != nil {
 		return fmt.Errorf("failed to reset login attempts: %w", err)
 	}
 
@@ -152,13 +153,13 @@ func (r *UserRepository) ResetFailedLoginAttempts(userID uuid.UUID) error {
 }
 
 // UnlockAccount unlocks a user account
-func (r *UserRepository) UnlockAccount(userID uuid.UUID) error {
-	return r.ResetFailedLoginAttempts(userID)
+func (r *UserRepository) UnlockAccount(ctx context.Context, userID uuid.UUID) error {
+	return r.ResetFailedLoginAttempts(ctx, userID)
 }
 
 // Delete soft deletes a user
-func (r *UserRepository) Delete(userID uuid.UUID) error {
-	result := r.db.Delete(&models.User{ID: userID})
+func (r *UserRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.User{ID: userID})
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete user: %w", result.Error)
 	}
@@ -170,15 +171,15 @@ func (r *UserRepository) Delete(userID uuid.UUID) error {
 }
 
 // ListUsers lists users with pagination
-func (r *UserRepository) ListUsers(offset, limit int) ([]*models.User, int64, error) {
+func (r *UserRepository) ListUsers(ctx context.Context, offset, limit int) ([]*models.User, int64, error) {
 	var users []*models.User
 	var total int64
 
-	if err := r.db.Model(&models.User{}).Count(&total).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&models.User{}).Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to count users: %w", err)
 	}
 
-	if err := r.db.Offset(offset).Limit(limit).Find(&users).Error; err != nil {
+	if err := r.db.WithContext(ctx).Offset(offset).Limit(limit).Find(&users).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to list users: %w", err)
 	}
 
@@ -198,9 +199,9 @@ func isDuplicateKeyError(err error) bool {
 }
 
 // GetByEmailExcluding retrieves a user by email, excluding a specific user ID
-func (r *UserRepository) GetByEmailExcluding(email string, excludeUserID uuid.UUID) (*models.User, error) {
+func (r *UserRepository) GetByEmailExcluding(ctx context.Context, email string, excludeUserID uuid.UUID) (*models.User, error) {
 	var user models.User
-	if err := r.db.Where("email = ? AND id != ?", email, excludeUserID).First(&user).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("email = ? AND id != ?", email, excludeUserID).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
 		}
@@ -210,8 +211,8 @@ func (r *UserRepository) GetByEmailExcluding(email string, excludeUserID uuid.UU
 }
 
 // UpdateFields updates specific fields of a user
-func (r *UserRepository) UpdateFields(userID uuid.UUID, fields map[string]interface{}) error {
-	result := r.db.Model(&models.User{ID: userID}).
+func (r *UserRepository) UpdateFields(ctx context.Context, userID uuid.UUID, fields map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&models.User{ID: userID}).
 		Updates(fields)
 
 	if result.Error != nil {
@@ -227,8 +228,8 @@ func (r *UserRepository) UpdateFields(userID uuid.UUID, fields map[string]interf
 }
 
 // UpdateEmail updates a user's email address
-func (r *UserRepository) UpdateEmail(userID uuid.UUID, newEmail string) error {
-	result := r.db.Model(&models.User{ID: userID}).
+func (r *UserRepository) UpdateEmail(ctx context.Context, userID uuid.UUID, newEmail string) error {
+	result := r.db.WithContext(ctx).Model(&models.User{ID: userID}).
 		Update("email", newEmail)
 
 	if result.Error != nil {
@@ -244,9 +245,9 @@ func (r *UserRepository) UpdateEmail(userID uuid.UUID, newEmail string) error {
 }
 
 // CountAccountsByUserID counts the number of active accounts for a user
-func (r *UserRepository) CountAccountsByUserID(userID uuid.UUID) (int64, error) {
+func (r *UserRepository) CountAccountsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
 	var count int64
-	if err := r.db.Model(&models.Account{}).
+	if err := r.db.WithContext(ctx).Model(&models.Account{}).
 		Where("user_id = ? AND deleted_at IS NULL", userID).
 		Count(&count).Error; err != nil {
 		return 0, fmt.Errorf("failed to count accounts: %w", err)
@@ -255,11 +256,11 @@ func (r *UserRepository) CountAccountsByUserID(userID uuid.UUID) (int64, error)
 }
 
 // SearchUsers searches for users based on criteria
-func (r *UserRepository) SearchUsers(criteria UserSearchCriteria, offset, limit int) ([]*models.User, int64, error) {
+func (r *UserRepository) SearchUsers(ctx context.Context, criteria UserSearchCriteria, offset, limit int) ([]*models.User, int64, error) {
 	var users []*models.User
 	var total int64
 
-	baseQuery := r.db.Model(&models.User{})
+	baseQuery := r.db.WithContext(ctx).Model(&models.User{})
 
 	// Apply search filter based on type
 	switch criteria.SearchType {
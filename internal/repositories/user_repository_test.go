@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -38,7 +39,7 @@ func (s *UserRepositorySuite) TestUserRepository_Create() {
 		Role:         models.RoleCustomer,
 	}
 
-	err := s.repo.Create(user)
+	err := s.repo.Create(context.Background(), user)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, user.ID)
 	s.NotZero(user.CreatedAt)
@@ -54,17 +55,17 @@ func (s *UserRepositorySuite) TestUserRepository_GetByEmail() {
 		LastName:     "User",
 		Role:         models.RoleCustomer,
 	}
-	err := s.repo.Create(user)
+	err := s.repo.Create(context.Background(), user)
 	s.NoError(err)
 
 	// Test getting existing user
-	foundUser, err := s.repo.GetByEmail("test@example.com")
+	foundUser, err := s.repo.GetByEmail(context.Background(), "test@example.com")
 	s.NoError(err)
 	s.Equal(user.ID, foundUser.ID)
 	s.Equal(user.Email, foundUser.Email)
 
 	// Test getting non-existent user
-	_, err = s.repo.GetByEmail("nonexistent@example.com")
+	_, err = s.repo.GetByEmail(context.Background(), "nonexistent@example.com")
 	s.Equal(ErrUserNotFound, err)
 }
 
@@ -77,17 +78,17 @@ func (s *UserRepositorySuite) TestUserRepository_Update() {
 		LastName:     "User",
 		Role:         models.RoleCustomer,
 	}
-	err := s.repo.Create(user)
+	err := s.repo.Create(context.Background(), user)
 	s.NoError(err)
 
 	// Update user
 	user.FirstName = "Updated"
 	user.FailedLoginAttempts = 2
-	err = s.repo.Update(user)
+	err = s.repo.Update(context.Background(), user)
 	s.NoError(err)
 
 	// Verify update
-	updatedUser, err := s.repo.GetByID(user.ID)
+	updatedUser, err := s.repo.GetByID(context.Background(), user.ID)
 	s.NoError(err)
 	s.Equal("Updated", updatedUser.FirstName)
 	s.Equal(2, updatedUser.FailedLoginAttempts)
@@ -103,15 +104,15 @@ func (s *UserRepositorySuite) TestUserRepository_UnlockAccount() {
 		Role:                models.RoleCustomer,
 		FailedLoginAttempts: 3,
 	}
-	err := s.repo.Create(user)
+	err := s.repo.Create(context.Background(), user)
 	s.NoError(err)
 
 	// Unlock account
-	err = s.repo.UnlockAccount(user.ID)
+	err = s.repo.UnlockAccount(context.Background(), user.ID)
 	s.NoError(err)
 
 	// Verify unlock
-	unlockedUser, err := s.repo.GetByID(user.ID)
+	unlockedUser, err := s.repo.GetByID(context.Background(), user.ID)
 	s.NoError(err)
 	s.Equal(0, unlockedUser.FailedLoginAttempts)
 	s.Nil(unlockedUser.LockedAt)
@@ -126,15 +127,15 @@ func (s *UserRepositorySuite) TestUserRepository_Delete() {
 		LastName:     "User",
 		Role:         models.RoleCustomer,
 	}
-	err := s.repo.Create(user)
+	err := s.repo.Create(context.Background(), user)
 	s.NoError(err)
 
 	// Delete user
-	err = s.repo.Delete(user.ID)
+	err = s.repo.Delete(context.Background(), user.ID)
 	s.NoError(err)
 
 	// Verify user is soft deleted (not found by normal query)
-	_, err = s.repo.GetByID(user.ID)
+	_, err = s.repo.GetByID(context.Background(), user.ID)
 	s.Equal(ErrUserNotFound, err)
 }
 
@@ -148,18 +149,18 @@ func (s *UserRepositorySuite) TestUserRepository_ListUsers() {
 			LastName:     fmt.Sprintf("User%d", i),
 			Role:         models.RoleCustomer,
 		}
-		err := s.repo.Create(user)
+		err := s.repo.Create(context.Background(), user)
 		s.NoError(err)
 	}
 
 	// Test pagination
-	users, total, err := s.repo.ListUsers(0, 3)
+	users, total, err := s.repo.ListUsers(context.Background(), 0, 3)
 	s.NoError(err)
 	s.Equal(int64(5), total)
 	s.Len(users, 3)
 
 	// Test second page
-	users, total, err = s.repo.ListUsers(3, 3)
+	users, total, err = s.repo.ListUsers(context.Background(), 3, 3)
 	s.NoError(err)
 	s.Equal(int64(5), total)
 	s.Len(users, 2)
@@ -174,25 +175,25 @@ func (s *UserRepositorySuite) TestUserRepository_GetByIDActive() {
 		LastName:     "User",
 		Role:         models.RoleCustomer,
 	}
-	err := s.repo.Create(user)
+	err := s.repo.Create(context.Background(), user)
 	s.NoError(err)
 
 	// Test getting active user
-	foundUser, err := s.repo.GetByIDActive(user.ID)
+	foundUser, err := s.repo.GetByIDActive(context.Background(), user.ID)
 	s.NoError(err)
 	s.Equal(user.ID, foundUser.ID)
 	s.Equal(user.Email, foundUser.Email)
 
 	// Soft delete the user
-	err = s.repo.Delete(user.ID)
+	err = s.repo.Delete(context.Background(), user.ID)
 	s.NoError(err)
 
 	// Test getting deleted user (should fail)
-	_, err = s.repo.GetByIDActive(user.ID)
+	_, err = s.repo.GetByIDActive(context.Background(), user.ID)
 	s.Equal(ErrUserNotFound, err)
 
 	// Test getting non-existent user
-	_, err = s.repo.GetByIDActive(uuid.New())
+	_, err = s.repo.GetByIDActive(context.Background(), uuid.New())
 	s.Equal(ErrUserNotFound, err)
 }
 
@@ -205,30 +206,30 @@ func (s *UserRepositorySuite) TestUserRepository_UpdatePasswordHash() {
 		LastName:     "User",
 		Role:         models.RoleCustomer,
 	}
-	err := s.repo.Create(user)
+	err := s.repo.Create(context.Background(), user)
 	s.NoError(err)
 
 	// Update password hash
 	newHash := "new_hash_value"
-	err = s.repo.UpdatePasswordHash(user.ID, newHash)
+	err = s.repo.UpdatePasswordHash(context.Background(), user.ID, newHash)
 	s.NoError(err)
 
 	// Verify update
-	updatedUser, err := s.repo.GetByID(user.ID)
+	updatedUser, err := s.repo.GetByID(context.Background(), user.ID)
 	s.NoError(err)
 	s.Equal(newHash, updatedUser.PasswordHash)
 
 	// Test with nil UUID
-	err = s.repo.UpdatePasswordHash(uuid.Nil, "hash")
+	err = s.repo.UpdatePasswordHash(context.Background(), uuid.Nil, "hash")
 	s.Error(err)
 	s.Contains(err.Error(), "user ID cannot be nil")
 
 	// Test with empty hash
-	err = s.repo.UpdatePasswordHash(user.ID, "")
+	err = s.repo.UpdatePasswordHash(context.Background(), user.ID, "")
 	s.Error(err)
 	s.Contains(err.Error(), "password hash cannot be empty")
 
 	// Test with non-existent user
-	err = s.repo.UpdatePasswordHash(uuid.New(), "new_hash")
+	err = s.repo.UpdatePasswordHash(context.Background(), uuid.New(), "new_hash")
 	s.Equal(ErrUserNotFound, err)
 }
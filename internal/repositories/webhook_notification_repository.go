@@ -1,13 +1,18 @@
 package repositories
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.comcom/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+var ErrWebhookNotificationNotFound = errors.New("webhook notification not found")
+
 type webhookNotificationRepository struct {
 	db *gorm.DB
 }
@@ -16,26 +21,26 @@ func NewWebhookNotificationRepository(db *gorm.DB) WebhookNotificationRepository
 	return &webhookNotificationRepository{db: db}
 }
 
-func (r *webhookNotificationRepository) Create(notification *models.WebhookNotification) error {
-	if err := r.db.Create(notification).Error; err != nil {
+func (r *webhookNotificationRepository) Create(ctx context.Context, notification *models.WebhookNotification) error {
+	if err := r.db.WithContext(ctx).Create(notification).Error; err != nil {
 		return fmt.Errorf("failed to create webhook notification: %w", err)
 	}
 	return nil
 }
 
-func (r *webhookNotificationRepository) Update(notification *models.WebhookNotification) error {
-	if err := r.db.Save(notification).Error; err != nil {
+func (r *webhookNotificationRepository) Update(ctx context.Context, notification *models.WebhookNotification) error {
+	if err := r.db.WithContext(ctx).Save(notification).Error; err != nil {
 		return fmt.Errorf("failed to update webhook notification: %w", err)
 	}
 	return nil
 }
 
 // FindPending retrieves notifications that are pending or failed and ready for a retry.
-func (r *webhookNotificationRepository) FindPending(limit int) ([]models.WebhookNotification, error) {
+func (r *webhookNotificationRepository) FindPending(ctx context.Context, limit int) ([]models.WebhookNotification, error) {
 	var notifications []models.WebhookNotification
 	now := time.Now()
 
-	err := r.db.Preload("Transfer").Where("status IN ? AND next_attempt_at <= ? AND attempts < ?",
+	err := r.db.WithContext(ctx).Preload("Transfer").Where("status IN ? AND next_attempt_at <= ? AND attempts < ?",
 		[]string{models.WebhookStatusPending, models.WebhookStatusFailed},
 		now,
 		models.WebhookMaxAttempts,
@@ -49,3 +54,58 @@ func (r *webhookNotificationRepository) FindPending(limit int) ([]models.Webhook
 	}
 	return notifications, nil
 }
+
+func (r *webhookNotificationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookNotification, error) {
+	var notification models.WebhookNotification
+	if err := r.db.WithContext(ctx).Preload("Transfer").First(&notification, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookNotificationNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook notification: %w", err)
+	}
+	return &notification, nil
+}
+
+// List returns notifications matching the filters, oldest first.
+func (r *webhookNotificationRepository) List(ctx context.Context, filters models.WebhookNotificationFilters, offset, limit int) ([]models.WebhookNotification, int64, error) {
+	var notifications []models.WebhookNotification
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.WebhookNotification{})
+	if len(filters.Statuses) > 0 {
+		query = query.Where("status IN ?", filters.Statuses)
+	}
+	if filters.TransferID != nil {
+		query = query.Where("transfer_id = ?", *filters.TransferID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook notifications: %w", err)
+	}
+
+	if err := query.Preload("Transfer").Order("created_at ASC").Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook notifications: %w", err)
+	}
+
+	return notifications, total, nil
+}
+
+// CountByStatus returns the number of notifications in each status that has any.
+func (r *webhookNotificationRepository) CountByStatus(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).Model(&models.WebhookNotification{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count webhook notifications by status: %w", err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
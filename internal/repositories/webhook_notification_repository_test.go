@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -19,7 +20,6 @@ type WebhookNotificationRepositoryTestSuite struct {
 
 func (s *WebhookNotificationRepositoryTestSuite) SetupTest() {
 	s.db = database.SetupTestDB(s.T())
-	s.NoError(s.db.AutoMigrate(&models.Transfer{}, &models.WebhookNotification{}))
 	s.repo = NewWebhookNotificationRepository(s.db.DB)
 }
 
@@ -52,7 +52,7 @@ func (s *WebhookNotificationRepositoryTestSuite) TestCreate_Success() {
 		Status:     models.WebhookStatusPending,
 	}
 
-	err := s.repo.Create(notification)
+	err := s.repo.Create(context.Background(), notification)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, notification.ID)
 
@@ -70,14 +70,14 @@ func (s *WebhookNotificationRepositoryTestSuite) TestUpdate_Success() {
 		URL:        "https://example.com/webhook",
 		Status:     models.WebhookStatusPending,
 	}
-	s.NoError(s.repo.Create(notification))
+	s.NoError(s.repo.Create(context.Background(), notification))
 
 	now := time.Now()
 	notification.Status = models.WebhookStatusSent
 	notification.Attempts = 1
 	notification.LastAttemptAt = &now
 
-	err := s.repo.Update(notification)
+	err := s.repo.Update(context.Background(), notification)
 	s.NoError(err)
 
 	var found models.WebhookNotification
@@ -90,33 +90,33 @@ func (s *WebhookNotificationRepositoryTestSuite) TestUpdate_Success() {
 func (s *WebhookNotificationRepositoryTestSuite) TestFindPending() {
 	// 1. Pending and due now (should be found)
 	pendingDue := &models.WebhookNotification{TransferID: s.createTestTransfer().ID, URL: "url1", Status: models.WebhookStatusPending}
-	s.NoError(s.repo.Create(pendingDue))
+	s.NoError(s.repo.Create(context.Background(), pendingDue))
 
 	// 2. Pending but not due yet (should NOT be found)
 	nextAttemptFuture := time.Now().Add(1 * time.Hour)
 	pendingNotDue := &models.WebhookNotification{TransferID: s.createTestTransfer().ID, URL: "url2", Status: models.WebhookStatusPending, NextAttemptAt: &nextAttemptFuture}
-	s.NoError(s.repo.Create(pendingNotDue))
+	s.NoError(s.repo.Create(context.Background(), pendingNotDue))
 
 	// 3. Failed and ready for retry (should be found)
 	nextAttemptPast := time.Now().Add(-1 * time.Minute)
 	failedRetry := &models.WebhookNotification{TransferID: s.createTestTransfer().ID, URL: "url3", Status: models.WebhookStatusFailed, Attempts: 2, NextAttemptAt: &nextAttemptPast}
-	s.NoError(s.repo.Create(failedRetry))
+	s.NoError(s.repo.Create(context.Background(), failedRetry))
 
 	// 4. Sent (should NOT be found)
 	sent := &models.WebhookNotification{TransferID: s.createTestTransfer().ID, URL: "url4", Status: models.WebhookStatusSent}
-	s.NoError(s.repo.Create(sent))
+	s.NoError(s.repo.Create(context.Background(), sent))
 
 	// 5. Failed and max attempts reached (should NOT be found)
 	failedMaxed := &models.WebhookNotification{TransferID: s.createTestTransfer().ID, URL: "url5", Status: models.WebhookStatusFailed, Attempts: models.WebhookMaxAttempts, NextAttemptAt: &nextAttemptPast}
-	s.NoError(s.repo.Create(failedMaxed))
+	s.NoError(s.repo.Create(context.Background(), failedMaxed))
 
-	notifications, err := s.repo.FindPending(10)
+	notifications, err := s.repo.FindPending(context.Background(), 10)
 	s.NoError(err)
 	s.Len(notifications, 2)
 
 	foundIDs := make(map[uuid.UUID]bool)
-	for _, n := range notifications {
-		foundIDs[n.ID] = true
+	for i := range notifications {
+		foundIDs[notifications[i].ID] = true
 	}
 
 	s.True(foundIDs[pendingDue.ID])
@@ -125,3 +125,51 @@ func (s *WebhookNotificationRepositoryTestSuite) TestFindPending() {
 	s.False(foundIDs[sent.ID])
 	s.False(foundIDs[failedMaxed.ID])
 }
+
+func (s *WebhookNotificationRepositoryTestSuite) TestGetByID() {
+	transfer := s.createTestTransfer()
+	notification := &models.WebhookNotification{TransferID: transfer.ID, URL: "url", Status: models.WebhookStatusPending}
+	s.NoError(s.repo.Create(context.Background(), notification))
+
+	found, err := s.repo.GetByID(context.Background(), notification.ID)
+	s.Require().NoError(err)
+	s.Equal(transfer.ID, found.Transfer.ID)
+
+	_, err = s.repo.GetByID(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrWebhookNotificationNotFound)
+}
+
+func (s *WebhookNotificationRepositoryTestSuite) TestList_FiltersByStatusAndTransfer() {
+	transfer := s.createTestTransfer()
+	failed := &models.WebhookNotification{TransferID: transfer.ID, URL: "url1", Status: models.WebhookStatusFailed, Attempts: 2}
+	s.NoError(s.repo.Create(context.Background(), failed))
+	deadLettered := &models.WebhookNotification{TransferID: s.createTestTransfer().ID, URL: "url2", Status: models.WebhookStatusDeadLettered, Attempts: models.WebhookMaxAttempts}
+	s.NoError(s.repo.Create(context.Background(), deadLettered))
+	sent := &models.WebhookNotification{TransferID: transfer.ID, URL: "url3", Status: models.WebhookStatusSent}
+	s.NoError(s.repo.Create(context.Background(), sent))
+
+	notifications, total, err := s.repo.List(context.Background(), models.WebhookNotificationFilters{
+		Statuses: []string{models.WebhookStatusFailed, models.WebhookStatusDeadLettered},
+	}, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+	s.Len(notifications, 2)
+
+	notifications, total, err = s.repo.List(context.Background(), models.WebhookNotificationFilters{TransferID: &transfer.ID}, 0, 1)
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+	s.Len(notifications, 1)
+	s.Equal(failed.ID, notifications[0].ID)
+}
+
+func (s *WebhookNotificationRepositoryTestSuite) TestCountByStatus() {
+	for _, status := range []string{models.WebhookStatusDeadLettered, models.WebhookStatusDeadLettered, models.WebhookStatusSent} {
+		s.NoError(s.repo.Create(context.Background(), &models.WebhookNotification{TransferID: s.createTestTransfer().ID, URL: "url", Status: status}))
+	}
+
+	counts, err := s.repo.CountByStatus(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(2), counts[models.WebhookStatusDeadLettered])
+	s.Equal(int64(1), counts[models.WebhookStatusSent])
+	s.Zero(counts[models.WebhookStatusFailed])
+}
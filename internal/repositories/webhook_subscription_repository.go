@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+)
+
+type webhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) WebhookSubscriptionRepositoryInterface {
+	return &webhookSubscriptionRepository{db: db}
+}
+
+func (r *webhookSubscriptionRepository) Create(ctx context.Context, subscription *models.WebhookSubscription) error {
+	if err := r.db.WithContext(ctx).Create(subscription).Error; err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookSubscriptionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.WebhookSubscription, error) {
+	var subscription models.WebhookSubscription
+	if err := r.db.WithContext(ctx).First(&subscription, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook subscription by id: %w", err)
+	}
+	return &subscription, nil
+}
+
+func (r *webhookSubscriptionRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]models.WebhookSubscription, error) {
+	var subscriptions []models.WebhookSubscription
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions for user: %w", err)
+	}
+	return subscriptions, nil
+}
+
+func (r *webhookSubscriptionRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.WebhookSubscription{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count webhook subscriptions for user: %w", err)
+	}
+	return count, nil
+}
+
+func (r *webhookSubscriptionRepository) Update(ctx context.Context, subscription *models.WebhookSubscription) error {
+	if err := r.db.WithContext(ctx).Save(subscription).Error; err != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the subscription together with its delivery log.
+func (r *webhookSubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+
+		result := tx.Delete(&models.WebhookSubscription{}, "id = ?", id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete webhook subscription: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrWebhookSubscriptionNotFound
+		}
+		return nil
+	})
+}
+
+// CreateDeliveries queues deliveries, skipping any event already queued for the subscription so a
+// redelivered outbox event does not notify the customer twice.
+func (r *webhookSubscriptionRepository) CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(deliveries).Error; err != nil {
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookSubscriptionRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	if err := r.db.WithContext(ctx).First(&delivery, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook delivery by id: %w", err)
+	}
+	return &delivery, nil
+}
+
+// ListDeliveries returns the delivery log of a subscription, newest first.
+func (r *webhookSubscriptionRepository) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	var deliveries []models.WebhookDelivery
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).Where("subscription_id = ?", subscriptionID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// CountPendingDeliveries counts deliveries waiting to be sent or retried to active subscriptions.
+func (r *webhookSubscriptionRepository) CountPendingDeliveries(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Joins("JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id").
+		Where("webhook_subscriptions.status = ?", models.WebhookSubscriptionStatusActive).
+		Where("webhook_deliveries.status IN ? AND webhook_deliveries.attempts < ?",
+			[]string{models.WebhookStatusPending, models.WebhookStatusFailed},
+			models.WebhookMaxAttempts,
+		).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count pending webhook deliveries: %w", err)
+	}
+	return count, nil
+}
+
+// FindPendingDeliveries retrieves deliveries to active subscriptions that are pending or failed
+// and ready for a retry. Deliveries to disabled subscriptions wait until it is re-enabled.
+func (r *webhookSubscriptionRepository) FindPendingDeliveries(ctx context.Context, limit int) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	now := time.Now()
+
+	err := r.db.WithContext(ctx).Preload("Subscription").
+		Joins("JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id").
+		Where("webhook_subscriptions.status = ?", models.WebhookSubscriptionStatusActive).
+		Where("webhook_deliveries.status IN ? AND webhook_deliveries.next_attempt_at <= ? AND webhook_deliveries.attempts < ?",
+			[]string{models.WebhookStatusPending, models.WebhookStatusFailed},
+			now,
+			models.WebhookMaxAttempts,
+		).
+		Limit(limit).
+		Order("webhook_deliveries.next_attempt_at ASC").
+		Find(&deliveries).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (r *webhookSubscriptionRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if err := r.db.WithContext(ctx).Omit("Subscription").Save(delivery).Error; err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// RecordDeliveryAttempt saves the outcome of a delivery attempt and updates the subscription's
+// consecutive failure count in the same transaction. It disables the subscription when the count
+// reaches models.WebhookSubscriptionFailureLimit and reports whether this attempt disabled it.
+func (r *webhookSubscriptionRepository) RecordDeliveryAttempt(ctx context.Context, delivery *models.WebhookDelivery, succeeded bool) (disabled bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Subscription").Save(delivery).Error; err != nil {
+			return fmt.Errorf("failed to update webhook delivery: %w", err)
+		}
+
+		subscriptions := tx.Model(&models.WebhookSubscription{}).Where("id = ?", delivery.SubscriptionID)
+		if succeeded {
+			if err := subscriptions.Update("consecutive_failures", 0).Error; err != nil {
+				return fmt.Errorf("failed to reset webhook subscription failures: %w", err)
+			}
+			return nil
+		}
+
+		if err := subscriptions.Update("consecutive_failures", gorm.Expr("consecutive_failures + 1")).Error; err != nil {
+			return fmt.Errorf("failed to count webhook subscription failure: %w", err)
+		}
+
+		now := time.Now()
+		reason := fmt.Sprintf("disabled after %d consecutive failed deliveries", models.WebhookSubscriptionFailureLimit)
+		result := tx.Model(&models.WebhookSubscription{}).
+			Where("id = ? AND status = ? AND consecutive_failures >= ?",
+				delivery.SubscriptionID, models.WebhookSubscriptionStatusActive, models.WebhookSubscriptionFailureLimit).
+			Updates(map[string]interface{}{
+				"status":          models.WebhookSubscriptionStatusDisabled,
+				"disabled_at":     now,
+				"disabled_reason": reason,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to disable webhook subscription: %w", result.Error)
+		}
+		disabled = result.RowsAffected > 0
+		return nil
+	})
+	return disabled, err
+}
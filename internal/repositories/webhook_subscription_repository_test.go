@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/database"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookSubscriptionRepositoryTestSuite struct {
+	suite.Suite
+	db   *database.DB
+	repo WebhookSubscriptionRepositoryInterface
+	user *models.User
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) SetupTest() {
+	s.db = database.SetupTestDB(s.T())
+	s.repo = NewWebhookSubscriptionRepository(s.db.DB)
+	s.user = database.CreateTestUser(s.T(), s.db, "hooks@example.com")
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) TearDownTest() {
+	database.CleanupTestDB(s.T(), s.db)
+}
+
+func TestWebhookSubscriptionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSubscriptionRepositoryTestSuite))
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) createSubscription() *models.WebhookSubscription {
+	subscription := &models.WebhookSubscription{
+		UserID: s.user.ID,
+		URL:    "https://example.com/hooks",
+		Secret: "whsec_test",
+	}
+	subscription.SetEventTypes([]string{models.EventTypeTransactionPosted})
+	s.Require().NoError(s.repo.Create(context.Background(), subscription))
+	return subscription
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) createDelivery(subscription *models.WebhookSubscription) *models.WebhookDelivery {
+	delivery := &models.WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		EventID:        uuid.New(),
+		EventType:      models.EventTypeTransactionPosted,
+		Payload:        models.JSONBMap{"amount": "10"},
+	}
+	s.Require().NoError(s.repo.CreateDeliveries(context.Background(), []*models.WebhookDelivery{delivery}))
+	return delivery
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) TestCreateAndGet() {
+	subscription := s.createSubscription()
+	s.Equal(models.WebhookSubscriptionStatusActive, subscription.Status)
+
+	found, err := s.repo.GetByID(context.Background(), subscription.ID)
+	s.Require().NoError(err)
+	s.Equal([]string{models.EventTypeTransactionPosted}, found.EventTypeList())
+
+	count, err := s.repo.CountByUserID(context.Background(), s.user.ID)
+	s.NoError(err)
+	s.Equal(int64(1), count)
+
+	_, err = s.repo.GetByID(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrWebhookSubscriptionNotFound)
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) TestCreateDeliveries_SkipsDuplicateEvents() {
+	subscription := s.createSubscription()
+	delivery := s.createDelivery(subscription)
+
+	duplicate := &models.WebhookDelivery{SubscriptionID: subscription.ID, EventID: delivery.EventID, EventType: delivery.EventType}
+	s.NoError(s.repo.CreateDeliveries(context.Background(), []*models.WebhookDelivery{duplicate}))
+
+	deliveries, total, err := s.repo.ListDeliveries(context.Background(), subscription.ID, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(delivery.ID, deliveries[0].ID)
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) TestFindPendingDeliveries_SkipsDisabledSubscriptions() {
+	active := s.createSubscription()
+	activeDelivery := s.createDelivery(active)
+
+	disabled := s.createSubscription()
+	disabled.Status = models.WebhookSubscriptionStatusDisabled
+	s.Require().NoError(s.repo.Update(context.Background(), disabled))
+	s.createDelivery(disabled)
+
+	future := time.Now().Add(time.Hour)
+	scheduled := s.createDelivery(active)
+	scheduled.Status = models.WebhookStatusFailed
+	scheduled.Attempts = 1
+	scheduled.NextAttemptAt = &future
+	s.Require().NoError(s.repo.UpdateDelivery(context.Background(), scheduled))
+
+	deliveries, err := s.repo.FindPendingDeliveries(context.Background(), 10)
+	s.Require().NoError(err)
+	s.Require().Len(deliveries, 1)
+	s.Equal(activeDelivery.ID, deliveries[0].ID)
+	s.Equal(active.URL, deliveries[0].Subscription.URL)
+
+	// Deliveries backing off still count towards the backlog
+	count, err := s.repo.CountPendingDeliveries(context.Background())
+	s.Require().NoError(err)
+	s.Equal(int64(2), count)
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) TestRecordDeliveryAttempt_DisablesAfterFailureLimit() {
+	subscription := s.createSubscription()
+	delivery := s.createDelivery(subscription)
+
+	for i := 1; i < models.WebhookSubscriptionFailureLimit; i++ {
+		disabled, err := s.repo.RecordDeliveryAttempt(context.Background(), delivery, false)
+		s.Require().NoError(err)
+		s.False(disabled)
+	}
+
+	disabled, err := s.repo.RecordDeliveryAttempt(context.Background(), delivery, false)
+	s.Require().NoError(err)
+	s.True(disabled)
+
+	found, err := s.repo.GetByID(context.Background(), subscription.ID)
+	s.Require().NoError(err)
+	s.Equal(models.WebhookSubscriptionStatusDisabled, found.Status)
+	s.NotNil(found.DisabledAt)
+	s.Require().NotNil(found.DisabledReason)
+	s.Contains(*found.DisabledReason, "consecutive failed deliveries")
+
+	disabled, err = s.repo.RecordDeliveryAttempt(context.Background(), delivery, false)
+	s.NoError(err)
+	s.False(disabled, "only the attempt reaching the limit reports disabling")
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) TestRecordDeliveryAttempt_SuccessResetsFailures() {
+	subscription := s.createSubscription()
+	delivery := s.createDelivery(subscription)
+
+	_, err := s.repo.RecordDeliveryAttempt(context.Background(), delivery, false)
+	s.Require().NoError(err)
+	found, _ := s.repo.GetByID(context.Background(), subscription.ID)
+	s.Equal(1, found.ConsecutiveFailures)
+
+	delivery.Status = models.WebhookStatusSent
+	_, err = s.repo.RecordDeliveryAttempt(context.Background(), delivery, true)
+	s.Require().NoError(err)
+
+	found, _ = s.repo.GetByID(context.Background(), subscription.ID)
+	s.Equal(0, found.ConsecutiveFailures)
+	saved, err := s.repo.GetDelivery(context.Background(), delivery.ID)
+	s.Require().NoError(err)
+	s.Equal(models.WebhookStatusSent, saved.Status)
+}
+
+func (s *WebhookSubscriptionRepositoryTestSuite) TestDelete_RemovesDeliveries() {
+	subscription := s.createSubscription()
+	delivery := s.createDelivery(subscription)
+
+	s.Require().NoError(s.repo.Delete(context.Background(), subscription.ID))
+
+	_, err := s.repo.GetDelivery(context.Background(), delivery.ID)
+	s.ErrorIs(err, ErrWebhookDeliveryNotFound)
+	s.ErrorIs(s.repo.Delete(context.Background(), subscription.ID), ErrWebhookSubscriptionNotFound)
+}
@@ -0,0 +1,40 @@
+// Package requestctx carries details of the HTTP caller through a request's context, so services
+// can record who made a change without taking them as parameters.
+package requestctx
+
+import "context"
+
+const (
+	// SystemIPAddress is recorded for work that did not come from an HTTP request.
+	SystemIPAddress = "system"
+	// SystemUserAgent is recorded for work that did not come from an HTTP request.
+	SystemUserAgent = "internal"
+)
+
+type clientKey struct{}
+
+type client struct {
+	ipAddress string
+	userAgent string
+}
+
+// WithClient returns a copy of ctx carrying the caller's IP address and user agent.
+func WithClient(ctx context.Context, ipAddress, userAgent string) context.Context {
+	return context.WithValue(ctx, clientKey{}, client{ipAddress: ipAddress, userAgent: userAgent})
+}
+
+// IPAddress returns the caller's IP address, or SystemIPAddress when ctx did not come from a request.
+func IPAddress(ctx context.Context) string {
+	if c, ok := ctx.Value(clientKey{}).(client); ok && c.ipAddress != "" {
+		return c.ipAddress
+	}
+	return SystemIPAddress
+}
+
+// UserAgent returns the caller's user agent, or SystemUserAgent when ctx did not come from a request.
+func UserAgent(ctx context.Context) string {
+	if c, ok := ctx.Value(clientKey{}).(client); ok && c.userAgent != "" {
+		return c.userAgent
+	}
+	return SystemUserAgent
+}
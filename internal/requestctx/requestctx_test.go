@@ -0,0 +1,31 @@
+package requestctx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RequestContextTestSuite struct {
+	suite.Suite
+}
+
+func TestRequestContextTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestContextTestSuite))
+}
+
+func (s *RequestContextTestSuite) TestWithClient() {
+	ctx := WithClient(context.Background(), "203.0.113.7", "banking-app/2.1")
+
+	s.Equal("203.0.113.7", IPAddress(ctx))
+	s.Equal("banking-app/2.1", UserAgent(ctx))
+}
+
+func (s *RequestContextTestSuite) TestDefaultsToSystem() {
+	s.Equal(SystemIPAddress, IPAddress(context.Background()))
+	s.Equal(SystemUserAgent, UserAgent(context.Background()))
+
+	ctx := WithClient(context.Background(), "203.0.113.7", "")
+	s.Equal(SystemUserAgent, UserAgent(ctx))
+}
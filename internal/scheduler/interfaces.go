@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/google/uuid"
+)
+
+// SchedulerInterface is the admin view of the scheduler used by the job endpoints
+type SchedulerInterface interface {
+	// ListJobs returns every registered job with its pause state, next run and last run
+	ListJobs(ctx context.Context) ([]dto.JobResponse, error)
+	// ListRuns returns a page of the job's runs, newest first, with the total count
+	ListRuns(ctx context.Context, name string, offset, limit int) ([]models.JobRun, int64, error)
+	// TriggerJob starts a run of the job now, even when it is paused
+	TriggerJob(ctx context.Context, adminID uuid.UUID, name string) (*models.JobRun, error)
+	// PauseJob stops the job running on its schedule on every instance
+	PauseJob(ctx context.Context, adminID uuid.UUID, name string) (*dto.JobResponse, error)
+	// ResumeJob returns a paused job to its schedule
+	ResumeJob(ctx context.Context, adminID uuid.UUID, name string) (*dto.JobResponse, error)
+}
+
+var _ SchedulerInterface = (*Scheduler)(nil)
@@ -0,0 +1,234 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// Schedule decides when a job next runs.
+type Schedule interface {
+	// Next returns the first run time strictly after the given time, or the zero time when the
+	// schedule never fires again.
+	Next(after time.Time) time.Time
+	String() string
+}
+
+// Every runs a job at a fixed interval, measured from the end of the previous run so a slow run
+// never overlaps the next one.
+func Every(interval time.Duration) Schedule {
+	return intervalSchedule{interval: interval}
+}
+
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+func (s intervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(s.interval)
+}
+
+func (s intervalSchedule) String() string {
+	return "@every " + s.interval.String()
+}
+
+// ParseSchedule parses a schedule specification:
+//
+//   - "@every 30s" or a bare duration such as "30s" runs at a fixed interval
+//   - "@hourly", "@daily", "@weekly" and "@monthly" are cron shorthands
+//   - anything else is a five-field cron expression (minute hour day-of-month month day-of-week)
+//     evaluated in UTC, supporting *, lists (1,15), ranges (1-5) and steps (*/10, 0-30/5)
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if rest, ok := strings.CutPrefix(spec, "@every "); ok {
+		return parseInterval(strings.TrimSpace(rest))
+	}
+	if _, err := time.ParseDuration(spec); err == nil {
+		return parseInterval(spec)
+	}
+	return ParseCron(spec)
+}
+
+func parseInterval(spec string) (Schedule, error) {
+	interval, err := time.ParseDuration(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is not a duration", ErrInvalidSchedule, spec)
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w: interval must be positive, got %s", ErrInvalidSchedule, interval)
+	}
+	return Every(interval), nil
+}
+
+var cronShorthands = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+}
+
+// cronField describes the values one cron field accepts.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7}, // 0 and 7 are both Sunday
+}
+
+// cronSchedule holds the values each field matches as a bit set.
+type cronSchedule struct {
+	spec                          string
+	minute, hour, dom, month, dow uint64
+	domRestricted, dowRestricted  bool
+}
+
+// ParseCron parses a five-field cron expression or one of the @hourly, @daily, @weekly and
+// @monthly shorthands.
+func ParseCron(spec string) (Schedule, error) {
+	expression := spec
+	if expanded, ok := cronShorthands[spec]; ok {
+		expression = expanded
+	}
+
+	parts := strings.Fields(expression)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("%w: %q must have 5 fields (minute hour day-of-month month day-of-week)", ErrInvalidSchedule, spec)
+	}
+
+	var sets [5]uint64
+	for i, part := range parts {
+		set, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidSchedule, spec, err)
+		}
+		sets[i] = set
+	}
+
+	// Sunday may be written as 0 or 7
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	return &cronSchedule{
+		spec:          spec,
+		minute:        sets[0],
+		hour:          sets[1],
+		dom:           sets[2],
+		month:         sets[3],
+		dow:           sets[4],
+		domRestricted: parts[2] != "*",
+		dowRestricted: parts[4] != "*",
+	}, nil
+}
+
+func parseCronField(spec string, field cronField) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(spec, ",") {
+		rangeSpec, stepSpec, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepSpec)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepSpec, field.name)
+			}
+		}
+
+		low, high := field.min, field.max
+		if rangeSpec != "*" {
+			lowSpec, highSpec, isRange := strings.Cut(rangeSpec, "-")
+			var err error
+			if low, err = parseCronValue(lowSpec, field); err != nil {
+				return 0, err
+			}
+			high = low
+			if isRange {
+				if high, err = parseCronValue(highSpec, field); err != nil {
+					return 0, err
+				}
+				if high < low {
+					return 0, fmt.Errorf("range %q in %s field is backwards", rangeSpec, field.name)
+				}
+			} else if hasStep {
+				// "5/15" means every 15 starting at 5
+				high = field.max
+			}
+		}
+
+		for value := low; value <= high; value += step {
+			set |= 1 << uint(value)
+		}
+	}
+	return set, nil
+}
+
+func parseCronValue(spec string, field cronField) (int, error) {
+	value, err := strconv.Atoi(spec)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", spec, field.name)
+	}
+	if value < field.min || value > field.max {
+		return 0, fmt.Errorf("%s value %d is outside %d-%d", field.name, value, field.min, field.max)
+	}
+	return value, nil
+}
+
+// cronSearchLimit bounds the search for the next match, so an expression that can never fire
+// (such as "0 0 30 2 *") returns the zero time rather than looping forever.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches follows cron's rule that when both day fields are restricted a day matching either
+// one fires.
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+func (s *cronSchedule) String() string {
+	return s.spec
+}
+
+func has(set uint64, value int) bool {
+	return set&(1<<uint(value)) != 0
+}
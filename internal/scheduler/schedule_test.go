@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleTestSuite struct {
+	suite.Suite
+}
+
+func TestScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleTestSuite))
+}
+
+// next parses spec and returns its next run after the given time
+func (s *ScheduleTestSuite) next(spec string, after time.Time) time.Time {
+	schedule, err := ParseSchedule(spec)
+	s.Require().NoError(err)
+	return schedule.Next(after)
+}
+
+func (s *ScheduleTestSuite) TestParseSchedule_Intervals() {
+	after := time.Date(2026, 3, 6, 15, 0, 10, 0, time.UTC)
+
+	s.Equal(after.Add(30*time.Second), s.next("@every 30s", after))
+	s.Equal(after.Add(5*time.Minute), s.next("5m", after))
+
+	schedule, err := ParseSchedule("@every 1m30s")
+	s.Require().NoError(err)
+	s.Equal("@every 1m30s", schedule.String())
+}
+
+func (s *ScheduleTestSuite) TestParseSchedule_CronExpressions() {
+	after := time.Date(2026, 3, 6, 15, 7, 42, 0, time.UTC) // A Friday
+
+	cases := []struct {
+		spec string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 3, 6, 15, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 3, 6, 15, 15, 0, 0, time.UTC)},
+		{"30 3 * * *", time.Date(2026, 3, 7, 3, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2026, 3, 6, 17, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 6 * * 1-5", time.Date(2026, 3, 9, 6, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 1 *", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 3, 6, 16, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		s.Equal(tc.want, s.next(tc.spec, after), tc.spec)
+	}
+}
+
+func (s *ScheduleTestSuite) TestParseSchedule_RestrictedDaysMatchEither() {
+	// The 13th of the month or any Monday, whichever comes first
+	after := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	s.Equal(time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), s.next("0 0 13 * 1", after))
+}
+
+func (s *ScheduleTestSuite) TestParseSchedule_EvaluatedInUTC() {
+	est := time.FixedZone("EST", -5*60*60)
+	after := time.Date(2026, 3, 6, 22, 0, 0, 0, est) // 03:00 UTC on the 7th
+
+	s.Equal(time.Date(2026, 3, 7, 3, 30, 0, 0, time.UTC), s.next("30 3 * * *", after))
+}
+
+func (s *ScheduleTestSuite) TestParseSchedule_NeverFires() {
+	after := time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC)
+	s.True(s.next("0 0 30 2 *", after).IsZero())
+}
+
+func (s *ScheduleTestSuite) TestParseSchedule_Invalid() {
+	for _, spec := range []string{
+		"",
+		"@every soon",
+		"@every -5s",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"0 0 0 * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		_, err := ParseSchedule(spec)
+		s.ErrorIs(err, ErrInvalidSchedule, spec)
+	}
+}
@@ -0,0 +1,586 @@
+// Package scheduler runs the API's periodic background work. Jobs are registered with a schedule
+// and a per-run timeout; every run is recorded in job_runs with its outcome and counts, and admins
+// can list jobs, read their history, run them on demand and pause or resume them.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// DefaultTimeout bounds a run of a job registered without a timeout.
+	DefaultTimeout = 5 * time.Minute
+
+	// finishTimeout bounds recording a run's outcome, which still happens while the scheduler is
+	// stopping and the run's own context has been cancelled.
+	finishTimeout = 5 * time.Second
+)
+
+var (
+	ErrJobNotFound       = errors.New("job not found")
+	ErrJobRunning        = errors.New("job is already running")
+	ErrNotLeader         = errors.New("job runs only on the leader instance")
+	ErrInvalidJob        = errors.New("invalid job")
+	ErrAlreadyRegistered = errors.New("job already registered")
+	ErrAlreadyStarted    = errors.New("scheduler already started")
+	ErrDraining          = errors.New("scheduler is shutting down")
+	// ErrLeadershipLost is the cause of a singleton run's context being cancelled because this
+	// instance stopped leading mid-run.
+	ErrLeadershipLost = errors.New("leadership lost")
+)
+
+var (
+	jobRunsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "job_runs_total",
+			Help: "Total number of background job runs by job and outcome",
+		},
+		[]string{"job", "status"},
+	)
+	jobRunDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "job_run_duration_seconds",
+			Help:    "Background job run duration in seconds by job",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+		},
+		[]string{"job"},
+	)
+)
+
+// Counts reports what a run did, e.g. {"delivered": 12}, and is recorded on the job run.
+type Counts map[string]int64
+
+// Job is a unit of periodic work.
+type Job struct {
+	// Name identifies the job in job_runs, metrics and the admin endpoints, e.g. "outbox-relay".
+	Name        string
+	Description string
+	Schedule    Schedule
+	// Timeout bounds a single run through its context; zero uses DefaultTimeout.
+	Timeout time.Duration
+	// Jitter delays each scheduled run by a random duration up to this long, so replicas and jobs
+	// sharing a schedule do not all start at the same moment.
+	Jitter time.Duration
+	// RunOnStart runs the job as soon as the scheduler starts instead of waiting for the first
+	// scheduled time. A singleton job runs each time this instance takes the lead instead, since the
+	// scheduler usually starts before the first election is won.
+	RunOnStart bool
+	// Singleton jobs run only on the leader instance, for work that must not run on every replica
+	// at once, such as sending webhooks. A run in progress is cancelled if this instance steps down.
+	Singleton bool
+	Run       func(ctx context.Context) (Counts, error)
+}
+
+// Leadership reports whether this instance currently leads the replicas.
+type Leadership interface {
+	IsLeader() bool
+	// LeaderContext returns a context that is cancelled when this instance stops leading, and is
+	// already cancelled when it does not lead.
+	LeaderContext() context.Context
+	// NextTerm returns a channel that is closed when this instance next takes the lead.
+	NextTerm() <-chan struct{}
+}
+
+func (j Job) timeout() time.Duration {
+	if j.Timeout > 0 {
+		return j.Timeout
+	}
+	return DefaultTimeout
+}
+
+// job is a registered job and what this instance knows about it.
+type job struct {
+	Job
+	running   atomic.Bool
+	nextRunAt atomic.Pointer[time.Time]
+}
+
+// Scheduler runs registered jobs on their schedules. A job never overlaps itself on one instance:
+// a scheduled run due while a run is still going (for example one triggered by an admin) is skipped.
+// On shutdown, Drain stops new runs and waits for those in progress before the context passed to
+// Start is cancelled, so runs are only interrupted once the drain deadline has passed.
+type Scheduler struct {
+	repo       repositories.JobRepositoryInterface
+	auditRepo  repositories.AuditLogRepositoryInterface
+	leadership Leadership
+	instance   string
+	logger     *slog.Logger
+	now        func() time.Time
+
+	mu       sync.RWMutex
+	jobs     map[string]*job
+	order    []string
+	baseCtx  context.Context
+	started  bool
+	draining bool
+	wg       sync.WaitGroup // Job loops and triggered runs
+	runs     sync.WaitGroup // Runs in progress
+}
+
+// New creates a scheduler whose runs are recorded as made by instance, e.g. the processing queue
+// worker ID. Singleton jobs run only while leadership reports this instance leads; with nil
+// leadership every instance runs them.
+func New(repo repositories.JobRepositoryInterface, auditRepo repositories.AuditLogRepositoryInterface, leadership Leadership, instance string) *Scheduler {
+	return &Scheduler{
+		repo:       repo,
+		auditRepo:  auditRepo,
+		leadership: leadership,
+		instance:   instance,
+		logger:     slog.Default().With("component", "Scheduler"),
+		now:        time.Now,
+		jobs:       make(map[string]*job),
+		baseCtx:    context.Background(),
+	}
+}
+
+// Register adds a job. Jobs must be registered before Start.
+func (s *Scheduler) Register(j Job) error {
+	if j.Name == "" || j.Schedule == nil || j.Run == nil {
+		return fmt.Errorf("%w: a job needs a name, a schedule and a run function", ErrInvalidJob)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return fmt.Errorf("%w: cannot register %q", ErrAlreadyStarted, j.Name)
+	}
+	if _, exists := s.jobs[j.Name]; exists {
+		return fmt.Errorf("%w: %q", ErrAlreadyRegistered, j.Name)
+	}
+	s.jobs[j.Name] = &job{Job: j}
+	s.order = append(s.order, j.Name)
+	return nil
+}
+
+// Start runs every registered job on its schedule until ctx is cancelled. Runs triggered by an
+// admin also use ctx, so cancelling it interrupts them too; Wait returns once every run has
+// recorded its outcome.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+	s.baseCtx = ctx
+
+	for _, name := range s.order {
+		j := s.jobs[name]
+		s.wg.Add(1)
+		go s.loop(ctx, j)
+	}
+	s.logger.Info("scheduler started", "jobs", len(s.order), "instance", s.instance)
+}
+
+// Wait blocks until the job loops and any runs in progress have stopped after the context passed
+// to Start is cancelled.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// Drain stops scheduled and triggered runs from starting and waits for the runs in progress to
+// finish, or for ctx to expire. The job loops keep going until the context passed to Start is
+// cancelled.
+func (s *Scheduler) Drain(ctx context.Context) error {
+	s.mu.Lock()
+	s.draining = true
+	s.mu.Unlock()
+
+	s.logger.InfoContext(ctx, "scheduler draining, waiting for runs in progress")
+	done := make(chan struct{})
+	go func() {
+		s.runs.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		s.logger.InfoContext(ctx, "scheduler drained")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("job runs still in progress: %w", ctx.Err())
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	defer s.wg.Done()
+
+	if j.RunOnStart {
+		if j.Singleton && s.leadership != nil {
+			s.wg.Add(1)
+			go s.runEachTerm(ctx, j)
+		} else {
+			s.runScheduled(ctx, j)
+		}
+	}
+
+	for {
+		next := j.Schedule.Next(s.now())
+		if next.IsZero() {
+			s.logger.Warn("job schedule never fires again", "job", j.Name, "schedule", j.Schedule.String())
+			j.nextRunAt.Store(nil)
+			return
+		}
+		if j.Jitter > 0 {
+			next = next.Add(rand.N(j.Jitter))
+		}
+		j.nextRunAt.Store(&next)
+
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runScheduled(ctx, j)
+	}
+}
+
+// runEachTerm runs a RunOnStart singleton job once at the start of every term of leadership, so
+// the run happens once this instance wins its first election, and again whenever it takes over
+// from another leader.
+func (s *Scheduler) runEachTerm(ctx context.Context, j *job) {
+	defer s.wg.Done()
+
+	var ran context.Context
+	for {
+		// Taken before the term so that a term starting in between is not missed
+		next := s.leadership.NextTerm()
+		if term := s.leadership.LeaderContext(); term.Err() == nil && term != ran {
+			ran = term
+			s.runScheduled(ctx, j)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-next:
+		}
+	}
+}
+
+// runScheduled runs the job unless it is paused, already running, or a singleton on a follower.
+// When the pause state cannot be read the run is skipped, so a paused job is never run by mistake.
+func (s *Scheduler) runScheduled(ctx context.Context, j *job) {
+	if !s.mayRun(j) {
+		s.logger.DebugContext(ctx, "not the leader, skipping singleton job", "job", j.Name)
+		return
+	}
+
+	states, err := s.repo.GetStates(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to read job state, skipping run", "job", j.Name, "error", err)
+		return
+	}
+	if state := states[j.Name]; state != nil && state.Paused {
+		s.logger.DebugContext(ctx, "job paused, skipping run", "job", j.Name)
+		return
+	}
+
+	run, err := s.start(ctx, j, models.JobRunTriggerSchedule, nil)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrJobRunning):
+			s.logger.DebugContext(ctx, "job still running, skipping run", "job", j.Name)
+		case errors.Is(err, ErrDraining):
+			s.logger.DebugContext(ctx, "scheduler draining, skipping run", "job", j.Name)
+		default:
+			s.logger.ErrorContext(ctx, "failed to start job run", "job", j.Name, "error", err)
+		}
+		return
+	}
+	s.execute(ctx, j, run)
+}
+
+// start claims the job on this instance and records the start of a run. A started run must be
+// passed to execute, which releases the claim.
+func (s *Scheduler) start(ctx context.Context, j *job, trigger string, triggeredBy *uuid.UUID) (*models.JobRun, error) {
+	s.mu.Lock()
+	if s.draining {
+		s.mu.Unlock()
+		return nil, ErrDraining
+	}
+	s.runs.Add(1)
+	s.mu.Unlock()
+
+	if !j.running.CompareAndSwap(false, true) {
+		s.runs.Done()
+		return nil, ErrJobRunning
+	}
+
+	run := &models.JobRun{
+		JobName:     j.Name,
+		Trigger:     trigger,
+		TriggeredBy: triggeredBy,
+		Instance:    s.instance,
+		Status:      models.JobRunStatusRunning,
+		StartedAt:   s.now(),
+	}
+	if err := s.repo.CreateRun(ctx, run); err != nil {
+		j.running.Store(false)
+		s.runs.Done()
+		return nil, err
+	}
+	return run, nil
+}
+
+// execute runs a started job under its timeout and records the outcome. A singleton run is also
+// cancelled as soon as this instance stops leading, so it cannot go on alongside a run on the new
+// leader.
+func (s *Scheduler) execute(ctx context.Context, j *job, run *models.JobRun) {
+	defer s.runs.Done()
+	defer j.running.Store(false)
+
+	runCtx, cancel := context.WithTimeout(ctx, j.timeout())
+	defer cancel()
+	if j.Singleton && s.leadership != nil {
+		var cancelLeading context.CancelCauseFunc
+		runCtx, cancelLeading = context.WithCancelCause(runCtx)
+		stop := context.AfterFunc(s.leadership.LeaderContext(), func() { cancelLeading(ErrLeadershipLost) })
+		defer stop()
+		defer cancelLeading(nil)
+	}
+	runCtx, span := telemetry.StartSpan(runCtx, "Job "+j.Name,
+		attribute.String("job.name", j.Name),
+		attribute.String("job.trigger", run.Trigger),
+		attribute.String("job.run_id", run.ID.String()),
+	)
+
+	counts, err := s.call(runCtx, j)
+
+	finishedAt := s.now()
+	duration := finishedAt.Sub(run.StartedAt)
+	durationMs := duration.Milliseconds()
+	run.FinishedAt = &finishedAt
+	run.DurationMs = &durationMs
+	run.Status = models.JobRunStatusSucceeded
+
+	switch {
+	case errors.Is(runCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil:
+		run.Status = models.JobRunStatusTimedOut
+		if err == nil {
+			err = fmt.Errorf("timed out after %s", j.timeout())
+		}
+	case errors.Is(context.Cause(runCtx), ErrLeadershipLost):
+		// Another instance may already be running the job, so the work was cut short
+		run.Status = models.JobRunStatusFailed
+		err = fmt.Errorf("interrupted: %w", ErrLeadershipLost)
+	case err != nil:
+		run.Status = models.JobRunStatusFailed
+	case ctx.Err() != nil:
+		// The scheduler stopped mid-run, so the work may have been cut short
+		run.Status = models.JobRunStatusFailed
+		err = fmt.Errorf("interrupted: %w", ctx.Err())
+	}
+	if err != nil {
+		run.Error = err.Error()
+	}
+	if len(counts) > 0 {
+		run.Counts = make(models.JSONBMap, len(counts))
+		for key, value := range counts {
+			run.Counts[key] = value
+		}
+	}
+
+	telemetry.EndSpan(span, err)
+	jobRunsTotal.WithLabelValues(j.Name, run.Status).Inc()
+	jobRunDuration.WithLabelValues(j.Name).Observe(duration.Seconds())
+
+	if err != nil {
+		s.logger.ErrorContext(runCtx, "job run failed", "job", j.Name, "run_id", run.ID, "status", run.Status, "duration_ms", durationMs, "error", err)
+	} else {
+		s.logger.DebugContext(runCtx, "job run finished", "job", j.Name, "run_id", run.ID, "duration_ms", durationMs, "counts", counts)
+	}
+
+	finishCtx, cancelFinish := context.WithTimeout(context.WithoutCancel(ctx), finishTimeout)
+	defer cancelFinish()
+	if err := s.repo.FinishRun(finishCtx, run); err != nil {
+		s.logger.ErrorContext(runCtx, "failed to record job run outcome", "job", j.Name, "run_id", run.ID, "error", err)
+	}
+}
+
+// call runs the job, turning a panic into a failed run rather than a crashed process.
+func (s *Scheduler) call(ctx context.Context, j *job) (counts Counts, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.ErrorContext(ctx, "job panicked", "job", j.Name, "panic", r, "stack", string(debug.Stack()))
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return j.Run(ctx)
+}
+
+// mayRun reports whether this instance may run the job.
+func (s *Scheduler) mayRun(j *job) bool {
+	return !j.Singleton || s.leadership == nil || s.leadership.IsLeader()
+}
+
+func (s *Scheduler) job(name string) (*job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	j, ok := s.jobs[name]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	return j, nil
+}
+
+// ListJobs returns every registered job in registration order with its pause state, next run on
+// this instance and most recent run on any instance.
+func (s *Scheduler) ListJobs(ctx context.Context) ([]dto.JobResponse, error) {
+	states, err := s.repo.GetStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.repo.LatestRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	jobs := make([]dto.JobResponse, 0, len(s.order))
+	for _, name := range s.order {
+		jobs = append(jobs, s.describe(s.jobs[name], states[name], latest[name]))
+	}
+	return jobs, nil
+}
+
+// ListRuns returns a page of the job's runs, newest first, with the total count.
+func (s *Scheduler) ListRuns(ctx context.Context, name string, offset, limit int) ([]models.JobRun, int64, error) {
+	if _, err := s.job(name); err != nil {
+		return nil, 0, err
+	}
+	return s.repo.ListRuns(ctx, name, offset, limit)
+}
+
+// TriggerJob starts a run of the job now, even when it is paused, and returns the run as
+// recorded at its start. The run continues in the background after the request returns. A
+// singleton job can only be triggered on the leader, and no job once the scheduler is draining.
+func (s *Scheduler) TriggerJob(ctx context.Context, adminID uuid.UUID, name string) (*models.JobRun, error) {
+	j, err := s.job(name)
+	if err != nil {
+		return nil, err
+	}
+	if !s.mayRun(j) {
+		return nil, ErrNotLeader
+	}
+
+	run, err := s.start(ctx, j, models.JobRunTriggerManual, &adminID)
+	if err != nil {
+		return nil, err
+	}
+	started := *run
+
+	s.mu.RLock()
+	runCtx := s.baseCtx
+	s.mu.RUnlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.execute(runCtx, j, run)
+	}()
+
+	s.logger.InfoContext(ctx, "job triggered", "job", name, "run_id", started.ID, "admin_id", adminID)
+	s.audit(ctx, adminID, "job.triggered", name, models.JSONBMap{"run_id": started.ID.String()})
+
+	return &started, nil
+}
+
+// PauseJob stops the job running on its schedule on every instance. A run in progress finishes.
+func (s *Scheduler) PauseJob(ctx context.Context, adminID uuid.UUID, name string) (*dto.JobResponse, error) {
+	return s.setPaused(ctx, adminID, name, true)
+}
+
+// ResumeJob returns a paused job to its schedule.
+func (s *Scheduler) ResumeJob(ctx context.Context, adminID uuid.UUID, name string) (*dto.JobResponse, error) {
+	return s.setPaused(ctx, adminID, name, false)
+}
+
+func (s *Scheduler) setPaused(ctx context.Context, adminID uuid.UUID, name string, paused bool) (*dto.JobResponse, error) {
+	j, err := s.job(name)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.repo.SetPaused(ctx, name, paused, adminID)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.repo.LatestRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	action := "job.resumed"
+	if paused {
+		action = "job.paused"
+	}
+	s.logger.WarnContext(ctx, "scheduled job pause state changed", "job", name, "paused", paused, "admin_id", adminID)
+	s.audit(ctx, adminID, action, name, models.JSONBMap{})
+
+	response := s.describe(j, state, latest[name])
+	return &response, nil
+}
+
+func (s *Scheduler) describe(j *job, state *models.ScheduledJob, lastRun *models.JobRun) dto.JobResponse {
+	response := dto.JobResponse{
+		Name:        j.Name,
+		Description: j.Description,
+		Schedule:    j.Schedule.String(),
+		Timeout:     j.timeout().String(),
+		Singleton:   j.Singleton,
+		Running:     j.running.Load(),
+		LastRun:     lastRun,
+	}
+	if s.mayRun(j) {
+		response.NextRunAt = j.nextRunAt.Load()
+	}
+	if j.Jitter > 0 {
+		response.Jitter = j.Jitter.String()
+	}
+	if state != nil {
+		response.Paused = state.Paused
+		response.PausedBy = state.PausedBy
+		response.PausedAt = state.PausedAt
+	}
+	return response
+}
+
+func (s *Scheduler) audit(ctx context.Context, adminID uuid.UUID, action, jobName string, metadata models.JSONBMap) {
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     action,
+		Resource:   "scheduled_job",
+		ResourceID: jobName,
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
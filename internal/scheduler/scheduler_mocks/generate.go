@@ -0,0 +1,7 @@
+package scheduler_mocks
+
+//go:generate mockgen -source=../interfaces.go -destination=scheduler_mocks.go -package=scheduler_mocks
+
+// This file contains the go:generate directive to generate mocks for the scheduler interface.
+// To regenerate the mocks, run:
+//   go generate ./internal/scheduler/scheduler_mocks
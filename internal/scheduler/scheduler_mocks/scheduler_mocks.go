@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../interfaces.go
+
+// Package scheduler_mocks is a generated GoMock package.
+package scheduler_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/array/banking-api/internal/dto"
+	models "github.com/array/banking-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockSchedulerInterface is a mock of SchedulerInterface interface.
+type MockSchedulerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerInterfaceMockRecorder
+}
+
+// MockSchedulerInterfaceMockRecorder is the mock recorder for MockSchedulerInterface.
+type MockSchedulerInterfaceMockRecorder struct {
+	mock *MockSchedulerInterface
+}
+
+// NewMockSchedulerInterface creates a new mock instance.
+func NewMockSchedulerInterface(ctrl *gomock.Controller) *MockSchedulerInterface {
+	mock := &MockSchedulerInterface{ctrl: ctrl}
+	mock.recorder = &MockSchedulerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedulerInterface) EXPECT() *MockSchedulerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ListJobs mocks base method.
+func (m *MockSchedulerInterface) ListJobs(ctx context.Context) ([]dto.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", ctx)
+	ret0, _ := ret[0].([]dto.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockSchedulerInterfaceMockRecorder) ListJobs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockSchedulerInterface)(nil).ListJobs), ctx)
+}
+
+// ListRuns mocks base method.
+func (m *MockSchedulerInterface) ListRuns(ctx context.Context, name string, offset, limit int) ([]models.JobRun, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", ctx, name, offset, limit)
+	ret0, _ := ret[0].([]models.JobRun)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockSchedulerInterfaceMockRecorder) ListRuns(ctx, name, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockSchedulerInterface)(nil).ListRuns), ctx, name, offset, limit)
+}
+
+// PauseJob mocks base method.
+func (m *MockSchedulerInterface) PauseJob(ctx context.Context, adminID uuid.UUID, name string) (*dto.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseJob", ctx, adminID, name)
+	ret0, _ := ret[0].(*dto.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseJob indicates an expected call of PauseJob.
+func (mr *MockSchedulerInterfaceMockRecorder) PauseJob(ctx, adminID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseJob", reflect.TypeOf((*MockSchedulerInterface)(nil).PauseJob), ctx, adminID, name)
+}
+
+// ResumeJob mocks base method.
+func (m *MockSchedulerInterface) ResumeJob(ctx context.Context, adminID uuid.UUID, name string) (*dto.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeJob", ctx, adminID, name)
+	ret0, _ := ret[0].(*dto.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeJob indicates an expected call of ResumeJob.
+func (mr *MockSchedulerInterfaceMockRecorder) ResumeJob(ctx, adminID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeJob", reflect.TypeOf((*MockSchedulerInterface)(nil).ResumeJob), ctx, adminID, name)
+}
+
+// TriggerJob mocks base method.
+func (m *MockSchedulerInterface) TriggerJob(ctx context.Context, adminID uuid.UUID, name string) (*models.JobRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerJob", ctx, adminID, name)
+	ret0, _ := ret[0].(*models.JobRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerJob indicates an expected call of TriggerJob.
+func (mr *MockSchedulerInterfaceMockRecorder) TriggerJob(ctx, adminID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerJob", reflect.TypeOf((*MockSchedulerInterface)(nil).TriggerJob), ctx, adminID, name)
+}
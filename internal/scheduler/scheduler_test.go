@@ -0,0 +1,495 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeLeadership reports whatever leadership the test sets
+type fakeLeadership struct {
+	mu       sync.Mutex
+	leader   bool
+	term     context.Context
+	endTerm  context.CancelFunc
+	nextTerm chan struct{}
+}
+
+func newFakeLeadership() *fakeLeadership {
+	l := &fakeLeadership{nextTerm: make(chan struct{})}
+	l.set(false)
+	return l
+}
+
+// set starts a new term when leadership is gained and ends the current one when it is lost
+func (l *fakeLeadership) set(leader bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if leader == l.leader && l.term != nil {
+		return
+	}
+	if l.endTerm != nil {
+		l.endTerm()
+	}
+	l.leader = leader
+	l.term, l.endTerm = context.WithCancel(context.Background())
+	if !leader {
+		l.endTerm()
+		return
+	}
+	close(l.nextTerm)
+	l.nextTerm = make(chan struct{})
+}
+
+func (l *fakeLeadership) IsLeader() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.leader
+}
+
+func (l *fakeLeadership) LeaderContext() context.Context {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.term
+}
+
+func (l *fakeLeadership) NextTerm() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.nextTerm
+}
+
+type SchedulerTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	repo       *repository_mocks.MockJobRepositoryInterface
+	auditRepo  *repository_mocks.MockAuditLogRepositoryInterface
+	leadership *fakeLeadership
+	scheduler  *Scheduler
+	adminID    uuid.UUID
+}
+
+func (s *SchedulerTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.repo = repository_mocks.NewMockJobRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.leadership = newFakeLeadership()
+	s.leadership.set(true)
+	s.scheduler = New(s.repo, s.auditRepo, s.leadership, "api-1")
+	s.adminID = uuid.New()
+}
+
+func (s *SchedulerTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerTestSuite))
+}
+
+// expectRun expects one run to be recorded and returns a channel receiving it once finished
+func (s *SchedulerTestSuite) expectRun() <-chan *models.JobRun {
+	finished := make(chan *models.JobRun, 1)
+	s.repo.EXPECT().CreateRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run *models.JobRun) error {
+		s.Equal(models.JobRunStatusRunning, run.Status)
+		s.Equal("api-1", run.Instance)
+		run.ID = uuid.New()
+		return nil
+	})
+	s.repo.EXPECT().FinishRun(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, run *models.JobRun) error {
+		finished <- run
+		return nil
+	})
+	return finished
+}
+
+// runOnce starts the scheduler with a job that runs at start and then not for an hour, and
+// returns the recorded run
+func (s *SchedulerTestSuite) runOnce(job Job) *models.JobRun {
+	job.Schedule = Every(time.Hour)
+	job.RunOnStart = true
+	s.Require().NoError(s.scheduler.Register(job))
+
+	s.repo.EXPECT().GetStates(gomock.Any()).Return(map[string]*models.ScheduledJob{}, nil)
+	finished := s.expectRun()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.scheduler.Start(ctx)
+	defer func() {
+		cancel()
+		s.scheduler.Wait()
+	}()
+
+	select {
+	case run := <-finished:
+		return run
+	case <-time.After(5 * time.Second):
+		s.FailNow("job run was not recorded")
+		return nil
+	}
+}
+
+func (s *SchedulerTestSuite) TestRegister_RejectsInvalidAndDuplicateJobs() {
+	run := func(context.Context) (Counts, error) { return nil, nil }
+
+	s.ErrorIs(s.scheduler.Register(Job{Name: "outbox-relay", Run: run}), ErrInvalidJob)
+	s.Require().NoError(s.scheduler.Register(Job{Name: "outbox-relay", Schedule: Every(time.Second), Run: run}))
+	s.ErrorIs(s.scheduler.Register(Job{Name: "outbox-relay", Schedule: Every(time.Minute), Run: run}), ErrAlreadyRegistered)
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_RecordsSuccessWithCounts() {
+	run := s.runOnce(Job{
+		Name: "outbox-relay",
+		Run: func(context.Context) (Counts, error) {
+			return Counts{"delivered": 12}, nil
+		},
+	})
+
+	s.Equal(models.JobRunTriggerSchedule, run.Trigger)
+	s.Equal(models.JobRunStatusSucceeded, run.Status)
+	s.Equal(int64(12), run.Counts["delivered"])
+	s.NotNil(run.FinishedAt)
+	s.NotNil(run.DurationMs)
+	s.Empty(run.Error)
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_RecordsFailure() {
+	run := s.runOnce(Job{
+		Name: "compliance-reports",
+		Run: func(context.Context) (Counts, error) {
+			return nil, errors.New("regulator unavailable")
+		},
+	})
+
+	s.Equal(models.JobRunStatusFailed, run.Status)
+	s.Equal("regulator unavailable", run.Error)
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_RecordsPanicAsFailure() {
+	run := s.runOnce(Job{
+		Name: "transfer-monitor",
+		Run: func(context.Context) (Counts, error) {
+			panic("nil account")
+		},
+	})
+
+	s.Equal(models.JobRunStatusFailed, run.Status)
+	s.Contains(run.Error, "nil account")
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_TimesOut() {
+	run := s.runOnce(Job{
+		Name:    "sanctions-list-refresh",
+		Timeout: 20 * time.Millisecond,
+		Run: func(ctx context.Context) (Counts, error) {
+			<-ctx.Done()
+			return nil, nil
+		},
+	})
+
+	s.Equal(models.JobRunStatusTimedOut, run.Status)
+	s.Contains(run.Error, "timed out after 20ms")
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_SkippedWhilePaused() {
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:       "outbox-relay",
+		Schedule:   Every(time.Hour),
+		RunOnStart: true,
+		Run: func(context.Context) (Counts, error) {
+			s.Fail("paused job ran")
+			return nil, nil
+		},
+	}))
+
+	checked := make(chan struct{})
+	s.repo.EXPECT().GetStates(gomock.Any()).DoAndReturn(func(context.Context) (map[string]*models.ScheduledJob, error) {
+		defer close(checked)
+		return map[string]*models.ScheduledJob{"outbox-relay": {Name: "outbox-relay", Paused: true}}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.scheduler.Start(ctx)
+	<-checked
+	cancel()
+	s.scheduler.Wait()
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_SingletonRunsOnLeader() {
+	run := s.runOnce(Job{
+		Name:      "regulator-webhooks",
+		Singleton: true,
+		Run: func(context.Context) (Counts, error) {
+			return nil, nil
+		},
+	})
+
+	s.Equal(models.JobRunStatusSucceeded, run.Status)
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_SingletonSkippedOnFollower() {
+	s.leadership.set(false)
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:      "regulator-webhooks",
+		Schedule:  Every(15 * time.Second),
+		Singleton: true,
+		Run: func(context.Context) (Counts, error) {
+			s.Fail("singleton job ran on a follower")
+			return nil, nil
+		},
+	}))
+
+	// No pause check or run record either: the mocks fail on any call
+	s.scheduler.runScheduled(context.Background(), s.scheduler.jobs["regulator-webhooks"])
+}
+
+func (s *SchedulerTestSuite) TestScheduledRun_SingletonRunsOnStartEachTerm() {
+	s.leadership.set(false)
+	ran := make(chan struct{}, 2)
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:       "transfer-saga-recovery",
+		Schedule:   Every(time.Hour),
+		RunOnStart: true,
+		Singleton:  true,
+		Run: func(context.Context) (Counts, error) {
+			ran <- struct{}{}
+			return nil, nil
+		},
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.scheduler.Start(ctx)
+	defer func() {
+		cancel()
+		s.scheduler.Wait()
+	}()
+
+	// Still following: the start-up run waits for the election instead of being skipped
+	select {
+	case <-ran:
+		s.FailNow("singleton job ran on a follower")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	for term := 1; term <= 2; term++ {
+		s.repo.EXPECT().GetStates(gomock.Any()).Return(map[string]*models.ScheduledJob{}, nil)
+		finished := s.expectRun()
+		s.leadership.set(true)
+
+		select {
+		case run := <-finished:
+			s.Equal(models.JobRunStatusSucceeded, run.Status)
+		case <-time.After(5 * time.Second):
+			s.FailNow("start-up run did not happen when leadership was taken", "term %d", term)
+		}
+		s.leadership.set(false)
+	}
+	s.Len(ran, 2)
+}
+
+func (s *SchedulerTestSuite) TestTriggerJob_SingletonCancelledWhenLeadershipLost() {
+	started := make(chan struct{})
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:      "regulator-webhooks",
+		Schedule:  Every(15 * time.Second),
+		Singleton: true,
+		Run: func(ctx context.Context) (Counts, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}))
+
+	finished := s.expectRun()
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.scheduler.TriggerJob(context.Background(), s.adminID, "regulator-webhooks")
+	s.Require().NoError(err)
+	<-started
+
+	// Another instance takes over while the run is going
+	s.leadership.set(false)
+
+	select {
+	case run := <-finished:
+		s.Equal(models.JobRunStatusFailed, run.Status)
+		s.Contains(run.Error, "leadership lost")
+	case <-time.After(5 * time.Second):
+		s.FailNow("run went on after leadership was lost")
+	}
+	s.scheduler.Wait()
+}
+
+func (s *SchedulerTestSuite) TestTriggerJob_SingletonRefusedOnFollower() {
+	s.leadership.set(false)
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:      "regulator-webhooks",
+		Schedule:  Every(15 * time.Second),
+		Singleton: true,
+		Run:       func(context.Context) (Counts, error) { return nil, nil },
+	}))
+
+	_, err := s.scheduler.TriggerJob(context.Background(), s.adminID, "regulator-webhooks")
+	s.ErrorIs(err, ErrNotLeader)
+}
+
+func (s *SchedulerTestSuite) TestTriggerJob_RunsNowAndAudits() {
+	release := make(chan struct{})
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:     "transfer-monitor",
+		Schedule: Every(time.Hour),
+		Run: func(context.Context) (Counts, error) {
+			<-release
+			return Counts{"checked": 3}, nil
+		},
+	}))
+
+	finished := s.expectRun()
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("job.triggered", log.Action)
+		s.Equal("transfer-monitor", log.ResourceID)
+		s.Equal(&s.adminID, log.UserID)
+		return nil
+	})
+
+	run, err := s.scheduler.TriggerJob(context.Background(), s.adminID, "transfer-monitor")
+	s.Require().NoError(err)
+	s.Equal(models.JobRunTriggerManual, run.Trigger)
+	s.Equal(&s.adminID, run.TriggeredBy)
+	s.Equal(models.JobRunStatusRunning, run.Status)
+
+	// A second trigger while the first run is going is refused
+	_, err = s.scheduler.TriggerJob(context.Background(), s.adminID, "transfer-monitor")
+	s.ErrorIs(err, ErrJobRunning)
+
+	close(release)
+	finishedRun := <-finished
+	s.Equal(run.ID, finishedRun.ID)
+	s.Equal(models.JobRunStatusSucceeded, finishedRun.Status)
+	s.scheduler.Wait()
+}
+
+func (s *SchedulerTestSuite) TestDrain_WaitsForRunInProgressAndRefusesNewRuns() {
+	release := make(chan struct{})
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:     "regulator-webhooks",
+		Schedule: Every(time.Hour),
+		Run: func(ctx context.Context) (Counts, error) {
+			<-release
+			return nil, ctx.Err()
+		},
+	}))
+
+	finished := s.expectRun()
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	_, err := s.scheduler.TriggerJob(context.Background(), s.adminID, "regulator-webhooks")
+	s.Require().NoError(err)
+
+	drained := make(chan error, 1)
+	go func() { drained <- s.scheduler.Drain(context.Background()) }()
+
+	// Draining refuses new runs while the one in progress carries on
+	s.Eventually(func() bool {
+		_, err := s.scheduler.TriggerJob(context.Background(), s.adminID, "regulator-webhooks")
+		return errors.Is(err, ErrDraining)
+	}, time.Second, time.Millisecond)
+	select {
+	case <-drained:
+		s.FailNow("drain returned before the run finished")
+	default:
+	}
+
+	close(release)
+	s.NoError(<-drained)
+	s.Equal(models.JobRunStatusSucceeded, (<-finished).Status)
+	s.scheduler.Wait()
+}
+
+func (s *SchedulerTestSuite) TestDrain_GivesUpAtDeadline() {
+	release := make(chan struct{})
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:     "sanctions-list-refresh",
+		Schedule: Every(time.Hour),
+		Run: func(context.Context) (Counts, error) {
+			<-release
+			return nil, nil
+		},
+	}))
+
+	finished := s.expectRun()
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	_, err := s.scheduler.TriggerJob(context.Background(), s.adminID, "sanctions-list-refresh")
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	s.ErrorIs(s.scheduler.Drain(ctx), context.DeadlineExceeded)
+
+	close(release)
+	<-finished
+	s.scheduler.Wait()
+}
+
+func (s *SchedulerTestSuite) TestTriggerJob_UnknownJob() {
+	_, err := s.scheduler.TriggerJob(context.Background(), s.adminID, "missing")
+	s.ErrorIs(err, ErrJobNotFound)
+}
+
+func (s *SchedulerTestSuite) TestPauseJob_SavesStateAndAudits() {
+	s.Require().NoError(s.scheduler.Register(Job{
+		Name:     "outbox-relay",
+		Schedule: Every(5 * time.Second),
+		Run:      func(context.Context) (Counts, error) { return nil, nil },
+	}))
+
+	pausedAt := time.Now()
+	s.repo.EXPECT().SetPaused(gomock.Any(), "outbox-relay", true, s.adminID).Return(&models.ScheduledJob{
+		Name: "outbox-relay", Paused: true, PausedBy: &s.adminID, PausedAt: &pausedAt,
+	}, nil)
+	s.repo.EXPECT().LatestRuns(gomock.Any()).Return(map[string]*models.JobRun{}, nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("job.paused", log.Action)
+		s.Equal("scheduled_job", log.Resource)
+		return nil
+	})
+
+	job, err := s.scheduler.PauseJob(context.Background(), s.adminID, "outbox-relay")
+	s.Require().NoError(err)
+	s.True(job.Paused)
+	s.Equal(&s.adminID, job.PausedBy)
+	s.Equal("@every 5s", job.Schedule)
+}
+
+func (s *SchedulerTestSuite) TestListJobs_InRegistrationOrderWithStateAndLastRun() {
+	noop := func(context.Context) (Counts, error) { return nil, nil }
+	s.Require().NoError(s.scheduler.Register(Job{Name: "transfer-monitor", Schedule: Every(30 * time.Second), Run: noop}))
+	s.Require().NoError(s.scheduler.Register(Job{Name: "outbox-relay", Schedule: Every(5 * time.Second), Jitter: time.Second, Run: noop}))
+
+	lastRun := &models.JobRun{ID: uuid.New(), JobName: "outbox-relay", Status: models.JobRunStatusSucceeded}
+	s.repo.EXPECT().GetStates(gomock.Any()).Return(map[string]*models.ScheduledJob{
+		"transfer-monitor": {Name: "transfer-monitor", Paused: true},
+	}, nil)
+	s.repo.EXPECT().LatestRuns(gomock.Any()).Return(map[string]*models.JobRun{"outbox-relay": lastRun}, nil)
+
+	jobs, err := s.scheduler.ListJobs(context.Background())
+	s.Require().NoError(err)
+	s.Require().Len(jobs, 2)
+
+	s.Equal("transfer-monitor", jobs[0].Name)
+	s.True(jobs[0].Paused)
+	s.Nil(jobs[0].LastRun)
+	s.Equal(DefaultTimeout.String(), jobs[0].Timeout)
+
+	s.Equal("outbox-relay", jobs[1].Name)
+	s.False(jobs[1].Paused)
+	s.Equal(lastRun, jobs[1].LastRun)
+	s.Equal("1s", jobs[1].Jitter)
+}
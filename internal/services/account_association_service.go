@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -37,12 +38,12 @@ var (
 )
 
 // GetCustomerAccounts retrieves all accounts for a customer (excludes closed and deleted accounts)
-func (s *AccountAssociationService) GetCustomerAccounts(customerID uuid.UUID) ([]*models.Account, error) {
+func (s *AccountAssociationService) GetCustomerAccounts(ctx context.Context, customerID uuid.UUID) ([]*models.Account, error) {
 	if customerID == uuid.Nil {
 		return nil, ErrInvalidCustomerID
 	}
 
-	_, err := s.userRepo.GetByIDActive(customerID)
+	_, err := s.userRepo.GetByIDActive(ctx, customerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return nil, ErrCustomerNotFound
@@ -50,7 +51,7 @@ func (s *AccountAssociationService) GetCustomerAccounts(customerID uuid.UUID) ([
 		return nil, fmt.Errorf("failed to verify customer: %w", err)
 	}
 
-	accounts, err := s.accountRepo.GetByUserIDExcludingStatus(customerID, models.AccountStatusClosed)
+	accounts, err := s.accountRepo.GetByUserIDExcludingStatus(ctx, customerID, models.AccountStatusClosed)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get customer accounts: %w", err)
 	}
@@ -59,7 +60,7 @@ func (s *AccountAssociationService) GetCustomerAccounts(customerID uuid.UUID) ([
 }
 
 // CreateAccountForCustomer creates a new account for a customer (admin operation)
-func (s *AccountAssociationService) CreateAccountForCustomer(customerID, performedBy uuid.UUID, accountType, ipAddress, userAgent string) (*models.Account, error) {
+func (s *AccountAssociationService) CreateAccountForCustomer(ctx context.Context, customerID, performedBy uuid.UUID, accountType, ipAddress, userAgent string) (*models.Account, error) {
 	if customerID == uuid.Nil {
 		return nil, ErrInvalidCustomerID
 	}
@@ -72,7 +73,7 @@ func (s *AccountAssociationService) CreateAccountForCustomer(customerID, perform
 		return nil, models.ErrInvalidAccountType
 	}
 
-	_, err := s.userRepo.GetByIDActive(customerID)
+	_, err := s.userRepo.GetByIDActive(ctx, customerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return nil, ErrCustomerNotFound
@@ -80,7 +81,7 @@ func (s *AccountAssociationService) CreateAccountForCustomer(customerID, perform
 		return nil, fmt.Errorf("failed to verify customer: %w", err)
 	}
 
-	accountNumber, err := s.accountRepo.GenerateUniqueAccountNumber(accountType)
+	accountNumber, err := s.accountRepo.GenerateUniqueAccountNumber(ctx, accountType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate unique account number: %w", err)
 	}
@@ -94,12 +95,12 @@ func (s *AccountAssociationService) CreateAccountForCustomer(customerID, perform
 		Currency:      "USD",
 	}
 
-	if err := s.accountRepo.Create(account); err != nil {
+	if err := s.accountRepo.Create(ctx, account); err != nil {
 		return nil, fmt.Errorf("failed to create account: %w", err)
 	}
 
 	if s.auditService != nil {
-		if err := s.auditService.LogAccountCreated(customerID, performedBy, account.ID, accountType, ipAddress, userAgent); err != nil {
+		if err := s.auditService.LogAccountCreated(ctx, customerID, performedBy, account.ID, accountType, ipAddress, userAgent); err != nil {
 			// Log error but don't fail the operation
 			s.logger.Error("failed to log account creation",
 				"error", err,
@@ -113,7 +114,7 @@ func (s *AccountAssociationService) CreateAccountForCustomer(customerID, perform
 }
 
 // TransferAccountOwnership transfers an account from one customer to another (atomic transaction)
-func (s *AccountAssociationService) TransferAccountOwnership(accountID, fromCustomerID, toCustomerID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+func (s *AccountAssociationService) TransferAccountOwnership(ctx context.Context, accountID, fromCustomerID, toCustomerID, performedBy uuid.UUID, ipAddress, userAgent string) error {
 	if accountID == uuid.Nil {
 		return ErrInvalidAccountID
 	}
@@ -130,7 +131,7 @@ func (s *AccountAssociationService) TransferAccountOwnership(accountID, fromCust
 		return ErrSameCustomer
 	}
 
-	account, err := s.accountRepo.GetByID(accountID)
+	account, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrAccountNotFound) {
 			return ErrAccountNotFound
@@ -142,7 +143,7 @@ func (s *AccountAssociationService) TransferAccountOwnership(accountID, fromCust
 		return ErrFromCustomerMismatch
 	}
 
-	_, err = s.userRepo.GetByIDActive(fromCustomerID)
+	_, err = s.userRepo.GetByIDActive(ctx, fromCustomerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
@@ -150,7 +151,7 @@ func (s *AccountAssociationService) TransferAccountOwnership(accountID, fromCust
 		return fmt.Errorf("failed to verify from customer: %w", err)
 	}
 
-	_, err = s.userRepo.GetByIDActive(toCustomerID)
+	_, err = s.userRepo.GetByIDActive(ctx, toCustomerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
@@ -158,7 +159,7 @@ func (s *AccountAssociationService) TransferAccountOwnership(accountID, fromCust
 		return fmt.Errorf("failed to verify to customer: %w", err)
 	}
 
-	if err := s.accountRepo.UpdateOwnership(accountID, toCustomerID); err != nil {
+	if err := s.accountRepo.UpdateOwnership(ctx, accountID, toCustomerID); err != nil {
 		if errors.Is(err, repositories.ErrAccountNotFound) {
 			return ErrAccountNotFound
 		}
@@ -166,7 +167,7 @@ func (s *AccountAssociationService) TransferAccountOwnership(accountID, fromCust
 	}
 
 	if s.auditService != nil {
-		if err := s.auditService.LogAccountTransferred(fromCustomerID, toCustomerID, performedBy, accountID, ipAddress, userAgent); err != nil {
+		if err := s.auditService.LogAccountTransferred(ctx, fromCustomerID, toCustomerID, performedBy, accountID, ipAddress, userAgent); err != nil {
 			// Log error but don't fail the operation
 			s.logger.Error("failed to log account transfer",
 				"error", err,
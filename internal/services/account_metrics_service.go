@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -56,23 +57,23 @@ func NewAccountMetricsService(
 	}
 }
 
-func (s *accountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
+func (s *accountMetricsService) GetAccountMetrics(ctx context.Context, requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
 	effectiveStart, effectiveEnd, err := s.validateAndNormalizeDateRange(startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 
-	requestor, err := s.validateRequestor(requestorID)
+	requestor, err := s.validateRequestor(ctx, requestorID)
 	if err != nil {
 		return nil, err
 	}
 
-	account, err := s.getAndAuthorizeAccount(accountID, requestor, isAdmin)
+	account, err := s.getAndAuthorizeAccount(ctx, accountID, requestor, isAdmin)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions, err := s.transactionRepo.GetByDateRange(accountID, effectiveStart, effectiveEnd)
+	transactions, err := s.transactionRepo.GetByDateRange(ctx, accountID, effectiveStart, effectiveEnd)
 	if err != nil {
 		slog.Error("failed to fetch transactions for metrics",
 			"account_id", accountID,
@@ -91,23 +92,23 @@ func (s *accountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UU
 	return metrics, nil
 }
 
-func (s *accountMetricsService) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
+func (s *accountMetricsService) GetUserAggregateMetrics(ctx context.Context, requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
 	effectiveStart, effectiveEnd, err := s.validateAndNormalizeDateRange(startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 
-	requestor, err := s.validateRequestor(requestorID)
+	requestor, err := s.validateRequestor(ctx, requestorID)
 	if err != nil {
 		return nil, err
 	}
 
-	effectiveUserID, err := s.authorizeUserAccess(requestorID, targetUserID, requestor, isAdmin)
+	effectiveUserID, err := s.authorizeUserAccess(ctx, requestorID, targetUserID, requestor, isAdmin)
 	if err != nil {
 		return nil, err
 	}
 
-	accounts, err := s.accountRepo.GetByUserID(effectiveUserID)
+	accounts, err := s.accountRepo.GetByUserID(ctx, effectiveUserID)
 	if err != nil {
 		slog.Error("failed to fetch user accounts for aggregate metrics",
 			"user_id", effectiveUserID,
@@ -115,7 +116,7 @@ func (s *accountMetricsService) GetUserAggregateMetrics(requestorID, targetUserI
 		return nil, fmt.Errorf("failed to fetch accounts: %w", err)
 	}
 
-	aggregateMetrics := s.calculateAggregateMetrics(effectiveUserID, accounts, effectiveStart, effectiveEnd)
+	aggregateMetrics := s.calculateAggregateMetrics(ctx, effectiveUserID, accounts, effectiveStart, effectiveEnd)
 
 	slog.Info("user aggregate metrics generated",
 		"user_id", effectiveUserID,
@@ -153,8 +154,8 @@ func (s *accountMetricsService) validateAndNormalizeDateRange(startDate, endDate
 	return effectiveStart, effectiveEnd, nil
 }
 
-func (s *accountMetricsService) validateRequestor(requestorID uuid.UUID) (*models.User, error) {
-	requestor, err := s.userRepo.GetByID(requestorID)
+func (s *accountMetricsService) validateRequestor(ctx context.Context, requestorID uuid.UUID) (*models.User, error) {
+	requestor, err := s.userRepo.GetByID(ctx, requestorID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			slog.Warn("user not found during metrics request",
@@ -170,8 +171,8 @@ func (s *accountMetricsService) validateRequestor(requestorID uuid.UUID) (*model
 	return requestor, nil
 }
 
-func (s *accountMetricsService) getAndAuthorizeAccount(accountID uuid.UUID, requestor *models.User, isAdmin bool) (*models.Account, error) {
-	account, err := s.accountRepo.GetByID(accountID)
+func (s *accountMetricsService) getAndAuthorizeAccount(ctx context.Context, accountID uuid.UUID, requestor *models.User, isAdmin bool) (*models.Account, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
 		slog.Error("failed to get account for metrics",
 			"account_id", accountID,
@@ -199,7 +200,7 @@ func (s *accountMetricsService) getAndAuthorizeAccount(accountID uuid.UUID, requ
 	return account, nil
 }
 
-func (s *accountMetricsService) authorizeUserAccess(requestorID, targetUserID uuid.UUID, requestor *models.User, isAdmin bool) (uuid.UUID, error) {
+func (s *accountMetricsService) authorizeUserAccess(ctx context.Context, requestorID, targetUserID uuid.UUID, requestor *models.User, isAdmin bool) (uuid.UUID, error) {
 	if requestorID == targetUserID {
 		return requestorID, nil
 	}
@@ -212,7 +213,7 @@ func (s *accountMetricsService) authorizeUserAccess(requestorID, targetUserID uu
 		return uuid.Nil, ErrUnauthorized
 	}
 
-	targetUser, err := s.userRepo.GetByID(targetUserID)
+	targetUser, err := s.userRepo.GetByID(ctx, targetUserID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			slog.Warn("target user not found during admin metrics request",
@@ -312,7 +313,7 @@ func (s *accountMetricsService) calculateAccountMetrics(accountID uuid.UUID, tra
 	return metrics
 }
 
-func (s *accountMetricsService) calculateAggregateMetrics(userID uuid.UUID, accounts []models.Account, startDate, endDate time.Time) *models.UserAggregateMetrics {
+func (s *accountMetricsService) calculateAggregateMetrics(ctx context.Context, userID uuid.UUID, accounts []models.Account, startDate, endDate time.Time) *models.UserAggregateMetrics {
 	aggregateMetrics := &models.UserAggregateMetrics{
 		UserID:                userID,
 		StartDate:             startDate,
@@ -329,7 +330,7 @@ func (s *accountMetricsService) calculateAggregateMetrics(userID uuid.UUID, acco
 	for i := range accounts {
 		account := &accounts[i]
 
-		transactions, err := s.transactionRepo.GetByDateRange(account.ID, startDate, endDate)
+		transactions, err := s.transactionRepo.GetByDateRange(ctx, account.ID, startDate, endDate)
 		if err != nil {
 			slog.Error("failed to fetch transactions for account in aggregate metrics",
 				"account_id", account.ID,
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -91,11 +92,11 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_DefaultPeriod()
 		},
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, nil, nil, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, nil, nil, false)
 
 	s.NoError(err)
 	s.NotNil(metrics)
@@ -131,11 +132,11 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_CustomDateRange(
 
 	transactions := []models.Transaction{}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, startDate, endDate).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, startDate, endDate).Return(transactions, nil)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, &startDate, &endDate, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, &startDate, &endDate, false)
 
 	s.NoError(err)
 	s.NotNil(metrics)
@@ -154,7 +155,7 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Error_InvalidDateRange()
 	startDate := time.Now()
 	endDate := time.Now().AddDate(0, 0, -7)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, &startDate, &endDate, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, &startDate, &endDate, false)
 
 	s.Error(err)
 	s.Nil(metrics)
@@ -168,7 +169,7 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Error_FutureEndDate() {
 	startDate := time.Now().AddDate(0, 0, -7)
 	endDate := time.Now().AddDate(0, 0, 7)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, &startDate, &endDate, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, &startDate, &endDate, false)
 
 	s.Error(err)
 	s.Nil(metrics)
@@ -193,10 +194,10 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Error_Unauthorized() {
 		Balance: decimal.NewFromFloat(5000.00),
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, nil, nil, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, nil, nil, false)
 
 	s.Error(err)
 	s.Nil(metrics)
@@ -223,11 +224,11 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_AdminAccess() {
 
 	transactions := []models.Transaction{}
 
-	s.mockUserRepo.EXPECT().GetByID(adminID).Return(admin, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), adminID).Return(admin, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 
-	metrics, err := s.service.GetAccountMetrics(adminID, accountID, nil, nil, true)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), adminID, accountID, nil, nil, true)
 
 	s.NoError(err)
 	s.NotNil(metrics)
@@ -245,10 +246,10 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Error_AccountNotFound()
 		Role:  models.RoleCustomer,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(nil, errors.New("account not found"))
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(nil, errors.New("account not found"))
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, nil, nil, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, nil, nil, false)
 
 	s.Error(err)
 	s.Nil(metrics)
@@ -259,9 +260,9 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Error_RequestorNotFound(
 	requestorID := uuid.New()
 	accountID := uuid.New()
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(nil, repositories.ErrUserNotFound)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(nil, repositories.ErrUserNotFound)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, nil, nil, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, nil, nil, false)
 
 	s.Error(err)
 	s.Nil(metrics)
@@ -327,11 +328,11 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_AdvancedMetrics(
 		},
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, nil, nil, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, nil, nil, false)
 
 	s.NoError(err)
 	s.NotNil(metrics)
@@ -390,11 +391,11 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_OnlyCompletedTra
 		},
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, nil, nil, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, nil, nil, false)
 
 	s.NoError(err)
 	s.NotNil(metrics)
@@ -452,12 +453,12 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Success() {
 		},
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByUserID(requestorID).Return(accounts, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(account1ID, gomock.Any(), gomock.Any()).Return(transactions1, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(account2ID, gomock.Any(), gomock.Any()).Return(transactions2, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByUserID(gomock.Any(), requestorID).Return(accounts, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), account1ID, gomock.Any(), gomock.Any()).Return(transactions1, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), account2ID, gomock.Any(), gomock.Any()).Return(transactions2, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, nil, nil, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(context.Background(), requestorID, requestorID, nil, nil, false)
 
 	s.NoError(err)
 	s.NotNil(aggregateMetrics)
@@ -481,10 +482,10 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Success_NoAccounts
 
 	accounts := []models.Account{}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByUserID(requestorID).Return(accounts, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByUserID(gomock.Any(), requestorID).Return(accounts, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, nil, nil, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(context.Background(), requestorID, requestorID, nil, nil, false)
 
 	s.NoError(err)
 	s.NotNil(aggregateMetrics)
@@ -507,9 +508,9 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Error_Unauthorized
 		Role:  models.RoleCustomer,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, targetUserID, nil, nil, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(context.Background(), requestorID, targetUserID, nil, nil, false)
 
 	s.Error(err)
 	s.Nil(aggregateMetrics)
@@ -535,11 +536,11 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Success_AdminAcces
 
 	accounts := []models.Account{}
 
-	s.mockUserRepo.EXPECT().GetByID(adminID).Return(admin, nil)
-	s.mockUserRepo.EXPECT().GetByID(targetUserID).Return(targetUser, nil)
-	s.mockAccountRepo.EXPECT().GetByUserID(targetUserID).Return(accounts, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), adminID).Return(admin, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), targetUserID).Return(targetUser, nil)
+	s.mockAccountRepo.EXPECT().GetByUserID(gomock.Any(), targetUserID).Return(accounts, nil)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(adminID, targetUserID, nil, nil, true)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(context.Background(), adminID, targetUserID, nil, nil, true)
 
 	s.NoError(err)
 	s.NotNil(aggregateMetrics)
@@ -552,7 +553,7 @@ func (s *MetricsServiceTestSuite) TestGetUserAggregateMetrics_Error_InvalidDateR
 	startDate := time.Now()
 	endDate := time.Now().AddDate(0, 0, -7)
 
-	aggregateMetrics, err := s.service.GetUserAggregateMetrics(requestorID, requestorID, &startDate, &endDate, false)
+	aggregateMetrics, err := s.service.GetUserAggregateMetrics(context.Background(), requestorID, requestorID, &startDate, &endDate, false)
 
 	s.Error(err)
 	s.Nil(aggregateMetrics)
@@ -599,11 +600,11 @@ func (s *MetricsServiceTestSuite) TestGetAccountMetrics_Success_LargeTransaction
 		},
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 
-	metrics, err := s.service.GetAccountMetrics(requestorID, accountID, nil, nil, false)
+	metrics, err := s.service.GetAccountMetrics(context.Background(), requestorID, accountID, nil, nil, false)
 
 	s.NoError(err)
 	s.NotNil(metrics)
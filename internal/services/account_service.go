@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"log/slog"
 	"math/rand"
+	"time"
 
 	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
 	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -27,6 +30,12 @@ var (
 	ErrAccountClosureNotAllowed = errors.New("account closure not allowed")
 	ErrTransferPending          = errors.New("transfer is still processing with this idempotency key")
 	ErrTransferFailed           = errors.New("previous transfer failed with this idempotency key")
+	ErrAsyncProcessingDisabled  = errors.New("asynchronous transaction processing is not available")
+	ErrOperationNotFound        = errors.New("transaction operation not found")
+
+	// errTransferSubmissionDeferred means Northwind could not be reached; the debit stays posted
+	// and the saga recovery worker resubmits the transfer.
+	errTransferSubmissionDeferred = errors.New("external transfer submission deferred")
 )
 
 // accountService implements AccountServiceInterface interface
@@ -35,10 +44,14 @@ type accountService struct {
 	transactionRepo     repositories.TransactionRepositoryInterface
 	transferRepo        repositories.TransferRepositoryInterface
 	externalAccountRepo repositories.ExternalAccountRepositoryInterface
+	transferSagaRepo    repositories.TransferSagaRepositoryInterface
 	northwindClient     NorthwindClientInterface
 	userRepo            repositories.UserRepositoryInterface
-	webhookService      WebhookServiceInterface
 	auditRepo           repositories.AuditLogRepositoryInterface
+	fraudScreener       FraudScreeningServiceInterface        // Optional; movements are not screened when nil
+	sanctionsScreener   SanctionsScreeningServiceInterface    // Optional; sanctions holds are not enforced when nil
+	transferReviewer    TransferReviewServiceInterface        // Optional; transfers are never held for review when nil
+	transactionQueue    TransactionProcessingServiceInterface // Optional; transactions cannot be submitted asynchronously when nil
 	logger              *slog.Logger
 }
 
@@ -48,10 +61,14 @@ func NewAccountService(
 	transactionRepo repositories.TransactionRepositoryInterface,
 	transferRepo repositories.TransferRepositoryInterface,
 	externalAccountRepo repositories.ExternalAccountRepositoryInterface,
-	webhookService WebhookServiceInterface,
+	transferSagaRepo repositories.TransferSagaRepositoryInterface,
 	northwindClient NorthwindClientInterface,
 	userRepo repositories.UserRepositoryInterface,
 	auditRepo repositories.AuditLogRepositoryInterface,
+	fraudScreener FraudScreeningServiceInterface,
+	sanctionsScreener SanctionsScreeningServiceInterface,
+	transferReviewer TransferReviewServiceInterface,
+	transactionQueue TransactionProcessingServiceInterface,
 	logger *slog.Logger,
 ) AccountServiceInterface {
 	return &accountService{
@@ -59,17 +76,21 @@ func NewAccountService(
 		transactionRepo:     transactionRepo,
 		transferRepo:        transferRepo,
 		externalAccountRepo: externalAccountRepo,
-		webhookService:      webhookService,
+		transferSagaRepo:    transferSagaRepo,
 		northwindClient:     northwindClient,
 		userRepo:            userRepo,
 		auditRepo:           auditRepo,
+		fraudScreener:       fraudScreener,
+		sanctionsScreener:   sanctionsScreener,
+		transferReviewer:    transferReviewer,
+		transactionQueue:    transactionQueue,
 		logger:              logger,
 	}
 }
 
 // CreateAccount creates a new account for a user
-func (s *accountService) CreateAccount(userID uuid.UUID, accountType string, initialDeposit decimal.Decimal) (*models.Account, error) {
-	user, err := s.userRepo.GetByID(userID)
+func (s *accountService) CreateAccount(ctx context.Context, userID uuid.UUID, accountType string, initialDeposit decimal.Decimal) (*models.Account, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return nil, ErrUserNotFound
@@ -78,7 +99,7 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType string, ini
 	}
 
 	// Business rule: One account per type per user
-	exists, err := s.accountRepo.ExistsForUser(userID, accountType)
+	exists, err := s.accountRepo.ExistsForUser(ctx, userID, accountType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check existing account: %w", err)
 	}
@@ -90,7 +111,7 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType string, ini
 		return nil, ErrInvalidAmount
 	}
 
-	accountNumber, err := s.accountRepo.GenerateUniqueAccountNumber(accountType)
+	accountNumber, err := s.accountRepo.GenerateUniqueAccountNumber(ctx, accountType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate account number: %w", err)
 	}
@@ -118,17 +139,17 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType string, ini
 		transactions = append(transactions, transaction)
 	}
 
-	if err := s.accountRepo.CreateWithTransaction(account, transactions); err != nil {
+	if err := s.accountRepo.CreateWithTransaction(ctx, account, transactions); err != nil {
 		return nil, fmt.Errorf("failed to create account: %w", err)
 	}
 
-	if err := s.auditRepo.Create(&models.AuditLog{
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
 		UserID:     &user.ID,
 		Action:     "account.created",
 		Resource:   "account",
 		ResourceID: account.ID.String(),
-		IPAddress:  "system",
-		UserAgent:  "internal",
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
 		Metadata: models.JSONBMap{
 			"account_type":   accountType,
 			"account_number": account.AccountNumber,
@@ -141,15 +162,15 @@ func (s *accountService) CreateAccount(userID uuid.UUID, accountType string, ini
 }
 
 // CreateAccountsForNewUser creates default checking and savings accounts for a new user
-func (s *accountService) CreateAccountsForNewUser(userID uuid.UUID) error {
+func (s *accountService) CreateAccountsForNewUser(ctx context.Context, userID uuid.UUID) error {
 	checkingBalance := s.generateRandomBalance(100, 5000)
-	_, err := s.createAccountWithSampleData(userID, models.AccountTypeChecking, checkingBalance)
+	_, err := s.createAccountWithSampleData(ctx, userID, models.AccountTypeChecking, checkingBalance)
 	if err != nil {
 		return fmt.Errorf("failed to create checking account: %w", err)
 	}
 
 	savingsBalance := s.generateRandomBalance(500, 10000)
-	_, err = s.createAccountWithSampleData(userID, models.AccountTypeSavings, savingsBalance)
+	_, err = s.createAccountWithSampleData(ctx, userID, models.AccountTypeSavings, savingsBalance)
 	if err != nil {
 		return fmt.Errorf("failed to create savings account: %w", err)
 	}
@@ -158,8 +179,8 @@ func (s *accountService) CreateAccountsForNewUser(userID uuid.UUID) error {
 }
 
 // createAccountWithSampleData creates an account with random sample transactions
-func (s *accountService) createAccountWithSampleData(userID uuid.UUID, accountType string, targetBalance decimal.Decimal) (*models.Account, error) {
-	accountNumber, err := s.accountRepo.GenerateUniqueAccountNumber(accountType)
+func (s *accountService) createAccountWithSampleData(ctx context.Context, userID uuid.UUID, accountType string, targetBalance decimal.Decimal) (*models.Account, error) {
+	accountNumber, err := s.accountRepo.GenerateUniqueAccountNumber(ctx, accountType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate account number: %w", err)
 	}
@@ -187,7 +208,7 @@ func (s *accountService) createAccountWithSampleData(userID uuid.UUID, accountTy
 
 	account.Balance = finalBalance
 
-	if err := s.accountRepo.CreateWithTransaction(account, transactions); err != nil {
+	if err := s.accountRepo.CreateWithTransaction(ctx, account, transactions); err != nil {
 		return nil, fmt.Errorf("failed to create account with sample data: %w", err)
 	}
 
@@ -287,8 +308,8 @@ func (s *accountService) generateRandomBalance(min, max int) decimal.Decimal {
 }
 
 // GetAccountByID retrieves an account by ID with optional user verification
-func (s *accountService) GetAccountByID(accountID uuid.UUID, userID *uuid.UUID) (*models.Account, error) {
-	account, err := s.accountRepo.GetByID(accountID)
+func (s *accountService) GetAccountByID(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID) (*models.Account, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrAccountNotFound) {
 			return nil, ErrAccountNotFound
@@ -298,7 +319,7 @@ func (s *accountService) GetAccountByID(accountID uuid.UUID, userID *uuid.UUID)
 
 	// Authorization: Non-admin users can only access their own accounts
 	if userID != nil && account.UserID != *userID {
-		user, err := s.userRepo.GetByID(*userID)
+		user, err := s.userRepo.GetByID(ctx, *userID)
 		if err != nil || !user.IsAdmin() {
 			return nil, ErrUnauthorized
 		}
@@ -308,8 +329,8 @@ func (s *accountService) GetAccountByID(accountID uuid.UUID, userID *uuid.UUID)
 }
 
 // GetAccountByNumber retrieves an account by account number
-func (s *accountService) GetAccountByNumber(accountNumber string) (*models.Account, error) {
-	account, err := s.accountRepo.GetByAccountNumber(accountNumber)
+func (s *accountService) GetAccountByNumber(ctx context.Context, accountNumber string) (*models.Account, error) {
+	account, err := s.accountRepo.GetByAccountNumber(ctx, accountNumber)
 	if err != nil {
 		if errors.Is(err, repositories.ErrAccountNotFound) {
 			return nil, ErrAccountNotFound
@@ -320,8 +341,8 @@ func (s *accountService) GetAccountByNumber(accountNumber string) (*models.Accou
 }
 
 // GetUserAccounts retrieves all accounts for a user
-func (s *accountService) GetUserAccounts(userID uuid.UUID) ([]models.Account, error) {
-	accounts, err := s.accountRepo.GetByUserID(userID)
+func (s *accountService) GetUserAccounts(ctx context.Context, userID uuid.UUID) ([]models.Account, error) {
+	accounts, err := s.accountRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user accounts: %w", err)
 	}
@@ -329,8 +350,8 @@ func (s *accountService) GetUserAccounts(userID uuid.UUID) ([]models.Account, er
 }
 
 // GetAllAccounts retrieves all accounts with filters (admin only)
-func (s *accountService) GetAllAccounts(filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
-	accounts, total, err := s.accountRepo.GetAllWithFilters(filters, offset, limit)
+func (s *accountService) GetAllAccounts(ctx context.Context, filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
+	accounts, total, err := s.accountRepo.GetAllWithFilters(ctx, filters, offset, limit)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get all accounts: %w", err)
 	}
@@ -338,8 +359,8 @@ func (s *accountService) GetAllAccounts(filters models.AccountFilters, offset, l
 }
 
 // UpdateAccountStatus updates the status of an account
-func (s *accountService) UpdateAccountStatus(accountID uuid.UUID, userID *uuid.UUID, status string) (*models.Account, error) {
-	account, err := s.GetAccountByID(accountID, userID)
+func (s *accountService) UpdateAccountStatus(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, status string) (*models.Account, error) {
+	account, err := s.GetAccountByID(ctx, accountID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -361,17 +382,17 @@ func (s *accountService) UpdateAccountStatus(accountID uuid.UUID, userID *uuid.U
 		return nil, fmt.Errorf("invalid account status: %s", status)
 	}
 
-	if err := s.accountRepo.Update(account); err != nil {
+	if err := s.accountRepo.Update(ctx, account); err != nil {
 		return nil, fmt.Errorf("failed to update account status: %w", err)
 	}
 
-	if err := s.auditRepo.Create(&models.AuditLog{
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
 		UserID:     &account.UserID,
 		Action:     "account.status_changed",
 		Resource:   "account",
 		ResourceID: account.ID.String(),
-		IPAddress:  "system",
-		UserAgent:  "internal",
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
 		Metadata: models.JSONBMap{
 			"new_status": status,
 		},
@@ -383,8 +404,8 @@ func (s *accountService) UpdateAccountStatus(accountID uuid.UUID, userID *uuid.U
 }
 
 // CloseAccount closes an account
-func (s *accountService) CloseAccount(accountID uuid.UUID, userID uuid.UUID) error {
-	account, err := s.GetAccountByID(accountID, &userID)
+func (s *accountService) CloseAccount(ctx context.Context, accountID uuid.UUID, userID uuid.UUID) error {
+	account, err := s.GetAccountByID(ctx, accountID, &userID)
 	if err != nil {
 		return err
 	}
@@ -398,17 +419,17 @@ func (s *accountService) CloseAccount(accountID uuid.UUID, userID uuid.UUID) err
 		return err
 	}
 
-	if err := s.accountRepo.Update(account); err != nil {
+	if err := s.accountRepo.Update(ctx, account); err != nil {
 		return fmt.Errorf("failed to close account: %w", err)
 	}
 
-	if err := s.auditRepo.Create(&models.AuditLog{
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
 		UserID:     &userID,
 		Action:     "account.closed",
 		Resource:   "account",
 		ResourceID: account.ID.String(),
-		IPAddress:  "system",
-		UserAgent:  "internal",
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
 		Metadata: models.JSONBMap{
 			"account_number": account.AccountNumber,
 		},
@@ -419,73 +440,176 @@ func (s *accountService) CloseAccount(accountID uuid.UUID, userID uuid.UUID) err
 	return nil
 }
 
-// PerformTransaction creates a transaction on an account
-func (s *accountService) PerformTransaction(accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error) {
-	if amount.LessThanOrEqual(decimal.Zero) {
-		return nil, ErrInvalidAmount
-	}
-
-	account, err := s.GetAccountByID(accountID, userID)
+// PerformTransaction posts a credit or debit to an account
+func (s *accountService) PerformTransaction(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error) {
+	account, decision, err := s.prepareTransaction(ctx, accountID, amount, transactionType, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	if !account.IsActive() {
-		return nil, ErrAccountNotActive
+	transaction := &models.Transaction{
+		AccountID:       accountID,
+		TransactionType: transactionType,
+		Amount:          amount,
+		Description:     description,
+		Status:          models.TransactionStatusCompleted,
+		Reference:       models.GenerateTransactionReference(),
 	}
 
-	balanceBefore := account.Balance
-
-	if err := s.accountRepo.UpdateBalance(accountID, amount, transactionType); err != nil {
-		if errors.Is(err, repositories.ErrInsufficientFunds) {
+	// The balance and the ledger entry are written together, so a request cancelled part way
+	// cannot move the balance without recording why
+	if err := s.transactionRepo.Post(ctx, transaction); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrInsufficientFunds):
 			return nil, ErrInsufficientFunds
+		case errors.Is(err, repositories.ErrAccountNotActive):
+			return nil, ErrAccountNotActive
 		}
-		return nil, fmt.Errorf("failed to update balance: %w", err)
+		return nil, fmt.Errorf("failed to post transaction: %w", err)
 	}
+	s.attachFraudDecision(ctx, decision, "transaction", transaction.ID)
 
-	var balanceAfter decimal.Decimal
-	if transactionType == models.TransactionTypeCredit {
-		balanceAfter = balanceBefore.Add(amount)
-	} else {
-		balanceAfter = balanceBefore.Sub(amount)
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &account.UserID,
+		Action:     fmt.Sprintf("transaction.%s", transactionType),
+		Resource:   "transaction",
+		ResourceID: transaction.ID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata: models.JSONBMap{
+			"account_number": account.AccountNumber,
+			"amount":         amount.String(),
+			"type":           transactionType,
+		},
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", fmt.Sprintf("transaction.%s", transactionType))
+	}
+
+	return transaction, nil
+}
+
+// SubmitTransaction accepts a transaction for asynchronous processing. It runs the same checks as
+// PerformTransaction, then records the transaction as pending and queues it; the processing
+// workers apply it to the balance. Funds are checked when the transaction is processed, so an
+// overdrawing debit shows up as a failed operation rather than an error here.
+func (s *accountService) SubmitTransaction(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.TransactionOperation, error) {
+	if s.transactionQueue == nil {
+		return nil, ErrAsyncProcessingDisabled
+	}
+
+	account, decision, err := s.prepareTransaction(ctx, accountID, amount, transactionType, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	transaction := &models.Transaction{
 		AccountID:       accountID,
 		TransactionType: transactionType,
 		Amount:          amount,
-		BalanceBefore:   balanceBefore,
-		BalanceAfter:    balanceAfter,
 		Description:     description,
-		Status:          models.TransactionStatusCompleted,
+		Status:          models.TransactionStatusPending,
 		Reference:       models.GenerateTransactionReference(),
 	}
 
-	if err := s.transactionRepo.Create(transaction); err != nil {
+	if err := s.transactionRepo.Create(ctx, transaction); err != nil {
 		return nil, fmt.Errorf("failed to create transaction record: %w", err)
 	}
+	s.attachFraudDecision(ctx, decision, "transaction", transaction.ID)
 
-	if err := s.auditRepo.Create(&models.AuditLog{
+	priority := s.transactionQueue.PriorityForAccountType(account.AccountType)
+	if err := s.transactionQueue.EnqueueTransaction(ctx, transaction.ID, models.QueueOperationProcess, priority); err != nil {
+		// Nothing will ever process it, so do not leave it looking in flight
+		if updateErr := s.transactionRepo.FailPending(ctx, transaction, transaction.Version, models.TransactionFailureProcessingFailed); updateErr != nil {
+			s.logger.Error("failed to fail unqueued transaction", "error", updateErr, "transaction_id", transaction.ID)
+		}
+		return nil, fmt.Errorf("failed to queue transaction: %w", err)
+	}
+
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
 		UserID:     &account.UserID,
-		Action:     fmt.Sprintf("transaction.%s", transactionType),
+		Action:     "transaction.submitted",
 		Resource:   "transaction",
 		ResourceID: transaction.ID.String(),
-		IPAddress:  "system",
-		UserAgent:  "internal",
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
 		Metadata: models.JSONBMap{
 			"account_number": account.AccountNumber,
 			"amount":         amount.String(),
 			"type":           transactionType,
+			"priority":       priority,
 		},
 	}); err != nil {
-		s.logger.Error("failed to create audit log", "error", err, "action", fmt.Sprintf("transaction.%s", transactionType))
+		s.logger.Error("failed to create audit log", "error", err, "action", "transaction.submitted")
 	}
 
-	return transaction, nil
+	return &models.TransactionOperation{Transaction: transaction}, nil
+}
+
+// GetTransactionOperation returns the status of a transaction submitted on the account. The
+// operation ID is the transaction ID.
+func (s *accountService) GetTransactionOperation(ctx context.Context, accountID, operationID uuid.UUID, userID *uuid.UUID) (*models.TransactionOperation, error) {
+	if _, err := s.GetAccountByID(ctx, accountID, userID); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.transactionRepo.GetByID(ctx, operationID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransactionNotFound) {
+			return nil, ErrOperationNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if transaction.AccountID != accountID {
+		return nil, ErrOperationNotFound
+	}
+
+	operation := &models.TransactionOperation{Transaction: transaction}
+	if transaction.IsPending() && s.transactionQueue != nil {
+		item, err := s.transactionQueue.GetTransactionQueueItem(ctx, transaction.ID)
+		if err != nil && !errors.Is(err, repositories.ErrQueueItemNotFound) {
+			return nil, fmt.Errorf("failed to get queue item: %w", err)
+		}
+		operation.QueueItem = item
+	}
+
+	return operation, nil
+}
+
+// prepareTransaction runs the checks shared by synchronous and queued transactions: the amount,
+// access to the account, its status and, for debits, fraud screening.
+func (s *accountService) prepareTransaction(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType string, userID *uuid.UUID) (*models.Account, *models.FraudDecision, error) {
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, nil, ErrInvalidAmount
+	}
+
+	account, err := s.GetAccountByID(ctx, accountID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !account.IsActive() {
+		return nil, nil, ErrAccountNotActive
+	}
+
+	// Deposits are covered by the daily compliance reports; only money leaving is screened
+	var decision *models.FraudDecision
+	if transactionType == models.TransactionTypeDebit {
+		decision, err = s.screenMovement(ctx, &dto.FraudScreeningRequest{
+			UserID:    account.UserID,
+			Operation: models.FraudOperationTransaction,
+			AccountID: account.ID,
+			Amount:    amount,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return account, decision, nil
 }
 
 // TransferBetweenAccounts performs an atomic transfer with idempotency support
-func (s *accountService) TransferBetweenAccounts(
+func (s *accountService) TransferBetweenAccounts(ctx context.Context,
 	fromAccountID, toAccountID uuid.UUID,
 	amount decimal.Decimal,
 	description, idempotencyKey string,
@@ -495,33 +619,56 @@ func (s *accountService) TransferBetweenAccounts(
 		return nil, err
 	}
 
-	if existingTransfer, err := s.checkExistingTransfer(idempotencyKey); existingTransfer != nil || err != nil {
+	if existingTransfer, err := s.checkExistingTransfer(ctx, idempotencyKey); existingTransfer != nil || err != nil {
 		return existingTransfer, err
 	}
 
-	fromAccount, toAccount, err := s.retrieveAndAuthorizeAccounts(fromAccountID, toAccountID, userID)
+	fromAccount, toAccount, err := s.retrieveAndAuthorizeAccounts(ctx, fromAccountID, toAccountID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	transfer, debitTxID, creditTxID, err := s.executeTransfer(
+	decision, err := s.screenMovement(ctx, &dto.FraudScreeningRequest{
+		UserID:                userID,
+		Operation:             models.FraudOperationInternalTransfer,
+		AccountID:             fromAccount.ID,
+		CounterpartyAccountID: &toAccount.ID,
+		Amount:                amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.holdForReview(ctx, userID, &models.Transfer{
+		FromAccountID:  fromAccount.ID,
+		ToAccountID:    &toAccount.ID,
+		Amount:         amount,
+		Description:    description,
+		IdempotencyKey: idempotencyKey,
+	}, "", fmt.Sprintf("Transfer to %s: %s", toAccount.AccountNumber, description))
+	if err != nil {
+		return nil, err
+	}
+	if review != nil {
+		s.attachFraudDecision(ctx, decision, "transfer", review.TransferID)
+		return &review.Transfer, nil
+	}
+
+	transfer, debitTxID, creditTxID, err := s.executeTransfer(ctx,
 		amount, description, idempotencyKey,
 		fromAccount, toAccount,
 	)
 	if err != nil {
 		if transfer != nil {
-			s.handleTransferFailure(transfer, err, fromAccount, toAccount, amount, idempotencyKey, userID)
+			s.handleTransferFailure(ctx, transfer, err, fromAccount, toAccount, amount, idempotencyKey, userID)
 		}
 		return nil, err
 	}
 
-	if err := s.handleTransferSuccess(transfer, debitTxID, creditTxID, fromAccount, toAccount, amount, idempotencyKey, userID); err != nil {
+	if err := s.handleTransferSuccess(ctx, transfer, debitTxID, creditTxID, fromAccount, toAccount, amount, idempotencyKey, userID); err != nil {
 		return nil, err
 	}
-
-	if s.webhookService != nil {
-		s.webhookService.QueueTransferNotification(context.Background(), transfer) // Use background context for out-of-band task
-	}
+	s.attachFraudDecision(ctx, decision, "transfer", transfer.ID)
 
 	return transfer, nil
 }
@@ -550,8 +697,8 @@ func (s *accountService) validateTransferRequest(
 	return nil
 }
 
-func (s *accountService) checkExistingTransfer(idempotencyKey string) (*models.Transfer, error) {
-	existingTransfer, err := s.transferRepo.FindByIdempotencyKey(idempotencyKey)
+func (s *accountService) checkExistingTransfer(ctx context.Context, idempotencyKey string) (*models.Transfer, error) {
+	existingTransfer, err := s.transferRepo.FindByIdempotencyKey(ctx, idempotencyKey)
 	if err != nil {
 		if errors.Is(err, repositories.ErrTransferNotFound) {
 			return nil, nil
@@ -560,9 +707,9 @@ func (s *accountService) checkExistingTransfer(idempotencyKey string) (*models.T
 	}
 
 	switch existingTransfer.Status {
-	case models.TransferStatusCompleted:
+	case models.TransferStatusCompleted, models.TransferStatusHeldForReview:
 		return existingTransfer, nil
-	case models.TransferStatusPending:
+	case models.TransferStatusPending, models.TransferStatusProcessing:
 		return nil, ErrTransferPending
 	case models.TransferStatusFailed:
 		return nil, ErrTransferFailed
@@ -571,10 +718,10 @@ func (s *accountService) checkExistingTransfer(idempotencyKey string) (*models.T
 	return nil, nil
 }
 
-func (s *accountService) retrieveAndAuthorizeAccounts(
+func (s *accountService) retrieveAndAuthorizeAccounts(ctx context.Context,
 	fromAccountID, toAccountID, userID uuid.UUID,
 ) (*models.Account, *models.Account, error) {
-	fromAccount, err := s.accountRepo.GetByID(fromAccountID)
+	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrAccountNotFound) {
 			return nil, nil, ErrAccountNotFound
@@ -582,7 +729,7 @@ func (s *accountService) retrieveAndAuthorizeAccounts(
 		return nil, nil, fmt.Errorf("failed to get source account: %w", err)
 	}
 
-	toAccount, err := s.accountRepo.GetByID(toAccountID)
+	toAccount, err := s.accountRepo.GetByID(ctx, toAccountID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrAccountNotFound) {
 			return nil, nil, ErrAccountNotFound
@@ -605,7 +752,7 @@ func (s *accountService) retrieveAndAuthorizeAccounts(
 	return fromAccount, toAccount, nil
 }
 
-func (s *accountService) executeTransfer(
+func (s *accountService) executeTransfer(ctx context.Context,
 	amount decimal.Decimal,
 	description, idempotencyKey string,
 	fromAccount, toAccount *models.Account,
@@ -619,14 +766,14 @@ func (s *accountService) executeTransfer(
 		Status:         models.TransferStatusPending,
 	}
 
-	if err := s.transferRepo.Create(transfer); err != nil {
+	if err := s.transferRepo.Create(ctx, transfer); err != nil {
 		return nil, uuid.Nil, uuid.Nil, fmt.Errorf("failed to create transfer: %w", err)
 	}
 
 	fromDescription := fmt.Sprintf("Transfer to %s: %s", toAccount.AccountNumber, description)
 	toDescription := fmt.Sprintf("Transfer from %s: %s", fromAccount.AccountNumber, description)
 
-	debitTxID, creditTxID, err := s.accountRepo.ExecuteAtomicTransfer(
+	debitTxID, creditTxID, err := s.accountRepo.ExecuteAtomicTransfer(ctx,
 		fromAccount.ID,
 		toAccount.ID,
 		amount,
@@ -637,7 +784,7 @@ func (s *accountService) executeTransfer(
 	return transfer, debitTxID, creditTxID, err
 }
 
-func (s *accountService) handleTransferFailure(
+func (s *accountService) handleTransferFailure(ctx context.Context,
 	transfer *models.Transfer,
 	txErr error,
 	fromAccount, toAccount *models.Account,
@@ -647,17 +794,17 @@ func (s *accountService) handleTransferFailure(
 ) {
 	errorMsg := txErr.Error()
 	transfer.Fail(errorMsg)
-	if err := s.transferRepo.Update(transfer); err != nil {
+	if err := s.transferRepo.Update(ctx, transfer); err != nil {
 		s.logger.Error("failed to update transfer status", "error", err, "transfer_id", transfer.ID)
 	}
 
-	if err := s.auditRepo.Create(&models.AuditLog{
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
 		UserID:     &userID,
 		Action:     "transfer.failed",
 		Resource:   "transfer",
 		ResourceID: transfer.ID.String(),
-		IPAddress:  "system",
-		UserAgent:  "internal",
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
 		Metadata: models.JSONBMap{
 			"from_account":    fromAccount.AccountNumber,
 			"to_account":      toAccount.AccountNumber,
@@ -670,7 +817,7 @@ func (s *accountService) handleTransferFailure(
 	}
 }
 
-func (s *accountService) handleTransferSuccess(
+func (s *accountService) handleTransferSuccess(ctx context.Context,
 	transfer *models.Transfer,
 	debitTxID, creditTxID uuid.UUID,
 	fromAccount, toAccount *models.Account,
@@ -679,17 +826,17 @@ func (s *accountService) handleTransferSuccess(
 	userID uuid.UUID,
 ) error {
 	transfer.Complete(debitTxID, creditTxID)
-	if err := s.transferRepo.Update(transfer); err != nil {
+	if err := s.transferRepo.UpdateWithEvents(ctx, transfer, models.NewTransferEvent(transfer)); err != nil {
 		return fmt.Errorf("failed to update transfer status: %w", err)
 	}
 
-	if err := s.auditRepo.Create(&models.AuditLog{
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
 		UserID:     &userID,
 		Action:     "transfer.completed",
 		Resource:   "transfer",
 		ResourceID: transfer.ID.String(),
-		IPAddress:  "system",
-		UserAgent:  "internal",
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
 		Metadata: models.JSONBMap{
 			"from_account":    fromAccount.AccountNumber,
 			"to_account":      toAccount.AccountNumber,
@@ -705,57 +852,87 @@ func (s *accountService) handleTransferSuccess(
 }
 
 // HandleFailedExternalTransfer reverses a failed external transfer by crediting the source account.
-func (s *accountService) HandleFailedExternalTransfer(ctx context.Context, transfer *models.Transfer, reason string) error {
+// Transfers tracked by a saga are compensated through it, which applies the reversal exactly once.
+func (s *accountService) HandleFailedExternalTransfer(ctx context.Context, transfer *models.Transfer, reason string) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "AccountService.HandleFailedExternalTransfer")
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if transfer.Status == models.TransferStatusFailed {
-		s.logger.Warn("attempted to handle already failed transfer", "transfer_id", transfer.ID)
+		s.logger.WarnContext(ctx, "attempted to handle already failed transfer", "transfer_id", transfer.ID)
 		return nil // Idempotent: already handled
 	}
 
-	fromAccount, err := s.accountRepo.GetByID(transfer.FromAccountID)
+	compensated, _, err := s.compensateExternalTransfer(ctx, transfer, reason)
+	if err == nil {
+		*transfer = *compensated
+		return nil
+	}
+	if !errors.Is(err, repositories.ErrTransferSagaNotFound) {
+		return err
+	}
+
+	// Transfers created before sagas were introduced are reversed directly
+	fromAccount, err := s.accountRepo.GetByID(ctx, transfer.FromAccountID)
 	if err != nil {
-		s.logger.Error("failed to get source account for reversal", "transfer_id", transfer.ID, "account_id", transfer.FromAccountID, "error", err)
+		s.logger.ErrorContext(ctx, "failed to get source account for reversal", "transfer_id", transfer.ID, "account_id", transfer.FromAccountID, "error", err)
 		return fmt.Errorf("failed to get source account for reversal: %w", err)
 	}
 
-	// Create a reversal credit transaction
-	var reversalDescription string
-	if transfer.ExternalTransferID != nil {
-		reversalDescription = fmt.Sprintf("Reversal for failed transfer ref %s", *transfer.ExternalTransferID)
-	} else {
-		reversalDescription = "Reversal for failed transfer (no external ref)"
-	}
-	creditTx, err := s.PerformTransaction(transfer.FromAccountID, transfer.Amount, models.TransactionTypeCredit, reversalDescription, &fromAccount.UserID)
+	creditTx, err := s.PerformTransaction(ctx, transfer.FromAccountID, transfer.Amount, models.TransactionTypeCredit, reversalDescription(transfer), &fromAccount.UserID)
 	if err != nil {
-		s.logger.Error("CRITICAL: failed to create reversal transaction for failed external transfer", "transfer_id", transfer.ID, "error", err)
+		s.logger.ErrorContext(ctx, "CRITICAL: failed to create reversal transaction for failed external transfer", "transfer_id", transfer.ID, "error", err)
 		return fmt.Errorf("critical: failed to create reversal transaction: %w", err)
 	}
 
 	transfer.Fail(reason)
 	transfer.ReversalTransactionID = &creditTx.ID
-	if err := s.transferRepo.Update(transfer); err != nil {
-		s.logger.Error("failed to update transfer status to failed after reversal", "transfer_id", transfer.ID, "error", err)
+	if err := s.transferRepo.UpdateWithEvents(ctx, transfer, models.NewTransferEvent(transfer)); err != nil {
+		s.logger.ErrorContext(ctx, "failed to update transfer status to failed after reversal", "transfer_id", transfer.ID, "error", err)
 		return fmt.Errorf("failed to update transfer status: %w", err)
 	}
 
-	if s.webhookService != nil {
-		s.webhookService.QueueTransferNotification(ctx, transfer)
-	}
+	s.logger.InfoContext(ctx, "successfully reversed failed external transfer", "transfer_id", transfer.ID, "reversal_tx_id", creditTx.ID)
+	return nil
+}
 
-	s.logger.Info("successfully reversed failed external transfer", "transfer_id", transfer.ID, "reversal_tx_id", creditTx.ID)
+// CompleteExternalTransfer records partner confirmation of an external transfer together with its
+// transfer.completed event. A transfer whose saga was already compensated is left failed.
+func (s *accountService) CompleteExternalTransfer(ctx context.Context, transfer *models.Transfer) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "AccountService.CompleteExternalTransfer")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	now := time.Now()
+	transfer.Status = models.TransferStatusCompleted
+	transfer.CompletedAt = &now
+
+	err = s.transferSagaRepo.Confirm(ctx, transfer)
+	if errors.Is(err, repositories.ErrTransferSagaNotFound) {
+		err = s.transferRepo.UpdateWithEvents(ctx, transfer, models.NewTransferEvent(transfer))
+	}
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to record external transfer completion", "transfer_id", transfer.ID, "error", err)
+		return fmt.Errorf("failed to complete transfer: %w", err)
+	}
 	return nil
 }
 
 // InitiateExternalTransfer handles the logic for starting a transfer to a registered external account.
-func (s *accountService) InitiateExternalTransfer(ctx context.Context, userID, fromAccountID, toExternalAccountID uuid.UUID, amount decimal.Decimal, description, transferType, idempotencyKey string) (*models.Transfer, error) {
+// The debit, transfer and saga are written atomically before Northwind is called; if the partner
+// rejects the transfer the debit is compensated before returning. If the partner cannot be reached
+// the pending transfer is returned and submitted later by the saga recovery worker.
+func (s *accountService) InitiateExternalTransfer(ctx context.Context, userID, fromAccountID, toExternalAccountID uuid.UUID, amount decimal.Decimal, description, transferType, idempotencyKey string) (_ *models.Transfer, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "AccountService.InitiateExternalTransfer")
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if amount.LessThanOrEqual(decimal.Zero) {
 		return nil, ErrInvalidAmount
 	}
 
-	if existingTransfer, err := s.checkExistingTransfer(idempotencyKey); existingTransfer != nil || err != nil {
+	if existingTransfer, err := s.checkExistingTransfer(ctx, idempotencyKey); existingTransfer != nil || err != nil {
 		return existingTransfer, err
 	}
 
-	fromAccount, err := s.accountRepo.GetByID(fromAccountID)
+	fromAccount, err := s.accountRepo.GetByID(ctx, fromAccountID)
 	if err != nil {
 		return nil, ErrAccountNotFound
 	}
@@ -769,7 +946,7 @@ func (s *accountService) InitiateExternalTransfer(ctx context.Context, userID, f
 		return nil, ErrInsufficientFunds
 	}
 
-	toExternalAccount, err := s.externalAccountRepo.GetByID(toExternalAccountID)
+	toExternalAccount, err := s.externalAccountRepo.GetByID(ctx, toExternalAccountID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrExternalAccountNotFound) {
 			return nil, ErrAccountNotFound // Treat as a general not found for security
@@ -779,10 +956,25 @@ func (s *accountService) InitiateExternalTransfer(ctx context.Context, userID, f
 	if toExternalAccount.UserID != userID {
 		return nil, ErrUnauthorized // User can only send to external accounts they registered
 	}
+	if toExternalAccount.IsLocked() {
+		return nil, ErrExternalAccountLocked
+	}
+	if toExternalAccount.IsSanctionsHeld() {
+		return nil, ErrPayeeSanctionsHold
+	}
+	if !toExternalAccount.CanReceiveTransfer(amount) {
+		return nil, ErrExternalAccountNotVerified // Unverified payees are capped at a small limit; the running total is checked with the debit
+	}
 
-	debitTx, err := s.PerformTransaction(fromAccountID, amount, models.TransactionTypeDebit, fmt.Sprintf("External Transfer to %s", toExternalAccount.Nickname), &userID)
+	decision, err := s.screenMovement(ctx, &dto.FraudScreeningRequest{
+		UserID:            userID,
+		Operation:         models.FraudOperationExternalTransfer,
+		AccountID:         fromAccount.ID,
+		ExternalAccountID: &toExternalAccount.ID,
+		Amount:            amount,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to debit source account: %w", err)
+		return nil, err
 	}
 
 	transfer := &models.Transfer{
@@ -792,45 +984,319 @@ func (s *accountService) InitiateExternalTransfer(ctx context.Context, userID, f
 		Description:         description,
 		IdempotencyKey:      idempotencyKey,
 		Status:              models.TransferStatusPending,
-		DebitTransactionID:  &debitTx.ID,
 	}
+	debitDescription := fmt.Sprintf("External Transfer to %s", toExternalAccount.Nickname)
+
+	review, err := s.holdForReview(ctx, userID, transfer, transferType, debitDescription)
+	if err != nil {
+		return nil, err
+	}
+	if review != nil {
+		s.attachFraudDecision(ctx, decision, "transfer", transfer.ID)
+		return transfer, nil
+	}
+
+	saga, err := s.transferSagaRepo.BeginWithDebit(ctx, transfer, transferType, debitDescription)
+	if err != nil {
+		return nil, mapDebitErr(err)
+	}
+	s.attachFraudDecision(ctx, decision, "transfer", transfer.ID)
+
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &userID,
+		Action:     fmt.Sprintf("transaction.%s", models.TransactionTypeDebit),
+		Resource:   "transaction",
+		ResourceID: saga.DebitTransactionID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata: models.JSONBMap{
+			"account_number": fromAccount.AccountNumber,
+			"amount":         amount.String(),
+			"type":           models.TransactionTypeDebit,
+			"transfer_id":    transfer.ID.String(),
+		},
+	}); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create audit log", "error", err, "action", "transaction.debit")
+	}
+
+	if err := s.submitExternalTransfer(ctx, transfer, fromAccount, toExternalAccount, transferType); err != nil {
+		if errors.Is(err, errTransferSubmissionDeferred) {
+			return transfer, nil // Accepted as pending; recovery submits it once Northwind is back
+		}
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// screenMovement refuses movements by customers under a sanctions hold, then runs fraud
+// screening when a screener is configured. A block is returned as ErrTransactionDeclined; a
+// review lets the movement proceed and stays flagged on the decision.
+func (s *accountService) screenMovement(ctx context.Context, req *dto.FraudScreeningRequest) (*models.FraudDecision, error) {
+	if s.sanctionsScreener != nil {
+		if err := s.sanctionsScreener.CheckUser(ctx, req.UserID); err != nil {
+			if errors.Is(err, ErrSanctionsHold) {
+				return nil, ErrSanctionsHold
+			}
+			return nil, fmt.Errorf("failed to check sanctions status: %w", err)
+		}
+	}
+	if s.fraudScreener == nil {
+		return nil, nil
+	}
+
+	decision, err := s.fraudScreener.Screen(ctx, req)
+	if err != nil {
+		if errors.Is(err, ErrTransactionDeclined) {
+			return nil, ErrTransactionDeclined
+		}
+		return nil, fmt.Errorf("failed to screen money movement: %w", err)
+	}
+	return decision, nil
+}
+
+// holdForReview runs the transfer review triggers and, when any fires, debits the source account
+// and queues the transfer for manual review. A nil review means the transfer executes now.
+func (s *accountService) holdForReview(ctx context.Context, userID uuid.UUID, transfer *models.Transfer, transferType, debitDescription string) (*models.TransferReview, error) {
+	if s.transferReviewer == nil {
+		return nil, nil
+	}
+
+	reasons, err := s.transferReviewer.Evaluate(ctx, &dto.TransferReviewRequest{
+		UserID:            userID,
+		AccountID:         transfer.FromAccountID,
+		ToAccountID:       transfer.ToAccountID,
+		ExternalAccountID: transfer.ToExternalAccountID,
+		Amount:            transfer.Amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate transfer review triggers: %w", err)
+	}
+	if len(reasons) == 0 {
+		return nil, nil
+	}
+
+	review, err := s.transferReviewer.Hold(ctx, userID, transfer, reasons, transferType, debitDescription)
+	if err != nil {
+		return nil, mapDebitErr(err)
+	}
+	return review, nil
+}
+
+// ApproveHeldTransfer releases a transfer held for review. An approved external transfer is
+// submitted to Northwind straight away; if the partner cannot be reached the saga recovery worker
+// submits it later.
+func (s *accountService) ApproveHeldTransfer(ctx context.Context, adminID, reviewID uuid.UUID, note string) (_ *models.TransferReview, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "AccountService.ApproveHeldTransfer")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	if s.transferReviewer == nil {
+		return nil, ErrTransferReviewNotFound
+	}
+
+	review, err := s.transferReviewer.Approve(ctx, adminID, reviewID, note)
+	if err != nil {
+		return nil, err
+	}
+	if !review.Transfer.IsExternal() {
+		return review, nil
+	}
+
+	if err := s.ResumeExternalTransfer(ctx, review.TransferID); err != nil {
+		s.logger.WarnContext(ctx, "approved transfer left for saga recovery", "transfer_id", review.TransferID, "error", err)
+	}
+	transfer, err := s.transferRepo.FindByID(ctx, review.TransferID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload approved transfer: %w", err)
+	}
+	review.Transfer = *transfer
+	return review, nil
+}
+
+// mapDebitErr maps repository errors from posting a transfer debit to service errors.
+func mapDebitErr(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrInsufficientFunds):
+		return ErrInsufficientFunds
+	case errors.Is(err, repositories.ErrAccountNotActive):
+		return ErrAccountNotActive
+	case errors.Is(err, repositories.ErrAccountNotFound), errors.Is(err, repositories.ErrExternalAccountNotFound):
+		return ErrAccountNotFound
+	case errors.Is(err, repositories.ErrUnverifiedPayeeLimitExceeded):
+		return ErrExternalAccountNotVerified
+	}
+	return fmt.Errorf("failed to debit source account: %w", err)
+}
+
+// attachFraudDecision links the screening decision to the recorded transfer or transaction.
+func (s *accountService) attachFraudDecision(ctx context.Context, decision *models.FraudDecision, resourceType string, resourceID uuid.UUID) {
+	if decision == nil {
+		return
+	}
+	if err := s.fraudScreener.AttachResource(ctx, decision.ID, resourceType, resourceID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to attach fraud decision", "error", err, "decision_id", decision.ID, "resource_id", resourceID)
+	}
+}
 
-	if err := s.transferRepo.Create(transfer); err != nil {
-		s.logger.Error("failed to create transfer record, debit must be manually reversed", "error", err, "debit_tx_id", debitTx.ID)
-		return nil, fmt.Errorf("failed to create transfer record: %w", err)
+// ResumeExternalTransfer continues a saga that stopped after the debit was posted, for example
+// because the process crashed before Northwind answered. The transfer is resubmitted with its
+// original idempotency key so the partner never creates it twice; if the partner rejects it or
+// the destination is gone, the debit is compensated.
+func (s *accountService) ResumeExternalTransfer(ctx context.Context, transferID uuid.UUID) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "AccountService.ResumeExternalTransfer")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	saga, err := s.transferSagaRepo.GetByTransferID(ctx, transferID)
+	if err != nil {
+		return err
+	}
+	if saga.Step != models.TransferSagaStepDebitPosted {
+		return nil // Already submitted or finished
 	}
 
+	transfer, err := s.transferRepo.FindByID(ctx, transferID)
+	if err != nil {
+		return fmt.Errorf("failed to load transfer for saga recovery: %w", err)
+	}
+
+	fromAccount, err := s.accountRepo.GetByID(ctx, transfer.FromAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to load source account for saga recovery: %w", err)
+	}
+
+	if transfer.ToExternalAccountID == nil {
+		return s.compensateAfterFailedSubmission(ctx, transfer, "Transfer has no external destination")
+	}
+	toExternalAccount, err := s.externalAccountRepo.GetByID(ctx, *transfer.ToExternalAccountID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrExternalAccountNotFound) {
+			return s.compensateAfterFailedSubmission(ctx, transfer, "External account no longer exists")
+		}
+		return fmt.Errorf("failed to load external account for saga recovery: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "resuming external transfer saga", "transfer_id", transfer.ID, "recovery_attempts", saga.RecoveryAttempts)
+	err = s.submitExternalTransfer(ctx, transfer, fromAccount, toExternalAccount, saga.TransferType)
+	if err != nil && errors.Is(err, ErrExternalTransferFailed) {
+		return nil // Compensated
+	}
+	return err
+}
+
+// submitExternalTransfer sends a transfer whose debit is already posted to Northwind and advances
+// the saga. Unavailability is not treated as a rejection: the saga stays at debit_posted for the
+// recovery worker, since the partner may have accepted the request before the connection dropped.
+func (s *accountService) submitExternalTransfer(ctx context.Context, transfer *models.Transfer, fromAccount *models.Account, toExternalAccount *models.ExternalAccount, transferType string) error {
 	northwindReq := &dto.NorthwindInitiateTransferRequest{
 		SourceAccountID:      fromAccount.AccountNumber,
 		DestinationAccountID: toExternalAccount.ExternalAccountID.String(),
-		Amount:               amount.String(),
+		Amount:               transfer.Amount.String(),
 		Direction:            "debit",
 		TransferType:         transferType,
+		IdempotencyKey:       transfer.IdempotencyKey,
 	}
 
 	northwindResp, err := s.northwindClient.InitiateTransfer(ctx, northwindReq)
 	if err != nil {
-		transfer.Fail(fmt.Sprintf("Northwind API error: %v", err))
-		s.transferRepo.Update(transfer)
-		s.logger.Error("failed to initiate transfer with Northwind, debit must be reversed", "transfer_id", transfer.ID, "debit_tx_id", debitTx.ID)
-		return nil, fmt.Errorf("%w: %v", ErrExternalTransferFailed, err)
+		if errors.Is(err, ErrNorthwindUnavailable) {
+			s.logger.WarnContext(ctx, "northwind unavailable, transfer left for saga recovery", "transfer_id", transfer.ID, "error", err)
+			if recordErr := s.transferSagaRepo.RecordRecoveryFailure(ctx, transfer.ID, err.Error()); recordErr != nil {
+				s.logger.ErrorContext(ctx, "failed to record saga recovery failure", "transfer_id", transfer.ID, "error", recordErr)
+			}
+			return fmt.Errorf("%w: %w", errTransferSubmissionDeferred, err)
+		}
+
+		if compErr := s.compensateAfterFailedSubmission(ctx, transfer, fmt.Sprintf("Northwind API error: %v", err)); compErr != nil {
+			return compErr
+		}
+		return fmt.Errorf("%w: %v", ErrExternalTransferFailed, err)
 	}
 
 	transfer.ExternalTransferID = &northwindResp.ID
-	transfer.Status = northwindResp.Status // e.g., "processing"
-	s.transferRepo.Update(transfer)
+	// A resubmission under the same idempotency key returns the transfer as the partner has it,
+	// which may already be settled. The status monitor only polls transfers still in flight, so a
+	// settled transfer is confirmed or compensated here.
+	switch northwindResp.Status {
+	case models.TransferStatusCompleted:
+		return s.CompleteExternalTransfer(ctx, transfer)
+	case models.TransferStatusFailed:
+		if compErr := s.compensateAfterFailedSubmission(ctx, transfer, "Transfer failed at external bank."); compErr != nil {
+			return compErr
+		}
+		return fmt.Errorf("%w: partner reported the transfer failed", ErrExternalTransferFailed)
+	case models.TransferStatusPending:
+		transfer.Status = models.TransferStatusPending
+	default:
+		transfer.Status = models.TransferStatusProcessing
+	}
+	if err := s.transferSagaRepo.MarkSubmitted(ctx, transfer); err != nil {
+		// The partner has the transfer; the status monitor reconciles it by external ID
+		s.logger.ErrorContext(ctx, "failed to record partner submission for transfer saga", "transfer_id", transfer.ID, "external_transfer_id", northwindResp.ID, "error", err)
+		return fmt.Errorf("failed to record transfer submission: %w", err)
+	}
 
-	return transfer, nil
+	return nil
+}
+
+// compensateAfterFailedSubmission reverses the debit of a transfer the partner rejected.
+func (s *accountService) compensateAfterFailedSubmission(ctx context.Context, transfer *models.Transfer, reason string) error {
+	compensated, _, err := s.compensateExternalTransfer(ctx, transfer, reason)
+	if err != nil {
+		return err
+	}
+	*transfer = *compensated
+	return nil
+}
+
+// compensateExternalTransfer applies the saga compensation for the transfer and audits it.
+func (s *accountService) compensateExternalTransfer(ctx context.Context, transfer *models.Transfer, reason string) (*models.Transfer, bool, error) {
+	compensated, applied, err := s.transferSagaRepo.Compensate(ctx, transfer.ID, reason, reversalDescription(transfer))
+	if err != nil {
+		if !errors.Is(err, repositories.ErrTransferSagaNotFound) {
+			s.logger.Error("CRITICAL: failed to compensate external transfer", "transfer_id", transfer.ID, "error", err)
+			return nil, false, fmt.Errorf("critical: failed to compensate transfer: %w", err)
+		}
+		return nil, false, err
+	}
+	if !applied {
+		s.logger.Warn("external transfer already compensated", "transfer_id", transfer.ID)
+		return compensated, false, nil
+	}
+
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		Action:     "transfer.compensated",
+		Resource:   "transfer",
+		ResourceID: compensated.ID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata: models.JSONBMap{
+			"amount":         compensated.Amount.String(),
+			"reason":         reason,
+			"reversal_tx_id": compensated.ReversalTransactionID.String(),
+		},
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", "transfer.compensated")
+	}
+
+	s.logger.Info("successfully reversed failed external transfer", "transfer_id", compensated.ID, "reversal_tx_id", compensated.ReversalTransactionID)
+	return compensated, true, nil
+}
+
+// reversalDescription describes the credit that reverses a failed external transfer.
+func reversalDescription(transfer *models.Transfer) string {
+	if transfer.ExternalTransferID != nil {
+		return fmt.Sprintf("Reversal for failed transfer ref %s", *transfer.ExternalTransferID)
+	}
+	return "Reversal for failed transfer (no external ref)"
 }
 
 // GetAccountTransactions retrieves transactions for an account
-func (s *accountService) GetAccountTransactions(accountID uuid.UUID, userID *uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
-	_, err := s.GetAccountByID(accountID, userID)
+func (s *accountService) GetAccountTransactions(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
+	_, err := s.GetAccountByID(ctx, accountID, userID)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	transactions, total, err := s.transactionRepo.GetByAccountID(accountID, offset, limit)
+	transactions, total, err := s.transactionRepo.GetByAccountID(ctx, accountID, offset, limit)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get transactions: %w", err)
 	}
@@ -839,13 +1305,13 @@ func (s *accountService) GetAccountTransactions(accountID uuid.UUID, userID *uui
 }
 
 // GetRecentTransactions retrieves recent transactions for an account
-func (s *accountService) GetRecentTransactions(accountID uuid.UUID, userID *uuid.UUID, limit int) ([]models.Transaction, error) {
-	_, err := s.GetAccountByID(accountID, userID)
+func (s *accountService) GetRecentTransactions(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, limit int) ([]models.Transaction, error) {
+	_, err := s.GetAccountByID(ctx, accountID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions, err := s.transactionRepo.GetRecentByAccountID(accountID, limit)
+	transactions, err := s.transactionRepo.GetRecentByAccountID(ctx, accountID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get recent transactions: %w", err)
 	}
@@ -854,8 +1320,8 @@ func (s *accountService) GetRecentTransactions(accountID uuid.UUID, userID *uuid
 }
 
 // GetUserTransfers retrieves transfer history for a user's accounts
-func (s *accountService) GetUserTransfers(userID uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
-	accounts, err := s.GetUserAccounts(userID)
+func (s *accountService) GetUserTransfers(ctx context.Context, userID uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
+	accounts, err := s.GetUserAccounts(ctx, userID)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get user accounts: %w", err)
 	}
@@ -869,7 +1335,7 @@ func (s *accountService) GetUserTransfers(userID uuid.UUID, filters models.Trans
 		accountIDs[i] = account.ID
 	}
 
-	transfers, total, err := s.transferRepo.FindByUserAccountsWithFilters(accountIDs, filters, offset, limit)
+	transfers, total, err := s.transferRepo.FindByUserAccountsWithFilters(ctx, accountIDs, filters, offset, limit)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get user transfers: %w", err)
 	}
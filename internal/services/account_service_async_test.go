@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func (s *AccountServiceSuite) withTransactionQueue() *service_mocks.MockTransactionProcessingServiceInterface {
+	queue := service_mocks.NewMockTransactionProcessingServiceInterface(s.ctrl)
+	s.service.transactionQueue = queue
+	return queue
+}
+
+func (s *AccountServiceSuite) TestSubmitTransaction_QueuesPendingTransaction() {
+	queue := s.withTransactionQueue()
+	transactionID := uuid.New()
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transaction) error {
+		s.Equal(models.TransactionStatusPending, t.Status)
+		s.True(t.BalanceAfter.IsZero())
+		t.ID = transactionID
+		return nil
+	})
+	queue.EXPECT().PriorityForAccountType(models.AccountTypeChecking).Return(models.QueuePriorityHigh)
+	queue.EXPECT().EnqueueTransaction(gomock.Any(), transactionID, models.QueueOperationProcess, models.QueuePriorityHigh).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("transaction.submitted", log.Action)
+		s.Equal(models.QueuePriorityHigh, log.Metadata["priority"])
+		return nil
+	})
+
+	operation, err := s.service.SubmitTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(100), models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+
+	s.Require().NoError(err)
+	s.Equal(transactionID, operation.Transaction.ID)
+	s.Equal(models.TransactionOperationQueued, operation.Status())
+}
+
+func (s *AccountServiceSuite) TestSubmitTransaction_RunsSynchronousChecks() {
+	s.withTransactionQueue()
+	account := s.activeAccount()
+	account.Status = models.AccountStatusInactive
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+
+	operation, err := s.service.SubmitTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(100), models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+
+	s.Nil(operation)
+	s.Equal(ErrAccountNotActive, err)
+
+	_, err = s.service.SubmitTransaction(context.Background(), s.testAccountID, decimal.Zero, models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+	s.Equal(ErrInvalidAmount, err)
+}
+
+func (s *AccountServiceSuite) TestSubmitTransaction_EnqueueFailureFailsTransaction() {
+	queue := s.withTransactionQueue()
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	queue.EXPECT().PriorityForAccountType(gomock.Any()).Return(models.QueuePriorityNormal)
+	queue.EXPECT().EnqueueTransaction(gomock.Any(), gomock.Any(), models.QueueOperationProcess, models.QueuePriorityNormal).Return(errors.New("queue unavailable"))
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), gomock.Any(), gomock.Any(), models.TransactionFailureProcessingFailed).DoAndReturn(func(_ context.Context, t *models.Transaction, _ int, _ string) error {
+		s.Equal(models.TransactionStatusPending, t.Status)
+		return nil
+	})
+
+	operation, err := s.service.SubmitTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(50), models.TransactionTypeCredit, "Deposit", &s.testUserID)
+
+	s.Nil(operation)
+	s.Error(err)
+}
+
+func (s *AccountServiceSuite) TestSubmitTransaction_NoQueue() {
+	operation, err := s.service.SubmitTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(50), models.TransactionTypeCredit, "Deposit", &s.testUserID)
+
+	s.Nil(operation)
+	s.Equal(ErrAsyncProcessingDisabled, err)
+}
+
+func (s *AccountServiceSuite) TestGetTransactionOperation_PendingReportsQueueItem() {
+	queue := s.withTransactionQueue()
+	transaction := &models.Transaction{ID: uuid.New(), AccountID: s.testAccountID, Status: models.TransactionStatusPending}
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transaction.ID).Return(transaction, nil)
+	queue.EXPECT().GetTransactionQueueItem(gomock.Any(), transaction.ID).Return(&models.ProcessingQueueItem{Status: models.QueueStatusProcessing}, nil)
+
+	operation, err := s.service.GetTransactionOperation(context.Background(), s.testAccountID, transaction.ID, &s.testUserID)
+
+	s.Require().NoError(err)
+	s.Equal(models.TransactionOperationProcessing, operation.Status())
+}
+
+func (s *AccountServiceSuite) TestGetTransactionOperation_CompletedSkipsQueue() {
+	s.withTransactionQueue()
+	transaction := &models.Transaction{ID: uuid.New(), AccountID: s.testAccountID, Status: models.TransactionStatusCompleted}
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transaction.ID).Return(transaction, nil)
+
+	operation, err := s.service.GetTransactionOperation(context.Background(), s.testAccountID, transaction.ID, &s.testUserID)
+
+	s.Require().NoError(err)
+	s.Equal(models.TransactionOperationCompleted, operation.Status())
+	s.Nil(operation.QueueItem)
+}
+
+func (s *AccountServiceSuite) TestGetTransactionOperation_OtherAccountsTransaction() {
+	transaction := &models.Transaction{ID: uuid.New(), AccountID: uuid.New(), Status: models.TransactionStatusPending}
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transaction.ID).Return(transaction, nil)
+
+	operation, err := s.service.GetTransactionOperation(context.Background(), s.testAccountID, transaction.ID, &s.testUserID)
+
+	s.Nil(operation)
+	s.Equal(ErrOperationNotFound, err)
+}
+
+func (s *AccountServiceSuite) TestGetTransactionOperation_UnknownOperation() {
+	operationID := uuid.New()
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), operationID).Return(nil, repositories.ErrTransactionNotFound)
+
+	_, err := s.service.GetTransactionOperation(context.Background(), s.testAccountID, operationID, &s.testUserID)
+
+	s.Equal(ErrOperationNotFound, err)
+}
+
+// A queued transaction must leave the same record as one posted synchronously: the same balances
+// and transaction.posted event, and the same transaction.<type> audit log rather than only
+// transaction.submitted.
+func (s *AccountServiceSuite) TestSubmitTransaction_ProcessedLikePerformTransaction() {
+	amount := decimal.NewFromFloat(100)
+
+	var synchronous *models.Transaction
+	var synchronousAudit *models.AuditLog
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transaction) error {
+		t.ID = uuid.New()
+		t.BalanceBefore = decimal.NewFromFloat(500)
+		t.BalanceAfter = decimal.NewFromFloat(400)
+		synchronous = t
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		synchronousAudit = log
+		return nil
+	})
+
+	_, err := s.service.PerformTransaction(context.Background(), s.testAccountID, amount, models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+	s.Require().NoError(err)
+
+	queued := &models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       s.testAccountID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          amount,
+		Description:     "Withdrawal",
+		Status:          models.TransactionStatusPending,
+		Version:         1,
+	}
+	var queuedAudit *models.AuditLog
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().CompletePending(gomock.Any(), queued, 1, gomock.Any()).DoAndReturn(
+		func(_ context.Context, t *models.Transaction, _ int, log *models.AuditLog) error {
+			t.BalanceBefore = decimal.NewFromFloat(500)
+			t.BalanceAfter = decimal.NewFromFloat(400)
+			t.Complete()
+			queuedAudit = log
+			return nil
+		})
+	auditLogger := service_mocks.NewMockAuditLoggerInterface(s.ctrl)
+	auditLogger.EXPECT().LogBalanceUpdate(gomock.Any(), s.testAccountID, "500", "400", queued.ID)
+	auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), queued.ID, models.TransactionStatusPending, models.TransactionStatusCompleted)
+	processing := &TransactionProcessingService{transactionRepo: s.transactionRepo, accountRepo: s.accountRepo, auditLogger: auditLogger}
+
+	s.Require().NoError(processing.processTransaction(context.Background(), queued))
+
+	s.Equal(synchronous.Status, queued.Status)
+	s.True(synchronous.BalanceBefore.Equal(queued.BalanceBefore))
+	s.True(synchronous.BalanceAfter.Equal(queued.BalanceAfter))
+
+	synchronousEvent := models.NewTransactionPostedEvent(synchronous)
+	queuedEvent := models.NewTransactionPostedEvent(queued)
+	s.Require().NotNil(queuedEvent)
+	for _, key := range []string{"account_id", "transaction_type", "amount", "balance_before", "balance_after", "description"} {
+		s.Equal(synchronousEvent.Payload[key], queuedEvent.Payload[key], key)
+	}
+
+	s.Require().NotNil(queuedAudit)
+	s.Equal(synchronousAudit.Action, queuedAudit.Action)
+	s.Equal(synchronousAudit.Resource, queuedAudit.Resource)
+	s.Equal(*synchronousAudit.UserID, *queuedAudit.UserID)
+	s.Equal(synchronousAudit.Metadata, queuedAudit.Metadata)
+	s.Equal(queued.ID.String(), queuedAudit.ResourceID)
+}
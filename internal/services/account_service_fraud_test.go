@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func (s *AccountServiceSuite) withFraudScreener() *service_mocks.MockFraudScreeningServiceInterface {
+	screener := service_mocks.NewMockFraudScreeningServiceInterface(s.ctrl)
+	s.service.fraudScreener = screener
+	return screener
+}
+
+func (s *AccountServiceSuite) activeAccount() *models.Account {
+	return &models.Account{
+		ID:            s.testAccountID,
+		UserID:        s.testUserID,
+		AccountNumber: "1012345678",
+		AccountType:   models.AccountTypeChecking,
+		Balance:       decimal.NewFromFloat(500),
+		Status:        models.AccountStatusActive,
+	}
+}
+
+func (s *AccountServiceSuite) TestPerformTransaction_DebitBlockedByFraudScreening() {
+	screener := s.withFraudScreener()
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	screener.EXPECT().Screen(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *dto.FraudScreeningRequest) (*models.FraudDecision, error) {
+			s.Equal(models.FraudOperationTransaction, req.Operation)
+			s.Equal(s.testUserID, req.UserID)
+			s.True(decimal.NewFromFloat(300).Equal(req.Amount))
+			return &models.FraudDecision{ID: uuid.New(), Outcome: models.FraudOutcomeBlock}, ErrTransactionDeclined
+		})
+
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(300), models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+
+	s.Nil(transaction)
+	s.Equal(ErrTransactionDeclined, err)
+}
+
+func (s *AccountServiceSuite) TestPerformTransaction_ReviewedDebitProceeds() {
+	screener := s.withFraudScreener()
+	decisionID := uuid.New()
+	transactionID := uuid.New()
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	screener.EXPECT().Screen(gomock.Any(), gomock.Any()).Return(&models.FraudDecision{ID: decisionID, Outcome: models.FraudOutcomeReview}, nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transaction) error {
+		t.ID = transactionID
+		return nil
+	})
+	screener.EXPECT().AttachResource(gomock.Any(), decisionID, "transaction", transactionID).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(100), models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+
+	s.NoError(err)
+	s.Equal(transactionID, transaction.ID)
+}
+
+func (s *AccountServiceSuite) TestPerformTransaction_CreditNotScreened() {
+	s.withFraudScreener()
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(50), models.TransactionTypeCredit, "Deposit", &s.testUserID)
+
+	s.NoError(err)
+}
+
+func (s *AccountServiceSuite) TestPerformTransaction_ScreeningErrorFailsClosed() {
+	screener := s.withFraudScreener()
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	screener.EXPECT().Screen(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	_, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(100), models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+
+	s.Error(err)
+	s.NotErrorIs(err, ErrTransactionDeclined)
+}
+
+func (s *AccountServiceSuite) TestTransferBetweenAccounts_BlockedByFraudScreening() {
+	screener := s.withFraudScreener()
+	fromAccount := s.activeAccount()
+	toAccount := &models.Account{
+		ID:            uuid.New(),
+		UserID:        s.testUserID,
+		AccountNumber: "2012345679",
+		AccountType:   models.AccountTypeSavings,
+		Status:        models.AccountStatusActive,
+	}
+	idempotencyKey := uuid.NewString()
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), toAccount.ID).Return(toAccount, nil)
+	screener.EXPECT().Screen(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *dto.FraudScreeningRequest) (*models.FraudDecision, error) {
+			s.Equal(models.FraudOperationInternalTransfer, req.Operation)
+			s.Equal(toAccount.ID, *req.CounterpartyAccountID)
+			return &models.FraudDecision{ID: uuid.New(), Outcome: models.FraudOutcomeBlock}, ErrTransactionDeclined
+		})
+
+	transfer, err := s.service.TransferBetweenAccounts(context.Background(), fromAccount.ID, toAccount.ID, decimal.NewFromFloat(100), "Savings", idempotencyKey, s.testUserID)
+
+	s.Nil(transfer)
+	s.Equal(ErrTransactionDeclined, err)
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_BlockedByFraudScreening() {
+	screener := s.withFraudScreener()
+	fromAccount := s.activeAccount()
+	payee := &models.ExternalAccount{
+		ID:                 uuid.New(),
+		UserID:             s.testUserID,
+		Nickname:           "Landlord",
+		VerificationStatus: models.ExternalAccountStatusVerified,
+	}
+	idempotencyKey := uuid.NewString()
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	screener.EXPECT().Screen(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *dto.FraudScreeningRequest) (*models.FraudDecision, error) {
+			s.Equal(models.FraudOperationExternalTransfer, req.Operation)
+			s.Equal(payee.ID, *req.ExternalAccountID)
+			return &models.FraudDecision{ID: uuid.New(), Outcome: models.FraudOutcomeBlock}, ErrTransactionDeclined
+		})
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(400), "Rent", "standard", idempotencyKey)
+
+	s.Nil(transfer)
+	s.Equal(ErrTransactionDeclined, err)
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_AttachesFraudDecision() {
+	screener := s.withFraudScreener()
+	decisionID := uuid.New()
+	idempotencyKey := uuid.NewString()
+
+	screener.EXPECT().Screen(gomock.Any(), gomock.Any()).Return(&models.FraudDecision{ID: decisionID, Outcome: models.FraudOutcomeAllow}, nil)
+	fromAccount, payee := s.setupSagaTransfer(idempotencyKey)
+	screener.EXPECT().AttachResource(gomock.Any(), decisionID, "transfer", gomock.Any()).Return(nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindInitiateTransferResponse{ID: "nw_tr_1", Status: models.TransferStatusProcessing}, nil)
+	s.transferSagaRepo.EXPECT().MarkSubmitted(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(100), "Rent", "standard", idempotencyKey)
+
+	s.NoError(err)
+}
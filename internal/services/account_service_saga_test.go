@@ -0,0 +1,333 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// setupSagaTransfer prepares mocks for an external transfer up to the saga debit
+func (s *AccountServiceSuite) setupSagaTransfer(idempotencyKey string) (*models.Account, *models.ExternalAccount) {
+	fromAccount := &models.Account{
+		ID:            s.testAccountID,
+		UserID:        s.testUserID,
+		AccountNumber: "1012345678",
+		Balance:       decimal.NewFromFloat(500.00),
+		Status:        models.AccountStatusActive,
+	}
+	payee := &models.ExternalAccount{
+		ID:                 uuid.New(),
+		UserID:             s.testUserID,
+		Nickname:           "Landlord",
+		ExternalAccountID:  uuid.New(),
+		VerificationStatus: models.ExternalAccountStatusVerified,
+	}
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	s.transferSagaRepo.EXPECT().BeginWithDebit(gomock.Any(), gomock.Any(), "standard", "External Transfer to Landlord").
+		DoAndReturn(func(_ context.Context, t *models.Transfer, transferType, _ string) (*models.TransferSaga, error) {
+			t.ID = uuid.New()
+			debitID := uuid.New()
+			t.DebitTransactionID = &debitID
+			return &models.TransferSaga{TransferID: t.ID, Step: models.TransferSagaStepDebitPosted, TransferType: transferType, DebitTransactionID: &debitID}, nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	return fromAccount, payee
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_SagaSubmitted() {
+	idempotencyKey := uuid.NewString()
+	fromAccount, payee := s.setupSagaTransfer(idempotencyKey)
+
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+			s.Equal(idempotencyKey, req.IdempotencyKey)
+			s.Equal(payee.ExternalAccountID.String(), req.DestinationAccountID)
+			return &dto.NorthwindInitiateTransferResponse{ID: "nw_tr_1", Status: models.TransferStatusProcessing}, nil
+		})
+	s.transferSagaRepo.EXPECT().MarkSubmitted(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal("nw_tr_1", *t.ExternalTransferID)
+		s.Equal(models.TransferStatusProcessing, t.Status)
+		return nil
+	})
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(100), "Rent", "standard", idempotencyKey)
+	s.Require().NoError(err)
+	s.Equal(models.TransferStatusProcessing, transfer.Status)
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_PartnerRejectionCompensates() {
+	idempotencyKey := uuid.NewString()
+	fromAccount, payee := s.setupSagaTransfer(idempotencyKey)
+
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Return(nil, ErrNorthwindValidation)
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), gomock.Any(), gomock.Any(), "Reversal for failed transfer (no external ref)").
+		DoAndReturn(func(_ context.Context, id uuid.UUID, reason, _ string) (*models.Transfer, bool, error) {
+			reversalID := uuid.New()
+			t := &models.Transfer{ID: id, Amount: decimal.NewFromFloat(100), ReversalTransactionID: &reversalID}
+			t.Fail(reason)
+			return t, true, nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("transfer.compensated", log.Action)
+		return nil
+	})
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(100), "Rent", "standard", idempotencyKey)
+	s.ErrorIs(err, ErrExternalTransferFailed)
+	s.Nil(transfer)
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_PartnerUnavailableDefersToRecovery() {
+	idempotencyKey := uuid.NewString()
+	fromAccount, payee := s.setupSagaTransfer(idempotencyKey)
+
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Return(nil, ErrNorthwindUnavailable)
+	s.transferSagaRepo.EXPECT().RecordRecoveryFailure(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(100), "Rent", "standard", idempotencyKey)
+	s.Require().NoError(err)
+	s.Equal(models.TransferStatusPending, transfer.Status)
+	s.Nil(transfer.ExternalTransferID)
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_SagaDebitInsufficientFunds() {
+	idempotencyKey := uuid.NewString()
+	fromAccount := &models.Account{ID: s.testAccountID, UserID: s.testUserID, Balance: decimal.NewFromFloat(500), Status: models.AccountStatusActive}
+	payee := &models.ExternalAccount{ID: uuid.New(), UserID: s.testUserID, VerificationStatus: models.ExternalAccountStatusVerified}
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	// The balance changed between the pre-check and the locked debit
+	s.transferSagaRepo.EXPECT().BeginWithDebit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repositories.ErrInsufficientFunds)
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(100), "Rent", "standard", idempotencyKey)
+	s.ErrorIs(err, ErrInsufficientFunds)
+	s.Nil(transfer)
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_UnverifiedPayeeRunningTotalOverLimit() {
+	idempotencyKey := uuid.NewString()
+	fromAccount := &models.Account{ID: s.testAccountID, UserID: s.testUserID, Balance: decimal.NewFromFloat(500), Status: models.AccountStatusActive}
+	payee := &models.ExternalAccount{ID: uuid.New(), UserID: s.testUserID, VerificationStatus: models.ExternalAccountStatusMicroDepositsSent}
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	// The transfer is under the limit on its own, but earlier transfers to the payee count towards it
+	s.transferSagaRepo.EXPECT().BeginWithDebit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repositories.ErrUnverifiedPayeeLimitExceeded)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(50), "Rent", "standard", idempotencyKey)
+	s.Equal(ErrExternalAccountNotVerified, err)
+	s.Nil(transfer)
+}
+
+func (s *AccountServiceSuite) TestHandleFailedExternalTransfer_CompensatesSagaOnce() {
+	externalID := "nw_tr_failed"
+	transfer := &models.Transfer{ID: uuid.New(), ExternalTransferID: &externalID, Amount: decimal.NewFromFloat(40), Status: models.TransferStatusProcessing}
+
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), transfer.ID, "account closed", "Reversal for failed transfer ref nw_tr_failed").
+		DoAndReturn(func(_ context.Context, id uuid.UUID, reason, _ string) (*models.Transfer, bool, error) {
+			reversalID := uuid.New()
+			t := &models.Transfer{ID: id, ExternalTransferID: &externalID, Amount: decimal.NewFromFloat(40), ReversalTransactionID: &reversalID}
+			t.Fail(reason)
+			return t, true, nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	// The legacy reversal path must not run for saga-tracked transfers
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Times(0)
+
+	s.Require().NoError(s.service.HandleFailedExternalTransfer(context.Background(), transfer, "account closed"))
+	s.Equal(models.TransferStatusFailed, transfer.Status)
+	s.NotNil(transfer.ReversalTransactionID)
+}
+
+func (s *AccountServiceSuite) TestHandleFailedExternalTransfer_AlreadyCompensated() {
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusPending}
+
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), transfer.ID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id uuid.UUID, reason, _ string) (*models.Transfer, bool, error) {
+			t := &models.Transfer{ID: id}
+			t.Fail(reason)
+			return t, false, nil
+		})
+
+	s.Require().NoError(s.service.HandleFailedExternalTransfer(context.Background(), transfer, "stale"))
+	s.Equal(models.TransferStatusFailed, transfer.Status)
+}
+
+func (s *AccountServiceSuite) TestHandleFailedExternalTransfer_ConfirmedSagaIsNotReversed() {
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusProcessing}
+
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), transfer.ID, gomock.Any(), gomock.Any()).Return(nil, false, repositories.ErrTransferSagaConfirmed)
+
+	err := s.service.HandleFailedExternalTransfer(context.Background(), transfer, "late failure")
+	s.ErrorIs(err, repositories.ErrTransferSagaConfirmed)
+}
+
+func (s *AccountServiceSuite) TestCompleteExternalTransfer_ConfirmsSaga() {
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusProcessing}
+
+	s.transferSagaRepo.EXPECT().Confirm(gomock.Any(), transfer).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal(models.TransferStatusCompleted, t.Status)
+		s.NotNil(t.CompletedAt)
+		return nil
+	})
+
+	s.NoError(s.service.CompleteExternalTransfer(context.Background(), transfer))
+}
+
+func (s *AccountServiceSuite) TestCompleteExternalTransfer_LegacyTransferWithoutSaga() {
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusProcessing}
+
+	s.transferSagaRepo.EXPECT().Confirm(gomock.Any(), transfer).Return(repositories.ErrTransferSagaNotFound)
+	s.transferRepo.EXPECT().UpdateWithEvents(gomock.Any(), transfer, gomock.Any()).Return(nil)
+
+	s.NoError(s.service.CompleteExternalTransfer(context.Background(), transfer))
+}
+
+func (s *AccountServiceSuite) TestCompleteExternalTransfer_CompensatedSagaIsNotCompleted() {
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusProcessing}
+
+	s.transferSagaRepo.EXPECT().Confirm(gomock.Any(), transfer).Return(repositories.ErrTransferSagaFinished)
+
+	s.ErrorIs(s.service.CompleteExternalTransfer(context.Background(), transfer), repositories.ErrTransferSagaFinished)
+}
+
+func (s *AccountServiceSuite) TestResumeExternalTransfer_ResubmitsWithOriginalKey() {
+	payee := &models.ExternalAccount{ID: uuid.New(), ExternalAccountID: uuid.New()}
+	transfer := &models.Transfer{
+		ID:                  uuid.New(),
+		FromAccountID:       s.testAccountID,
+		ToExternalAccountID: &payee.ID,
+		Amount:              decimal.NewFromFloat(60),
+		IdempotencyKey:      "original-key",
+		Status:              models.TransferStatusPending,
+	}
+
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), transfer.ID).Return(&models.TransferSaga{TransferID: transfer.ID, Step: models.TransferSagaStepDebitPosted, TransferType: "same_day"}, nil)
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(&models.Account{ID: s.testAccountID, AccountNumber: "1012345678"}, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+			s.Equal("original-key", req.IdempotencyKey)
+			s.Equal("same_day", req.TransferType)
+			s.Equal("60", req.Amount)
+			return &dto.NorthwindInitiateTransferResponse{ID: "nw_tr_resumed", Status: models.TransferStatusProcessing}, nil
+		})
+	s.transferSagaRepo.EXPECT().MarkSubmitted(gomock.Any(), transfer).Return(nil)
+
+	s.NoError(s.service.ResumeExternalTransfer(context.Background(), transfer.ID))
+	s.Equal("nw_tr_resumed", *transfer.ExternalTransferID)
+}
+
+// resumableTransfer prepares mocks for resuming a saga left at debit_posted up to the partner call
+func (s *AccountServiceSuite) resumableTransfer() *models.Transfer {
+	payee := &models.ExternalAccount{ID: uuid.New(), ExternalAccountID: uuid.New()}
+	transfer := &models.Transfer{
+		ID:                  uuid.New(),
+		FromAccountID:       s.testAccountID,
+		ToExternalAccountID: &payee.ID,
+		Amount:              decimal.NewFromFloat(60),
+		IdempotencyKey:      "original-key",
+		Status:              models.TransferStatusPending,
+	}
+
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), transfer.ID).Return(&models.TransferSaga{TransferID: transfer.ID, Step: models.TransferSagaStepDebitPosted, TransferType: "standard"}, nil)
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(&models.Account{ID: s.testAccountID, AccountNumber: "1012345678"}, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	return transfer
+}
+
+func (s *AccountServiceSuite) TestResumeExternalTransfer_PartnerAlreadyCompletedConfirmsSaga() {
+	transfer := s.resumableTransfer()
+
+	// The first submission reached the partner, which has since settled the transfer
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindInitiateTransferResponse{ID: "nw_tr_done", Status: models.TransferStatusCompleted}, nil)
+	s.transferSagaRepo.EXPECT().MarkSubmitted(gomock.Any(), gomock.Any()).Times(0)
+	s.transferSagaRepo.EXPECT().Confirm(gomock.Any(), transfer).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal(models.TransferStatusCompleted, t.Status)
+		s.Equal("nw_tr_done", *t.ExternalTransferID)
+		s.NotNil(t.CompletedAt)
+		return nil
+	})
+
+	s.NoError(s.service.ResumeExternalTransfer(context.Background(), transfer.ID))
+	s.Equal(models.TransferStatusCompleted, transfer.Status)
+}
+
+func (s *AccountServiceSuite) TestResumeExternalTransfer_PartnerAlreadyFailedCompensates() {
+	transfer := s.resumableTransfer()
+
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindInitiateTransferResponse{ID: "nw_tr_failed", Status: models.TransferStatusFailed}, nil)
+	s.transferSagaRepo.EXPECT().MarkSubmitted(gomock.Any(), gomock.Any()).Times(0)
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), transfer.ID, "Transfer failed at external bank.", gomock.Any()).
+		DoAndReturn(func(_ context.Context, id uuid.UUID, reason, _ string) (*models.Transfer, bool, error) {
+			reversalID := uuid.New()
+			t := &models.Transfer{ID: id, ReversalTransactionID: &reversalID}
+			t.Fail(reason)
+			return t, true, nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.NoError(s.service.ResumeExternalTransfer(context.Background(), transfer.ID))
+	s.Equal(models.TransferStatusFailed, transfer.Status)
+}
+
+func (s *AccountServiceSuite) TestResumeExternalTransfer_MissingPayeeCompensates() {
+	payeeID := uuid.New()
+	transfer := &models.Transfer{ID: uuid.New(), FromAccountID: s.testAccountID, ToExternalAccountID: &payeeID, Status: models.TransferStatusPending}
+
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), transfer.ID).Return(&models.TransferSaga{TransferID: transfer.ID, Step: models.TransferSagaStepDebitPosted}, nil)
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(&models.Account{ID: s.testAccountID}, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payeeID).Return(nil, repositories.ErrExternalAccountNotFound)
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), transfer.ID, "External account no longer exists", gomock.Any()).
+		DoAndReturn(func(_ context.Context, id uuid.UUID, reason, _ string) (*models.Transfer, bool, error) {
+			reversalID := uuid.New()
+			t := &models.Transfer{ID: id, ReversalTransactionID: &reversalID}
+			t.Fail(reason)
+			return t, true, nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.NoError(s.service.ResumeExternalTransfer(context.Background(), transfer.ID))
+}
+
+func (s *AccountServiceSuite) TestResumeExternalTransfer_StillUnavailable() {
+	payee := &models.ExternalAccount{ID: uuid.New(), ExternalAccountID: uuid.New()}
+	transfer := &models.Transfer{ID: uuid.New(), FromAccountID: s.testAccountID, ToExternalAccountID: &payee.ID, Amount: decimal.NewFromFloat(60), Status: models.TransferStatusPending}
+
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), transfer.ID).Return(&models.TransferSaga{TransferID: transfer.ID, Step: models.TransferSagaStepDebitPosted}, nil)
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(&models.Account{ID: s.testAccountID}, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Return(nil, ErrNorthwindUnavailable)
+	s.transferSagaRepo.EXPECT().RecordRecoveryFailure(gomock.Any(), transfer.ID, gomock.Any()).Return(nil)
+
+	err := s.service.ResumeExternalTransfer(context.Background(), transfer.ID)
+	s.True(errors.Is(err, ErrNorthwindUnavailable))
+}
+
+func (s *AccountServiceSuite) TestResumeExternalTransfer_AlreadySubmitted() {
+	transferID := uuid.New()
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), transferID).Return(&models.TransferSaga{TransferID: transferID, Step: models.TransferSagaStepPartnerSubmitted}, nil)
+
+	s.NoError(s.service.ResumeExternalTransfer(context.Background(), transferID))
+}
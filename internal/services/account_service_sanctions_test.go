@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+)
+
+func (s *AccountServiceSuite) withSanctionsScreener() *service_mocks.MockSanctionsScreeningServiceInterface {
+	screener := service_mocks.NewMockSanctionsScreeningServiceInterface(s.ctrl)
+	s.service.sanctionsScreener = screener
+	return screener
+}
+
+func (s *AccountServiceSuite) TestPerformTransaction_DebitRefusedUnderSanctionsHold() {
+	screener := s.withSanctionsScreener()
+	fraudScreener := s.withFraudScreener()
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	screener.EXPECT().CheckUser(gomock.Any(), s.testUserID).Return(ErrSanctionsHold)
+	fraudScreener.EXPECT().Screen(gomock.Any(), gomock.Any()).Times(0)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Times(0)
+
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(100), models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+
+	s.Nil(transaction)
+	s.Equal(ErrSanctionsHold, err)
+}
+
+func (s *AccountServiceSuite) TestPerformTransaction_CreditAcceptedUnderSanctionsHold() {
+	s.withSanctionsScreener()
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(50), models.TransactionTypeCredit, "Deposit", &s.testUserID)
+
+	s.NoError(err)
+}
+
+func (s *AccountServiceSuite) TestPerformTransaction_SanctionsCheckErrorFailsClosed() {
+	screener := s.withSanctionsScreener()
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(s.activeAccount(), nil)
+	screener.EXPECT().CheckUser(gomock.Any(), s.testUserID).Return(errors.New("db down"))
+
+	_, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(100), models.TransactionTypeDebit, "Withdrawal", &s.testUserID)
+
+	s.ErrorContains(err, "failed to check sanctions status")
+	s.NotErrorIs(err, ErrSanctionsHold)
+}
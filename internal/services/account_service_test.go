@@ -9,6 +9,7 @@ import (
 	"github.com/array/banking-api/internal/models"
 	"github.com/array/banking-api/internal/repositories"
 	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/requestctx"
 	"github.com/array/banking-api/internal/services/service_mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -24,8 +25,8 @@ type AccountServiceSuite struct {
 	transactionRepo     *repository_mocks.MockTransactionRepositoryInterface
 	transferRepo        *repository_mocks.MockTransferRepositoryInterface
 	externalAccountRepo *repository_mocks.MockExternalAccountRepositoryInterface
+	transferSagaRepo    *repository_mocks.MockTransferSagaRepositoryInterface
 	northwindClient     *service_mocks.MockNorthwindClientInterface
-	webhookService      *service_mocks.MockWebhookServiceInterface
 	userRepo            *repository_mocks.MockUserRepositoryInterface
 	auditRepo           *repository_mocks.MockAuditLogRepositoryInterface
 	service             *accountService
@@ -44,16 +45,20 @@ func (s *AccountServiceSuite) SetupTest() {
 	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
 	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
 	s.externalAccountRepo = repository_mocks.NewMockExternalAccountRepositoryInterface(s.ctrl)
+	s.transferSagaRepo = repository_mocks.NewMockTransferSagaRepositoryInterface(s.ctrl)
 	s.northwindClient = service_mocks.NewMockNorthwindClientInterface(s.ctrl)
-	s.webhookService = service_mocks.NewMockWebhookServiceInterface(s.ctrl)
 	s.service = NewAccountService(s.accountRepo,
 		s.transactionRepo,
 		s.transferRepo,
 		s.externalAccountRepo,
-		s.webhookService,
+		s.transferSagaRepo,
 		s.northwindClient,
 		s.userRepo,
 		s.auditRepo,
+		nil,
+		nil,
+		nil,
+		nil,
 		slog.Default()).(*accountService)
 
 	// Setup common test data
@@ -82,11 +87,11 @@ func TestAccountServiceSuite(t *testing.T) {
 // Test CreateAccount functionality
 func (s *AccountServiceSuite) TestCreateAccount_WithInitialDeposit() {
 	// Setup expectations
-	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
-	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "checking").Return(false, nil)
-	s.accountRepo.EXPECT().GenerateUniqueAccountNumber("checking").Return("1012345678", nil)
-	s.accountRepo.EXPECT().CreateWithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
-		func(account *models.Account, transactions []models.Transaction) error {
+	s.userRepo.EXPECT().GetByID(gomock.Any(), s.testUserID).Return(s.testUser, nil)
+	s.accountRepo.EXPECT().ExistsForUser(gomock.Any(), s.testUserID, "checking").Return(false, nil)
+	s.accountRepo.EXPECT().GenerateUniqueAccountNumber(gomock.Any(), "checking").Return("1012345678", nil)
+	s.accountRepo.EXPECT().CreateWithTransaction(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, account *models.Account, transactions []models.Transaction) error {
 			account.ID = s.testAccountID
 			account.CreatedAt = s.testTime
 			account.UpdatedAt = s.testTime
@@ -98,9 +103,9 @@ func (s *AccountServiceSuite) TestCreateAccount_WithInitialDeposit() {
 			}
 			return nil
 		})
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.NewFromFloat(100))
+	account, err := s.service.CreateAccount(context.Background(), s.testUserID, "checking", decimal.NewFromFloat(100))
 	s.NoError(err)
 	s.NotNil(account)
 	s.Equal(s.testUserID, account.UserID)
@@ -111,48 +116,48 @@ func (s *AccountServiceSuite) TestCreateAccount_WithInitialDeposit() {
 }
 
 func (s *AccountServiceSuite) TestCreateAccount_WithoutInitialDeposit() {
-	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
-	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "savings").Return(false, nil)
-	s.accountRepo.EXPECT().GenerateUniqueAccountNumber("savings").Return("2012345679", nil)
-	s.accountRepo.EXPECT().CreateWithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
-		func(account *models.Account, transactions []models.Transaction) error {
+	s.userRepo.EXPECT().GetByID(gomock.Any(), s.testUserID).Return(s.testUser, nil)
+	s.accountRepo.EXPECT().ExistsForUser(gomock.Any(), s.testUserID, "savings").Return(false, nil)
+	s.accountRepo.EXPECT().GenerateUniqueAccountNumber(gomock.Any(), "savings").Return("2012345679", nil)
+	s.accountRepo.EXPECT().CreateWithTransaction(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, account *models.Account, transactions []models.Transaction) error {
 			account.ID = s.testAccountID
 			account.CreatedAt = s.testTime
 			account.UpdatedAt = s.testTime
 			return nil
 		})
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "savings", decimal.Zero)
+	account, err := s.service.CreateAccount(context.Background(), s.testUserID, "savings", decimal.Zero)
 	s.NoError(err)
 	s.NotNil(account)
 	s.Equal(decimal.Zero, account.Balance)
 }
 
 func (s *AccountServiceSuite) TestCreateAccount_UserNotFound() {
-	s.userRepo.EXPECT().GetByID(s.testUserID).Return(nil, repositories.ErrUserNotFound)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), s.testUserID).Return(nil, repositories.ErrUserNotFound)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.Zero)
+	account, err := s.service.CreateAccount(context.Background(), s.testUserID, "checking", decimal.Zero)
 	s.Error(err)
 	s.Nil(account)
 	s.Equal(ErrUserNotFound, err)
 }
 
 func (s *AccountServiceSuite) TestCreateAccount_NegativeInitialDeposit() {
-	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
-	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "checking").Return(false, nil)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), s.testUserID).Return(s.testUser, nil)
+	s.accountRepo.EXPECT().ExistsForUser(gomock.Any(), s.testUserID, "checking").Return(false, nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.NewFromFloat(-100))
+	account, err := s.service.CreateAccount(context.Background(), s.testUserID, "checking", decimal.NewFromFloat(-100))
 	s.Error(err)
 	s.Nil(account)
 	s.Equal(ErrInvalidAmount, err)
 }
 
 func (s *AccountServiceSuite) TestCreateAccount_AccountAlreadyExists() {
-	s.userRepo.EXPECT().GetByID(s.testUserID).Return(s.testUser, nil)
-	s.accountRepo.EXPECT().ExistsForUser(s.testUserID, "checking").Return(true, nil)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), s.testUserID).Return(s.testUser, nil)
+	s.accountRepo.EXPECT().ExistsForUser(gomock.Any(), s.testUserID, "checking").Return(true, nil)
 
-	account, err := s.service.CreateAccount(s.testUserID, "checking", decimal.Zero)
+	account, err := s.service.CreateAccount(context.Background(), s.testUserID, "checking", decimal.Zero)
 	s.Error(err)
 	s.Nil(account)
 	s.Equal(ErrAccountAlreadyExists, err)
@@ -169,9 +174,9 @@ func (s *AccountServiceSuite) TestGetAccountByID_WithoutUserVerification() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
 
-	result, err := s.service.GetAccountByID(s.testAccountID, nil)
+	result, err := s.service.GetAccountByID(context.Background(), s.testAccountID, nil)
 	s.NoError(err)
 	s.Equal(account, result)
 }
@@ -186,10 +191,10 @@ func (s *AccountServiceSuite) TestGetAccountByID_WithOwnerVerification() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
 
 	// Owner can access their own account
-	result, err := s.service.GetAccountByID(s.testAccountID, &s.testUserID)
+	result, err := s.service.GetAccountByID(context.Background(), s.testAccountID, &s.testUserID)
 	s.NoError(err)
 	s.Equal(account, result)
 }
@@ -211,11 +216,11 @@ func (s *AccountServiceSuite) TestGetAccountByID_AdminAccess() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.userRepo.EXPECT().GetByID(adminID).Return(adminUser, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), adminID).Return(adminUser, nil)
 
 	// Admin can access any account
-	result, err := s.service.GetAccountByID(s.testAccountID, &adminID)
+	result, err := s.service.GetAccountByID(context.Background(), s.testAccountID, &adminID)
 	s.NoError(err)
 	s.Equal(account, result)
 }
@@ -236,19 +241,19 @@ func (s *AccountServiceSuite) TestGetAccountByID_UnauthorizedAccess() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.userRepo.EXPECT().GetByID(otherUserID).Return(otherUser, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), otherUserID).Return(otherUser, nil)
 
-	result, err := s.service.GetAccountByID(s.testAccountID, &otherUserID)
+	result, err := s.service.GetAccountByID(context.Background(), s.testAccountID, &otherUserID)
 	s.Error(err)
 	s.Nil(result)
 	s.Equal(ErrUnauthorized, err)
 }
 
 func (s *AccountServiceSuite) TestGetAccountByID_NotFound() {
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(nil, repositories.ErrAccountNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(nil, repositories.ErrAccountNotFound)
 
-	result, err := s.service.GetAccountByID(s.testAccountID, nil)
+	result, err := s.service.GetAccountByID(context.Background(), s.testAccountID, nil)
 	s.Error(err)
 	s.Nil(result)
 	s.Equal(ErrAccountNotFound, err)
@@ -265,18 +270,17 @@ func (s *AccountServiceSuite) TestPerformTransaction_Credit() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.accountRepo.EXPECT().UpdateBalance(s.testAccountID, decimal.NewFromFloat(50), "credit").Return(nil)
-	s.transactionRepo.EXPECT().Create(gomock.Any()).DoAndReturn(
-		func(t *models.Transaction) error {
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, t *models.Transaction) error {
 			t.ID = uuid.New()
 			t.CreatedAt = s.testTime
 			t.UpdatedAt = s.testTime
 			return nil
 		})
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	transaction, err := s.service.PerformTransaction(s.testAccountID, decimal.NewFromFloat(50), "credit", "Deposit", &s.testUserID)
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(50), "credit", "Deposit", &s.testUserID)
 	s.NoError(err)
 	s.NotNil(transaction)
 	s.Equal(decimal.NewFromFloat(50), transaction.Amount)
@@ -293,24 +297,47 @@ func (s *AccountServiceSuite) TestPerformTransaction_Debit() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.accountRepo.EXPECT().UpdateBalance(s.testAccountID, decimal.NewFromFloat(100), "debit").Return(nil)
-	s.transactionRepo.EXPECT().Create(gomock.Any()).DoAndReturn(
-		func(t *models.Transaction) error {
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, t *models.Transaction) error {
 			t.ID = uuid.New()
 			t.CreatedAt = s.testTime
 			t.UpdatedAt = s.testTime
 			return nil
 		})
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	transaction, err := s.service.PerformTransaction(s.testAccountID, decimal.NewFromFloat(100), "debit", "Withdrawal", &s.testUserID)
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(100), "debit", "Withdrawal", &s.testUserID)
 	s.NoError(err)
 	s.NotNil(transaction)
 	s.Equal(decimal.NewFromFloat(100), transaction.Amount)
 	s.Equal("debit", transaction.TransactionType)
 }
 
+func (s *AccountServiceSuite) TestPerformTransaction_AuditsRequestCaller() {
+	account := &models.Account{
+		ID:            s.testAccountID,
+		UserID:        s.testUserID,
+		AccountNumber: "1012345678",
+		AccountType:   "checking",
+		Balance:       decimal.NewFromFloat(500),
+		Status:        "active",
+	}
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, log *models.AuditLog) error {
+			s.Equal("203.0.113.7", log.IPAddress)
+			s.Equal("banking-app/2.1", log.UserAgent)
+			return nil
+		})
+
+	ctx := requestctx.WithClient(context.Background(), "203.0.113.7", "banking-app/2.1")
+	_, err := s.service.PerformTransaction(ctx, s.testAccountID, decimal.NewFromFloat(50), "credit", "Deposit", &s.testUserID)
+	s.NoError(err)
+}
+
 func (s *AccountServiceSuite) TestPerformTransaction_InsufficientFunds() {
 	account := &models.Account{
 		ID:            s.testAccountID,
@@ -321,10 +348,10 @@ func (s *AccountServiceSuite) TestPerformTransaction_InsufficientFunds() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.accountRepo.EXPECT().UpdateBalance(s.testAccountID, decimal.NewFromFloat(1000), "debit").Return(repositories.ErrInsufficientFunds)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).Return(repositories.ErrInsufficientFunds)
 
-	transaction, err := s.service.PerformTransaction(s.testAccountID, decimal.NewFromFloat(1000), "debit", "Large withdrawal", &s.testUserID)
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(1000), "debit", "Large withdrawal", &s.testUserID)
 	s.Error(err)
 	s.Nil(transaction)
 	s.Equal(ErrInsufficientFunds, err)
@@ -340,16 +367,16 @@ func (s *AccountServiceSuite) TestPerformTransaction_InactiveAccount() {
 		Status:        "inactive",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(inactiveAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(inactiveAccount, nil)
 
-	transaction, err := s.service.PerformTransaction(s.testAccountID, decimal.NewFromFloat(50), "credit", "Deposit", &s.testUserID)
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(50), "credit", "Deposit", &s.testUserID)
 	s.Error(err)
 	s.Nil(transaction)
 	s.Equal(ErrAccountNotActive, err)
 }
 
 func (s *AccountServiceSuite) TestPerformTransaction_InvalidAmount() {
-	transaction, err := s.service.PerformTransaction(s.testAccountID, decimal.NewFromFloat(-50), "credit", "Invalid", nil)
+	transaction, err := s.service.PerformTransaction(context.Background(), s.testAccountID, decimal.NewFromFloat(-50), "credit", "Invalid", nil)
 	s.Error(err)
 	s.Nil(transaction)
 	s.Equal(ErrInvalidAmount, err)
@@ -385,24 +412,24 @@ func (s *AccountServiceSuite) TestTransferBetweenAccounts_Success() {
 
 	// Check for existing transfer with idempotency key
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get both accounts
-	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil)
-	s.accountRepo.EXPECT().GetByID(toAccountID).Return(toAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil)
 
 	// Create transfer entity with pending status
 	s.transferRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(transfer *models.Transfer) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transfer *models.Transfer) error {
 			transfer.ID = uuid.New()
 			return nil
 		})
 
 	// Execute atomic transfer
 	s.accountRepo.EXPECT().
-		ExecuteAtomicTransfer(
+		ExecuteAtomicTransfer(gomock.Any(),
 			fromAccountID,
 			toAccountID,
 			amount,
@@ -411,27 +438,29 @@ func (s *AccountServiceSuite) TestTransferBetweenAccounts_Success() {
 		).
 		Return(debitTxID, creditTxID, nil)
 
-	// Update transfer status to completed
+	// Update transfer status to completed with its outbox event
 	s.transferRepo.EXPECT().
-		Update(gomock.Any()).
-		Return(nil)
+		UpdateWithEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transfer *models.Transfer, events ...*models.OutboxEvent) error {
+			s.Require().Len(events, 1)
+			s.Equal(models.EventTypeTransferCompleted, events[0].EventType)
+			s.Equal(transfer.ID, events[0].AggregateID)
+			return nil
+		})
 
 	// Audit log for successful transfer
 	s.auditRepo.EXPECT().
-		Create(gomock.Any()).
+		Create(gomock.Any(), gomock.Any()).
 		Return(nil)
 
-	s.webhookService.EXPECT().
-		QueueTransferNotification(gomock.Any(), gomock.Any()).Return(nil)
-
-	_, err := s.service.TransferBetweenAccounts(fromAccountID, toAccountID, amount, "Transfer funds", idempotencyKey, s.testUserID)
+	_, err := s.service.TransferBetweenAccounts(context.Background(), fromAccountID, toAccountID, amount, "Transfer funds", idempotencyKey, s.testUserID)
 	s.NoError(err)
 }
 
 func (s *AccountServiceSuite) TestTransferBetweenAccounts_SameAccount() {
 	accountID := uuid.New()
 
-	_, err := s.service.TransferBetweenAccounts(accountID, accountID, decimal.NewFromFloat(100), "placeholder-description", "placeholder-idempotency-key", s.testUserID)
+	_, err := s.service.TransferBetweenAccounts(context.Background(), accountID, accountID, decimal.NewFromFloat(100), "placeholder-description", "placeholder-idempotency-key", s.testUserID)
 	s.Error(err)
 	s.Equal(ErrSameAccountTransfer, err)
 }
@@ -440,7 +469,7 @@ func (s *AccountServiceSuite) TestTransferBetweenAccounts_InvalidAmount() {
 	fromAccountID := uuid.New()
 	toAccountID := uuid.New()
 
-	_, err := s.service.TransferBetweenAccounts(fromAccountID, toAccountID, decimal.NewFromFloat(-100), "placeholder-description", "placeholder-idempotency-key", s.testUserID)
+	_, err := s.service.TransferBetweenAccounts(context.Background(), fromAccountID, toAccountID, decimal.NewFromFloat(-100), "placeholder-description", "placeholder-idempotency-key", s.testUserID)
 	s.Error(err)
 	s.Equal(ErrInvalidAmount, err)
 }
@@ -466,9 +495,9 @@ func (s *AccountServiceSuite) TestGetUserAccounts() {
 		},
 	}
 
-	s.accountRepo.EXPECT().GetByUserID(s.testUserID).Return(expectedAccounts, nil)
+	s.accountRepo.EXPECT().GetByUserID(gomock.Any(), s.testUserID).Return(expectedAccounts, nil)
 
-	accounts, err := s.service.GetUserAccounts(s.testUserID)
+	accounts, err := s.service.GetUserAccounts(context.Background(), s.testUserID)
 	s.NoError(err)
 	s.Len(accounts, 2)
 	s.Equal(expectedAccounts, accounts)
@@ -485,15 +514,15 @@ func (s *AccountServiceSuite) TestCloseAccount_Success() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
-	s.accountRepo.EXPECT().Update(gomock.Any()).DoAndReturn(
-		func(a *models.Account) error {
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
+	s.accountRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *models.Account) error {
 			s.Equal("closed", a.Status)
 			return nil
 		})
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 
-	err := s.service.CloseAccount(s.testAccountID, s.testUserID)
+	err := s.service.CloseAccount(context.Background(), s.testAccountID, s.testUserID)
 	s.NoError(err)
 }
 
@@ -507,9 +536,9 @@ func (s *AccountServiceSuite) TestCloseAccount_NonZeroBalance() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
 
-	err := s.service.CloseAccount(s.testAccountID, s.testUserID)
+	err := s.service.CloseAccount(context.Background(), s.testAccountID, s.testUserID)
 	s.Error(err)
 	s.Equal(ErrAccountClosureNotAllowed, err)
 }
@@ -525,16 +554,16 @@ func (s *AccountServiceSuite) TestCloseAccount_Unauthorized() {
 		Status:        "active",
 	}
 
-	s.accountRepo.EXPECT().GetByID(s.testAccountID).Return(account, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(account, nil)
 
 	// GetAccountByID will check if user is admin since account belongs to different user
 	user := &models.User{
 		ID:   s.testUserID,
 		Role: models.RoleCustomer, // Not admin
 	}
-	s.userRepo.EXPECT().GetByID(s.testUserID).Return(user, nil)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), s.testUserID).Return(user, nil)
 
-	err := s.service.CloseAccount(s.testAccountID, s.testUserID)
+	err := s.service.CloseAccount(context.Background(), s.testAccountID, s.testUserID)
 	s.Error(err)
 	s.Equal(ErrUnauthorized, err)
 }
@@ -554,34 +583,34 @@ func (s *AccountServiceSuite) TestHandleFailedExternalTransfer_Success() {
 	fromAccount := &models.Account{
 		ID:     fromAccountID,
 		UserID: s.testUserID,
+		Status: models.AccountStatusActive,
 	}
 
 	reversalTx := &models.Transaction{
 		ID: uuid.New(),
 	}
 
+	// Transfers without a saga fall back to a direct reversal
+	s.transferSagaRepo.EXPECT().Compensate(gomock.Any(), transfer.ID, "API error", gomock.Any()).Return(nil, false, repositories.ErrTransferSagaNotFound)
 	// Expect GetByID for the reversal process
-	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil).Times(2)
-	// Expect balance update for the reversal
-	s.accountRepo.EXPECT().UpdateBalance(fromAccountID, amount, models.TransactionTypeCredit).Return(nil)
-	// Expect creation of the reversal transaction
-	s.transactionRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(tx *models.Transaction) error {
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil).Times(2)
+	// Expect the reversal to be posted
+	s.transactionRepo.EXPECT().Post(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *models.Transaction) error {
 		tx.ID = reversalTx.ID
 		return nil
 	})
 	// Expect audit log for the reversal transaction
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil)
-	// Expect the final update to the transfer record
-	s.transferRepo.EXPECT().Update(gomock.Any()).DoAndReturn(func(t *models.Transfer) error {
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	// Expect the final update to the transfer record together with the failure event
+	s.transferRepo.EXPECT().UpdateWithEvents(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer, events ...*models.OutboxEvent) error {
 		s.Equal(models.TransferStatusFailed, t.Status)
 		s.Equal(&reversalTx.ID, t.ReversalTransactionID)
 		s.NotNil(t.ErrorMessage)
+		s.Require().Len(events, 1)
+		s.Equal(models.EventTypeTransferFailed, events[0].EventType)
 		return nil
 	})
 
-	// Expect webhook to be queued for the failed transfer
-	s.webhookService.EXPECT().QueueTransferNotification(gomock.Any(), gomock.Any()).Return(nil)
-
 	err := s.service.HandleFailedExternalTransfer(context.Background(), transfer, "API error")
 	s.NoError(err)
 }
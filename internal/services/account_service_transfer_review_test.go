@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func (s *AccountServiceSuite) withTransferReviewer() *service_mocks.MockTransferReviewServiceInterface {
+	reviewer := service_mocks.NewMockTransferReviewServiceInterface(s.ctrl)
+	s.service.transferReviewer = reviewer
+	return reviewer
+}
+
+func (s *AccountServiceSuite) heldReview(transfer *models.Transfer) *models.TransferReview {
+	transfer.ID = uuid.New()
+	transfer.Status = models.TransferStatusHeldForReview
+	return &models.TransferReview{
+		ID:         uuid.New(),
+		TransferID: transfer.ID,
+		Status:     models.TransferReviewStatusPending,
+		Transfer:   *transfer,
+	}
+}
+
+func (s *AccountServiceSuite) TestTransferBetweenAccounts_HeldForReview() {
+	reviewer := s.withTransferReviewer()
+	fromAccount := s.activeAccount()
+	toAccount := &models.Account{
+		ID:            uuid.New(),
+		UserID:        s.testUserID,
+		AccountNumber: "2012345679",
+		AccountType:   models.AccountTypeSavings,
+		Status:        models.AccountStatusActive,
+	}
+	idempotencyKey := uuid.NewString()
+	reasons := []dto.TransferReviewReason{{Trigger: TransferReviewTriggerAmountThreshold, Reason: "large"}}
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), toAccount.ID).Return(toAccount, nil)
+	reviewer.EXPECT().Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *dto.TransferReviewRequest) ([]dto.TransferReviewReason, error) {
+			s.Equal(toAccount.ID, *req.ToAccountID)
+			s.Nil(req.ExternalAccountID)
+			return reasons, nil
+		})
+	reviewer.EXPECT().Hold(gomock.Any(), s.testUserID, gomock.Any(), reasons, "", "Transfer to 2012345679: Savings").
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, transfer *models.Transfer, _ []dto.TransferReviewReason, _, _ string) (*models.TransferReview, error) {
+			return s.heldReview(transfer), nil
+		})
+	s.transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	transfer, err := s.service.TransferBetweenAccounts(context.Background(), fromAccount.ID, toAccount.ID, decimal.NewFromFloat(300), "Savings", idempotencyKey, s.testUserID)
+	s.Require().NoError(err)
+	s.True(transfer.IsHeldForReview())
+	s.Equal(idempotencyKey, transfer.IdempotencyKey)
+}
+
+func (s *AccountServiceSuite) TestTransferBetweenAccounts_HeldTransferReturnedOnRetry() {
+	idempotencyKey := uuid.NewString()
+	held := &models.Transfer{ID: uuid.New(), IdempotencyKey: idempotencyKey, Status: models.TransferStatusHeldForReview}
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(held, nil)
+
+	transfer, err := s.service.TransferBetweenAccounts(context.Background(), s.testAccountID, uuid.New(), decimal.NewFromFloat(300), "Savings", idempotencyKey, s.testUserID)
+	s.Require().NoError(err)
+	s.Equal(held, transfer)
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_HeldBeforeDebit() {
+	reviewer := s.withTransferReviewer()
+	fromAccount := s.activeAccount()
+	payee := &models.ExternalAccount{
+		ID:                 uuid.New(),
+		UserID:             s.testUserID,
+		Nickname:           "Landlord",
+		VerificationStatus: models.ExternalAccountStatusVerified,
+	}
+	idempotencyKey := uuid.NewString()
+	reasons := []dto.TransferReviewReason{{Trigger: TransferReviewTriggerFirstPayee, Reason: "first transfer to this payee"}}
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	reviewer.EXPECT().Evaluate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *dto.TransferReviewRequest) ([]dto.TransferReviewReason, error) {
+			s.Equal(payee.ID, *req.ExternalAccountID)
+			return reasons, nil
+		})
+	reviewer.EXPECT().Hold(gomock.Any(), s.testUserID, gomock.Any(), reasons, "standard", "External Transfer to Landlord").
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, transfer *models.Transfer, _ []dto.TransferReviewReason, _, _ string) (*models.TransferReview, error) {
+			return s.heldReview(transfer), nil
+		})
+	s.transferSagaRepo.EXPECT().BeginWithDebit(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(100), "Rent", "standard", idempotencyKey)
+	s.Require().NoError(err)
+	s.True(transfer.IsHeldForReview())
+}
+
+func (s *AccountServiceSuite) TestInitiateExternalTransfer_HoldInsufficientFunds() {
+	reviewer := s.withTransferReviewer()
+	fromAccount := s.activeAccount()
+	payee := &models.ExternalAccount{ID: uuid.New(), UserID: s.testUserID, VerificationStatus: models.ExternalAccountStatusVerified}
+	idempotencyKey := uuid.NewString()
+
+	s.transferRepo.EXPECT().FindByIdempotencyKey(gomock.Any(), idempotencyKey).Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccount.ID).Return(fromAccount, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	reviewer.EXPECT().Evaluate(gomock.Any(), gomock.Any()).Return([]dto.TransferReviewReason{{Trigger: TransferReviewTriggerFirstPayee}}, nil)
+	// The balance changed between the pre-check and the locked debit
+	reviewer.EXPECT().Hold(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repositories.ErrInsufficientFunds)
+
+	transfer, err := s.service.InitiateExternalTransfer(context.Background(), s.testUserID, fromAccount.ID, payee.ID, decimal.NewFromFloat(100), "Rent", "standard", idempotencyKey)
+	s.ErrorIs(err, ErrInsufficientFunds)
+	s.Nil(transfer)
+}
+
+func (s *AccountServiceSuite) TestApproveHeldTransfer_InternalReturnsReview() {
+	reviewer := s.withTransferReviewer()
+	adminID := uuid.New()
+	review := &models.TransferReview{
+		ID:       uuid.New(),
+		Status:   models.TransferReviewStatusApproved,
+		Transfer: models.Transfer{ID: uuid.New(), ToAccountID: &s.testAccountID, Status: models.TransferStatusCompleted},
+	}
+	reviewer.EXPECT().Approve(gomock.Any(), adminID, review.ID, "Confirmed").Return(review, nil)
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), gomock.Any()).Times(0)
+
+	approved, err := s.service.ApproveHeldTransfer(context.Background(), adminID, review.ID, "Confirmed")
+	s.Require().NoError(err)
+	s.Equal(review, approved)
+}
+
+func (s *AccountServiceSuite) TestApproveHeldTransfer_ExternalSubmitsToPartner() {
+	reviewer := s.withTransferReviewer()
+	adminID := uuid.New()
+	payee := &models.ExternalAccount{ID: uuid.New(), ExternalAccountID: uuid.New()}
+	transfer := &models.Transfer{
+		ID:                  uuid.New(),
+		FromAccountID:       s.testAccountID,
+		ToExternalAccountID: &payee.ID,
+		Amount:              decimal.NewFromFloat(60),
+		IdempotencyKey:      "held-key",
+		Status:              models.TransferStatusPending,
+	}
+	review := &models.TransferReview{ID: uuid.New(), TransferID: transfer.ID, Status: models.TransferReviewStatusApproved, Transfer: *transfer}
+
+	reviewer.EXPECT().Approve(gomock.Any(), adminID, review.ID, "Known payee").Return(review, nil)
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), transfer.ID).Return(&models.TransferSaga{TransferID: transfer.ID, Step: models.TransferSagaStepDebitPosted, TransferType: "standard"}, nil)
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil).Times(2)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(&models.Account{ID: s.testAccountID, AccountNumber: "1012345678"}, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+			s.Equal("held-key", req.IdempotencyKey)
+			return &dto.NorthwindInitiateTransferResponse{ID: "nw_tr_approved", Status: models.TransferStatusProcessing}, nil
+		})
+	s.transferSagaRepo.EXPECT().MarkSubmitted(gomock.Any(), transfer).Return(nil)
+
+	approved, err := s.service.ApproveHeldTransfer(context.Background(), adminID, review.ID, "Known payee")
+	s.Require().NoError(err)
+	s.Equal("nw_tr_approved", *approved.Transfer.ExternalTransferID)
+}
+
+func (s *AccountServiceSuite) TestApproveHeldTransfer_PartnerUnavailableStillApproved() {
+	reviewer := s.withTransferReviewer()
+	adminID := uuid.New()
+	payee := &models.ExternalAccount{ID: uuid.New(), ExternalAccountID: uuid.New()}
+	transfer := &models.Transfer{ID: uuid.New(), FromAccountID: s.testAccountID, ToExternalAccountID: &payee.ID, Amount: decimal.NewFromFloat(60), Status: models.TransferStatusPending}
+	review := &models.TransferReview{ID: uuid.New(), TransferID: transfer.ID, Status: models.TransferReviewStatusApproved, Transfer: *transfer}
+
+	reviewer.EXPECT().Approve(gomock.Any(), adminID, review.ID, "Known payee").Return(review, nil)
+	s.transferSagaRepo.EXPECT().GetByTransferID(gomock.Any(), transfer.ID).Return(&models.TransferSaga{TransferID: transfer.ID, Step: models.TransferSagaStepDebitPosted}, nil)
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil).Times(2)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), s.testAccountID).Return(&models.Account{ID: s.testAccountID}, nil)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), payee.ID).Return(payee, nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Return(nil, ErrNorthwindUnavailable)
+	s.transferSagaRepo.EXPECT().RecordRecoveryFailure(gomock.Any(), transfer.ID, gomock.Any()).Return(nil)
+
+	approved, err := s.service.ApproveHeldTransfer(context.Background(), adminID, review.ID, "Known payee")
+	s.Require().NoError(err)
+	s.Equal(models.TransferStatusPending, approved.Transfer.Status)
+}
+
+func (s *AccountServiceSuite) TestApproveHeldTransfer_DecisionError() {
+	reviewer := s.withTransferReviewer()
+	reviewer.EXPECT().Approve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, ErrTransferReviewDecided)
+
+	_, err := s.service.ApproveHeldTransfer(context.Background(), uuid.New(), uuid.New(), "Again")
+	s.ErrorIs(err, ErrTransferReviewDecided)
+}
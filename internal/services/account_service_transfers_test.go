@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"log/slog"
 	"testing"
@@ -22,6 +23,7 @@ type TransferServiceTestSuite struct {
 	accountRepo     *repository_mocks.MockAccountRepositoryInterface
 	transactionRepo *repository_mocks.MockTransactionRepositoryInterface
 	transferRepo    *repository_mocks.MockTransferRepositoryInterface
+	externalRepo    *repository_mocks.MockExternalAccountRepositoryInterface
 	userRepo        *repository_mocks.MockUserRepositoryInterface
 	auditRepo       *repository_mocks.MockAuditLogRepositoryInterface
 	db              *gorm.DB
@@ -34,6 +36,7 @@ func (s *TransferServiceTestSuite) SetupTest() {
 	s.accountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
 	s.transactionRepo = repository_mocks.NewMockTransactionRepositoryInterface(s.ctrl)
 	s.transferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
+	s.externalRepo = repository_mocks.NewMockExternalAccountRepositoryInterface(s.ctrl)
 	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
 	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
 
@@ -42,8 +45,15 @@ func (s *TransferServiceTestSuite) SetupTest() {
 		s.accountRepo,
 		s.transactionRepo,
 		s.transferRepo,
+		s.externalRepo,
+		nil,
+		nil,
 		s.userRepo,
 		s.auditRepo,
+		nil,
+		nil,
+		nil,
+		nil,
 		slog.Default(),
 	)
 }
@@ -79,7 +89,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Success() {
 
 	toAccount := &models.Account{
 		ID:            toAccountID,
-		UserID:        uuid.New(),
+		UserID:        userID,
 		AccountNumber: "2023456789",
 		AccountType:   models.AccountTypeSavings,
 		Balance:       decimal.NewFromFloat(200.00),
@@ -88,25 +98,25 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Success() {
 
 	// Check for existing transfer with idempotency key
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get source account
 	s.accountRepo.EXPECT().
-		GetByID(fromAccountID).
+		GetByID(gomock.Any(), fromAccountID).
 		Return(fromAccount, nil)
 
 	// Get destination account
 	s.accountRepo.EXPECT().
-		GetByID(toAccountID).
+		GetByID(gomock.Any(), toAccountID).
 		Return(toAccount, nil)
 
 	// Create transfer entity with pending status
 	s.transferRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(transfer *models.Transfer) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transfer *models.Transfer) error {
 			s.Equal(fromAccountID, transfer.FromAccountID)
-			s.Equal(toAccountID, transfer.ToAccountID)
+			s.Equal(&toAccountID, transfer.ToAccountID)
 			s.True(amount.Equal(transfer.Amount))
 			s.Equal(idempotencyKey, transfer.IdempotencyKey)
 			s.Equal(models.TransferStatusPending, transfer.Status)
@@ -116,7 +126,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Success() {
 
 	// Execute atomic transfer
 	s.accountRepo.EXPECT().
-		ExecuteAtomicTransfer(
+		ExecuteAtomicTransfer(gomock.Any(),
 			fromAccountID,
 			toAccountID,
 			amount,
@@ -127,8 +137,8 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Success() {
 
 	// Update transfer status to completed
 	s.transferRepo.EXPECT().
-		Update(gomock.Any()).
-		DoAndReturn(func(transfer *models.Transfer) error {
+		UpdateWithEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transfer *models.Transfer, _ ...*models.OutboxEvent) error {
 			s.Equal(models.TransferStatusCompleted, transfer.Status)
 			s.Equal(&debitTxID, transfer.DebitTransactionID)
 			s.Equal(&creditTxID, transfer.CreditTransactionID)
@@ -137,10 +147,10 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Success() {
 
 	// Audit log for successful transfer
 	s.auditRepo.EXPECT().
-		Create(gomock.Any()).
+		Create(gomock.Any(), gomock.Any()).
 		Return(nil)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -165,7 +175,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_IdempotencyKeyExi
 	existingTransfer := &models.Transfer{
 		ID:             uuid.New(),
 		FromAccountID:  fromAccountID,
-		ToAccountID:    toAccountID,
+		ToAccountID:    &toAccountID,
 		Amount:         amount,
 		IdempotencyKey: idempotencyKey,
 		Status:         models.TransferStatusCompleted,
@@ -173,10 +183,10 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_IdempotencyKeyExi
 
 	// Check for existing transfer - found completed
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(existingTransfer, nil)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -202,7 +212,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_IdempotencyKeyExi
 	existingTransfer := &models.Transfer{
 		ID:             uuid.New(),
 		FromAccountID:  fromAccountID,
-		ToAccountID:    toAccountID,
+		ToAccountID:    &toAccountID,
 		Amount:         amount,
 		IdempotencyKey: idempotencyKey,
 		Status:         models.TransferStatusPending,
@@ -210,10 +220,10 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_IdempotencyKeyExi
 
 	// Check for existing transfer - found pending
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(existingTransfer, nil)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -238,7 +248,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_IdempotencyKeyExi
 	existingTransfer := &models.Transfer{
 		ID:             uuid.New(),
 		FromAccountID:  fromAccountID,
-		ToAccountID:    toAccountID,
+		ToAccountID:    &toAccountID,
 		Amount:         amount,
 		IdempotencyKey: idempotencyKey,
 		Status:         models.TransferStatusFailed,
@@ -246,10 +256,10 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_IdempotencyKeyExi
 
 	// Check for existing transfer - found failed
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(existingTransfer, nil)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -282,7 +292,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InsufficientFunds
 
 	toAccount := &models.Account{
 		ID:            toAccountID,
-		UserID:        uuid.New(),
+		UserID:        userID,
 		AccountNumber: "2023456789",
 		AccountType:   models.AccountTypeSavings,
 		Balance:       decimal.NewFromFloat(200.00),
@@ -291,24 +301,24 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InsufficientFunds
 
 	// Check for existing transfer
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get accounts
-	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil)
-	s.accountRepo.EXPECT().GetByID(toAccountID).Return(toAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil)
 
 	// Create transfer with pending status
 	s.transferRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(transfer *models.Transfer) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transfer *models.Transfer) error {
 			transfer.ID = uuid.New()
 			return nil
 		})
 
 	// Execute atomic transfer - should fail with insufficient funds
 	s.accountRepo.EXPECT().
-		ExecuteAtomicTransfer(
+		ExecuteAtomicTransfer(gomock.Any(),
 			fromAccountID,
 			toAccountID,
 			amount,
@@ -319,8 +329,8 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InsufficientFunds
 
 	// Update transfer to failed
 	s.transferRepo.EXPECT().
-		Update(gomock.Any()).
-		DoAndReturn(func(transfer *models.Transfer) error {
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transfer *models.Transfer) error {
 			s.Equal(models.TransferStatusFailed, transfer.Status)
 			s.NotNil(transfer.ErrorMessage)
 			s.NotNil(transfer.FailedAt)
@@ -328,9 +338,9 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InsufficientFunds
 		})
 
 	// Audit log for failed transfer
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -351,7 +361,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_SameAccount() {
 	amount := decimal.NewFromFloat(100.00)
 	idempotencyKey := uuid.New().String()
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		accountID,
 		accountID,
 		amount,
@@ -373,7 +383,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InvalidAmount() {
 	amount := decimal.NewFromFloat(-50.00)
 	idempotencyKey := uuid.New().String()
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -397,15 +407,15 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_SourceAccountNotF
 
 	// Check for existing transfer
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get source account - not found
 	s.accountRepo.EXPECT().
-		GetByID(fromAccountID).
+		GetByID(gomock.Any(), fromAccountID).
 		Return(nil, repositories.ErrAccountNotFound)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -438,20 +448,20 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_DestinationAccoun
 
 	// Check for existing transfer
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get source account
 	s.accountRepo.EXPECT().
-		GetByID(fromAccountID).
+		GetByID(gomock.Any(), fromAccountID).
 		Return(fromAccount, nil)
 
 	// Get destination account - not found
 	s.accountRepo.EXPECT().
-		GetByID(toAccountID).
+		GetByID(gomock.Any(), toAccountID).
 		Return(nil, repositories.ErrAccountNotFound)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -494,20 +504,20 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_Unauthorized() {
 
 	// Check for existing transfer
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get source account
 	s.accountRepo.EXPECT().
-		GetByID(fromAccountID).
+		GetByID(gomock.Any(), fromAccountID).
 		Return(fromAccount, nil)
 
 	// Get destination account
 	s.accountRepo.EXPECT().
-		GetByID(toAccountID).
+		GetByID(gomock.Any(), toAccountID).
 		Return(toAccount, nil)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -540,7 +550,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InactiveSourceAcc
 
 	toAccount := &models.Account{
 		ID:            toAccountID,
-		UserID:        uuid.New(),
+		UserID:        userID,
 		AccountNumber: "2023456789",
 		AccountType:   models.AccountTypeSavings,
 		Balance:       decimal.NewFromFloat(200.00),
@@ -549,14 +559,14 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_InactiveSourceAcc
 
 	// Check for existing transfer
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get accounts
-	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil)
-	s.accountRepo.EXPECT().GetByID(toAccountID).Return(toAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil)
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -589,7 +599,7 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_TransactionRollba
 
 	toAccount := &models.Account{
 		ID:            toAccountID,
-		UserID:        uuid.New(),
+		UserID:        userID,
 		AccountNumber: "2023456789",
 		AccountType:   models.AccountTypeSavings,
 		Balance:       decimal.NewFromFloat(200.00),
@@ -598,19 +608,19 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_TransactionRollba
 
 	// Check for existing transfer
 	s.transferRepo.EXPECT().
-		FindByIdempotencyKey(idempotencyKey).
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
 		Return(nil, repositories.ErrTransferNotFound)
 
 	// Get accounts
-	s.accountRepo.EXPECT().GetByID(fromAccountID).Return(fromAccount, nil)
-	s.accountRepo.EXPECT().GetByID(toAccountID).Return(toAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), fromAccountID).Return(fromAccount, nil)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), toAccountID).Return(toAccount, nil)
 
 	// Create transfer
 	s.transferRepo.EXPECT().
-		Create(gomock.Any()).
+		Create(gomock.Any(), gomock.Any()).
 		Return(errors.New("database error"))
 
-	result, err := s.service.TransferBetweenAccounts(
+	result, err := s.service.TransferBetweenAccounts(context.Background(),
 		fromAccountID,
 		toAccountID,
 		amount,
@@ -622,3 +632,109 @@ func (s *TransferServiceTestSuite) TestTransferBetweenAccounts_TransactionRollba
 	s.Error(err)
 	s.Nil(result)
 }
+
+// setupExternalTransferPayee prepares mocks for an external transfer up to the payee checks
+func (s *TransferServiceTestSuite) setupExternalTransferPayee(userID uuid.UUID, payee *models.ExternalAccount, idempotencyKey string) uuid.UUID {
+	fromAccountID := uuid.New()
+
+	s.transferRepo.EXPECT().
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
+		Return(nil, repositories.ErrTransferNotFound)
+	s.accountRepo.EXPECT().
+		GetByID(gomock.Any(), fromAccountID).
+		Return(&models.Account{
+			ID:          fromAccountID,
+			UserID:      userID,
+			AccountType: models.AccountTypeChecking,
+			Balance:     decimal.NewFromFloat(5000.00),
+			Status:      models.AccountStatusActive,
+		}, nil)
+	s.externalRepo.EXPECT().
+		GetByID(gomock.Any(), payee.ID).
+		Return(payee, nil)
+
+	return fromAccountID
+}
+
+// TestInitiateExternalTransfer_UnverifiedPayeeOverLimit tests that unverified payees are capped
+func (s *TransferServiceTestSuite) TestInitiateExternalTransfer_UnverifiedPayeeOverLimit() {
+	userID := uuid.New()
+	idempotencyKey := uuid.New().String()
+	payee := &models.ExternalAccount{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		VerificationStatus: models.ExternalAccountStatusMicroDepositsSent,
+	}
+	fromAccountID := s.setupExternalTransferPayee(userID, payee, idempotencyKey)
+
+	// No debit may be taken for a blocked transfer
+	s.transactionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	amount := models.UnverifiedExternalTransferLimit.Add(decimal.NewFromFloat(0.01))
+	result, err := s.service.InitiateExternalTransfer(context.Background(), userID, fromAccountID, payee.ID, amount, "Rent", "standard", idempotencyKey)
+
+	s.ErrorIs(err, ErrExternalAccountNotVerified)
+	s.Nil(result)
+}
+
+// TestInitiateExternalTransfer_IdempotencyKeyExists_Processing tests that a retry for a transfer
+// already in flight with the partner does not start another one
+func (s *TransferServiceTestSuite) TestInitiateExternalTransfer_IdempotencyKeyExists_Processing() {
+	userID := uuid.New()
+	idempotencyKey := uuid.New().String()
+	s.transferRepo.EXPECT().
+		FindByIdempotencyKey(gomock.Any(), idempotencyKey).
+		Return(&models.Transfer{
+			ID:             uuid.New(),
+			FromAccountID:  uuid.New(),
+			Amount:         decimal.NewFromFloat(100.00),
+			IdempotencyKey: idempotencyKey,
+			Status:         models.TransferStatusProcessing,
+		}, nil)
+
+	// Neither a new debit nor a new transfer may be recorded
+	s.transactionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+	s.transferRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := s.service.InitiateExternalTransfer(context.Background(), userID, uuid.New(), uuid.New(), decimal.NewFromFloat(100.00), "Rent", "standard", idempotencyKey)
+
+	s.ErrorIs(err, ErrTransferPending)
+	s.Nil(result)
+}
+
+// TestInitiateExternalTransfer_LockedPayee tests that locked payees cannot receive transfers
+func (s *TransferServiceTestSuite) TestInitiateExternalTransfer_LockedPayee() {
+	userID := uuid.New()
+	idempotencyKey := uuid.New().String()
+	payee := &models.ExternalAccount{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		VerificationStatus: models.ExternalAccountStatusLocked,
+	}
+	fromAccountID := s.setupExternalTransferPayee(userID, payee, idempotencyKey)
+
+	result, err := s.service.InitiateExternalTransfer(context.Background(), userID, fromAccountID, payee.ID, decimal.NewFromFloat(10.00), "Rent", "standard", idempotencyKey)
+
+	s.ErrorIs(err, ErrExternalAccountLocked)
+	s.Nil(result)
+}
+
+// TestInitiateExternalTransfer_SanctionsHeldPayee tests that payees held by sanctions screening cannot receive transfers
+func (s *TransferServiceTestSuite) TestInitiateExternalTransfer_SanctionsHeldPayee() {
+	userID := uuid.New()
+	idempotencyKey := uuid.New().String()
+	payee := &models.ExternalAccount{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		VerificationStatus: models.ExternalAccountStatusVerified,
+		SanctionsStatus:    models.SanctionsStatusPendingReview,
+	}
+	fromAccountID := s.setupExternalTransferPayee(userID, payee, idempotencyKey)
+
+	s.transactionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := s.service.InitiateExternalTransfer(context.Background(), userID, fromAccountID, payee.ID, decimal.NewFromFloat(10.00), "Rent", "standard", idempotencyKey)
+
+	s.ErrorIs(err, ErrPayeeSanctionsHold)
+	s.Nil(result)
+}
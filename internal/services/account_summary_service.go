@@ -1,10 +1,12 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 
+	"github.com/array/banking-api/internal/logging"
 	"github.com/array/banking-api/internal/models"
 	"github.com/array/banking-api/internal/repositories"
 	"github.com/google/uuid"
@@ -30,18 +32,18 @@ func NewAccountSummaryService(
 	}
 }
 
-func (s *accountSummaryService) GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error) {
-	requestor, err := s.validateRequestor(requestorID)
+func (s *accountSummaryService) GetAccountSummary(ctx context.Context, requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error) {
+	requestor, err := s.validateRequestor(ctx, requestorID)
 	if err != nil {
 		return nil, err
 	}
 
-	effectiveUserID, err := s.determineTargetUserID(requestorID, targetUserID, requestor, isAdmin)
+	effectiveUserID, err := s.determineTargetUserID(ctx, requestorID, targetUserID, requestor, isAdmin)
 	if err != nil {
 		return nil, err
 	}
 
-	accounts, err := s.fetchUserAccounts(effectiveUserID)
+	accounts, err := s.fetchUserAccounts(ctx, effectiveUserID)
 	if err != nil {
 		return nil, err
 	}
@@ -56,8 +58,8 @@ func (s *accountSummaryService) GetAccountSummary(requestorID uuid.UUID, targetU
 	return summary, nil
 }
 
-func (s *accountSummaryService) validateRequestor(requestorID uuid.UUID) (*models.User, error) {
-	requestor, err := s.userRepo.GetByID(requestorID)
+func (s *accountSummaryService) validateRequestor(ctx context.Context, requestorID uuid.UUID) (*models.User, error) {
+	requestor, err := s.userRepo.GetByID(ctx, requestorID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			slog.Warn("user not found during account summary request",
@@ -73,7 +75,7 @@ func (s *accountSummaryService) validateRequestor(requestorID uuid.UUID) (*model
 	return requestor, nil
 }
 
-func (s *accountSummaryService) determineTargetUserID(
+func (s *accountSummaryService) determineTargetUserID(ctx context.Context,
 	requestorID uuid.UUID,
 	targetUserID *uuid.UUID,
 	requestor *models.User,
@@ -87,7 +89,7 @@ func (s *accountSummaryService) determineTargetUserID(
 		return uuid.Nil, err
 	}
 
-	if err := s.validateTargetUser(*targetUserID); err != nil {
+	if err := s.validateTargetUser(ctx, *targetUserID); err != nil {
 		return uuid.Nil, err
 	}
 
@@ -113,8 +115,8 @@ func (s *accountSummaryService) authorizeAdminAccess(
 	return nil
 }
 
-func (s *accountSummaryService) validateTargetUser(targetUserID uuid.UUID) error {
-	_, err := s.userRepo.GetByID(targetUserID)
+func (s *accountSummaryService) validateTargetUser(ctx context.Context, targetUserID uuid.UUID) error {
+	_, err := s.userRepo.GetByID(ctx, targetUserID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			slog.Warn("target user not found during admin account summary request",
@@ -137,8 +139,8 @@ func (s *accountSummaryService) logAdminAccess(adminID uuid.UUID, adminEmail str
 		"target_user_id", targetUserID)
 }
 
-func (s *accountSummaryService) fetchUserAccounts(userID uuid.UUID) ([]models.Account, error) {
-	accounts, err := s.accountRepo.GetByUserID(userID)
+func (s *accountSummaryService) fetchUserAccounts(ctx context.Context, userID uuid.UUID) ([]models.Account, error) {
+	accounts, err := s.accountRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		slog.Error("failed to fetch user accounts",
 			"user_id", userID,
@@ -182,9 +184,5 @@ func (s *accountSummaryService) createAccountSummaryItem(account *models.Account
 }
 
 func maskAccountNumber(accountNumber string) string {
-	if len(accountNumber) <= 4 {
-		return "****"
-	}
-	lastFour := accountNumber[len(accountNumber)-4:]
-	return "****" + lastFour
+	return logging.MaskAccountNumber(accountNumber)
 }
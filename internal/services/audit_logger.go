@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/array/banking-api/internal/telemetry"
 	"github.com/google/uuid"
 )
 
@@ -189,6 +190,7 @@ func (al *AuditLogger) LogTransferIdempotencyCheck(ctx context.Context, idempote
 	)
 }
 
+// getCorrelationID prefers an explicit correlation or request ID and falls back to the active trace ID.
 func getCorrelationID(ctx context.Context) string {
 	if ctx == nil {
 		return ""
@@ -202,5 +204,5 @@ func getCorrelationID(ctx context.Context) string {
 		return requestID
 	}
 
-	return ""
+	return telemetry.TraceID(ctx)
 }
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -60,7 +61,7 @@ func ValidateActivityType(action string) error {
 }
 
 // CreateAuditLog creates a new audit log entry with validation
-func (s *AuditService) CreateAuditLog(log *models.AuditLog) error {
+func (s *AuditService) CreateAuditLog(ctx context.Context, log *models.AuditLog) error {
 	if log == nil {
 		return ErrInvalidAuditLog
 	}
@@ -69,7 +70,7 @@ func (s *AuditService) CreateAuditLog(log *models.AuditLog) error {
 		return err
 	}
 
-	if err := s.repo.Create(log); err != nil {
+	if err := s.repo.Create(ctx, log); err != nil {
 		return fmt.Errorf("failed to create audit log: %w", err)
 	}
 
@@ -77,7 +78,7 @@ func (s *AuditService) CreateAuditLog(log *models.AuditLog) error {
 }
 
 // GetCustomerActivity retrieves activity logs for a specific customer with optional date filtering and pagination
-func (s *AuditService) GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (s *AuditService) GetCustomerActivity(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
 	if userID == uuid.Nil {
 		return nil, 0, ErrInvalidUserID
 	}
@@ -86,11 +87,11 @@ func (s *AuditService) GetCustomerActivity(userID uuid.UUID, startDate, endDate
 		return nil, 0, ErrAuditDateRange
 	}
 
-	return s.repo.GetCustomerActivity(userID, startDate, endDate, offset, limit)
+	return s.repo.GetCustomerActivity(ctx, userID, startDate, endDate, offset, limit)
 }
 
 // LogLogin logs a successful login event
-func (s *AuditService) LogLogin(userID uuid.UUID, ipAddress, userAgent string) error {
+func (s *AuditService) LogLogin(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionLogin,
@@ -99,11 +100,11 @@ func (s *AuditService) LogLogin(userID uuid.UUID, ipAddress, userAgent string) e
 		IPAddress:  ipAddress,
 		UserAgent:  userAgent,
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogLogout logs a logout event
-func (s *AuditService) LogLogout(userID uuid.UUID, ipAddress, userAgent string) error {
+func (s *AuditService) LogLogout(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionLogout,
@@ -112,11 +113,11 @@ func (s *AuditService) LogLogout(userID uuid.UUID, ipAddress, userAgent string)
 		IPAddress:  ipAddress,
 		UserAgent:  userAgent,
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogProfileUpdate logs a customer profile update event
-func (s *AuditService) LogProfileUpdate(userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error {
+func (s *AuditService) LogProfileUpdate(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionProfileUpdated,
@@ -129,11 +130,11 @@ func (s *AuditService) LogProfileUpdate(userID, performedBy uuid.UUID, ipAddress
 	if performedBy != uuid.Nil {
 		log.SetMetadata("performed_by", performedBy.String())
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogEmailUpdate logs an email update event
-func (s *AuditService) LogEmailUpdate(userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error {
+func (s *AuditService) LogEmailUpdate(ctx context.Context, userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionEmailUpdated,
@@ -147,11 +148,11 @@ func (s *AuditService) LogEmailUpdate(userID, performedBy uuid.UUID, oldEmail, n
 			"performed_by": performedBy.String(),
 		},
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogPasswordReset logs an admin password reset event
-func (s *AuditService) LogPasswordReset(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+func (s *AuditService) LogPasswordReset(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionPasswordReset,
@@ -163,11 +164,11 @@ func (s *AuditService) LogPasswordReset(userID, performedBy uuid.UUID, ipAddress
 			"performed_by": performedBy.String(),
 		},
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogPasswordUpdate logs a customer self-service password update
-func (s *AuditService) LogPasswordUpdate(userID uuid.UUID, ipAddress, userAgent string) error {
+func (s *AuditService) LogPasswordUpdate(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionPasswordUpdated,
@@ -176,11 +177,11 @@ func (s *AuditService) LogPasswordUpdate(userID uuid.UUID, ipAddress, userAgent
 		IPAddress:  ipAddress,
 		UserAgent:  userAgent,
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogCustomerCreated logs a customer creation event
-func (s *AuditService) LogCustomerCreated(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+func (s *AuditService) LogCustomerCreated(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionCustomerCreated,
@@ -192,11 +193,11 @@ func (s *AuditService) LogCustomerCreated(userID, performedBy uuid.UUID, ipAddre
 			"performed_by": performedBy.String(),
 		},
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogCustomerDeleted logs a customer deletion event
-func (s *AuditService) LogCustomerDeleted(userID, performedBy uuid.UUID, ipAddress, userAgent string, reason string) error {
+func (s *AuditService) LogCustomerDeleted(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string, reason string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionCustomerDeleted,
@@ -209,11 +210,11 @@ func (s *AuditService) LogCustomerDeleted(userID, performedBy uuid.UUID, ipAddre
 			"reason":       reason,
 		},
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogAccountCreated logs an account creation event
-func (s *AuditService) LogAccountCreated(userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error {
+func (s *AuditService) LogAccountCreated(ctx context.Context, userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &userID,
 		Action:     models.AuditActionAccountCreated,
@@ -226,11 +227,11 @@ func (s *AuditService) LogAccountCreated(userID, performedBy, accountID uuid.UUI
 			"account_type": accountType,
 		},
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
 
 // LogAccountTransferred logs an account ownership transfer event
-func (s *AuditService) LogAccountTransferred(fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error {
+func (s *AuditService) LogAccountTransferred(ctx context.Context, fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error {
 	log := &models.AuditLog{
 		UserID:     &fromUserID,
 		Action:     models.AuditActionAccountTransferred,
@@ -244,5 +245,5 @@ func (s *AuditService) LogAccountTransferred(fromUserID, toUserID, performedBy,
 			"performed_by": performedBy.String(),
 		},
 	}
-	return s.CreateAuditLog(log)
+	return s.CreateAuditLog(ctx, log)
 }
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -76,21 +77,21 @@ func (s *AuditServiceTestSuite) TestCreateAuditLog_ValidLog() {
 	}
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(l *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, l *models.AuditLog) error {
 			// Simulate DB behavior: set ID and ensure CreatedAt is set
 			l.ID = uuid.New()
 			return nil
 		}).
 		Times(1)
 
-	err := s.service.CreateAuditLog(log)
+	err := s.service.CreateAuditLog(context.Background(), log)
 	s.NoError(err)
 	s.NotEqual(uuid.Nil, log.ID)
 }
 
 func (s *AuditServiceTestSuite) TestCreateAuditLog_NilLog() {
-	err := s.service.CreateAuditLog(nil)
+	err := s.service.CreateAuditLog(context.Background(), nil)
 	s.Error(err)
 	s.ErrorIs(err, ErrInvalidAuditLog)
 }
@@ -104,7 +105,7 @@ func (s *AuditServiceTestSuite) TestCreateAuditLog_InvalidActivityType() {
 		ResourceID: userID.String(),
 	}
 
-	err := s.service.CreateAuditLog(log)
+	err := s.service.CreateAuditLog(context.Background(), log)
 	s.Error(err)
 }
 
@@ -118,11 +119,11 @@ func (s *AuditServiceTestSuite) TestCreateAuditLog_RepositoryError() {
 	}
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
+		Create(gomock.Any(), gomock.Any()).
 		Return(errors.New("database error")).
 		Times(1)
 
-	err := s.service.CreateAuditLog(log)
+	err := s.service.CreateAuditLog(context.Background(), log)
 	s.Error(err)
 	s.Contains(err.Error(), "failed to create audit log")
 }
@@ -159,11 +160,11 @@ func (s *AuditServiceTestSuite) TestGetCustomerActivity_GetAll() {
 	}
 
 	s.mockRepo.EXPECT().
-		GetCustomerActivity(userID, nil, nil, 0, 10).
+		GetCustomerActivity(gomock.Any(), userID, nil, nil, 0, 10).
 		Return(expectedLogs, int64(3), nil).
 		Times(1)
 
-	results, total, err := s.service.GetCustomerActivity(userID, nil, nil, 0, 10)
+	results, total, err := s.service.GetCustomerActivity(context.Background(), userID, nil, nil, 0, 10)
 	s.NoError(err)
 	s.Len(results, 3)
 	s.Equal(int64(3), total)
@@ -196,11 +197,11 @@ func (s *AuditServiceTestSuite) TestGetCustomerActivity_WithDateRange() {
 	}
 
 	s.mockRepo.EXPECT().
-		GetCustomerActivity(userID, &startDate, &endDate, 0, 10).
+		GetCustomerActivity(gomock.Any(), userID, &startDate, &endDate, 0, 10).
 		Return(expectedLogs, int64(2), nil).
 		Times(1)
 
-	results, total, err := s.service.GetCustomerActivity(userID, &startDate, &endDate, 0, 10)
+	results, total, err := s.service.GetCustomerActivity(context.Background(), userID, &startDate, &endDate, 0, 10)
 	s.NoError(err)
 	s.Len(results, 2)
 	s.Equal(int64(2), total)
@@ -230,18 +231,18 @@ func (s *AuditServiceTestSuite) TestGetCustomerActivity_WithPagination() {
 	}
 
 	s.mockRepo.EXPECT().
-		GetCustomerActivity(userID, nil, nil, 1, 2).
+		GetCustomerActivity(gomock.Any(), userID, nil, nil, 1, 2).
 		Return(expectedLogs, int64(3), nil).
 		Times(1)
 
-	results, total, err := s.service.GetCustomerActivity(userID, nil, nil, 1, 2)
+	results, total, err := s.service.GetCustomerActivity(context.Background(), userID, nil, nil, 1, 2)
 	s.NoError(err)
 	s.Len(results, 2)
 	s.Equal(int64(3), total)
 }
 
 func (s *AuditServiceTestSuite) TestGetCustomerActivity_InvalidUserID() {
-	results, total, err := s.service.GetCustomerActivity(uuid.Nil, nil, nil, 0, 10)
+	results, total, err := s.service.GetCustomerActivity(context.Background(), uuid.Nil, nil, nil, 0, 10)
 	s.Error(err)
 	s.Len(results, 0)
 	s.Equal(int64(0), total)
@@ -254,7 +255,7 @@ func (s *AuditServiceTestSuite) TestGetCustomerActivity_InvalidDateRange() {
 	startDate := now
 	endDate := now.Add(-1 * time.Hour)
 
-	results, total, err := s.service.GetCustomerActivity(userID, &startDate, &endDate, 0, 10)
+	results, total, err := s.service.GetCustomerActivity(context.Background(), userID, &startDate, &endDate, 0, 10)
 	s.Error(err)
 	s.Len(results, 0)
 	s.Equal(int64(0), total)
@@ -265,11 +266,11 @@ func (s *AuditServiceTestSuite) TestGetCustomerActivity_RepositoryError() {
 	userID := uuid.New()
 
 	s.mockRepo.EXPECT().
-		GetCustomerActivity(userID, nil, nil, 0, 10).
+		GetCustomerActivity(gomock.Any(), userID, nil, nil, 0, 10).
 		Return(nil, int64(0), errors.New("database error")).
 		Times(1)
 
-	results, total, err := s.service.GetCustomerActivity(userID, nil, nil, 0, 10)
+	results, total, err := s.service.GetCustomerActivity(context.Background(), userID, nil, nil, 0, 10)
 	s.Error(err)
 	s.Nil(results)
 	s.Equal(int64(0), total)
@@ -280,8 +281,8 @@ func (s *AuditServiceTestSuite) TestLogLogin() {
 	userID := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionLogin, log.Action)
 			s.Equal("192.168.1.1", log.IPAddress)
@@ -292,7 +293,7 @@ func (s *AuditServiceTestSuite) TestLogLogin() {
 		}).
 		Times(1)
 
-	err := s.service.LogLogin(userID, "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogLogin(context.Background(), userID, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -300,8 +301,8 @@ func (s *AuditServiceTestSuite) TestLogLogout() {
 	userID := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionLogout, log.Action)
 			log.ID = uuid.New()
@@ -309,7 +310,7 @@ func (s *AuditServiceTestSuite) TestLogLogout() {
 		}).
 		Times(1)
 
-	err := s.service.LogLogout(userID, "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogLogout(context.Background(), userID, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -323,8 +324,8 @@ func (s *AuditServiceTestSuite) TestLogProfileUpdate() {
 	}
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionProfileUpdated, log.Action)
 			s.NotNil(log.Metadata)
@@ -333,7 +334,7 @@ func (s *AuditServiceTestSuite) TestLogProfileUpdate() {
 		}).
 		Times(1)
 
-	err := s.service.LogProfileUpdate(userID, performedBy, "192.168.1.1", "Mozilla/5.0", changes)
+	err := s.service.LogProfileUpdate(context.Background(), userID, performedBy, "192.168.1.1", "Mozilla/5.0", changes)
 	s.NoError(err)
 }
 
@@ -342,8 +343,8 @@ func (s *AuditServiceTestSuite) TestLogEmailUpdate() {
 	performedBy := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionEmailUpdated, log.Action)
 			s.NotNil(log.Metadata)
@@ -354,7 +355,7 @@ func (s *AuditServiceTestSuite) TestLogEmailUpdate() {
 		}).
 		Times(1)
 
-	err := s.service.LogEmailUpdate(userID, performedBy, "old@example.com", "new@example.com", "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogEmailUpdate(context.Background(), userID, performedBy, "old@example.com", "new@example.com", "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -363,8 +364,8 @@ func (s *AuditServiceTestSuite) TestLogPasswordReset() {
 	performedBy := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionPasswordReset, log.Action)
 			log.ID = uuid.New()
@@ -372,7 +373,7 @@ func (s *AuditServiceTestSuite) TestLogPasswordReset() {
 		}).
 		Times(1)
 
-	err := s.service.LogPasswordReset(userID, performedBy, "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogPasswordReset(context.Background(), userID, performedBy, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -380,8 +381,8 @@ func (s *AuditServiceTestSuite) TestLogPasswordUpdate() {
 	userID := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionPasswordUpdated, log.Action)
 			log.ID = uuid.New()
@@ -389,7 +390,7 @@ func (s *AuditServiceTestSuite) TestLogPasswordUpdate() {
 		}).
 		Times(1)
 
-	err := s.service.LogPasswordUpdate(userID, "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogPasswordUpdate(context.Background(), userID, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -398,8 +399,8 @@ func (s *AuditServiceTestSuite) TestLogCustomerCreated() {
 	performedBy := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionCustomerCreated, log.Action)
 			log.ID = uuid.New()
@@ -407,7 +408,7 @@ func (s *AuditServiceTestSuite) TestLogCustomerCreated() {
 		}).
 		Times(1)
 
-	err := s.service.LogCustomerCreated(userID, performedBy, "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogCustomerCreated(context.Background(), userID, performedBy, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -416,8 +417,8 @@ func (s *AuditServiceTestSuite) TestLogCustomerDeleted() {
 	performedBy := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionCustomerDeleted, log.Action)
 			s.NotNil(log.Metadata)
@@ -427,7 +428,7 @@ func (s *AuditServiceTestSuite) TestLogCustomerDeleted() {
 		}).
 		Times(1)
 
-	err := s.service.LogCustomerDeleted(userID, performedBy, "192.168.1.1", "Mozilla/5.0", "Requested by user")
+	err := s.service.LogCustomerDeleted(context.Background(), userID, performedBy, "192.168.1.1", "Mozilla/5.0", "Requested by user")
 	s.NoError(err)
 }
 
@@ -437,8 +438,8 @@ func (s *AuditServiceTestSuite) TestLogAccountCreated() {
 	accountID := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&userID, log.UserID)
 			s.Equal(models.AuditActionAccountCreated, log.Action)
 			s.Equal("account", log.Resource)
@@ -448,7 +449,7 @@ func (s *AuditServiceTestSuite) TestLogAccountCreated() {
 		}).
 		Times(1)
 
-	err := s.service.LogAccountCreated(userID, performedBy, accountID, "checking", "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogAccountCreated(context.Background(), userID, performedBy, accountID, "checking", "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -459,8 +460,8 @@ func (s *AuditServiceTestSuite) TestLogAccountTransferred() {
 	accountID := uuid.New()
 
 	s.mockRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(log *models.AuditLog) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 			s.Equal(&fromUserID, log.UserID)
 			s.Equal(models.AuditActionAccountTransferred, log.Action)
 			s.Equal(fromUserID.String(), log.GetMetadata("from_user_id", ""))
@@ -470,6 +471,6 @@ func (s *AuditServiceTestSuite) TestLogAccountTransferred() {
 		}).
 		Times(1)
 
-	err := s.service.LogAccountTransferred(fromUserID, toUserID, performedBy, accountID, "192.168.1.1", "Mozilla/5.0")
+	err := s.service.LogAccountTransferred(context.Background(), fromUserID, toUserID, performedBy, accountID, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
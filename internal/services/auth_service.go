@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -29,6 +30,7 @@ type AuthService struct {
 	passwordService      PasswordServiceInterface
 	tokenService         TokenServiceInterface
 	accountService       AccountServiceInterface
+	sanctionsScreener    SanctionsScreeningServiceInterface // Optional; new customers are not screened when nil
 	logger               *slog.Logger
 }
 
@@ -41,6 +43,7 @@ func NewAuthService(
 	passwordService PasswordServiceInterface,
 	tokenService TokenServiceInterface,
 	accountService AccountServiceInterface,
+	sanctionsScreener SanctionsScreeningServiceInterface,
 	logger *slog.Logger,
 ) AuthServiceInterface {
 	return &AuthService{
@@ -51,19 +54,20 @@ func NewAuthService(
 		passwordService:      passwordService,
 		tokenService:         tokenService,
 		accountService:       accountService,
+		sanctionsScreener:    sanctionsScreener,
 		logger:               logger,
 	}
 }
 
 // Register creates a new user account
-func (s *AuthService) Register(req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error) {
-	existingUser, err := s.userRepo.GetByEmail(req.Email)
+func (s *AuthService) Register(ctx context.Context, req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error) {
+	existingUser, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil && !errors.Is(err, repositories.ErrUserNotFound) {
 		return nil, fmt.Errorf("failed to check existing user: %w", err)
 	}
 
 	if existingUser != nil {
-		s.auditFailedRegistration(req.Email, ipAddress, userAgent, "email_already_exists")
+		s.auditFailedRegistration(ctx, req.Email, ipAddress, userAgent, "email_already_exists")
 		return nil, ErrUserAlreadyExists
 	}
 
@@ -80,41 +84,51 @@ func (s *AuthService) Register(req *dto.RegisterRequest, ipAddress, userAgent st
 		Role:         models.RoleCustomer,
 	}
 
-	if err := s.userRepo.Create(user); err != nil {
+	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	if err := s.accountService.CreateAccountsForNewUser(user.ID); err != nil {
+	// A match holds the customer for review rather than failing registration, so the outcome
+	// is not revealed to the applicant
+	if s.sanctionsScreener != nil {
+		if err := s.sanctionsScreener.ScreenUser(ctx, user); err != nil {
+			s.logger.Error("failed to screen new customer against sanctions list",
+				"error", err,
+				"user_id", user.ID)
+		}
+	}
+
+	if err := s.accountService.CreateAccountsForNewUser(ctx, user.ID); err != nil {
 		// Non-critical: Admin can retry account creation
-		s.auditFailedAccountCreation(user, ipAddress, userAgent, err.Error())
+		s.auditFailedAccountCreation(ctx, user, ipAddress, userAgent, err.Error())
 	} else {
-		s.auditSuccessfulAccountCreation(user, ipAddress, userAgent)
+		s.auditSuccessfulAccountCreation(ctx, user, ipAddress, userAgent)
 	}
 
-	s.auditSuccessfulRegistration(user, ipAddress, userAgent)
+	s.auditSuccessfulRegistration(ctx, user, ipAddress, userAgent)
 
 	return user, nil
 }
 
 // Login authenticates a user and returns tokens
-func (s *AuthService) Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
-	user, err := s.userRepo.GetByEmail(req.Email)
+func (s *AuthService) Login(ctx context.Context, req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
-			s.auditFailedLogin(req.Email, ipAddress, userAgent, "user_not_found")
+			s.auditFailedLogin(ctx, req.Email, ipAddress, userAgent, "user_not_found")
 			return nil, ErrInvalidCredentials
 		}
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
 	if user.IsLocked() {
-		s.auditFailedLogin(req.Email, ipAddress, userAgent, "account_locked")
+		s.auditFailedLogin(ctx, req.Email, ipAddress, userAgent, "account_locked")
 		return nil, ErrAccountLocked
 	}
 
 	if !s.passwordService.ComparePassword(req.Password, user.PasswordHash) {
 		user.IncrementFailedAttempts()
-		if err := s.userRepo.UpdateFailedLoginAttempts(user); err != nil {
+		if err := s.userRepo.UpdateFailedLoginAttempts(ctx, user); err != nil {
 			// Security: Never reveal user existence via error messages
 			s.logger.Error("failed to update login attempts",
 				"error", err,
@@ -123,15 +137,15 @@ func (s *AuthService) Login(req *dto.LoginRequest, ipAddress, userAgent string)
 		}
 
 		if user.IsLocked() {
-			s.auditAccountLocked(user, ipAddress, userAgent)
+			s.auditAccountLocked(ctx, user, ipAddress, userAgent)
 		}
 
-		s.auditFailedLogin(req.Email, ipAddress, userAgent, "invalid_password")
+		s.auditFailedLogin(ctx, req.Email, ipAddress, userAgent, "invalid_password")
 		return nil, ErrInvalidCredentials
 	}
 
 	user.ResetFailedAttempts()
-	if err := s.userRepo.UpdateFailedLoginAttempts(user); err != nil {
+	if err := s.userRepo.UpdateFailedLoginAttempts(ctx, user); err != nil {
 		// Non-critical: Audit logging failure shouldn't block login
 		s.logger.Warn("failed to reset login attempts",
 			"error", err,
@@ -139,21 +153,21 @@ func (s *AuthService) Login(req *dto.LoginRequest, ipAddress, userAgent string)
 			"email", user.Email)
 	}
 
-	tokens, err := s.generateTokens(user)
+	tokens, err := s.generateTokens(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate tokens: %w", err)
 	}
 
-	s.auditSuccessfulLogin(user, ipAddress, userAgent)
+	s.auditSuccessfulLogin(ctx, user, ipAddress, userAgent)
 
 	return tokens, nil
 }
 
 // RefreshTokens generates new tokens using a refresh token
-func (s *AuthService) RefreshTokens(refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+func (s *AuthService) RefreshTokens(ctx context.Context, refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error) {
 	claims, err := s.tokenService.ValidateRefreshToken(refreshToken)
 	if err != nil {
-		s.auditFailedTokenRefresh("", ipAddress, userAgent, "invalid_token")
+		s.auditFailedTokenRefresh(ctx, "", ipAddress, userAgent, "invalid_token")
 		return nil, ErrInvalidRefreshToken
 	}
 
@@ -162,24 +176,24 @@ func (s *AuthService) RefreshTokens(refreshToken, ipAddress, userAgent string) (
 		return nil, fmt.Errorf("invalid user ID in token: %w", err)
 	}
 
-	storedToken, err := s.refreshTokenRepo.GetByTokenHash(hashToken(refreshToken))
+	storedToken, err := s.refreshTokenRepo.GetByTokenHash(ctx, hashToken(refreshToken))
 	if err != nil {
-		s.auditFailedTokenRefresh(claims.UserID, ipAddress, userAgent, "token_not_found")
+		s.auditFailedTokenRefresh(ctx, claims.UserID, ipAddress, userAgent, "token_not_found")
 		return nil, ErrInvalidRefreshToken
 	}
 
 	if !storedToken.IsValid() {
-		s.auditFailedTokenRefresh(claims.UserID, ipAddress, userAgent, "token_expired_or_revoked")
+		s.auditFailedTokenRefresh(ctx, claims.UserID, ipAddress, userAgent, "token_expired_or_revoked")
 		return nil, ErrInvalidRefreshToken
 	}
 
-	user, err := s.userRepo.GetByID(userID)
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
 	storedToken.Revoke()
-	if err := s.refreshTokenRepo.Update(storedToken); err != nil {
+	if err := s.refreshTokenRepo.Update(ctx, storedToken); err != nil {
 		// Non-critical: Token revocation failure shouldn't block refresh
 		s.logger.Warn("failed to revoke old token",
 			"error", err,
@@ -187,24 +201,24 @@ func (s *AuthService) RefreshTokens(refreshToken, ipAddress, userAgent string) (
 			"token_id", storedToken.ID)
 	}
 
-	tokens, err := s.generateTokens(user)
+	tokens, err := s.generateTokens(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate new tokens: %w", err)
 	}
 
-	s.auditSuccessfulTokenRefresh(user, ipAddress, userAgent)
+	s.auditSuccessfulTokenRefresh(ctx, user, ipAddress, userAgent)
 
 	return tokens, nil
 }
 
 // Logout invalidates the user's tokens
-func (s *AuthService) Logout(accessToken, ipAddress, userAgent string) error {
+func (s *AuthService) Logout(ctx context.Context, accessToken, ipAddress, userAgent string) error {
 	claims, err := s.tokenService.ValidateAccessToken(accessToken)
 	if err != nil {
 		// Security: Blacklist even expired tokens to prevent reuse
 		jti, _ := s.tokenService.GetJTI(accessToken)
 		if jti != "" {
-			if err := s.blacklistToken(jti, uuid.Nil, time.Now().Add(24*time.Hour)); err != nil {
+			if err := s.blacklistToken(ctx, jti, uuid.Nil, time.Now().Add(24*time.Hour)); err != nil {
 				s.logger.Error("failed to blacklist expired token",
 					"error", err,
 					"jti", jti)
@@ -216,26 +230,26 @@ func (s *AuthService) Logout(accessToken, ipAddress, userAgent string) error {
 	userID, _ := uuid.Parse(claims.UserID)
 
 	expiry, _ := s.tokenService.GetTokenExpiry(accessToken)
-	if err := s.blacklistToken(claims.ID, userID, expiry); err != nil {
+	if err := s.blacklistToken(ctx, claims.ID, userID, expiry); err != nil {
 		s.logger.Error("failed to blacklist token",
 			"error", err,
 			"jti", claims.ID,
 			"user_id", userID)
 	}
 
-	if err := s.refreshTokenRepo.RevokeAllForUser(userID); err != nil {
+	if err := s.refreshTokenRepo.RevokeAllForUser(ctx, userID); err != nil {
 		// Non-critical: Token revocation failure shouldn't block refresh
 		s.logger.Warn("failed to revoke refresh tokens",
 			"error", err,
 			"user_id", userID)
 	}
 
-	s.auditLogout(userID, ipAddress, userAgent)
+	s.auditLogout(ctx, userID, ipAddress, userAgent)
 
 	return nil
 }
 
-func (s *AuthService) generateTokens(user *models.User) (*dto.TokenResponse, error) {
+func (s *AuthService) generateTokens(ctx context.Context, user *models.User) (*dto.TokenResponse, error) {
 	accessToken, expiresAt, err := s.tokenService.GenerateAccessToken(user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
@@ -252,7 +266,7 @@ func (s *AuthService) generateTokens(user *models.User) (*dto.TokenResponse, err
 		ExpiresAt: refreshExpiresAt,
 	}
 
-	if err := s.refreshTokenRepo.Create(refreshTokenModel); err != nil {
+	if err := s.refreshTokenRepo.Create(ctx, refreshTokenModel); err != nil {
 		return nil, fmt.Errorf("failed to store refresh token: %w", err)
 	}
 
@@ -264,13 +278,13 @@ func (s *AuthService) generateTokens(user *models.User) (*dto.TokenResponse, err
 	}, nil
 }
 
-func (s *AuthService) blacklistToken(jti string, userID uuid.UUID, expiresAt time.Time) error {
+func (s *AuthService) blacklistToken(ctx context.Context, jti string, userID uuid.UUID, expiresAt time.Time) error {
 	token := &models.BlacklistedToken{
 		JTI:       jti,
 		UserID:    userID,
 		ExpiresAt: expiresAt,
 	}
-	return s.blacklistedTokenRepo.Create(token)
+	return s.blacklistedTokenRepo.Create(ctx, token)
 }
 
 func hashToken(token string) string {
@@ -280,39 +294,39 @@ func hashToken(token string) string {
 }
 
 // Audit logging methods
-func (s *AuthService) auditSuccessfulRegistration(user *models.User, ipAddress, userAgent string) {
-	s.createAuditLog(&user.ID, models.AuditActionRegister, "user", user.ID.String(), ipAddress, userAgent, nil)
+func (s *AuthService) auditSuccessfulRegistration(ctx context.Context, user *models.User, ipAddress, userAgent string) {
+	s.createAuditLog(ctx, &user.ID, models.AuditActionRegister, "user", user.ID.String(), ipAddress, userAgent, nil)
 }
 
-func (s *AuthService) auditFailedRegistration(email, ipAddress, userAgent, reason string) {
+func (s *AuthService) auditFailedRegistration(ctx context.Context, email, ipAddress, userAgent, reason string) {
 	metadata := map[string]interface{}{
 		"email":  email,
 		"reason": reason,
 	}
-	s.createAuditLog(nil, models.AuditActionRegister, "user", "", ipAddress, userAgent, metadata)
+	s.createAuditLog(ctx, nil, models.AuditActionRegister, "user", "", ipAddress, userAgent, metadata)
 }
 
-func (s *AuthService) auditSuccessfulLogin(user *models.User, ipAddress, userAgent string) {
-	s.createAuditLog(&user.ID, models.AuditActionLogin, "user", user.ID.String(), ipAddress, userAgent, nil)
+func (s *AuthService) auditSuccessfulLogin(ctx context.Context, user *models.User, ipAddress, userAgent string) {
+	s.createAuditLog(ctx, &user.ID, models.AuditActionLogin, "user", user.ID.String(), ipAddress, userAgent, nil)
 }
 
-func (s *AuthService) auditFailedLogin(email, ipAddress, userAgent, reason string) {
+func (s *AuthService) auditFailedLogin(ctx context.Context, email, ipAddress, userAgent, reason string) {
 	metadata := map[string]interface{}{
 		"email":  email,
 		"reason": reason,
 	}
-	s.createAuditLog(nil, models.AuditActionFailedLogin, "user", "", ipAddress, userAgent, metadata)
+	s.createAuditLog(ctx, nil, models.AuditActionFailedLogin, "user", "", ipAddress, userAgent, metadata)
 }
 
-func (s *AuthService) auditAccountLocked(user *models.User, ipAddress, userAgent string) {
-	s.createAuditLog(&user.ID, models.AuditActionAccountLocked, "user", user.ID.String(), ipAddress, userAgent, nil)
+func (s *AuthService) auditAccountLocked(ctx context.Context, user *models.User, ipAddress, userAgent string) {
+	s.createAuditLog(ctx, &user.ID, models.AuditActionAccountLocked, "user", user.ID.String(), ipAddress, userAgent, nil)
 }
 
-func (s *AuthService) auditSuccessfulTokenRefresh(user *models.User, ipAddress, userAgent string) {
-	s.createAuditLog(&user.ID, models.AuditActionTokenRefresh, "user", user.ID.String(), ipAddress, userAgent, nil)
+func (s *AuthService) auditSuccessfulTokenRefresh(ctx context.Context, user *models.User, ipAddress, userAgent string) {
+	s.createAuditLog(ctx, &user.ID, models.AuditActionTokenRefresh, "user", user.ID.String(), ipAddress, userAgent, nil)
 }
 
-func (s *AuthService) auditFailedTokenRefresh(userID, ipAddress, userAgent, reason string) {
+func (s *AuthService) auditFailedTokenRefresh(ctx context.Context, userID, ipAddress, userAgent, reason string) {
 	var uid *uuid.UUID
 	if userID != "" {
 		id, _ := uuid.Parse(userID)
@@ -321,28 +335,28 @@ func (s *AuthService) auditFailedTokenRefresh(userID, ipAddress, userAgent, reas
 	metadata := map[string]interface{}{
 		"reason": reason,
 	}
-	s.createAuditLog(uid, models.AuditActionTokenRefresh, "token", "", ipAddress, userAgent, metadata)
+	s.createAuditLog(ctx, uid, models.AuditActionTokenRefresh, "token", "", ipAddress, userAgent, metadata)
 }
 
-func (s *AuthService) auditLogout(userID uuid.UUID, ipAddress, userAgent string) {
-	s.createAuditLog(&userID, models.AuditActionLogout, "user", userID.String(), ipAddress, userAgent, nil)
+func (s *AuthService) auditLogout(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) {
+	s.createAuditLog(ctx, &userID, models.AuditActionLogout, "user", userID.String(), ipAddress, userAgent, nil)
 }
 
-func (s *AuthService) auditSuccessfulAccountCreation(user *models.User, ipAddress, userAgent string) {
+func (s *AuthService) auditSuccessfulAccountCreation(ctx context.Context, user *models.User, ipAddress, userAgent string) {
 	metadata := map[string]interface{}{
 		"message": "default accounts created",
 	}
-	s.createAuditLog(&user.ID, "account.auto_created", "user", user.ID.String(), ipAddress, userAgent, metadata)
+	s.createAuditLog(ctx, &user.ID, "account.auto_created", "user", user.ID.String(), ipAddress, userAgent, metadata)
 }
 
-func (s *AuthService) auditFailedAccountCreation(user *models.User, ipAddress, userAgent, reason string) {
+func (s *AuthService) auditFailedAccountCreation(ctx context.Context, user *models.User, ipAddress, userAgent, reason string) {
 	metadata := map[string]interface{}{
 		"reason": reason,
 	}
-	s.createAuditLog(&user.ID, "account.auto_creation_failed", "user", user.ID.String(), ipAddress, userAgent, metadata)
+	s.createAuditLog(ctx, &user.ID, "account.auto_creation_failed", "user", user.ID.String(), ipAddress, userAgent, metadata)
 }
 
-func (s *AuthService) createAuditLog(userID *uuid.UUID, action, resource, resourceID, ipAddress, userAgent string, metadata map[string]interface{}) {
+func (s *AuthService) createAuditLog(ctx context.Context, userID *uuid.UUID, action, resource, resourceID, ipAddress, userAgent string, metadata map[string]interface{}) {
 	log := &models.AuditLog{
 		UserID:     userID,
 		Action:     action,
@@ -353,7 +367,7 @@ func (s *AuthService) createAuditLog(userID *uuid.UUID, action, resource, resour
 		Metadata:   metadata,
 	}
 
-	if err := s.auditRepo.Create(log); err != nil {
+	if err := s.auditRepo.Create(ctx, log); err != nil {
 		// Non-critical: Audit logging failure shouldn't block operations
 		s.logger.Error("failed to create audit log",
 			"error", err,
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"log/slog"
 	"testing"
@@ -39,7 +40,7 @@ func (s *AuthServiceTestSuite) SetupTest() {
 	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
 	s.blacklistedTokenRepo = repository_mocks.NewMockBlacklistedTokenRepositoryInterface(s.ctrl)
 	s.passwordService = service_mocks.NewMockPasswordServiceInterface(s.ctrl)
-	s.authService = NewAuthService(s.userRepo, s.refreshTokenRepo, s.auditRepo, s.blacklistedTokenRepo, s.passwordService, s.tokenService, s.accountService, slog.Default())
+	s.authService = NewAuthService(s.userRepo, s.refreshTokenRepo, s.auditRepo, s.blacklistedTokenRepo, s.passwordService, s.tokenService, s.accountService, nil, slog.Default())
 }
 
 func (s *AuthServiceTestSuite) TearDownTest() {
@@ -59,13 +60,13 @@ func (s *AuthServiceTestSuite) TestRegister_SuccessfulRegistration() {
 	}
 
 	// Setup mock expectations
-	s.userRepo.EXPECT().GetByEmail(req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
 	s.passwordService.EXPECT().HashPassword(req.Password).Return("hashed_password", nil).Times(1)
-	s.userRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(2) // account creation + registration audit logs
+	s.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2) // account creation + registration audit logs
 
-	user, err := s.authService.Register(req, "192.168.1.1", "Mozilla/5.0")
+	user, err := s.authService.Register(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
 
 	s.NoError(err)
 	s.NotNil(user)
@@ -92,15 +93,66 @@ func (s *AuthServiceTestSuite) TestRegister_UserAlreadyExists() {
 	}
 
 	// Setup mock expectations - user already exists
-	s.userRepo.EXPECT().GetByEmail(req.Email).Return(existingUser, nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // failed registration audit log
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(existingUser, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // failed registration audit log
 
 	// Attempt registration with existing email
-	user, err := s.authService.Register(req, "192.168.1.1", "Mozilla/5.0")
+	user, err := s.authService.Register(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
 	s.Equal(ErrUserAlreadyExists, err)
 	s.Nil(user)
 }
 
+func (s *AuthServiceTestSuite) TestRegister_ScreensNewCustomer() {
+	screener := service_mocks.NewMockSanctionsScreeningServiceInterface(s.ctrl)
+	s.authService.(*AuthService).sanctionsScreener = screener
+	req := &dto.RegisterRequest{
+		Email:     "maria@example.com",
+		Password:  "SecurePass123!",
+		FirstName: "Maria",
+		LastName:  "Santos",
+	}
+
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(nil, repositories.ErrUserNotFound)
+	s.passwordService.EXPECT().HashPassword(req.Password).Return("hashed_password", nil)
+	s.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any(), gomock.Any()).Return(nil)
+	screener.EXPECT().ScreenUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user *models.User) error {
+		s.Equal("Maria", user.FirstName)
+		s.Equal("Santos", user.LastName)
+		user.SanctionsStatus = models.SanctionsStatusPendingReview
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	user, err := s.authService.Register(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
+
+	s.NoError(err)
+	s.True(user.IsSanctionsHeld())
+}
+
+func (s *AuthServiceTestSuite) TestRegister_ScreeningFailureDoesNotFailRegistration() {
+	screener := service_mocks.NewMockSanctionsScreeningServiceInterface(s.ctrl)
+	s.authService.(*AuthService).sanctionsScreener = screener
+	req := &dto.RegisterRequest{
+		Email:     "jane@example.com",
+		Password:  "SecurePass123!",
+		FirstName: "Jane",
+		LastName:  "Doe",
+	}
+
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(nil, repositories.ErrUserNotFound)
+	s.passwordService.EXPECT().HashPassword(req.Password).Return("hashed_password", nil)
+	s.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any(), gomock.Any()).Return(nil)
+	screener.EXPECT().ScreenUser(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	user, err := s.authService.Register(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
+
+	s.NoError(err)
+	s.NotNil(user)
+}
+
 func (s *AuthServiceTestSuite) TestRegister_WeakPasswordValidation() {
 	req := &dto.RegisterRequest{
 		Email:     "weak@example.com",
@@ -110,11 +162,11 @@ func (s *AuthServiceTestSuite) TestRegister_WeakPasswordValidation() {
 	}
 
 	// Setup mock expectations
-	s.userRepo.EXPECT().GetByEmail(req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
 	s.passwordService.EXPECT().HashPassword(req.Password).Return("", errors.New("password must be at least 12 characters")).Times(1)
 
 	// The password service enforces minimum length requirement
-	user, err := s.authService.Register(req, "192.168.1.1", "Mozilla/5.0")
+	user, err := s.authService.Register(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
 	s.Error(err)
 	s.Contains(err.Error(), "password must be at least 12 characters")
 	s.Nil(user)
@@ -144,15 +196,15 @@ func (s *AuthServiceTestSuite) TestLogin_SuccessfulLogin() {
 	expiresAt := time.Now().Add(15 * time.Minute)
 
 	// Setup mock expectations
-	s.userRepo.EXPECT().GetByEmail(email).Return(user, nil).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), email).Return(user, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword(password, user.PasswordHash).Return(true).Times(1)
-	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	s.tokenService.EXPECT().GenerateAccessToken(user).Return("access_token", expiresAt, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // successful login audit log
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // successful login audit log
 
-	tokens, err := s.authService.Login(req, "192.168.1.1", "Mozilla/5.0")
+	tokens, err := s.authService.Login(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
 
 	s.NoError(err)
 	s.NotNil(tokens)
@@ -183,12 +235,12 @@ func (s *AuthServiceTestSuite) TestLogin_InvalidPassword() {
 	}
 
 	// Setup mock expectations
-	s.userRepo.EXPECT().GetByEmail(email).Return(user, nil).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), email).Return(user, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword("WrongPassword", user.PasswordHash).Return(false).Times(1)
-	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // failed login audit log
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // failed login audit log
 
-	tokens, err := s.authService.Login(req, "192.168.1.1", "Mozilla/5.0")
+	tokens, err := s.authService.Login(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
 
 	s.Equal(ErrInvalidCredentials, err)
 	s.Nil(tokens)
@@ -201,10 +253,10 @@ func (s *AuthServiceTestSuite) TestLogin_NonExistentUser() {
 	}
 
 	// Setup mock expectations
-	s.userRepo.EXPECT().GetByEmail(req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // failed login audit log
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // failed login audit log
 
-	tokens, err := s.authService.Login(req, "192.168.1.1", "Mozilla/5.0")
+	tokens, err := s.authService.Login(context.Background(), req, "192.168.1.1", "Mozilla/5.0")
 
 	s.Equal(ErrInvalidCredentials, err)
 	s.Nil(tokens)
@@ -233,12 +285,12 @@ func (s *AuthServiceTestSuite) TestLogin_AccountLockoutAfterFailedAttempts() {
 	}
 
 	// Third failed attempt - this should lock the account
-	s.userRepo.EXPECT().GetByEmail(lockoutEmail).Return(user, nil).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), lockoutEmail).Return(user, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword("WrongPassword", user.PasswordHash).Return(false).Times(1)
-	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(2) // account locked + failed login audit logs
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2) // account locked + failed login audit logs
 
-	_, err := s.authService.Login(wrongReq, "192.168.1.1", "Mozilla/5.0")
+	_, err := s.authService.Login(context.Background(), wrongReq, "192.168.1.1", "Mozilla/5.0")
 	s.Equal(ErrInvalidCredentials, err)
 
 	// Now try with correct password - should be locked
@@ -259,10 +311,10 @@ func (s *AuthServiceTestSuite) TestLogin_AccountLockoutAfterFailedAttempts() {
 		Password: lockoutPassword,
 	}
 
-	s.userRepo.EXPECT().GetByEmail(lockoutEmail).Return(lockedUser, nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // account locked audit log
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), lockoutEmail).Return(lockedUser, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // account locked audit log
 
-	tokens, err := s.authService.Login(correctReq, "192.168.1.1", "Mozilla/5.0")
+	tokens, err := s.authService.Login(context.Background(), correctReq, "192.168.1.1", "Mozilla/5.0")
 	s.Equal(ErrAccountLocked, err)
 	s.Nil(tokens)
 }
@@ -294,15 +346,15 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_SuccessfulTokenRefresh() {
 
 	// Setup mock expectations
 	s.tokenService.EXPECT().ValidateRefreshToken(refreshToken).Return(claims, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(storedToken, nil).Times(1)
-	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Update(gomock.Any()).Return(nil).Times(1) // Revoke old token
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(storedToken, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1) // Revoke old token
 	s.tokenService.EXPECT().GenerateAccessToken(user).Return("new_access_token", expiresAt, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("new_refresh_token", time.Now().Add(7*24*time.Hour), nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // successful token refresh audit log
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // successful token refresh audit log
 
-	newTokens, err := s.authService.RefreshTokens(refreshToken, "192.168.1.1", "Mozilla/5.0")
+	newTokens, err := s.authService.RefreshTokens(context.Background(), refreshToken, "192.168.1.1", "Mozilla/5.0")
 
 	s.NoError(err)
 	s.NotNil(newTokens)
@@ -313,9 +365,9 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_SuccessfulTokenRefresh() {
 func (s *AuthServiceTestSuite) TestRefreshTokens_InvalidRefreshToken() {
 	// Setup mock expectations
 	s.tokenService.EXPECT().ValidateRefreshToken("invalid.refresh.token").Return(nil, errors.New("invalid token")).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // failed token refresh audit log
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // failed token refresh audit log
 
-	tokens, err := s.authService.RefreshTokens("invalid.refresh.token", "192.168.1.1", "Mozilla/5.0")
+	tokens, err := s.authService.RefreshTokens(context.Background(), "invalid.refresh.token", "192.168.1.1", "Mozilla/5.0")
 
 	s.Equal(ErrInvalidRefreshToken, err)
 	s.Nil(tokens)
@@ -351,15 +403,15 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_UsingRevokedRefreshToken() {
 
 	// First refresh expectations
 	s.tokenService.EXPECT().ValidateRefreshToken(refreshToken).Return(claims, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(storedToken, nil).Times(1)
-	s.userRepo.EXPECT().GetByID(userID).Return(user, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Update(gomock.Any()).Return(nil).Times(1) // Revoke old token
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(storedToken, nil).Times(1)
+	s.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1) // Revoke old token
 	s.tokenService.EXPECT().GenerateAccessToken(user).Return("new_access_token", expiresAt, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID).Return("new_refresh_token", now.Add(7*24*time.Hour), nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
-	newTokens, err := s.authService.RefreshTokens(refreshToken, "192.168.1.1", "Mozilla/5.0")
+	newTokens, err := s.authService.RefreshTokens(context.Background(), refreshToken, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 	s.NotNil(newTokens)
 
@@ -373,10 +425,10 @@ func (s *AuthServiceTestSuite) TestRefreshTokens_UsingRevokedRefreshToken() {
 	}
 
 	s.tokenService.EXPECT().ValidateRefreshToken(refreshToken).Return(claims, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(revokedToken, nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // failed token refresh audit log
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(revokedToken, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // failed token refresh audit log
 
-	tokens2, err := s.authService.RefreshTokens(refreshToken, "192.168.1.1", "Mozilla/5.0")
+	tokens2, err := s.authService.RefreshTokens(context.Background(), refreshToken, "192.168.1.1", "Mozilla/5.0")
 	s.Equal(ErrInvalidRefreshToken, err)
 	s.Nil(tokens2)
 }
@@ -396,19 +448,19 @@ func (s *AuthServiceTestSuite) TestLogout_SuccessfulLogout() {
 	// Logout expectations
 	s.tokenService.EXPECT().ValidateAccessToken(accessToken).Return(claims, nil).Times(1)
 	s.tokenService.EXPECT().GetTokenExpiry(accessToken).Return(time.Now().Add(15*time.Minute), nil).Times(1)
-	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // blacklist token
-	s.refreshTokenRepo.EXPECT().RevokeAllForUser(userID).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // logout audit log
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // blacklist token
+	s.refreshTokenRepo.EXPECT().RevokeAllForUser(gomock.Any(), userID).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // logout audit log
 
-	err := s.authService.Logout(accessToken, "192.168.1.1", "Mozilla/5.0")
+	err := s.authService.Logout(context.Background(), accessToken, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 
 	// After logout, refresh token should not work
 	s.tokenService.EXPECT().ValidateRefreshToken(refreshToken).Return(claims, nil).Times(1)
-	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any()).Return(nil, repositories.ErrRefreshTokenNotFound).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // failed token refresh audit log
+	s.refreshTokenRepo.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrRefreshTokenNotFound).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // failed token refresh audit log
 
-	newTokens, err := s.authService.RefreshTokens(refreshToken, "192.168.1.1", "Mozilla/5.0")
+	newTokens, err := s.authService.RefreshTokens(context.Background(), refreshToken, "192.168.1.1", "Mozilla/5.0")
 	s.Equal(ErrInvalidRefreshToken, err)
 	s.Nil(newTokens)
 }
@@ -419,7 +471,7 @@ func (s *AuthServiceTestSuite) TestLogout_WithInvalidToken() {
 	s.tokenService.EXPECT().GetJTI("invalid.access.token").Return("", errors.New("invalid token")).Times(1)
 
 	// Should not error - logout is idempotent
-	err := s.authService.Logout("invalid.access.token", "192.168.1.1", "Mozilla/5.0")
+	err := s.authService.Logout(context.Background(), "invalid.access.token", "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -437,21 +489,21 @@ func (s *AuthServiceTestSuite) TestLogout_MultipleTimes() {
 	// First logout - successful
 	s.tokenService.EXPECT().ValidateAccessToken(accessToken).Return(claims, nil).Times(1)
 	s.tokenService.EXPECT().GetTokenExpiry(accessToken).Return(time.Now().Add(15*time.Minute), nil).Times(1)
-	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // blacklist token
-	s.refreshTokenRepo.EXPECT().RevokeAllForUser(userID).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // logout audit log
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // blacklist token
+	s.refreshTokenRepo.EXPECT().RevokeAllForUser(gomock.Any(), userID).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // logout audit log
 
-	err := s.authService.Logout(accessToken, "192.168.1.1", "Mozilla/5.0")
+	err := s.authService.Logout(context.Background(), accessToken, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 
 	// Second logout - should still be successful (idempotent)
 	s.tokenService.EXPECT().ValidateAccessToken(accessToken).Return(claims, nil).Times(1)
 	s.tokenService.EXPECT().GetTokenExpiry(accessToken).Return(time.Now().Add(15*time.Minute), nil).Times(1)
-	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // blacklist token
-	s.refreshTokenRepo.EXPECT().RevokeAllForUser(userID).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1) // logout audit log
+	s.blacklistedTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // blacklist token
+	s.refreshTokenRepo.EXPECT().RevokeAllForUser(gomock.Any(), userID).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1) // logout audit log
 
-	err = s.authService.Logout(accessToken, "192.168.1.1", "Mozilla/5.0")
+	err = s.authService.Logout(context.Background(), accessToken, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 }
 
@@ -473,23 +525,23 @@ func (s *AuthServiceTestSuite) TestPasswordHashing_PasswordsAreHashedDifferently
 	}
 
 	// First registration
-	s.userRepo.EXPECT().GetByEmail(req1.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req1.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
 	s.passwordService.EXPECT().HashPassword(password).Return("hashed_password_1", nil).Times(1)
-	s.userRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(2) // account creation + registration audit logs
+	s.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2) // account creation + registration audit logs
 
-	user1, err := s.authService.Register(req1, "192.168.1.1", "Mozilla/5.0")
+	user1, err := s.authService.Register(context.Background(), req1, "192.168.1.1", "Mozilla/5.0")
 	s.Require().NoError(err)
 
 	// Second registration with different hash (due to salt)
-	s.userRepo.EXPECT().GetByEmail(req2.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req2.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
 	s.passwordService.EXPECT().HashPassword(password).Return("hashed_password_2", nil).Times(1)
-	s.userRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(2) // account creation + registration audit logs
+	s.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2) // account creation + registration audit logs
 
-	user2, err := s.authService.Register(req2, "192.168.1.1", "Mozilla/5.0")
+	user2, err := s.authService.Register(context.Background(), req2, "192.168.1.1", "Mozilla/5.0")
 	s.Require().NoError(err)
 
 	// Same password should result in different hashes (due to salt)
@@ -523,28 +575,28 @@ func (s *AuthServiceTestSuite) TestPasswordHashing_PasswordsAreHashedDifferently
 	expiresAt := time.Now().Add(15 * time.Minute)
 
 	// First login
-	s.userRepo.EXPECT().GetByEmail(req1.Email).Return(user1Model, nil).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req1.Email).Return(user1Model, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword(password, "hashed_password_1").Return(true).Times(1)
-	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	s.tokenService.EXPECT().GenerateAccessToken(user1Model).Return("access_token_1", expiresAt, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID1).Return("refresh_token_1", time.Now().Add(7*24*time.Hour), nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
-	tokens1, err := s.authService.Login(loginReq1, "192.168.1.1", "Mozilla/5.0")
+	tokens1, err := s.authService.Login(context.Background(), loginReq1, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 	s.NotNil(tokens1)
 
 	// Second login
-	s.userRepo.EXPECT().GetByEmail(req2.Email).Return(user2Model, nil).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req2.Email).Return(user2Model, nil).Times(1)
 	s.passwordService.EXPECT().ComparePassword(password, "hashed_password_2").Return(true).Times(1)
-	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any()).Return(nil).Times(1)
+	s.userRepo.EXPECT().UpdateFailedLoginAttempts(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	s.tokenService.EXPECT().GenerateAccessToken(user2Model).Return("access_token_2", expiresAt, nil).Times(1)
 	s.tokenService.EXPECT().GenerateRefreshToken(userID2).Return("refresh_token_2", time.Now().Add(7*24*time.Hour), nil).Times(1)
-	s.refreshTokenRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.auditRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.refreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
-	tokens2, err := s.authService.Login(loginReq2, "192.168.1.1", "Mozilla/5.0")
+	tokens2, err := s.authService.Login(context.Background(), loginReq2, "192.168.1.1", "Mozilla/5.0")
 	s.NoError(err)
 	s.NotNil(tokens2)
 }
@@ -558,21 +610,21 @@ func (s *AuthServiceTestSuite) TestAuditLogging_RegistrationCreatesAuditLog() {
 	}
 
 	// Setup mock expectations - the audit log create should be called
-	s.userRepo.EXPECT().GetByEmail(req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
 	s.passwordService.EXPECT().HashPassword(req.Password).Return("hashed_password", nil).Times(1)
-	s.userRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
-	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any()).Return(nil).Times(1)
+	s.userRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.accountService.EXPECT().CreateAccountsForNewUser(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	// Capture the audit log to verify it was created
 	var capturedAuditLog *models.AuditLog
-	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 		if log.Action == models.AuditActionRegister {
 			capturedAuditLog = log
 		}
 		return nil
 	}).Times(2) // account creation + registration audit logs
 
-	_, err := s.authService.Register(req, "192.168.1.1", "TestAgent")
+	_, err := s.authService.Register(context.Background(), req, "192.168.1.1", "TestAgent")
 	s.Require().NoError(err)
 
 	// Verify that registration audit log was created
@@ -589,18 +641,18 @@ func (s *AuthServiceTestSuite) TestAuditLogging_FailedLoginCreatesAuditLog() {
 	}
 
 	// Setup mock expectations
-	s.userRepo.EXPECT().GetByEmail(req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.userRepo.EXPECT().GetByEmail(gomock.Any(), req.Email).Return(nil, repositories.ErrUserNotFound).Times(1)
 
 	// Capture the audit log to verify it was created
 	var capturedAuditLog *models.AuditLog
-	s.auditRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(log *models.AuditLog) error {
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
 		if log.Action == models.AuditActionFailedLogin {
 			capturedAuditLog = log
 		}
 		return nil
 	}).Times(1) // failed login audit log
 
-	_, err := s.authService.Login(req, "192.168.1.2", "TestAgent2")
+	_, err := s.authService.Login(context.Background(), req, "192.168.1.2", "TestAgent2")
 	s.Error(err)
 
 	// Verify that failed login audit log was created
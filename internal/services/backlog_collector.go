@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// backlogCollectTimeout bounds the queries made for a single scrape.
+const backlogCollectTimeout = 5 * time.Second
+
+var (
+	queueDepthDesc = prometheus.NewDesc(
+		"transaction_queue_depth",
+		"Current number of transaction processing queue items by status",
+		[]string{"status"}, nil,
+	)
+	queuePausedDesc = prometheus.NewDesc(
+		"transaction_queue_paused",
+		"1 while an operator has paused the transaction processing queue, otherwise 0",
+		nil, nil,
+	)
+	queueOldestPendingDesc = prometheus.NewDesc(
+		"transaction_queue_oldest_pending_seconds",
+		"Age in seconds of the oldest pending queue item; 0 when nothing is pending",
+		nil, nil,
+	)
+	webhookDeliveryBacklogDesc = prometheus.NewDesc(
+		"customer_webhook_deliveries_pending",
+		"Customer webhook deliveries waiting to be sent or retried to active subscriptions",
+		nil, nil,
+	)
+	externalTransfersPendingDesc = prometheus.NewDesc(
+		"external_transfers_pending",
+		"External transfers waiting for a final status from Northwind; escalated=true are in the stuck transfer queue",
+		[]string{"escalated"}, nil,
+	)
+)
+
+// BacklogCollector reports the depth of the work queues the API drains in the background. The
+// values are read from the database on every scrape, so they stay correct across restarts and
+// when several instances share the queues.
+type BacklogCollector struct {
+	processingService TransactionProcessingServiceInterface
+	subscriptionRepo  repositories.WebhookSubscriptionRepositoryInterface
+	transferRepo      repositories.TransferRepositoryInterface
+	logger            *slog.Logger
+}
+
+func NewBacklogCollector(
+	processingService TransactionProcessingServiceInterface,
+	subscriptionRepo repositories.WebhookSubscriptionRepositoryInterface,
+	transferRepo repositories.TransferRepositoryInterface,
+) *BacklogCollector {
+	return &BacklogCollector{
+		processingService: processingService,
+		subscriptionRepo:  subscriptionRepo,
+		transferRepo:      transferRepo,
+		logger:            slog.Default().With("service", "BacklogCollector"),
+	}
+}
+
+func (c *BacklogCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueDepthDesc
+	ch <- queuePausedDesc
+	ch <- queueOldestPendingDesc
+	ch <- webhookDeliveryBacklogDesc
+	ch <- externalTransfersPendingDesc
+}
+
+// Collect queries each backlog independently. A failed query is logged and its metrics are left
+// out of the scrape rather than reported as zero.
+func (c *BacklogCollector) Collect(ch chan<- prometheus.Metric) {
+	// Scrapes carry no context, so bound the queries to keep a slow database from stalling them.
+	ctx, cancel := context.WithTimeout(context.Background(), backlogCollectTimeout)
+	defer cancel()
+
+	c.collectQueue(ctx, ch)
+
+	if pending, err := c.subscriptionRepo.CountPendingDeliveries(ctx); err != nil {
+		c.logger.Error("failed to count pending webhook deliveries", "error", err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(webhookDeliveryBacklogDesc, prometheus.GaugeValue, float64(pending))
+	}
+
+	if pending, escalated, err := c.transferRepo.CountPendingExternal(ctx); err != nil {
+		c.logger.Error("failed to count pending external transfers", "error", err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(externalTransfersPendingDesc, prometheus.GaugeValue, float64(pending), "false")
+		ch <- prometheus.MustNewConstMetric(externalTransfersPendingDesc, prometheus.GaugeValue, float64(escalated), "true")
+	}
+}
+
+func (c *BacklogCollector) collectQueue(ctx context.Context, ch chan<- prometheus.Metric) {
+	metrics, err := c.processingService.GetQueueMetrics(ctx)
+	if err != nil {
+		c.logger.Error("failed to get processing queue metrics", "error", err)
+		return
+	}
+
+	depths := map[string]int64{
+		models.QueueStatusPending:      metrics.PendingCount,
+		models.QueueStatusProcessing:   metrics.ProcessingCount,
+		models.QueueStatusFailed:       metrics.FailedCount,
+		models.QueueStatusDeadLettered: metrics.DeadLetteredCount,
+	}
+	for status, depth := range depths {
+		ch <- prometheus.MustNewConstMetric(queueDepthDesc, prometheus.GaugeValue, float64(depth), status)
+	}
+
+	paused := 0.0
+	if metrics.Paused {
+		paused = 1
+	}
+	ch <- prometheus.MustNewConstMetric(queuePausedDesc, prometheus.GaugeValue, paused)
+
+	var oldest time.Duration
+	if metrics.OldestPending != nil {
+		if oldest, err = time.ParseDuration(*metrics.OldestPending); err != nil {
+			c.logger.Warn("failed to parse oldest pending age", "value", *metrics.OldestPending, "error", err)
+			return
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(queueOldestPendingDesc, prometheus.GaugeValue, oldest.Seconds())
+}
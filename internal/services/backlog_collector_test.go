@@ -0,0 +1,84 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/suite"
+)
+
+type BacklogCollectorTestSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	processingService *service_mocks.MockTransactionProcessingServiceInterface
+	subscriptionRepo  *repository_mocks.MockWebhookSubscriptionRepositoryInterface
+	transferRepo      *repository_mocks.MockTransferRepositoryInterface
+	collector         *BacklogCollector
+}
+
+func (s *BacklogCollectorTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.processingService = service_mocks.NewMockTransactionProcessingServiceInterface(s.ctrl)
+	s.subscriptionRepo = repository_mocks.NewMockWebhookSubscriptionRepositoryInterface(s.ctrl)
+	s.transferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
+	s.collector = NewBacklogCollector(s.processingService, s.subscriptionRepo, s.transferRepo)
+}
+
+func (s *BacklogCollectorTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestBacklogCollectorTestSuite(t *testing.T) {
+	suite.Run(t, new(BacklogCollectorTestSuite))
+}
+
+func (s *BacklogCollectorTestSuite) TestCollect_ReportsBacklogs() {
+	oldest := "1m30s"
+	s.processingService.EXPECT().GetQueueMetrics(gomock.Any()).Return(&dto.QueueMetrics{
+		PendingCount:      12,
+		ProcessingCount:   3,
+		CompletedCount:    500,
+		DeadLetteredCount: 1,
+		OldestPending:     &oldest,
+		Paused:            true,
+	}, nil)
+	s.subscriptionRepo.EXPECT().CountPendingDeliveries(gomock.Any()).Return(int64(7), nil)
+	s.transferRepo.EXPECT().CountPendingExternal(gomock.Any()).Return(int64(4), int64(2), nil)
+
+	expected := `
+# HELP customer_webhook_deliveries_pending Customer webhook deliveries waiting to be sent or retried to active subscriptions
+# TYPE customer_webhook_deliveries_pending gauge
+customer_webhook_deliveries_pending 7
+# HELP external_transfers_pending External transfers waiting for a final status from Northwind; escalated=true are in the stuck transfer queue
+# TYPE external_transfers_pending gauge
+external_transfers_pending{escalated="false"} 4
+external_transfers_pending{escalated="true"} 2
+# HELP transaction_queue_depth Current number of transaction processing queue items by status
+# TYPE transaction_queue_depth gauge
+transaction_queue_depth{status="dead_lettered"} 1
+transaction_queue_depth{status="failed"} 0
+transaction_queue_depth{status="pending"} 12
+transaction_queue_depth{status="processing"} 3
+# HELP transaction_queue_oldest_pending_seconds Age in seconds of the oldest pending queue item; 0 when nothing is pending
+# TYPE transaction_queue_oldest_pending_seconds gauge
+transaction_queue_oldest_pending_seconds 90
+# HELP transaction_queue_paused 1 while an operator has paused the transaction processing queue, otherwise 0
+# TYPE transaction_queue_paused gauge
+transaction_queue_paused 1
+`
+	s.NoError(testutil.CollectAndCompare(s.collector, strings.NewReader(expected)))
+}
+
+func (s *BacklogCollectorTestSuite) TestCollect_SkipsFailedQueries() {
+	s.processingService.EXPECT().GetQueueMetrics(gomock.Any()).Return(nil, errors.New("database unavailable"))
+	s.subscriptionRepo.EXPECT().CountPendingDeliveries(gomock.Any()).Return(int64(0), nil)
+	s.transferRepo.EXPECT().CountPendingExternal(gomock.Any()).Return(int64(0), int64(0), errors.New("database unavailable"))
+
+	s.Equal(1, testutil.CollectAndCount(s.collector))
+}
@@ -0,0 +1,495 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	complianceSubmissionBatchLimit = 50
+	maxComplianceSubmissionBackoff = 1 * time.Hour
+	complianceDateLayout           = "2006-01-02"
+)
+
+var (
+	ErrComplianceReportNotFound         = errors.New("compliance report not found")
+	ErrComplianceReportNotPendingReview = errors.New("compliance report is not pending review")
+)
+
+type complianceService struct {
+	reportRepo       repositories.ComplianceReportRepositoryInterface
+	auditRepo        repositories.AuditLogRepositoryInterface
+	regulatorClient  RegulatorClientInterface
+	config           config.ComplianceConfig
+	logger           *slog.Logger
+	now              func() time.Time
+	lastGeneratedDay string // Business day GeneratePreviousBusinessDay last completed
+}
+
+func NewComplianceService(
+	reportRepo repositories.ComplianceReportRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	regulatorClient RegulatorClientInterface,
+	cfg config.ComplianceConfig,
+) ComplianceServiceInterface {
+	if cfg.BusinessDayLocation == nil {
+		cfg.BusinessDayLocation = time.UTC
+	}
+	return &complianceService{
+		reportRepo:      reportRepo,
+		auditRepo:       auditRepo,
+		regulatorClient: regulatorClient,
+		config:          cfg,
+		logger:          slog.Default().With("service", "ComplianceService"),
+		now:             time.Now,
+	}
+}
+
+// complianceReportDetails is the shape of ComplianceReport.Details.
+type complianceReportDetails struct {
+	Threshold          string                            `json:"threshold"`
+	NearThresholdFloor string                            `json:"near_threshold_floor,omitempty"`
+	Transactions       []dto.ComplianceReportTransaction `json:"transactions"`
+}
+
+// GenerateReports aggregates each customer's cash and transfer activity for the business day
+// and records a CTR when the inbound or outbound total exceeds the threshold, and a
+// structuring alert when near-threshold transactions repeat across days or accounts within
+// the structuring window. Moves between a customer's own accounts are ignored.
+func (s *complianceService) GenerateReports(ctx context.Context, businessDate time.Time) (*dto.GenerateComplianceReportsResponse, error) {
+	loc := s.config.BusinessDayLocation
+	day := time.Date(businessDate.Year(), businessDate.Month(), businessDate.Day(), 0, 0, 0, 0, time.UTC)
+	windowStart := day.AddDate(0, 0, -(s.windowDays() - 1))
+
+	from := time.Date(windowStart.Year(), windowStart.Month(), windowStart.Day(), 0, 0, 0, 0, loc)
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	to := dayStart.AddDate(0, 0, 1)
+
+	activity, err := s.reportRepo.ListActivity(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := make(map[uuid.UUID][]models.ComplianceActivity)
+	var userIDs []uuid.UUID
+	for _, a := range activity {
+		if a.IsOwnAccountMove() {
+			continue
+		}
+		if _, ok := byUser[a.UserID]; !ok {
+			userIDs = append(userIDs, a.UserID)
+		}
+		byUser[a.UserID] = append(byUser[a.UserID], a)
+	}
+
+	result := &dto.GenerateComplianceReportsResponse{
+		BusinessDate:     day.Format(complianceDateLayout),
+		CustomersScanned: len(userIDs),
+	}
+
+	for _, userID := range userIDs {
+		userActivity := byUser[userID]
+
+		var dayActivity []models.ComplianceActivity
+		for _, a := range userActivity {
+			if !a.CreatedAt.Before(dayStart) {
+				dayActivity = append(dayActivity, a)
+			}
+		}
+
+		if report := s.buildCTR(userID, day, dayActivity); report != nil {
+			created, err := s.createReport(ctx, report)
+			if err != nil {
+				return nil, err
+			}
+			if created {
+				result.CTRReports++
+			}
+		}
+
+		if report := s.buildStructuring(userID, day, windowStart, dayStart, userActivity); report != nil {
+			created, err := s.createReport(ctx, report)
+			if err != nil {
+				return nil, err
+			}
+			if created {
+				result.StructuringReports++
+			}
+		}
+	}
+
+	s.logger.InfoContext(ctx, "generated compliance reports",
+		"business_date", result.BusinessDate,
+		"customers", result.CustomersScanned,
+		"ctr_reports", result.CTRReports,
+		"structuring_reports", result.StructuringReports)
+
+	return result, nil
+}
+
+// GeneratePreviousBusinessDay generates reports for yesterday in the business day time zone.
+// It runs at most once per day per process; generation is idempotent, so a restart repeating
+// the day is harmless.
+func (s *complianceService) GeneratePreviousBusinessDay(ctx context.Context) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "ComplianceService.GeneratePreviousBusinessDay")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	yesterday := s.now().In(s.config.BusinessDayLocation).AddDate(0, 0, -1)
+	key := yesterday.Format(complianceDateLayout)
+	if key == s.lastGeneratedDay {
+		return nil
+	}
+
+	if _, err := s.GenerateReports(ctx, yesterday); err != nil {
+		return err
+	}
+	s.lastGeneratedDay = key
+	return nil
+}
+
+// ListReports lists reports matching the filters, newest business day first.
+func (s *complianceService) ListReports(ctx context.Context, filters models.ComplianceReportFilters, offset, limit int) ([]models.ComplianceReport, int64, error) {
+	return s.reportRepo.List(ctx, filters, offset, limit)
+}
+
+// GetReport returns a single report with its customer.
+func (s *complianceService) GetReport(ctx context.Context, reportID uuid.UUID) (*models.ComplianceReport, error) {
+	report, err := s.reportRepo.GetByID(ctx, reportID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrComplianceReportNotFound) {
+			return nil, ErrComplianceReportNotFound
+		}
+		return nil, err
+	}
+	return report, nil
+}
+
+// GetReportFile builds the regulator file for a report. Reports that have not been reviewed
+// yet produce a file with an empty review section.
+func (s *complianceService) GetReportFile(ctx context.Context, reportID uuid.UUID) (*dto.ComplianceReportFile, error) {
+	report, err := s.GetReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildFile(report)
+}
+
+// ApproveReport approves a pending report and queues it for submission to the regulator.
+func (s *complianceService) ApproveReport(ctx context.Context, adminID, reportID uuid.UUID, note string) (*models.ComplianceReport, error) {
+	return s.review(ctx, adminID, reportID, note, true)
+}
+
+// RejectReport dismisses a pending report; it is kept for the record but never filed.
+func (s *complianceService) RejectReport(ctx context.Context, adminID, reportID uuid.UUID, note string) (*models.ComplianceReport, error) {
+	return s.review(ctx, adminID, reportID, note, false)
+}
+
+// SubmitApprovedReports files approved reports that are due. Failed submissions are retried
+// with exponential backoff, capped at one hour, until the regulator accepts them.
+func (s *complianceService) SubmitApprovedReports(ctx context.Context) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "ComplianceService.SubmitApprovedReports")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	reports, err := s.reportRepo.FindDueSubmissions(ctx, complianceSubmissionBatchLimit)
+	if err != nil {
+		return err
+	}
+
+	for i := range reports {
+		report := &reports[i]
+
+		file, err := s.buildFile(report)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to build compliance report file", "error", err, "report_id", report.ID)
+			continue
+		}
+
+		statusCode, responseBody, err := s.regulatorClient.SubmitComplianceReport(ctx, file, report.SubmissionAttempts+1)
+
+		now := s.now()
+		report.SubmissionAttempts++
+		if statusCode != 0 {
+			report.SubmissionStatusCode = &statusCode
+		}
+		if responseBody != "" {
+			report.SubmissionResponse = &responseBody
+		}
+
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to submit compliance report", "error", err, "report_id", report.ID, "attempt", report.SubmissionAttempts)
+			lastError := err.Error()
+			report.LastError = &lastError
+			backoff := initialBackoffPeriod * time.Duration(math.Pow(2, float64(report.SubmissionAttempts-1)))
+			if backoff > maxComplianceSubmissionBackoff {
+				backoff = maxComplianceSubmissionBackoff
+			}
+			nextAttempt := now.Add(backoff)
+			report.NextSubmissionAt = &nextAttempt
+		} else {
+			s.logger.InfoContext(ctx, "submitted compliance report", "report_id", report.ID, "report_type", report.ReportType)
+			report.Status = models.ComplianceReportStatusSubmitted
+			report.SubmittedAt = &now
+			report.NextSubmissionAt = nil
+			report.LastError = nil
+		}
+
+		if err := s.reportRepo.Update(ctx, report); err != nil {
+			s.logger.ErrorContext(ctx, "failed to update compliance report submission", "error", err, "report_id", report.ID)
+			continue
+		}
+
+		if report.Status == models.ComplianceReportStatusSubmitted {
+			s.audit(ctx, report.ReviewedBy, report, "compliance_report.submitted", models.JSONBMap{
+				"attempts":    report.SubmissionAttempts,
+				"status_code": statusCode,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (s *complianceService) review(ctx context.Context, adminID, reportID uuid.UUID, note string, approve bool) (*models.ComplianceReport, error) {
+	report, err := s.GetReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsPendingReview() {
+		return nil, fmt.Errorf("%w: status is %s", ErrComplianceReportNotPendingReview, report.Status)
+	}
+
+	action := "compliance_report.rejected"
+	if approve {
+		report.Approve(adminID, note)
+		action = "compliance_report.approved"
+	} else {
+		report.Reject(adminID, note)
+	}
+
+	if err := s.reportRepo.Update(ctx, report); err != nil {
+		return nil, fmt.Errorf("failed to review compliance report: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "reviewed compliance report", "report_id", report.ID, "status", report.Status, "admin_id", adminID)
+	s.audit(ctx, &adminID, report, action, models.JSONBMap{"note": note})
+
+	return report, nil
+}
+
+// buildCTR returns a CTR when the customer's inbound or outbound total for the day exceeds
+// the threshold, or nil.
+func (s *complianceService) buildCTR(userID uuid.UUID, day time.Time, activity []models.ComplianceActivity) *models.ComplianceReport {
+	report := s.newReport(models.ComplianceReportTypeCTR, userID, day, day, activity)
+	if !report.TotalIn().GreaterThan(s.config.CTRThreshold) && !report.TotalOut().GreaterThan(s.config.CTRThreshold) {
+		return nil
+	}
+	report.Details = s.details(activity, "")
+	return report
+}
+
+// buildStructuring returns a structuring alert when the window holds enough near-threshold
+// transactions, at least one of them on the reported day, spread over more than one business
+// day or account. Otherwise it returns nil.
+func (s *complianceService) buildStructuring(userID uuid.UUID, day, windowStart, dayStart time.Time, activity []models.ComplianceActivity) *models.ComplianceReport {
+	floor := s.nearThresholdFloor()
+
+	var near []models.ComplianceActivity
+	days := make(map[string]struct{})
+	accounts := make(map[uuid.UUID]struct{})
+	onDay := false
+	for _, a := range activity {
+		if a.Amount.LessThan(floor) || !a.Amount.LessThan(s.config.CTRThreshold) {
+			continue
+		}
+		near = append(near, a)
+		days[a.CreatedAt.In(s.config.BusinessDayLocation).Format(complianceDateLayout)] = struct{}{}
+		accounts[a.AccountID] = struct{}{}
+		if !a.CreatedAt.Before(dayStart) {
+			onDay = true
+		}
+	}
+
+	if !onDay || len(near) < s.config.StructuringMinTransactions || (len(days) < 2 && len(accounts) < 2) {
+		return nil
+	}
+
+	report := s.newReport(models.ComplianceReportTypeStructuring, userID, day, windowStart, near)
+	report.Details = s.details(near, floor.StringFixed(2))
+	return report
+}
+
+func (s *complianceService) newReport(reportType string, userID uuid.UUID, day, windowStart time.Time, activity []models.ComplianceActivity) *models.ComplianceReport {
+	report := &models.ComplianceReport{
+		ReportType:        reportType,
+		UserID:            userID,
+		BusinessDate:      day,
+		WindowStart:       windowStart,
+		CashInAmount:      decimal.Zero,
+		CashOutAmount:     decimal.Zero,
+		TransferInAmount:  decimal.Zero,
+		TransferOutAmount: decimal.Zero,
+		TransactionCount:  len(activity),
+		Status:            models.ComplianceReportStatusPendingReview,
+	}
+	for _, a := range activity {
+		inbound := a.TransactionType == models.TransactionTypeCredit
+		switch {
+		case a.Category() == models.ComplianceActivityCash && inbound:
+			report.CashInAmount = report.CashInAmount.Add(a.Amount)
+		case a.Category() == models.ComplianceActivityCash:
+			report.CashOutAmount = report.CashOutAmount.Add(a.Amount)
+		case inbound:
+			report.TransferInAmount = report.TransferInAmount.Add(a.Amount)
+		default:
+			report.TransferOutAmount = report.TransferOutAmount.Add(a.Amount)
+		}
+	}
+	return report
+}
+
+func (s *complianceService) details(activity []models.ComplianceActivity, floor string) models.JSONBMap {
+	details := complianceReportDetails{
+		Threshold:          s.config.CTRThreshold.StringFixed(2),
+		NearThresholdFloor: floor,
+		Transactions:       make([]dto.ComplianceReportTransaction, 0, len(activity)),
+	}
+	for _, a := range activity {
+		direction := "out"
+		if a.TransactionType == models.TransactionTypeCredit {
+			direction = "in"
+		}
+		details.Transactions = append(details.Transactions, dto.ComplianceReportTransaction{
+			TransactionID: a.TransactionID,
+			AccountID:     a.AccountID,
+			PostedAt:      a.CreatedAt.UTC(),
+			Direction:     direction,
+			Category:      a.Category(),
+			Amount:        a.Amount.StringFixed(2),
+		})
+	}
+	sort.SliceStable(details.Transactions, func(i, j int) bool {
+		return details.Transactions[i].PostedAt.Before(details.Transactions[j].PostedAt)
+	})
+
+	// Store the details the way they read back from the database.
+	var m models.JSONBMap
+	raw, _ := json.Marshal(details)
+	_ = json.Unmarshal(raw, &m)
+	return m
+}
+
+func (s *complianceService) createReport(ctx context.Context, report *models.ComplianceReport) (bool, error) {
+	created, err := s.reportRepo.Create(ctx, report)
+	if err != nil {
+		return false, err
+	}
+	if created {
+		s.logger.Info("compliance report generated for review",
+			"report_id", report.ID,
+			"report_type", report.ReportType,
+			"user_id", report.UserID,
+			"business_date", report.BusinessDate.Format(complianceDateLayout))
+		s.audit(ctx, nil, report, "compliance_report.generated", models.JSONBMap{
+			"total_in":  report.TotalIn().StringFixed(2),
+			"total_out": report.TotalOut().StringFixed(2),
+		})
+	}
+	return created, nil
+}
+
+func (s *complianceService) buildFile(report *models.ComplianceReport) (*dto.ComplianceReportFile, error) {
+	var details complianceReportDetails
+	raw, err := json.Marshal(report.Details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compliance report details: %w", err)
+	}
+	if err := json.Unmarshal(raw, &details); err != nil {
+		return nil, fmt.Errorf("failed to read compliance report details: %w", err)
+	}
+	if details.Transactions == nil {
+		details.Transactions = []dto.ComplianceReportTransaction{}
+	}
+
+	file := &dto.ComplianceReportFile{
+		Format:            dto.ComplianceReportFileFormat,
+		ReportID:          report.ID,
+		ReportType:        report.ReportType,
+		FilingInstitution: s.config.FilingInstitution,
+		BusinessDate:      report.BusinessDate.Format(complianceDateLayout),
+		WindowStart:       report.WindowStart.Format(complianceDateLayout),
+		Currency:          "USD",
+		Threshold:         details.Threshold,
+		Subject: dto.ComplianceReportSubject{
+			CustomerID: report.UserID,
+			FirstName:  report.User.FirstName,
+			LastName:   report.User.LastName,
+			Email:      report.User.Email,
+		},
+		Totals:       complianceReportTotals(report),
+		Transactions: details.Transactions,
+		GeneratedAt:  report.CreatedAt.UTC(),
+	}
+	if report.ReviewedBy != nil && report.ReviewedAt != nil {
+		file.Review = dto.ComplianceReportReview{ReviewedBy: *report.ReviewedBy, ReviewedAt: report.ReviewedAt.UTC()}
+		if report.ReviewNote != nil {
+			file.Review.Note = *report.ReviewNote
+		}
+	}
+	return file, nil
+}
+
+// complianceReportTotals converts a report's amounts to their file and API representation.
+func complianceReportTotals(report *models.ComplianceReport) dto.ComplianceReportTotals {
+	return dto.ComplianceReportTotals{
+		CashIn:           report.CashInAmount.StringFixed(2),
+		CashOut:          report.CashOutAmount.StringFixed(2),
+		TransferIn:       report.TransferInAmount.StringFixed(2),
+		TransferOut:      report.TransferOutAmount.StringFixed(2),
+		TotalIn:          report.TotalIn().StringFixed(2),
+		TotalOut:         report.TotalOut().StringFixed(2),
+		TransactionCount: report.TransactionCount,
+	}
+}
+
+func (s *complianceService) nearThresholdFloor() decimal.Decimal {
+	return s.config.CTRThreshold.Mul(decimal.NewFromInt(int64(s.config.StructuringBandPercent))).Div(decimal.NewFromInt(100))
+}
+
+func (s *complianceService) windowDays() int {
+	if s.config.StructuringWindowDays < 1 {
+		return 1
+	}
+	return s.config.StructuringWindowDays
+}
+
+func (s *complianceService) audit(ctx context.Context, userID *uuid.UUID, report *models.ComplianceReport, action string, metadata models.JSONBMap) {
+	metadata["report_type"] = report.ReportType
+	metadata["customer_id"] = report.UserID.String()
+	metadata["business_date"] = report.BusinessDate.Format(complianceDateLayout)
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     userID,
+		Action:     action,
+		Resource:   "compliance_report",
+		ResourceID: report.ID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
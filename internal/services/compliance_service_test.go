@@ -0,0 +1,395 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComplianceServiceTestSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	reportRepo      *repository_mocks.MockComplianceReportRepositoryInterface
+	auditRepo       *repository_mocks.MockAuditLogRepositoryInterface
+	regulatorClient *service_mocks.MockRegulatorClientInterface
+	service         ComplianceServiceInterface
+	loc             *time.Location
+	day             time.Time
+	userID          uuid.UUID
+	accountID       uuid.UUID
+}
+
+func (s *ComplianceServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reportRepo = repository_mocks.NewMockComplianceReportRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.regulatorClient = service_mocks.NewMockRegulatorClientInterface(s.ctrl)
+
+	loc, err := time.LoadLocation("America/New_York")
+	s.Require().NoError(err)
+	s.loc = loc
+	s.day = time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	s.userID = uuid.New()
+	s.accountID = uuid.New()
+
+	s.service = NewComplianceService(s.reportRepo, s.auditRepo, s.regulatorClient, config.ComplianceConfig{
+		CTRThreshold:               decimal.NewFromInt(10000),
+		StructuringBandPercent:     80,
+		StructuringWindowDays:      5,
+		StructuringMinTransactions: 3,
+		BusinessDayLocation:        loc,
+		FilingInstitution:          "Array Banking",
+	})
+}
+
+func (s *ComplianceServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestComplianceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ComplianceServiceTestSuite))
+}
+
+// at returns a time on the business day daysBefore days before the reported day.
+func (s *ComplianceServiceTestSuite) at(daysBefore, hour int) time.Time {
+	return time.Date(s.day.Year(), s.day.Month(), s.day.Day()-daysBefore, hour, 0, 0, 0, s.loc)
+}
+
+func (s *ComplianceServiceTestSuite) activity(transactionType string, amount int64, at time.Time) models.ComplianceActivity {
+	return models.ComplianceActivity{
+		TransactionID:   uuid.New(),
+		AccountID:       s.accountID,
+		UserID:          s.userID,
+		TransactionType: transactionType,
+		Amount:          decimal.NewFromInt(amount),
+		CreatedAt:       at,
+	}
+}
+
+func (s *ComplianceServiceTestSuite) expectActivity(activity ...models.ComplianceActivity) {
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, s.loc)
+	to := time.Date(2026, 3, 7, 0, 0, 0, 0, s.loc)
+	s.reportRepo.EXPECT().ListActivity(gomock.Any(), from, to).Return(activity, nil)
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_CTR() {
+	transferID := uuid.New()
+	inbound := s.activity(models.TransactionTypeCredit, 4000, s.at(0, 14))
+	inbound.TransferID = &transferID
+	s.expectActivity(
+		s.activity(models.TransactionTypeCredit, 7000, s.at(0, 9)),
+		inbound,
+		s.activity(models.TransactionTypeDebit, 300, s.at(0, 23)),
+		s.activity(models.TransactionTypeCredit, 7000, s.at(1, 9)), // Previous day, not in the CTR
+	)
+
+	var report *models.ComplianceReport
+	s.reportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *models.ComplianceReport) (bool, error) {
+		report = r
+		return true, nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("compliance_report.generated", log.Action)
+		s.Nil(log.UserID)
+		return nil
+	})
+
+	result, err := s.service.GenerateReports(context.Background(), s.day)
+	s.Require().NoError(err)
+	s.Equal(&dto.GenerateComplianceReportsResponse{BusinessDate: "2026-03-06", CustomersScanned: 1, CTRReports: 1}, result)
+
+	s.Require().NotNil(report)
+	s.Equal(models.ComplianceReportTypeCTR, report.ReportType)
+	s.Equal(s.userID, report.UserID)
+	s.Equal(s.day, report.BusinessDate)
+	s.Equal(s.day, report.WindowStart)
+	s.True(report.CashInAmount.Equal(decimal.NewFromInt(7000)))
+	s.True(report.TransferInAmount.Equal(decimal.NewFromInt(4000)))
+	s.True(report.CashOutAmount.Equal(decimal.NewFromInt(300)))
+	s.Equal(3, report.TransactionCount)
+	s.Equal("10000.00", report.Details["threshold"])
+	s.Len(report.Details["transactions"], 3)
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_AtThresholdIsNotReported() {
+	s.expectActivity(
+		s.activity(models.TransactionTypeCredit, 6000, s.at(0, 9)),
+		s.activity(models.TransactionTypeCredit, 4000, s.at(0, 10)),
+	)
+
+	result, err := s.service.GenerateReports(context.Background(), s.day)
+	s.NoError(err)
+	s.Equal(0, result.CTRReports)
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_IgnoresOwnAccountMoves() {
+	transferID := uuid.New()
+	move := s.activity(models.TransactionTypeCredit, 25000, s.at(0, 9))
+	move.TransferID = &transferID
+	move.CounterpartyUserID = &s.userID
+	s.expectActivity(move)
+
+	result, err := s.service.GenerateReports(context.Background(), s.day)
+	s.NoError(err)
+	s.Equal(0, result.CustomersScanned)
+	s.Equal(0, result.CTRReports)
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_Structuring() {
+	s.expectActivity(
+		s.activity(models.TransactionTypeCredit, 9500, s.at(3, 10)),
+		s.activity(models.TransactionTypeCredit, 200, s.at(2, 10)), // Well below the band
+		s.activity(models.TransactionTypeCredit, 9000, s.at(1, 10)),
+		s.activity(models.TransactionTypeCredit, 8000, s.at(0, 10)),
+	)
+
+	var report *models.ComplianceReport
+	s.reportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *models.ComplianceReport) (bool, error) {
+		report = r
+		return true, nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := s.service.GenerateReports(context.Background(), s.day)
+	s.Require().NoError(err)
+	s.Equal(0, result.CTRReports)
+	s.Equal(1, result.StructuringReports)
+
+	s.Require().NotNil(report)
+	s.Equal(models.ComplianceReportTypeStructuring, report.ReportType)
+	s.Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), report.WindowStart)
+	s.True(report.CashInAmount.Equal(decimal.NewFromInt(26500)))
+	s.Equal(3, report.TransactionCount)
+	s.Equal("8000.00", report.Details["near_threshold_floor"])
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_StructuringAcrossAccountsOnOneDay() {
+	first := s.activity(models.TransactionTypeCredit, 9000, s.at(0, 9))
+	second := s.activity(models.TransactionTypeCredit, 9000, s.at(0, 10))
+	second.AccountID = uuid.New()
+	third := s.activity(models.TransactionTypeCredit, 9000, s.at(0, 11))
+	third.AccountID = uuid.New()
+	s.expectActivity(first, second, third)
+
+	s.reportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	result, err := s.service.GenerateReports(context.Background(), s.day)
+	s.NoError(err)
+	s.Equal(1, result.CTRReports)
+	s.Equal(1, result.StructuringReports)
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_NoStructuring() {
+	testCases := []struct {
+		name     string
+		activity []models.ComplianceActivity
+	}{
+		{
+			name: "none on the reported day",
+			activity: []models.ComplianceActivity{
+				s.activity(models.TransactionTypeCredit, 9000, s.at(3, 10)),
+				s.activity(models.TransactionTypeCredit, 9000, s.at(2, 10)),
+				s.activity(models.TransactionTypeCredit, 9000, s.at(1, 10)),
+			},
+		},
+		{
+			name: "too few",
+			activity: []models.ComplianceActivity{
+				s.activity(models.TransactionTypeCredit, 9000, s.at(1, 10)),
+				s.activity(models.TransactionTypeCredit, 9000, s.at(0, 10)),
+			},
+		},
+		{
+			name: "one day and one account",
+			activity: []models.ComplianceActivity{
+				s.activity(models.TransactionTypeCredit, 3000, s.at(0, 9)),
+				s.activity(models.TransactionTypeDebit, 8500, s.at(0, 10)),
+				s.activity(models.TransactionTypeDebit, 8500, s.at(0, 11)),
+				s.activity(models.TransactionTypeDebit, 8500, s.at(0, 12)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.expectActivity(tc.activity...)
+			if tc.name == "one day and one account" {
+				// The outbound total still exceeds the threshold
+				s.reportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *models.ComplianceReport) (bool, error) {
+					s.Equal(models.ComplianceReportTypeCTR, r.ReportType)
+					return true, nil
+				})
+				s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			result, err := s.service.GenerateReports(context.Background(), s.day)
+			s.NoError(err)
+			s.Equal(0, result.StructuringReports)
+		})
+	}
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_ExistingReportNotCounted() {
+	s.expectActivity(s.activity(models.TransactionTypeCredit, 12000, s.at(0, 9)))
+	s.reportRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	result, err := s.service.GenerateReports(context.Background(), s.day)
+	s.NoError(err)
+	s.Equal(0, result.CTRReports)
+}
+
+func (s *ComplianceServiceTestSuite) TestGenerateReports_ActivityError() {
+	s.reportRepo.EXPECT().ListActivity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	_, err := s.service.GenerateReports(context.Background(), s.day)
+	s.Error(err)
+}
+
+func (s *ComplianceServiceTestSuite) TestGeneratePreviousBusinessDay_OncePerDay() {
+	svc := s.service.(*complianceService)
+	now := time.Date(2026, 3, 7, 1, 30, 0, 0, s.loc)
+	svc.now = func() time.Time { return now }
+
+	s.expectActivity()
+	s.NoError(s.service.GeneratePreviousBusinessDay(context.Background()))
+	s.NoError(s.service.GeneratePreviousBusinessDay(context.Background()))
+
+	now = now.Add(24 * time.Hour)
+	s.reportRepo.EXPECT().ListActivity(gomock.Any(), time.Date(2026, 3, 3, 0, 0, 0, 0, s.loc), time.Date(2026, 3, 8, 0, 0, 0, 0, s.loc)).Return(nil, nil)
+	s.NoError(s.service.GeneratePreviousBusinessDay(context.Background()))
+}
+
+func (s *ComplianceServiceTestSuite) TestApproveReport() {
+	adminID := uuid.New()
+	report := &models.ComplianceReport{ID: uuid.New(), UserID: s.userID, ReportType: models.ComplianceReportTypeCTR, Status: models.ComplianceReportStatusPendingReview}
+	s.reportRepo.EXPECT().GetByID(gomock.Any(), report.ID).Return(report, nil)
+	s.reportRepo.EXPECT().Update(gomock.Any(), report).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("compliance_report.approved", log.Action)
+		s.Equal(adminID, *log.UserID)
+		s.Equal("verified with branch", log.Metadata["note"])
+		return nil
+	})
+
+	approved, err := s.service.ApproveReport(context.Background(), adminID, report.ID, "verified with branch")
+	s.Require().NoError(err)
+	s.Equal(models.ComplianceReportStatusApproved, approved.Status)
+	s.Equal(adminID, *approved.ReviewedBy)
+	s.NotNil(approved.NextSubmissionAt)
+}
+
+func (s *ComplianceServiceTestSuite) TestRejectReport() {
+	report := &models.ComplianceReport{ID: uuid.New(), Status: models.ComplianceReportStatusPendingReview}
+	s.reportRepo.EXPECT().GetByID(gomock.Any(), report.ID).Return(report, nil)
+	s.reportRepo.EXPECT().Update(gomock.Any(), report).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	rejected, err := s.service.RejectReport(context.Background(), uuid.New(), report.ID, "payroll deposit")
+	s.Require().NoError(err)
+	s.Equal(models.ComplianceReportStatusRejected, rejected.Status)
+	s.Nil(rejected.NextSubmissionAt)
+}
+
+func (s *ComplianceServiceTestSuite) TestReviewReport_Errors() {
+	reviewed := &models.ComplianceReport{ID: uuid.New(), Status: models.ComplianceReportStatusSubmitted}
+	s.reportRepo.EXPECT().GetByID(gomock.Any(), reviewed.ID).Return(reviewed, nil)
+	_, err := s.service.ApproveReport(context.Background(), uuid.New(), reviewed.ID, "note")
+	s.ErrorIs(err, ErrComplianceReportNotPendingReview)
+
+	missingID := uuid.New()
+	s.reportRepo.EXPECT().GetByID(gomock.Any(), missingID).Return(nil, repositories.ErrComplianceReportNotFound)
+	_, err = s.service.RejectReport(context.Background(), uuid.New(), missingID, "note")
+	s.ErrorIs(err, ErrComplianceReportNotFound)
+}
+
+func (s *ComplianceServiceTestSuite) approvedReport() *models.ComplianceReport {
+	report := &models.ComplianceReport{
+		ID:               uuid.New(),
+		ReportType:       models.ComplianceReportTypeCTR,
+		UserID:           s.userID,
+		BusinessDate:     s.day,
+		WindowStart:      s.day,
+		CashInAmount:     decimal.NewFromInt(12000),
+		TransactionCount: 1,
+		Status:           models.ComplianceReportStatusPendingReview,
+		Details: models.JSONBMap{
+			"threshold": "10000.00",
+			"transactions": []interface{}{map[string]interface{}{
+				"transaction_id": uuid.NewString(),
+				"account_id":     s.accountID.String(),
+				"posted_at":      "2026-03-06T14:00:00Z",
+				"direction":      "in",
+				"category":       "cash",
+				"amount":         "12000.00",
+			}},
+		},
+		User: models.User{FirstName: "Jane", LastName: "Smith", Email: "jane.smith@example.com"},
+	}
+	report.Approve(uuid.New(), "verified")
+	return report
+}
+
+func (s *ComplianceServiceTestSuite) TestSubmitApprovedReports_Success() {
+	report := s.approvedReport()
+	s.reportRepo.EXPECT().FindDueSubmissions(gomock.Any(), complianceSubmissionBatchLimit).Return([]models.ComplianceReport{*report}, nil)
+	s.regulatorClient.EXPECT().SubmitComplianceReport(gomock.Any(), gomock.Any(), 1).DoAndReturn(func(ctx context.Context, file *dto.ComplianceReportFile, _ int) (int, string, error) {
+		s.Equal(dto.ComplianceReportFileFormat, file.Format)
+		s.Equal(report.ID, file.ReportID)
+		s.Equal("2026-03-06", file.BusinessDate)
+		s.Equal("10000.00", file.Threshold)
+		s.Equal("Array Banking", file.FilingInstitution)
+		s.Equal("Smith", file.Subject.LastName)
+		s.Equal("12000.00", file.Totals.TotalIn)
+		s.Require().Len(file.Transactions, 1)
+		s.Equal("12000.00", file.Transactions[0].Amount)
+		s.Equal(*report.ReviewedBy, file.Review.ReviewedBy)
+		s.Equal("verified", file.Review.Note)
+		return http.StatusAccepted, `{"status":"received"}`, nil
+	})
+	s.reportRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *models.ComplianceReport) error {
+		s.Equal(models.ComplianceReportStatusSubmitted, r.Status)
+		s.Equal(1, r.SubmissionAttempts)
+		s.NotNil(r.SubmittedAt)
+		s.Nil(r.NextSubmissionAt)
+		s.Equal(http.StatusAccepted, *r.SubmissionStatusCode)
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("compliance_report.submitted", log.Action)
+		return nil
+	})
+
+	s.NoError(s.service.SubmitApprovedReports(context.Background()))
+}
+
+func (s *ComplianceServiceTestSuite) TestSubmitApprovedReports_FailureBacksOff() {
+	report := s.approvedReport()
+	report.SubmissionAttempts = 7
+	s.reportRepo.EXPECT().FindDueSubmissions(gomock.Any(), complianceSubmissionBatchLimit).Return([]models.ComplianceReport{*report}, nil)
+	s.regulatorClient.EXPECT().SubmitComplianceReport(gomock.Any(), gomock.Any(), 8).Return(http.StatusServiceUnavailable, "unavailable", errors.New("regulator client: report returned non-2xx status: 503"))
+
+	start := time.Now()
+	s.reportRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *models.ComplianceReport) error {
+		s.Equal(models.ComplianceReportStatusApproved, r.Status)
+		s.Equal(8, r.SubmissionAttempts)
+		s.Contains(*r.LastError, "503")
+		s.WithinDuration(start.Add(maxComplianceSubmissionBackoff), *r.NextSubmissionAt, 5*time.Second)
+		return nil
+	})
+
+	s.NoError(s.service.SubmitApprovedReports(context.Background()))
+}
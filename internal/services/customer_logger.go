@@ -5,12 +5,13 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/array/banking-api/internal/logging"
 	"github.com/google/uuid"
 )
 
 const (
 	// RedactedValue is used to mask sensitive information in logs to avoid logging PII
-	RedactedValue = "***REDACTED***"
+	RedactedValue = logging.RedactedValue
 )
 
 // CustomerLogger provides structured logging for customer-related operations
@@ -1,9 +1,11 @@
 package services
 
 import (
+	"context"
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math/big"
 
 	"github.com/array/banking-api/internal/models"
@@ -14,17 +16,19 @@ import (
 
 // CustomerProfileService handles customer profile operations
 type CustomerProfileService struct {
-	userRepo     repositories.UserRepositoryInterface
-	accountRepo  repositories.AccountRepositoryInterface
-	auditService AuditServiceInterface
+	userRepo          repositories.UserRepositoryInterface
+	accountRepo       repositories.AccountRepositoryInterface
+	auditService      AuditServiceInterface
+	sanctionsScreener SanctionsScreeningServiceInterface // Optional; names are not screened when nil
 }
 
 // NewCustomerProfileService creates a new customer profile service
-func NewCustomerProfileService(userRepo repositories.UserRepositoryInterface, accountRepo repositories.AccountRepositoryInterface, auditService AuditServiceInterface) CustomerProfileServiceInterface {
+func NewCustomerProfileService(userRepo repositories.UserRepositoryInterface, accountRepo repositories.AccountRepositoryInterface, auditService AuditServiceInterface, sanctionsScreener SanctionsScreeningServiceInterface) CustomerProfileServiceInterface {
 	return &CustomerProfileService{
-		userRepo:     userRepo,
-		accountRepo:  accountRepo,
-		auditService: auditService,
+		userRepo:          userRepo,
+		accountRepo:       accountRepo,
+		auditService:      auditService,
+		sanctionsScreener: sanctionsScreener,
 	}
 }
 
@@ -43,12 +47,12 @@ var (
 )
 
 // GetCustomerProfile retrieves a customer profile by ID
-func (s *CustomerProfileService) GetCustomerProfile(customerID uuid.UUID) (*models.User, error) {
+func (s *CustomerProfileService) GetCustomerProfile(ctx context.Context, customerID uuid.UUID) (*models.User, error) {
 	if customerID == uuid.Nil {
 		return nil, ErrInvalidCustomerID
 	}
 
-	user, err := s.userRepo.GetByIDActive(customerID)
+	user, err := s.userRepo.GetByIDActive(ctx, customerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return nil, ErrCustomerNotFound
@@ -60,7 +64,7 @@ func (s *CustomerProfileService) GetCustomerProfile(customerID uuid.UUID) (*mode
 }
 
 // CreateCustomer creates a new customer with a temporary password
-func (s *CustomerProfileService) CreateCustomer(email, firstName, lastName string, role string) (*models.User, string, error) {
+func (s *CustomerProfileService) CreateCustomer(ctx context.Context, email, firstName, lastName string, role string) (*models.User, string, error) {
 	if email == "" {
 		return nil, "", ErrInvalidEmail
 	}
@@ -69,7 +73,7 @@ func (s *CustomerProfileService) CreateCustomer(email, firstName, lastName strin
 		return nil, "", ErrInvalidRole
 	}
 
-	existingUser, err := s.userRepo.GetByEmail(email)
+	existingUser, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil && !errors.Is(err, repositories.ErrUserNotFound) {
 		return nil, "", fmt.Errorf("failed to check email uniqueness: %w", err)
 	}
@@ -95,18 +99,20 @@ func (s *CustomerProfileService) CreateCustomer(email, firstName, lastName strin
 		PasswordHash: string(hashedPassword),
 	}
 
-	if err := s.userRepo.Create(user); err != nil {
+	if err := s.userRepo.Create(ctx, user); err != nil {
 		if errors.Is(err, repositories.ErrEmailAlreadyExists) {
 			return nil, "", ErrEmailAlreadyExists
 		}
 		return nil, "", fmt.Errorf("failed to create customer: %w", err)
 	}
 
+	s.screenName(ctx, user)
+
 	return user, tempPassword, nil
 }
 
 // UpdateCustomerProfile updates customer profile fields
-func (s *CustomerProfileService) UpdateCustomerProfile(customerID uuid.UUID, updates map[string]interface{}) error {
+func (s *CustomerProfileService) UpdateCustomerProfile(ctx context.Context, customerID uuid.UUID, updates map[string]interface{}) error {
 	if customerID == uuid.Nil {
 		return ErrInvalidCustomerID
 	}
@@ -115,7 +121,7 @@ func (s *CustomerProfileService) UpdateCustomerProfile(customerID uuid.UUID, upd
 		return errors.New("no updates provided")
 	}
 
-	_, err := s.userRepo.GetByIDActive(customerID)
+	user, err := s.userRepo.GetByIDActive(ctx, customerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
@@ -125,18 +131,31 @@ func (s *CustomerProfileService) UpdateCustomerProfile(customerID uuid.UUID, upd
 
 	preventUpdatingSensitiveAndNonApplicableFields(updates)
 
-	if err := s.userRepo.UpdateFields(customerID, updates); err != nil {
+	if err := s.userRepo.UpdateFields(ctx, customerID, updates); err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
 		}
 		return fmt.Errorf("failed to update customer profile: %w", err)
 	}
 
+	// A renamed customer is screened under the new name
+	firstName, firstNameChanged := updates["first_name"].(string)
+	lastName, lastNameChanged := updates["last_name"].(string)
+	if firstNameChanged || lastNameChanged {
+		if firstNameChanged {
+			user.FirstName = firstName
+		}
+		if lastNameChanged {
+			user.LastName = lastName
+		}
+		s.screenName(ctx, user)
+	}
+
 	return nil
 }
 
 // UpdateCustomerEmail updates a customer's email address with uniqueness validation
-func (s *CustomerProfileService) UpdateCustomerEmail(customerID uuid.UUID, newEmail string) error {
+func (s *CustomerProfileService) UpdateCustomerEmail(ctx context.Context, customerID uuid.UUID, newEmail string) error {
 	if customerID == uuid.Nil {
 		return ErrInvalidCustomerID
 	}
@@ -145,7 +164,7 @@ func (s *CustomerProfileService) UpdateCustomerEmail(customerID uuid.UUID, newEm
 		return ErrInvalidEmail
 	}
 
-	existingUser, err := s.userRepo.GetByEmailExcluding(newEmail, customerID)
+	existingUser, err := s.userRepo.GetByEmailExcluding(ctx, newEmail, customerID)
 	if err != nil && !errors.Is(err, repositories.ErrUserNotFound) {
 		return fmt.Errorf("failed to check email uniqueness: %w", err)
 	}
@@ -153,7 +172,7 @@ func (s *CustomerProfileService) UpdateCustomerEmail(customerID uuid.UUID, newEm
 		return ErrEmailAlreadyExists
 	}
 
-	_, err = s.userRepo.GetByIDActive(customerID)
+	_, err = s.userRepo.GetByIDActive(ctx, customerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
@@ -161,7 +180,7 @@ func (s *CustomerProfileService) UpdateCustomerEmail(customerID uuid.UUID, newEm
 		return fmt.Errorf("failed to find customer: %w", err)
 	}
 
-	if err := s.userRepo.UpdateEmail(customerID, newEmail); err != nil {
+	if err := s.userRepo.UpdateEmail(ctx, customerID, newEmail); err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
 		}
@@ -175,12 +194,12 @@ func (s *CustomerProfileService) UpdateCustomerEmail(customerID uuid.UUID, newEm
 }
 
 // DeleteCustomer soft deletes a customer with balance validation and cascade account deactivation
-func (s *CustomerProfileService) DeleteCustomer(customerID uuid.UUID, reason string) error {
+func (s *CustomerProfileService) DeleteCustomer(ctx context.Context, customerID uuid.UUID, reason string) error {
 	if customerID == uuid.Nil {
 		return ErrInvalidCustomerID
 	}
 
-	_, err := s.userRepo.GetByIDActive(customerID)
+	_, err := s.userRepo.GetByIDActive(ctx, customerID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
@@ -188,7 +207,7 @@ func (s *CustomerProfileService) DeleteCustomer(customerID uuid.UUID, reason str
 		return fmt.Errorf("failed to find customer: %w", err)
 	}
 
-	totalBalance, err := s.accountRepo.GetTotalBalanceByUserID(customerID)
+	totalBalance, err := s.accountRepo.GetTotalBalanceByUserID(ctx, customerID)
 	if err != nil {
 		return fmt.Errorf("failed to check customer balance: %w", err)
 	}
@@ -196,14 +215,14 @@ func (s *CustomerProfileService) DeleteCustomer(customerID uuid.UUID, reason str
 		return ErrCustomerHasBalance
 	}
 
-	if err := s.userRepo.Delete(customerID); err != nil {
+	if err := s.userRepo.Delete(ctx, customerID); err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			return ErrCustomerNotFound
 		}
 		return fmt.Errorf("failed to delete customer: %w", err)
 	}
 
-	if err := s.accountRepo.SoftDeleteByUserID(customerID); err != nil {
+	if err := s.accountRepo.SoftDeleteByUserID(ctx, customerID); err != nil {
 		return fmt.Errorf("failed to deactivate accounts: %w", err)
 	}
 
@@ -232,4 +251,17 @@ func preventUpdatingSensitiveAndNonApplicableFields(updates map[string]interface
 	delete(updates, "email")
 	delete(updates, "deleted_at")
 	delete(updates, "role")
+	delete(updates, "sanctions_status")
+}
+
+// screenName screens the customer's name against the sanctions list when a screener is
+// configured. The profile change has already been saved, so a failure is logged for an admin
+// rescreen rather than returned.
+func (s *CustomerProfileService) screenName(ctx context.Context, user *models.User) {
+	if s.sanctionsScreener == nil {
+		return
+	}
+	if err := s.sanctionsScreener.ScreenUser(ctx, user); err != nil {
+		slog.Error("failed to screen customer against sanctions list", "error", err, "user_id", user.ID)
+	}
 }
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -50,7 +51,7 @@ func ValidateSearchType(searchType models.SearchType) error {
 
 // SearchCustomers searches for customers based on the query and search type
 // Performs case-insensitive exact match searches
-func (s *CustomerSearchService) SearchCustomers(query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error) {
+func (s *CustomerSearchService) SearchCustomers(ctx context.Context, query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error) {
 	if strings.TrimSpace(query) == "" {
 		return nil, 0, ErrInvalidSearchQuery
 	}
@@ -75,14 +76,14 @@ func (s *CustomerSearchService) SearchCustomers(query string, searchType models.
 		SearchType: string(searchType),
 	}
 
-	users, total, err := s.userRepo.SearchUsers(criteria, offset, limit)
+	users, total, err := s.userRepo.SearchUsers(ctx, criteria, offset, limit)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to search customers: %w", err)
 	}
 
 	results := make([]*models.CustomerSearchResult, 0, len(users))
 	for _, user := range users {
-		accountCount, err := s.userRepo.CountAccountsByUserID(user.ID)
+		accountCount, err := s.userRepo.CountAccountsByUserID(ctx, user.ID)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to count accounts for user %s: %w", user.ID, err)
 		}
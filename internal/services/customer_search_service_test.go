@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -149,8 +150,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "John",
 					SearchType: string(models.SearchTypeFirstName),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(2), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(2), nil).Times(1)
 			},
 		},
 		{
@@ -170,8 +171,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "jOhN",
 					SearchType: string(models.SearchTypeFirstName),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(2), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(2), nil).Times(1)
 			},
 		},
 		{
@@ -193,9 +194,9 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "Doe",
 					SearchType: string(models.SearchTypeLastName),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{john, alice}, int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(alice.ID).Return(int64(1), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{john, alice}, int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), alice.ID).Return(int64(1), nil).Times(1)
 			},
 		},
 		{
@@ -218,8 +219,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "Johnson",
 					SearchType: string(models.SearchTypeName),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{bob}, int64(1), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(bob.ID).Return(int64(0), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{bob}, int64(1), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), bob.ID).Return(int64(0), nil).Times(1)
 			},
 		},
 		{
@@ -241,8 +242,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "jane.smith@example.com",
 					SearchType: string(models.SearchTypeEmail),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{jane}, int64(1), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(jane.ID).Return(int64(1), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{jane}, int64(1), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), jane.ID).Return(int64(1), nil).Times(1)
 			},
 		},
 		{
@@ -262,8 +263,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "JANE.SMITH@EXAMPLE.COM",
 					SearchType: string(models.SearchTypeEmail),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{jane}, int64(1), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(jane.ID).Return(int64(1), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{jane}, int64(1), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), jane.ID).Return(int64(1), nil).Times(1)
 			},
 		},
 		{
@@ -284,8 +285,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "1000000001",
 					SearchType: string(models.SearchTypeAccountNumber),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(2), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(2), nil).Times(1)
 			},
 		},
 		{
@@ -304,8 +305,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "Doe",
 					SearchType: string(models.SearchTypeLastName),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 1).Return([]*models.User{john}, int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(2), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 1).Return([]*models.User{john}, int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(2), nil).Times(1)
 			},
 		},
 		{
@@ -324,8 +325,8 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "Doe",
 					SearchType: string(models.SearchTypeLastName),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 1, 10).Return([]*models.User{alice}, int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(alice.ID).Return(int64(1), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 1, 10).Return([]*models.User{alice}, int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), alice.ID).Return(int64(1), nil).Times(1)
 			},
 		},
 		{
@@ -364,7 +365,7 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					Query:      "NonExistent",
 					SearchType: string(models.SearchTypeFirstName),
 				}
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{}, int64(0), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{}, int64(0), nil).Times(1)
 			},
 		},
 		{
@@ -384,9 +385,9 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					SearchType: string(models.SearchTypeLastName),
 				}
 				// Service will convert limit 0 to DefaultSearchLimit (10)
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{john, alice}, int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(alice.ID).Return(int64(1), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{john, alice}, int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), alice.ID).Return(int64(1), nil).Times(1)
 			},
 		},
 		{
@@ -406,9 +407,9 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 					SearchType: string(models.SearchTypeLastName),
 				}
 				// Service will cap limit at MaxSearchLimit (1000)
-				s.userRepo.EXPECT().SearchUsers(criteria, 0, 1000).Return([]*models.User{john, alice}, int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(2), nil).Times(1)
-				s.userRepo.EXPECT().CountAccountsByUserID(alice.ID).Return(int64(1), nil).Times(1)
+				s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 1000).Return([]*models.User{john, alice}, int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(2), nil).Times(1)
+				s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), alice.ID).Return(int64(1), nil).Times(1)
 			},
 		},
 	}
@@ -420,7 +421,7 @@ func (s *CustomerSearchServiceTestSuite) TestSearchCustomers() {
 				tt.setupMocks()
 			}
 
-			results, total, err := s.service.SearchCustomers(tt.query, tt.searchType, tt.offset, tt.limit)
+			results, total, err := s.service.SearchCustomers(context.Background(), tt.query, tt.searchType, tt.offset, tt.limit)
 
 			if tt.wantErr {
 				s.Error(err)
@@ -459,11 +460,11 @@ func (s *CustomerSearchServiceTestSuite) TestExcludesDeletedUsers() {
 		SearchType: string(models.SearchTypeLastName),
 	}
 	// Repository excludes deleted users, so only john is returned
-	s.userRepo.EXPECT().SearchUsers(criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
-	s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(0), nil).Times(1)
+	s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
+	s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(0), nil).Times(1)
 
 	// Search should only return the non-deleted user
-	results, total, err := s.service.SearchCustomers("Doe", models.SearchTypeLastName, 0, 10)
+	results, total, err := s.service.SearchCustomers(context.Background(), "Doe", models.SearchTypeLastName, 0, 10)
 	s.Require().NoError(err)
 	s.Equal(int64(1), total)
 	s.Len(results, 1)
@@ -482,12 +483,12 @@ func (s *CustomerSearchServiceTestSuite) TestExcludesDeletedAccounts() {
 		Query:      "Doe",
 		SearchType: string(models.SearchTypeLastName),
 	}
-	s.userRepo.EXPECT().SearchUsers(criteria1, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
+	s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria1, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
 	// CountAccountsByUserID excludes deleted accounts, so only 1
-	s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(1), nil).Times(1)
+	s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(1), nil).Times(1)
 
 	// Search should only count the active account
-	results, total, err := s.service.SearchCustomers("Doe", models.SearchTypeLastName, 0, 10)
+	results, total, err := s.service.SearchCustomers(context.Background(), "Doe", models.SearchTypeLastName, 0, 10)
 	s.Require().NoError(err)
 	s.Equal(int64(1), total)
 	s.Len(results, 1)
@@ -499,10 +500,10 @@ func (s *CustomerSearchServiceTestSuite) TestExcludesDeletedAccounts() {
 		SearchType: string(models.SearchTypeAccountNumber),
 	}
 	// Repository excludes deleted accounts, so no results
-	s.userRepo.EXPECT().SearchUsers(criteria2, 0, 10).Return([]*models.User{}, int64(0), nil).Times(1)
+	s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria2, 0, 10).Return([]*models.User{}, int64(0), nil).Times(1)
 
 	// Search by deleted account number should return nothing
-	results, total, err = s.service.SearchCustomers("1000000002", models.SearchTypeAccountNumber, 0, 10)
+	results, total, err = s.service.SearchCustomers(context.Background(), "1000000002", models.SearchTypeAccountNumber, 0, 10)
 	s.Require().NoError(err)
 	s.Equal(int64(0), total)
 	s.Len(results, 0)
@@ -512,11 +513,11 @@ func (s *CustomerSearchServiceTestSuite) TestExcludesDeletedAccounts() {
 		Query:      "1000000001",
 		SearchType: string(models.SearchTypeAccountNumber),
 	}
-	s.userRepo.EXPECT().SearchUsers(criteria3, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
-	s.userRepo.EXPECT().CountAccountsByUserID(john.ID).Return(int64(1), nil).Times(1)
+	s.userRepo.EXPECT().SearchUsers(gomock.Any(), criteria3, 0, 10).Return([]*models.User{john}, int64(1), nil).Times(1)
+	s.userRepo.EXPECT().CountAccountsByUserID(gomock.Any(), john.ID).Return(int64(1), nil).Times(1)
 
 	// Search by active account number should work
-	results, total, err = s.service.SearchCustomers("1000000001", models.SearchTypeAccountNumber, 0, 10)
+	results, total, err = s.service.SearchCustomers(context.Background(), "1000000001", models.SearchTypeAccountNumber, 0, 10)
 	s.Require().NoError(err)
 	s.Equal(int64(1), total)
 	s.Len(results, 1)
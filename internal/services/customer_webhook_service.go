@@ -0,0 +1,557 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/netguard"
+	"github.com/array/banking-api/internal/regulatorwebhook"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound     = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound         = errors.New("webhook delivery not found")
+	ErrWebhookSubscriptionLimitReached = errors.New("webhook subscription limit reached")
+	ErrInvalidLowBalanceThreshold      = errors.New("invalid low balance threshold")
+	ErrWebhookDeliveryInProgress       = errors.New("webhook delivery is still being retried")
+	ErrInvalidWebhookURL               = errors.New("invalid webhook URL")
+)
+
+const (
+	maxWebhookSubscriptionsPerUser = 10
+	customerWebhookTimeout         = 10 * time.Second
+	customerWebhookMaxResponseBody = 200 // Bytes of the endpoint's response kept for support; never returned to customers
+	customerWebhookSecretPrefix    = "whsec_"
+)
+
+type customerWebhookService struct {
+	subscriptionRepo repositories.WebhookSubscriptionRepositoryInterface
+	accountRepo      repositories.AccountRepositoryInterface
+	auditRepo        repositories.AuditLogRepositoryInterface
+	httpClient       *http.Client
+	logger           *slog.Logger
+}
+
+// NewCustomerWebhookService creates the service managing customer webhook subscriptions. Events
+// reach it from the outbox through the customer webhook consumer; deliveries are sent by
+// ProcessPendingDeliveries with the same retry schedule as regulator notifications.
+func NewCustomerWebhookService(
+	subscriptionRepo repositories.WebhookSubscriptionRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+) CustomerWebhookServiceInterface {
+	return &customerWebhookService{
+		subscriptionRepo: subscriptionRepo,
+		accountRepo:      accountRepo,
+		auditRepo:        auditRepo,
+		httpClient:       newCustomerWebhookClient(),
+		logger:           slog.Default().With("service", "CustomerWebhookService"),
+	}
+}
+
+// newCustomerWebhookClient returns the client deliveries are sent with. Customers choose the
+// URLs, so it only connects to public addresses and does not follow redirects, which could
+// otherwise point it back at the internal network.
+func newCustomerWebhookClient() *http.Client {
+	dialer := &net.Dialer{Timeout: customerWebhookTimeout, Control: netguard.Control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil // A proxy would connect on our behalf, past the dialer's check
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   customerWebhookTimeout,
+		Transport: telemetry.NewTransport(transport),
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// CreateSubscription registers an endpoint and generates the secret its deliveries are signed with.
+func (s *customerWebhookService) CreateSubscription(ctx context.Context, userID uuid.UUID, req *dto.CreateWebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
+	if err := netguard.CheckURL(req.URL); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebhookURL, err)
+	}
+
+	count, err := s.subscriptionRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook subscriptions: %w", err)
+	}
+	if count >= maxWebhookSubscriptionsPerUser {
+		return nil, fmt.Errorf("%w: at most %d subscriptions are allowed", ErrWebhookSubscriptionLimitReached, maxWebhookSubscriptionsPerUser)
+	}
+
+	threshold, err := parseLowBalanceThreshold(req.LowBalanceThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &models.WebhookSubscription{
+		UserID:              userID,
+		URL:                 req.URL,
+		Description:         req.Description,
+		Secret:              secret,
+		LowBalanceThreshold: threshold,
+		Status:              models.WebhookSubscriptionStatusActive,
+	}
+	subscription.SetEventTypes(req.EventTypes)
+	if err := validateLowBalanceSubscription(subscription); err != nil {
+		return nil, err
+	}
+
+	if err := s.subscriptionRepo.Create(ctx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	s.audit(ctx, subscription, "webhook_subscription.created", models.JSONBMap{
+		"url":         subscription.URL,
+		"event_types": subscription.EventTypes,
+	})
+
+	return subscription, nil
+}
+
+// ListSubscriptions returns the user's subscriptions, newest first.
+func (s *customerWebhookService) ListSubscriptions(ctx context.Context, userID uuid.UUID) ([]models.WebhookSubscription, error) {
+	subscriptions, err := s.subscriptionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// GetSubscription returns a subscription owned by the user.
+func (s *customerWebhookService) GetSubscription(ctx context.Context, userID, subscriptionID uuid.UUID) (*models.WebhookSubscription, error) {
+	return s.getOwnedSubscription(ctx, userID, subscriptionID)
+}
+
+// UpdateSubscription applies the fields set in the request. Re-enabling a disabled subscription
+// clears its failure count; deliveries queued while it was disabled are then retried.
+func (s *customerWebhookService) UpdateSubscription(ctx context.Context, userID, subscriptionID uuid.UUID, req *dto.UpdateWebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
+	subscription, err := s.getOwnedSubscription(ctx, userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := netguard.CheckURL(*req.URL); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidWebhookURL, err)
+		}
+		subscription.URL = *req.URL
+	}
+	if req.Description != nil {
+		subscription.Description = *req.Description
+	}
+	if len(req.EventTypes) > 0 {
+		subscription.SetEventTypes(req.EventTypes)
+	}
+	if req.LowBalanceThreshold != nil {
+		threshold, err := parseLowBalanceThreshold(*req.LowBalanceThreshold)
+		if err != nil {
+			return nil, err
+		}
+		subscription.LowBalanceThreshold = threshold
+	}
+	if err := validateLowBalanceSubscription(subscription); err != nil {
+		return nil, err
+	}
+
+	if req.Status != nil && *req.Status != subscription.Status {
+		switch *req.Status {
+		case models.WebhookSubscriptionStatusActive:
+			subscription.Status = models.WebhookSubscriptionStatusActive
+			subscription.ConsecutiveFailures = 0
+			subscription.DisabledAt = nil
+			subscription.DisabledReason = nil
+		case models.WebhookSubscriptionStatusDisabled:
+			now := time.Now()
+			reason := "disabled by customer"
+			subscription.Status = models.WebhookSubscriptionStatusDisabled
+			subscription.DisabledAt = &now
+			subscription.DisabledReason = &reason
+		}
+	}
+
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+
+	s.audit(ctx, subscription, "webhook_subscription.updated", models.JSONBMap{
+		"url":         subscription.URL,
+		"event_types": subscription.EventTypes,
+		"status":      subscription.Status,
+	})
+
+	return subscription, nil
+}
+
+// DeleteSubscription removes a subscription and its delivery log. Queued deliveries are dropped.
+func (s *customerWebhookService) DeleteSubscription(ctx context.Context, userID, subscriptionID uuid.UUID) error {
+	subscription, err := s.getOwnedSubscription(ctx, userID, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.subscriptionRepo.Delete(ctx, subscription.ID); err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	s.audit(ctx, subscription, "webhook_subscription.deleted", models.JSONBMap{
+		"url": subscription.URL,
+	})
+
+	return nil
+}
+
+// ListDeliveries returns the delivery log of a subscription owned by the user, newest first.
+func (s *customerWebhookService) ListDeliveries(ctx context.Context, userID, subscriptionID uuid.UUID, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	subscription, err := s.getOwnedSubscription(ctx, userID, subscriptionID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	deliveries, total, err := s.subscriptionRepo.ListDeliveries(ctx, subscription.ID, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// Redeliver queues a delivery to be sent again with a fresh set of attempts. Deliveries still
+// being retried are rejected, since they will be sent again anyway. The event ID is unchanged.
+func (s *customerWebhookService) Redeliver(ctx context.Context, userID, subscriptionID, deliveryID uuid.UUID) (*models.WebhookDelivery, error) {
+	subscription, err := s.getOwnedSubscription(ctx, userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.subscriptionRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWebhookDeliveryNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	if delivery.SubscriptionID != subscription.ID {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if delivery.Status == models.WebhookStatusPending || (delivery.Status == models.WebhookStatusFailed && delivery.NextAttemptAt != nil && delivery.Attempts < models.WebhookMaxAttempts) {
+		return nil, ErrWebhookDeliveryInProgress
+	}
+
+	now := time.Now()
+	delivery.Status = models.WebhookStatusPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+
+	if err := s.subscriptionRepo.UpdateDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to queue webhook redelivery: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "queued webhook redelivery", "subscription_id", subscription.ID, "delivery_id", delivery.ID)
+	return delivery, nil
+}
+
+// Ping sends a webhook.ping event to the subscription immediately and returns the logged delivery.
+// Pings are not retried and do not count towards disabling the subscription, so an endpoint can
+// be tested before a disabled subscription is re-enabled.
+func (s *customerWebhookService) Ping(ctx context.Context, userID, subscriptionID uuid.UUID) (*models.WebhookDelivery, error) {
+	subscription, err := s.getOwnedSubscription(ctx, userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &models.WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		EventType:      models.WebhookEventPing,
+		Payload: models.JSONBMap{
+			"subscription_id": subscription.ID.String(),
+		},
+	}
+	if err := s.subscriptionRepo.CreateDeliveries(ctx, []*models.WebhookDelivery{delivery}); err != nil {
+		return nil, fmt.Errorf("failed to log webhook ping: %w", err)
+	}
+
+	sendErr := s.send(ctx, subscription, delivery)
+	if sendErr != nil {
+		delivery.NextAttemptAt = nil
+	}
+	if err := s.subscriptionRepo.UpdateDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to log webhook ping: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// QueueEvent creates a delivery for each active subscription of the account owner that selected
+// the event. A debit taking the balance below a subscription's threshold also queues balance.low.
+// Delivery event IDs are derived from the outbox event, so redelivered events are not queued twice.
+func (s *customerWebhookService) QueueEvent(ctx context.Context, event *models.OutboxEvent) error {
+	var accountKey string
+	switch event.EventType {
+	case models.EventTypeTransactionPosted:
+		accountKey = "account_id"
+	case models.EventTypeTransferCompleted, models.EventTypeTransferFailed:
+		accountKey = "from_account_id"
+	default:
+		return nil
+	}
+
+	accountIDValue, _ := event.Payload[accountKey].(string)
+	accountID, err := uuid.Parse(accountIDValue)
+	if err != nil {
+		s.logger.WarnContext(ctx, "event has no account to notify", "event_id", event.EventID, "event_type", event.EventType)
+		return nil
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAccountNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to find account owner: %w", err)
+	}
+
+	subscriptions, err := s.subscriptionRepo.ListByUserID(ctx, account.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	var deliveries []*models.WebhookDelivery
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		if !subscription.IsActive() {
+			continue
+		}
+		if subscription.Subscribes(event.EventType) {
+			deliveries = append(deliveries, &models.WebhookDelivery{
+				SubscriptionID: subscription.ID,
+				EventID:        event.EventID,
+				EventType:      event.EventType,
+				Payload:        event.Payload,
+			})
+		}
+		if subscription.Subscribes(models.WebhookEventBalanceLow) && crossesLowBalanceThreshold(event, subscription.LowBalanceThreshold) {
+			deliveries = append(deliveries, &models.WebhookDelivery{
+				SubscriptionID: subscription.ID,
+				EventID:        uuid.NewSHA1(event.EventID, []byte(models.WebhookEventBalanceLow)),
+				EventType:      models.WebhookEventBalanceLow,
+				Payload: models.JSONBMap{
+					"account_id":     account.ID.String(),
+					"account_number": account.AccountNumber,
+					"balance":        event.Payload["balance_after"],
+					"threshold":      subscription.LowBalanceThreshold.StringFixed(2),
+					"transaction_id": event.Payload["transaction_id"],
+				},
+			})
+		}
+	}
+
+	if err := s.subscriptionRepo.CreateDeliveries(ctx, deliveries); err != nil {
+		return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ProcessPendingDeliveries sends deliveries that are due, backing off exponentially between
+// attempts like regulator webhooks, and disables subscriptions that keep failing.
+func (s *customerWebhookService) ProcessPendingDeliveries(ctx context.Context) {
+	ctx, span := telemetry.StartSpan(ctx, "CustomerWebhookService.ProcessPendingDeliveries")
+	defer span.End()
+
+	deliveries, err := s.subscriptionRepo.FindPendingDeliveries(ctx, webhookBatchLimit)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to fetch pending customer webhooks", "error", err)
+		return
+	}
+
+	for i := range deliveries {
+		delivery := &deliveries[i]
+		sendErr := s.send(ctx, &delivery.Subscription, delivery)
+		if sendErr != nil {
+			s.logger.WarnContext(ctx, "failed to send customer webhook", "error", sendErr, "delivery_id", delivery.ID, "attempt", delivery.Attempts)
+			if delivery.Attempts < models.WebhookMaxAttempts {
+				// Exponential backoff: 1m, 2m, 4m, 8m
+				nextAttempt := delivery.LastAttemptAt.Add(initialBackoffPeriod * time.Duration(math.Pow(2, float64(delivery.Attempts-1))))
+				delivery.NextAttemptAt = &nextAttempt
+			} else {
+				delivery.NextAttemptAt = nil
+			}
+		}
+
+		disabled, err := s.subscriptionRepo.RecordDeliveryAttempt(ctx, delivery, sendErr == nil)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to update customer webhook delivery", "error", err, "delivery_id", delivery.ID)
+			continue
+		}
+		if disabled {
+			s.logger.WarnContext(ctx, "disabled webhook subscription after repeated failures", "subscription_id", delivery.SubscriptionID)
+			s.audit(ctx, &delivery.Subscription, "webhook_subscription.disabled", models.JSONBMap{
+				"consecutive_failures": models.WebhookSubscriptionFailureLimit,
+			})
+		}
+	}
+}
+
+// send makes one signed delivery attempt and records its outcome on the delivery.
+func (s *customerWebhookService) send(ctx context.Context, subscription *models.WebhookSubscription, delivery *models.WebhookDelivery) error {
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatusCode = nil
+	delivery.ResponseBody = nil
+	delivery.LastError = nil
+
+	err := s.post(ctx, subscription, delivery, now)
+	if err != nil {
+		message := err.Error()
+		delivery.Status = models.WebhookStatusFailed
+		delivery.LastError = &message
+		return err
+	}
+
+	delivery.Status = models.WebhookStatusSent
+	delivery.NextAttemptAt = nil
+	return nil
+}
+
+func (s *customerWebhookService) post(ctx context.Context, subscription *models.WebhookSubscription, delivery *models.WebhookDelivery, now time.Time) error {
+	body, err := json.Marshal(dto.CustomerWebhookEnvelope{
+		EventID:   delivery.EventID,
+		EventType: delivery.EventType,
+		CreatedAt: delivery.CreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	// Subscriptions created before URLs were restricted may be plain http; the dialer refuses
+	// internal addresses however the host resolves
+	if err := netguard.CheckScheme(subscription.URL); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhookURL, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	regulatorwebhook.SetHeaders(req.Header, delivery.EventID.String(), body, []string{subscription.Secret}, now)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, customerWebhookMaxResponseBody))
+	statusCode := resp.StatusCode
+	delivery.ResponseStatusCode = &statusCode
+	if len(responseBody) > 0 {
+		text := strings.ToValidUTF8(string(responseBody), "")
+		delivery.ResponseBody = &text
+	}
+
+	if statusCode < 200 || statusCode >= 300 {
+		return fmt.Errorf("webhook returned non-2xx status: %d", statusCode)
+	}
+	return nil
+}
+
+func (s *customerWebhookService) getOwnedSubscription(ctx context.Context, userID, subscriptionID uuid.UUID) (*models.WebhookSubscription, error) {
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWebhookSubscriptionNotFound) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, err
+	}
+	// Treat other users' subscriptions as not found so their existence is not revealed
+	if subscription.UserID != userID {
+		return nil, ErrWebhookSubscriptionNotFound
+	}
+	return subscription, nil
+}
+
+func (s *customerWebhookService) audit(ctx context.Context, subscription *models.WebhookSubscription, action string, metadata models.JSONBMap) {
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &subscription.UserID,
+		Action:     action,
+		Resource:   "webhook_subscription",
+		ResourceID: subscription.ID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
+
+// parseLowBalanceThreshold parses an optional decimal amount; empty means no threshold.
+func parseLowBalanceThreshold(value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+	threshold, err := decimal.NewFromString(value)
+	if err != nil || threshold.IsNegative() {
+		return decimal.Zero, fmt.Errorf("%w: must be a non-negative amount", ErrInvalidLowBalanceThreshold)
+	}
+	return threshold.Round(2), nil
+}
+
+// validateLowBalanceSubscription requires a threshold when balance.low is selected; with a zero
+// threshold the event could never fire.
+func validateLowBalanceSubscription(subscription *models.WebhookSubscription) error {
+	if subscription.Subscribes(models.WebhookEventBalanceLow) && !subscription.LowBalanceThreshold.IsPositive() {
+		return fmt.Errorf("%w: required when subscribing to %s", ErrInvalidLowBalanceThreshold, models.WebhookEventBalanceLow)
+	}
+	return nil
+}
+
+// crossesLowBalanceThreshold returns true for a posted debit that took the balance from at or
+// above the threshold to below it, so a customer is alerted once per drop rather than on every debit.
+func crossesLowBalanceThreshold(event *models.OutboxEvent, threshold decimal.Decimal) bool {
+	if event.EventType != models.EventTypeTransactionPosted || event.Payload["transaction_type"] != models.TransactionTypeDebit {
+		return false
+	}
+
+	before, errBefore := decimal.NewFromString(fmt.Sprint(event.Payload["balance_before"]))
+	after, errAfter := decimal.NewFromString(fmt.Sprint(event.Payload["balance_after"]))
+	if errBefore != nil || errAfter != nil {
+		return false
+	}
+	return !before.LessThan(threshold) && after.LessThan(threshold)
+}
+
+// generateWebhookSecret returns a random signing secret for a new subscription.
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return customerWebhookSecretPrefix + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,431 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/netguard"
+	"github.com/array/banking-api/internal/regulatorwebhook"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomerWebhookServiceTestSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	subscriptionRepo *repository_mocks.MockWebhookSubscriptionRepositoryInterface
+	accountRepo      *repository_mocks.MockAccountRepositoryInterface
+	auditRepo        *repository_mocks.MockAuditLogRepositoryInterface
+	service          CustomerWebhookServiceInterface
+	userID           uuid.UUID
+}
+
+func (s *CustomerWebhookServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.subscriptionRepo = repository_mocks.NewMockWebhookSubscriptionRepositoryInterface(s.ctrl)
+	s.accountRepo = repository_mocks.NewMockAccountRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.service = NewCustomerWebhookService(s.subscriptionRepo, s.accountRepo, s.auditRepo)
+	s.userID = uuid.New()
+}
+
+func (s *CustomerWebhookServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestCustomerWebhookServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomerWebhookServiceTestSuite))
+}
+
+// trust lets the service reach a local TLS test server. The transport is replaced, so the
+// address check is bypassed; the redirect policy is kept.
+func (s *CustomerWebhookServiceTestSuite) trust(server *httptest.Server) {
+	s.service.(*customerWebhookService).httpClient.Transport = server.Client().Transport
+}
+
+func (s *CustomerWebhookServiceTestSuite) subscription(url string, eventTypes ...string) *models.WebhookSubscription {
+	subscription := &models.WebhookSubscription{
+		ID:     uuid.New(),
+		UserID: s.userID,
+		URL:    url,
+		Secret: "whsec_test",
+		Status: models.WebhookSubscriptionStatusActive,
+	}
+	subscription.SetEventTypes(eventTypes)
+	return subscription
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestCreateSubscription_Success() {
+	s.subscriptionRepo.EXPECT().CountByUserID(gomock.Any(), s.userID).Return(int64(0), nil)
+	s.subscriptionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, subscription *models.WebhookSubscription) error {
+		s.Equal(s.userID, subscription.UserID)
+		s.Equal("transaction.posted,balance.low", subscription.EventTypes)
+		s.True(subscription.LowBalanceThreshold.Equal(decimal.NewFromInt(100)))
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	subscription, err := s.service.CreateSubscription(context.Background(), s.userID, &dto.CreateWebhookSubscriptionRequest{
+		URL:                 "https://example.com/hooks",
+		EventTypes:          []string{models.EventTypeTransactionPosted, models.WebhookEventBalanceLow},
+		LowBalanceThreshold: "100.00",
+	})
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(subscription.Secret, customerWebhookSecretPrefix))
+	s.Len(subscription.Secret, len(customerWebhookSecretPrefix)+64)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestCreateSubscription_LimitReached() {
+	s.subscriptionRepo.EXPECT().CountByUserID(gomock.Any(), s.userID).Return(int64(maxWebhookSubscriptionsPerUser), nil)
+
+	_, err := s.service.CreateSubscription(context.Background(), s.userID, &dto.CreateWebhookSubscriptionRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{models.EventTypeTransferFailed},
+	})
+	s.ErrorIs(err, ErrWebhookSubscriptionLimitReached)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestCreateSubscription_BalanceLowRequiresThreshold() {
+	s.subscriptionRepo.EXPECT().CountByUserID(gomock.Any(), s.userID).Return(int64(0), nil)
+
+	_, err := s.service.CreateSubscription(context.Background(), s.userID, &dto.CreateWebhookSubscriptionRequest{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{models.WebhookEventBalanceLow},
+	})
+	s.ErrorIs(err, ErrInvalidLowBalanceThreshold)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestGetSubscription_OtherUserIsNotFound() {
+	subscription := s.subscription("https://example.com/hooks", models.EventTypeTransferFailed)
+	s.subscriptionRepo.EXPECT().GetByID(gomock.Any(), subscription.ID).Return(subscription, nil)
+
+	_, err := s.service.GetSubscription(context.Background(), uuid.New(), subscription.ID)
+	s.ErrorIs(err, ErrWebhookSubscriptionNotFound)
+
+	s.subscriptionRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrWebhookSubscriptionNotFound)
+	_, err = s.service.GetSubscription(context.Background(), s.userID, uuid.New())
+	s.ErrorIs(err, ErrWebhookSubscriptionNotFound)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestUpdateSubscription_ReenableClearsFailures() {
+	subscription := s.subscription("https://example.com/hooks", models.EventTypeTransferFailed)
+	reason := "disabled after 15 consecutive failed deliveries"
+	subscription.Status = models.WebhookSubscriptionStatusDisabled
+	subscription.ConsecutiveFailures = models.WebhookSubscriptionFailureLimit
+	subscription.DisabledReason = &reason
+
+	s.subscriptionRepo.EXPECT().GetByID(gomock.Any(), subscription.ID).Return(subscription, nil)
+	s.subscriptionRepo.EXPECT().Update(gomock.Any(), subscription).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	status := models.WebhookSubscriptionStatusActive
+	updated, err := s.service.UpdateSubscription(context.Background(), s.userID, subscription.ID, &dto.UpdateWebhookSubscriptionRequest{Status: &status})
+	s.Require().NoError(err)
+	s.True(updated.IsActive())
+	s.Zero(updated.ConsecutiveFailures)
+	s.Nil(updated.DisabledReason)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestQueueEvent_TransactionPosted() {
+	account := &models.Account{ID: uuid.New(), UserID: s.userID, AccountNumber: "1012345678"}
+	event := models.NewTransactionPostedEvent(&models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       account.ID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromInt(100),
+		BalanceBefore:   decimal.NewFromInt(150),
+		BalanceAfter:    decimal.NewFromInt(50),
+		Status:          models.TransactionStatusCompleted,
+	})
+	event.EventID = uuid.New()
+
+	posted := s.subscription("https://example.com/posted", models.EventTypeTransactionPosted)
+	lowBalance := s.subscription("https://example.com/low", models.WebhookEventBalanceLow)
+	lowBalance.LowBalanceThreshold = decimal.NewFromInt(100)
+	belowThreshold := s.subscription("https://example.com/already-low", models.WebhookEventBalanceLow)
+	belowThreshold.LowBalanceThreshold = decimal.NewFromInt(200)
+	disabled := s.subscription("https://example.com/disabled", models.EventTypeTransactionPosted)
+	disabled.Status = models.WebhookSubscriptionStatusDisabled
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.subscriptionRepo.EXPECT().ListByUserID(gomock.Any(), s.userID).Return([]models.WebhookSubscription{*posted, *lowBalance, *belowThreshold, *disabled}, nil)
+	s.subscriptionRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, deliveries []*models.WebhookDelivery) error {
+		s.Require().Len(deliveries, 2)
+		s.Equal(posted.ID, deliveries[0].SubscriptionID)
+		s.Equal(event.EventID, deliveries[0].EventID)
+		s.Equal(models.EventTypeTransactionPosted, deliveries[0].EventType)
+
+		s.Equal(lowBalance.ID, deliveries[1].SubscriptionID)
+		s.Equal(models.WebhookEventBalanceLow, deliveries[1].EventType)
+		s.Equal(uuid.NewSHA1(event.EventID, []byte(models.WebhookEventBalanceLow)), deliveries[1].EventID)
+		s.Equal("50", deliveries[1].Payload["balance"])
+		s.Equal("100.00", deliveries[1].Payload["threshold"])
+		return nil
+	})
+
+	s.NoError(s.service.QueueEvent(context.Background(), event))
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestQueueEvent_TransferNotifiesSourceAccountOwner() {
+	account := &models.Account{ID: uuid.New(), UserID: s.userID}
+	transfer := &models.Transfer{ID: uuid.New(), FromAccountID: account.ID, Amount: decimal.NewFromInt(25)}
+	transfer.Fail("account closed")
+	event := models.NewTransferEvent(transfer)
+
+	subscription := s.subscription("https://example.com/hooks", models.EventTypeTransferFailed)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.subscriptionRepo.EXPECT().ListByUserID(gomock.Any(), s.userID).Return([]models.WebhookSubscription{*subscription}, nil)
+	s.subscriptionRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Len(1)).Return(nil)
+
+	s.NoError(s.service.QueueEvent(context.Background(), event))
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestQueueEvent_IgnoresOtherEvents() {
+	s.NoError(s.service.QueueEvent(context.Background(), &models.OutboxEvent{EventType: "account.closed"}))
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestProcessPendingDeliveries_SendsSignedEvent() {
+	var received dto.CustomerWebhookEnvelope
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+		_, err = regulatorwebhook.NewVerifier([]string{"whsec_test"}, 0).Verify(r.Header, body)
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(body, &received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	s.trust(server)
+
+	subscription := s.subscription(server.URL, models.EventTypeTransactionPosted)
+	delivery := models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		Subscription:   *subscription,
+		EventID:        uuid.New(),
+		EventType:      models.EventTypeTransactionPosted,
+		Payload:        models.JSONBMap{"amount": "10"},
+		Status:         models.WebhookStatusPending,
+	}
+
+	s.subscriptionRepo.EXPECT().FindPendingDeliveries(gomock.Any(), webhookBatchLimit).Return([]models.WebhookDelivery{delivery}, nil)
+	s.subscriptionRepo.EXPECT().RecordDeliveryAttempt(gomock.Any(), gomock.Any(), true).DoAndReturn(func(_ context.Context, d *models.WebhookDelivery, _ bool) (bool, error) {
+		s.Equal(models.WebhookStatusSent, d.Status)
+		s.Equal(1, d.Attempts)
+		s.Require().NotNil(d.ResponseStatusCode)
+		s.Equal(http.StatusNoContent, *d.ResponseStatusCode)
+		s.Nil(d.NextAttemptAt)
+		return false, nil
+	})
+
+	s.service.ProcessPendingDeliveries(context.Background())
+	s.Equal(delivery.EventID, received.EventID)
+	s.Equal("10", received.Data["amount"])
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestProcessPendingDeliveries_FailureBacksOffAndDisables() {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("upstream down"))
+	}))
+	defer server.Close()
+	s.trust(server)
+
+	subscription := s.subscription(server.URL, models.EventTypeTransactionPosted)
+	delivery := models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		Subscription:   *subscription,
+		EventID:        uuid.New(),
+		EventType:      models.EventTypeTransactionPosted,
+		Status:         models.WebhookStatusFailed,
+		Attempts:       1,
+	}
+
+	s.subscriptionRepo.EXPECT().FindPendingDeliveries(gomock.Any(), webhookBatchLimit).Return([]models.WebhookDelivery{delivery}, nil)
+	s.subscriptionRepo.EXPECT().RecordDeliveryAttempt(gomock.Any(), gomock.Any(), false).DoAndReturn(func(_ context.Context, d *models.WebhookDelivery, _ bool) (bool, error) {
+		s.Equal(models.WebhookStatusFailed, d.Status)
+		s.Equal(2, d.Attempts)
+		s.Require().NotNil(d.NextAttemptAt)
+		s.WithinDuration(d.LastAttemptAt.Add(2*time.Minute), *d.NextAttemptAt, time.Second)
+		s.Equal("upstream down", *d.ResponseBody)
+		s.Contains(*d.LastError, "502")
+		return true, nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("webhook_subscription.disabled", log.Action)
+		return nil
+	})
+
+	s.service.ProcessPendingDeliveries(context.Background())
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestProcessPendingDeliveries_TruncatesResponse() {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(strings.Repeat("x", 10*customerWebhookMaxResponseBody)))
+	}))
+	defer server.Close()
+	s.trust(server)
+
+	subscription := s.subscription(server.URL, models.EventTypeTransactionPosted)
+	delivery := models.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Subscription: *subscription, EventID: uuid.New(), Status: models.WebhookStatusPending}
+
+	s.subscriptionRepo.EXPECT().FindPendingDeliveries(gomock.Any(), webhookBatchLimit).Return([]models.WebhookDelivery{delivery}, nil)
+	s.subscriptionRepo.EXPECT().RecordDeliveryAttempt(gomock.Any(), gomock.Any(), false).DoAndReturn(func(_ context.Context, d *models.WebhookDelivery, _ bool) (bool, error) {
+		s.Len(*d.ResponseBody, customerWebhookMaxResponseBody)
+		return false, nil
+	})
+
+	s.service.ProcessPendingDeliveries(context.Background())
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestProcessPendingDeliveries_DoesNotFollowRedirects() {
+	var redirected bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hooks", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/internal", func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	})
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+	s.trust(server)
+
+	subscription := s.subscription(server.URL+"/hooks", models.EventTypeTransactionPosted)
+	delivery := models.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Subscription: *subscription, EventID: uuid.New(), Status: models.WebhookStatusPending}
+
+	s.subscriptionRepo.EXPECT().FindPendingDeliveries(gomock.Any(), webhookBatchLimit).Return([]models.WebhookDelivery{delivery}, nil)
+	s.subscriptionRepo.EXPECT().RecordDeliveryAttempt(gomock.Any(), gomock.Any(), false).DoAndReturn(func(_ context.Context, d *models.WebhookDelivery, _ bool) (bool, error) {
+		s.Equal(http.StatusTemporaryRedirect, *d.ResponseStatusCode)
+		return false, nil
+	})
+
+	s.service.ProcessPendingDeliveries(context.Background())
+	s.False(redirected)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestProcessPendingDeliveries_RefusesDisallowedURL() {
+	// Created before URLs were restricted
+	subscription := s.subscription("http://example.com/hooks", models.EventTypeTransactionPosted)
+	delivery := models.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Subscription: *subscription, EventID: uuid.New(), Status: models.WebhookStatusPending}
+
+	s.subscriptionRepo.EXPECT().FindPendingDeliveries(gomock.Any(), webhookBatchLimit).Return([]models.WebhookDelivery{delivery}, nil)
+	s.subscriptionRepo.EXPECT().RecordDeliveryAttempt(gomock.Any(), gomock.Any(), false).DoAndReturn(func(_ context.Context, d *models.WebhookDelivery, _ bool) (bool, error) {
+		s.Nil(d.ResponseStatusCode)
+		s.Contains(*d.LastError, "invalid webhook URL")
+		return false, nil
+	})
+
+	s.service.ProcessPendingDeliveries(context.Background())
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestHTTPClient_RefusesInternalAddresses() {
+	var reached bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+	defer server.Close()
+
+	// The dialer checks the resolved address, so this holds for any name that resolves to it
+	_, err := newCustomerWebhookClient().Post(server.URL, "application/json", nil)
+	s.ErrorIs(err, netguard.ErrDisallowedAddress)
+	s.False(reached)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestCreateSubscription_DisallowedURL() {
+	for _, url := range []string{
+		"http://example.com/hooks",
+		"https://169.254.169.254/latest/meta-data",
+		"https://127.0.0.1:9090/metrics",
+		"https://localhost/admin",
+		"https://[fd00::1]/hooks",
+	} {
+		_, err := s.service.CreateSubscription(context.Background(), s.userID, &dto.CreateWebhookSubscriptionRequest{
+			URL:        url,
+			EventTypes: []string{models.EventTypeTransactionPosted},
+		})
+		s.ErrorIs(err, ErrInvalidWebhookURL, url)
+	}
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestUpdateSubscription_DisallowedURL() {
+	subscription := s.subscription("https://example.com/hooks", models.EventTypeTransactionPosted)
+	s.subscriptionRepo.EXPECT().GetByID(gomock.Any(), subscription.ID).Return(subscription, nil)
+	s.subscriptionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+	url := "https://10.0.0.5/hooks"
+	_, err := s.service.UpdateSubscription(context.Background(), s.userID, subscription.ID, &dto.UpdateWebhookSubscriptionRequest{URL: &url})
+	s.ErrorIs(err, ErrInvalidWebhookURL)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestRedeliver() {
+	subscription := s.subscription("https://example.com/hooks", models.EventTypeTransactionPosted)
+	sent := &models.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Status: models.WebhookStatusSent, Attempts: 2}
+
+	s.subscriptionRepo.EXPECT().GetByID(gomock.Any(), subscription.ID).Return(subscription, nil).Times(2)
+	s.subscriptionRepo.EXPECT().GetDelivery(gomock.Any(), sent.ID).Return(sent, nil)
+	s.subscriptionRepo.EXPECT().UpdateDelivery(gomock.Any(), sent).Return(nil)
+
+	delivery, err := s.service.Redeliver(context.Background(), s.userID, subscription.ID, sent.ID)
+	s.Require().NoError(err)
+	s.Equal(models.WebhookStatusPending, delivery.Status)
+	s.Zero(delivery.Attempts)
+	s.NotNil(delivery.NextAttemptAt)
+
+	next := time.Now().Add(time.Minute)
+	retrying := &models.WebhookDelivery{ID: uuid.New(), SubscriptionID: subscription.ID, Status: models.WebhookStatusFailed, Attempts: 1, NextAttemptAt: &next}
+	s.subscriptionRepo.EXPECT().GetDelivery(gomock.Any(), retrying.ID).Return(retrying, nil)
+
+	_, err = s.service.Redeliver(context.Background(), s.userID, subscription.ID, retrying.ID)
+	s.ErrorIs(err, ErrWebhookDeliveryInProgress)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestRedeliver_DeliveryOfOtherSubscription() {
+	subscription := s.subscription("https://example.com/hooks", models.EventTypeTransactionPosted)
+	other := &models.WebhookDelivery{ID: uuid.New(), SubscriptionID: uuid.New(), Status: models.WebhookStatusSent}
+
+	s.subscriptionRepo.EXPECT().GetByID(gomock.Any(), subscription.ID).Return(subscription, nil)
+	s.subscriptionRepo.EXPECT().GetDelivery(gomock.Any(), other.ID).Return(other, nil)
+
+	_, err := s.service.Redeliver(context.Background(), s.userID, subscription.ID, other.ID)
+	s.ErrorIs(err, ErrWebhookDeliveryNotFound)
+}
+
+func (s *CustomerWebhookServiceTestSuite) TestPing_FailureIsNotRetried() {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.NotEmpty(r.Header.Get(regulatorwebhook.SignatureHeader))
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	s.trust(server)
+
+	subscription := s.subscription(server.URL, models.EventTypeTransactionPosted)
+	s.subscriptionRepo.EXPECT().GetByID(gomock.Any(), subscription.ID).Return(subscription, nil)
+	s.subscriptionRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, deliveries []*models.WebhookDelivery) error {
+		s.Equal(models.WebhookEventPing, deliveries[0].EventType)
+		// Stand in for the BeforeCreate hook
+		deliveries[0].EventID = uuid.New()
+		return nil
+	})
+	s.subscriptionRepo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).Return(nil)
+
+	delivery, err := s.service.Ping(context.Background(), s.userID, subscription.ID)
+	s.Require().NoError(err)
+	s.Equal(models.WebhookStatusFailed, delivery.Status)
+	s.Equal(http.StatusNotFound, *delivery.ResponseStatusCode)
+	s.Nil(delivery.NextAttemptAt)
+}
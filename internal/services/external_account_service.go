@@ -4,32 +4,64 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"math/rand"
+	"time"
 
+	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
 	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 var (
-	ErrRegistrationFailed = errors.New("failed to register external account with external bank")
+	ErrRegistrationFailed                 = errors.New("failed to register external account with external bank")
+	ErrExternalAccountNotFound            = errors.New("external account not found")
+	ErrExternalAccountNotVerified         = errors.New("external account is not verified")
+	ErrExternalAccountLocked              = errors.New("external account verification is locked")
+	ErrMicroDepositMismatch               = errors.New("micro-deposit amounts do not match")
+	ErrInvalidVerificationState           = errors.New("external account is not in a valid state for this verification step")
+	ErrMicroDepositsFailed                = errors.New("failed to send micro-deposits to external account")
+	ErrMicroDepositSourceNotConfigured    = errors.New("micro-deposit source account is not configured")
+	ErrExternalAccountHasPendingTransfers = errors.New("external account has pending transfers")
 )
 
 type externalAccountService struct {
 	externalAccountRepo repositories.ExternalAccountRepositoryInterface
+	transferRepo        repositories.TransferRepositoryInterface
+	auditRepo           repositories.AuditLogRepositoryInterface
 	northwindClient     NorthwindClientInterface
+	sanctionsScreener   SanctionsScreeningServiceInterface // Optional; payees are not screened when nil
+	config              config.NorthwindConfig
+	logger              *slog.Logger
 }
 
 func NewExternalAccountService(
 	externalAccountRepo repositories.ExternalAccountRepositoryInterface,
+	transferRepo repositories.TransferRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
 	northwindClient NorthwindClientInterface,
+	sanctionsScreener SanctionsScreeningServiceInterface,
+	cfg config.NorthwindConfig,
 ) ExternalAccountServiceInterface {
 	return &externalAccountService{
 		externalAccountRepo: externalAccountRepo,
+		transferRepo:        transferRepo,
+		auditRepo:           auditRepo,
 		northwindClient:     northwindClient,
+		sanctionsScreener:   sanctionsScreener,
+		config:              cfg,
+		logger:              slog.Default().With("service", "ExternalAccountService"),
 	}
 }
 
+// Register creates the payee with Northwind, stores it locally in the unverified state, screens
+// the name on the account and immediately sends the two verification micro-deposits. A failure
+// to send the deposits does not fail registration; the payee is left in the failed state and the
+// customer can re-send. Payees held by sanctions screening receive no deposits.
 func (s *externalAccountService) Register(ctx context.Context, userID uuid.UUID, req *dto.RegisterExternalAccountRequest) (*models.ExternalAccount, error) {
 	northwindReq := &dto.NorthwindCreateAccountRequest{
 		AccountNumber: req.AccountNumber,
@@ -45,17 +77,349 @@ func (s *externalAccountService) Register(ctx context.Context, userID uuid.UUID,
 	}
 
 	account := &models.ExternalAccount{
-		UserID:            userID,
-		ExternalAccountID: northwindResp.ID,
-		Nickname:          req.Nickname,
-		AccountNumberMask: req.AccountNumber[len(req.AccountNumber)-4:],
-		NameOnAccount:     req.NameOnAccount,
-		BankName:          req.BankName,
+		UserID:             userID,
+		ExternalAccountID:  northwindResp.ID,
+		Nickname:           req.Nickname,
+		AccountNumberMask:  req.AccountNumber[len(req.AccountNumber)-4:],
+		NameOnAccount:      req.NameOnAccount,
+		BankName:           req.BankName,
+		VerificationStatus: models.ExternalAccountStatusUnverified,
 	}
 
-	if err := s.externalAccountRepo.Create(account); err != nil {
+	if err := s.externalAccountRepo.Create(ctx, account); err != nil {
 		return nil, fmt.Errorf("failed to save external account locally: %w", err)
 	}
 
+	if s.sanctionsScreener != nil {
+		if err := s.sanctionsScreener.ScreenExternalAccount(ctx, account); err != nil {
+			return nil, fmt.Errorf("failed to screen external account: %w", err)
+		}
+	}
+	if account.IsSanctionsHeld() {
+		s.logger.WarnContext(ctx, "micro-deposits withheld pending sanctions review", "external_account_id", account.ID)
+		return account, nil
+	}
+
+	if err := s.sendMicroDeposits(ctx, account); err != nil {
+		s.logger.WarnContext(ctx, "micro-deposits not sent at registration", "external_account_id", account.ID, "error", err)
+	}
+
+	return account, nil
+}
+
+// SendMicroDeposits (re)sends the verification micro-deposits for a payee that is
+// unverified or whose previous deposits failed.
+func (s *externalAccountService) SendMicroDeposits(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccount, error) {
+	account, err := s.getOwnedAccount(ctx, userID, externalAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsLocked() {
+		return nil, ErrExternalAccountLocked
+	}
+	if account.IsSanctionsHeld() {
+		return nil, ErrPayeeSanctionsHold
+	}
+	if !account.CanSendMicroDeposits() {
+		return nil, ErrInvalidVerificationState
+	}
+
+	if err := s.sendMicroDeposits(ctx, account); err != nil {
+		return nil, err
+	}
+
 	return account, nil
 }
+
+// VerifyMicroDeposits checks the amounts reported by the customer against the deposits sent.
+// Every attempt is counted and audited; the payee is locked once the attempt limit is reached.
+func (s *externalAccountService) VerifyMicroDeposits(ctx context.Context, userID, externalAccountID uuid.UUID, amount1, amount2 decimal.Decimal) (*models.ExternalAccount, error) {
+	account, err := s.getOwnedAccount(ctx, userID, externalAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkVerificationState(account); err != nil {
+		return nil, err
+	}
+	if account.IsVerified() {
+		return account, nil
+	}
+
+	// The attempt is counted before the amounts are compared, so concurrent requests share the
+	// attempt limit rather than each seeing the count as it was read
+	attempt, err := s.externalAccountRepo.ClaimVerificationAttempt(ctx, account.ID, models.MaxMicroDepositVerificationAttempts)
+	if err != nil {
+		if errors.Is(err, repositories.ErrVerificationAttemptRefused) {
+			return s.settledVerification(ctx, account.ID)
+		}
+		return nil, fmt.Errorf("failed to record verification attempt: %w", err)
+	}
+
+	matched := account.MatchesMicroDeposits(amount1, amount2)
+	account.VerificationAttempts = attempt
+	account.ApplyVerificationResult(matched)
+
+	s.audit(ctx, account, "external_account.verification_attempted", models.JSONBMap{
+		"attempt":            account.VerificationAttempts,
+		"matched":            matched,
+		"remaining_attempts": account.RemainingVerificationAttempts(),
+	})
+
+	if account.VerificationStatus != models.ExternalAccountStatusMicroDepositsSent {
+		if err := s.externalAccountRepo.UpdateVerificationStatus(ctx, account, models.ExternalAccountStatusMicroDepositsSent); err != nil {
+			if errors.Is(err, repositories.ErrVerificationStatusChanged) {
+				return s.settledVerification(ctx, account.ID)
+			}
+			return nil, fmt.Errorf("failed to update external account: %w", err)
+		}
+	}
+
+	switch {
+	case matched:
+		s.audit(ctx, account, "external_account.verified", models.JSONBMap{
+			"attempts": account.VerificationAttempts,
+		})
+		return account, nil
+	case account.IsLocked():
+		s.audit(ctx, account, "external_account.verification_locked", models.JSONBMap{
+			"attempts": account.VerificationAttempts,
+		})
+		return nil, ErrExternalAccountLocked
+	default:
+		return nil, fmt.Errorf("%w: %d attempts remaining", ErrMicroDepositMismatch, account.RemainingVerificationAttempts())
+	}
+}
+
+// settledVerification reports the outcome for an attempt refused because concurrent attempts
+// verified or locked the payee, or used up the remaining attempts, since it was read.
+func (s *externalAccountService) settledVerification(ctx context.Context, externalAccountID uuid.UUID) (*models.ExternalAccount, error) {
+	account, err := s.externalAccountRepo.GetByID(ctx, externalAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload external account: %w", err)
+	}
+	if err := checkVerificationState(account); err != nil {
+		return nil, err
+	}
+	if account.IsVerified() {
+		return account, nil
+	}
+	// Still awaiting confirmation with no attempts left: the request holding the last attempt
+	// settles the status
+	return nil, ErrExternalAccountLocked
+}
+
+// checkVerificationState returns the error for a payee that is not awaiting confirmation; a
+// verified payee is not an error.
+func checkVerificationState(account *models.ExternalAccount) error {
+	switch account.VerificationStatus {
+	case models.ExternalAccountStatusVerified, models.ExternalAccountStatusMicroDepositsSent:
+		return nil
+	case models.ExternalAccountStatusLocked:
+		return ErrExternalAccountLocked
+	default:
+		return ErrInvalidVerificationState
+	}
+}
+
+// List returns the user's payees, most recently registered first.
+func (s *externalAccountService) List(ctx context.Context, userID uuid.UUID) ([]models.ExternalAccount, error) {
+	accounts, err := s.externalAccountRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list external accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// Get returns a single payee owned by the user.
+func (s *externalAccountService) Get(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccount, error) {
+	return s.getOwnedAccount(ctx, userID, externalAccountID)
+}
+
+// UpdateNickname renames a payee. Banking details are immutable; a changed account must be re-registered.
+func (s *externalAccountService) UpdateNickname(ctx context.Context, userID, externalAccountID uuid.UUID, nickname string) (*models.ExternalAccount, error) {
+	account, err := s.getOwnedAccount(ctx, userID, externalAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.externalAccountRepo.UpdateNickname(ctx, account.ID, nickname); err != nil {
+		return nil, fmt.Errorf("failed to update external account: %w", err)
+	}
+	previous := account.Nickname
+	account.Nickname = nickname
+
+	s.audit(ctx, account, "external_account.updated", models.JSONBMap{
+		"previous_nickname": previous,
+		"nickname":          nickname,
+	})
+
+	return account, nil
+}
+
+// Delete removes a payee. Payees with transfers still pending or processing with the partner
+// cannot be removed until those transfers settle.
+func (s *externalAccountService) Delete(ctx context.Context, userID, externalAccountID uuid.UUID) error {
+	account, err := s.getOwnedAccount(ctx, userID, externalAccountID)
+	if err != nil {
+		return err
+	}
+
+	stats, err := s.transferRepo.GetExternalAccountStats(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check pending transfers: %w", err)
+	}
+	if stats.PendingTransfers > 0 {
+		return fmt.Errorf("%w: %d still in flight", ErrExternalAccountHasPendingTransfers, stats.PendingTransfers)
+	}
+
+	// The repository checks again under the payee's lock, in case a transfer was created since
+	if err := s.externalAccountRepo.Delete(ctx, account.ID); err != nil {
+		if errors.Is(err, repositories.ErrExternalAccountHasTransfersInFlight) {
+			return fmt.Errorf("%w: a transfer was started while deleting", ErrExternalAccountHasPendingTransfers)
+		}
+		return fmt.Errorf("failed to delete external account: %w", err)
+	}
+
+	s.audit(ctx, account, "external_account.deleted", models.JSONBMap{
+		"nickname":        account.Nickname,
+		"total_transfers": stats.TotalTransfers,
+	})
+
+	return nil
+}
+
+// GetTransferHistory returns transfers sent to a payee owned by the user.
+func (s *externalAccountService) GetTransferHistory(ctx context.Context, userID, externalAccountID uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
+	account, err := s.getOwnedAccount(ctx, userID, externalAccountID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transfers, total, err := s.transferRepo.FindByExternalAccount(ctx, account.ID, offset, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get transfer history: %w", err)
+	}
+
+	return transfers, total, nil
+}
+
+// GetTransferStats returns usage statistics for a payee owned by the user.
+func (s *externalAccountService) GetTransferStats(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccountTransferStats, error) {
+	account, err := s.getOwnedAccount(ctx, userID, externalAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.transferRepo.GetExternalAccountStats(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *externalAccountService) getOwnedAccount(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccount, error) {
+	account, err := s.externalAccountRepo.GetByID(ctx, externalAccountID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrExternalAccountNotFound) {
+			return nil, ErrExternalAccountNotFound
+		}
+		return nil, err
+	}
+	// Treat other users' payees as not found so their existence is not revealed
+	if account.UserID != userID {
+		return nil, ErrExternalAccountNotFound
+	}
+	return account, nil
+}
+
+// sendMicroDeposits sends two random amounts between $0.01 and $0.99 to the payee and records
+// the outcome on the account. The amounts are saved before the first is sent and each accepted
+// deposit is counted, so a send that stopped part-way resumes with the same amounts, and each
+// deposit carries an idempotency key so the partner does not pay it out twice.
+func (s *externalAccountService) sendMicroDeposits(ctx context.Context, account *models.ExternalAccount) error {
+	if s.config.MicroDepositSourceAccount == "" {
+		return ErrMicroDepositSourceNotConfigured
+	}
+
+	if !account.HasMicroDepositAmounts() {
+		account.MicroDepositAmount1, account.MicroDepositAmount2 = generateMicroDepositAmounts()
+		account.MicroDepositsSent = 0
+		if err := s.externalAccountRepo.UpdateMicroDeposits(ctx, account); err != nil {
+			return fmt.Errorf("failed to save micro-deposit amounts: %w", err)
+		}
+	}
+
+	amounts := account.MicroDepositAmounts()
+	for account.MicroDepositsSent < len(amounts) {
+		amount := amounts[account.MicroDepositsSent]
+		_, err := s.northwindClient.InitiateTransfer(ctx, &dto.NorthwindInitiateTransferRequest{
+			SourceAccountID:      s.config.MicroDepositSourceAccount,
+			DestinationAccountID: account.ExternalAccountID.String(),
+			Amount:               amount.StringFixed(2),
+			Direction:            "debit",
+			TransferType:         "standard",
+			IdempotencyKey:       fmt.Sprintf("micro-deposit-%s-%d-%s", account.ID, account.MicroDepositsSent+1, amount.StringFixed(2)),
+		})
+		if err != nil {
+			account.VerificationStatus = models.ExternalAccountStatusFailed
+			if updateErr := s.externalAccountRepo.UpdateMicroDeposits(ctx, account); updateErr != nil {
+				s.logger.ErrorContext(ctx, "failed to record micro-deposit failure", "external_account_id", account.ID, "error", updateErr)
+			}
+			s.audit(ctx, account, "external_account.micro_deposits_failed", models.JSONBMap{
+				"error": err.Error(),
+				"sent":  account.MicroDepositsSent,
+			})
+			return fmt.Errorf("%w: %v", ErrMicroDepositsFailed, err)
+		}
+
+		account.MicroDepositsSent++
+		if account.MicroDepositsSent < len(amounts) {
+			if err := s.externalAccountRepo.UpdateMicroDeposits(ctx, account); err != nil {
+				return fmt.Errorf("failed to record micro-deposit: %w", err)
+			}
+		}
+	}
+
+	now := time.Now()
+	account.MicroDepositsSentAt = &now
+	account.VerificationAttempts = 0
+	account.VerificationStatus = models.ExternalAccountStatusMicroDepositsSent
+
+	if err := s.externalAccountRepo.UpdateMicroDeposits(ctx, account); err != nil {
+		return fmt.Errorf("failed to update external account: %w", err)
+	}
+
+	s.audit(ctx, account, "external_account.micro_deposits_sent", nil)
+
+	return nil
+}
+
+func (s *externalAccountService) audit(ctx context.Context, account *models.ExternalAccount, action string, metadata models.JSONBMap) {
+	if s.auditRepo == nil {
+		return
+	}
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &account.UserID,
+		Action:     action,
+		Resource:   "external_account",
+		ResourceID: account.ID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
+
+// generateMicroDepositAmounts returns two distinct amounts between $0.01 and $0.99.
+func generateMicroDepositAmounts() (decimal.Decimal, decimal.Decimal) {
+	cents1 := rand.Intn(99) + 1
+	cents2 := rand.Intn(98) + 1
+	if cents2 >= cents1 {
+		cents2++
+	}
+	return decimal.New(int64(cents1), -2), decimal.New(int64(cents2), -2)
+}
@@ -3,14 +3,19 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
 	"github.com/array/banking-api/internal/repositories/repository_mocks"
 	"github.com/array/banking-api/internal/services/service_mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -18,6 +23,8 @@ type ExternalAccountServiceTestSuite struct {
 	suite.Suite
 	ctrl                *gomock.Controller
 	externalAccountRepo *repository_mocks.MockExternalAccountRepositoryInterface
+	transferRepo        *repository_mocks.MockTransferRepositoryInterface
+	auditRepo           *repository_mocks.MockAuditLogRepositoryInterface
 	northwindClient     *service_mocks.MockNorthwindClientInterface
 	service             ExternalAccountServiceInterface
 }
@@ -25,8 +32,12 @@ type ExternalAccountServiceTestSuite struct {
 func (s *ExternalAccountServiceTestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.externalAccountRepo = repository_mocks.NewMockExternalAccountRepositoryInterface(s.ctrl)
+	s.transferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
 	s.northwindClient = service_mocks.NewMockNorthwindClientInterface(s.ctrl)
-	s.service = NewExternalAccountService(s.externalAccountRepo, s.northwindClient)
+	s.service = NewExternalAccountService(s.externalAccountRepo, s.transferRepo, s.auditRepo, s.northwindClient, nil, config.NorthwindConfig{
+		MicroDepositSourceAccount: "1000000001",
+	})
 }
 
 func (s *ExternalAccountServiceTestSuite) TearDownTest() {
@@ -58,14 +69,38 @@ func (s *ExternalAccountServiceTestSuite) TestRegister_Success() {
 		}).Times(1)
 
 	s.externalAccountRepo.EXPECT().
-		Create(gomock.Any()).
-		DoAndReturn(func(account *models.ExternalAccount) error {
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, account *models.ExternalAccount) error {
 			s.Equal(userID, account.UserID)
 			s.Equal(northwindID, account.ExternalAccountID)
 			s.Equal(req.Nickname, account.Nickname)
 			s.Equal("9012", account.AccountNumberMask) // last 4 digits
 			s.Equal(req.NameOnAccount, account.NameOnAccount)
 			s.Equal(req.BankName, account.BankName)
+			s.Equal(models.ExternalAccountStatusUnverified, account.VerificationStatus)
+			return nil
+		}).Times(1)
+
+	idempotencyKeys := map[string]bool{}
+	s.northwindClient.EXPECT().
+		InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+			s.Equal("1000000001", req.SourceAccountID)
+			s.Equal(northwindID.String(), req.DestinationAccountID)
+			amount, err := decimal.NewFromString(req.Amount)
+			s.NoError(err)
+			s.True(amount.GreaterThan(decimal.Zero))
+			s.True(amount.LessThan(decimal.NewFromInt(1)))
+			s.NotEmpty(req.IdempotencyKey)
+			idempotencyKeys[req.IdempotencyKey] = true
+			return &dto.NorthwindInitiateTransferResponse{ID: uuid.NewString(), Status: "pending"}, nil
+		}).Times(2)
+	// Amounts are saved before sending, progress after the first deposit, and the outcome last
+	s.externalAccountRepo.EXPECT().UpdateMicroDeposits(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			s.Equal("external_account.micro_deposits_sent", log.Action)
 			return nil
 		}).Times(1)
 
@@ -73,6 +108,39 @@ func (s *ExternalAccountServiceTestSuite) TestRegister_Success() {
 	s.NoError(err)
 	s.NotNil(account)
 	s.Equal(northwindID, account.ExternalAccountID)
+	s.Equal(models.ExternalAccountStatusMicroDepositsSent, account.VerificationStatus)
+	s.NotNil(account.MicroDepositsSentAt)
+	s.False(account.MicroDepositAmount1.Equal(account.MicroDepositAmount2))
+	s.Equal(models.MicroDepositCount, account.MicroDepositsSent)
+	s.Len(idempotencyKeys, 2)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestRegister_MicroDepositFailureStillRegisters() {
+	userID := uuid.New()
+	req := &dto.RegisterExternalAccountRequest{
+		BankName:      "Northwind Bank",
+		Nickname:      "Rent",
+		AccountNumber: "123456789012",
+		RoutingNumber: "123456789",
+		NameOnAccount: "John Doe",
+	}
+
+	s.northwindClient.EXPECT().
+		CreateExternalAccount(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindExternalAccountResponse{ID: uuid.New()}, nil)
+	s.externalAccountRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	s.northwindClient.EXPECT().
+		InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("northwind is down")).
+		Times(1)
+	s.externalAccountRepo.EXPECT().UpdateMicroDeposits(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	account, err := s.service.Register(context.Background(), userID, req)
+	s.NoError(err)
+	s.Equal(models.ExternalAccountStatusFailed, account.VerificationStatus)
+	s.Zero(account.MicroDepositsSent)
+	s.False(account.MicroDepositAmount1.IsZero())
 }
 
 func (s *ExternalAccountServiceTestSuite) TestRegister_NorthwindAPIFailure() {
@@ -91,10 +159,398 @@ func (s *ExternalAccountServiceTestSuite) TestRegister_NorthwindAPIFailure() {
 		Times(1)
 
 	// Ensure the local repo is not called
-	s.externalAccountRepo.EXPECT().Create(gomock.Any()).Times(0)
+	s.externalAccountRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
 
 	account, err := s.service.Register(context.Background(), userID, req)
 	s.Error(err)
 	s.Nil(account)
 	s.ErrorIs(err, ErrRegistrationFailed)
 }
+
+func (s *ExternalAccountServiceTestSuite) TestRegister_SanctionsMatchWithholdsMicroDeposits() {
+	screener := service_mocks.NewMockSanctionsScreeningServiceInterface(s.ctrl)
+	s.service.(*externalAccountService).sanctionsScreener = screener
+	req := &dto.RegisterExternalAccountRequest{
+		BankName:      "Northwind Bank",
+		Nickname:      "Supplier",
+		AccountNumber: "123456789012",
+		RoutingNumber: "123456789",
+		NameOnAccount: "Banco Nacional de Cuba",
+	}
+
+	s.northwindClient.EXPECT().
+		CreateExternalAccount(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindExternalAccountResponse{ID: uuid.New()}, nil)
+	s.externalAccountRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	screener.EXPECT().
+		ScreenExternalAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, account *models.ExternalAccount) error {
+			s.Equal(req.NameOnAccount, account.NameOnAccount)
+			account.SanctionsStatus = models.SanctionsStatusBlocked
+			return nil
+		})
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	account, err := s.service.Register(context.Background(), uuid.New(), req)
+	s.NoError(err)
+	s.True(account.IsSanctionsHeld())
+	s.Equal(models.ExternalAccountStatusUnverified, account.VerificationStatus)
+}
+
+func (s *ExternalAccountServiceTestSuite) newPendingAccount(userID uuid.UUID) *models.ExternalAccount {
+	sentAt := time.Now()
+	return &models.ExternalAccount{
+		ID:                  uuid.New(),
+		UserID:              userID,
+		ExternalAccountID:   uuid.New(),
+		VerificationStatus:  models.ExternalAccountStatusMicroDepositsSent,
+		MicroDepositAmount1: decimal.RequireFromString("0.12"),
+		MicroDepositAmount2: decimal.RequireFromString("0.34"),
+		MicroDepositsSent:   models.MicroDepositCount,
+		MicroDepositsSentAt: &sentAt,
+	}
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_Success() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.externalAccountRepo.EXPECT().
+		ClaimVerificationAttempt(gomock.Any(), account.ID, models.MaxMicroDepositVerificationAttempts).
+		Return(1, nil)
+	s.externalAccountRepo.EXPECT().
+		UpdateVerificationStatus(gomock.Any(), account, models.ExternalAccountStatusMicroDepositsSent).
+		Return(nil)
+	var actions []string
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			actions = append(actions, log.Action)
+			return nil
+		}).Times(2)
+
+	// Amounts may be supplied in either order
+	result, err := s.service.VerifyMicroDeposits(context.Background(), userID, account.ID,
+		decimal.RequireFromString("0.34"), decimal.RequireFromString("0.12"))
+	s.NoError(err)
+	s.Equal(models.ExternalAccountStatusVerified, result.VerificationStatus)
+	s.NotNil(result.VerifiedAt)
+	s.Equal([]string{"external_account.verification_attempted", "external_account.verified"}, actions)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_Mismatch() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.externalAccountRepo.EXPECT().
+		ClaimVerificationAttempt(gomock.Any(), account.ID, models.MaxMicroDepositVerificationAttempts).
+		Return(1, nil)
+	s.externalAccountRepo.EXPECT().UpdateVerificationStatus(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	result, err := s.service.VerifyMicroDeposits(context.Background(), userID, account.ID,
+		decimal.RequireFromString("0.12"), decimal.RequireFromString("0.35"))
+	s.ErrorIs(err, ErrMicroDepositMismatch)
+	s.Nil(result)
+	s.Equal(1, account.VerificationAttempts)
+	s.Equal(models.ExternalAccountStatusMicroDepositsSent, account.VerificationStatus)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_LocksAfterMaxAttempts() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.VerificationAttempts = models.MaxMicroDepositVerificationAttempts - 1
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.externalAccountRepo.EXPECT().
+		ClaimVerificationAttempt(gomock.Any(), account.ID, models.MaxMicroDepositVerificationAttempts).
+		Return(models.MaxMicroDepositVerificationAttempts, nil)
+	s.externalAccountRepo.EXPECT().
+		UpdateVerificationStatus(gomock.Any(), account, models.ExternalAccountStatusMicroDepositsSent).
+		Return(nil)
+	var actions []string
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			actions = append(actions, log.Action)
+			return nil
+		}).Times(2)
+
+	_, err := s.service.VerifyMicroDeposits(context.Background(), userID, account.ID,
+		decimal.RequireFromString("0.01"), decimal.RequireFromString("0.02"))
+	s.ErrorIs(err, ErrExternalAccountLocked)
+	s.Equal(models.ExternalAccountStatusLocked, account.VerificationStatus)
+	s.Equal([]string{"external_account.verification_attempted", "external_account.verification_locked"}, actions)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_Locked() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.VerificationStatus = models.ExternalAccountStatusLocked
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.externalAccountRepo.EXPECT().ClaimVerificationAttempt(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.VerifyMicroDeposits(context.Background(), userID, account.ID,
+		decimal.RequireFromString("0.12"), decimal.RequireFromString("0.34"))
+	s.ErrorIs(err, ErrExternalAccountLocked)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_AttemptsUsedByConcurrentRequests() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	exhausted := s.newPendingAccount(userID)
+	exhausted.ID = account.ID
+	exhausted.VerificationAttempts = models.MaxMicroDepositVerificationAttempts
+
+	// The account read before the attempt still had attempts left
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil).Times(1)
+	s.externalAccountRepo.EXPECT().
+		ClaimVerificationAttempt(gomock.Any(), account.ID, models.MaxMicroDepositVerificationAttempts).
+		Return(0, repositories.ErrVerificationAttemptRefused)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(exhausted, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.VerifyMicroDeposits(context.Background(), userID, account.ID,
+		decimal.RequireFromString("0.12"), decimal.RequireFromString("0.34"))
+	s.ErrorIs(err, ErrExternalAccountLocked)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_MatchAfterConcurrentLock() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	locked := s.newPendingAccount(userID)
+	locked.ID = account.ID
+	locked.VerificationStatus = models.ExternalAccountStatusLocked
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil).Times(1)
+	s.externalAccountRepo.EXPECT().
+		ClaimVerificationAttempt(gomock.Any(), account.ID, models.MaxMicroDepositVerificationAttempts).
+		Return(2, nil)
+	s.externalAccountRepo.EXPECT().
+		UpdateVerificationStatus(gomock.Any(), account, models.ExternalAccountStatusMicroDepositsSent).
+		Return(repositories.ErrVerificationStatusChanged)
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(locked, nil).Times(1)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.service.VerifyMicroDeposits(context.Background(), userID, account.ID,
+		decimal.RequireFromString("0.12"), decimal.RequireFromString("0.34"))
+	s.ErrorIs(err, ErrExternalAccountLocked)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_NotSent() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.VerificationStatus = models.ExternalAccountStatusUnverified
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+
+	_, err := s.service.VerifyMicroDeposits(context.Background(), userID, account.ID,
+		decimal.RequireFromString("0.12"), decimal.RequireFromString("0.34"))
+	s.ErrorIs(err, ErrInvalidVerificationState)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestVerifyMicroDeposits_OtherUsersAccount() {
+	account := s.newPendingAccount(uuid.New())
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+
+	_, err := s.service.VerifyMicroDeposits(context.Background(), uuid.New(), account.ID,
+		decimal.RequireFromString("0.12"), decimal.RequireFromString("0.34"))
+	s.ErrorIs(err, ErrExternalAccountNotFound)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestSendMicroDeposits_ResendAfterFailure() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.VerificationStatus = models.ExternalAccountStatusFailed
+	account.MicroDepositAmount1 = decimal.Zero
+	account.MicroDepositAmount2 = decimal.Zero
+	account.MicroDepositsSent = 0
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.northwindClient.EXPECT().
+		InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindInitiateTransferResponse{ID: uuid.NewString(), Status: "pending"}, nil).
+		Times(2)
+	s.externalAccountRepo.EXPECT().UpdateMicroDeposits(gomock.Any(), account).Return(nil).Times(3)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := s.service.SendMicroDeposits(context.Background(), userID, account.ID)
+	s.NoError(err)
+	s.Equal(models.ExternalAccountStatusMicroDepositsSent, result.VerificationStatus)
+	s.Equal(0, result.VerificationAttempts)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestSendMicroDeposits_SecondDepositFailureKeepsAmounts() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.VerificationStatus = models.ExternalAccountStatusUnverified
+	account.MicroDepositAmount1 = decimal.Zero
+	account.MicroDepositAmount2 = decimal.Zero
+	account.MicroDepositsSent = 0
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.northwindClient.EXPECT().
+		InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindInitiateTransferResponse{ID: uuid.NewString(), Status: "pending"}, nil)
+	s.northwindClient.EXPECT().
+		InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("northwind is down"))
+	s.externalAccountRepo.EXPECT().UpdateMicroDeposits(gomock.Any(), account).Return(nil).Times(3)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.service.SendMicroDeposits(context.Background(), userID, account.ID)
+	s.ErrorIs(err, ErrMicroDepositsFailed)
+	s.Equal(models.ExternalAccountStatusFailed, account.VerificationStatus)
+	s.Equal(1, account.MicroDepositsSent)
+	s.False(account.MicroDepositAmount1.IsZero())
+	s.False(account.MicroDepositAmount2.IsZero())
+}
+
+func (s *ExternalAccountServiceTestSuite) TestSendMicroDeposits_ResumesAfterPartialSend() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.VerificationStatus = models.ExternalAccountStatusFailed
+	account.MicroDepositsSent = 1
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	// Only the deposit the partner did not accept is sent, with the amount already saved
+	s.northwindClient.EXPECT().
+		InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+			s.Equal("0.34", req.Amount)
+			s.Equal("micro-deposit-"+account.ID.String()+"-2-0.34", req.IdempotencyKey)
+			return &dto.NorthwindInitiateTransferResponse{ID: uuid.NewString(), Status: "pending"}, nil
+		})
+	s.externalAccountRepo.EXPECT().UpdateMicroDeposits(gomock.Any(), account).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := s.service.SendMicroDeposits(context.Background(), userID, account.ID)
+	s.NoError(err)
+	s.Equal(models.ExternalAccountStatusMicroDepositsSent, result.VerificationStatus)
+	s.Equal(models.MicroDepositCount, result.MicroDepositsSent)
+	s.True(result.MicroDepositAmount1.Equal(decimal.RequireFromString("0.12")))
+	s.True(result.MicroDepositAmount2.Equal(decimal.RequireFromString("0.34")))
+}
+
+func (s *ExternalAccountServiceTestSuite) TestSendMicroDeposits_AllAcceptedReusesAmounts() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	// Both deposits were accepted but saving the new status failed
+	account.VerificationStatus = models.ExternalAccountStatusUnverified
+	account.MicroDepositsSent = models.MicroDepositCount
+	account.MicroDepositsSentAt = nil
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Times(0)
+	s.externalAccountRepo.EXPECT().UpdateMicroDeposits(gomock.Any(), account).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := s.service.SendMicroDeposits(context.Background(), userID, account.ID)
+	s.NoError(err)
+	s.Equal(models.ExternalAccountStatusMicroDepositsSent, result.VerificationStatus)
+	s.True(result.MicroDepositAmount1.Equal(decimal.RequireFromString("0.12")))
+	s.True(result.MicroDepositAmount2.Equal(decimal.RequireFromString("0.34")))
+}
+
+func (s *ExternalAccountServiceTestSuite) TestSendMicroDeposits_AlreadySent() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+
+	_, err := s.service.SendMicroDeposits(context.Background(), userID, account.ID)
+	s.ErrorIs(err, ErrInvalidVerificationState)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestSendMicroDeposits_SanctionsHeld() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.VerificationStatus = models.ExternalAccountStatusUnverified
+	account.SanctionsStatus = models.SanctionsStatusPendingReview
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.SendMicroDeposits(context.Background(), userID, account.ID)
+	s.ErrorIs(err, ErrPayeeSanctionsHold)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestUpdateNickname_Success() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+	account.Nickname = "Old name"
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.externalAccountRepo.EXPECT().UpdateNickname(gomock.Any(), account.ID, "Landlord").Return(nil)
+	s.auditRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			s.Equal("external_account.updated", log.Action)
+			s.Equal("Old name", log.Metadata["previous_nickname"])
+			return nil
+		})
+
+	result, err := s.service.UpdateNickname(context.Background(), userID, account.ID, "Landlord")
+	s.NoError(err)
+	s.Equal("Landlord", result.Nickname)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestDelete_Success() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.transferRepo.EXPECT().
+		GetExternalAccountStats(gomock.Any(), account.ID).
+		Return(&models.ExternalAccountTransferStats{TotalTransfers: 3, CompletedTransfers: 3}, nil)
+	s.externalAccountRepo.EXPECT().Delete(gomock.Any(), account.ID).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.service.Delete(context.Background(), userID, account.ID)
+	s.NoError(err)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestDelete_PendingTransfers() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.transferRepo.EXPECT().
+		GetExternalAccountStats(gomock.Any(), account.ID).
+		Return(&models.ExternalAccountTransferStats{TotalTransfers: 2, PendingTransfers: 1}, nil)
+	s.externalAccountRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Delete(context.Background(), userID, account.ID)
+	s.ErrorIs(err, ErrExternalAccountHasPendingTransfers)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestDelete_TransferStartedDuringDelete() {
+	userID := uuid.New()
+	account := s.newPendingAccount(userID)
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.transferRepo.EXPECT().
+		GetExternalAccountStats(gomock.Any(), account.ID).
+		Return(&models.ExternalAccountTransferStats{}, nil)
+	s.externalAccountRepo.EXPECT().
+		Delete(gomock.Any(), account.ID).
+		Return(fmt.Errorf("%w: 1 still in flight", repositories.ErrExternalAccountHasTransfersInFlight))
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Delete(context.Background(), userID, account.ID)
+	s.ErrorIs(err, ErrExternalAccountHasPendingTransfers)
+}
+
+func (s *ExternalAccountServiceTestSuite) TestGetTransferHistory_OtherUsersAccount() {
+	account := s.newPendingAccount(uuid.New())
+
+	s.externalAccountRepo.EXPECT().GetByID(gomock.Any(), account.ID).Return(account, nil)
+	s.transferRepo.EXPECT().FindByExternalAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, _, err := s.service.GetTransferHistory(context.Background(), uuid.New(), account.ID, 0, 20)
+	s.ErrorIs(err, ErrExternalAccountNotFound)
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// Built-in fraud rule names
+const (
+	FraudRuleVelocity         = "velocity"
+	FraudRuleNewPayee         = "new_payee"
+	FraudRuleAmountSpike      = "amount_spike"
+	FraudRuleNewIPLogin       = "new_ip_login"
+	FraudRuleRoundAmountBurst = "round_amount_burst"
+)
+
+// FraudRuleEvaluator is a pluggable screening rule. Defaults supplies the settings the rule runs
+// with until an admin edits them; Evaluate receives the effective settings and returns an allow
+// result or, when the rule triggers, the configured action and score with a reason.
+type FraudRuleEvaluator interface {
+	Name() string
+	Description() string
+	Defaults() models.FraudRule
+	Evaluate(req *dto.FraudScreeningRequest, history *FraudHistory, rule models.FraudRule) dto.FraudRuleResult
+}
+
+// FraudHistory is the customer activity rules compare a money movement against.
+type FraudHistory struct {
+	Now                 time.Time
+	Debits              []models.Transaction // Completed debits in the history window, newest first
+	PriorPayeeTransfers int64                // Transfers to the payee that did not fail; external transfers only
+	Logins              []models.AuditLog    // Latest successful logins, newest first
+}
+
+// DebitsSince returns the debits posted at or after the given time.
+func (h *FraudHistory) DebitsSince(since time.Time) []models.Transaction {
+	var debits []models.Transaction
+	for _, debit := range h.Debits {
+		if !debit.CreatedAt.Before(since) {
+			debits = append(debits, debit)
+		}
+	}
+	return debits
+}
+
+// DefaultFraudRules returns the built-in screening rules.
+func DefaultFraudRules() []FraudRuleEvaluator {
+	return []FraudRuleEvaluator{
+		velocityRule{},
+		newPayeeRule{},
+		amountSpikeRule{},
+		newIPLoginRule{},
+		roundAmountBurstRule{},
+	}
+}
+
+func allowFraudRule(rule models.FraudRule) dto.FraudRuleResult {
+	return dto.FraudRuleResult{Rule: rule.Name, Outcome: models.FraudOutcomeAllow}
+}
+
+func triggerFraudRule(rule models.FraudRule, format string, args ...interface{}) dto.FraudRuleResult {
+	return dto.FraudRuleResult{
+		Rule:      rule.Name,
+		Outcome:   rule.Action,
+		Score:     rule.Score,
+		Triggered: true,
+		Reason:    fmt.Sprintf(format, args...),
+	}
+}
+
+// fraudParamInt reads an integer rule parameter; JSON numbers read back from the database are float64.
+func fraudParamInt(params models.JSONBMap, key string, fallback int) int {
+	switch v := params[key].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return fallback
+}
+
+// fraudParamDecimal reads a money rule parameter stored as a decimal string.
+func fraudParamDecimal(params models.JSONBMap, key string, fallback decimal.Decimal) decimal.Decimal {
+	switch v := params[key].(type) {
+	case string:
+		if d, err := decimal.NewFromString(v); err == nil {
+			return d
+		}
+	case float64:
+		return decimal.NewFromFloat(v)
+	}
+	return fallback
+}
+
+// velocityRule triggers when the customer moves money out too many times in a short window.
+type velocityRule struct{}
+
+func (velocityRule) Name() string { return FraudRuleVelocity }
+
+func (velocityRule) Description() string {
+	return "More than max_count debits across the customer's accounts within window_minutes"
+}
+
+func (velocityRule) Defaults() models.FraudRule {
+	return models.FraudRule{
+		Name: FraudRuleVelocity, Enabled: true, Action: models.FraudOutcomeReview, Score: 40,
+		Params: models.JSONBMap{"window_minutes": 60, "max_count": 5},
+	}
+}
+
+func (velocityRule) Evaluate(req *dto.FraudScreeningRequest, history *FraudHistory, rule models.FraudRule) dto.FraudRuleResult {
+	window := fraudParamInt(rule.Params, "window_minutes", 60)
+	maxCount := fraudParamInt(rule.Params, "max_count", 5)
+
+	count := len(history.DebitsSince(history.Now.Add(-time.Duration(window)*time.Minute))) + 1
+	if count > maxCount {
+		return triggerFraudRule(rule, "%d debits within %d minutes exceeds %d", count, window, maxCount)
+	}
+	return allowFraudRule(rule)
+}
+
+// newPayeeRule triggers on the first sizeable transfer to an external account.
+type newPayeeRule struct{}
+
+func (newPayeeRule) Name() string { return FraudRuleNewPayee }
+
+func (newPayeeRule) Description() string {
+	return "First external transfer to a payee of at least min_amount"
+}
+
+func (newPayeeRule) Defaults() models.FraudRule {
+	return models.FraudRule{
+		Name: FraudRuleNewPayee, Enabled: true, Action: models.FraudOutcomeReview, Score: 30,
+		Params: models.JSONBMap{"min_amount": "500.00"},
+	}
+}
+
+func (newPayeeRule) Evaluate(req *dto.FraudScreeningRequest, history *FraudHistory, rule models.FraudRule) dto.FraudRuleResult {
+	if req.ExternalAccountID == nil || history.PriorPayeeTransfers > 0 {
+		return allowFraudRule(rule)
+	}
+
+	minAmount := fraudParamDecimal(rule.Params, "min_amount", decimal.NewFromInt(500))
+	if req.Amount.LessThan(minAmount) {
+		return allowFraudRule(rule)
+	}
+	return triggerFraudRule(rule, "first transfer to this payee is %s, at least %s", req.Amount.StringFixed(2), minAmount.StringFixed(2))
+}
+
+// amountSpikeRule triggers when a debit is far above the customer's usual debit amount.
+type amountSpikeRule struct{}
+
+func (amountSpikeRule) Name() string { return FraudRuleAmountSpike }
+
+func (amountSpikeRule) Description() string {
+	return "Amount of at least min_amount and more than multiplier times the customer's average debit, given min_history earlier debits"
+}
+
+func (amountSpikeRule) Defaults() models.FraudRule {
+	return models.FraudRule{
+		Name: FraudRuleAmountSpike, Enabled: true, Action: models.FraudOutcomeReview, Score: 40,
+		Params: models.JSONBMap{"multiplier": 5, "min_history": 3, "min_amount": "1000.00"},
+	}
+}
+
+func (amountSpikeRule) Evaluate(req *dto.FraudScreeningRequest, history *FraudHistory, rule models.FraudRule) dto.FraudRuleResult {
+	multiplier := fraudParamInt(rule.Params, "multiplier", 5)
+	minHistory := fraudParamInt(rule.Params, "min_history", 3)
+	minAmount := fraudParamDecimal(rule.Params, "min_amount", decimal.NewFromInt(1000))
+
+	if len(history.Debits) == 0 || len(history.Debits) < minHistory || req.Amount.LessThan(minAmount) {
+		return allowFraudRule(rule)
+	}
+
+	total := decimal.Zero
+	for _, debit := range history.Debits {
+		total = total.Add(debit.Amount)
+	}
+	average := total.Div(decimal.NewFromInt(int64(len(history.Debits))))
+
+	if req.Amount.GreaterThan(average.Mul(decimal.NewFromInt(int64(multiplier)))) {
+		return triggerFraudRule(rule, "amount %s is more than %d times the average debit of %s", req.Amount.StringFixed(2), multiplier, average.StringFixed(2))
+	}
+	return allowFraudRule(rule)
+}
+
+// newIPLoginRule triggers when the customer's latest login, shortly before the movement, came
+// from an IP address none of their earlier logins used.
+type newIPLoginRule struct{}
+
+func (newIPLoginRule) Name() string { return FraudRuleNewIPLogin }
+
+func (newIPLoginRule) Description() string {
+	return "Latest login within window_minutes came from an IP address not seen in earlier logins"
+}
+
+func (newIPLoginRule) Defaults() models.FraudRule {
+	return models.FraudRule{
+		Name: FraudRuleNewIPLogin, Enabled: true, Action: models.FraudOutcomeReview, Score: 40,
+		Params: models.JSONBMap{"window_minutes": 30},
+	}
+}
+
+func (newIPLoginRule) Evaluate(req *dto.FraudScreeningRequest, history *FraudHistory, rule models.FraudRule) dto.FraudRuleResult {
+	// A first-ever login has nothing to compare against
+	if len(history.Logins) < 2 {
+		return allowFraudRule(rule)
+	}
+
+	window := fraudParamInt(rule.Params, "window_minutes", 30)
+	latest := history.Logins[0]
+	if latest.IPAddress == "" || latest.CreatedAt.Before(history.Now.Add(-time.Duration(window)*time.Minute)) {
+		return allowFraudRule(rule)
+	}
+
+	for _, login := range history.Logins[1:] {
+		if login.IPAddress == latest.IPAddress {
+			return allowFraudRule(rule)
+		}
+	}
+	minutes := int(math.Max(0, history.Now.Sub(latest.CreatedAt).Minutes()))
+	return triggerFraudRule(rule, "login from new IP address %s %d minutes earlier", latest.IPAddress, minutes)
+}
+
+// roundAmountBurstRule triggers on repeated round-amount debits in a short window.
+type roundAmountBurstRule struct{}
+
+func (roundAmountBurstRule) Name() string { return FraudRuleRoundAmountBurst }
+
+func (roundAmountBurstRule) Description() string {
+	return "At least min_count debits that are multiples of round_unit within window_minutes, this one included"
+}
+
+func (roundAmountBurstRule) Defaults() models.FraudRule {
+	return models.FraudRule{
+		Name: FraudRuleRoundAmountBurst, Enabled: true, Action: models.FraudOutcomeReview, Score: 30,
+		Params: models.JSONBMap{"window_minutes": 60, "min_count": 3, "round_unit": "100.00"},
+	}
+}
+
+func (roundAmountBurstRule) Evaluate(req *dto.FraudScreeningRequest, history *FraudHistory, rule models.FraudRule) dto.FraudRuleResult {
+	window := fraudParamInt(rule.Params, "window_minutes", 60)
+	minCount := fraudParamInt(rule.Params, "min_count", 3)
+	unit := fraudParamDecimal(rule.Params, "round_unit", decimal.NewFromInt(100))
+
+	isRound := func(amount decimal.Decimal) bool {
+		return unit.IsPositive() && amount.Mod(unit).IsZero()
+	}
+	if !isRound(req.Amount) {
+		return allowFraudRule(rule)
+	}
+
+	count := 1
+	for _, debit := range history.DebitsSince(history.Now.Add(-time.Duration(window) * time.Minute)) {
+		if isRound(debit.Amount) {
+			count++
+		}
+	}
+	if count >= minCount {
+		return triggerFraudRule(rule, "%d debits in multiples of %s within %d minutes", count, unit.StringFixed(2), window)
+	}
+	return allowFraudRule(rule)
+}
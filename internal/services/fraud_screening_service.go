@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// fraudLoginHistoryLimit is how many recent logins the new IP rule compares against.
+const fraudLoginHistoryLimit = 20
+
+var (
+	ErrTransactionDeclined    = errors.New("transaction declined by fraud screening")
+	ErrFraudRuleNotFound      = errors.New("fraud rule not found")
+	ErrInvalidFraudRuleConfig = errors.New("invalid fraud rule configuration")
+	ErrFraudDecisionNotFound  = errors.New("fraud decision not found")
+)
+
+type fraudScreeningService struct {
+	fraudRepo repositories.FraudRepositoryInterface
+	auditRepo repositories.AuditLogRepositoryInterface
+	rules     []FraudRuleEvaluator
+	config    config.FraudConfig
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+func NewFraudScreeningService(
+	fraudRepo repositories.FraudRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	rules []FraudRuleEvaluator,
+	cfg config.FraudConfig,
+) FraudScreeningServiceInterface {
+	return &fraudScreeningService{
+		fraudRepo: fraudRepo,
+		auditRepo: auditRepo,
+		rules:     rules,
+		config:    cfg,
+		logger:    slog.Default().With("service", "FraudScreeningService"),
+		now:       time.Now,
+	}
+}
+
+// Screen evaluates every enabled rule against the movement and the customer's history and
+// persists the decision. The most severe rule outcome wins, and the combined score of the
+// triggered rules escalates the decision to review or block at the configured thresholds.
+// A block is returned together with ErrTransactionDeclined.
+func (s *fraudScreeningService) Screen(ctx context.Context, req *dto.FraudScreeningRequest) (_ *models.FraudDecision, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "FraudScreeningService.Screen")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	settings, err := s.effectiveRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.loadHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := models.FraudOutcomeAllow
+	score := 0
+	results := make([]dto.FraudRuleResult, 0, len(s.rules))
+	for _, evaluator := range s.rules {
+		rule := settings[evaluator.Name()]
+		if !rule.Enabled {
+			continue
+		}
+		result := evaluator.Evaluate(req, history, rule)
+		if result.Triggered {
+			score += result.Score
+			if models.FraudOutcomeSeverity(result.Outcome) > models.FraudOutcomeSeverity(outcome) {
+				outcome = result.Outcome
+			}
+		}
+		results = append(results, result)
+	}
+
+	if s.config.BlockScore > 0 && score >= s.config.BlockScore {
+		outcome = models.FraudOutcomeBlock
+	} else if s.config.ReviewScore > 0 && score >= s.config.ReviewScore && outcome == models.FraudOutcomeAllow {
+		outcome = models.FraudOutcomeReview
+	}
+
+	decision := &models.FraudDecision{
+		UserID:                req.UserID,
+		Operation:             req.Operation,
+		AccountID:             req.AccountID,
+		CounterpartyAccountID: req.CounterpartyAccountID,
+		ExternalAccountID:     req.ExternalAccountID,
+		Amount:                req.Amount,
+		Outcome:               outcome,
+		Score:                 score,
+		RuleResults:           models.JSONBMap{"rules": results},
+	}
+	if err := s.fraudRepo.CreateDecision(ctx, decision); err != nil {
+		return nil, err
+	}
+
+	if outcome != models.FraudOutcomeAllow {
+		s.logger.WarnContext(ctx, "money movement flagged by fraud screening",
+			"decision_id", decision.ID, "outcome", outcome, "score", score,
+			"operation", req.Operation, "user_id", req.UserID)
+	}
+	if outcome == models.FraudOutcomeBlock {
+		return decision, ErrTransactionDeclined
+	}
+	return decision, nil
+}
+
+// AttachResource links an allowed decision to the transfer or transaction it let through.
+func (s *fraudScreeningService) AttachResource(ctx context.Context, decisionID uuid.UUID, resourceType string, resourceID uuid.UUID) error {
+	return s.fraudRepo.AttachDecisionResource(ctx, decisionID, resourceType, resourceID)
+}
+
+// ListRules returns the effective settings of every registered rule.
+func (s *fraudScreeningService) ListRules(ctx context.Context) ([]dto.FraudRuleResponse, error) {
+	stored, err := s.storedRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]dto.FraudRuleResponse, 0, len(s.rules))
+	for _, evaluator := range s.rules {
+		row, customized := stored[evaluator.Name()]
+		rules = append(rules, toFraudRuleResponse(evaluator, mergeFraudRule(evaluator.Defaults(), row, customized), row, customized))
+	}
+	return rules, nil
+}
+
+// UpdateRule changes a rule's settings. Parameters are merged into the current ones and must be
+// parameters the rule knows, of the same type as their defaults. The change applies to the next
+// screened movement.
+func (s *fraudScreeningService) UpdateRule(ctx context.Context, adminID uuid.UUID, name string, req *dto.UpdateFraudRuleRequest) (*dto.FraudRuleResponse, error) {
+	evaluator := s.evaluator(name)
+	if evaluator == nil {
+		return nil, ErrFraudRuleNotFound
+	}
+
+	stored, err := s.storedRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, customized := stored[name]
+	defaults := evaluator.Defaults()
+	rule := mergeFraudRule(defaults, row, customized)
+
+	for key, value := range req.Params {
+		if err := validateFraudRuleParam(defaults.Params, key, value); err != nil {
+			return nil, err
+		}
+		rule.Params[key] = value
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Action != nil {
+		rule.Action = *req.Action
+	}
+	if req.Score != nil {
+		rule.Score = *req.Score
+	}
+	rule.UpdatedBy = &adminID
+
+	if err := s.fraudRepo.SaveRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     "fraud_rule.updated",
+		Resource:   "fraud_rule",
+		ResourceID: name,
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata: models.JSONBMap{
+			"enabled": rule.Enabled,
+			"action":  rule.Action,
+			"score":   rule.Score,
+			"params":  rule.Params,
+		},
+	}); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create audit log", "error", err, "action", "fraud_rule.updated")
+	}
+
+	response := toFraudRuleResponse(evaluator, rule, rule, true)
+	return &response, nil
+}
+
+// ListDecisions lists screening decisions matching the filters, newest first.
+func (s *fraudScreeningService) ListDecisions(ctx context.Context, filters models.FraudDecisionFilters, offset, limit int) ([]models.FraudDecision, int64, error) {
+	return s.fraudRepo.ListDecisions(ctx, filters, offset, limit)
+}
+
+// GetDecision returns a single screening decision.
+func (s *fraudScreeningService) GetDecision(ctx context.Context, decisionID uuid.UUID) (*models.FraudDecision, error) {
+	decision, err := s.fraudRepo.GetDecisionByID(ctx, decisionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrFraudDecisionNotFound) {
+			return nil, ErrFraudDecisionNotFound
+		}
+		return nil, err
+	}
+	return decision, nil
+}
+
+func (s *fraudScreeningService) evaluator(name string) FraudRuleEvaluator {
+	for _, evaluator := range s.rules {
+		if evaluator.Name() == name {
+			return evaluator
+		}
+	}
+	return nil
+}
+
+func (s *fraudScreeningService) storedRules(ctx context.Context) (map[string]models.FraudRule, error) {
+	rows, err := s.fraudRepo.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]models.FraudRule, len(rows))
+	for _, row := range rows {
+		stored[row.Name] = row
+	}
+	return stored, nil
+}
+
+// effectiveRules returns the settings each registered rule runs with, read on every screening
+// so admin changes apply without a restart.
+func (s *fraudScreeningService) effectiveRules(ctx context.Context) (map[string]models.FraudRule, error) {
+	stored, err := s.storedRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings := make(map[string]models.FraudRule, len(s.rules))
+	for _, evaluator := range s.rules {
+		row, customized := stored[evaluator.Name()]
+		settings[evaluator.Name()] = mergeFraudRule(evaluator.Defaults(), row, customized)
+	}
+	return settings, nil
+}
+
+func (s *fraudScreeningService) loadHistory(ctx context.Context, req *dto.FraudScreeningRequest) (*FraudHistory, error) {
+	now := s.now()
+	historyDays := s.config.HistoryDays
+	if historyDays <= 0 {
+		historyDays = 90
+	}
+
+	debits, err := s.fraudRepo.ListRecentDebits(ctx, req.UserID, now.AddDate(0, 0, -historyDays))
+	if err != nil {
+		return nil, err
+	}
+
+	logins, err := s.fraudRepo.ListRecentLogins(ctx, req.UserID, fraudLoginHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &FraudHistory{Now: now, Debits: debits, Logins: logins}
+	if req.ExternalAccountID != nil {
+		history.PriorPayeeTransfers, err = s.fraudRepo.CountTransfersToExternalAccount(ctx, *req.ExternalAccountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+// mergeFraudRule overlays an admin-edited row on the rule defaults. Parameters missing from the
+// row, for example ones added in a later release, keep their default.
+func mergeFraudRule(defaults, row models.FraudRule, customized bool) models.FraudRule {
+	params := models.JSONBMap{}
+	for key, value := range defaults.Params {
+		params[key] = value
+	}
+	if !customized {
+		defaults.Params = params
+		return defaults
+	}
+
+	for key, value := range row.Params {
+		if _, known := defaults.Params[key]; known {
+			params[key] = value
+		}
+	}
+	row.Params = params
+	return row
+}
+
+// validateFraudRuleParam checks a new parameter value against the type of its default: counts
+// and minutes are non-negative whole numbers, and money amounts are non-negative decimal strings.
+func validateFraudRuleParam(defaults models.JSONBMap, key string, value interface{}) error {
+	current, known := defaults[key]
+	if !known {
+		return fmt.Errorf("%w: unknown parameter %q", ErrInvalidFraudRuleConfig, key)
+	}
+
+	switch current.(type) {
+	case string:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%w: %s must be a decimal string", ErrInvalidFraudRuleConfig, key)
+		}
+		amount, err := decimal.NewFromString(s)
+		if err != nil || amount.IsNegative() {
+			return fmt.Errorf("%w: %s must be a non-negative decimal string", ErrInvalidFraudRuleConfig, key)
+		}
+	default:
+		n, ok := value.(float64)
+		if !ok || n < 0 || n != float64(int(n)) {
+			return fmt.Errorf("%w: %s must be a non-negative whole number", ErrInvalidFraudRuleConfig, key)
+		}
+	}
+	return nil
+}
+
+func toFraudRuleResponse(evaluator FraudRuleEvaluator, rule, row models.FraudRule, customized bool) dto.FraudRuleResponse {
+	response := dto.FraudRuleResponse{
+		Name:        evaluator.Name(),
+		Description: evaluator.Description(),
+		Enabled:     rule.Enabled,
+		Action:      rule.Action,
+		Score:       rule.Score,
+		Params:      rule.Params,
+		Customized:  customized,
+	}
+	if customized {
+		response.UpdatedBy = row.UpdatedBy
+		if !row.UpdatedAt.IsZero() {
+			updatedAt := row.UpdatedAt
+			response.UpdatedAt = &updatedAt
+		}
+	}
+	return response
+}
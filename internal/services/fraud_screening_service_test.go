@@ -0,0 +1,394 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type FraudScreeningServiceTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	fraudRepo *repository_mocks.MockFraudRepositoryInterface
+	auditRepo *repository_mocks.MockAuditLogRepositoryInterface
+	service   *fraudScreeningService
+	now       time.Time
+	userID    uuid.UUID
+	accountID uuid.UUID
+	payeeID   uuid.UUID
+}
+
+func (s *FraudScreeningServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.fraudRepo = repository_mocks.NewMockFraudRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.service = NewFraudScreeningService(s.fraudRepo, s.auditRepo, DefaultFraudRules(), config.FraudConfig{
+		ReviewScore: 50,
+		BlockScore:  100,
+		HistoryDays: 90,
+	}).(*fraudScreeningService)
+
+	s.now = time.Date(2026, 3, 6, 15, 0, 0, 0, time.UTC)
+	s.service.now = func() time.Time { return s.now }
+	s.userID = uuid.New()
+	s.accountID = uuid.New()
+	s.payeeID = uuid.New()
+}
+
+func (s *FraudScreeningServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestFraudScreeningServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FraudScreeningServiceTestSuite))
+}
+
+func (s *FraudScreeningServiceTestSuite) debit(amount int64, ago time.Duration) models.Transaction {
+	return models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       s.accountID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromInt(amount),
+		CreatedAt:       s.now.Add(-ago),
+	}
+}
+
+func (s *FraudScreeningServiceTestSuite) login(ip string, ago time.Duration) models.AuditLog {
+	return models.AuditLog{UserID: &s.userID, Action: models.AuditActionLogin, IPAddress: ip, CreatedAt: s.now.Add(-ago)}
+}
+
+func (s *FraudScreeningServiceTestSuite) transactionRequest(amount int64) *dto.FraudScreeningRequest {
+	return &dto.FraudScreeningRequest{
+		UserID:    s.userID,
+		Operation: models.FraudOperationTransaction,
+		AccountID: s.accountID,
+		Amount:    decimal.NewFromInt(amount),
+	}
+}
+
+func (s *FraudScreeningServiceTestSuite) externalRequest(amount int64) *dto.FraudScreeningRequest {
+	return &dto.FraudScreeningRequest{
+		UserID:            s.userID,
+		Operation:         models.FraudOperationExternalTransfer,
+		AccountID:         s.accountID,
+		ExternalAccountID: &s.payeeID,
+		Amount:            decimal.NewFromInt(amount),
+	}
+}
+
+// expectHistory stubs the rule settings and customer history and captures the saved decision.
+func (s *FraudScreeningServiceTestSuite) expectHistory(rules []models.FraudRule, debits []models.Transaction, logins []models.AuditLog) *models.FraudDecision {
+	s.fraudRepo.EXPECT().ListRules(gomock.Any()).Return(rules, nil)
+	s.fraudRepo.EXPECT().ListRecentDebits(gomock.Any(), s.userID, s.now.AddDate(0, 0, -90)).Return(debits, nil)
+	s.fraudRepo.EXPECT().ListRecentLogins(gomock.Any(), s.userID, fraudLoginHistoryLimit).Return(logins, nil)
+
+	saved := &models.FraudDecision{}
+	s.fraudRepo.EXPECT().CreateDecision(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, decision *models.FraudDecision) error {
+		*saved = *decision
+		return nil
+	})
+	return saved
+}
+
+func triggeredRules(decision *models.FraudDecision) []string {
+	var names []string
+	for _, result := range decision.RuleResults["rules"].([]dto.FraudRuleResult) {
+		if result.Triggered {
+			names = append(names, result.Rule)
+		}
+	}
+	return names
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreen_AllowsOrdinaryDebit() {
+	saved := s.expectHistory(nil, []models.Transaction{s.debit(120, 48*time.Hour)}, []models.AuditLog{
+		s.login("10.0.0.1", 10*time.Minute), s.login("10.0.0.1", 72*time.Hour),
+	})
+
+	decision, err := s.service.Screen(context.Background(), s.transactionRequest(85))
+
+	s.Require().NoError(err)
+	s.Equal(models.FraudOutcomeAllow, decision.Outcome)
+	s.Equal(0, decision.Score)
+	s.Empty(triggeredRules(saved))
+	s.Len(saved.RuleResults["rules"], len(DefaultFraudRules()))
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreen_Rules() {
+	testCases := []struct {
+		name      string
+		request   func() *dto.FraudScreeningRequest
+		debits    func() []models.Transaction
+		logins    func() []models.AuditLog
+		triggered []string
+		outcome   string
+		score     int
+	}{
+		{
+			name:    "velocity",
+			request: func() *dto.FraudScreeningRequest { return s.transactionRequest(42) },
+			debits: func() []models.Transaction {
+				return []models.Transaction{
+					s.debit(10, time.Minute), s.debit(11, 5*time.Minute), s.debit(12, 10*time.Minute),
+					s.debit(13, 20*time.Minute), s.debit(14, 50*time.Minute), s.debit(15, 2*time.Hour),
+				}
+			},
+			triggered: []string{FraudRuleVelocity},
+			outcome:   models.FraudOutcomeReview,
+			score:     40,
+		},
+		{
+			name:      "new payee",
+			request:   func() *dto.FraudScreeningRequest { return s.externalRequest(750) },
+			triggered: []string{FraudRuleNewPayee},
+			outcome:   models.FraudOutcomeReview,
+			score:     30,
+		},
+		{
+			name:    "amount spike",
+			request: func() *dto.FraudScreeningRequest { return s.transactionRequest(2500) },
+			debits: func() []models.Transaction {
+				return []models.Transaction{s.debit(90, 24*time.Hour), s.debit(110, 48*time.Hour), s.debit(100, 72*time.Hour)}
+			},
+			triggered: []string{FraudRuleAmountSpike},
+			outcome:   models.FraudOutcomeReview,
+			score:     40,
+		},
+		{
+			name:    "login from new IP",
+			request: func() *dto.FraudScreeningRequest { return s.transactionRequest(42) },
+			logins: func() []models.AuditLog {
+				return []models.AuditLog{s.login("203.0.113.7", 5*time.Minute), s.login("10.0.0.1", 24*time.Hour)}
+			},
+			triggered: []string{FraudRuleNewIPLogin},
+			outcome:   models.FraudOutcomeReview,
+			score:     40,
+		},
+		{
+			name:    "new IP login outside the window",
+			request: func() *dto.FraudScreeningRequest { return s.transactionRequest(42) },
+			logins: func() []models.AuditLog {
+				return []models.AuditLog{s.login("203.0.113.7", 2*time.Hour), s.login("10.0.0.1", 24*time.Hour)}
+			},
+			outcome: models.FraudOutcomeAllow,
+		},
+		{
+			name:    "round amount burst",
+			request: func() *dto.FraudScreeningRequest { return s.transactionRequest(300) },
+			debits: func() []models.Transaction {
+				return []models.Transaction{s.debit(200, 10*time.Minute), s.debit(100, 30*time.Minute), s.debit(45, 40*time.Minute)}
+			},
+			triggered: []string{FraudRuleRoundAmountBurst},
+			outcome:   models.FraudOutcomeReview,
+			score:     30,
+		},
+		{
+			name:    "combined score blocks",
+			request: func() *dto.FraudScreeningRequest { return s.externalRequest(500) },
+			debits: func() []models.Transaction {
+				return []models.Transaction{
+					s.debit(100, time.Minute), s.debit(200, 5*time.Minute), s.debit(300, 10*time.Minute),
+					s.debit(400, 20*time.Minute), s.debit(500, 30*time.Minute),
+				}
+			},
+			logins: func() []models.AuditLog {
+				return []models.AuditLog{s.login("203.0.113.7", time.Minute), s.login("10.0.0.1", 24*time.Hour)}
+			},
+			triggered: []string{FraudRuleVelocity, FraudRuleNewPayee, FraudRuleNewIPLogin, FraudRuleRoundAmountBurst},
+			outcome:   models.FraudOutcomeBlock,
+			score:     140,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var debits []models.Transaction
+			if tc.debits != nil {
+				debits = tc.debits()
+			}
+			var logins []models.AuditLog
+			if tc.logins != nil {
+				logins = tc.logins()
+			}
+			req := tc.request()
+			saved := s.expectHistory(nil, debits, logins)
+			if req.ExternalAccountID != nil {
+				s.fraudRepo.EXPECT().CountTransfersToExternalAccount(gomock.Any(), s.payeeID).Return(int64(0), nil)
+			}
+
+			decision, err := s.service.Screen(context.Background(), req)
+
+			if tc.outcome == models.FraudOutcomeBlock {
+				s.ErrorIs(err, ErrTransactionDeclined)
+			} else {
+				s.NoError(err)
+			}
+			s.Require().NotNil(decision)
+			s.Equal(tc.outcome, saved.Outcome)
+			s.Equal(tc.score, saved.Score)
+			s.Equal(tc.triggered, triggeredRules(saved))
+		})
+	}
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreen_ScoreEscalatesToReview() {
+	rules := []models.FraudRule{
+		{Name: FraudRuleVelocity, Enabled: true, Action: models.FraudOutcomeReview, Score: 0},
+		{Name: FraudRuleNewIPLogin, Enabled: true, Action: models.FraudOutcomeAllow, Score: 25},
+		{Name: FraudRuleNewPayee, Enabled: true, Action: models.FraudOutcomeAllow, Score: 25},
+	}
+	saved := s.expectHistory(rules, nil, []models.AuditLog{s.login("203.0.113.7", time.Minute), s.login("10.0.0.1", 24*time.Hour)})
+	s.fraudRepo.EXPECT().CountTransfersToExternalAccount(gomock.Any(), s.payeeID).Return(int64(0), nil)
+
+	decision, err := s.service.Screen(context.Background(), s.externalRequest(600))
+
+	s.NoError(err)
+	s.Equal(models.FraudOutcomeReview, decision.Outcome)
+	s.Equal(50, saved.Score)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreen_UsesStoredRuleSettings() {
+	rules := []models.FraudRule{
+		{Name: FraudRuleNewPayee, Enabled: true, Action: models.FraudOutcomeBlock, Score: 10, Params: models.JSONBMap{"min_amount": "50.00"}},
+		{Name: FraudRuleVelocity, Enabled: false, Action: models.FraudOutcomeReview, Score: 40},
+	}
+	debits := []models.Transaction{
+		s.debit(10, time.Minute), s.debit(11, time.Minute), s.debit(12, time.Minute),
+		s.debit(13, time.Minute), s.debit(14, time.Minute), s.debit(15, time.Minute),
+	}
+	saved := s.expectHistory(rules, debits, nil)
+	s.fraudRepo.EXPECT().CountTransfersToExternalAccount(gomock.Any(), s.payeeID).Return(int64(0), nil)
+
+	decision, err := s.service.Screen(context.Background(), s.externalRequest(60))
+
+	s.ErrorIs(err, ErrTransactionDeclined)
+	s.Equal(models.FraudOutcomeBlock, decision.Outcome)
+	s.Equal([]string{FraudRuleNewPayee}, triggeredRules(saved))
+	for _, result := range saved.RuleResults["rules"].([]dto.FraudRuleResult) {
+		s.NotEqual(FraudRuleVelocity, result.Rule, "disabled rules are not evaluated")
+	}
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreen_KnownPayeeIsNotNew() {
+	saved := s.expectHistory(nil, nil, nil)
+	s.fraudRepo.EXPECT().CountTransfersToExternalAccount(gomock.Any(), s.payeeID).Return(int64(2), nil)
+
+	decision, err := s.service.Screen(context.Background(), s.externalRequest(750))
+
+	s.NoError(err)
+	s.Equal(models.FraudOutcomeAllow, decision.Outcome)
+	s.Empty(triggeredRules(saved))
+}
+
+func (s *FraudScreeningServiceTestSuite) TestScreen_HistoryError() {
+	s.fraudRepo.EXPECT().ListRules(gomock.Any()).Return(nil, nil)
+	s.fraudRepo.EXPECT().ListRecentDebits(gomock.Any(), s.userID, gomock.Any()).Return(nil, errors.New("db down"))
+
+	decision, err := s.service.Screen(context.Background(), s.transactionRequest(10))
+
+	s.Error(err)
+	s.Nil(decision)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestListRules() {
+	adminID := uuid.New()
+	s.fraudRepo.EXPECT().ListRules(gomock.Any()).Return([]models.FraudRule{
+		{Name: FraudRuleVelocity, Enabled: false, Action: models.FraudOutcomeBlock, Score: 70,
+			Params: models.JSONBMap{"max_count": float64(3), "retired": float64(1)}, UpdatedBy: &adminID, UpdatedAt: s.now},
+	}, nil)
+
+	rules, err := s.service.ListRules(context.Background())
+
+	s.Require().NoError(err)
+	s.Require().Len(rules, len(DefaultFraudRules()))
+	s.Equal(FraudRuleVelocity, rules[0].Name)
+	s.True(rules[0].Customized)
+	s.False(rules[0].Enabled)
+	s.Equal(models.FraudOutcomeBlock, rules[0].Action)
+	s.Equal(float64(3), rules[0].Params["max_count"])
+	s.Equal(60, rules[0].Params["window_minutes"])
+	s.NotContains(rules[0].Params, "retired")
+	s.Equal(&adminID, rules[0].UpdatedBy)
+
+	s.Equal(FraudRuleNewPayee, rules[1].Name)
+	s.False(rules[1].Customized)
+	s.True(rules[1].Enabled)
+	s.Equal("500.00", rules[1].Params["min_amount"])
+}
+
+func (s *FraudScreeningServiceTestSuite) TestUpdateRule() {
+	adminID := uuid.New()
+	action := models.FraudOutcomeBlock
+	s.fraudRepo.EXPECT().ListRules(gomock.Any()).Return(nil, nil)
+	s.fraudRepo.EXPECT().SaveRule(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rule *models.FraudRule) error {
+		s.Equal(FraudRuleAmountSpike, rule.Name)
+		s.Equal(models.FraudOutcomeBlock, rule.Action)
+		s.Equal(40, rule.Score)
+		s.Equal(float64(10), rule.Params["multiplier"])
+		s.Equal("1000.00", rule.Params["min_amount"])
+		s.Equal(adminID, *rule.UpdatedBy)
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("fraud_rule.updated", log.Action)
+		s.Equal(FraudRuleAmountSpike, log.ResourceID)
+		return nil
+	})
+
+	rule, err := s.service.UpdateRule(context.Background(), adminID, FraudRuleAmountSpike, &dto.UpdateFraudRuleRequest{
+		Action: &action,
+		Params: map[string]interface{}{"multiplier": float64(10)},
+	})
+
+	s.Require().NoError(err)
+	s.True(rule.Customized)
+	s.Equal(models.FraudOutcomeBlock, rule.Action)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestUpdateRule_Invalid() {
+	_, err := s.service.UpdateRule(context.Background(), uuid.New(), "unknown", &dto.UpdateFraudRuleRequest{})
+	s.ErrorIs(err, ErrFraudRuleNotFound)
+
+	testCases := []struct {
+		name   string
+		params map[string]interface{}
+	}{
+		{"unknown parameter", map[string]interface{}{"threshold": float64(1)}},
+		{"count as string", map[string]interface{}{"max_count": "5"}},
+		{"fractional count", map[string]interface{}{"max_count": 2.5}},
+		{"negative count", map[string]interface{}{"window_minutes": float64(-1)}},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.fraudRepo.EXPECT().ListRules(gomock.Any()).Return(nil, nil)
+			_, err := s.service.UpdateRule(context.Background(), uuid.New(), FraudRuleVelocity, &dto.UpdateFraudRuleRequest{Params: tc.params})
+			s.ErrorIs(err, ErrInvalidFraudRuleConfig)
+		})
+	}
+
+	s.fraudRepo.EXPECT().ListRules(gomock.Any()).Return(nil, nil)
+	_, err = s.service.UpdateRule(context.Background(), uuid.New(), FraudRuleNewPayee, &dto.UpdateFraudRuleRequest{
+		Params: map[string]interface{}{"min_amount": "lots"},
+	})
+	s.ErrorIs(err, ErrInvalidFraudRuleConfig)
+}
+
+func (s *FraudScreeningServiceTestSuite) TestGetDecision_NotFound() {
+	id := uuid.New()
+	s.fraudRepo.EXPECT().GetDecisionByID(gomock.Any(), id).Return(nil, repositories.ErrFraudDecisionNotFound)
+
+	_, err := s.service.GetDecision(context.Background(), id)
+
+	s.ErrorIs(err, ErrFraudDecisionNotFound)
+}
@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInboundCreditNotFound      = errors.New("inbound credit not found")
+	ErrInboundCreditNotInSuspense = errors.New("inbound credit is not in suspense")
+	ErrInboundCreditNotReturnable = errors.New("inbound credit cannot be returned to the sender")
+	ErrInboundCreditReturnFailed  = errors.New("failed to return inbound credit through external bank")
+	// ErrInboundCreditNotRecorded is returned when a temporary failure left the credit neither
+	// posted nor recorded; the event must not be acknowledged, so that Northwind redelivers it.
+	ErrInboundCreditNotRecorded = errors.New("inbound credit could not be recorded")
+)
+
+// inboundCreditCurrency is the only currency inbound credits are accepted in.
+const inboundCreditCurrency = "USD"
+
+type inboundCreditService struct {
+	inboundCreditRepo repositories.InboundCreditRepositoryInterface
+	accountService    AccountServiceInterface
+	auditRepo         repositories.AuditLogRepositoryInterface
+	northwindClient   NorthwindClientInterface
+	config            config.NorthwindConfig
+	logger            *slog.Logger
+}
+
+func NewInboundCreditService(
+	inboundCreditRepo repositories.InboundCreditRepositoryInterface,
+	accountService AccountServiceInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	northwindClient NorthwindClientInterface,
+	cfg config.NorthwindConfig,
+) InboundCreditServiceInterface {
+	return &inboundCreditService{
+		inboundCreditRepo: inboundCreditRepo,
+		accountService:    accountService,
+		auditRepo:         auditRepo,
+		northwindClient:   northwindClient,
+		config:            cfg,
+		logger:            slog.Default().With("service", "InboundCreditService"),
+	}
+}
+
+// ProcessNorthwindCredit records the event and posts the credit in a single database transaction,
+// so the unique event ID guards against double posting when Northwind redelivers and a failure
+// leaves nothing behind for the redelivery to trip over. Credits that cannot be matched to an
+// active account, or that carry an invalid amount or currency, are parked in suspense for an admin
+// to resolve or return; the event is still acknowledged so the partner stops retrying. A temporary
+// failure to look up the account or post the credit returns ErrInboundCreditNotRecorded instead,
+// leaving nothing recorded so that the redelivered event is posted normally.
+func (s *inboundCreditService) ProcessNorthwindCredit(ctx context.Context, eventID string, data *dto.NorthwindCreditReceivedData) (_ *models.InboundCredit, _ bool, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "InboundCreditService.ProcessNorthwindCredit")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	existing, err := s.inboundCreditRepo.GetByEventID(ctx, eventID)
+	if err == nil {
+		return s.duplicate(ctx, existing)
+	}
+	if !errors.Is(err, repositories.ErrInboundCreditNotFound) {
+		return nil, false, fmt.Errorf("failed to check for duplicate inbound credit: %w", err)
+	}
+
+	amount, amountErr := decimal.NewFromString(data.Amount)
+	if amountErr != nil {
+		amount = decimal.Zero
+	}
+
+	credit := &models.InboundCredit{
+		EventID:            eventID,
+		ExternalTransferID: data.TransferID,
+		AccountNumber:      data.AccountNumber,
+		Amount:             amount,
+		Currency:           strings.ToUpper(data.Currency),
+		SenderName:         data.SenderName,
+		SenderAccountID:    data.SenderAccountID,
+		Reference:          data.Reference,
+		Status:             models.InboundCreditStatusReceived,
+	}
+
+	account, reason, err := s.matchAccount(ctx, credit, amountErr)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %w", ErrInboundCreditNotRecorded, err)
+	}
+	if reason != "" {
+		return s.suspend(ctx, credit, reason)
+	}
+
+	transaction := newInboundCreditTransaction(credit, account.ID)
+	if err := s.inboundCreditRepo.CreatePosted(ctx, credit, transaction); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrInboundCreditDuplicate):
+			return s.loadDuplicate(ctx, eventID)
+		case errors.Is(err, repositories.ErrAccountNotActive):
+			// The account was closed or frozen since it was matched
+			return s.suspend(ctx, credit, fmt.Sprintf("posting failed: %v", err))
+		default:
+			return nil, false, fmt.Errorf("%w: %w", ErrInboundCreditNotRecorded, err)
+		}
+	}
+
+	s.audit(ctx, &account.UserID, credit, "inbound_credit.posted", models.JSONBMap{
+		"account_number": credit.AccountNumber,
+		"amount":         credit.Amount.String(),
+		"transaction_id": transaction.ID.String(),
+	})
+
+	return credit, false, nil
+}
+
+func (s *inboundCreditService) List(ctx context.Context, status string, offset, limit int) ([]models.InboundCredit, int64, error) {
+	return s.inboundCreditRepo.ListByStatus(ctx, status, offset, limit)
+}
+
+// Resolve posts a suspense credit to an account chosen by the admin, for example after
+// confirming the intended recipient with the sender. The credit is posted only if it is still in
+// suspense when the posting commits, so it cannot be resolved twice or resolved and returned.
+func (s *inboundCreditService) Resolve(ctx context.Context, adminID, creditID uuid.UUID, accountNumber, note string) (*models.InboundCredit, error) {
+	credit, err := s.getSuspenseCredit(ctx, creditID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.accountService.GetAccountByNumber(ctx, accountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := newInboundCreditTransaction(credit, account.ID)
+	credit.Resolve(adminID, note)
+	if err := s.inboundCreditRepo.ResolvePosted(ctx, credit, transaction); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrInboundCreditStatusChanged):
+			return nil, ErrInboundCreditNotInSuspense
+		case errors.Is(err, repositories.ErrAccountNotActive):
+			return nil, ErrAccountNotActive
+		case errors.Is(err, repositories.ErrAccountNotFound):
+			return nil, ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to post inbound credit: %w", err)
+	}
+
+	s.audit(ctx, &adminID, credit, "inbound_credit.resolved", models.JSONBMap{
+		"account_number": account.AccountNumber,
+		"amount":         credit.Amount.String(),
+		"transaction_id": transaction.ID.String(),
+		"note":           note,
+	})
+
+	return credit, nil
+}
+
+// Return sends a suspense credit back to the originator from the Northwind settlement account.
+// The credit is claimed as returning before Northwind is called, so it cannot also be resolved.
+// If Northwind rejects the return the credit goes back to suspense; if the outcome is unknown it
+// stays returning and the return may be retried, which the idempotency key makes safe.
+func (s *inboundCreditService) Return(ctx context.Context, adminID, creditID uuid.UUID, note string) (*models.InboundCredit, error) {
+	credit, err := s.getCredit(ctx, creditID)
+	if err != nil {
+		return nil, err
+	}
+	if !credit.IsInSuspense() && credit.Status != models.InboundCreditStatusReturning {
+		return nil, ErrInboundCreditNotInSuspense
+	}
+
+	switch {
+	case s.config.SettlementAccount == "":
+		return nil, fmt.Errorf("%w: settlement account is not configured", ErrInboundCreditNotReturnable)
+	case credit.SenderAccountID == "":
+		return nil, fmt.Errorf("%w: sender account is unknown", ErrInboundCreditNotReturnable)
+	case !credit.Amount.IsPositive():
+		return nil, fmt.Errorf("%w: amount is not positive", ErrInboundCreditNotReturnable)
+	}
+
+	if credit.IsInSuspense() {
+		credit.Status = models.InboundCreditStatusReturning
+		if err := s.inboundCreditRepo.UpdateFromStatus(ctx, credit, models.InboundCreditStatusSuspense); err != nil {
+			if errors.Is(err, repositories.ErrInboundCreditStatusChanged) {
+				return nil, ErrInboundCreditNotInSuspense
+			}
+			return nil, fmt.Errorf("failed to claim inbound credit: %w", err)
+		}
+	}
+
+	resp, err := s.northwindClient.InitiateTransfer(ctx, &dto.NorthwindInitiateTransferRequest{
+		SourceAccountID:      s.config.SettlementAccount,
+		DestinationAccountID: credit.SenderAccountID,
+		Amount:               credit.Amount.StringFixed(2),
+		Direction:            "debit",
+		TransferType:         "standard",
+		IdempotencyKey:       "inbound-credit-return-" + credit.ID.String(),
+	})
+	if err != nil {
+		if !errors.Is(err, ErrNorthwindUnavailable) {
+			// Rejected outright, so nothing was sent and the credit can be resolved instead
+			credit.Status = models.InboundCreditStatusSuspense
+			if releaseErr := s.inboundCreditRepo.UpdateFromStatus(ctx, credit, models.InboundCreditStatusReturning); releaseErr != nil {
+				s.logger.ErrorContext(ctx, "failed to release inbound credit after rejected return", "inbound_credit_id", credit.ID, "error", releaseErr)
+			}
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInboundCreditReturnFailed, err)
+	}
+
+	credit.Status = models.InboundCreditStatusReturned
+	credit.ReturnTransferID = &resp.ID
+	credit.Resolve(adminID, note)
+	if err := s.inboundCreditRepo.UpdateFromStatus(ctx, credit, models.InboundCreditStatusReturning); err != nil {
+		if errors.Is(err, repositories.ErrInboundCreditStatusChanged) {
+			// A concurrent retry of the same return recorded it first
+			return s.getCredit(ctx, creditID)
+		}
+		s.logger.ErrorContext(ctx, "failed to mark inbound credit returned", "inbound_credit_id", credit.ID, "return_transfer_id", resp.ID, "error", err)
+		return nil, fmt.Errorf("failed to update inbound credit: %w", err)
+	}
+
+	s.audit(ctx, &adminID, credit, "inbound_credit.returned", models.JSONBMap{
+		"amount":             credit.Amount.String(),
+		"return_transfer_id": resp.ID,
+		"note":               note,
+	})
+
+	return credit, nil
+}
+
+// matchAccount returns the account to credit, or the reason the credit must go to suspense. An
+// error is returned when the account could not be looked up.
+func (s *inboundCreditService) matchAccount(ctx context.Context, credit *models.InboundCredit, amountErr error) (*models.Account, string, error) {
+	if amountErr != nil || !credit.Amount.IsPositive() {
+		return nil, "invalid amount", nil
+	}
+	if credit.Currency != inboundCreditCurrency {
+		return nil, fmt.Sprintf("unsupported currency %q", credit.Currency), nil
+	}
+
+	account, err := s.accountService.GetAccountByNumber(ctx, credit.AccountNumber)
+	if err != nil {
+		if errors.Is(err, ErrAccountNotFound) {
+			return nil, "no account matches account number", nil
+		}
+		return nil, "", fmt.Errorf("account lookup failed: %w", err)
+	}
+	if !account.IsActive() {
+		return nil, "account is not active", nil
+	}
+
+	return account, "", nil
+}
+
+// suspend records the credit in suspense. A credit recorded concurrently under the same event ID
+// is returned as a duplicate.
+func (s *inboundCreditService) suspend(ctx context.Context, credit *models.InboundCredit, reason string) (*models.InboundCredit, bool, error) {
+	credit.MarkSuspense(reason)
+	if err := s.inboundCreditRepo.Create(ctx, credit); err != nil {
+		if errors.Is(err, repositories.ErrInboundCreditDuplicate) {
+			return s.loadDuplicate(ctx, credit.EventID)
+		}
+		return nil, false, fmt.Errorf("failed to move inbound credit to suspense: %w", err)
+	}
+
+	s.logger.Warn("inbound credit moved to suspense", "inbound_credit_id", credit.ID, "event_id", credit.EventID, "reason", reason)
+	s.audit(ctx, nil, credit, "inbound_credit.suspense", models.JSONBMap{
+		"account_number": credit.AccountNumber,
+		"amount":         credit.Amount.String(),
+		"reason":         reason,
+	})
+
+	return credit, false, nil
+}
+
+func (s *inboundCreditService) loadDuplicate(ctx context.Context, eventID string) (*models.InboundCredit, bool, error) {
+	existing, err := s.inboundCreditRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load duplicate inbound credit: %w", err)
+	}
+	return s.duplicate(ctx, existing)
+}
+
+func (s *inboundCreditService) duplicate(ctx context.Context, existing *models.InboundCredit) (*models.InboundCredit, bool, error) {
+	s.logger.InfoContext(ctx, "duplicate inbound credit event ignored", "event_id", existing.EventID, "status", existing.Status)
+	return existing, true, nil
+}
+
+func (s *inboundCreditService) getCredit(ctx context.Context, creditID uuid.UUID) (*models.InboundCredit, error) {
+	credit, err := s.inboundCreditRepo.GetByID(ctx, creditID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrInboundCreditNotFound) {
+			return nil, ErrInboundCreditNotFound
+		}
+		return nil, err
+	}
+	return credit, nil
+}
+
+func (s *inboundCreditService) getSuspenseCredit(ctx context.Context, creditID uuid.UUID) (*models.InboundCredit, error) {
+	credit, err := s.getCredit(ctx, creditID)
+	if err != nil {
+		return nil, err
+	}
+	if !credit.IsInSuspense() {
+		return nil, ErrInboundCreditNotInSuspense
+	}
+	return credit, nil
+}
+
+func (s *inboundCreditService) audit(ctx context.Context, userID *uuid.UUID, credit *models.InboundCredit, action string, metadata models.JSONBMap) {
+	if s.auditRepo == nil {
+		return
+	}
+	if metadata == nil {
+		metadata = models.JSONBMap{}
+	}
+	metadata["event_id"] = credit.EventID
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     userID,
+		Action:     action,
+		Resource:   "inbound_credit",
+		ResourceID: credit.ID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
+
+func newInboundCreditTransaction(credit *models.InboundCredit, accountID uuid.UUID) *models.Transaction {
+	return &models.Transaction{
+		AccountID:       accountID,
+		TransactionType: models.TransactionTypeCredit,
+		Amount:          credit.Amount,
+		Description:     inboundCreditDescription(credit),
+		Reference:       models.GenerateTransactionReference(),
+	}
+}
+
+func inboundCreditDescription(credit *models.InboundCredit) string {
+	if credit.SenderName == "" {
+		return "Inbound transfer via Northwind"
+	}
+	return fmt.Sprintf("Inbound transfer from %s", credit.SenderName)
+}
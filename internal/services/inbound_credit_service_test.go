@@ -0,0 +1,414 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type InboundCreditServiceTestSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	inboundCreditRepo *repository_mocks.MockInboundCreditRepositoryInterface
+	accountService    *service_mocks.MockAccountServiceInterface
+	auditRepo         *repository_mocks.MockAuditLogRepositoryInterface
+	northwindClient   *service_mocks.MockNorthwindClientInterface
+	service           InboundCreditServiceInterface
+	account           *models.Account
+	data              *dto.NorthwindCreditReceivedData
+}
+
+func (s *InboundCreditServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.inboundCreditRepo = repository_mocks.NewMockInboundCreditRepositoryInterface(s.ctrl)
+	s.accountService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.northwindClient = service_mocks.NewMockNorthwindClientInterface(s.ctrl)
+	s.service = NewInboundCreditService(s.inboundCreditRepo, s.accountService, s.auditRepo, s.northwindClient, config.NorthwindConfig{
+		SettlementAccount: "1000000009",
+	})
+
+	s.account = &models.Account{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		AccountNumber: "1012345678",
+		Status:        models.AccountStatusActive,
+	}
+	s.data = &dto.NorthwindCreditReceivedData{
+		TransferID:      "nw_tr_1",
+		AccountNumber:   s.account.AccountNumber,
+		Amount:          "125.50",
+		Currency:        "usd",
+		SenderName:      "Jane Sender",
+		SenderAccountID: "nw_acct_42",
+	}
+}
+
+func (s *InboundCreditServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestInboundCreditServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(InboundCreditServiceTestSuite))
+}
+
+func (s *InboundCreditServiceTestSuite) expectNewEvent(eventID interface{}) {
+	s.inboundCreditRepo.EXPECT().GetByEventID(gomock.Any(), eventID).Return(nil, repositories.ErrInboundCreditNotFound)
+}
+
+func (s *InboundCreditServiceTestSuite) TestProcessNorthwindCredit_Posted() {
+	transactionID := uuid.New()
+
+	s.expectNewEvent("evt_1")
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), s.account.AccountNumber).Return(s.account, nil)
+	s.inboundCreditRepo.EXPECT().CreatePosted(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, credit *models.InboundCredit, transaction *models.Transaction) error {
+			s.Equal("evt_1", credit.EventID)
+			s.Equal("USD", credit.Currency)
+			s.True(credit.Amount.Equal(decimal.RequireFromString("125.50")))
+			s.Equal(s.account.ID, transaction.AccountID)
+			s.Equal(models.TransactionTypeCredit, transaction.TransactionType)
+			s.True(transaction.Amount.Equal(credit.Amount))
+			s.Equal("Inbound transfer from Jane Sender", transaction.Description)
+			credit.ID = uuid.New()
+			transaction.ID = transactionID
+			credit.MarkPosted(transaction.AccountID, transaction.ID)
+			return nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("inbound_credit.posted", log.Action)
+		s.Equal(&s.account.UserID, log.UserID)
+		return nil
+	})
+
+	credit, duplicate, err := s.service.ProcessNorthwindCredit(context.Background(), "evt_1", s.data)
+	s.NoError(err)
+	s.False(duplicate)
+	s.Equal(models.InboundCreditStatusPosted, credit.Status)
+	s.Equal(&s.account.ID, credit.AccountID)
+	s.Equal(&transactionID, credit.TransactionID)
+}
+
+func (s *InboundCreditServiceTestSuite) TestProcessNorthwindCredit_Duplicate() {
+	existing := &models.InboundCredit{ID: uuid.New(), EventID: "evt_1", Status: models.InboundCreditStatusPosted}
+
+	s.inboundCreditRepo.EXPECT().GetByEventID(gomock.Any(), "evt_1").Return(existing, nil)
+	s.inboundCreditRepo.EXPECT().CreatePosted(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	credit, duplicate, err := s.service.ProcessNorthwindCredit(context.Background(), "evt_1", s.data)
+	s.NoError(err)
+	s.True(duplicate)
+	s.Equal(existing, credit)
+}
+
+func (s *InboundCreditServiceTestSuite) TestProcessNorthwindCredit_ConcurrentDuplicatePostsNothing() {
+	existing := &models.InboundCredit{ID: uuid.New(), EventID: "evt_1", Status: models.InboundCreditStatusPosted}
+
+	s.expectNewEvent("evt_1")
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), s.account.AccountNumber).Return(s.account, nil)
+	// A redelivery processed at the same time committed first; the posting is rolled back
+	s.inboundCreditRepo.EXPECT().CreatePosted(gomock.Any(), gomock.Any(), gomock.Any()).Return(repositories.ErrInboundCreditDuplicate)
+	s.inboundCreditRepo.EXPECT().GetByEventID(gomock.Any(), "evt_1").Return(existing, nil)
+
+	credit, duplicate, err := s.service.ProcessNorthwindCredit(context.Background(), "evt_1", s.data)
+	s.NoError(err)
+	s.True(duplicate)
+	s.Equal(existing, credit)
+}
+
+func (s *InboundCreditServiceTestSuite) TestProcessNorthwindCredit_SuspenseReasons() {
+	testCases := []struct {
+		name   string
+		modify func(data *dto.NorthwindCreditReceivedData)
+		lookup func()
+		reason string
+	}{
+		{
+			name:   "invalid amount",
+			modify: func(data *dto.NorthwindCreditReceivedData) { data.Amount = "abc" },
+			reason: "invalid amount",
+		},
+		{
+			name:   "non-positive amount",
+			modify: func(data *dto.NorthwindCreditReceivedData) { data.Amount = "-5.00" },
+			reason: "invalid amount",
+		},
+		{
+			name:   "unsupported currency",
+			modify: func(data *dto.NorthwindCreditReceivedData) { data.Currency = "EUR" },
+			reason: `unsupported currency "EUR"`,
+		},
+		{
+			name:   "unknown account",
+			modify: func(data *dto.NorthwindCreditReceivedData) {},
+			lookup: func() {
+				s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Return(nil, ErrAccountNotFound)
+			},
+			reason: "no account matches account number",
+		},
+		{
+			name:   "inactive account",
+			modify: func(data *dto.NorthwindCreditReceivedData) {},
+			lookup: func() {
+				closed := *s.account
+				closed.Status = models.AccountStatusClosed
+				s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Return(&closed, nil)
+			},
+			reason: "account is not active",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			data := *s.data
+			tc.modify(&data)
+
+			s.expectNewEvent(gomock.Any())
+			if tc.lookup != nil {
+				tc.lookup()
+			}
+			s.inboundCreditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, credit *models.InboundCredit) error {
+				s.Equal(models.InboundCreditStatusSuspense, credit.Status)
+				return nil
+			})
+			s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+				s.Equal("inbound_credit.suspense", log.Action)
+				s.Nil(log.UserID)
+				return nil
+			})
+
+			credit, duplicate, err := s.service.ProcessNorthwindCredit(context.Background(), uuid.NewString(), &data)
+			s.NoError(err)
+			s.False(duplicate)
+			s.Equal(models.InboundCreditStatusSuspense, credit.Status)
+			s.Require().NotNil(credit.SuspenseReason)
+			s.Equal(tc.reason, *credit.SuspenseReason)
+		})
+	}
+}
+
+func (s *InboundCreditServiceTestSuite) TestProcessNorthwindCredit_PostingFailureGoesToSuspense() {
+	s.expectNewEvent("evt_1")
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Return(s.account, nil)
+	s.inboundCreditRepo.EXPECT().CreatePosted(gomock.Any(), gomock.Any(), gomock.Any()).Return(repositories.ErrAccountNotActive)
+	s.inboundCreditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	credit, _, err := s.service.ProcessNorthwindCredit(context.Background(), "evt_1", s.data)
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusSuspense, credit.Status)
+	s.Equal("posting failed: account is not active", *credit.SuspenseReason)
+}
+
+func (s *InboundCreditServiceTestSuite) TestProcessNorthwindCredit_RecordErrorIsReturnedForRedelivery() {
+	s.expectNewEvent("evt_1")
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Return(s.account, nil)
+	s.inboundCreditRepo.EXPECT().CreatePosted(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+	// A temporary failure must not park the credit in suspense and acknowledge it
+	s.inboundCreditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	// Nothing was recorded, so the error makes Northwind redeliver and the credit is posted then
+	_, _, err := s.service.ProcessNorthwindCredit(context.Background(), "evt_1", s.data)
+	s.ErrorIs(err, ErrInboundCreditNotRecorded)
+}
+
+func (s *InboundCreditServiceTestSuite) TestProcessNorthwindCredit_AccountLookupErrorIsReturnedForRedelivery() {
+	s.expectNewEvent("evt_1")
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+	s.inboundCreditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	_, _, err := s.service.ProcessNorthwindCredit(context.Background(), "evt_1", s.data)
+	s.ErrorIs(err, ErrInboundCreditNotRecorded)
+}
+
+func (s *InboundCreditServiceTestSuite) suspenseCredit() *models.InboundCredit {
+	credit := &models.InboundCredit{
+		ID:              uuid.New(),
+		EventID:         "evt_1",
+		AccountNumber:   "9999999999",
+		Amount:          decimal.RequireFromString("40.00"),
+		Currency:        "USD",
+		SenderAccountID: "nw_acct_42",
+	}
+	credit.MarkSuspense("no account matches account number")
+	return credit
+}
+
+func (s *InboundCreditServiceTestSuite) TestResolve_Success() {
+	adminID := uuid.New()
+	credit := s.suspenseCredit()
+	transactionID := uuid.New()
+
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), s.account.AccountNumber).Return(s.account, nil)
+	s.inboundCreditRepo.EXPECT().ResolvePosted(gomock.Any(), credit, gomock.Any()).
+		DoAndReturn(func(_ context.Context, credit *models.InboundCredit, transaction *models.Transaction) error {
+			s.Equal(s.account.ID, transaction.AccountID)
+			s.True(transaction.Amount.Equal(credit.Amount))
+			transaction.ID = transactionID
+			credit.MarkPosted(transaction.AccountID, transaction.ID)
+			return nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("inbound_credit.resolved", log.Action)
+		s.Equal(&adminID, log.UserID)
+		return nil
+	})
+
+	resolved, err := s.service.Resolve(context.Background(), adminID, credit.ID, s.account.AccountNumber, "confirmed with sender")
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusPosted, resolved.Status)
+	s.Equal(&transactionID, resolved.TransactionID)
+	s.Equal(&adminID, resolved.ResolvedBy)
+	s.NotNil(resolved.ResolvedAt)
+	s.Equal("confirmed with sender", *resolved.ResolutionNote)
+}
+
+func (s *InboundCreditServiceTestSuite) TestResolve_ActionedConcurrently() {
+	credit := s.suspenseCredit()
+
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), s.account.AccountNumber).Return(s.account, nil)
+	// Another admin resolved or returned the credit after it was read; nothing is posted
+	s.inboundCreditRepo.EXPECT().ResolvePosted(gomock.Any(), credit, gomock.Any()).Return(repositories.ErrInboundCreditStatusChanged)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.Resolve(context.Background(), uuid.New(), credit.ID, s.account.AccountNumber, "double click")
+	s.ErrorIs(err, ErrInboundCreditNotInSuspense)
+}
+
+func (s *InboundCreditServiceTestSuite) TestResolve_NotFound() {
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrInboundCreditNotFound)
+
+	_, err := s.service.Resolve(context.Background(), uuid.New(), uuid.New(), "1012345678", "note")
+	s.ErrorIs(err, ErrInboundCreditNotFound)
+}
+
+func (s *InboundCreditServiceTestSuite) TestResolve_NotInSuspense() {
+	credit := s.suspenseCredit()
+	credit.Status = models.InboundCreditStatusPosted
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+
+	_, err := s.service.Resolve(context.Background(), uuid.New(), credit.ID, "1012345678", "note")
+	s.ErrorIs(err, ErrInboundCreditNotInSuspense)
+}
+
+func (s *InboundCreditServiceTestSuite) TestResolve_AccountNotFound() {
+	credit := s.suspenseCredit()
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.accountService.EXPECT().GetAccountByNumber(gomock.Any(), "0000000000").Return(nil, ErrAccountNotFound)
+
+	_, err := s.service.Resolve(context.Background(), uuid.New(), credit.ID, "0000000000", "note")
+	s.ErrorIs(err, ErrAccountNotFound)
+	s.Equal(models.InboundCreditStatusSuspense, credit.Status)
+}
+
+func (s *InboundCreditServiceTestSuite) TestReturn_Success() {
+	adminID := uuid.New()
+	credit := s.suspenseCredit()
+
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), credit, models.InboundCreditStatusSuspense).
+		DoAndReturn(func(_ context.Context, credit *models.InboundCredit, _ string) error {
+			s.Equal(models.InboundCreditStatusReturning, credit.Status)
+			return nil
+		})
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+			s.Equal("1000000009", req.SourceAccountID)
+			s.Equal("nw_acct_42", req.DestinationAccountID)
+			s.Equal("40.00", req.Amount)
+			s.Equal("inbound-credit-return-"+credit.ID.String(), req.IdempotencyKey)
+			return &dto.NorthwindInitiateTransferResponse{ID: "nw_tr_return", Status: "pending"}, nil
+		})
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), credit, models.InboundCreditStatusReturning).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("inbound_credit.returned", log.Action)
+		return nil
+	})
+
+	returned, err := s.service.Return(context.Background(), adminID, credit.ID, "unknown beneficiary")
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusReturned, returned.Status)
+	s.Equal("nw_tr_return", *returned.ReturnTransferID)
+	s.Equal(&adminID, returned.ResolvedBy)
+}
+
+func (s *InboundCreditServiceTestSuite) TestReturn_ClaimLost() {
+	credit := s.suspenseCredit()
+
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), credit, models.InboundCreditStatusSuspense).Return(repositories.ErrInboundCreditStatusChanged)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.Return(context.Background(), uuid.New(), credit.ID, "note")
+	s.ErrorIs(err, ErrInboundCreditNotInSuspense)
+}
+
+func (s *InboundCreditServiceTestSuite) TestReturn_UnknownSender() {
+	credit := s.suspenseCredit()
+	credit.SenderAccountID = ""
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+
+	_, err := s.service.Return(context.Background(), uuid.New(), credit.ID, "note")
+	s.ErrorIs(err, ErrInboundCreditNotReturnable)
+}
+
+func (s *InboundCreditServiceTestSuite) TestReturn_SettlementAccountNotConfigured() {
+	service := NewInboundCreditService(s.inboundCreditRepo, s.accountService, s.auditRepo, s.northwindClient, config.NorthwindConfig{})
+	credit := s.suspenseCredit()
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+
+	_, err := service.Return(context.Background(), uuid.New(), credit.ID, "note")
+	s.ErrorIs(err, ErrInboundCreditNotReturnable)
+}
+
+func (s *InboundCreditServiceTestSuite) TestReturn_NorthwindRejectionReleasesCredit() {
+	credit := s.suspenseCredit()
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), credit, models.InboundCreditStatusSuspense).Return(nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Return(nil, ErrNorthwindNotFound)
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), credit, models.InboundCreditStatusReturning).Return(nil)
+
+	_, err := s.service.Return(context.Background(), uuid.New(), credit.ID, "note")
+	s.ErrorIs(err, ErrInboundCreditReturnFailed)
+	s.Equal(models.InboundCreditStatusSuspense, credit.Status)
+}
+
+func (s *InboundCreditServiceTestSuite) TestReturn_NorthwindUnavailableStaysReturning() {
+	credit := s.suspenseCredit()
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), credit, models.InboundCreditStatusSuspense).Return(nil)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).Return(nil, ErrNorthwindUnavailable)
+
+	// Northwind may have accepted the return, so the credit must not become resolvable again
+	_, err := s.service.Return(context.Background(), uuid.New(), credit.ID, "note")
+	s.ErrorIs(err, ErrInboundCreditReturnFailed)
+	s.Equal(models.InboundCreditStatusReturning, credit.Status)
+}
+
+func (s *InboundCreditServiceTestSuite) TestReturn_RetryWhileReturning() {
+	credit := s.suspenseCredit()
+	credit.Status = models.InboundCreditStatusReturning
+	s.inboundCreditRepo.EXPECT().GetByID(gomock.Any(), credit.ID).Return(credit, nil)
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), gomock.Any(), models.InboundCreditStatusSuspense).Times(0)
+	s.northwindClient.EXPECT().InitiateTransfer(gomock.Any(), gomock.Any()).
+		Return(&dto.NorthwindInitiateTransferResponse{ID: "nw_tr_return", Status: "pending"}, nil)
+	s.inboundCreditRepo.EXPECT().UpdateFromStatus(gomock.Any(), credit, models.InboundCreditStatusReturning).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	returned, err := s.service.Return(context.Background(), uuid.New(), credit.ID, "retry")
+	s.NoError(err)
+	s.Equal(models.InboundCreditStatusReturned, returned.Status)
+}
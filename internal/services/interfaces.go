@@ -12,48 +12,53 @@ import (
 
 // AccountAssociationServiceInterface defines the contract for account association operations
 type AccountAssociationServiceInterface interface {
-	GetCustomerAccounts(customerID uuid.UUID) ([]*models.Account, error)
-	CreateAccountForCustomer(customerID, performedBy uuid.UUID, accountType, ipAddress, userAgent string) (*models.Account, error)
-	TransferAccountOwnership(accountID, fromCustomerID, toCustomerID, performedBy uuid.UUID, ipAddress, userAgent string) error
+	GetCustomerAccounts(ctx context.Context, customerID uuid.UUID) ([]*models.Account, error)
+	CreateAccountForCustomer(ctx context.Context, customerID, performedBy uuid.UUID, accountType, ipAddress, userAgent string) (*models.Account, error)
+	TransferAccountOwnership(ctx context.Context, accountID, fromCustomerID, toCustomerID, performedBy uuid.UUID, ipAddress, userAgent string) error
 }
 
 // AccountServiceInterface defines account-related business operations
 type AccountServiceInterface interface {
-	CreateAccount(userID uuid.UUID, accountType string, initialDeposit decimal.Decimal) (*models.Account, error)
-	CreateAccountsForNewUser(userID uuid.UUID) error
-	GetAccountByID(accountID uuid.UUID, userID *uuid.UUID) (*models.Account, error)
-	GetAccountByNumber(accountNumber string) (*models.Account, error)
-	GetUserAccounts(userID uuid.UUID) ([]models.Account, error)
-	GetAllAccounts(filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error)
-	UpdateAccountStatus(accountID uuid.UUID, userID *uuid.UUID, status string) (*models.Account, error)
-	CloseAccount(accountID uuid.UUID, userID uuid.UUID) error
-	PerformTransaction(accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error)
-	TransferBetweenAccounts(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description, idempotencyKey string, userID uuid.UUID) (*models.Transfer, error)
+	CreateAccount(ctx context.Context, userID uuid.UUID, accountType string, initialDeposit decimal.Decimal) (*models.Account, error)
+	CreateAccountsForNewUser(ctx context.Context, userID uuid.UUID) error
+	GetAccountByID(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID) (*models.Account, error)
+	GetAccountByNumber(ctx context.Context, accountNumber string) (*models.Account, error)
+	GetUserAccounts(ctx context.Context, userID uuid.UUID) ([]models.Account, error)
+	GetAllAccounts(ctx context.Context, filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error)
+	UpdateAccountStatus(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, status string) (*models.Account, error)
+	CloseAccount(ctx context.Context, accountID uuid.UUID, userID uuid.UUID) error
+	PerformTransaction(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error)
+	SubmitTransaction(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.TransactionOperation, error)
+	GetTransactionOperation(ctx context.Context, accountID, operationID uuid.UUID, userID *uuid.UUID) (*models.TransactionOperation, error)
+	TransferBetweenAccounts(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description, idempotencyKey string, userID uuid.UUID) (*models.Transfer, error)
 	HandleFailedExternalTransfer(ctx context.Context, transfer *models.Transfer, reason string) error
+	CompleteExternalTransfer(ctx context.Context, transfer *models.Transfer) error
+	ResumeExternalTransfer(ctx context.Context, transferID uuid.UUID) error
 	InitiateExternalTransfer(ctx context.Context, userID, fromAccountID, toExternalAccountID uuid.UUID, amount decimal.Decimal, description, transferType, idempotencyKey string) (*models.Transfer, error)
-	GetAccountTransactions(accountID uuid.UUID, userID *uuid.UUID, offset, limit int) ([]models.Transaction, int64, error)
-	GetRecentTransactions(accountID uuid.UUID, userID *uuid.UUID, limit int) ([]models.Transaction, error)
-	GetUserTransfers(userID uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error)
+	GetAccountTransactions(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, offset, limit int) ([]models.Transaction, int64, error)
+	GetRecentTransactions(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, limit int) ([]models.Transaction, error)
+	GetUserTransfers(ctx context.Context, userID uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error)
+	ApproveHeldTransfer(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error)
 }
 
 type AccountSummaryServiceInterface interface {
-	GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error)
+	GetAccountSummary(ctx context.Context, requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error)
 }
 
 // AuditServiceInterface defines the contract for audit logging operations
 type AuditServiceInterface interface {
-	CreateAuditLog(log *models.AuditLog) error
-	GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
-	LogLogin(userID uuid.UUID, ipAddress, userAgent string) error
-	LogLogout(userID uuid.UUID, ipAddress, userAgent string) error
-	LogProfileUpdate(userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error
-	LogEmailUpdate(userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error
-	LogPasswordReset(userID, performedBy uuid.UUID, ipAddress, userAgent string) error
-	LogPasswordUpdate(userID uuid.UUID, ipAddress, userAgent string) error
-	LogCustomerCreated(userID, performedBy uuid.UUID, ipAddress, userAgent string) error
-	LogCustomerDeleted(userID, performedBy uuid.UUID, ipAddress, userAgent string, reason string) error
-	LogAccountCreated(userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error
-	LogAccountTransferred(fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error
+	CreateAuditLog(ctx context.Context, log *models.AuditLog) error
+	GetCustomerActivity(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error)
+	LogLogin(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error
+	LogLogout(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error
+	LogProfileUpdate(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error
+	LogEmailUpdate(ctx context.Context, userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error
+	LogPasswordReset(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string) error
+	LogPasswordUpdate(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error
+	LogCustomerCreated(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string) error
+	LogCustomerDeleted(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string, reason string) error
+	LogAccountCreated(ctx context.Context, userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error
+	LogAccountTransferred(ctx context.Context, fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error
 }
 
 // CategoryServiceInterface defines the interface for transaction categorization operations
@@ -82,25 +87,25 @@ type CategoryServiceInterface interface {
 
 // CustomerProfileServiceInterface defines the contract for customer profile operations
 type CustomerProfileServiceInterface interface {
-	GetCustomerProfile(customerID uuid.UUID) (*models.User, error)
-	CreateCustomer(email, firstName, lastName string, role string) (*models.User, string, error)
-	UpdateCustomerProfile(customerID uuid.UUID, updates map[string]interface{}) error
-	UpdateCustomerEmail(customerID uuid.UUID, newEmail string) error
-	DeleteCustomer(customerID uuid.UUID, reason string) error
+	GetCustomerProfile(ctx context.Context, customerID uuid.UUID) (*models.User, error)
+	CreateCustomer(ctx context.Context, email, firstName, lastName string, role string) (*models.User, string, error)
+	UpdateCustomerProfile(ctx context.Context, customerID uuid.UUID, updates map[string]interface{}) error
+	UpdateCustomerEmail(ctx context.Context, customerID uuid.UUID, newEmail string) error
+	DeleteCustomer(ctx context.Context, customerID uuid.UUID, reason string) error
 }
 
 // CustomerSearchServiceInterface defines the contract for customer search operations
 type CustomerSearchServiceInterface interface {
-	SearchCustomers(query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error)
+	SearchCustomers(ctx context.Context, query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error)
 }
 
 // AccountMetricsServiceInterface provides performance metrics and analytics for accounts
 type AccountMetricsServiceInterface interface {
 	// GetAccountMetrics calculates performance metrics for a single account over a date range
-	GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error)
+	GetAccountMetrics(ctx context.Context, requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error)
 
 	// GetUserAggregateMetrics calculates aggregate metrics across all accounts for a user
-	GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error)
+	GetUserAggregateMetrics(ctx context.Context, requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error)
 }
 
 type MetricsRecorderInterface interface {
@@ -112,7 +117,7 @@ type MetricsRecorderInterface interface {
 // StatementServiceInterface provides account statement generation
 type StatementServiceInterface interface {
 	// GenerateStatement generates a monthly or quarterly account statement
-	GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error)
+	GenerateStatement(ctx context.Context, requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error)
 }
 
 // TransactionGeneratorInterface generates realistic transaction data for testing
@@ -130,10 +135,10 @@ type TransactionGeneratorInterface interface {
 }
 
 type AuthServiceInterface interface {
-	Register(req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error)
-	Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error)
-	RefreshTokens(refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error)
-	Logout(accessToken, ipAddress, userAgent string) error
+	Register(ctx context.Context, req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error)
+	Login(ctx context.Context, req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error)
+	RefreshTokens(ctx context.Context, refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error)
+	Logout(ctx context.Context, accessToken, ipAddress, userAgent string) error
 }
 
 type TokenServiceInterface interface {
@@ -154,8 +159,8 @@ type PasswordServiceInterface interface {
 	GenerateSecurePassword() (string, error)
 	GenerateSecurePasswordWithLength(length int) (string, error)
 	PasswordStrength(password string) int
-	AdminResetPassword(customerID, adminID uuid.UUID) (string, error)
-	CustomerUpdatePassword(customerID uuid.UUID, currentPassword, newPassword string) error
+	AdminResetPassword(ctx context.Context, customerID, adminID uuid.UUID) (string, error)
+	CustomerUpdatePassword(ctx context.Context, customerID uuid.UUID, currentPassword, newPassword string) error
 }
 
 type AuditLoggerInterface interface {
@@ -201,10 +206,43 @@ type CustomerLoggerInterface interface {
 }
 
 type TransactionProcessingServiceInterface interface {
-	EnqueueTransaction(transactionID uuid.UUID, operation string, priority int) error
+	EnqueueTransaction(ctx context.Context, transactionID uuid.UUID, operation string, priority int) error
+	PriorityForAccountType(accountType string) int
+	GetTransactionQueueItem(ctx context.Context, transactionID uuid.UUID) (*models.ProcessingQueueItem, error)
 	StartProcessing(ctx context.Context)
+	Drain(ctx context.Context) error
+	ReapExpiredLeases(ctx context.Context) (int64, error)
 	ProcessQueueItem(ctx context.Context, queueItem *models.ProcessingQueueItem) error
-	GetQueueMetrics() (*dto.QueueMetrics, error)
+	GetQueueMetrics(ctx context.Context) (*dto.QueueMetrics, error)
+}
+
+// QueueAdminServiceInterface defines the contract for operating the transaction processing queue.
+// Every action that changes the queue is audited against the admin.
+type QueueAdminServiceInterface interface {
+	// GetMetrics returns queue depth by status, processing times and whether workers are paused.
+	GetMetrics(ctx context.Context) (*dto.QueueMetrics, error)
+	// ListItems lists queue items matching the filters, oldest first.
+	ListItems(ctx context.Context, filters models.ProcessingQueueFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error)
+	// GetItem returns a single queue item with its last error.
+	GetItem(ctx context.Context, itemID uuid.UUID) (*models.ProcessingQueueItem, error)
+	// RetryItem requeues a pending, failed or dead-lettered item now with fresh retries.
+	RetryItem(ctx context.Context, adminID, itemID uuid.UUID) (*models.ProcessingQueueItem, error)
+	// RescheduleItem requeues a pending, failed or dead-lettered item at scheduledAt with fresh retries.
+	RescheduleItem(ctx context.Context, adminID, itemID uuid.UUID, scheduledAt time.Time) (*models.ProcessingQueueItem, error)
+	// RetryItems requeues items in bulk, reporting those that could not be requeued.
+	RetryItems(ctx context.Context, adminID uuid.UUID, req *dto.RetryQueueItemsRequest) (*dto.RetryQueueItemsResponse, error)
+	// CancelItem cancels an item that is not being processed so it never runs.
+	CancelItem(ctx context.Context, adminID, itemID uuid.UUID, note string) (*models.ProcessingQueueItem, error)
+	// DeadLetterItem parks a pending or failed item until an operator retries or cancels it.
+	DeadLetterItem(ctx context.Context, adminID, itemID uuid.UUID, note string) (*models.ProcessingQueueItem, error)
+	// GetWorkerStatus returns whether the queue workers are paused.
+	GetWorkerStatus(ctx context.Context) (*models.ProcessingQueueControl, error)
+	// PauseWorkers stops every worker from claiming new items.
+	PauseWorkers(ctx context.Context, adminID uuid.UUID, reason string) (*models.ProcessingQueueControl, error)
+	// ResumeWorkers lets the workers claim items again.
+	ResumeWorkers(ctx context.Context, adminID uuid.UUID) (*models.ProcessingQueueControl, error)
+	// CleanupCompleted deletes completed items processed more than olderThan ago.
+	CleanupCompleted(ctx context.Context, adminID uuid.UUID, olderThan time.Duration) (int64, error)
 }
 
 // NorthwindClientInterface defines the interface for interacting with the Northwind API.
@@ -223,18 +261,53 @@ type NorthwindClientInterface interface {
 type ExternalAccountServiceInterface interface {
 	// Register creates a new external account by calling the Northwind API and saving it locally.
 	Register(ctx context.Context, userID uuid.UUID, req *dto.RegisterExternalAccountRequest) (*models.ExternalAccount, error)
+	// SendMicroDeposits (re)sends the two verification micro-deposits to an unverified payee.
+	SendMicroDeposits(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccount, error)
+	// VerifyMicroDeposits confirms payee ownership by matching the micro-deposit amounts.
+	VerifyMicroDeposits(ctx context.Context, userID, externalAccountID uuid.UUID, amount1, amount2 decimal.Decimal) (*models.ExternalAccount, error)
+	// List returns all payees registered by the user.
+	List(ctx context.Context, userID uuid.UUID) ([]models.ExternalAccount, error)
+	// Get returns a single payee owned by the user.
+	Get(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccount, error)
+	// UpdateNickname renames a payee owned by the user.
+	UpdateNickname(ctx context.Context, userID, externalAccountID uuid.UUID, nickname string) (*models.ExternalAccount, error)
+	// Delete soft-deletes a payee that has no pending transfers.
+	Delete(ctx context.Context, userID, externalAccountID uuid.UUID) error
+	// GetTransferHistory returns paginated transfers sent to a payee.
+	GetTransferHistory(ctx context.Context, userID, externalAccountID uuid.UUID, offset, limit int) ([]models.Transfer, int64, error)
+	// GetTransferStats returns usage statistics for a payee.
+	GetTransferStats(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccountTransferStats, error)
+}
+
+// TransferSagaRecoveryServiceInterface defines the contract for recovering interrupted external
+// transfer sagas.
+type TransferSagaRecoveryServiceInterface interface {
+	// RecoverIncompleteSagas resubmits or compensates transfers whose saga stalled after the
+	// debit was posted, returning how many were resolved.
+	RecoverIncompleteSagas(ctx context.Context) int
 }
 
 // TransferMonitorServiceInterface defines the contract for monitoring external transfers.
 type TransferMonitorServiceInterface interface {
-	// MonitorPendingTransfers checks the status of pending external transfers and updates them.
+	// MonitorPendingTransfers polls transfers whose status callback is overdue, backing off per
+	// transfer and escalating those older than the configured maximum age.
 	MonitorPendingTransfers(ctx context.Context)
+	// HandleStatusCallback applies a status change pushed by Northwind. It is idempotent and
+	// ignores out-of-order deliveries; applied reports whether the transfer changed.
+	HandleStatusCallback(ctx context.Context, data *dto.NorthwindTransferStatusData) (transfer *models.Transfer, applied bool, err error)
+	// ListEscalatedTransfers returns unresolved transfers in the admin stuck queue.
+	ListEscalatedTransfers(ctx context.Context, offset, limit int) ([]models.Transfer, int64, error)
+	// RequeueEscalatedTransfer returns a stuck transfer to normal polling.
+	RequeueEscalatedTransfer(ctx context.Context, transferID uuid.UUID) (*models.Transfer, error)
 }
 
 // RegulatorClientInterface defines the contract for sending notifications to the regulator.
 type RegulatorClientInterface interface {
 	// SendTransferNotification sends a webhook notification about a transfer's final status.
-	SendTransferNotification(ctx context.Context, payload *dto.RegulatorNotificationPayload) (statusCode int, responseBody string, err error)
+	// The payload's event ID must be the same on every attempt at the same notification.
+	SendTransferNotification(ctx context.Context, payload *dto.RegulatorNotificationPayload, attempt int) (statusCode int, responseBody string, err error)
+	// SubmitComplianceReport files an approved CTR or structuring report with the regulator.
+	SubmitComplianceReport(ctx context.Context, file *dto.ComplianceReportFile, attempt int) (statusCode int, responseBody string, err error)
 }
 
 // WebhookServiceInterface defines the contract for managing and sending webhooks.
@@ -243,4 +316,166 @@ type WebhookServiceInterface interface {
 	QueueTransferNotification(ctx context.Context, transfer *models.Transfer) error
 	// ProcessPendingWebhooks fetches and sends pending webhooks.
 	ProcessPendingWebhooks(ctx context.Context)
+	// RecordDeadLetterMetrics exports notification counts by status and raises the DLQ alert.
+	RecordDeadLetterMetrics(ctx context.Context)
+	// ListNotifications lists notifications matching the filters for admin review.
+	ListNotifications(ctx context.Context, filters models.WebhookNotificationFilters, offset, limit int) ([]models.WebhookNotification, int64, error)
+	// GetNotification returns a single notification with the regulator's last response.
+	GetNotification(ctx context.Context, notificationID uuid.UUID) (*models.WebhookNotification, error)
+	// ReplayNotification returns a failed or dead-lettered notification to the delivery queue.
+	ReplayNotification(ctx context.Context, adminID, notificationID uuid.UUID) (*models.WebhookNotification, error)
+	// ReplayNotifications replays notifications in bulk, reporting those that could not be replayed.
+	ReplayNotifications(ctx context.Context, adminID uuid.UUID, req *dto.ReplayWebhookNotificationsRequest) (*dto.ReplayWebhookNotificationsResponse, error)
+	// ResolveNotification closes a failed or dead-lettered notification without delivering it.
+	ResolveNotification(ctx context.Context, adminID, notificationID uuid.UUID, note string) (*models.WebhookNotification, error)
+}
+
+// InboundCreditServiceInterface defines the contract for inbound partner credits and their suspense queue.
+type InboundCreditServiceInterface interface {
+	// ProcessNorthwindCredit records a credit.received event and posts it to the matching account,
+	// or parks it in suspense. Redelivered events return the existing record with duplicate=true.
+	ProcessNorthwindCredit(ctx context.Context, eventID string, data *dto.NorthwindCreditReceivedData) (credit *models.InboundCredit, duplicate bool, err error)
+	// List returns inbound credits in the given status (all when empty), oldest first.
+	List(ctx context.Context, status string, offset, limit int) ([]models.InboundCredit, int64, error)
+	// Resolve posts a suspense credit to the account with the given number.
+	Resolve(ctx context.Context, adminID, creditID uuid.UUID, accountNumber, note string) (*models.InboundCredit, error)
+	// Return sends a suspense credit back to the originator through Northwind.
+	Return(ctx context.Context, adminID, creditID uuid.UUID, note string) (*models.InboundCredit, error)
+}
+
+// OutboxConsumerInterface defines the contract for an in-process consumer of outbox events.
+type OutboxConsumerInterface interface {
+	// Name identifies the consumer's checkpoint and must be stable across deployments.
+	Name() string
+	// HandleEvent processes one event. Delivery is at-least-once, so it must tolerate redelivery;
+	// an error stops the consumer at this event until a retry succeeds or the event is dead-lettered.
+	// Events may arrive out of sequence order when an admin replays a dead letter.
+	HandleEvent(ctx context.Context, event *models.OutboxEvent) error
+}
+
+// OutboxRelayServiceInterface defines the contract for relaying outbox events to consumers.
+type OutboxRelayServiceInterface interface {
+	// Relay delivers pending events to each consumer in order, returning how many were delivered.
+	Relay(ctx context.Context) int
+	// ListConsumers returns each consumer's checkpoint and undelivered event count.
+	ListConsumers(ctx context.Context) ([]dto.OutboxConsumerStatus, error)
+	// ListUndeliveredEvents returns the events the consumer has not yet handled, oldest first.
+	ListUndeliveredEvents(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxEvent, int64, error)
+	// SkipEvent dead-letters the consumer's next undelivered event, which must have the given sequence.
+	SkipEvent(ctx context.Context, adminID uuid.UUID, consumer string, sequence int64) (*models.OutboxDeadLetter, error)
+	// ListDeadLetters returns the consumer's dead letters that have not been replayed, oldest first.
+	ListDeadLetters(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxDeadLetter, int64, error)
+	// ReplayDeadLetter hands a dead-lettered event to its consumer again.
+	ReplayDeadLetter(ctx context.Context, adminID uuid.UUID, consumer string, sequence int64) (*models.OutboxDeadLetter, error)
+}
+
+// CustomerWebhookServiceInterface defines the contract for customer webhook subscriptions and their delivery.
+type CustomerWebhookServiceInterface interface {
+	// CreateSubscription registers an endpoint and generates the secret its deliveries are signed with.
+	CreateSubscription(ctx context.Context, userID uuid.UUID, req *dto.CreateWebhookSubscriptionRequest) (*models.WebhookSubscription, error)
+	// ListSubscriptions returns the user's subscriptions, newest first.
+	ListSubscriptions(ctx context.Context, userID uuid.UUID) ([]models.WebhookSubscription, error)
+	// GetSubscription returns a subscription owned by the user.
+	GetSubscription(ctx context.Context, userID, subscriptionID uuid.UUID) (*models.WebhookSubscription, error)
+	// UpdateSubscription changes a subscription; setting it active re-enables it after auto-disabling.
+	UpdateSubscription(ctx context.Context, userID, subscriptionID uuid.UUID, req *dto.UpdateWebhookSubscriptionRequest) (*models.WebhookSubscription, error)
+	// DeleteSubscription removes a subscription and its delivery log.
+	DeleteSubscription(ctx context.Context, userID, subscriptionID uuid.UUID) error
+	// ListDeliveries returns the delivery log of a subscription, newest first.
+	ListDeliveries(ctx context.Context, userID, subscriptionID uuid.UUID, offset, limit int) ([]models.WebhookDelivery, int64, error)
+	// Redeliver queues a sent or exhausted delivery to be sent again with the same event ID.
+	Redeliver(ctx context.Context, userID, subscriptionID, deliveryID uuid.UUID) (*models.WebhookDelivery, error)
+	// Ping sends a test event to the subscription immediately and returns the logged delivery.
+	Ping(ctx context.Context, userID, subscriptionID uuid.UUID) (*models.WebhookDelivery, error)
+	// QueueEvent creates deliveries of an outbox event for the subscriptions that selected it.
+	QueueEvent(ctx context.Context, event *models.OutboxEvent) error
+	// ProcessPendingDeliveries sends due deliveries and schedules retries for failed ones.
+	ProcessPendingDeliveries(ctx context.Context)
+}
+
+// ComplianceServiceInterface defines the contract for Currency Transaction Reports and
+// structuring detection.
+type ComplianceServiceInterface interface {
+	// GenerateReports aggregates activity for a business day and records CTR and structuring
+	// reports for review. Reports that already exist for the day are kept as they are.
+	GenerateReports(ctx context.Context, businessDate time.Time) (*dto.GenerateComplianceReportsResponse, error)
+	// GeneratePreviousBusinessDay runs GenerateReports for the day before today, once per day.
+	GeneratePreviousBusinessDay(ctx context.Context) error
+	// ListReports returns reports matching the filters.
+	ListReports(ctx context.Context, filters models.ComplianceReportFilters, offset, limit int) ([]models.ComplianceReport, int64, error)
+	// GetReport returns a single report.
+	GetReport(ctx context.Context, reportID uuid.UUID) (*models.ComplianceReport, error)
+	// GetReportFile builds the file that is, or would be, filed with the regulator.
+	GetReportFile(ctx context.Context, reportID uuid.UUID) (*dto.ComplianceReportFile, error)
+	// ApproveReport approves a pending report and queues it for submission.
+	ApproveReport(ctx context.Context, adminID, reportID uuid.UUID, note string) (*models.ComplianceReport, error)
+	// RejectReport dismisses a pending report without filing it.
+	RejectReport(ctx context.Context, adminID, reportID uuid.UUID, note string) (*models.ComplianceReport, error)
+	// SubmitApprovedReports files approved reports that are due with the regulator.
+	SubmitApprovedReports(ctx context.Context) error
+}
+
+// FraudScreeningServiceInterface defines the contract for rule-based fraud and AML screening of
+// money movements.
+type FraudScreeningServiceInterface interface {
+	// Screen evaluates the enabled rules and persists the decision. A block returns the decision
+	// together with ErrTransactionDeclined.
+	Screen(ctx context.Context, req *dto.FraudScreeningRequest) (*models.FraudDecision, error)
+	// AttachResource links a decision to the transfer or transaction it allowed.
+	AttachResource(ctx context.Context, decisionID uuid.UUID, resourceType string, resourceID uuid.UUID) error
+	// ListRules returns the effective settings of every rule.
+	ListRules(ctx context.Context) ([]dto.FraudRuleResponse, error)
+	// UpdateRule changes a rule's settings; the change applies to the next screened movement.
+	UpdateRule(ctx context.Context, adminID uuid.UUID, name string, req *dto.UpdateFraudRuleRequest) (*dto.FraudRuleResponse, error)
+	// ListDecisions returns decisions matching the filters, newest first.
+	ListDecisions(ctx context.Context, filters models.FraudDecisionFilters, offset, limit int) ([]models.FraudDecision, int64, error)
+	// GetDecision returns a single decision.
+	GetDecision(ctx context.Context, decisionID uuid.UUID) (*models.FraudDecision, error)
+}
+
+// SanctionsScreeningServiceInterface defines the contract for screening customer and payee names
+// against the sanctions list and reviewing potential matches.
+type SanctionsScreeningServiceInterface interface {
+	// LoadList reloads the list file into this instance's memory when it has changed.
+	LoadList(ctx context.Context) error
+	// RefreshList reloads the list file when it has changed and rescreens every customer and
+	// payee against a version they have not yet been screened against.
+	RefreshList(ctx context.Context) error
+	// Rescreen screens every customer and payee against the loaded list.
+	Rescreen(ctx context.Context) (*models.SanctionsListLoad, error)
+	// ScreenUser screens a customer's name and sets their screening status.
+	ScreenUser(ctx context.Context, user *models.User) error
+	// ScreenExternalAccount screens a payee's name on account and sets its screening status.
+	ScreenExternalAccount(ctx context.Context, account *models.ExternalAccount) error
+	// CheckUser returns ErrSanctionsHold when a sanctions match restricts the customer.
+	CheckUser(ctx context.Context, userID uuid.UUID) error
+	// GetListStatus returns the most recently loaded list version.
+	GetListStatus(ctx context.Context) (*models.SanctionsListLoad, error)
+	// ListMatches returns matches for the filters, newest first.
+	ListMatches(ctx context.Context, filters models.SanctionsMatchFilters, offset, limit int) ([]models.SanctionsMatch, int64, error)
+	// GetMatch returns a single match.
+	GetMatch(ctx context.Context, matchID uuid.UUID) (*models.SanctionsMatch, error)
+	// ClearMatch dismisses an open match as a false positive.
+	ClearMatch(ctx context.Context, adminID, matchID uuid.UUID, note string) (*models.SanctionsMatch, error)
+	// ConfirmMatch records an open match as a true match and blocks the subject.
+	ConfirmMatch(ctx context.Context, adminID, matchID uuid.UUID, note string) (*models.SanctionsMatch, error)
+}
+
+// TransferReviewServiceInterface defines the contract for holding transfers for manual review and
+// deciding the review queue.
+type TransferReviewServiceInterface interface {
+	// Evaluate returns the reasons the transfer should be held; none means it executes immediately.
+	Evaluate(ctx context.Context, req *dto.TransferReviewRequest) ([]dto.TransferReviewReason, error)
+	// Hold debits the source account and queues the transfer for review.
+	Hold(ctx context.Context, userID uuid.UUID, transfer *models.Transfer, reasons []dto.TransferReviewReason, transferType, debitDescription string) (*models.TransferReview, error)
+	// ListReviews returns reviews for the filters, earliest SLA deadline first.
+	ListReviews(ctx context.Context, filters models.TransferReviewFilters, offset, limit int) ([]models.TransferReview, int64, error)
+	// GetReview returns a single review with its transfer.
+	GetReview(ctx context.Context, reviewID uuid.UUID) (*models.TransferReview, error)
+	// GetCustomerActivity returns the customer's recent transfers and transactions.
+	GetCustomerActivity(ctx context.Context, userID uuid.UUID) (*dto.TransferReviewActivity, error)
+	// Approve releases a held transfer; external transfers are left for partner submission.
+	Approve(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error)
+	// Reject returns the reserved funds and fails the held transfer.
+	Reject(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error)
 }
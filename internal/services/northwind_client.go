@@ -4,154 +4,351 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
 	"net/http"
 	"time"
 
+	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
 	defaultTimeout   = 10 * time.Second
 	northwindBaseURL = "https://northwind.dev.array.io/api/v1"
+
+	// northwindIdempotencyHeader carries our idempotency key so Northwind deduplicates retries
+	northwindIdempotencyHeader = "Idempotency-Key"
+	// maxNorthwindErrorBodyBytes bounds how much of an error response is read for parsing
+	maxNorthwindErrorBodyBytes = 64 << 10
+)
+
+// Northwind error kinds. Errors returned by the client wrap one of these when the failure
+// can be classified, so callers can use errors.Is.
+var (
+	ErrNorthwindValidation        = errors.New("northwind rejected the request as invalid")
+	ErrNorthwindInsufficientFunds = errors.New("northwind reported insufficient funds")
+	ErrNorthwindNotFound          = errors.New("northwind resource not found")
+	ErrNorthwindUnavailable       = errors.New("northwind is unavailable")
 )
 
+// NorthwindError is a non-success response from the Northwind API.
+type NorthwindError struct {
+	Operation      string
+	StatusCode     int
+	ExpectedStatus int
+	Code           string // Partner error code from the response body, if any
+	Message        string // Partner error message from the response body, if any
+	kind           error
+}
+
+func (e *NorthwindError) Error() string {
+	msg := fmt.Sprintf("northwind client: %s returned non-%d status: %d", e.Operation, e.ExpectedStatus, e.StatusCode)
+	if e.Code != "" || e.Message != "" {
+		msg += fmt.Sprintf(" (%s: %s)", e.Code, e.Message)
+	}
+	return msg
+}
+
+// Unwrap returns the error kind, such as ErrNorthwindNotFound, or nil if unclassified.
+func (e *NorthwindError) Unwrap() error {
+	return e.kind
+}
+
+// classifyNorthwindError maps a partner response onto one of the Northwind error kinds.
+func classifyNorthwindError(statusCode int, code string) error {
+	switch {
+	case code == "insufficient_funds" || statusCode == http.StatusPaymentRequired:
+		return ErrNorthwindInsufficientFunds
+	case statusCode == http.StatusNotFound:
+		return ErrNorthwindNotFound
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusConflict, statusCode == http.StatusUnprocessableEntity:
+		return ErrNorthwindValidation
+	case statusCode == http.StatusTooManyRequests, statusCode >= http.StatusInternalServerError:
+		return ErrNorthwindUnavailable
+	default:
+		return nil
+	}
+}
+
 // northwindClient implements the NorthwindClientInterface.
 type northwindClient struct {
-	httpClient *http.Client
-	apiKey     string
-	baseURL    string
+	httpClient     *http.Client
+	apiKey         string
+	baseURL        string
+	budget         time.Duration
+	maxRetries     int
+	retryBaseDelay time.Duration
+	retryMaxDelay  time.Duration
+	circuitBreaker CircuitBreakerInterface
+	logger         *slog.Logger
+}
+
+// northwindCall describes a single Northwind API operation.
+type northwindCall struct {
+	operation      string // Human-readable name used in errors, e.g. "get transfer"
+	method         string
+	path           string
+	body           interface{}
+	expectedStatus int
+	idempotencyKey string
 }
 
-// NewNorthwindClient creates a new authenticated client for the Northwind API.
-func NewNorthwindClient(apiKey string) NorthwindClientInterface {
+// NewNorthwindClient creates a new authenticated client for the Northwind API. Every attempt
+// passes through the circuit breaker, and only GETs and requests carrying an idempotency key
+// are retried.
+func NewNorthwindClient(cfg config.NorthwindConfig, circuitBreaker CircuitBreakerInterface) NorthwindClientInterface {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = northwindBaseURL
+	}
+
 	return &northwindClient{
 		httpClient: &http.Client{
-			Timeout: defaultTimeout,
+			Timeout:   timeout,
+			Transport: telemetry.NewTransport(nil),
 		},
-		apiKey:  apiKey,
-		baseURL: northwindBaseURL,
+		apiKey:         cfg.APIKey,
+		baseURL:        baseURL,
+		budget:         cfg.RequestBudget,
+		maxRetries:     cfg.MaxRetries,
+		retryBaseDelay: cfg.RetryBaseDelay,
+		retryMaxDelay:  cfg.RetryMaxDelay,
+		circuitBreaker: circuitBreaker,
+		logger:         slog.Default().With("service", "NorthwindClient"),
 	}
 }
 
 // HealthCheck checks the health of the Northwind API.
 func (c *northwindClient) HealthCheck(ctx context.Context) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/health", c.baseURL), nil)
+	return c.do(ctx, northwindCall{
+		operation:      "health check",
+		method:         http.MethodGet,
+		path:           "/health",
+		expectedStatus: http.StatusOK,
+	}, nil)
+}
+
+// CreateExternalAccount registers a new external account with the Northwind API.
+// This is necessary to designate an account as a valid destination for transfers.
+func (c *northwindClient) CreateExternalAccount(ctx context.Context, details *dto.NorthwindCreateAccountRequest) (*dto.NorthwindExternalAccountResponse, error) {
+	var response dto.NorthwindExternalAccountResponse
+	err := c.do(ctx, northwindCall{
+		operation:      "create external account",
+		method:         http.MethodPost,
+		path:           "/accounts",
+		body:           details,
+		expectedStatus: http.StatusCreated,
+	}, &response)
 	if err != nil {
-		return fmt.Errorf("northwind client: failed to create health check request: %w", err)
+		return nil, err
 	}
 
-	req.Header.Set("X-Api-Key", c.apiKey)
-	req.Header.Set("Accept", "application/json")
+	return &response, nil
+}
 
-	resp, err := c.httpClient.Do(req)
+// InitiateTransfer starts a new transfer with the Northwind API. The request is retried only
+// when it carries an idempotency key.
+func (c *northwindClient) InitiateTransfer(ctx context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+	var response dto.NorthwindInitiateTransferResponse
+	err := c.do(ctx, northwindCall{
+		operation:      "initiate transfer",
+		method:         http.MethodPost,
+		path:           "/transfers",
+		body:           req,
+		expectedStatus: http.StatusCreated,
+		idempotencyKey: req.IdempotencyKey,
+	}, &response)
 	if err != nil {
-		return fmt.Errorf("northwind client: health check request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("northwind client: health check returned non-200 status: %d", resp.StatusCode)
+		return nil, err
 	}
 
-	return nil
+	return &response, nil
 }
 
-// CreateExternalAccount registers a new external account with the Northwind API.
-// This is necessary to designate an account as a valid destination for transfers.
-func (c *northwindClient) CreateExternalAccount(ctx context.Context, details *dto.NorthwindCreateAccountRequest) (*dto.NorthwindExternalAccountResponse, error) {
-	requestBody, err := json.Marshal(details)
+// GetTransfer retrieves the status and details of a specific transfer from the Northwind API.
+func (c *northwindClient) GetTransfer(ctx context.Context, transferID string) (*dto.NorthwindGetTransferResponse, error) {
+	var response dto.NorthwindGetTransferResponse
+	err := c.do(ctx, northwindCall{
+		operation:      "get transfer",
+		method:         http.MethodGet,
+		path:           fmt.Sprintf("/transfers/%s", transferID),
+		expectedStatus: http.StatusOK,
+	}, &response)
 	if err != nil {
-		return nil, fmt.Errorf("northwind client: failed to marshal request body: %w", err)
+		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/accounts", c.baseURL), bytes.NewBuffer(requestBody))
-	if err != nil {
-		return nil, fmt.Errorf("northwind client: failed to create request: %w", err)
-	}
+	return &response, nil
+}
 
-	req.Header.Set("X-Api-Key", c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+// do performs the call, retrying transient failures with jittered exponential backoff when the
+// call is safe to repeat. The call as a whole, retries included, is bounded by the client's
+// budget, which expires before the request deadline so the caller still has time to record the
+// outcome, or to defer it, when Northwind is slow.
+func (c *northwindClient) do(ctx context.Context, call northwindCall, out interface{}) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "Northwind "+call.operation, attribute.String("northwind.operation", call.operation))
+	defer func() { telemetry.EndSpan(span, err) }()
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("northwind client: request to create external account failed: %w", err)
+	if c.budget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.budget)
+		defer cancel()
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("northwind client: create external account returned non-201 status: %d", resp.StatusCode)
+	var body []byte
+	if call.body != nil {
+		var err error
+		body, err = json.Marshal(call.body)
+		if err != nil {
+			return fmt.Errorf("northwind client: failed to marshal %s request: %w", call.operation, err)
+		}
 	}
 
-	var response dto.NorthwindExternalAccountResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("northwind client: failed to decode response body: %w", err)
+	attempts := 1
+	if call.method == http.MethodGet || call.idempotencyKey != "" {
+		attempts += c.maxRetries
 	}
 
-	return &response, nil
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			delay := c.retryDelay(attempt - 1)
+			c.logger.WarnContext(ctx, "retrying Northwind request", "operation", call.operation, "attempt", attempt, "delay", delay.String(), "error", err)
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return fmt.Errorf("northwind client: %s cancelled while waiting to retry: %w: %w", call.operation, ErrNorthwindUnavailable, ctx.Err())
+			case <-timer.C:
+			}
+		}
+
+		err = c.attempt(ctx, call, body, out)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrNorthwindUnavailable) || errors.Is(err, ErrCircuitBreakerOpen) || ctx.Err() != nil {
+			return err
+		}
+	}
+
+	return err
 }
 
-// InitiateTransfer starts a new transfer with the Northwind API.
-func (c *northwindClient) InitiateTransfer(ctx context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
-	requestBody, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("northwind client: failed to marshal transfer request: %w", err)
+// attempt sends the request once and records the outcome on the circuit breaker. Only transport
+// failures and 429/5xx responses count as failures; a 4xx means Northwind is healthy.
+func (c *northwindClient) attempt(ctx context.Context, call northwindCall, body []byte, out interface{}) error {
+	if c.circuitBreaker != nil && c.circuitBreaker.IsOpen() {
+		return fmt.Errorf("northwind client: %s: %w: %w", call.operation, ErrNorthwindUnavailable, ErrCircuitBreakerOpen)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/transfers", c.baseURL), bytes.NewBuffer(requestBody))
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, call.method, c.baseURL+call.path, reader)
 	if err != nil {
-		return nil, fmt.Errorf("northwind client: failed to create transfer request: %w", err)
+		return fmt.Errorf("northwind client: failed to create %s request: %w", call.operation, err)
 	}
 
-	httpReq.Header.Set("X-Api-Key", c.apiKey)
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Api-Key", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if call.idempotencyKey != "" {
+		req.Header.Set(northwindIdempotencyHeader, call.idempotencyKey)
+	}
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("northwind client: transfer request failed: %w", err)
+		if ctx.Err() == nil {
+			c.recordFailure()
+		}
+		return fmt.Errorf("northwind client: %s request failed: %w: %v", call.operation, ErrNorthwindUnavailable, err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("northwind client: initiate transfer returned non-201 status: %d", resp.StatusCode)
+	if resp.StatusCode != call.expectedStatus {
+		nwErr := c.parseError(call, resp)
+		if errors.Is(nwErr, ErrNorthwindUnavailable) {
+			c.recordFailure()
+		} else {
+			c.recordSuccess()
+		}
+		return nwErr
 	}
 
-	var response dto.NorthwindInitiateTransferResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("northwind client: failed to decode transfer response: %w", err)
+	c.recordSuccess()
+
+	if out != nil {
+		// Northwind accepted the request, so an unreadable body leaves the outcome unknown rather
+		// than rejected; callers must not undo work the partner may have done.
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("northwind client: failed to decode response body for %s: %w: %v", call.operation, ErrNorthwindUnavailable, err)
+		}
 	}
 
-	return &response, nil
+	return nil
 }
 
-// GetTransfer retrieves the status and details of a specific transfer from the Northwind API.
-func (c *northwindClient) GetTransfer(ctx context.Context, transferID string) (*dto.NorthwindGetTransferResponse, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/transfers/%s", c.baseURL, transferID), nil)
-	if err != nil {
-		return nil, fmt.Errorf("northwind client: failed to create get transfer request: %w", err)
+// parseError builds a NorthwindError from a non-success response, using the partner error body
+// when it is present and well formed.
+func (c *northwindClient) parseError(call northwindCall, resp *http.Response) *NorthwindError {
+	nwErr := &NorthwindError{
+		Operation:      call.operation,
+		StatusCode:     resp.StatusCode,
+		ExpectedStatus: call.expectedStatus,
 	}
 
-	req.Header.Set("X-Api-Key", c.apiKey)
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("northwind client: get transfer request failed: %w", err)
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxNorthwindErrorBodyBytes))
+	var body dto.NorthwindErrorResponse
+	if len(raw) > 0 && json.Unmarshal(raw, &body) == nil {
+		nwErr.Code = body.Code
+		nwErr.Message = body.Message
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("northwind client: get transfer returned non-200 status: %d", resp.StatusCode)
-	}
+	nwErr.kind = classifyNorthwindError(resp.StatusCode, nwErr.Code)
+	return nwErr
+}
 
-	var response dto.NorthwindGetTransferResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("northwind client: failed to decode get transfer response: %w", err)
+// retryDelay returns the backoff before the given retry: base * 2^(retry-1), capped at the
+// maximum, with the upper half randomised so concurrent callers do not retry in lockstep.
+func (c *northwindClient) retryDelay(retry int) time.Duration {
+	delay := c.retryBaseDelay
+	for i := 1; i < retry && (c.retryMaxDelay <= 0 || delay < c.retryMaxDelay); i++ {
+		delay *= 2
+	}
+	if c.retryMaxDelay > 0 && delay > c.retryMaxDelay {
+		delay = c.retryMaxDelay
+	}
+	if delay <= 0 {
+		return 0
 	}
 
-	return &response, nil
+	half := delay / 2
+	return half + rand.N(delay-half+1)
 }
+
+func (c *northwindClient) recordFailure() {
+	if c.circuitBreaker != nil {
+		c.circuitBreaker.RecordFailure()
+	}
 }
+
+func (c *northwindClient) recordSuccess() {
+	if c.circuitBreaker != nil {
+		c.circuitBreaker.RecordSuccess()
+	}
 }
@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -23,15 +26,28 @@ func TestNorthwindClientTestSuite(t *testing.T) {
 
 func (s *NorthwindClientTestSuite) TestNewNorthwindClient() {
 	apiKey := "my-secret-key"
-	client := NewNorthwindClient(apiKey)
+	client := NewNorthwindClient(config.NorthwindConfig{
+		BaseURL:    "https://northwind.example.com/api/v1",
+		APIKey:     apiKey,
+		Timeout:    3 * time.Second,
+		MaxRetries: 2,
+	}, NewCircuitBreaker(DefaultCircuitBreakerConfig()))
 	s.NotNil(client)
 
 	// Use type assertion to inspect internal fields
 	c, ok := client.(*northwindClient)
 	s.True(ok)
 	s.Equal(apiKey, c.apiKey)
-	s.Equal(northwindBaseURL, c.baseURL)
+	s.Equal("https://northwind.example.com/api/v1", c.baseURL)
 	s.NotNil(c.httpClient)
+	s.Equal(3*time.Second, c.httpClient.Timeout)
+	s.Equal(2, c.maxRetries)
+	s.NotNil(c.circuitBreaker)
+}
+
+func (s *NorthwindClientTestSuite) TestNewNorthwindClient_Defaults() {
+	c := NewNorthwindClient(config.NorthwindConfig{APIKey: "key"}, nil).(*northwindClient)
+	s.Equal(northwindBaseURL, c.baseURL)
 	s.Equal(defaultTimeout, c.httpClient.Timeout)
 }
 
@@ -75,7 +91,7 @@ func (s *NorthwindClientTestSuite) TestHealthCheck_APIError() {
 
 func (s *NorthwindClientTestSuite) TestHealthCheck_RequestFailure() {
 	// Intentionally don't start a server to simulate a network error
-	client := NewNorthwindClient("any-key")
+	client := NewNorthwindClient(config.NorthwindConfig{APIKey: "any-key"}, nil)
 	// Point to a non-existent server
 	client.(*northwindClient).baseURL = "http://127.0.0.1:9999"
 
@@ -155,6 +171,22 @@ func (s *NorthwindClientTestSuite) TestCreateExternalAccount_BadResponseBody() {
 	s.Error(err)
 	s.Nil(resp)
 	s.Contains(err.Error(), "northwind client: failed to decode response body")
+	s.ErrorIs(err, ErrNorthwindUnavailable)
+}
+
+func (s *NorthwindClientTestSuite) TestInitiateTransfer_UnreadableAcceptanceIsUnknown() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "txn_`))
+	}))
+	defer server.Close()
+
+	client := &northwindClient{httpClient: server.Client(), apiKey: "any-key", baseURL: server.URL + "/api/v1"}
+
+	// The transfer may have been created, so the error must not read as a rejection
+	resp, err := client.InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{Amount: "10.00"})
+	s.Nil(resp)
+	s.ErrorIs(err, ErrNorthwindUnavailable)
 }
 
 func (s *NorthwindClientTestSuite) TestInitiateTransfer_Success() {
@@ -251,4 +283,187 @@ func (s *NorthwindClientTestSuite) TestGetTransfer_APIError() {
 	s.Nil(resp)
 	s.Contains(err.Error(), "northwind client: get transfer returned non-200 status: 404")
 }
+
+// newRetryingClient returns a client for the test server that retries quickly
+func (s *NorthwindClientTestSuite) newRetryingClient(server *httptest.Server, breaker CircuitBreakerInterface) *northwindClient {
+	return NewNorthwindClient(config.NorthwindConfig{
+		BaseURL:        server.URL + "/api/v1",
+		APIKey:         "any-key",
+		MaxRetries:     3,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  5 * time.Millisecond,
+	}, breaker).(*northwindClient)
+}
+
+func (s *NorthwindClientTestSuite) TestGetTransfer_RetriesTransientFailures() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(dto.NorthwindGetTransferResponse{ID: "txn_1", Status: "completed"})
+	}))
+	defer server.Close()
+
+	resp, err := s.newRetryingClient(server, nil).GetTransfer(context.Background(), "txn_1")
+	s.NoError(err)
+	s.Equal("completed", resp.Status)
+	s.Equal(int32(3), atomic.LoadInt32(&calls))
+}
+
+func (s *NorthwindClientTestSuite) TestGetTransfer_StopsAtBudget() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := s.newRetryingClient(server, nil)
+	client.budget = 50 * time.Millisecond
+
+	start := time.Now()
+	_, err := client.GetTransfer(context.Background(), "txn_slow")
+	s.ErrorIs(err, ErrNorthwindUnavailable)
+	s.Less(time.Since(start), 500*time.Millisecond)
+	s.Equal(int32(1), atomic.LoadInt32(&calls))
+}
+
+func (s *NorthwindClientTestSuite) TestGetTransfer_DoesNotRetryClientErrors() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := s.newRetryingClient(server, nil).GetTransfer(context.Background(), "txn_missing")
+	s.ErrorIs(err, ErrNorthwindNotFound)
+	s.Equal(int32(1), atomic.LoadInt32(&calls))
+}
+
+func (s *NorthwindClientTestSuite) TestInitiateTransfer_RetriesWithIdempotencyKey() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("transfer-key-1", r.Header.Get("Idempotency-Key"))
+
+		var reqBody map[string]interface{}
+		s.NoError(json.NewDecoder(r.Body).Decode(&reqBody))
+		s.NotContains(reqBody, "IdempotencyKey")
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(dto.NorthwindInitiateTransferResponse{ID: "txn_1", Status: "processing"})
+	}))
+	defer server.Close()
+
+	resp, err := s.newRetryingClient(server, nil).InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{
+		SourceAccountID: "12345",
+		Amount:          "10.00",
+		IdempotencyKey:  "transfer-key-1",
+	})
+	s.NoError(err)
+	s.Equal("txn_1", resp.ID)
+	s.Equal(int32(2), atomic.LoadInt32(&calls))
+}
+
+func (s *NorthwindClientTestSuite) TestInitiateTransfer_NoRetryWithoutIdempotencyKey() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		s.Empty(r.Header.Get("Idempotency-Key"))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := s.newRetryingClient(server, nil).InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{
+		SourceAccountID: "12345",
+		Amount:          "10.00",
+	})
+	s.ErrorIs(err, ErrNorthwindUnavailable)
+	s.Equal(int32(1), atomic.LoadInt32(&calls))
+}
+
+func (s *NorthwindClientTestSuite) TestInitiateTransfer_TypedErrors() {
+	testCases := []struct {
+		name        string
+		status      int
+		body        string
+		expectedErr error
+	}{
+		{"validation", http.StatusUnprocessableEntity, `{"code":"validation_error","message":"amount is required"}`, ErrNorthwindValidation},
+		{"insufficient funds", http.StatusBadRequest, `{"code":"insufficient_funds","message":"balance too low"}`, ErrNorthwindInsufficientFunds},
+		{"not found", http.StatusNotFound, `{"code":"not_found","message":"no such account"}`, ErrNorthwindNotFound},
+		{"unavailable", http.StatusServiceUnavailable, ``, ErrNorthwindUnavailable},
+		{"rate limited", http.StatusTooManyRequests, ``, ErrNorthwindUnavailable},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			client := &northwindClient{httpClient: server.Client(), apiKey: "any-key", baseURL: server.URL + "/api/v1"}
+			_, err := client.InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{})
+			s.ErrorIs(err, tc.expectedErr)
+
+			var nwErr *NorthwindError
+			s.Require().ErrorAs(err, &nwErr)
+			s.Equal(tc.status, nwErr.StatusCode)
+			if tc.body != "" {
+				s.NotEmpty(nwErr.Message)
+				s.Contains(err.Error(), nwErr.Message)
+			}
+		})
+	}
+}
+
+func (s *NorthwindClientTestSuite) TestCircuitBreaker_OpensOnRepeatedFailures() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{MaxFailures: 2, ResetTimeout: time.Minute, HalfOpenMaxSucc: 1})
+	client := s.newRetryingClient(server, breaker)
+
+	_, err := client.GetTransfer(context.Background(), "txn_1")
+	s.ErrorIs(err, ErrNorthwindUnavailable)
+	s.ErrorIs(err, ErrCircuitBreakerOpen)
+	s.Equal(int32(2), atomic.LoadInt32(&calls))
+	s.Equal(StateOpen, breaker.GetState())
+
+	// While open, calls fail fast without reaching Northwind
+	s.Error(client.HealthCheck(context.Background()))
+	s.Equal(int32(2), atomic.LoadInt32(&calls))
+}
+
+func (s *NorthwindClientTestSuite) TestCircuitBreaker_ClientErrorsDoNotCount() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{MaxFailures: 1, ResetTimeout: time.Minute, HalfOpenMaxSucc: 1})
+	client := s.newRetryingClient(server, breaker)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.CreateExternalAccount(context.Background(), &dto.NorthwindCreateAccountRequest{})
+		s.ErrorIs(err, ErrNorthwindValidation)
+	}
+	s.Equal(StateClosed, breaker.GetState())
 }
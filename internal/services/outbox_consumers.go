@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+)
+
+// Outbox consumer names. They key the consumer checkpoints, so they must not change once deployed.
+const (
+	OutboxConsumerRegulatorWebhooks = "regulator_webhooks"
+	OutboxConsumerAudit             = "audit"
+	OutboxConsumerMetrics           = "metrics"
+	OutboxConsumerCustomerWebhooks  = "customer_webhooks"
+)
+
+// regulatorWebhookConsumer queues a regulator notification for every terminal transfer event.
+type regulatorWebhookConsumer struct {
+	webhookService WebhookServiceInterface
+}
+
+// NewRegulatorWebhookConsumer creates the consumer that feeds transfer events to the regulator
+// webhook queue. Redelivered events may queue a second notification for the same transfer.
+func NewRegulatorWebhookConsumer(webhookService WebhookServiceInterface) OutboxConsumerInterface {
+	return &regulatorWebhookConsumer{webhookService: webhookService}
+}
+
+func (c *regulatorWebhookConsumer) Name() string {
+	return OutboxConsumerRegulatorWebhooks
+}
+
+func (c *regulatorWebhookConsumer) HandleEvent(ctx context.Context, event *models.OutboxEvent) error {
+	if event.AggregateType != models.AggregateTypeTransfer {
+		return nil
+	}
+
+	status, _ := event.Payload["status"].(string)
+	return c.webhookService.QueueTransferNotification(ctx, &models.Transfer{ID: event.AggregateID, Status: status})
+}
+
+// auditEventConsumer records every domain event in the audit log.
+type auditEventConsumer struct {
+	auditRepo repositories.AuditLogRepositoryInterface
+}
+
+// NewAuditEventConsumer creates the consumer that keeps an audit trail of published events.
+func NewAuditEventConsumer(auditRepo repositories.AuditLogRepositoryInterface) OutboxConsumerInterface {
+	return &auditEventConsumer{auditRepo: auditRepo}
+}
+
+func (c *auditEventConsumer) Name() string {
+	return OutboxConsumerAudit
+}
+
+func (c *auditEventConsumer) HandleEvent(ctx context.Context, event *models.OutboxEvent) error {
+	metadata := models.JSONBMap{"event_id": event.EventID.String()}
+	for key, value := range event.Payload {
+		metadata[key] = value
+	}
+
+	if err := c.auditRepo.Create(ctx, &models.AuditLog{
+		Action:     "event." + event.EventType,
+		Resource:   event.AggregateType,
+		ResourceID: event.AggregateID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		return fmt.Errorf("failed to audit event: %w", err)
+	}
+	return nil
+}
+
+// metricsEventConsumer counts domain events by type.
+type metricsEventConsumer struct {
+	metrics MetricsRecorderInterface
+}
+
+// NewMetricsEventConsumer creates the consumer that counts published events.
+func NewMetricsEventConsumer(metrics MetricsRecorderInterface) OutboxConsumerInterface {
+	return &metricsEventConsumer{metrics: metrics}
+}
+
+func (c *metricsEventConsumer) Name() string {
+	return OutboxConsumerMetrics
+}
+
+func (c *metricsEventConsumer) HandleEvent(ctx context.Context, event *models.OutboxEvent) error {
+	c.metrics.IncrementCounter("domain_event", map[string]string{"event_type": event.EventType})
+	return nil
+}
+
+// customerWebhookConsumer queues customer webhook deliveries for account events.
+type customerWebhookConsumer struct {
+	customerWebhookService CustomerWebhookServiceInterface
+}
+
+// NewCustomerWebhookConsumer creates the consumer that feeds events to customer webhook subscriptions.
+func NewCustomerWebhookConsumer(customerWebhookService CustomerWebhookServiceInterface) OutboxConsumerInterface {
+	return &customerWebhookConsumer{customerWebhookService: customerWebhookService}
+}
+
+func (c *customerWebhookConsumer) Name() string {
+	return OutboxConsumerCustomerWebhooks
+}
+
+func (c *customerWebhookConsumer) HandleEvent(ctx context.Context, event *models.OutboxEvent) error {
+	return c.customerWebhookService.QueueEvent(ctx, event)
+}
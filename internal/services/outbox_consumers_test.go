@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxConsumersTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	webhookService *service_mocks.MockWebhookServiceInterface
+	auditRepo      *repository_mocks.MockAuditLogRepositoryInterface
+	metrics        *service_mocks.MockMetricsRecorderInterface
+	customerHooks  *service_mocks.MockCustomerWebhookServiceInterface
+	event          *models.OutboxEvent
+}
+
+func (s *OutboxConsumersTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.webhookService = service_mocks.NewMockWebhookServiceInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.metrics = service_mocks.NewMockMetricsRecorderInterface(s.ctrl)
+	s.customerHooks = service_mocks.NewMockCustomerWebhookServiceInterface(s.ctrl)
+
+	transfer := &models.Transfer{ID: uuid.New(), FromAccountID: uuid.New(), Amount: decimal.NewFromFloat(25)}
+	transfer.Fail("account closed")
+	s.event = models.NewTransferEvent(transfer)
+	s.event.EventID = uuid.New()
+}
+
+func (s *OutboxConsumersTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestOutboxConsumersTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxConsumersTestSuite))
+}
+
+func (s *OutboxConsumersTestSuite) TestRegulatorWebhookConsumer_QueuesNotification() {
+	consumer := NewRegulatorWebhookConsumer(s.webhookService)
+	s.webhookService.EXPECT().QueueTransferNotification(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transfer *models.Transfer) error {
+		s.Equal(s.event.AggregateID, transfer.ID)
+		s.Equal(models.TransferStatusFailed, transfer.Status)
+		return nil
+	})
+
+	s.Equal(OutboxConsumerRegulatorWebhooks, consumer.Name())
+	s.NoError(consumer.HandleEvent(context.Background(), s.event))
+}
+
+func (s *OutboxConsumersTestSuite) TestRegulatorWebhookConsumer_ReturnsQueueError() {
+	consumer := NewRegulatorWebhookConsumer(s.webhookService)
+	s.webhookService.EXPECT().QueueTransferNotification(gomock.Any(), gomock.Any()).Return(errors.New("database is down"))
+
+	s.Error(consumer.HandleEvent(context.Background(), s.event))
+}
+
+func (s *OutboxConsumersTestSuite) TestAuditEventConsumer_RecordsEvent() {
+	consumer := NewAuditEventConsumer(s.auditRepo)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("event.transfer.failed", log.Action)
+		s.Equal(models.AggregateTypeTransfer, log.Resource)
+		s.Equal(s.event.AggregateID.String(), log.ResourceID)
+		s.Equal(s.event.EventID.String(), log.Metadata["event_id"])
+		s.Equal("account closed", log.Metadata["reason"])
+		return nil
+	})
+
+	s.Equal(OutboxConsumerAudit, consumer.Name())
+	s.NoError(consumer.HandleEvent(context.Background(), s.event))
+}
+
+func (s *OutboxConsumersTestSuite) TestMetricsEventConsumer_CountsEvent() {
+	consumer := NewMetricsEventConsumer(s.metrics)
+	s.metrics.EXPECT().IncrementCounter("domain_event", map[string]string{"event_type": models.EventTypeTransferFailed})
+
+	s.Equal(OutboxConsumerMetrics, consumer.Name())
+	s.NoError(consumer.HandleEvent(context.Background(), s.event))
+}
+
+func (s *OutboxConsumersTestSuite) TestCustomerWebhookConsumer_QueuesDeliveries() {
+	consumer := NewCustomerWebhookConsumer(s.customerHooks)
+	s.customerHooks.EXPECT().QueueEvent(gomock.Any(), s.event).Return(errors.New("database is down"))
+
+	s.Equal(OutboxConsumerCustomerWebhooks, consumer.Name())
+	s.Error(consumer.HandleEvent(context.Background(), s.event))
+}
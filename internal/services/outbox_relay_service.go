@@ -0,0 +1,358 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+const (
+	outboxBatchLimit      = 100
+	outboxRetryBaseDelay  = 5 * time.Second
+	outboxRetryMaxDelay   = 5 * time.Minute
+	outboxMaxErrorMessage = 1000
+	// outboxMaxAttempts is how many times an event is tried before it is dead-lettered; with the
+	// backoff above that is roughly half an hour.
+	outboxMaxAttempts = 10
+	// outboxGapTimeout is how long a missing sequence number holds up delivery. Numbers are
+	// allocated when an event is inserted but only become visible when its transaction commits,
+	// so a gap younger than this may still fill; an older one is taken to be a rollback.
+	outboxGapTimeout = time.Minute
+)
+
+var (
+	ErrOutboxConsumerNotFound   = errors.New("outbox consumer not found")
+	ErrOutboxEventNotNext       = errors.New("event is not the consumer's next undelivered event")
+	ErrOutboxDeadLetterNotFound = errors.New("outbox dead letter not found")
+	ErrOutboxDeadLetterReplayed = errors.New("outbox dead letter already replayed")
+	ErrOutboxReplayFailed       = errors.New("outbox consumer failed to handle replayed event")
+)
+
+type outboxRelayService struct {
+	outboxRepo repositories.OutboxRepositoryInterface
+	auditRepo  repositories.AuditLogRepositoryInterface
+	consumers  []OutboxConsumerInterface
+	logger     *slog.Logger
+}
+
+// NewOutboxRelayService creates the relay that delivers outbox events to the given consumers.
+// Each consumer keeps its own checkpoint, so a failing consumer does not hold up the others.
+func NewOutboxRelayService(outboxRepo repositories.OutboxRepositoryInterface, auditRepo repositories.AuditLogRepositoryInterface, consumers ...OutboxConsumerInterface) OutboxRelayServiceInterface {
+	return &outboxRelayService{
+		outboxRepo: outboxRepo,
+		auditRepo:  auditRepo,
+		consumers:  consumers,
+		logger:     slog.Default().With("service", "OutboxRelay"),
+	}
+}
+
+// Relay delivers pending events to every consumer and returns how many deliveries succeeded.
+// Events are handled in sequence order; a failure stops that consumer at the failing event and
+// retries it with backoff. After outboxMaxAttempts failures the event is dead-lettered and the
+// consumer moves on. A gap in the sequence stops the consumer until the gap is older than
+// outboxGapTimeout, so events committed out of order are not skipped.
+func (s *outboxRelayService) Relay(ctx context.Context) int {
+	ctx, span := telemetry.StartSpan(ctx, "OutboxRelayService.Relay")
+	defer span.End()
+
+	delivered := 0
+	for _, consumer := range s.consumers {
+		if ctx.Err() != nil {
+			break
+		}
+		delivered += s.relayTo(ctx, consumer)
+	}
+	return delivered
+}
+
+func (s *outboxRelayService) relayTo(ctx context.Context, consumer OutboxConsumerInterface) int {
+	checkpoint, err := s.outboxRepo.GetCheckpoint(ctx, consumer.Name())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to load outbox checkpoint", "consumer", consumer.Name(), "error", err)
+		return 0
+	}
+
+	now := time.Now()
+	if checkpoint.NextAttemptAt != nil && now.Before(*checkpoint.NextAttemptAt) {
+		return 0 // Backing off after a failure
+	}
+
+	events, err := s.outboxRepo.FindAfter(ctx, checkpoint.LastEventID, outboxBatchLimit)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to fetch outbox events", "consumer", consumer.Name(), "error", err)
+		return 0
+	}
+
+	delivered := 0
+	for i := range events {
+		event := &events[i]
+		if ctx.Err() != nil {
+			break
+		}
+
+		if event.ID != checkpoint.LastEventID+1 {
+			if time.Since(event.CreatedAt) < outboxGapTimeout {
+				break // The missing event may still commit
+			}
+			s.logger.WarnContext(ctx, "skipping outbox sequence gap", "consumer", consumer.Name(), "after_sequence", checkpoint.LastEventID, "next_sequence", event.ID)
+		}
+
+		if err := consumer.HandleEvent(ctx, event); err != nil {
+			if !s.recordFailure(ctx, consumer, checkpoint, event, err) {
+				break
+			}
+			continue
+		}
+
+		checkpoint.LastEventID = event.ID
+		checkpoint.Attempts = 0
+		checkpoint.LastError = nil
+		checkpoint.NextAttemptAt = nil
+		if err := s.outboxRepo.SaveCheckpoint(ctx, checkpoint); err != nil {
+			if errors.Is(err, repositories.ErrOutboxCheckpointMoved) {
+				s.logger.InfoContext(ctx, "outbox checkpoint moved by an admin skip; stopping this run", "consumer", consumer.Name())
+				break
+			}
+			// The event was handled but will be delivered again; consumers tolerate redelivery
+			s.logger.ErrorContext(ctx, "failed to save outbox checkpoint", "consumer", consumer.Name(), "event_id", event.EventID, "error", err)
+			break
+		}
+		delivered++
+	}
+
+	return delivered
+}
+
+// recordFailure backs the consumer off after it failed to handle the event, or dead-letters the
+// event once it has used its attempts. It reports whether the consumer moved past the event.
+func (s *outboxRelayService) recordFailure(ctx context.Context, consumer OutboxConsumerInterface, checkpoint *models.OutboxCheckpoint, event *models.OutboxEvent, handleErr error) bool {
+	checkpoint.Attempts++
+	message := handleErr.Error()
+	if len(message) > outboxMaxErrorMessage {
+		message = message[:outboxMaxErrorMessage]
+	}
+	checkpoint.LastError = &message
+
+	if checkpoint.Attempts >= outboxMaxAttempts {
+		deadLetter := &models.OutboxDeadLetter{
+			Consumer:  consumer.Name(),
+			EventID:   event.ID,
+			Reason:    models.OutboxDeadLetterReasonMaxAttempts,
+			Attempts:  checkpoint.Attempts,
+			LastError: checkpoint.LastError,
+		}
+		checkpoint.LastEventID = event.ID
+		checkpoint.Attempts = 0
+		checkpoint.LastError = nil
+		checkpoint.NextAttemptAt = nil
+
+		s.logger.ErrorContext(ctx, "outbox consumer gave up on event; dead-lettered", "consumer", consumer.Name(), "event_id", event.EventID, "event_type", event.EventType, "attempts", deadLetter.Attempts, "error", handleErr)
+		if err := s.outboxRepo.DeadLetter(ctx, checkpoint, deadLetter); err != nil {
+			if errors.Is(err, repositories.ErrOutboxCheckpointMoved) {
+				s.logger.InfoContext(ctx, "outbox checkpoint moved by an admin skip; stopping this run", "consumer", consumer.Name())
+				return false
+			}
+			s.logger.ErrorContext(ctx, "failed to dead-letter outbox event", "consumer", consumer.Name(), "event_id", event.EventID, "error", err)
+			return false
+		}
+		return true
+	}
+
+	next := time.Now().Add(outboxRetryDelay(checkpoint.Attempts))
+	checkpoint.NextAttemptAt = &next
+
+	s.logger.WarnContext(ctx, "outbox consumer failed to handle event", "consumer", consumer.Name(), "event_id", event.EventID, "event_type", event.EventType, "attempt", checkpoint.Attempts, "next_attempt_at", next, "error", handleErr)
+	if err := s.outboxRepo.SaveCheckpoint(ctx, checkpoint); err != nil {
+		s.logger.ErrorContext(ctx, "failed to save outbox checkpoint", "consumer", consumer.Name(), "error", err)
+	}
+	return false
+}
+
+// ListConsumers reports each registered consumer's checkpoint and how far it is behind.
+func (s *outboxRelayService) ListConsumers(ctx context.Context) ([]dto.OutboxConsumerStatus, error) {
+	statuses := make([]dto.OutboxConsumerStatus, 0, len(s.consumers))
+	for _, consumer := range s.consumers {
+		checkpoint, err := s.outboxRepo.GetCheckpoint(ctx, consumer.Name())
+		if err != nil {
+			return nil, err
+		}
+		undelivered, err := s.outboxRepo.CountAfter(ctx, checkpoint.LastEventID)
+		if err != nil {
+			return nil, err
+		}
+		deadLetters, err := s.outboxRepo.CountDeadLetters(ctx, checkpoint.Consumer)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, dto.OutboxConsumerStatus{
+			Consumer:          checkpoint.Consumer,
+			LastEventSequence: checkpoint.LastEventID,
+			Undelivered:       undelivered,
+			DeadLetters:       deadLetters,
+			Attempts:          checkpoint.Attempts,
+			LastError:         checkpoint.LastError,
+			NextAttemptAt:     checkpoint.NextAttemptAt,
+			UpdatedAt:         checkpoint.UpdatedAt,
+		})
+	}
+	return statuses, nil
+}
+
+// ListUndeliveredEvents returns the events the consumer has not yet handled, oldest first.
+func (s *outboxRelayService) ListUndeliveredEvents(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxEvent, int64, error) {
+	if !s.hasConsumer(consumer) {
+		return nil, 0, ErrOutboxConsumerNotFound
+	}
+
+	checkpoint, err := s.outboxRepo.GetCheckpoint(ctx, consumer)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.outboxRepo.ListAfter(ctx, checkpoint.LastEventID, offset, limit)
+}
+
+// SkipEvent dead-letters the consumer's next undelivered event so delivery continues with the one
+// after it. The sequence must name that event, so an admin only skips the event they inspected.
+func (s *outboxRelayService) SkipEvent(ctx context.Context, adminID uuid.UUID, consumer string, sequence int64) (*models.OutboxDeadLetter, error) {
+	if !s.hasConsumer(consumer) {
+		return nil, ErrOutboxConsumerNotFound
+	}
+
+	checkpoint, err := s.outboxRepo.GetCheckpoint(ctx, consumer)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.outboxRepo.FindAfter(ctx, checkpoint.LastEventID, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 || events[0].ID != sequence {
+		return nil, ErrOutboxEventNotNext
+	}
+
+	deadLetter := &models.OutboxDeadLetter{
+		Consumer:  consumer,
+		EventID:   sequence,
+		Event:     events[0],
+		Reason:    models.OutboxDeadLetterReasonSkipped,
+		Attempts:  checkpoint.Attempts,
+		LastError: checkpoint.LastError,
+		SkippedBy: &adminID,
+	}
+	checkpoint.LastEventID = sequence
+	checkpoint.Attempts = 0
+	checkpoint.LastError = nil
+	checkpoint.NextAttemptAt = nil
+	if err := s.outboxRepo.DeadLetter(ctx, checkpoint, deadLetter); err != nil {
+		if errors.Is(err, repositories.ErrOutboxCheckpointMoved) {
+			return nil, ErrOutboxEventNotNext // Delivered or skipped concurrently
+		}
+		return nil, err
+	}
+
+	s.logger.WarnContext(ctx, "outbox event skipped by admin", "consumer", consumer, "event_id", deadLetter.Event.EventID, "sequence", sequence, "admin_id", adminID)
+	s.audit(ctx, adminID, "outbox_event.skipped", deadLetter)
+	return deadLetter, nil
+}
+
+// ListDeadLetters returns the consumer's dead letters that have not been replayed, oldest first.
+func (s *outboxRelayService) ListDeadLetters(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxDeadLetter, int64, error) {
+	if !s.hasConsumer(consumer) {
+		return nil, 0, ErrOutboxConsumerNotFound
+	}
+	return s.outboxRepo.ListDeadLetters(ctx, consumer, offset, limit)
+}
+
+// ReplayDeadLetter hands a dead-lettered event to its consumer again. The dead letter is marked
+// replayed only if the consumer handles it; otherwise it stays listed and the error is returned.
+func (s *outboxRelayService) ReplayDeadLetter(ctx context.Context, adminID uuid.UUID, consumerName string, sequence int64) (*models.OutboxDeadLetter, error) {
+	consumer := s.findConsumer(consumerName)
+	if consumer == nil {
+		return nil, ErrOutboxConsumerNotFound
+	}
+
+	deadLetter, err := s.outboxRepo.GetDeadLetter(ctx, consumerName, sequence)
+	if err != nil {
+		if errors.Is(err, repositories.ErrOutboxDeadLetterNotFound) {
+			return nil, ErrOutboxDeadLetterNotFound
+		}
+		return nil, err
+	}
+	if deadLetter.ReplayedAt != nil {
+		return nil, ErrOutboxDeadLetterReplayed
+	}
+
+	if err := consumer.HandleEvent(ctx, &deadLetter.Event); err != nil {
+		s.logger.WarnContext(ctx, "replayed outbox event failed", "consumer", consumerName, "sequence", sequence, "error", err)
+		return nil, fmt.Errorf("%w: %v", ErrOutboxReplayFailed, err)
+	}
+
+	now := time.Now()
+	deadLetter.ReplayedBy = &adminID
+	deadLetter.ReplayedAt = &now
+	if err := s.outboxRepo.MarkDeadLetterReplayed(ctx, deadLetter); err != nil {
+		if errors.Is(err, repositories.ErrOutboxDeadLetterReplayed) {
+			return nil, ErrOutboxDeadLetterReplayed
+		}
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "outbox dead letter replayed", "consumer", consumerName, "sequence", sequence, "admin_id", adminID)
+	s.audit(ctx, adminID, "outbox_event.replayed", deadLetter)
+	return deadLetter, nil
+}
+
+func (s *outboxRelayService) hasConsumer(name string) bool {
+	return s.findConsumer(name) != nil
+}
+
+func (s *outboxRelayService) findConsumer(name string) OutboxConsumerInterface {
+	for _, consumer := range s.consumers {
+		if consumer.Name() == name {
+			return consumer
+		}
+	}
+	return nil
+}
+
+func (s *outboxRelayService) audit(ctx context.Context, adminID uuid.UUID, action string, deadLetter *models.OutboxDeadLetter) {
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     action,
+		Resource:   "outbox_event",
+		ResourceID: strconv.FormatInt(deadLetter.EventID, 10),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata: models.JSONBMap{
+			"consumer":   deadLetter.Consumer,
+			"event_id":   deadLetter.Event.EventID.String(),
+			"event_type": deadLetter.Event.EventType,
+		},
+	}); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create audit log", "error", err, "action", action)
+	}
+}
+
+// outboxRetryDelay returns the backoff after the given number of consecutive failures:
+// base * 2^(attempts-1), capped at the maximum.
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxRetryBaseDelay
+	for i := 1; i < attempts && delay < outboxRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > outboxRetryMaxDelay {
+		delay = outboxRetryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,365 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxRelayServiceTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	outboxRepo *repository_mocks.MockOutboxRepositoryInterface
+	auditRepo  *repository_mocks.MockAuditLogRepositoryInterface
+	regulator  *service_mocks.MockOutboxConsumerInterface
+	audit      *service_mocks.MockOutboxConsumerInterface
+	service    OutboxRelayServiceInterface
+}
+
+func (s *OutboxRelayServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.outboxRepo = repository_mocks.NewMockOutboxRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.regulator = service_mocks.NewMockOutboxConsumerInterface(s.ctrl)
+	s.regulator.EXPECT().Name().Return(OutboxConsumerRegulatorWebhooks).AnyTimes()
+	s.audit = service_mocks.NewMockOutboxConsumerInterface(s.ctrl)
+	s.audit.EXPECT().Name().Return(OutboxConsumerAudit).AnyTimes()
+	s.service = NewOutboxRelayService(s.outboxRepo, s.auditRepo, s.regulator, s.audit)
+}
+
+func (s *OutboxRelayServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestOutboxRelayServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRelayServiceTestSuite))
+}
+
+func outboxEvents(ids ...int64) []models.OutboxEvent {
+	events := make([]models.OutboxEvent, len(ids))
+	for i, id := range ids {
+		events[i] = models.OutboxEvent{
+			ID:            id,
+			EventID:       uuid.New(),
+			EventType:     models.EventTypeTransferCompleted,
+			AggregateType: models.AggregateTypeTransfer,
+			AggregateID:   uuid.New(),
+		}
+	}
+	return events
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_DeliversInOrderAndAdvancesCheckpoint() {
+	events := outboxEvents(4, 5)
+	regulatorCheckpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, LastEventID: 3}
+	auditCheckpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerAudit, LastEventID: 5}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(regulatorCheckpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(3), outboxBatchLimit).Return(events, nil)
+	gomock.InOrder(
+		s.regulator.EXPECT().HandleEvent(gomock.Any(), &events[0]).Return(nil),
+		s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), regulatorCheckpoint).DoAndReturn(func(_ context.Context, c *models.OutboxCheckpoint) error {
+			s.Equal(int64(4), c.LastEventID)
+			return nil
+		}),
+		s.regulator.EXPECT().HandleEvent(gomock.Any(), &events[1]).Return(nil),
+		s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), regulatorCheckpoint).DoAndReturn(func(_ context.Context, c *models.OutboxCheckpoint) error {
+			s.Equal(int64(5), c.LastEventID)
+			return nil
+		}),
+	)
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(auditCheckpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(5), outboxBatchLimit).Return(nil, nil)
+
+	s.Equal(2, s.service.Relay(context.Background()))
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_FailureBacksOffWithoutBlockingOtherConsumers() {
+	events := outboxEvents(1, 2)
+	regulatorCheckpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, Attempts: 1}
+	auditCheckpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerAudit}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(regulatorCheckpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(0), outboxBatchLimit).Return(events, nil)
+	s.regulator.EXPECT().HandleEvent(gomock.Any(), &events[0]).Return(errors.New("queue unavailable"))
+	s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), regulatorCheckpoint).DoAndReturn(func(_ context.Context, c *models.OutboxCheckpoint) error {
+		s.Zero(c.LastEventID, "the failed event is retried")
+		s.Equal(2, c.Attempts)
+		s.Equal("queue unavailable", *c.LastError)
+		s.Require().NotNil(c.NextAttemptAt)
+		s.WithinDuration(time.Now().Add(2*outboxRetryBaseDelay), *c.NextAttemptAt, time.Second)
+		return nil
+	})
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(auditCheckpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(0), outboxBatchLimit).Return(events, nil)
+	s.audit.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), auditCheckpoint).Return(nil).Times(2)
+
+	s.Equal(2, s.service.Relay(context.Background()))
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_SuccessClearsFailureState() {
+	events := outboxEvents(1)
+	message := "timeout"
+	past := time.Now().Add(-time.Second)
+	checkpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, Attempts: 3, LastError: &message, NextAttemptAt: &past}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(checkpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(0), outboxBatchLimit).Return(events, nil)
+	s.regulator.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Return(nil)
+	s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), checkpoint).DoAndReturn(func(_ context.Context, c *models.OutboxCheckpoint) error {
+		s.Equal(int64(1), c.LastEventID)
+		s.Zero(c.Attempts)
+		s.Nil(c.LastError)
+		s.Nil(c.NextAttemptAt)
+		return nil
+	})
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(nil, errors.New("database is down"))
+
+	s.Equal(1, s.service.Relay(context.Background()))
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_SkipsConsumerWhileBackingOff() {
+	future := time.Now().Add(time.Minute)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(&models.OutboxCheckpoint{NextAttemptAt: &future}, nil)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(&models.OutboxCheckpoint{NextAttemptAt: &future}, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	s.Zero(s.service.Relay(context.Background()))
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_WaitsOnRecentSequenceGap() {
+	events := outboxEvents(3, 4)
+	events[0].CreatedAt = time.Now()
+	checkpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, LastEventID: 1}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(checkpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(1), outboxBatchLimit).Return(events, nil)
+	s.regulator.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Times(0)
+	s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), gomock.Any()).Times(0)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(nil, errors.New("database is down"))
+
+	s.Zero(s.service.Relay(context.Background()), "sequence 2 may still commit")
+	s.Equal(int64(1), checkpoint.LastEventID)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_SkipsStaleSequenceGap() {
+	events := outboxEvents(3)
+	events[0].CreatedAt = time.Now().Add(-2 * outboxGapTimeout)
+	checkpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, LastEventID: 1}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(checkpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(1), outboxBatchLimit).Return(events, nil)
+	s.regulator.EXPECT().HandleEvent(gomock.Any(), &events[0]).Return(nil)
+	s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), checkpoint).Return(nil)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(nil, errors.New("database is down"))
+
+	s.Equal(1, s.service.Relay(context.Background()))
+	s.Equal(int64(3), checkpoint.LastEventID)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_DeadLettersAfterMaxAttempts() {
+	events := outboxEvents(1, 2)
+	checkpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, Attempts: outboxMaxAttempts - 1}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(checkpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(0), outboxBatchLimit).Return(events, nil)
+	gomock.InOrder(
+		s.regulator.EXPECT().HandleEvent(gomock.Any(), &events[0]).Return(errors.New("payload rejected")),
+		s.outboxRepo.EXPECT().DeadLetter(gomock.Any(), checkpoint, gomock.Any()).DoAndReturn(func(_ context.Context, c *models.OutboxCheckpoint, dl *models.OutboxDeadLetter) error {
+			s.Equal(int64(1), c.LastEventID)
+			s.Zero(c.Attempts)
+			s.Nil(c.LastError)
+			s.Nil(c.NextAttemptAt)
+			s.Equal(OutboxConsumerRegulatorWebhooks, dl.Consumer)
+			s.Equal(int64(1), dl.EventID)
+			s.Equal(models.OutboxDeadLetterReasonMaxAttempts, dl.Reason)
+			s.Equal(outboxMaxAttempts, dl.Attempts)
+			s.Equal("payload rejected", *dl.LastError)
+			return nil
+		}),
+		s.regulator.EXPECT().HandleEvent(gomock.Any(), &events[1]).Return(nil),
+		s.outboxRepo.EXPECT().SaveCheckpoint(gomock.Any(), checkpoint).Return(nil),
+	)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(nil, errors.New("database is down"))
+
+	s.Equal(1, s.service.Relay(context.Background()))
+	s.Equal(int64(2), checkpoint.LastEventID)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestRelay_StopsWhenDeadLetterFails() {
+	events := outboxEvents(1, 2)
+	checkpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, Attempts: outboxMaxAttempts - 1}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(checkpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(0), outboxBatchLimit).Return(events, nil)
+	s.regulator.EXPECT().HandleEvent(gomock.Any(), &events[0]).Return(errors.New("payload rejected"))
+	s.outboxRepo.EXPECT().DeadLetter(gomock.Any(), checkpoint, gomock.Any()).Return(repositories.ErrOutboxCheckpointMoved)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(nil, errors.New("database is down"))
+
+	s.Zero(s.service.Relay(context.Background()))
+}
+
+func (s *OutboxRelayServiceTestSuite) TestSkipEvent() {
+	adminID := uuid.New()
+	events := outboxEvents(3)
+	message := "payload rejected"
+	checkpoint := &models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, LastEventID: 2, Attempts: 4, LastError: &message}
+
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(checkpoint, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(2), 1).Return(events, nil)
+	s.outboxRepo.EXPECT().DeadLetter(gomock.Any(), checkpoint, gomock.Any()).DoAndReturn(func(_ context.Context, c *models.OutboxCheckpoint, dl *models.OutboxDeadLetter) error {
+		s.Equal(int64(3), c.LastEventID)
+		s.Zero(c.Attempts)
+		s.Nil(c.LastError)
+		s.Equal(models.OutboxDeadLetterReasonSkipped, dl.Reason)
+		s.Equal(4, dl.Attempts)
+		s.Equal(&message, dl.LastError)
+		s.Equal(&adminID, dl.SkippedBy)
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal(&adminID, log.UserID)
+		s.Equal("outbox_event.skipped", log.Action)
+		s.Equal("3", log.ResourceID)
+		return nil
+	})
+
+	deadLetter, err := s.service.SkipEvent(context.Background(), adminID, OutboxConsumerRegulatorWebhooks, 3)
+	s.Require().NoError(err)
+	s.Equal(events[0].EventID, deadLetter.Event.EventID)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestSkipEvent_NotNextEvent() {
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(&models.OutboxCheckpoint{Consumer: OutboxConsumerAudit, LastEventID: 2}, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(2), 1).Return(outboxEvents(3), nil)
+
+	_, err := s.service.SkipEvent(context.Background(), uuid.New(), OutboxConsumerAudit, 4)
+	s.ErrorIs(err, ErrOutboxEventNotNext)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestSkipEvent_DeliveredConcurrently() {
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(&models.OutboxCheckpoint{Consumer: OutboxConsumerAudit, LastEventID: 2}, nil)
+	s.outboxRepo.EXPECT().FindAfter(gomock.Any(), int64(2), 1).Return(outboxEvents(3), nil)
+	s.outboxRepo.EXPECT().DeadLetter(gomock.Any(), gomock.Any(), gomock.Any()).Return(repositories.ErrOutboxCheckpointMoved)
+
+	_, err := s.service.SkipEvent(context.Background(), uuid.New(), OutboxConsumerAudit, 3)
+	s.ErrorIs(err, ErrOutboxEventNotNext)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestSkipEvent_UnknownConsumer() {
+	_, err := s.service.SkipEvent(context.Background(), uuid.New(), "unknown", 3)
+	s.ErrorIs(err, ErrOutboxConsumerNotFound)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestReplayDeadLetter() {
+	adminID := uuid.New()
+	deadLetter := &models.OutboxDeadLetter{Consumer: OutboxConsumerAudit, EventID: 3, Event: outboxEvents(3)[0]}
+
+	s.outboxRepo.EXPECT().GetDeadLetter(gomock.Any(), OutboxConsumerAudit, int64(3)).Return(deadLetter, nil)
+	gomock.InOrder(
+		s.audit.EXPECT().HandleEvent(gomock.Any(), &deadLetter.Event).Return(nil),
+		s.outboxRepo.EXPECT().MarkDeadLetterReplayed(gomock.Any(), deadLetter).Return(nil),
+	)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	replayed, err := s.service.ReplayDeadLetter(context.Background(), adminID, OutboxConsumerAudit, 3)
+	s.Require().NoError(err)
+	s.Equal(&adminID, replayed.ReplayedBy)
+	s.NotNil(replayed.ReplayedAt)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestReplayDeadLetter_ConsumerFails() {
+	deadLetter := &models.OutboxDeadLetter{Consumer: OutboxConsumerAudit, EventID: 3, Event: outboxEvents(3)[0]}
+
+	s.outboxRepo.EXPECT().GetDeadLetter(gomock.Any(), OutboxConsumerAudit, int64(3)).Return(deadLetter, nil)
+	s.audit.EXPECT().HandleEvent(gomock.Any(), &deadLetter.Event).Return(errors.New("payload rejected"))
+	s.outboxRepo.EXPECT().MarkDeadLetterReplayed(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.ReplayDeadLetter(context.Background(), uuid.New(), OutboxConsumerAudit, 3)
+	s.ErrorIs(err, ErrOutboxReplayFailed)
+	s.Contains(err.Error(), "payload rejected")
+}
+
+func (s *OutboxRelayServiceTestSuite) TestReplayDeadLetter_AlreadyReplayed() {
+	replayedAt := time.Now()
+	s.outboxRepo.EXPECT().GetDeadLetter(gomock.Any(), OutboxConsumerAudit, int64(3)).Return(&models.OutboxDeadLetter{ReplayedAt: &replayedAt}, nil)
+	s.audit.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.ReplayDeadLetter(context.Background(), uuid.New(), OutboxConsumerAudit, 3)
+	s.ErrorIs(err, ErrOutboxDeadLetterReplayed)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestReplayDeadLetter_ReplayedConcurrently() {
+	s.outboxRepo.EXPECT().GetDeadLetter(gomock.Any(), OutboxConsumerAudit, int64(3)).Return(&models.OutboxDeadLetter{}, nil)
+	s.audit.EXPECT().HandleEvent(gomock.Any(), gomock.Any()).Return(nil)
+	s.outboxRepo.EXPECT().MarkDeadLetterReplayed(gomock.Any(), gomock.Any()).Return(repositories.ErrOutboxDeadLetterReplayed)
+
+	_, err := s.service.ReplayDeadLetter(context.Background(), uuid.New(), OutboxConsumerAudit, 3)
+	s.ErrorIs(err, ErrOutboxDeadLetterReplayed)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestReplayDeadLetter_NotFound() {
+	s.outboxRepo.EXPECT().GetDeadLetter(gomock.Any(), OutboxConsumerAudit, int64(3)).Return(nil, repositories.ErrOutboxDeadLetterNotFound)
+
+	_, err := s.service.ReplayDeadLetter(context.Background(), uuid.New(), OutboxConsumerAudit, 3)
+	s.ErrorIs(err, ErrOutboxDeadLetterNotFound)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestReplayDeadLetter_UnknownConsumer() {
+	_, err := s.service.ReplayDeadLetter(context.Background(), uuid.New(), "unknown", 3)
+	s.ErrorIs(err, ErrOutboxConsumerNotFound)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestListConsumers() {
+	message := "timeout"
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(&models.OutboxCheckpoint{Consumer: OutboxConsumerRegulatorWebhooks, LastEventID: 2, Attempts: 1, LastError: &message}, nil)
+	s.outboxRepo.EXPECT().CountAfter(gomock.Any(), int64(2)).Return(int64(3), nil)
+	s.outboxRepo.EXPECT().CountDeadLetters(gomock.Any(), OutboxConsumerRegulatorWebhooks).Return(int64(1), nil)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(&models.OutboxCheckpoint{Consumer: OutboxConsumerAudit, LastEventID: 5}, nil)
+	s.outboxRepo.EXPECT().CountAfter(gomock.Any(), int64(5)).Return(int64(0), nil)
+	s.outboxRepo.EXPECT().CountDeadLetters(gomock.Any(), OutboxConsumerAudit).Return(int64(0), nil)
+
+	statuses, err := s.service.ListConsumers(context.Background())
+	s.Require().NoError(err)
+	s.Require().Len(statuses, 2)
+	s.Equal(OutboxConsumerRegulatorWebhooks, statuses[0].Consumer)
+	s.Equal(int64(3), statuses[0].Undelivered)
+	s.Equal(int64(1), statuses[0].DeadLetters)
+	s.Equal(&message, statuses[0].LastError)
+	s.Zero(statuses[1].Undelivered)
+	s.Zero(statuses[1].DeadLetters)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestListUndeliveredEvents() {
+	events := outboxEvents(3)
+	s.outboxRepo.EXPECT().GetCheckpoint(gomock.Any(), OutboxConsumerAudit).Return(&models.OutboxCheckpoint{Consumer: OutboxConsumerAudit, LastEventID: 2}, nil)
+	s.outboxRepo.EXPECT().ListAfter(gomock.Any(), int64(2), 0, 20).Return(events, int64(1), nil)
+
+	found, total, err := s.service.ListUndeliveredEvents(context.Background(), OutboxConsumerAudit, 0, 20)
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal(events, found)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestListUndeliveredEvents_UnknownConsumer() {
+	_, _, err := s.service.ListUndeliveredEvents(context.Background(), "unknown", 0, 20)
+	s.ErrorIs(err, ErrOutboxConsumerNotFound)
+}
+
+func (s *OutboxRelayServiceTestSuite) TestOutboxRetryDelay() {
+	s.Equal(outboxRetryBaseDelay, outboxRetryDelay(1))
+	s.Equal(4*outboxRetryBaseDelay, outboxRetryDelay(3))
+	s.Equal(outboxRetryMaxDelay, outboxRetryDelay(20))
+}
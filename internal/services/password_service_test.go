@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -282,10 +283,10 @@ func (s *PasswordServiceTestSuite) TestAdminResetPassword_Success() {
 	}
 
 	// Setup mock expectations BEFORE calling the service method
-	s.mockUserRepo.EXPECT().GetByIDActive(customerID).Return(testUser, nil).Times(1)
-	s.mockUserRepo.EXPECT().UpdatePasswordHash(customerID, gomock.Any()).Return(nil).Times(1)
+	s.mockUserRepo.EXPECT().GetByIDActive(gomock.Any(), customerID).Return(testUser, nil).Times(1)
+	s.mockUserRepo.EXPECT().UpdatePasswordHash(gomock.Any(), customerID, gomock.Any()).Return(nil).Times(1)
 
-	tempPassword, err := s.service.AdminResetPassword(customerID, adminID)
+	tempPassword, err := s.service.AdminResetPassword(context.Background(), customerID, adminID)
 	s.NoError(err)
 	s.NotEmpty(tempPassword)
 
@@ -297,7 +298,7 @@ func (s *PasswordServiceTestSuite) TestAdminResetPassword_Success() {
 func (s *PasswordServiceTestSuite) TestAdminResetPassword_NilCustomerID() {
 	adminID := uuid.New()
 
-	tempPassword, err := s.service.AdminResetPassword(uuid.Nil, adminID)
+	tempPassword, err := s.service.AdminResetPassword(context.Background(), uuid.Nil, adminID)
 	s.Error(err)
 	s.Empty(tempPassword)
 }
@@ -305,7 +306,7 @@ func (s *PasswordServiceTestSuite) TestAdminResetPassword_NilCustomerID() {
 func (s *PasswordServiceTestSuite) TestAdminResetPassword_NilAdminID() {
 	customerID := uuid.New()
 
-	tempPassword, err := s.service.AdminResetPassword(customerID, uuid.Nil)
+	tempPassword, err := s.service.AdminResetPassword(context.Background(), customerID, uuid.Nil)
 	s.Error(err)
 	s.Empty(tempPassword)
 }
@@ -315,9 +316,9 @@ func (s *PasswordServiceTestSuite) TestAdminResetPassword_CustomerNotFound() {
 	adminID := uuid.New()
 
 	// Setup mock to return user not found error
-	s.mockUserRepo.EXPECT().GetByIDActive(customerID).Return(nil, repositories.ErrUserNotFound).Times(1)
+	s.mockUserRepo.EXPECT().GetByIDActive(gomock.Any(), customerID).Return(nil, repositories.ErrUserNotFound).Times(1)
 
-	tempPassword, err := s.service.AdminResetPassword(customerID, adminID)
+	tempPassword, err := s.service.AdminResetPassword(context.Background(), customerID, adminID)
 	s.Error(err)
 	s.Empty(tempPassword)
 	s.ErrorIs(err, ErrCustomerNotFound)
@@ -344,10 +345,10 @@ func (s *PasswordServiceTestSuite) TestCustomerUpdatePassword_Success() {
 	}
 
 	// Setup mock expectations BEFORE calling the service method
-	s.mockUserRepo.EXPECT().GetByIDActive(customerID).Return(testUser, nil).Times(1)
-	s.mockUserRepo.EXPECT().UpdatePasswordHash(customerID, gomock.Any()).Return(nil).Times(1)
+	s.mockUserRepo.EXPECT().GetByIDActive(gomock.Any(), customerID).Return(testUser, nil).Times(1)
+	s.mockUserRepo.EXPECT().UpdatePasswordHash(gomock.Any(), customerID, gomock.Any()).Return(nil).Times(1)
 
-	err = s.service.CustomerUpdatePassword(customerID, currentPassword, newPassword)
+	err = s.service.CustomerUpdatePassword(context.Background(), customerID, currentPassword, newPassword)
 	s.NoError(err)
 }
 
@@ -372,9 +373,9 @@ func (s *PasswordServiceTestSuite) TestCustomerUpdatePassword_WrongCurrentPasswo
 	}
 
 	// Setup mock expectations - user exists but password won't match
-	s.mockUserRepo.EXPECT().GetByIDActive(customerID).Return(testUser, nil).Times(1)
+	s.mockUserRepo.EXPECT().GetByIDActive(gomock.Any(), customerID).Return(testUser, nil).Times(1)
 
-	err = s.service.CustomerUpdatePassword(customerID, wrongPassword, newPassword)
+	err = s.service.CustomerUpdatePassword(context.Background(), customerID, wrongPassword, newPassword)
 	s.Error(err)
 	s.ErrorIs(err, ErrCurrentPasswordWrong)
 }
@@ -383,7 +384,7 @@ func (s *PasswordServiceTestSuite) TestCustomerUpdatePassword_SamePassword() {
 	customerID := uuid.New()
 	currentPassword := "CurrentP@ssw0rd123"
 
-	err := s.service.CustomerUpdatePassword(customerID, currentPassword, currentPassword)
+	err := s.service.CustomerUpdatePassword(context.Background(), customerID, currentPassword, currentPassword)
 	s.Error(err)
 }
 
@@ -392,12 +393,12 @@ func (s *PasswordServiceTestSuite) TestCustomerUpdatePassword_WeakNewPassword()
 	currentPassword := "CurrentP@ssw0rd123"
 	weakPassword := "weak"
 
-	err := s.service.CustomerUpdatePassword(customerID, currentPassword, weakPassword)
+	err := s.service.CustomerUpdatePassword(context.Background(), customerID, currentPassword, weakPassword)
 	s.Error(err)
 }
 
 func (s *PasswordServiceTestSuite) TestCustomerUpdatePassword_NilCustomerID() {
-	err := s.service.CustomerUpdatePassword(uuid.Nil, "CurrentP@ssw0rd123", "NewP@ssw0rd12345")
+	err := s.service.CustomerUpdatePassword(context.Background(), uuid.Nil, "CurrentP@ssw0rd123", "NewP@ssw0rd12345")
 	s.Error(err)
 }
 
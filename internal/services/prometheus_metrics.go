@@ -10,7 +10,7 @@ import (
 type PrometheusMetrics struct {
 	transactionProcessed        *prometheus.CounterVec
 	transactionDuration         prometheus.Histogram
-	queueDepth                  *prometheus.GaugeVec
+	queueEnqueued               *prometheus.CounterVec
 	retryAttempts               *prometheus.CounterVec
 	circuitBreakerState         *prometheus.GaugeVec
 	transfersTotal              *prometheus.CounterVec
@@ -24,6 +24,11 @@ type PrometheusMetrics struct {
 	accountOwnershipTransferred prometheus.Counter
 	activeCustomersTotal        prometheus.Gauge
 	authenticationEventsTotal   *prometheus.CounterVec
+	domainEventsTotal           *prometheus.CounterVec
+	webhookNotifications        *prometheus.GaugeVec
+	webhookDeadLetteredTotal    prometheus.Counter
+	webhookDeadLetterAlert      prometheus.Gauge
+	queueLeaseEvents            *prometheus.CounterVec
 }
 
 func NewPrometheusMetrics() MetricsRecorderInterface {
@@ -42,12 +47,12 @@ func NewPrometheusMetrics() MetricsRecorderInterface {
 				Buckets: prometheus.ExponentialBuckets(1, 2, 12),
 			},
 		),
-		queueDepth: promauto.NewGaugeVec(
-			prometheus.GaugeOpts{
-				Name: "transaction_queue_depth",
-				Help: "Current depth of transaction processing queue",
+		queueEnqueued: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "transaction_queue_enqueued_total",
+				Help: "Total number of items added to the transaction processing queue; depth is reported by the backlog collector",
 			},
-			[]string{"status"},
+			[]string{"operation"},
 		),
 		retryAttempts: promauto.NewCounterVec(
 			prometheus.CounterOpts{
@@ -136,6 +141,39 @@ func NewPrometheusMetrics() MetricsRecorderInterface {
 			},
 			[]string{"event_type"},
 		),
+		domainEventsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "domain_events_total",
+				Help: "Total number of domain events relayed from the outbox",
+			},
+			[]string{"event_type"},
+		),
+		webhookNotifications: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "regulator_webhook_notifications",
+				Help: "Current number of regulator webhook notifications by status; dead_lettered is the DLQ depth",
+			},
+			[]string{"status"},
+		),
+		webhookDeadLetteredTotal: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Name: "regulator_webhook_dead_lettered_total",
+				Help: "Total number of regulator webhook notifications moved to the dead-letter queue",
+			},
+		),
+		webhookDeadLetterAlert: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "regulator_webhook_dead_letter_alert",
+				Help: "1 while the regulator webhook DLQ depth is at or above the alert threshold, otherwise 0",
+			},
+		),
+		queueLeaseEvents: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "processing_queue_lease_events_total",
+				Help: "Processing queue leases that expired and were reaped, or were lost by the worker holding them",
+			},
+			[]string{"event"},
+		),
 	}
 }
 
@@ -154,7 +192,7 @@ func (m *PrometheusMetrics) IncrementCounter(name string, tags map[string]string
 	case "transaction.duplicate.rejected":
 		m.transactionProcessed.WithLabelValues("", "duplicate").Inc()
 	case "queue.enqueued":
-		m.queueDepth.WithLabelValues("pending").Inc()
+		m.queueEnqueued.WithLabelValues(operation).Inc()
 	case "circuit_breaker.open":
 		m.circuitBreakerState.WithLabelValues(tags["service"]).Set(1)
 	case "transfers_total":
@@ -179,6 +217,16 @@ func (m *PrometheusMetrics) IncrementCounter(name string, tags map[string]string
 		if eventType := tags["event_type"]; eventType != "" {
 			m.authenticationEventsTotal.WithLabelValues(eventType).Inc()
 		}
+	case "domain_event":
+		if eventType := tags["event_type"]; eventType != "" {
+			m.domainEventsTotal.WithLabelValues(eventType).Inc()
+		}
+	case "webhook.dead_lettered":
+		m.webhookDeadLetteredTotal.Inc()
+	case "queue.lease":
+		if event := tags["event"]; event != "" {
+			m.queueLeaseEvents.WithLabelValues(event).Inc()
+		}
 	}
 }
 
@@ -200,15 +248,11 @@ func (m *PrometheusMetrics) RecordGauge(name string, value float64, tags map[str
 		m.transferAmount.Observe(value)
 	case "active_customers":
 		m.activeCustomersTotal.Set(value)
-	default:
+	case "webhook_notifications":
 		if status != "" {
-			m.queueDepth.WithLabelValues(status).Set(value)
+			m.webhookNotifications.WithLabelValues(status).Set(value)
 		}
+	case "webhook_dead_letter_alert":
+		m.webhookDeadLetterAlert.Set(value)
 	}
 }
-
-func (m *PrometheusMetrics) UpdateQueueMetrics(pending, processing, failed int64) {
-	m.queueDepth.WithLabelValues("pending").Set(float64(pending))
-	m.queueDepth.WithLabelValues("processing").Set(float64(processing))
-	m.queueDepth.WithLabelValues("failed").Set(float64(failed))
-}
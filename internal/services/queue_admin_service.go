@@ -0,0 +1,322 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/google/uuid"
+)
+
+// queueRetryLimit caps the items retried by a bulk request that selects by status.
+const queueRetryLimit = 100
+
+var (
+	ErrQueueItemNotFound     = errors.New("queue item not found")
+	ErrQueueItemInvalidState = errors.New("queue item is not in a state that allows this action")
+)
+
+type queueAdminService struct {
+	queueRepo         repositories.ProcessingQueueRepositoryInterface
+	auditRepo         repositories.AuditLogRepositoryInterface
+	processingService TransactionProcessingServiceInterface
+	logger            *slog.Logger
+	now               func() time.Time
+}
+
+func NewQueueAdminService(
+	queueRepo repositories.ProcessingQueueRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	processingService TransactionProcessingServiceInterface,
+) QueueAdminServiceInterface {
+	return &queueAdminService{
+		queueRepo:         queueRepo,
+		auditRepo:         auditRepo,
+		processingService: processingService,
+		logger:            slog.Default().With("service", "QueueAdminService"),
+		now:               time.Now,
+	}
+}
+
+// GetMetrics returns queue depth by status, processing times and whether workers are paused.
+func (s *queueAdminService) GetMetrics(ctx context.Context) (*dto.QueueMetrics, error) {
+	return s.processingService.GetQueueMetrics(ctx)
+}
+
+// ListItems lists queue items matching the filters, oldest first.
+func (s *queueAdminService) ListItems(ctx context.Context, filters models.ProcessingQueueFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
+	return s.queueRepo.List(ctx, filters, offset, limit)
+}
+
+// GetItem returns a single queue item.
+func (s *queueAdminService) GetItem(ctx context.Context, itemID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	item, err := s.queueRepo.GetByID(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrQueueItemNotFound) {
+			return nil, ErrQueueItemNotFound
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+// RetryItem returns a pending, failed or dead-lettered item to the queue straight away with a
+// fresh set of retries.
+func (s *queueAdminService) RetryItem(ctx context.Context, adminID, itemID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	return s.RescheduleItem(ctx, adminID, itemID, s.now())
+}
+
+// RescheduleItem returns a pending, failed or dead-lettered item to the queue at scheduledAt
+// with a fresh set of retries.
+func (s *queueAdminService) RescheduleItem(ctx context.Context, adminID, itemID uuid.UUID, scheduledAt time.Time) (*models.ProcessingQueueItem, error) {
+	item, err := s.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requeue(ctx, adminID, item, scheduledAt); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// RetryItems requeues the requested items, or the oldest in the requested status. Items that
+// cannot be requeued are skipped with the reason.
+func (s *queueAdminService) RetryItems(ctx context.Context, adminID uuid.UUID, req *dto.RetryQueueItemsRequest) (*dto.RetryQueueItemsResponse, error) {
+	result := &dto.RetryQueueItemsResponse{
+		Requeued: []uuid.UUID{},
+		Skipped:  []dto.QueueItemRetrySkip{},
+	}
+
+	scheduledAt := s.now()
+	if req.ScheduledAt != nil {
+		scheduledAt = *req.ScheduledAt
+	}
+
+	if req.Status != "" {
+		items, _, err := s.queueRepo.List(ctx, models.ProcessingQueueFilters{Statuses: []string{req.Status}}, 0, queueRetryLimit)
+		if err != nil {
+			return nil, err
+		}
+		for i := range items {
+			if err := s.requeueInto(ctx, result, adminID, &items[i], scheduledAt); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	for _, id := range req.ItemIDs {
+		item, err := s.GetItem(ctx, id)
+		if err != nil {
+			if !errors.Is(err, ErrQueueItemNotFound) {
+				return nil, err
+			}
+			result.Skipped = append(result.Skipped, dto.QueueItemRetrySkip{ID: id, Reason: err.Error()})
+			continue
+		}
+		if err := s.requeueInto(ctx, result, adminID, item, scheduledAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// CancelItem cancels a pending, failed or dead-lettered item so it is never processed. The
+// queued transaction is left unchanged.
+func (s *queueAdminService) CancelItem(ctx context.Context, adminID, itemID uuid.UUID, note string) (*models.ProcessingQueueItem, error) {
+	item, err := s.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if !item.CanCancel() {
+		return nil, fmt.Errorf("%w: status is %s", ErrQueueItemInvalidState, item.Status)
+	}
+	if err := s.close(ctx, adminID, item, models.QueueStatusCancelled, note); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// DeadLetterItem parks a pending or failed item in the dead-letter state, where workers leave it
+// until an operator retries or cancels it.
+func (s *queueAdminService) DeadLetterItem(ctx context.Context, adminID, itemID uuid.UUID, note string) (*models.ProcessingQueueItem, error) {
+	item, err := s.GetItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if !item.CanDeadLetter() {
+		return nil, fmt.Errorf("%w: status is %s", ErrQueueItemInvalidState, item.Status)
+	}
+	if err := s.close(ctx, adminID, item, models.QueueStatusDeadLettered, note); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// GetWorkerStatus returns whether the queue workers are paused, and by whom.
+func (s *queueAdminService) GetWorkerStatus(ctx context.Context) (*models.ProcessingQueueControl, error) {
+	return s.queueRepo.GetControl(ctx)
+}
+
+// PauseWorkers stops every worker from claiming new items. Items already being processed finish.
+func (s *queueAdminService) PauseWorkers(ctx context.Context, adminID uuid.UUID, reason string) (*models.ProcessingQueueControl, error) {
+	control, err := s.queueRepo.GetControl(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	control.Paused = true
+	control.PausedBy = &adminID
+	control.PausedAt = &now
+	control.PauseReason = reason
+	if err := s.queueRepo.SaveControl(ctx, control); err != nil {
+		return nil, err
+	}
+
+	s.logger.WarnContext(ctx, "processing queue workers paused", "admin_id", adminID, "reason", reason)
+	s.auditQueue(ctx, adminID, "processing_queue.paused", models.JSONBMap{"reason": reason})
+
+	return control, nil
+}
+
+// ResumeWorkers lets the workers claim items again.
+func (s *queueAdminService) ResumeWorkers(ctx context.Context, adminID uuid.UUID) (*models.ProcessingQueueControl, error) {
+	control, err := s.queueRepo.GetControl(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := models.JSONBMap{"was_paused": control.Paused}
+	if control.PausedAt != nil {
+		metadata["paused_for"] = s.now().Sub(*control.PausedAt).String()
+	}
+
+	control.Paused = false
+	control.PausedBy = nil
+	control.PausedAt = nil
+	control.PauseReason = ""
+	if err := s.queueRepo.SaveControl(ctx, control); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "processing queue workers resumed", "admin_id", adminID)
+	s.auditQueue(ctx, adminID, "processing_queue.resumed", metadata)
+
+	return control, nil
+}
+
+// CleanupCompleted deletes completed items processed more than olderThan ago.
+func (s *queueAdminService) CleanupCompleted(ctx context.Context, adminID uuid.UUID, olderThan time.Duration) (int64, error) {
+	deleted, err := s.queueRepo.CleanupCompleted(ctx, olderThan)
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.InfoContext(ctx, "cleaned up completed queue items", "admin_id", adminID, "deleted", deleted)
+	s.auditQueue(ctx, adminID, "processing_queue.cleaned_up", models.JSONBMap{
+		"older_than": olderThan.String(),
+		"deleted":    deleted,
+	})
+
+	return deleted, nil
+}
+
+// requeueInto requeues one item of a bulk request, recording it as skipped when it is not in a
+// requeueable state. Other errors abort the request.
+func (s *queueAdminService) requeueInto(ctx context.Context, result *dto.RetryQueueItemsResponse, adminID uuid.UUID, item *models.ProcessingQueueItem, scheduledAt time.Time) error {
+	if err := s.requeue(ctx, adminID, item, scheduledAt); err != nil {
+		if !errors.Is(err, ErrQueueItemInvalidState) {
+			return err
+		}
+		result.Skipped = append(result.Skipped, dto.QueueItemRetrySkip{ID: item.ID, Reason: err.Error()})
+		return nil
+	}
+	result.Requeued = append(result.Requeued, item.ID)
+	return nil
+}
+
+func (s *queueAdminService) requeue(ctx context.Context, adminID uuid.UUID, item *models.ProcessingQueueItem, scheduledAt time.Time) error {
+	if !item.CanRequeue() {
+		return fmt.Errorf("%w: status is %s", ErrQueueItemInvalidState, item.Status)
+	}
+
+	previousStatus, previousRetries := item.Status, item.RetryCount
+	if err := s.queueRepo.Requeue(ctx, item.ID, previousStatus, scheduledAt); err != nil {
+		return s.mapStateChanged(err)
+	}
+
+	item.Status = models.QueueStatusPending
+	item.RetryCount = 0
+	item.ScheduledAt = scheduledAt
+	item.ErrorMessage = ""
+	item.ProcessedAt = nil
+
+	s.logger.Info("requeued processing queue item", "queue_item_id", item.ID, "admin_id", adminID, "scheduled_at", scheduledAt)
+	s.audit(ctx, adminID, item, "processing_queue_item.requeued", models.JSONBMap{
+		"previous_status":      previousStatus,
+		"previous_retry_count": previousRetries,
+		"scheduled_at":         scheduledAt.Format(time.RFC3339),
+	})
+
+	return nil
+}
+
+func (s *queueAdminService) close(ctx context.Context, adminID uuid.UUID, item *models.ProcessingQueueItem, status, note string) error {
+	previousStatus := item.Status
+	if err := s.queueRepo.Close(ctx, item.ID, previousStatus, status); err != nil {
+		return s.mapStateChanged(err)
+	}
+
+	now := s.now()
+	item.Status = status
+	item.ProcessedAt = &now
+
+	s.logger.Info("closed processing queue item", "queue_item_id", item.ID, "admin_id", adminID, "status", status)
+	s.audit(ctx, adminID, item, "processing_queue_item."+status, models.JSONBMap{
+		"previous_status": previousStatus,
+		"note":            note,
+	})
+
+	return nil
+}
+
+// mapStateChanged reports an item a worker or another operator moved on concurrently as being in
+// an invalid state for the action.
+func (s *queueAdminService) mapStateChanged(err error) error {
+	if errors.Is(err, repositories.ErrQueueItemStateChanged) {
+		return fmt.Errorf("%w: %s", ErrQueueItemInvalidState, err.Error())
+	}
+	return err
+}
+
+func (s *queueAdminService) audit(ctx context.Context, adminID uuid.UUID, item *models.ProcessingQueueItem, action string, metadata models.JSONBMap) {
+	metadata["transaction_id"] = item.TransactionID.String()
+	metadata["operation"] = item.Operation
+	s.createAudit(ctx, adminID, action, "processing_queue_item", item.ID.String(), metadata)
+}
+
+func (s *queueAdminService) auditQueue(ctx context.Context, adminID uuid.UUID, action string, metadata models.JSONBMap) {
+	s.createAudit(ctx, adminID, action, "processing_queue", "transaction_processing_queue", metadata)
+}
+
+func (s *queueAdminService) createAudit(ctx context.Context, adminID uuid.UUID, action, resource, resourceID string, metadata models.JSONBMap) {
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     action,
+		Resource:   resource,
+		ResourceID: resourceID,
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
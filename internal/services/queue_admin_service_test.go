@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type QueueAdminServiceTestSuite struct {
+	suite.Suite
+	ctrl              *gomock.Controller
+	queueRepo         *repository_mocks.MockProcessingQueueRepositoryInterface
+	auditRepo         *repository_mocks.MockAuditLogRepositoryInterface
+	processingService *service_mocks.MockTransactionProcessingServiceInterface
+	service           *queueAdminService
+	now               time.Time
+	adminID           uuid.UUID
+}
+
+func (s *QueueAdminServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.queueRepo = repository_mocks.NewMockProcessingQueueRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.processingService = service_mocks.NewMockTransactionProcessingServiceInterface(s.ctrl)
+	s.service = NewQueueAdminService(s.queueRepo, s.auditRepo, s.processingService).(*queueAdminService)
+
+	s.now = time.Date(2026, 3, 6, 15, 0, 0, 0, time.UTC)
+	s.service.now = func() time.Time { return s.now }
+	s.adminID = uuid.New()
+}
+
+func (s *QueueAdminServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestQueueAdminServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(QueueAdminServiceTestSuite))
+}
+
+func (s *QueueAdminServiceTestSuite) queueItem(status string) *models.ProcessingQueueItem {
+	return &models.ProcessingQueueItem{
+		ID:            uuid.New(),
+		TransactionID: uuid.New(),
+		Operation:     models.QueueOperationProcess,
+		Status:        status,
+		RetryCount:    3,
+		MaxRetries:    3,
+		ErrorMessage:  "max retries exceeded",
+	}
+}
+
+func (s *QueueAdminServiceTestSuite) TestRetryItem_RequeuesNowAndAudits() {
+	item := s.queueItem(models.QueueStatusFailed)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+	s.queueRepo.EXPECT().Requeue(gomock.Any(), item.ID, models.QueueStatusFailed, s.now).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("processing_queue_item.requeued", log.Action)
+		s.Equal(item.ID.String(), log.ResourceID)
+		s.Equal(&s.adminID, log.UserID)
+		s.Equal(models.QueueStatusFailed, log.Metadata["previous_status"])
+		s.Equal(3, log.Metadata["previous_retry_count"])
+		s.Equal(item.TransactionID.String(), log.Metadata["transaction_id"])
+		return nil
+	})
+
+	requeued, err := s.service.RetryItem(context.Background(), s.adminID, item.ID)
+	s.Require().NoError(err)
+	s.Equal(models.QueueStatusPending, requeued.Status)
+	s.Zero(requeued.RetryCount)
+	s.Empty(requeued.ErrorMessage)
+	s.Equal(s.now, requeued.ScheduledAt)
+}
+
+func (s *QueueAdminServiceTestSuite) TestRescheduleItem_UsesScheduledTime() {
+	item := s.queueItem(models.QueueStatusDeadLettered)
+	scheduledAt := s.now.Add(2 * time.Hour)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+	s.queueRepo.EXPECT().Requeue(gomock.Any(), item.ID, models.QueueStatusDeadLettered, scheduledAt).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	requeued, err := s.service.RescheduleItem(context.Background(), s.adminID, item.ID, scheduledAt)
+	s.Require().NoError(err)
+	s.Equal(scheduledAt, requeued.ScheduledAt)
+}
+
+func (s *QueueAdminServiceTestSuite) TestRetryItem_InvalidStates() {
+	for _, status := range []string{models.QueueStatusProcessing, models.QueueStatusCompleted, models.QueueStatusCancelled} {
+		item := s.queueItem(status)
+		s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+
+		_, err := s.service.RetryItem(context.Background(), s.adminID, item.ID)
+		s.ErrorIs(err, ErrQueueItemInvalidState, status)
+	}
+}
+
+func (s *QueueAdminServiceTestSuite) TestRetryItem_ClaimedConcurrently() {
+	item := s.queueItem(models.QueueStatusPending)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+	s.queueRepo.EXPECT().Requeue(gomock.Any(), item.ID, models.QueueStatusPending, s.now).Return(repositories.ErrQueueItemStateChanged)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.RetryItem(context.Background(), s.adminID, item.ID)
+	s.ErrorIs(err, ErrQueueItemInvalidState)
+}
+
+func (s *QueueAdminServiceTestSuite) TestGetItem_NotFound() {
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrQueueItemNotFound)
+
+	_, err := s.service.GetItem(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrQueueItemNotFound)
+}
+
+func (s *QueueAdminServiceTestSuite) TestRetryItems_ByID_SkipsMissingAndInvalid() {
+	failed := s.queueItem(models.QueueStatusFailed)
+	completed := s.queueItem(models.QueueStatusCompleted)
+	missingID := uuid.New()
+	scheduledAt := s.now.Add(time.Hour)
+
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), failed.ID).Return(failed, nil)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), completed.ID).Return(completed, nil)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), missingID).Return(nil, repositories.ErrQueueItemNotFound)
+	s.queueRepo.EXPECT().Requeue(gomock.Any(), failed.ID, models.QueueStatusFailed, scheduledAt).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	result, err := s.service.RetryItems(context.Background(), s.adminID, &dto.RetryQueueItemsRequest{
+		ItemIDs:     []uuid.UUID{failed.ID, completed.ID, missingID},
+		ScheduledAt: &scheduledAt,
+	})
+	s.Require().NoError(err)
+	s.Equal([]uuid.UUID{failed.ID}, result.Requeued)
+	s.Require().Len(result.Skipped, 2)
+	s.Equal(completed.ID, result.Skipped[0].ID)
+	s.Contains(result.Skipped[0].Reason, "status is completed")
+	s.Equal(missingID, result.Skipped[1].ID)
+}
+
+func (s *QueueAdminServiceTestSuite) TestRetryItems_ByStatus() {
+	items := []models.ProcessingQueueItem{*s.queueItem(models.QueueStatusDeadLettered), *s.queueItem(models.QueueStatusDeadLettered)}
+	s.queueRepo.EXPECT().List(gomock.Any(), models.ProcessingQueueFilters{Statuses: []string{models.QueueStatusDeadLettered}}, 0, queueRetryLimit).Return(items, int64(2), nil)
+	s.queueRepo.EXPECT().Requeue(gomock.Any(), gomock.Any(), models.QueueStatusDeadLettered, s.now).Return(nil).Times(2)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	result, err := s.service.RetryItems(context.Background(), s.adminID, &dto.RetryQueueItemsRequest{Status: models.QueueStatusDeadLettered})
+	s.Require().NoError(err)
+	s.Len(result.Requeued, 2)
+	s.Empty(result.Skipped)
+}
+
+func (s *QueueAdminServiceTestSuite) TestRetryItems_RepositoryErrorAborts() {
+	item := s.queueItem(models.QueueStatusFailed)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+	s.queueRepo.EXPECT().Requeue(gomock.Any(), item.ID, gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+
+	_, err := s.service.RetryItems(context.Background(), s.adminID, &dto.RetryQueueItemsRequest{ItemIDs: []uuid.UUID{item.ID}})
+	s.Error(err)
+}
+
+func (s *QueueAdminServiceTestSuite) TestCancelItem_Audited() {
+	item := s.queueItem(models.QueueStatusPending)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+	s.queueRepo.EXPECT().Close(gomock.Any(), item.ID, models.QueueStatusPending, models.QueueStatusCancelled).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("processing_queue_item.cancelled", log.Action)
+		s.Equal("Duplicate of a manual adjustment", log.Metadata["note"])
+		return nil
+	})
+
+	cancelled, err := s.service.CancelItem(context.Background(), s.adminID, item.ID, "Duplicate of a manual adjustment")
+	s.Require().NoError(err)
+	s.Equal(models.QueueStatusCancelled, cancelled.Status)
+	s.NotNil(cancelled.ProcessedAt)
+}
+
+func (s *QueueAdminServiceTestSuite) TestCancelItem_Processing() {
+	item := s.queueItem(models.QueueStatusProcessing)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+	s.queueRepo.EXPECT().Close(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.service.CancelItem(context.Background(), s.adminID, item.ID, "note")
+	s.ErrorIs(err, ErrQueueItemInvalidState)
+}
+
+func (s *QueueAdminServiceTestSuite) TestDeadLetterItem() {
+	item := s.queueItem(models.QueueStatusFailed)
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(item, nil)
+	s.queueRepo.EXPECT().Close(gomock.Any(), item.ID, models.QueueStatusFailed, models.QueueStatusDeadLettered).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("processing_queue_item.dead_lettered", log.Action)
+		return nil
+	})
+
+	parked, err := s.service.DeadLetterItem(context.Background(), s.adminID, item.ID, "Account under investigation")
+	s.Require().NoError(err)
+	s.Equal(models.QueueStatusDeadLettered, parked.Status)
+
+	// Already dead-lettered items can only be retried or cancelled
+	s.queueRepo.EXPECT().GetByID(gomock.Any(), item.ID).Return(parked, nil)
+	_, err = s.service.DeadLetterItem(context.Background(), s.adminID, item.ID, "again")
+	s.ErrorIs(err, ErrQueueItemInvalidState)
+}
+
+func (s *QueueAdminServiceTestSuite) TestPauseAndResumeWorkers() {
+	s.queueRepo.EXPECT().GetControl(gomock.Any()).Return(models.NewProcessingQueueControl(), nil)
+	s.queueRepo.EXPECT().SaveControl(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, control *models.ProcessingQueueControl) error {
+		s.True(control.Paused)
+		s.Equal(&s.adminID, control.PausedBy)
+		s.Equal("Ledger migration", control.PauseReason)
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("processing_queue.paused", log.Action)
+		s.Equal("Ledger migration", log.Metadata["reason"])
+		return nil
+	})
+
+	paused, err := s.service.PauseWorkers(context.Background(), s.adminID, "Ledger migration")
+	s.Require().NoError(err)
+	s.True(paused.Paused)
+
+	s.now = s.now.Add(10 * time.Minute)
+	s.queueRepo.EXPECT().GetControl(gomock.Any()).Return(paused, nil)
+	s.queueRepo.EXPECT().SaveControl(gomock.Any(), gomock.Any()).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("processing_queue.resumed", log.Action)
+		s.Equal("10m0s", log.Metadata["paused_for"])
+		return nil
+	})
+
+	resumed, err := s.service.ResumeWorkers(context.Background(), s.adminID)
+	s.Require().NoError(err)
+	s.False(resumed.Paused)
+	s.Nil(resumed.PausedBy)
+	s.Empty(resumed.PauseReason)
+}
+
+func (s *QueueAdminServiceTestSuite) TestCleanupCompleted_Audited() {
+	s.queueRepo.EXPECT().CleanupCompleted(gomock.Any(), 72*time.Hour).Return(int64(40), nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("processing_queue.cleaned_up", log.Action)
+		s.Equal(int64(40), log.Metadata["deleted"])
+		return nil
+	})
+
+	deleted, err := s.service.CleanupCompleted(context.Background(), s.adminID, 72*time.Hour)
+	s.Require().NoError(err)
+	s.Equal(int64(40), deleted)
+}
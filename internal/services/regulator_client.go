@@ -6,11 +6,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/regulatorwebhook"
+	"github.com/array/banking-api/internal/telemetry"
 )
 
 const regulatorTimeout = 15 * time.Second
@@ -18,32 +22,73 @@ const regulatorTimeout = 15 * time.Second
 type regulatorClient struct {
 	httpClient *http.Client
 	config     config.RegulatorConfig
+	now        func() time.Time
 }
 
 // NewRegulatorClient creates a new client for sending webhooks to the regulator.
 func NewRegulatorClient(cfg config.RegulatorConfig) RegulatorClientInterface {
+	if cfg.WebhookURL != "" && len(cfg.SigningSecrets()) == 0 {
+		slog.Default().Warn("regulator webhooks will be sent unsigned; set REGULATOR_WEBHOOK_SIGNING_SECRET")
+	}
 	return &regulatorClient{
 		httpClient: &http.Client{
-			Timeout: regulatorTimeout,
+			Timeout:   regulatorTimeout,
+			Transport: telemetry.NewTransport(nil),
 		},
 		config: cfg,
+		now:    time.Now,
 	}
 }
 
 // SendTransferNotification sends a webhook notification about a transfer's final status.
-func (c *regulatorClient) SendTransferNotification(ctx context.Context, payload *dto.RegulatorNotificationPayload) (int, string, error) {
+// The payload's event ID identifies the notification and is the same on every attempt, so the
+// regulator can discard a retry it already received; attempt numbers the delivery. Each delivery
+// is timestamped and signed with the configured signing secrets; the caller's payload is not modified.
+func (c *regulatorClient) SendTransferNotification(ctx context.Context, payload *dto.RegulatorNotificationPayload, attempt int) (int, string, error) {
 	if c.config.WebhookURL == "" {
 		// If no URL is configured, we consider the notification "sent" to prevent queue blockage.
 		// In a real-world scenario, this might trigger an alert.
 		return http.StatusOK, "No-op: Webhook URL not configured", nil
 	}
+	if payload.EventID == "" {
+		return 0, "", fmt.Errorf("regulator client: notification has no event ID")
+	}
 
-	requestBody, err := json.Marshal(payload)
+	now := c.now()
+	delivery := *payload
+	delivery.Timestamp = now.UTC()
+
+	requestBody, err := json.Marshal(&delivery)
 	if err != nil {
 		return 0, "", fmt.Errorf("regulator client: failed to marshal payload: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.WebhookURL, bytes.NewBuffer(requestBody))
+	return c.post(ctx, c.config.WebhookURL, delivery.EventID, attempt, requestBody, now, "webhook")
+}
+
+// SubmitComplianceReport files an approved compliance report with the regulator report endpoint.
+// Deliveries are signed like webhooks, with an event ID derived from the report ID so every
+// attempt at filing a report carries the same one; the caller's file is not modified.
+func (c *regulatorClient) SubmitComplianceReport(ctx context.Context, file *dto.ComplianceReportFile, attempt int) (int, string, error) {
+	if c.config.ReportURL == "" {
+		// Unlike webhooks, a report that was never filed must not be marked as submitted.
+		return 0, "", fmt.Errorf("regulator client: report URL not configured")
+	}
+
+	delivery := *file
+	delivery.EventID = "rpt_" + file.ReportID.String()
+
+	requestBody, err := json.Marshal(&delivery)
+	if err != nil {
+		return 0, "", fmt.Errorf("regulator client: failed to marshal report: %w", err)
+	}
+
+	return c.post(ctx, c.config.ReportURL, delivery.EventID, attempt, requestBody, c.now(), "report")
+}
+
+// post delivers a JSON body to the regulator, signed with the configured signing secrets.
+func (c *regulatorClient) post(ctx context.Context, url, eventID string, attempt int, requestBody []byte, now time.Time, kind string) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return 0, "", fmt.Errorf("regulator client: failed to create request: %w", err)
 	}
@@ -51,12 +96,18 @@ func (c *regulatorClient) SendTransferNotification(ctx context.Context, payload
 	if c.config.WebhookAPIKey != "" {
 		req.Header.Set("X-Api-Key", c.config.WebhookAPIKey)
 	}
+	if secrets := c.config.SigningSecrets(); len(secrets) > 0 {
+		regulatorwebhook.SetHeaders(req.Header, eventID, requestBody, secrets, now)
+	} else {
+		req.Header.Set(regulatorwebhook.EventIDHeader, eventID)
+	}
+	req.Header.Set(regulatorwebhook.AttemptHeader, strconv.Itoa(attempt))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return 0, "", fmt.Errorf("regulator client: webhook request failed: %w", err)
+		return 0, "", fmt.Errorf("regulator client: %s request failed: %w", kind, err)
 	}
 	defer resp.Body.Close()
 
@@ -68,7 +119,7 @@ func (c *regulatorClient) SendTransferNotification(ctx context.Context, payload
 
 	// Regulators often return 200 OK or 202 Accepted. We'll treat any 2xx as success.
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return resp.StatusCode, bodyString, fmt.Errorf("regulator client: webhook returned non-2xx status: %d", resp.StatusCode)
+		return resp.StatusCode, bodyString, fmt.Errorf("regulator client: %s returned non-2xx status: %d", kind, resp.StatusCode)
 	}
 
 	return resp.StatusCode, bodyString, nil
@@ -3,12 +3,15 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/regulatorwebhook"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 )
@@ -45,12 +48,13 @@ func (s *RegulatorClientTestSuite) TestSendTransferNotification_Success() {
 	client := NewRegulatorClient(cfg)
 
 	payload := &dto.RegulatorNotificationPayload{
+		EventID:    "evt_" + uuid.NewString(),
 		TransferID: uuid.New(),
 		Status:     "completed",
 		Amount:     "123.45",
 	}
 
-	statusCode, body, err := client.SendTransferNotification(context.Background(), payload)
+	statusCode, body, err := client.SendTransferNotification(context.Background(), payload, 1)
 	s.NoError(err)
 	s.Equal(http.StatusAccepted, statusCode)
 	s.Contains(body, "received")
@@ -67,9 +71,9 @@ func (s *RegulatorClientTestSuite) TestSendTransferNotification_APIFailure() {
 
 	cfg := config.RegulatorConfig{WebhookURL: server.URL}
 	client := NewRegulatorClient(cfg)
-	payload := &dto.RegulatorNotificationPayload{TransferID: uuid.New()}
+	payload := &dto.RegulatorNotificationPayload{EventID: "evt_" + uuid.NewString(), TransferID: uuid.New()}
 
-	statusCode, body, err := client.SendTransferNotification(context.Background(), payload)
+	statusCode, body, err := client.SendTransferNotification(context.Background(), payload, 1)
 	s.Error(err)
 	s.Equal(http.StatusInternalServerError, statusCode)
 	s.Contains(body, "internal server error")
@@ -79,9 +83,9 @@ func (s *RegulatorClientTestSuite) TestSendTransferNotification_APIFailure() {
 func (s *RegulatorClientTestSuite) TestSendTransferNotification_NoURLConfigured() {
 	cfg := config.RegulatorConfig{WebhookURL: ""} // No URL
 	client := NewRegulatorClient(cfg)
-	payload := &dto.RegulatorNotificationPayload{TransferID: uuid.New()}
+	payload := &dto.RegulatorNotificationPayload{EventID: "evt_" + uuid.NewString(), TransferID: uuid.New()}
 
-	statusCode, body, err := client.SendTransferNotification(context.Background(), payload)
+	statusCode, body, err := client.SendTransferNotification(context.Background(), payload, 1)
 	s.NoError(err) // Should return nil and not block the queue
 	s.Equal(http.StatusOK, statusCode)
 	s.Contains(body, "No-op")
@@ -91,11 +95,121 @@ func (s *RegulatorClientTestSuite) TestSendTransferNotification_NetworkError() {
 	// Point to a non-existent server
 	cfg := config.RegulatorConfig{WebhookURL: "http://127.0.0.1:9999"}
 	client := NewRegulatorClient(cfg)
-	payload := &dto.RegulatorNotificationPayload{TransferID: uuid.New()}
+	payload := &dto.RegulatorNotificationPayload{EventID: "evt_" + uuid.NewString(), TransferID: uuid.New()}
 
-	statusCode, body, err := client.SendTransferNotification(context.Background(), payload)
+	statusCode, body, err := client.SendTransferNotification(context.Background(), payload, 1)
 	s.Error(err)
 	s.Equal(0, statusCode)
 	s.Empty(body)
 	s.Contains(err.Error(), "regulator client: webhook request failed")
 }
+
+func (s *RegulatorClientTestSuite) TestSendTransferNotification_SignsEachDelivery() {
+	// The regulator has not switched to the new secret yet
+	verifier := regulatorwebhook.NewVerifier([]string{"previous-secret"}, 0)
+	now := time.Unix(1_700_000_000, 0)
+	verifier.Now = func() time.Time { return now }
+	var eventIDs, attempts []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+
+		eventID, err := verifier.Verify(r.Header, body)
+		s.Require().NoError(err)
+
+		var received dto.RegulatorNotificationPayload
+		s.Require().NoError(json.Unmarshal(body, &received))
+		s.Equal(eventID, received.EventID)
+		s.Equal(now.UTC(), received.Timestamp)
+
+		eventIDs = append(eventIDs, eventID)
+		attempts = append(attempts, r.Header.Get(regulatorwebhook.AttemptHeader))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	cfg := config.RegulatorConfig{
+		WebhookURL:                   server.URL,
+		WebhookSigningSecret:         "current-secret",
+		WebhookPreviousSigningSecret: "previous-secret",
+	}
+	client := NewRegulatorClient(cfg).(*regulatorClient)
+	client.now = func() time.Time { return now }
+	payload := &dto.RegulatorNotificationPayload{EventID: "evt_notification", TransferID: uuid.New(), Status: "completed"}
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		_, _, err := client.SendTransferNotification(context.Background(), payload, attempt)
+		s.Require().NoError(err)
+		now = now.Add(time.Minute)
+	}
+
+	s.Equal([]string{"evt_notification", "evt_notification"}, eventIDs, "a retry keeps the event ID")
+	s.Equal([]string{"1", "2"}, attempts)
+	s.True(payload.Timestamp.IsZero()) // The caller's payload is not modified
+}
+
+func (s *RegulatorClientTestSuite) TestSendTransferNotification_RequiresEventID() {
+	client := NewRegulatorClient(config.RegulatorConfig{WebhookURL: "http://127.0.0.1:9999"})
+	_, _, err := client.SendTransferNotification(context.Background(), &dto.RegulatorNotificationPayload{TransferID: uuid.New()}, 1)
+	s.ErrorContains(err, "no event ID")
+}
+
+func (s *RegulatorClientTestSuite) TestSendTransferNotification_UnsignedWithoutSecret() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.NotEmpty(r.Header.Get(regulatorwebhook.EventIDHeader))
+		s.Empty(r.Header.Get(regulatorwebhook.SignatureHeader))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewRegulatorClient(config.RegulatorConfig{WebhookURL: server.URL})
+	_, _, err := client.SendTransferNotification(context.Background(), &dto.RegulatorNotificationPayload{EventID: "evt_1", TransferID: uuid.New()}, 1)
+	s.NoError(err)
+}
+
+func (s *RegulatorClientTestSuite) TestSubmitComplianceReport_Success() {
+	verifier := regulatorwebhook.NewVerifier([]string{"current-secret"}, 0)
+	file := &dto.ComplianceReportFile{Format: dto.ComplianceReportFileFormat, ReportID: uuid.New(), ReportType: "ctr"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("regulator-key", r.Header.Get("X-Api-Key"))
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+
+		eventID, err := verifier.Verify(r.Header, body)
+		s.Require().NoError(err)
+		s.Equal("rpt_"+file.ReportID.String(), eventID, "derived from the report so retries share it")
+		s.Equal("1", r.Header.Get(regulatorwebhook.AttemptHeader))
+
+		var received dto.ComplianceReportFile
+		s.Require().NoError(json.Unmarshal(body, &received))
+		s.Equal(file.ReportID, received.ReportID)
+
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"status":"received"}`))
+	}))
+	defer server.Close()
+
+	client := NewRegulatorClient(config.RegulatorConfig{ReportURL: server.URL, WebhookAPIKey: "regulator-key", WebhookSigningSecret: "current-secret"})
+	statusCode, body, err := client.SubmitComplianceReport(context.Background(), file, 1)
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, statusCode)
+	s.Contains(body, "received")
+}
+
+func (s *RegulatorClientTestSuite) TestSubmitComplianceReport_Errors() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	file := &dto.ComplianceReportFile{ReportID: uuid.New()}
+
+	_, _, err := NewRegulatorClient(config.RegulatorConfig{}).SubmitComplianceReport(context.Background(), file, 1)
+	s.ErrorContains(err, "report URL not configured") // Never treated as filed
+
+	statusCode, _, err := NewRegulatorClient(config.RegulatorConfig{ReportURL: server.URL}).SubmitComplianceReport(context.Background(), file, 1)
+	s.Equal(http.StatusBadRequest, statusCode)
+	s.ErrorContains(err, "regulator client: report returned non-2xx status: 400")
+}
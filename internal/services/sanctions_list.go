@@ -0,0 +1,213 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// OFAC SDN CSV columns: ent_num, SDN_Name, SDN_Type, Program, Title, Call_Sign, Vess_type,
+// Tonnage, GRT, Vess_flag, Vess_owner, Remarks. The file has no header and marks empty fields
+// with "-0-".
+const (
+	sdnColumnID       = 0
+	sdnColumnName     = 1
+	sdnColumnType     = 2
+	sdnColumnPrograms = 3
+	sdnMinColumns     = 4
+	sdnNullField      = "-0-"
+
+	sanctionsEntryTypeIndividual = "individual"
+)
+
+var ErrSanctionsListEmpty = errors.New("sanctions list has no entries")
+
+// SanctionsEntry is one person or entity on the sanctions list.
+type SanctionsEntry struct {
+	ID       string
+	Name     string // As published, "LAST, First" for individuals
+	Type     string // individual, or empty for entities
+	Programs string
+	forms    []sanctionsNameForm
+}
+
+// SanctionsCandidate is a list entry whose name is similar to a screened name.
+type SanctionsCandidate struct {
+	Entry      *SanctionsEntry
+	Similarity int // Percent, 0-100
+}
+
+// SanctionsList is a parsed sanctions list, identified by the SHA-256 of its file.
+type SanctionsList struct {
+	Version string
+	Entries []SanctionsEntry
+}
+
+// sanctionsNameForm is a normalized name compared both in written order and with its words
+// sorted, so "John Smith" matches "SMITH, John".
+type sanctionsNameForm struct {
+	ordered string
+	sorted  string
+}
+
+// LoadSanctionsListFile reads and parses an OFAC SDN CSV file.
+func LoadSanctionsListFile(path string) (*SanctionsList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sanctions list: %w", err)
+	}
+	return ParseSanctionsList(data)
+}
+
+// ParseSanctionsList parses the contents of an OFAC SDN CSV file. Vessels and aircraft are
+// skipped because only people and organisations are screened.
+func ParseSanctionsList(data []byte) (*SanctionsList, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimRight(data, "\x1a\r\n ")))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	list := &SanctionsList{Version: sanctionsListVersion(data)}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sanctions list: %w", err)
+		}
+		if len(record) < sdnMinColumns {
+			continue
+		}
+
+		entryType := sdnField(record[sdnColumnType])
+		if entryType != "" && entryType != sanctionsEntryTypeIndividual {
+			continue
+		}
+		name := sdnField(record[sdnColumnName])
+		if name == "" {
+			continue
+		}
+
+		list.Entries = append(list.Entries, SanctionsEntry{
+			ID:       sdnField(record[sdnColumnID]),
+			Name:     name,
+			Type:     entryType,
+			Programs: sdnField(record[sdnColumnPrograms]),
+			forms:    sanctionsEntryForms(name, entryType),
+		})
+	}
+
+	if len(list.Entries) == 0 {
+		return nil, ErrSanctionsListEmpty
+	}
+	return list, nil
+}
+
+// Match returns the entries whose name is at least minSimilarity percent similar to the given
+// name, most similar first. Entities are only considered when includeEntities is set, since
+// a person's name is not expected to match an organisation.
+func (l *SanctionsList) Match(name string, includeEntities bool, minSimilarity int) []SanctionsCandidate {
+	screened := newSanctionsNameForm(sanctionsNameTokens(name))
+	if screened.ordered == "" {
+		return nil
+	}
+
+	var candidates []SanctionsCandidate
+	for i := range l.Entries {
+		entry := &l.Entries[i]
+		if entry.Type != sanctionsEntryTypeIndividual && !includeEntities {
+			continue
+		}
+
+		best := 0
+		for _, form := range entry.forms {
+			if similarity := screened.similarity(form, minSimilarity); similarity > best {
+				best = similarity
+			}
+		}
+		if best >= minSimilarity {
+			candidates = append(candidates, SanctionsCandidate{Entry: entry, Similarity: best})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Similarity > candidates[j].Similarity
+	})
+	return candidates
+}
+
+// similarity returns the percent similarity of two names, or 0 when their lengths alone rule
+// out reaching minSimilarity. The length check skips most of the list cheaply.
+func (f sanctionsNameForm) similarity(other sanctionsNameForm, minSimilarity int) int {
+	longer, shorter := len(f.ordered), len(other.ordered)
+	if shorter > longer {
+		longer, shorter = shorter, longer
+	}
+	if longer == 0 || (longer-shorter)*100 > (100-minSimilarity)*longer {
+		return 0
+	}
+
+	score := calculateSimilarity(f.ordered, other.ordered)
+	if sorted := calculateSimilarity(f.sorted, other.sorted); sorted > score {
+		score = sorted
+	}
+	return int(score * 100)
+}
+
+// sanctionsEntryForms returns the name forms an entry is matched on. Individuals are listed as
+// "LAST, First Middle" and are also matched without their middle names.
+func sanctionsEntryForms(name, entryType string) []sanctionsNameForm {
+	if entryType != sanctionsEntryTypeIndividual {
+		return []sanctionsNameForm{newSanctionsNameForm(sanctionsNameTokens(name))}
+	}
+
+	last, given, found := strings.Cut(name, ",")
+	if !found {
+		return []sanctionsNameForm{newSanctionsNameForm(sanctionsNameTokens(name))}
+	}
+
+	lastTokens := sanctionsNameTokens(last)
+	givenTokens := sanctionsNameTokens(given)
+	forms := []sanctionsNameForm{newSanctionsNameForm(append(append([]string{}, givenTokens...), lastTokens...))}
+	if len(givenTokens) > 1 {
+		forms = append(forms, newSanctionsNameForm(append([]string{givenTokens[0]}, lastTokens...)))
+	}
+	return forms
+}
+
+func newSanctionsNameForm(tokens []string) sanctionsNameForm {
+	sorted := append([]string{}, tokens...)
+	sort.Strings(sorted)
+	return sanctionsNameForm{
+		ordered: strings.Join(tokens, " "),
+		sorted:  strings.Join(sorted, " "),
+	}
+}
+
+// sanctionsNameTokens lowercases a name and splits it into words, dropping punctuation.
+func sanctionsNameTokens(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func sdnField(value string) string {
+	value = strings.TrimSpace(value)
+	if value == sdnNullField {
+		return ""
+	}
+	return value
+}
+
+func sanctionsListVersion(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
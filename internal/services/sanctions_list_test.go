@@ -0,0 +1,116 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testSanctionsList is an excerpt in the OFAC SDN CSV layout, ending with the DOS end-of-file
+// marker the published file carries.
+const testSanctionsList = `36,"AERO-CARIBBEAN","-0-","CUBA","-0-","-0-","-0-","-0-","-0-","-0-","-0-","-0-"
+306,"BANCO NACIONAL DE CUBA","-0-","CUBA","-0-","-0-","-0-","-0-","-0-","-0-","-0-","a.k.a. 'BNC'."
+2674,"ABU ALI, Hassan Ahmad","individual","SDGT","-0-","-0-","-0-","-0-","-0-","-0-","-0-","DOB 1961."
+7000,"SANTOS, Maria","individual","SDNTK","-0-","-0-","-0-","-0-","-0-","-0-","-0-","-0-"
+15036,"ARMAN","vessel","IRAN","-0-","9CWL2","Crude Oil Tanker","-0-","-0-","Iran","-0-","-0-"
+` + "\x1a"
+
+type SanctionsListTestSuite struct {
+	suite.Suite
+	list *SanctionsList
+}
+
+func (s *SanctionsListTestSuite) SetupTest() {
+	list, err := ParseSanctionsList([]byte(testSanctionsList))
+	s.Require().NoError(err)
+	s.list = list
+}
+
+func TestSanctionsListTestSuite(t *testing.T) {
+	suite.Run(t, new(SanctionsListTestSuite))
+}
+
+func (s *SanctionsListTestSuite) TestParseSanctionsList() {
+	s.Len(s.list.Entries, 4, "vessels are skipped")
+	s.Len(s.list.Version, 64)
+
+	bank := s.list.Entries[1]
+	s.Equal("306", bank.ID)
+	s.Equal("BANCO NACIONAL DE CUBA", bank.Name)
+	s.Empty(bank.Type, "-0- is read as empty")
+	s.Equal("CUBA", bank.Programs)
+
+	person := s.list.Entries[2]
+	s.Equal("ABU ALI, Hassan Ahmad", person.Name)
+	s.Equal("individual", person.Type)
+}
+
+func (s *SanctionsListTestSuite) TestParseSanctionsList_Empty() {
+	_, err := ParseSanctionsList([]byte("15036,\"ARMAN\",\"vessel\",\"IRAN\"\n"))
+	s.ErrorIs(err, ErrSanctionsListEmpty)
+}
+
+func (s *SanctionsListTestSuite) TestParseSanctionsList_VersionChangesWithContents() {
+	other, err := ParseSanctionsList([]byte(testSanctionsList + "\n"))
+	s.Require().NoError(err)
+	s.NotEqual(s.list.Version, other.Version)
+}
+
+func (s *SanctionsListTestSuite) TestMatch() {
+	tests := []struct {
+		name            string
+		screened        string
+		includeEntities bool
+		wantID          string
+		wantSimilarity  int
+	}{
+		{name: "first name first", screened: "Maria Santos", wantID: "7000", wantSimilarity: 100},
+		{name: "last name first", screened: "Santos Maria", wantID: "7000", wantSimilarity: 100},
+		{name: "case and punctuation ignored", screened: "MARIA  santos.", wantID: "7000", wantSimilarity: 100},
+		{name: "misspelling", screened: "Maria Santoz", wantID: "7000", wantSimilarity: 91},
+		{name: "middle name omitted", screened: "Hassan Abu Ali", wantID: "2674", wantSimilarity: 100},
+		{name: "entity", screened: "Banco Nacional de Cuba", includeEntities: true, wantID: "306", wantSimilarity: 100},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			candidates := s.list.Match(tt.screened, tt.includeEntities, 85)
+			s.Require().NotEmpty(candidates)
+			s.Equal(tt.wantID, candidates[0].Entry.ID)
+			s.Equal(tt.wantSimilarity, candidates[0].Similarity)
+		})
+	}
+}
+
+func (s *SanctionsListTestSuite) TestMatch_NoMatch() {
+	tests := []struct {
+		name            string
+		screened        string
+		includeEntities bool
+	}{
+		{name: "unrelated name", screened: "Jane Doe"},
+		{name: "below threshold", screened: "Mario Sanchez"},
+		{name: "entities excluded for people", screened: "Banco Nacional de Cuba"},
+		{name: "vessel not loaded", screened: "Arman", includeEntities: true},
+		{name: "empty name", screened: " . "},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Empty(s.list.Match(tt.screened, tt.includeEntities, 85))
+		})
+	}
+}
+
+func (s *SanctionsListTestSuite) TestMatch_MostSimilarFirst() {
+	list, err := ParseSanctionsList([]byte(`1,"SANTOS, Mario","individual","SDNTK"
+2,"SANTOS, Maria","individual","SDNTK"
+`))
+	s.Require().NoError(err)
+
+	candidates := list.Match("Maria Santos", false, 85)
+	s.Require().Len(candidates, 2)
+	s.Equal("2", candidates[0].Entry.ID)
+	s.Equal(100, candidates[0].Similarity)
+	s.Equal("1", candidates[1].Entry.ID)
+}
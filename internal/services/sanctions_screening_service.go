@@ -0,0 +1,496 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+const sanctionsRescreenBatchSize = 500
+
+var (
+	ErrSanctionsHold          = errors.New("customer is restricted pending sanctions review")
+	ErrPayeeSanctionsHold     = errors.New("payee is restricted pending sanctions review")
+	ErrSanctionsMatchNotFound = errors.New("sanctions match not found")
+	ErrSanctionsMatchNotOpen  = errors.New("sanctions match has already been reviewed")
+	ErrSanctionsListNotLoaded = errors.New("sanctions list is not loaded")
+)
+
+type sanctionsScreeningService struct {
+	sanctionsRepo repositories.SanctionsRepositoryInterface
+	userRepo      repositories.UserRepositoryInterface
+	auditRepo     repositories.AuditLogRepositoryInterface
+	config        config.SanctionsConfig
+	logger        *slog.Logger
+	now           func() time.Time
+
+	mu   sync.RWMutex
+	list *SanctionsList
+}
+
+// sanctionsSubject is a customer or payee name to screen.
+type sanctionsSubject struct {
+	Type            string
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	Status          string
+	IncludeEntities bool // Payees may be organisations; customers are people
+}
+
+func NewSanctionsScreeningService(
+	sanctionsRepo repositories.SanctionsRepositoryInterface,
+	userRepo repositories.UserRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	cfg config.SanctionsConfig,
+) SanctionsScreeningServiceInterface {
+	return &sanctionsScreeningService{
+		sanctionsRepo: sanctionsRepo,
+		userRepo:      userRepo,
+		auditRepo:     auditRepo,
+		config:        cfg,
+		logger:        slog.Default().With("service", "SanctionsScreeningService"),
+		now:           time.Now,
+	}
+}
+
+// LoadList loads the list file into this instance's memory when its contents have changed. It
+// writes nothing, so every instance runs it to screen against the current list.
+func (s *sanctionsScreeningService) LoadList(ctx context.Context) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "SanctionsScreeningService.LoadList")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	_, err = s.loadList(ctx)
+	return err
+}
+
+// RefreshList loads the list file when its contents have changed. Customers and payees are
+// rescreened once against every list version, so a restart does not repeat a finished rescreen.
+func (s *sanctionsScreeningService) RefreshList(ctx context.Context) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "SanctionsScreeningService.RefreshList")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	list, err := s.loadList(ctx)
+	if err != nil || list == nil {
+		return err
+	}
+
+	load, err := s.listLoad(ctx, list)
+	if err != nil {
+		return err
+	}
+	if load.RescreenedAt != nil {
+		return nil
+	}
+	return s.rescreen(ctx, list, load)
+}
+
+// loadList reads the list file and replaces the loaded list when its version has changed. It
+// returns nil when no list is configured.
+func (s *sanctionsScreeningService) loadList(ctx context.Context) (*SanctionsList, error) {
+	if s.config.ListPath == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(s.config.ListPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sanctions list: %w", err)
+	}
+
+	list := s.currentList()
+	if list != nil && list.Version == sanctionsListVersion(data) {
+		return list, nil
+	}
+	list, err = ParseSanctionsList(data)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.list = list
+	s.mu.Unlock()
+	s.logger.InfoContext(ctx, "loaded sanctions list", "version", list.Version, "entries", len(list.Entries))
+	return list, nil
+}
+
+// Rescreen screens every customer and payee against the loaded list, whether or not that
+// version has been rescreened before.
+func (s *sanctionsScreeningService) Rescreen(ctx context.Context) (*models.SanctionsListLoad, error) {
+	list := s.currentList()
+	if list == nil {
+		return nil, ErrSanctionsListNotLoaded
+	}
+
+	load, err := s.listLoad(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rescreen(ctx, list, load); err != nil {
+		return nil, err
+	}
+	return load, nil
+}
+
+// ScreenUser screens a customer's name and updates their screening status. Screening is
+// skipped while no list is loaded; the rescreen that follows the first load covers them.
+func (s *sanctionsScreeningService) ScreenUser(ctx context.Context, user *models.User) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "SanctionsScreeningService.ScreenUser")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	if !user.IsCustomer() {
+		return nil
+	}
+	list := s.currentList()
+	if list == nil {
+		s.logger.WarnContext(ctx, "sanctions list not loaded; customer screening deferred", "user_id", user.ID)
+		return nil
+	}
+
+	status, _, err := s.screenSubject(ctx, list, sanctionsSubject{
+		Type:   models.SanctionsSubjectUser,
+		ID:     user.ID,
+		UserID: user.ID,
+		Name:   user.FullName(),
+		Status: user.SanctionsStatus,
+	})
+	if err != nil {
+		return err
+	}
+	user.SanctionsStatus = status
+	return nil
+}
+
+// ScreenExternalAccount screens the name on a payee account and updates its screening status.
+func (s *sanctionsScreeningService) ScreenExternalAccount(ctx context.Context, account *models.ExternalAccount) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "SanctionsScreeningService.ScreenExternalAccount")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	list := s.currentList()
+	if list == nil {
+		s.logger.WarnContext(ctx, "sanctions list not loaded; payee screening deferred", "external_account_id", account.ID)
+		return nil
+	}
+
+	status, _, err := s.screenSubject(ctx, list, sanctionsSubject{
+		Type:            models.SanctionsSubjectExternalAccount,
+		ID:              account.ID,
+		UserID:          account.UserID,
+		Name:            account.NameOnAccount,
+		Status:          account.SanctionsStatus,
+		IncludeEntities: true,
+	})
+	if err != nil {
+		return err
+	}
+	account.SanctionsStatus = status
+	return nil
+}
+
+// CheckUser returns ErrSanctionsHold when a sanctions match restricts the customer.
+func (s *sanctionsScreeningService) CheckUser(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check sanctions status: %w", err)
+	}
+	if user.IsSanctionsHeld() {
+		return ErrSanctionsHold
+	}
+	return nil
+}
+
+// GetListStatus returns the most recently loaded list version and its rescreen.
+func (s *sanctionsScreeningService) GetListStatus(ctx context.Context) (*models.SanctionsListLoad, error) {
+	load, err := s.sanctionsRepo.GetLatestListLoad(ctx)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSanctionsListLoadNotFound) {
+			return nil, ErrSanctionsListNotLoaded
+		}
+		return nil, err
+	}
+	return load, nil
+}
+
+func (s *sanctionsScreeningService) ListMatches(ctx context.Context, filters models.SanctionsMatchFilters, offset, limit int) ([]models.SanctionsMatch, int64, error) {
+	return s.sanctionsRepo.ListMatches(ctx, filters, offset, limit)
+}
+
+func (s *sanctionsScreeningService) GetMatch(ctx context.Context, matchID uuid.UUID) (*models.SanctionsMatch, error) {
+	match, err := s.sanctionsRepo.GetMatchByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSanctionsMatchNotFound) {
+			return nil, ErrSanctionsMatchNotFound
+		}
+		return nil, err
+	}
+	return match, nil
+}
+
+// ClearMatch dismisses an open match as a false positive. The subject is released once none of
+// its matches remain open or confirmed.
+func (s *sanctionsScreeningService) ClearMatch(ctx context.Context, adminID, matchID uuid.UUID, note string) (*models.SanctionsMatch, error) {
+	return s.review(ctx, adminID, matchID, note, false)
+}
+
+// ConfirmMatch records an open match as a true match and blocks the subject.
+func (s *sanctionsScreeningService) ConfirmMatch(ctx context.Context, adminID, matchID uuid.UUID, note string) (*models.SanctionsMatch, error) {
+	return s.review(ctx, adminID, matchID, note, true)
+}
+
+func (s *sanctionsScreeningService) review(ctx context.Context, adminID, matchID uuid.UUID, note string, confirm bool) (*models.SanctionsMatch, error) {
+	match, err := s.GetMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if !match.IsOpen() {
+		return nil, fmt.Errorf("%w: status is %s", ErrSanctionsMatchNotOpen, match.Status)
+	}
+
+	action := "sanctions_match.cleared"
+	if confirm {
+		match.Confirm(adminID, note)
+		action = "sanctions_match.confirmed"
+	} else {
+		match.Clear(adminID, note)
+	}
+
+	if err := s.sanctionsRepo.UpdateMatch(ctx, match); err != nil {
+		return nil, fmt.Errorf("failed to review sanctions match: %w", err)
+	}
+
+	matches, err := s.sanctionsRepo.ListMatchesForSubject(ctx, match.SubjectType, match.SubjectID)
+	if err != nil {
+		return nil, err
+	}
+	status := models.SanctionsStatusFor(matches)
+	if err := s.sanctionsRepo.UpdateSubjectStatus(ctx, match.SubjectType, match.SubjectID, status); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "reviewed sanctions match", "match_id", match.ID, "status", match.Status, "subject_status", status, "admin_id", adminID)
+	s.audit(ctx, &adminID, action, "sanctions_match", match.ID.String(), models.JSONBMap{
+		"note":           note,
+		"subject_type":   match.SubjectType,
+		"subject_id":     match.SubjectID.String(),
+		"subject_status": status,
+	})
+
+	return match, nil
+}
+
+// screenSubject records a match for every list entry similar enough to the subject's name and
+// returns the subject's resulting status. Entries already matched to the same name are not
+// raised again, so cleared false positives stay cleared across rescreens.
+func (s *sanctionsScreeningService) screenSubject(ctx context.Context, list *SanctionsList, subject sanctionsSubject) (string, int, error) {
+	candidates := list.Match(subject.Name, subject.IncludeEntities, s.config.ReviewSimilarity)
+
+	var matches []models.SanctionsMatch
+	var err error
+	if len(candidates) > 0 || models.SanctionsHeld(subject.Status) {
+		matches, err = s.sanctionsRepo.ListMatchesForSubject(ctx, subject.Type, subject.ID)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	raised := 0
+	for _, candidate := range candidates {
+		if sanctionsMatchRecorded(matches, candidate.Entry.ID, subject.Name) {
+			continue
+		}
+
+		action := models.SanctionsMatchActionReview
+		if candidate.Similarity >= s.config.BlockSimilarity {
+			action = models.SanctionsMatchActionBlock
+		}
+		match := models.SanctionsMatch{
+			SubjectType:  subject.Type,
+			SubjectID:    subject.ID,
+			UserID:       subject.UserID,
+			ScreenedName: subject.Name,
+			EntryID:      candidate.Entry.ID,
+			EntryName:    candidate.Entry.Name,
+			EntryType:    candidate.Entry.Type,
+			Programs:     candidate.Entry.Programs,
+			Similarity:   candidate.Similarity,
+			Action:       action,
+			Status:       models.SanctionsMatchStatusOpen,
+			ListVersion:  list.Version,
+		}
+		if err := s.sanctionsRepo.CreateMatch(ctx, &match); err != nil {
+			return "", raised, err
+		}
+		matches = append(matches, match)
+		raised++
+
+		s.logger.Warn("potential sanctions match",
+			"match_id", match.ID, "subject_type", subject.Type, "subject_id", subject.ID,
+			"entry_id", match.EntryID, "similarity", match.Similarity, "action", action)
+		s.audit(ctx, &subject.UserID, "sanctions_match.raised", "sanctions_match", match.ID.String(), models.JSONBMap{
+			"subject_type": subject.Type,
+			"subject_id":   subject.ID.String(),
+			"entry_id":     match.EntryID,
+			"similarity":   match.Similarity,
+			"action":       action,
+		})
+	}
+
+	status := models.SanctionsStatusFor(matches)
+	previous := subject.Status
+	if previous == "" {
+		previous = models.SanctionsStatusClear
+	}
+	if status != previous {
+		if err := s.sanctionsRepo.UpdateSubjectStatus(ctx, subject.Type, subject.ID, status); err != nil {
+			return "", raised, err
+		}
+		s.audit(ctx, &subject.UserID, "sanctions_status.changed", subject.Type, subject.ID.String(), models.JSONBMap{
+			"from": previous,
+			"to":   status,
+		})
+	}
+
+	return status, raised, nil
+}
+
+// rescreen pages through every customer and payee. The load is only marked rescreened when
+// every subject was screened, so a failed run is retried on the next refresh.
+func (s *sanctionsScreeningService) rescreen(ctx context.Context, list *SanctionsList, load *models.SanctionsListLoad) error {
+	s.logger.InfoContext(ctx, "rescreening customers and payees", "version", list.Version)
+
+	subjects, raised, failed := 0, 0, 0
+	screen := func(subject sanctionsSubject) {
+		_, n, err := s.screenSubject(ctx, list, subject)
+		subjects++
+		raised += n
+		if err != nil {
+			failed++
+			s.logger.ErrorContext(ctx, "failed to rescreen subject", "error", err, "subject_type", subject.Type, "subject_id", subject.ID)
+		}
+	}
+
+	afterID := uuid.Nil
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		users, err := s.sanctionsRepo.ListCustomersAfter(ctx, afterID, sanctionsRescreenBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			screen(sanctionsSubject{
+				Type:   models.SanctionsSubjectUser,
+				ID:     user.ID,
+				UserID: user.ID,
+				Name:   user.FullName(),
+				Status: user.SanctionsStatus,
+			})
+		}
+		if len(users) < sanctionsRescreenBatchSize {
+			break
+		}
+		afterID = users[len(users)-1].ID
+	}
+
+	afterID = uuid.Nil
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		accounts, err := s.sanctionsRepo.ListExternalAccountsAfter(ctx, afterID, sanctionsRescreenBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			screen(sanctionsSubject{
+				Type:            models.SanctionsSubjectExternalAccount,
+				ID:              account.ID,
+				UserID:          account.UserID,
+				Name:            account.NameOnAccount,
+				Status:          account.SanctionsStatus,
+				IncludeEntities: true,
+			})
+		}
+		if len(accounts) < sanctionsRescreenBatchSize {
+			break
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to rescreen %d of %d customers and payees", failed, subjects)
+	}
+
+	now := s.now()
+	load.RescreenedAt = &now
+	load.SubjectsCount = subjects
+	load.MatchesCount = raised
+	if err := s.sanctionsRepo.SaveListLoad(ctx, load); err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "rescreened customers and payees", "version", list.Version, "subjects", subjects, "matches", raised)
+	return nil
+}
+
+// listLoad returns the record of a list version, creating it on first load.
+func (s *sanctionsScreeningService) listLoad(ctx context.Context, list *SanctionsList) (*models.SanctionsListLoad, error) {
+	load, err := s.sanctionsRepo.GetListLoad(ctx, list.Version)
+	if err == nil {
+		return load, nil
+	}
+	if !errors.Is(err, repositories.ErrSanctionsListLoadNotFound) {
+		return nil, err
+	}
+
+	load = &models.SanctionsListLoad{
+		Version:    list.Version,
+		EntryCount: len(list.Entries),
+		LoadedAt:   s.now(),
+	}
+	if err := s.sanctionsRepo.SaveListLoad(ctx, load); err != nil {
+		return nil, err
+	}
+	return load, nil
+}
+
+func (s *sanctionsScreeningService) currentList() *SanctionsList {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list
+}
+
+func (s *sanctionsScreeningService) audit(ctx context.Context, userID *uuid.UUID, action, resource, resourceID string, metadata models.JSONBMap) {
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     userID,
+		Action:     action,
+		Resource:   resource,
+		ResourceID: resourceID,
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
+
+// sanctionsMatchRecorded reports whether a match against the entry was already recorded for
+// the same screened name.
+func sanctionsMatchRecorded(matches []models.SanctionsMatch, entryID, name string) bool {
+	for _, match := range matches {
+		if match.EntryID == entryID && match.ScreenedName == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,396 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SanctionsScreeningServiceTestSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	sanctionsRepo *repository_mocks.MockSanctionsRepositoryInterface
+	userRepo      *repository_mocks.MockUserRepositoryInterface
+	auditRepo     *repository_mocks.MockAuditLogRepositoryInterface
+	service       *sanctionsScreeningService
+	now           time.Time
+	list          *SanctionsList
+}
+
+func (s *SanctionsScreeningServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.sanctionsRepo = repository_mocks.NewMockSanctionsRepositoryInterface(s.ctrl)
+	s.userRepo = repository_mocks.NewMockUserRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.service = NewSanctionsScreeningService(s.sanctionsRepo, s.userRepo, s.auditRepo, config.SanctionsConfig{
+		ReviewSimilarity: 85,
+		BlockSimilarity:  95,
+	}).(*sanctionsScreeningService)
+
+	s.now = time.Date(2026, 3, 6, 15, 0, 0, 0, time.UTC)
+	s.service.now = func() time.Time { return s.now }
+
+	list, err := ParseSanctionsList([]byte(testSanctionsList))
+	s.Require().NoError(err)
+	s.list = list
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestSanctionsScreeningServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SanctionsScreeningServiceTestSuite))
+}
+
+func (s *SanctionsScreeningServiceTestSuite) withList() {
+	s.service.list = s.list
+}
+
+func (s *SanctionsScreeningServiceTestSuite) writeListFile() {
+	path := filepath.Join(s.T().TempDir(), "sdn.csv")
+	s.Require().NoError(os.WriteFile(path, []byte(testSanctionsList), 0o600))
+	s.service.config.ListPath = path
+}
+
+func (s *SanctionsScreeningServiceTestSuite) customer(firstName, lastName string) *models.User {
+	return &models.User{
+		ID:              uuid.New(),
+		FirstName:       firstName,
+		LastName:        lastName,
+		Role:            models.RoleCustomer,
+		SanctionsStatus: models.SanctionsStatusClear,
+	}
+}
+
+func (s *SanctionsScreeningServiceTestSuite) openMatch(subjectType string, subjectID uuid.UUID, action string) *models.SanctionsMatch {
+	return &models.SanctionsMatch{
+		ID:           uuid.New(),
+		SubjectType:  subjectType,
+		SubjectID:    subjectID,
+		UserID:       subjectID,
+		ScreenedName: "Maria Santos",
+		EntryID:      "7000",
+		EntryName:    "SANTOS, Maria",
+		Similarity:   100,
+		Action:       action,
+		Status:       models.SanctionsMatchStatusOpen,
+	}
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestRefreshList_NoListConfigured() {
+	s.NoError(s.service.RefreshList(context.Background()))
+	s.Nil(s.service.currentList())
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestRefreshList_MissingFile() {
+	s.service.config.ListPath = filepath.Join(s.T().TempDir(), "missing.csv")
+
+	err := s.service.RefreshList(context.Background())
+	s.ErrorContains(err, "failed to read sanctions list")
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestLoadList_LoadsWithoutRescreening() {
+	s.writeListFile()
+
+	// Followers load the list too; the mocks fail on any write
+	s.Require().NoError(s.service.LoadList(context.Background()))
+	s.Require().NotNil(s.service.currentList())
+	s.Equal(s.list.Version, s.service.currentList().Version)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestRefreshList_NewVersionRescreens() {
+	s.writeListFile()
+	user := s.customer("Maria", "Santos")
+	payee := models.ExternalAccount{ID: uuid.New(), UserID: user.ID, NameOnAccount: "Acme Supplies"}
+
+	var saved []models.SanctionsListLoad
+	s.sanctionsRepo.EXPECT().GetListLoad(gomock.Any(), s.list.Version).Return(nil, repositories.ErrSanctionsListLoadNotFound)
+	s.sanctionsRepo.EXPECT().SaveListLoad(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, load *models.SanctionsListLoad) error {
+		saved = append(saved, *load)
+		return nil
+	}).Times(2)
+	s.sanctionsRepo.EXPECT().ListCustomersAfter(gomock.Any(), uuid.Nil, sanctionsRescreenBatchSize).Return([]models.User{*user}, nil)
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectUser, user.ID).Return(nil, nil)
+	s.sanctionsRepo.EXPECT().CreateMatch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, match *models.SanctionsMatch) error {
+		s.Equal(user.ID, match.SubjectID)
+		s.Equal("Maria Santos", match.ScreenedName)
+		s.Equal("7000", match.EntryID)
+		s.Equal(100, match.Similarity)
+		s.Equal(models.SanctionsMatchActionBlock, match.Action)
+		s.Equal(s.list.Version, match.ListVersion)
+		return nil
+	})
+	s.sanctionsRepo.EXPECT().UpdateSubjectStatus(gomock.Any(), models.SanctionsSubjectUser, user.ID, models.SanctionsStatusBlocked).Return(nil)
+	s.sanctionsRepo.EXPECT().ListExternalAccountsAfter(gomock.Any(), uuid.Nil, sanctionsRescreenBatchSize).Return([]models.ExternalAccount{payee}, nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	s.Require().NoError(s.service.RefreshList(context.Background()))
+
+	s.Require().NotNil(s.service.currentList())
+	s.Equal(s.list.Version, s.service.currentList().Version)
+	s.Require().Len(saved, 2)
+	s.Equal(4, saved[0].EntryCount)
+	s.Nil(saved[0].RescreenedAt)
+	s.Require().NotNil(saved[1].RescreenedAt)
+	s.Equal(s.now, *saved[1].RescreenedAt)
+	s.Equal(2, saved[1].SubjectsCount)
+	s.Equal(1, saved[1].MatchesCount)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestRefreshList_VersionAlreadyRescreened() {
+	s.writeListFile()
+	rescreenedAt := s.now.Add(-time.Hour)
+	s.sanctionsRepo.EXPECT().GetListLoad(gomock.Any(), s.list.Version).Return(&models.SanctionsListLoad{
+		Version:      s.list.Version,
+		RescreenedAt: &rescreenedAt,
+	}, nil)
+
+	s.NoError(s.service.RefreshList(context.Background()))
+	s.NotNil(s.service.currentList())
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestRefreshList_FailedRescreenIsRetried() {
+	s.writeListFile()
+	user := s.customer("Maria", "Santos")
+
+	s.sanctionsRepo.EXPECT().GetListLoad(gomock.Any(), s.list.Version).Return(&models.SanctionsListLoad{Version: s.list.Version}, nil)
+	s.sanctionsRepo.EXPECT().ListCustomersAfter(gomock.Any(), uuid.Nil, sanctionsRescreenBatchSize).Return([]models.User{*user}, nil)
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectUser, user.ID).Return(nil, errors.New("db down"))
+	s.sanctionsRepo.EXPECT().ListExternalAccountsAfter(gomock.Any(), uuid.Nil, sanctionsRescreenBatchSize).Return(nil, nil)
+
+	err := s.service.RefreshList(context.Background())
+	s.ErrorContains(err, "failed to rescreen 1 of 1")
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestRescreen_ListNotLoaded() {
+	_, err := s.service.Rescreen(context.Background())
+	s.ErrorIs(err, ErrSanctionsListNotLoaded)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestScreenUser_ListNotLoaded() {
+	user := s.customer("Maria", "Santos")
+
+	s.NoError(s.service.ScreenUser(context.Background(), user))
+	s.Equal(models.SanctionsStatusClear, user.SanctionsStatus)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestScreenUser_AdminNotScreened() {
+	s.withList()
+	user := s.customer("Maria", "Santos")
+	user.Role = models.RoleAdmin
+
+	s.NoError(s.service.ScreenUser(context.Background(), user))
+	s.Equal(models.SanctionsStatusClear, user.SanctionsStatus)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestScreenUser_NoMatch() {
+	s.withList()
+	user := s.customer("Jane", "Doe")
+
+	s.NoError(s.service.ScreenUser(context.Background(), user))
+	s.Equal(models.SanctionsStatusClear, user.SanctionsStatus)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestScreenUser_WeakMatchPendsReview() {
+	s.withList()
+	user := s.customer("Maria", "Santoz")
+
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectUser, user.ID).Return(nil, nil)
+	s.sanctionsRepo.EXPECT().CreateMatch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, match *models.SanctionsMatch) error {
+		s.Equal(models.SanctionsMatchActionReview, match.Action)
+		s.Equal(91, match.Similarity)
+		return nil
+	})
+	s.sanctionsRepo.EXPECT().UpdateSubjectStatus(gomock.Any(), models.SanctionsSubjectUser, user.ID, models.SanctionsStatusPendingReview).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("sanctions_match.raised", log.Action)
+		return nil
+	})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("sanctions_status.changed", log.Action)
+		s.Equal(models.SanctionsStatusPendingReview, log.Metadata["to"])
+		return nil
+	})
+
+	s.NoError(s.service.ScreenUser(context.Background(), user))
+	s.Equal(models.SanctionsStatusPendingReview, user.SanctionsStatus)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestScreenUser_ClearedMatchNotRaisedAgain() {
+	s.withList()
+	user := s.customer("Maria", "Santos")
+	cleared := s.openMatch(models.SanctionsSubjectUser, user.ID, models.SanctionsMatchActionBlock)
+	cleared.Status = models.SanctionsMatchStatusCleared
+
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectUser, user.ID).Return([]models.SanctionsMatch{*cleared}, nil)
+
+	s.NoError(s.service.ScreenUser(context.Background(), user))
+	s.Equal(models.SanctionsStatusClear, user.SanctionsStatus)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestScreenUser_RenamedCustomerReleased() {
+	s.withList()
+	user := s.customer("Jane", "Doe")
+	user.SanctionsStatus = models.SanctionsStatusPendingReview
+	cleared := s.openMatch(models.SanctionsSubjectUser, user.ID, models.SanctionsMatchActionReview)
+	cleared.Status = models.SanctionsMatchStatusCleared
+
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectUser, user.ID).Return([]models.SanctionsMatch{*cleared}, nil)
+	s.sanctionsRepo.EXPECT().UpdateSubjectStatus(gomock.Any(), models.SanctionsSubjectUser, user.ID, models.SanctionsStatusClear).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.NoError(s.service.ScreenUser(context.Background(), user))
+	s.Equal(models.SanctionsStatusClear, user.SanctionsStatus)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestScreenExternalAccount_EntityMatch() {
+	s.withList()
+	account := &models.ExternalAccount{ID: uuid.New(), UserID: uuid.New(), NameOnAccount: "Banco Nacional de Cuba"}
+
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectExternalAccount, account.ID).Return(nil, nil)
+	s.sanctionsRepo.EXPECT().CreateMatch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, match *models.SanctionsMatch) error {
+		s.Equal(account.UserID, match.UserID)
+		s.Equal("306", match.EntryID)
+		return nil
+	})
+	s.sanctionsRepo.EXPECT().UpdateSubjectStatus(gomock.Any(), models.SanctionsSubjectExternalAccount, account.ID, models.SanctionsStatusBlocked).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	s.NoError(s.service.ScreenExternalAccount(context.Background(), account))
+	s.Equal(models.SanctionsStatusBlocked, account.SanctionsStatus)
+	s.True(account.IsSanctionsHeld())
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestCheckUser() {
+	tests := []struct {
+		name    string
+		status  string
+		wantErr error
+	}{
+		{name: "clear", status: models.SanctionsStatusClear},
+		{name: "pending review", status: models.SanctionsStatusPendingReview, wantErr: ErrSanctionsHold},
+		{name: "blocked", status: models.SanctionsStatusBlocked, wantErr: ErrSanctionsHold},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			user := s.customer("Jane", "Doe")
+			user.SanctionsStatus = tt.status
+			s.userRepo.EXPECT().GetByID(gomock.Any(), user.ID).Return(user, nil)
+
+			err := s.service.CheckUser(context.Background(), user.ID)
+			if tt.wantErr != nil {
+				s.ErrorIs(err, tt.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestCheckUser_LookupFails() {
+	userID := uuid.New()
+	s.userRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, repositories.ErrUserNotFound)
+
+	err := s.service.CheckUser(context.Background(), userID)
+	s.ErrorIs(err, repositories.ErrUserNotFound)
+	s.NotErrorIs(err, ErrSanctionsHold)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestGetListStatus_NotLoaded() {
+	s.sanctionsRepo.EXPECT().GetLatestListLoad(gomock.Any()).Return(nil, repositories.ErrSanctionsListLoadNotFound)
+
+	_, err := s.service.GetListStatus(context.Background())
+	s.ErrorIs(err, ErrSanctionsListNotLoaded)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestGetMatch_NotFound() {
+	matchID := uuid.New()
+	s.sanctionsRepo.EXPECT().GetMatchByID(gomock.Any(), matchID).Return(nil, repositories.ErrSanctionsMatchNotFound)
+
+	_, err := s.service.GetMatch(context.Background(), matchID)
+	s.ErrorIs(err, ErrSanctionsMatchNotFound)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestClearMatch_ReleasesSubject() {
+	adminID := uuid.New()
+	match := s.openMatch(models.SanctionsSubjectUser, uuid.New(), models.SanctionsMatchActionBlock)
+
+	s.sanctionsRepo.EXPECT().GetMatchByID(gomock.Any(), match.ID).Return(match, nil)
+	s.sanctionsRepo.EXPECT().UpdateMatch(gomock.Any(), match).Return(nil)
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectUser, match.SubjectID).DoAndReturn(
+		func(context.Context, string, uuid.UUID) ([]models.SanctionsMatch, error) {
+			return []models.SanctionsMatch{*match}, nil
+		})
+	s.sanctionsRepo.EXPECT().UpdateSubjectStatus(gomock.Any(), models.SanctionsSubjectUser, match.SubjectID, models.SanctionsStatusClear).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("sanctions_match.cleared", log.Action)
+		s.Equal(&adminID, log.UserID)
+		return nil
+	})
+
+	reviewed, err := s.service.ClearMatch(context.Background(), adminID, match.ID, "Different date of birth")
+	s.Require().NoError(err)
+	s.Equal(models.SanctionsMatchStatusCleared, reviewed.Status)
+	s.Equal(&adminID, reviewed.ReviewedBy)
+	s.NotNil(reviewed.ReviewedAt)
+	s.Equal("Different date of birth", reviewed.ReviewNote)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestClearMatch_OtherOpenMatchKeepsHold() {
+	match := s.openMatch(models.SanctionsSubjectUser, uuid.New(), models.SanctionsMatchActionBlock)
+	other := s.openMatch(models.SanctionsSubjectUser, match.SubjectID, models.SanctionsMatchActionReview)
+
+	s.sanctionsRepo.EXPECT().GetMatchByID(gomock.Any(), match.ID).Return(match, nil)
+	s.sanctionsRepo.EXPECT().UpdateMatch(gomock.Any(), match).Return(nil)
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectUser, match.SubjectID).DoAndReturn(
+		func(context.Context, string, uuid.UUID) ([]models.SanctionsMatch, error) {
+			return []models.SanctionsMatch{*match, *other}, nil
+		})
+	s.sanctionsRepo.EXPECT().UpdateSubjectStatus(gomock.Any(), models.SanctionsSubjectUser, match.SubjectID, models.SanctionsStatusPendingReview).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := s.service.ClearMatch(context.Background(), uuid.New(), match.ID, "Different person")
+	s.NoError(err)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestConfirmMatch_BlocksSubject() {
+	match := s.openMatch(models.SanctionsSubjectExternalAccount, uuid.New(), models.SanctionsMatchActionReview)
+
+	s.sanctionsRepo.EXPECT().GetMatchByID(gomock.Any(), match.ID).Return(match, nil)
+	s.sanctionsRepo.EXPECT().UpdateMatch(gomock.Any(), match).Return(nil)
+	s.sanctionsRepo.EXPECT().ListMatchesForSubject(gomock.Any(), models.SanctionsSubjectExternalAccount, match.SubjectID).DoAndReturn(
+		func(context.Context, string, uuid.UUID) ([]models.SanctionsMatch, error) {
+			return []models.SanctionsMatch{*match}, nil
+		})
+	s.sanctionsRepo.EXPECT().UpdateSubjectStatus(gomock.Any(), models.SanctionsSubjectExternalAccount, match.SubjectID, models.SanctionsStatusBlocked).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("sanctions_match.confirmed", log.Action)
+		return nil
+	})
+
+	reviewed, err := s.service.ConfirmMatch(context.Background(), uuid.New(), match.ID, "Confirmed by compliance")
+	s.Require().NoError(err)
+	s.Equal(models.SanctionsMatchStatusConfirmed, reviewed.Status)
+}
+
+func (s *SanctionsScreeningServiceTestSuite) TestReviewMatch_AlreadyReviewed() {
+	match := s.openMatch(models.SanctionsSubjectUser, uuid.New(), models.SanctionsMatchActionBlock)
+	match.Status = models.SanctionsMatchStatusConfirmed
+	s.sanctionsRepo.EXPECT().GetMatchByID(gomock.Any(), match.ID).Return(match, nil)
+
+	_, err := s.service.ClearMatch(context.Background(), uuid.New(), match.ID, "note")
+	s.ErrorIs(err, ErrSanctionsMatchNotOpen)
+}
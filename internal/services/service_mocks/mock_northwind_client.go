@@ -1,109 +0,0 @@
-// Code generated by MockGen. DO NOT EDIT.
-// Source: internal/services/interfaces.go
-
-package service_mocks
-
-import (
-	context "context"
-	reflect "reflect"
-
-	dto "github.com/array/banking-api/internal/dto"
-	gomock "github.com/golang/mock/gomock"
-)
-
-// MockNorthwindClientInterface is a mock of NorthwindClientInterface interface.
-type MockNorthwindClientInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockNorthwindClientInterfaceMockRecorder
-}
-
-// MockNorthwindClientInterfaceMockRecorder is the mock recorder for MockNorthwindClientInterface.
-type MockNorthwindClientInterfaceMockRecorder struct {
-	mock *MockNorthwindClientInterface
-}
-
-// NewMockNorthwindClientInterface creates a new mock instance.
-func NewMockNorthwindClientInterface(ctrl *gomock.Controller) *MockNorthwindClientInterface {
-	mock := &MockNorthwindClientInterface{ctrl: ctrl}
-	mock.recorder = &MockNorthwindClientInterfaceMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockNorthwindClientInterface) EXPECT() *MockNorthwindClientInterfaceMockRecorder {
-	return m.recorder
-}
-
-// HealthCheck mocks base method.
-func (m *MockNorthwindClientInterface) HealthCheck(ctx context.Context) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HealthCheck", ctx)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// HealthCheck indicates an expected call of HealthCheck.
-func (mr *MockNorthwindClientInterfaceMockRecorder) HealthCheck(ctx interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockNorthwindClientInterface)(nil).HealthCheck), ctx)
-}
-
-// CreateExternalAccount mocks base method.
-func (m *MockNorthwindClientInterface) CreateExternalAccount(ctx context.Context, details *dto.NorthwindCreateAccountRequest) (*dto.NorthwindExternalAccountResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateExternalAccount", ctx, details)
-	ret0, _ := ret[0].(*dto.NorthwindExternalAccountResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateExternalAccount indicates an expected call of CreateExternalAccount.
-func (mr *MockNorthwindClientInterfaceMockRecorder) CreateExternalAccount(ctx, details interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalAccount", reflect.TypeOf((*MockNorthwindClientInterface)(nil).CreateExternalAccount), ctx, details)
-}
-
-// GetTransfer mocks base method.
-func (m *MockNorthwindClientInterface) GetTransfer(ctx context.Context, transferID string) (*dto.NorthwindGetTransferResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", ctx, transferID)
-	ret0, _ := ret[0].(*dto.NorthwindGetTransferResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockNorthwindClientInterfaceMockRecorder) GetTransfer(ctx, transferID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockNorthwindClientInterface)(nil).GetTransfer), ctx, transferID)
-}
-
-// InitiateTransfer mocks base method.
-func (m *MockNorthwindClientInterface) InitiateTransfer(ctx context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitiateTransfer", ctx, req)
-	ret0, _ := ret[0].(*dto.NorthwindInitiateTransferResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// InitiateTransfer indicates an expected call of InitiateTransfer.
-func (mr *MockNorthwindClientInterfaceMockRecorder) InitiateTransfer(ctx, req interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateTransfer", reflect.TypeOf((*MockNorthwindClientInterface)(nil).InitiateTransfer), ctx, req)
-}
-
-// InitiateTransfer mocks base method.
-func (m *MockNorthwindClientInterface) InitiateTransfer(ctx context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitiateTransfer", ctx, req)
-	ret0, _ := ret[0].(*dto.NorthwindInitiateTransferResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// InitiateTransfer indicates an expected call of InitiateTransfer.
-func (mr *MockNorthwindClientInterfaceMockRecorder) InitiateTransfer(ctx, req interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateTransfer", reflect.TypeOf((*MockNorthwindClientInterface)(nil).InitiateTransfer), ctx, req)
-}
@@ -40,47 +40,47 @@ func (m *MockAccountAssociationServiceInterface) EXPECT() *MockAccountAssociatio
 }
 
 // CreateAccountForCustomer mocks base method.
-func (m *MockAccountAssociationServiceInterface) CreateAccountForCustomer(customerID, performedBy uuid.UUID, accountType, ipAddress, userAgent string) (*models.Account, error) {
+func (m *MockAccountAssociationServiceInterface) CreateAccountForCustomer(ctx context.Context, customerID, performedBy uuid.UUID, accountType, ipAddress, userAgent string) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccountForCustomer", customerID, performedBy, accountType, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "CreateAccountForCustomer", ctx, customerID, performedBy, accountType, ipAddress, userAgent)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAccountForCustomer indicates an expected call of CreateAccountForCustomer.
-func (mr *MockAccountAssociationServiceInterfaceMockRecorder) CreateAccountForCustomer(customerID, performedBy, accountType, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAccountAssociationServiceInterfaceMockRecorder) CreateAccountForCustomer(ctx, customerID, performedBy, accountType, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountForCustomer", reflect.TypeOf((*MockAccountAssociationServiceInterface)(nil).CreateAccountForCustomer), customerID, performedBy, accountType, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountForCustomer", reflect.TypeOf((*MockAccountAssociationServiceInterface)(nil).CreateAccountForCustomer), ctx, customerID, performedBy, accountType, ipAddress, userAgent)
 }
 
 // GetCustomerAccounts mocks base method.
-func (m *MockAccountAssociationServiceInterface) GetCustomerAccounts(customerID uuid.UUID) ([]*models.Account, error) {
+func (m *MockAccountAssociationServiceInterface) GetCustomerAccounts(ctx context.Context, customerID uuid.UUID) ([]*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerAccounts", customerID)
+	ret := m.ctrl.Call(m, "GetCustomerAccounts", ctx, customerID)
 	ret0, _ := ret[0].([]*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCustomerAccounts indicates an expected call of GetCustomerAccounts.
-func (mr *MockAccountAssociationServiceInterfaceMockRecorder) GetCustomerAccounts(customerID interface{}) *gomock.Call {
+func (mr *MockAccountAssociationServiceInterfaceMockRecorder) GetCustomerAccounts(ctx, customerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerAccounts", reflect.TypeOf((*MockAccountAssociationServiceInterface)(nil).GetCustomerAccounts), customerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerAccounts", reflect.TypeOf((*MockAccountAssociationServiceInterface)(nil).GetCustomerAccounts), ctx, customerID)
 }
 
 // TransferAccountOwnership mocks base method.
-func (m *MockAccountAssociationServiceInterface) TransferAccountOwnership(accountID, fromCustomerID, toCustomerID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+func (m *MockAccountAssociationServiceInterface) TransferAccountOwnership(ctx context.Context, accountID, fromCustomerID, toCustomerID, performedBy uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TransferAccountOwnership", accountID, fromCustomerID, toCustomerID, performedBy, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "TransferAccountOwnership", ctx, accountID, fromCustomerID, toCustomerID, performedBy, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // TransferAccountOwnership indicates an expected call of TransferAccountOwnership.
-func (mr *MockAccountAssociationServiceInterfaceMockRecorder) TransferAccountOwnership(accountID, fromCustomerID, toCustomerID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAccountAssociationServiceInterfaceMockRecorder) TransferAccountOwnership(ctx, accountID, fromCustomerID, toCustomerID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccountOwnership", reflect.TypeOf((*MockAccountAssociationServiceInterface)(nil).TransferAccountOwnership), accountID, fromCustomerID, toCustomerID, performedBy, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccountOwnership", reflect.TypeOf((*MockAccountAssociationServiceInterface)(nil).TransferAccountOwnership), ctx, accountID, fromCustomerID, toCustomerID, performedBy, ipAddress, userAgent)
 }
 
 // MockAccountServiceInterface is a mock of AccountServiceInterface interface.
@@ -106,83 +106,112 @@ func (m *MockAccountServiceInterface) EXPECT() *MockAccountServiceInterfaceMockR
 	return m.recorder
 }
 
+// ApproveHeldTransfer mocks base method.
+func (m *MockAccountServiceInterface) ApproveHeldTransfer(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveHeldTransfer", ctx, adminID, reviewID, note)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveHeldTransfer indicates an expected call of ApproveHeldTransfer.
+func (mr *MockAccountServiceInterfaceMockRecorder) ApproveHeldTransfer(ctx, adminID, reviewID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveHeldTransfer", reflect.TypeOf((*MockAccountServiceInterface)(nil).ApproveHeldTransfer), ctx, adminID, reviewID, note)
+}
+
 // CloseAccount mocks base method.
-func (m *MockAccountServiceInterface) CloseAccount(accountID, userID uuid.UUID) error {
+func (m *MockAccountServiceInterface) CloseAccount(ctx context.Context, accountID, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseAccount", accountID, userID)
+	ret := m.ctrl.Call(m, "CloseAccount", ctx, accountID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CloseAccount indicates an expected call of CloseAccount.
-func (mr *MockAccountServiceInterfaceMockRecorder) CloseAccount(accountID, userID interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) CloseAccount(ctx, accountID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockAccountServiceInterface)(nil).CloseAccount), ctx, accountID, userID)
+}
+
+// CompleteExternalTransfer mocks base method.
+func (m *MockAccountServiceInterface) CompleteExternalTransfer(ctx context.Context, transfer *models.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteExternalTransfer", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteExternalTransfer indicates an expected call of CompleteExternalTransfer.
+func (mr *MockAccountServiceInterfaceMockRecorder) CompleteExternalTransfer(ctx, transfer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockAccountServiceInterface)(nil).CloseAccount), accountID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteExternalTransfer", reflect.TypeOf((*MockAccountServiceInterface)(nil).CompleteExternalTransfer), ctx, transfer)
 }
 
 // CreateAccount mocks base method.
-func (m *MockAccountServiceInterface) CreateAccount(userID uuid.UUID, accountType string, initialDeposit decimal.Decimal) (*models.Account, error) {
+func (m *MockAccountServiceInterface) CreateAccount(ctx context.Context, userID uuid.UUID, accountType string, initialDeposit decimal.Decimal) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", userID, accountType, initialDeposit)
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, userID, accountType, initialDeposit)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockAccountServiceInterfaceMockRecorder) CreateAccount(userID, accountType, initialDeposit interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) CreateAccount(ctx, userID, accountType, initialDeposit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountServiceInterface)(nil).CreateAccount), userID, accountType, initialDeposit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountServiceInterface)(nil).CreateAccount), ctx, userID, accountType, initialDeposit)
 }
 
 // CreateAccountsForNewUser mocks base method.
-func (m *MockAccountServiceInterface) CreateAccountsForNewUser(userID uuid.UUID) error {
+func (m *MockAccountServiceInterface) CreateAccountsForNewUser(ctx context.Context, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccountsForNewUser", userID)
+	ret := m.ctrl.Call(m, "CreateAccountsForNewUser", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAccountsForNewUser indicates an expected call of CreateAccountsForNewUser.
-func (mr *MockAccountServiceInterfaceMockRecorder) CreateAccountsForNewUser(userID interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) CreateAccountsForNewUser(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountsForNewUser", reflect.TypeOf((*MockAccountServiceInterface)(nil).CreateAccountsForNewUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountsForNewUser", reflect.TypeOf((*MockAccountServiceInterface)(nil).CreateAccountsForNewUser), ctx, userID)
 }
 
 // GetAccountByID mocks base method.
-func (m *MockAccountServiceInterface) GetAccountByID(accountID uuid.UUID, userID *uuid.UUID) (*models.Account, error) {
+func (m *MockAccountServiceInterface) GetAccountByID(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountByID", accountID, userID)
+	ret := m.ctrl.Call(m, "GetAccountByID", ctx, accountID, userID)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAccountByID indicates an expected call of GetAccountByID.
-func (mr *MockAccountServiceInterfaceMockRecorder) GetAccountByID(accountID, userID interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) GetAccountByID(ctx, accountID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByID", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAccountByID), accountID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByID", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAccountByID), ctx, accountID, userID)
 }
 
 // GetAccountByNumber mocks base method.
-func (m *MockAccountServiceInterface) GetAccountByNumber(accountNumber string) (*models.Account, error) {
+func (m *MockAccountServiceInterface) GetAccountByNumber(ctx context.Context, accountNumber string) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountByNumber", accountNumber)
+	ret := m.ctrl.Call(m, "GetAccountByNumber", ctx, accountNumber)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAccountByNumber indicates an expected call of GetAccountByNumber.
-func (mr *MockAccountServiceInterfaceMockRecorder) GetAccountByNumber(accountNumber interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) GetAccountByNumber(ctx, accountNumber interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNumber", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAccountByNumber), accountNumber)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNumber", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAccountByNumber), ctx, accountNumber)
 }
 
 // GetAccountTransactions mocks base method.
-func (m *MockAccountServiceInterface) GetAccountTransactions(accountID uuid.UUID, userID *uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
+func (m *MockAccountServiceInterface) GetAccountTransactions(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, offset, limit int) ([]models.Transaction, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountTransactions", accountID, userID, offset, limit)
+	ret := m.ctrl.Call(m, "GetAccountTransactions", ctx, accountID, userID, offset, limit)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -190,15 +219,15 @@ func (m *MockAccountServiceInterface) GetAccountTransactions(accountID uuid.UUID
 }
 
 // GetAccountTransactions indicates an expected call of GetAccountTransactions.
-func (mr *MockAccountServiceInterfaceMockRecorder) GetAccountTransactions(accountID, userID, offset, limit interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) GetAccountTransactions(ctx, accountID, userID, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTransactions", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAccountTransactions), accountID, userID, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTransactions", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAccountTransactions), ctx, accountID, userID, offset, limit)
 }
 
 // GetAllAccounts mocks base method.
-func (m *MockAccountServiceInterface) GetAllAccounts(filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
+func (m *MockAccountServiceInterface) GetAllAccounts(ctx context.Context, filters models.AccountFilters, offset, limit int) ([]models.Account, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllAccounts", filters, offset, limit)
+	ret := m.ctrl.Call(m, "GetAllAccounts", ctx, filters, offset, limit)
 	ret0, _ := ret[0].([]models.Account)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -206,45 +235,60 @@ func (m *MockAccountServiceInterface) GetAllAccounts(filters models.AccountFilte
 }
 
 // GetAllAccounts indicates an expected call of GetAllAccounts.
-func (mr *MockAccountServiceInterfaceMockRecorder) GetAllAccounts(filters, offset, limit interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) GetAllAccounts(ctx, filters, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAccounts", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAllAccounts), filters, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAccounts", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetAllAccounts), ctx, filters, offset, limit)
 }
 
 // GetRecentTransactions mocks base method.
-func (m *MockAccountServiceInterface) GetRecentTransactions(accountID uuid.UUID, userID *uuid.UUID, limit int) ([]models.Transaction, error) {
+func (m *MockAccountServiceInterface) GetRecentTransactions(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, limit int) ([]models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecentTransactions", accountID, userID, limit)
+	ret := m.ctrl.Call(m, "GetRecentTransactions", ctx, accountID, userID, limit)
 	ret0, _ := ret[0].([]models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRecentTransactions indicates an expected call of GetRecentTransactions.
-func (mr *MockAccountServiceInterfaceMockRecorder) GetRecentTransactions(accountID, userID, limit interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) GetRecentTransactions(ctx, accountID, userID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentTransactions", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetRecentTransactions), accountID, userID, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentTransactions", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetRecentTransactions), ctx, accountID, userID, limit)
+}
+
+// GetTransactionOperation mocks base method.
+func (m *MockAccountServiceInterface) GetTransactionOperation(ctx context.Context, accountID, operationID uuid.UUID, userID *uuid.UUID) (*models.TransactionOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionOperation", ctx, accountID, operationID, userID)
+	ret0, _ := ret[0].(*models.TransactionOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionOperation indicates an expected call of GetTransactionOperation.
+func (mr *MockAccountServiceInterfaceMockRecorder) GetTransactionOperation(ctx, accountID, operationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionOperation", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetTransactionOperation), ctx, accountID, operationID, userID)
 }
 
 // GetUserAccounts mocks base method.
-func (m *MockAccountServiceInterface) GetUserAccounts(userID uuid.UUID) ([]models.Account, error) {
+func (m *MockAccountServiceInterface) GetUserAccounts(ctx context.Context, userID uuid.UUID) ([]models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAccounts", userID)
+	ret := m.ctrl.Call(m, "GetUserAccounts", ctx, userID)
 	ret0, _ := ret[0].([]models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserAccounts indicates an expected call of GetUserAccounts.
-func (mr *MockAccountServiceInterfaceMockRecorder) GetUserAccounts(userID interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) GetUserAccounts(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccounts", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetUserAccounts), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccounts", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetUserAccounts), ctx, userID)
 }
 
 // GetUserTransfers mocks base method.
-func (m *MockAccountServiceInterface) GetUserTransfers(userID uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
+func (m *MockAccountServiceInterface) GetUserTransfers(ctx context.Context, userID uuid.UUID, filters models.TransferFilters, offset, limit int) ([]models.Transfer, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserTransfers", userID, filters, offset, limit)
+	ret := m.ctrl.Call(m, "GetUserTransfers", ctx, userID, filters, offset, limit)
 	ret0, _ := ret[0].([]models.Transfer)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -252,9 +296,9 @@ func (m *MockAccountServiceInterface) GetUserTransfers(userID uuid.UUID, filters
 }
 
 // GetUserTransfers indicates an expected call of GetUserTransfers.
-func (mr *MockAccountServiceInterfaceMockRecorder) GetUserTransfers(userID, filters, offset, limit interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) GetUserTransfers(ctx, userID, filters, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransfers", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetUserTransfers), userID, filters, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransfers", reflect.TypeOf((*MockAccountServiceInterface)(nil).GetUserTransfers), ctx, userID, filters, offset, limit)
 }
 
 // HandleFailedExternalTransfer mocks base method.
@@ -287,48 +331,77 @@ func (mr *MockAccountServiceInterfaceMockRecorder) InitiateExternalTransfer(ctx,
 }
 
 // PerformTransaction mocks base method.
-func (m *MockAccountServiceInterface) PerformTransaction(accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error) {
+func (m *MockAccountServiceInterface) PerformTransaction(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PerformTransaction", accountID, amount, transactionType, description, userID)
+	ret := m.ctrl.Call(m, "PerformTransaction", ctx, accountID, amount, transactionType, description, userID)
 	ret0, _ := ret[0].(*models.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PerformTransaction indicates an expected call of PerformTransaction.
-func (mr *MockAccountServiceInterfaceMockRecorder) PerformTransaction(accountID, amount, transactionType, description, userID interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) PerformTransaction(ctx, accountID, amount, transactionType, description, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PerformTransaction", reflect.TypeOf((*MockAccountServiceInterface)(nil).PerformTransaction), ctx, accountID, amount, transactionType, description, userID)
+}
+
+// ResumeExternalTransfer mocks base method.
+func (m *MockAccountServiceInterface) ResumeExternalTransfer(ctx context.Context, transferID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeExternalTransfer", ctx, transferID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeExternalTransfer indicates an expected call of ResumeExternalTransfer.
+func (mr *MockAccountServiceInterfaceMockRecorder) ResumeExternalTransfer(ctx, transferID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PerformTransaction", reflect.TypeOf((*MockAccountServiceInterface)(nil).PerformTransaction), accountID, amount, transactionType, description, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeExternalTransfer", reflect.TypeOf((*MockAccountServiceInterface)(nil).ResumeExternalTransfer), ctx, transferID)
+}
+
+// SubmitTransaction mocks base method.
+func (m *MockAccountServiceInterface) SubmitTransaction(ctx context.Context, accountID uuid.UUID, amount decimal.Decimal, transactionType, description string, userID *uuid.UUID) (*models.TransactionOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitTransaction", ctx, accountID, amount, transactionType, description, userID)
+	ret0, _ := ret[0].(*models.TransactionOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitTransaction indicates an expected call of SubmitTransaction.
+func (mr *MockAccountServiceInterfaceMockRecorder) SubmitTransaction(ctx, accountID, amount, transactionType, description, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTransaction", reflect.TypeOf((*MockAccountServiceInterface)(nil).SubmitTransaction), ctx, accountID, amount, transactionType, description, userID)
 }
 
 // TransferBetweenAccounts mocks base method.
-func (m *MockAccountServiceInterface) TransferBetweenAccounts(fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description, idempotencyKey string, userID uuid.UUID) (*models.Transfer, error) {
+func (m *MockAccountServiceInterface) TransferBetweenAccounts(ctx context.Context, fromAccountID, toAccountID uuid.UUID, amount decimal.Decimal, description, idempotencyKey string, userID uuid.UUID) (*models.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TransferBetweenAccounts", fromAccountID, toAccountID, amount, description, idempotencyKey, userID)
+	ret := m.ctrl.Call(m, "TransferBetweenAccounts", ctx, fromAccountID, toAccountID, amount, description, idempotencyKey, userID)
 	ret0, _ := ret[0].(*models.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TransferBetweenAccounts indicates an expected call of TransferBetweenAccounts.
-func (mr *MockAccountServiceInterfaceMockRecorder) TransferBetweenAccounts(fromAccountID, toAccountID, amount, description, idempotencyKey, userID interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) TransferBetweenAccounts(ctx, fromAccountID, toAccountID, amount, description, idempotencyKey, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferBetweenAccounts", reflect.TypeOf((*MockAccountServiceInterface)(nil).TransferBetweenAccounts), fromAccountID, toAccountID, amount, description, idempotencyKey, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferBetweenAccounts", reflect.TypeOf((*MockAccountServiceInterface)(nil).TransferBetweenAccounts), ctx, fromAccountID, toAccountID, amount, description, idempotencyKey, userID)
 }
 
 // UpdateAccountStatus mocks base method.
-func (m *MockAccountServiceInterface) UpdateAccountStatus(accountID uuid.UUID, userID *uuid.UUID, status string) (*models.Account, error) {
+func (m *MockAccountServiceInterface) UpdateAccountStatus(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID, status string) (*models.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccountStatus", accountID, userID, status)
+	ret := m.ctrl.Call(m, "UpdateAccountStatus", ctx, accountID, userID, status)
 	ret0, _ := ret[0].(*models.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAccountStatus indicates an expected call of UpdateAccountStatus.
-func (mr *MockAccountServiceInterfaceMockRecorder) UpdateAccountStatus(accountID, userID, status interface{}) *gomock.Call {
+func (mr *MockAccountServiceInterfaceMockRecorder) UpdateAccountStatus(ctx, accountID, userID, status interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatus", reflect.TypeOf((*MockAccountServiceInterface)(nil).UpdateAccountStatus), accountID, userID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatus", reflect.TypeOf((*MockAccountServiceInterface)(nil).UpdateAccountStatus), ctx, accountID, userID, status)
 }
 
 // MockAccountSummaryServiceInterface is a mock of AccountSummaryServiceInterface interface.
@@ -355,18 +428,18 @@ func (m *MockAccountSummaryServiceInterface) EXPECT() *MockAccountSummaryService
 }
 
 // GetAccountSummary mocks base method.
-func (m *MockAccountSummaryServiceInterface) GetAccountSummary(requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error) {
+func (m *MockAccountSummaryServiceInterface) GetAccountSummary(ctx context.Context, requestorID uuid.UUID, targetUserID *uuid.UUID, isAdmin bool) (*models.UserAccountSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountSummary", requestorID, targetUserID, isAdmin)
+	ret := m.ctrl.Call(m, "GetAccountSummary", ctx, requestorID, targetUserID, isAdmin)
 	ret0, _ := ret[0].(*models.UserAccountSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAccountSummary indicates an expected call of GetAccountSummary.
-func (mr *MockAccountSummaryServiceInterfaceMockRecorder) GetAccountSummary(requestorID, targetUserID, isAdmin interface{}) *gomock.Call {
+func (mr *MockAccountSummaryServiceInterfaceMockRecorder) GetAccountSummary(ctx, requestorID, targetUserID, isAdmin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSummary", reflect.TypeOf((*MockAccountSummaryServiceInterface)(nil).GetAccountSummary), requestorID, targetUserID, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSummary", reflect.TypeOf((*MockAccountSummaryServiceInterface)(nil).GetAccountSummary), ctx, requestorID, targetUserID, isAdmin)
 }
 
 // MockAuditServiceInterface is a mock of AuditServiceInterface interface.
@@ -393,23 +466,23 @@ func (m *MockAuditServiceInterface) EXPECT() *MockAuditServiceInterfaceMockRecor
 }
 
 // CreateAuditLog mocks base method.
-func (m *MockAuditServiceInterface) CreateAuditLog(log *models.AuditLog) error {
+func (m *MockAuditServiceInterface) CreateAuditLog(ctx context.Context, log *models.AuditLog) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAuditLog", log)
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, log)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAuditLog indicates an expected call of CreateAuditLog.
-func (mr *MockAuditServiceInterfaceMockRecorder) CreateAuditLog(log interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) CreateAuditLog(ctx, log interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockAuditServiceInterface)(nil).CreateAuditLog), log)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockAuditServiceInterface)(nil).CreateAuditLog), ctx, log)
 }
 
 // GetCustomerActivity mocks base method.
-func (m *MockAuditServiceInterface) GetCustomerActivity(userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
+func (m *MockAuditServiceInterface) GetCustomerActivity(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, offset, limit int) ([]*models.AuditLog, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerActivity", userID, startDate, endDate, offset, limit)
+	ret := m.ctrl.Call(m, "GetCustomerActivity", ctx, userID, startDate, endDate, offset, limit)
 	ret0, _ := ret[0].([]*models.AuditLog)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -417,149 +490,149 @@ func (m *MockAuditServiceInterface) GetCustomerActivity(userID uuid.UUID, startD
 }
 
 // GetCustomerActivity indicates an expected call of GetCustomerActivity.
-func (mr *MockAuditServiceInterfaceMockRecorder) GetCustomerActivity(userID, startDate, endDate, offset, limit interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) GetCustomerActivity(ctx, userID, startDate, endDate, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerActivity", reflect.TypeOf((*MockAuditServiceInterface)(nil).GetCustomerActivity), userID, startDate, endDate, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerActivity", reflect.TypeOf((*MockAuditServiceInterface)(nil).GetCustomerActivity), ctx, userID, startDate, endDate, offset, limit)
 }
 
 // LogAccountCreated mocks base method.
-func (m *MockAuditServiceInterface) LogAccountCreated(userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogAccountCreated(ctx context.Context, userID, performedBy, accountID uuid.UUID, accountType, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogAccountCreated", userID, performedBy, accountID, accountType, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogAccountCreated", ctx, userID, performedBy, accountID, accountType, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogAccountCreated indicates an expected call of LogAccountCreated.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountCreated(userID, performedBy, accountID, accountType, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountCreated(ctx, userID, performedBy, accountID, accountType, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountCreated), userID, performedBy, accountID, accountType, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountCreated), ctx, userID, performedBy, accountID, accountType, ipAddress, userAgent)
 }
 
 // LogAccountTransferred mocks base method.
-func (m *MockAuditServiceInterface) LogAccountTransferred(fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogAccountTransferred(ctx context.Context, fromUserID, toUserID, performedBy, accountID uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogAccountTransferred", fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogAccountTransferred", ctx, fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogAccountTransferred indicates an expected call of LogAccountTransferred.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountTransferred(fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogAccountTransferred(ctx, fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountTransferred", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountTransferred), fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogAccountTransferred", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogAccountTransferred), ctx, fromUserID, toUserID, performedBy, accountID, ipAddress, userAgent)
 }
 
 // LogCustomerCreated mocks base method.
-func (m *MockAuditServiceInterface) LogCustomerCreated(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogCustomerCreated(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogCustomerCreated", userID, performedBy, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogCustomerCreated", ctx, userID, performedBy, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogCustomerCreated indicates an expected call of LogCustomerCreated.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerCreated(userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerCreated(ctx, userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerCreated), userID, performedBy, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerCreated", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerCreated), ctx, userID, performedBy, ipAddress, userAgent)
 }
 
 // LogCustomerDeleted mocks base method.
-func (m *MockAuditServiceInterface) LogCustomerDeleted(userID, performedBy uuid.UUID, ipAddress, userAgent, reason string) error {
+func (m *MockAuditServiceInterface) LogCustomerDeleted(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogCustomerDeleted", userID, performedBy, ipAddress, userAgent, reason)
+	ret := m.ctrl.Call(m, "LogCustomerDeleted", ctx, userID, performedBy, ipAddress, userAgent, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogCustomerDeleted indicates an expected call of LogCustomerDeleted.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerDeleted(userID, performedBy, ipAddress, userAgent, reason interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogCustomerDeleted(ctx, userID, performedBy, ipAddress, userAgent, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerDeleted", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerDeleted), userID, performedBy, ipAddress, userAgent, reason)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCustomerDeleted", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogCustomerDeleted), ctx, userID, performedBy, ipAddress, userAgent, reason)
 }
 
 // LogEmailUpdate mocks base method.
-func (m *MockAuditServiceInterface) LogEmailUpdate(userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogEmailUpdate(ctx context.Context, userID, performedBy uuid.UUID, oldEmail, newEmail, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogEmailUpdate", userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogEmailUpdate", ctx, userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogEmailUpdate indicates an expected call of LogEmailUpdate.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogEmailUpdate(userID, performedBy, oldEmail, newEmail, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogEmailUpdate(ctx, userID, performedBy, oldEmail, newEmail, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEmailUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogEmailUpdate), userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEmailUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogEmailUpdate), ctx, userID, performedBy, oldEmail, newEmail, ipAddress, userAgent)
 }
 
 // LogLogin mocks base method.
-func (m *MockAuditServiceInterface) LogLogin(userID uuid.UUID, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogLogin(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogLogin", userID, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogLogin", ctx, userID, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogLogin indicates an expected call of LogLogin.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogLogin(userID, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogLogin(ctx, userID, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogin", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogin), userID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogin", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogin), ctx, userID, ipAddress, userAgent)
 }
 
 // LogLogout mocks base method.
-func (m *MockAuditServiceInterface) LogLogout(userID uuid.UUID, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogLogout(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogLogout", userID, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogLogout", ctx, userID, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogLogout indicates an expected call of LogLogout.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogLogout(userID, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogLogout(ctx, userID, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogout", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogout), userID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogLogout", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogLogout), ctx, userID, ipAddress, userAgent)
 }
 
 // LogPasswordReset mocks base method.
-func (m *MockAuditServiceInterface) LogPasswordReset(userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogPasswordReset(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogPasswordReset", userID, performedBy, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogPasswordReset", ctx, userID, performedBy, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogPasswordReset indicates an expected call of LogPasswordReset.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordReset(userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordReset(ctx, userID, performedBy, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordReset", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordReset), userID, performedBy, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordReset", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordReset), ctx, userID, performedBy, ipAddress, userAgent)
 }
 
 // LogPasswordUpdate mocks base method.
-func (m *MockAuditServiceInterface) LogPasswordUpdate(userID uuid.UUID, ipAddress, userAgent string) error {
+func (m *MockAuditServiceInterface) LogPasswordUpdate(ctx context.Context, userID uuid.UUID, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogPasswordUpdate", userID, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "LogPasswordUpdate", ctx, userID, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogPasswordUpdate indicates an expected call of LogPasswordUpdate.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordUpdate(userID, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogPasswordUpdate(ctx, userID, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordUpdate), userID, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogPasswordUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogPasswordUpdate), ctx, userID, ipAddress, userAgent)
 }
 
 // LogProfileUpdate mocks base method.
-func (m *MockAuditServiceInterface) LogProfileUpdate(userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error {
+func (m *MockAuditServiceInterface) LogProfileUpdate(ctx context.Context, userID, performedBy uuid.UUID, ipAddress, userAgent string, changes map[string]interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogProfileUpdate", userID, performedBy, ipAddress, userAgent, changes)
+	ret := m.ctrl.Call(m, "LogProfileUpdate", ctx, userID, performedBy, ipAddress, userAgent, changes)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogProfileUpdate indicates an expected call of LogProfileUpdate.
-func (mr *MockAuditServiceInterfaceMockRecorder) LogProfileUpdate(userID, performedBy, ipAddress, userAgent, changes interface{}) *gomock.Call {
+func (mr *MockAuditServiceInterfaceMockRecorder) LogProfileUpdate(ctx, userID, performedBy, ipAddress, userAgent, changes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogProfileUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogProfileUpdate), userID, performedBy, ipAddress, userAgent, changes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogProfileUpdate", reflect.TypeOf((*MockAuditServiceInterface)(nil).LogProfileUpdate), ctx, userID, performedBy, ipAddress, userAgent, changes)
 }
 
 // MockCategoryServiceInterface is a mock of CategoryServiceInterface interface.
@@ -710,9 +783,9 @@ func (m *MockCustomerProfileServiceInterface) EXPECT() *MockCustomerProfileServi
 }
 
 // CreateCustomer mocks base method.
-func (m *MockCustomerProfileServiceInterface) CreateCustomer(email, firstName, lastName, role string) (*models.User, string, error) {
+func (m *MockCustomerProfileServiceInterface) CreateCustomer(ctx context.Context, email, firstName, lastName, role string) (*models.User, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCustomer", email, firstName, lastName, role)
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, email, firstName, lastName, role)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -720,66 +793,66 @@ func (m *MockCustomerProfileServiceInterface) CreateCustomer(email, firstName, l
 }
 
 // CreateCustomer indicates an expected call of CreateCustomer.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) CreateCustomer(email, firstName, lastName, role interface{}) *gomock.Call {
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) CreateCustomer(ctx, email, firstName, lastName, role interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).CreateCustomer), email, firstName, lastName, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).CreateCustomer), ctx, email, firstName, lastName, role)
 }
 
 // DeleteCustomer mocks base method.
-func (m *MockCustomerProfileServiceInterface) DeleteCustomer(customerID uuid.UUID, reason string) error {
+func (m *MockCustomerProfileServiceInterface) DeleteCustomer(ctx context.Context, customerID uuid.UUID, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCustomer", customerID, reason)
+	ret := m.ctrl.Call(m, "DeleteCustomer", ctx, customerID, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCustomer indicates an expected call of DeleteCustomer.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) DeleteCustomer(customerID, reason interface{}) *gomock.Call {
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) DeleteCustomer(ctx, customerID, reason interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).DeleteCustomer), customerID, reason)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomer", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).DeleteCustomer), ctx, customerID, reason)
 }
 
 // GetCustomerProfile mocks base method.
-func (m *MockCustomerProfileServiceInterface) GetCustomerProfile(customerID uuid.UUID) (*models.User, error) {
+func (m *MockCustomerProfileServiceInterface) GetCustomerProfile(ctx context.Context, customerID uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerProfile", customerID)
+	ret := m.ctrl.Call(m, "GetCustomerProfile", ctx, customerID)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCustomerProfile indicates an expected call of GetCustomerProfile.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) GetCustomerProfile(customerID interface{}) *gomock.Call {
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) GetCustomerProfile(ctx, customerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).GetCustomerProfile), customerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).GetCustomerProfile), ctx, customerID)
 }
 
 // UpdateCustomerEmail mocks base method.
-func (m *MockCustomerProfileServiceInterface) UpdateCustomerEmail(customerID uuid.UUID, newEmail string) error {
+func (m *MockCustomerProfileServiceInterface) UpdateCustomerEmail(ctx context.Context, customerID uuid.UUID, newEmail string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCustomerEmail", customerID, newEmail)
+	ret := m.ctrl.Call(m, "UpdateCustomerEmail", ctx, customerID, newEmail)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateCustomerEmail indicates an expected call of UpdateCustomerEmail.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerEmail(customerID, newEmail interface{}) *gomock.Call {
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerEmail(ctx, customerID, newEmail interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerEmail", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerEmail), customerID, newEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerEmail", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerEmail), ctx, customerID, newEmail)
 }
 
 // UpdateCustomerProfile mocks base method.
-func (m *MockCustomerProfileServiceInterface) UpdateCustomerProfile(customerID uuid.UUID, updates map[string]interface{}) error {
+func (m *MockCustomerProfileServiceInterface) UpdateCustomerProfile(ctx context.Context, customerID uuid.UUID, updates map[string]interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCustomerProfile", customerID, updates)
+	ret := m.ctrl.Call(m, "UpdateCustomerProfile", ctx, customerID, updates)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateCustomerProfile indicates an expected call of UpdateCustomerProfile.
-func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerProfile(customerID, updates interface{}) *gomock.Call {
+func (mr *MockCustomerProfileServiceInterfaceMockRecorder) UpdateCustomerProfile(ctx, customerID, updates interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerProfile), customerID, updates)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerProfile", reflect.TypeOf((*MockCustomerProfileServiceInterface)(nil).UpdateCustomerProfile), ctx, customerID, updates)
 }
 
 // MockCustomerSearchServiceInterface is a mock of CustomerSearchServiceInterface interface.
@@ -806,9 +879,9 @@ func (m *MockCustomerSearchServiceInterface) EXPECT() *MockCustomerSearchService
 }
 
 // SearchCustomers mocks base method.
-func (m *MockCustomerSearchServiceInterface) SearchCustomers(query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error) {
+func (m *MockCustomerSearchServiceInterface) SearchCustomers(ctx context.Context, query string, searchType models.SearchType, offset, limit int) ([]*models.CustomerSearchResult, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchCustomers", query, searchType, offset, limit)
+	ret := m.ctrl.Call(m, "SearchCustomers", ctx, query, searchType, offset, limit)
 	ret0, _ := ret[0].([]*models.CustomerSearchResult)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -816,9 +889,9 @@ func (m *MockCustomerSearchServiceInterface) SearchCustomers(query string, searc
 }
 
 // SearchCustomers indicates an expected call of SearchCustomers.
-func (mr *MockCustomerSearchServiceInterfaceMockRecorder) SearchCustomers(query, searchType, offset, limit interface{}) *gomock.Call {
+func (mr *MockCustomerSearchServiceInterfaceMockRecorder) SearchCustomers(ctx, query, searchType, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomers", reflect.TypeOf((*MockCustomerSearchServiceInterface)(nil).SearchCustomers), query, searchType, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomers", reflect.TypeOf((*MockCustomerSearchServiceInterface)(nil).SearchCustomers), ctx, query, searchType, offset, limit)
 }
 
 // MockAccountMetricsServiceInterface is a mock of AccountMetricsServiceInterface interface.
@@ -845,33 +918,33 @@ func (m *MockAccountMetricsServiceInterface) EXPECT() *MockAccountMetricsService
 }
 
 // GetAccountMetrics mocks base method.
-func (m *MockAccountMetricsServiceInterface) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
+func (m *MockAccountMetricsServiceInterface) GetAccountMetrics(ctx context.Context, requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountMetrics", requestorID, accountID, startDate, endDate, isAdmin)
+	ret := m.ctrl.Call(m, "GetAccountMetrics", ctx, requestorID, accountID, startDate, endDate, isAdmin)
 	ret0, _ := ret[0].(*models.AccountMetrics)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAccountMetrics indicates an expected call of GetAccountMetrics.
-func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetAccountMetrics(requestorID, accountID, startDate, endDate, isAdmin interface{}) *gomock.Call {
+func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetAccountMetrics(ctx, requestorID, accountID, startDate, endDate, isAdmin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetAccountMetrics), requestorID, accountID, startDate, endDate, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetAccountMetrics), ctx, requestorID, accountID, startDate, endDate, isAdmin)
 }
 
 // GetUserAggregateMetrics mocks base method.
-func (m *MockAccountMetricsServiceInterface) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
+func (m *MockAccountMetricsServiceInterface) GetUserAggregateMetrics(ctx context.Context, requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserAggregateMetrics", requestorID, targetUserID, startDate, endDate, isAdmin)
+	ret := m.ctrl.Call(m, "GetUserAggregateMetrics", ctx, requestorID, targetUserID, startDate, endDate, isAdmin)
 	ret0, _ := ret[0].(*models.UserAggregateMetrics)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserAggregateMetrics indicates an expected call of GetUserAggregateMetrics.
-func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetUserAggregateMetrics(requestorID, targetUserID, startDate, endDate, isAdmin interface{}) *gomock.Call {
+func (mr *MockAccountMetricsServiceInterfaceMockRecorder) GetUserAggregateMetrics(ctx, requestorID, targetUserID, startDate, endDate, isAdmin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAggregateMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetUserAggregateMetrics), requestorID, targetUserID, startDate, endDate, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAggregateMetrics", reflect.TypeOf((*MockAccountMetricsServiceInterface)(nil).GetUserAggregateMetrics), ctx, requestorID, targetUserID, startDate, endDate, isAdmin)
 }
 
 // MockMetricsRecorderInterface is a mock of MetricsRecorderInterface interface.
@@ -957,18 +1030,18 @@ func (m *MockStatementServiceInterface) EXPECT() *MockStatementServiceInterfaceM
 }
 
 // GenerateStatement mocks base method.
-func (m *MockStatementServiceInterface) GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error) {
+func (m *MockStatementServiceInterface) GenerateStatement(ctx context.Context, requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateStatement", requestorID, accountID, periodType, year, period, isAdmin)
+	ret := m.ctrl.Call(m, "GenerateStatement", ctx, requestorID, accountID, periodType, year, period, isAdmin)
 	ret0, _ := ret[0].(*models.AccountStatement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateStatement indicates an expected call of GenerateStatement.
-func (mr *MockStatementServiceInterfaceMockRecorder) GenerateStatement(requestorID, accountID, periodType, year, period, isAdmin interface{}) *gomock.Call {
+func (mr *MockStatementServiceInterfaceMockRecorder) GenerateStatement(ctx, requestorID, accountID, periodType, year, period, isAdmin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStatement", reflect.TypeOf((*MockStatementServiceInterface)(nil).GenerateStatement), requestorID, accountID, periodType, year, period, isAdmin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStatement", reflect.TypeOf((*MockStatementServiceInterface)(nil).GenerateStatement), ctx, requestorID, accountID, periodType, year, period, isAdmin)
 }
 
 // MockTransactionGeneratorInterface is a mock of TransactionGeneratorInterface interface.
@@ -1159,62 +1232,62 @@ func (m *MockAuthServiceInterface) EXPECT() *MockAuthServiceInterfaceMockRecorde
 }
 
 // Login mocks base method.
-func (m *MockAuthServiceInterface) Login(req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+func (m *MockAuthServiceInterface) Login(ctx context.Context, req *dto.LoginRequest, ipAddress, userAgent string) (*dto.TokenResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", req, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "Login", ctx, req, ipAddress, userAgent)
 	ret0, _ := ret[0].(*dto.TokenResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockAuthServiceInterfaceMockRecorder) Login(req, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuthServiceInterfaceMockRecorder) Login(ctx, req, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceInterface)(nil).Login), req, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceInterface)(nil).Login), ctx, req, ipAddress, userAgent)
 }
 
 // Logout mocks base method.
-func (m *MockAuthServiceInterface) Logout(accessToken, ipAddress, userAgent string) error {
+func (m *MockAuthServiceInterface) Logout(ctx context.Context, accessToken, ipAddress, userAgent string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logout", accessToken, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "Logout", ctx, accessToken, ipAddress, userAgent)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Logout indicates an expected call of Logout.
-func (mr *MockAuthServiceInterfaceMockRecorder) Logout(accessToken, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuthServiceInterfaceMockRecorder) Logout(ctx, accessToken, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceInterface)(nil).Logout), accessToken, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceInterface)(nil).Logout), ctx, accessToken, ipAddress, userAgent)
 }
 
 // RefreshTokens mocks base method.
-func (m *MockAuthServiceInterface) RefreshTokens(refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error) {
+func (m *MockAuthServiceInterface) RefreshTokens(ctx context.Context, refreshToken, ipAddress, userAgent string) (*dto.TokenResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokens", refreshToken, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "RefreshTokens", ctx, refreshToken, ipAddress, userAgent)
 	ret0, _ := ret[0].(*dto.TokenResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RefreshTokens indicates an expected call of RefreshTokens.
-func (mr *MockAuthServiceInterfaceMockRecorder) RefreshTokens(refreshToken, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuthServiceInterfaceMockRecorder) RefreshTokens(ctx, refreshToken, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokens", reflect.TypeOf((*MockAuthServiceInterface)(nil).RefreshTokens), refreshToken, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokens", reflect.TypeOf((*MockAuthServiceInterface)(nil).RefreshTokens), ctx, refreshToken, ipAddress, userAgent)
 }
 
 // Register mocks base method.
-func (m *MockAuthServiceInterface) Register(req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error) {
+func (m *MockAuthServiceInterface) Register(ctx context.Context, req *dto.RegisterRequest, ipAddress, userAgent string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", req, ipAddress, userAgent)
+	ret := m.ctrl.Call(m, "Register", ctx, req, ipAddress, userAgent)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockAuthServiceInterfaceMockRecorder) Register(req, ipAddress, userAgent interface{}) *gomock.Call {
+func (mr *MockAuthServiceInterfaceMockRecorder) Register(ctx, req, ipAddress, userAgent interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceInterface)(nil).Register), req, ipAddress, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceInterface)(nil).Register), ctx, req, ipAddress, userAgent)
 }
 
 // MockTokenServiceInterface is a mock of TokenServiceInterface interface.
@@ -1371,18 +1444,18 @@ func (m *MockPasswordServiceInterface) EXPECT() *MockPasswordServiceInterfaceMoc
 }
 
 // AdminResetPassword mocks base method.
-func (m *MockPasswordServiceInterface) AdminResetPassword(customerID, adminID uuid.UUID) (string, error) {
+func (m *MockPasswordServiceInterface) AdminResetPassword(ctx context.Context, customerID, adminID uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AdminResetPassword", customerID, adminID)
+	ret := m.ctrl.Call(m, "AdminResetPassword", ctx, customerID, adminID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AdminResetPassword indicates an expected call of AdminResetPassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) AdminResetPassword(customerID, adminID interface{}) *gomock.Call {
+func (mr *MockPasswordServiceInterfaceMockRecorder) AdminResetPassword(ctx, customerID, adminID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminResetPassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).AdminResetPassword), customerID, adminID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminResetPassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).AdminResetPassword), ctx, customerID, adminID)
 }
 
 // ComparePassword mocks base method.
@@ -1400,17 +1473,17 @@ func (mr *MockPasswordServiceInterfaceMockRecorder) ComparePassword(password, ha
 }
 
 // CustomerUpdatePassword mocks base method.
-func (m *MockPasswordServiceInterface) CustomerUpdatePassword(customerID uuid.UUID, currentPassword, newPassword string) error {
+func (m *MockPasswordServiceInterface) CustomerUpdatePassword(ctx context.Context, customerID uuid.UUID, currentPassword, newPassword string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CustomerUpdatePassword", customerID, currentPassword, newPassword)
+	ret := m.ctrl.Call(m, "CustomerUpdatePassword", ctx, customerID, currentPassword, newPassword)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CustomerUpdatePassword indicates an expected call of CustomerUpdatePassword.
-func (mr *MockPasswordServiceInterfaceMockRecorder) CustomerUpdatePassword(customerID, currentPassword, newPassword interface{}) *gomock.Call {
+func (mr *MockPasswordServiceInterfaceMockRecorder) CustomerUpdatePassword(ctx, customerID, currentPassword, newPassword interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerUpdatePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).CustomerUpdatePassword), customerID, currentPassword, newPassword)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomerUpdatePassword", reflect.TypeOf((*MockPasswordServiceInterface)(nil).CustomerUpdatePassword), ctx, customerID, currentPassword, newPassword)
 }
 
 // GenerateSecurePassword mocks base method.
@@ -1995,33 +2068,76 @@ func (m *MockTransactionProcessingServiceInterface) EXPECT() *MockTransactionPro
 	return m.recorder
 }
 
+// Drain mocks base method.
+func (m *MockTransactionProcessingServiceInterface) Drain(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drain", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) Drain(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).Drain), ctx)
+}
+
 // EnqueueTransaction mocks base method.
-func (m *MockTransactionProcessingServiceInterface) EnqueueTransaction(transactionID uuid.UUID, operation string, priority int) error {
+func (m *MockTransactionProcessingServiceInterface) EnqueueTransaction(ctx context.Context, transactionID uuid.UUID, operation string, priority int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnqueueTransaction", transactionID, operation, priority)
+	ret := m.ctrl.Call(m, "EnqueueTransaction", ctx, transactionID, operation, priority)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // EnqueueTransaction indicates an expected call of EnqueueTransaction.
-func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) EnqueueTransaction(transactionID, operation, priority interface{}) *gomock.Call {
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) EnqueueTransaction(ctx, transactionID, operation, priority interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueTransaction", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).EnqueueTransaction), transactionID, operation, priority)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueTransaction", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).EnqueueTransaction), ctx, transactionID, operation, priority)
 }
 
 // GetQueueMetrics mocks base method.
-func (m *MockTransactionProcessingServiceInterface) GetQueueMetrics() (*dto.QueueMetrics, error) {
+func (m *MockTransactionProcessingServiceInterface) GetQueueMetrics(ctx context.Context) (*dto.QueueMetrics, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQueueMetrics")
+	ret := m.ctrl.Call(m, "GetQueueMetrics", ctx)
 	ret0, _ := ret[0].(*dto.QueueMetrics)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetQueueMetrics indicates an expected call of GetQueueMetrics.
-func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) GetQueueMetrics() *gomock.Call {
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) GetQueueMetrics(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueMetrics", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).GetQueueMetrics), ctx)
+}
+
+// GetTransactionQueueItem mocks base method.
+func (m *MockTransactionProcessingServiceInterface) GetTransactionQueueItem(ctx context.Context, transactionID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionQueueItem", ctx, transactionID)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionQueueItem indicates an expected call of GetTransactionQueueItem.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) GetTransactionQueueItem(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionQueueItem", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).GetTransactionQueueItem), ctx, transactionID)
+}
+
+// PriorityForAccountType mocks base method.
+func (m *MockTransactionProcessingServiceInterface) PriorityForAccountType(accountType string) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriorityForAccountType", accountType)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PriorityForAccountType indicates an expected call of PriorityForAccountType.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) PriorityForAccountType(accountType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueMetrics", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).GetQueueMetrics))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriorityForAccountType", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).PriorityForAccountType), accountType)
 }
 
 // ProcessQueueItem mocks base method.
@@ -2038,6 +2154,21 @@ func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) ProcessQueueIte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessQueueItem", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).ProcessQueueItem), ctx, queueItem)
 }
 
+// ReapExpiredLeases mocks base method.
+func (m *MockTransactionProcessingServiceInterface) ReapExpiredLeases(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReapExpiredLeases", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReapExpiredLeases indicates an expected call of ReapExpiredLeases.
+func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) ReapExpiredLeases(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapExpiredLeases", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).ReapExpiredLeases), ctx)
+}
+
 // StartProcessing mocks base method.
 func (m *MockTransactionProcessingServiceInterface) StartProcessing(ctx context.Context) {
 	m.ctrl.T.Helper()
@@ -2050,165 +2181,208 @@ func (mr *MockTransactionProcessingServiceInterfaceMockRecorder) StartProcessing
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartProcessing", reflect.TypeOf((*MockTransactionProcessingServiceInterface)(nil).StartProcessing), ctx)
 }
 
-// MockExternalAccountServiceInterface is a mock of ExternalAccountServiceInterface interface.
-type MockExternalAccountServiceInterface struct {
+// MockQueueAdminServiceInterface is a mock of QueueAdminServiceInterface interface.
+type MockQueueAdminServiceInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockExternalAccountServiceInterfaceMockRecorder
+	recorder *MockQueueAdminServiceInterfaceMockRecorder
 }
 
-// MockExternalAccountServiceInterfaceMockRecorder is the mock recorder for MockExternalAccountServiceInterface.
-type MockExternalAccountServiceInterfaceMockRecorder struct {
-	mock *MockExternalAccountServiceInterface
+// MockQueueAdminServiceInterfaceMockRecorder is the mock recorder for MockQueueAdminServiceInterface.
+type MockQueueAdminServiceInterfaceMockRecorder struct {
+	mock *MockQueueAdminServiceInterface
 }
 
-// NewMockExternalAccountServiceInterface creates a new mock instance.
-func NewMockExternalAccountServiceInterface(ctrl *gomock.Controller) *MockExternalAccountServiceInterface {
-	mock := &MockExternalAccountServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockExternalAccountServiceInterfaceMockRecorder{mock}
+// NewMockQueueAdminServiceInterface creates a new mock instance.
+func NewMockQueueAdminServiceInterface(ctrl *gomock.Controller) *MockQueueAdminServiceInterface {
+	mock := &MockQueueAdminServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockQueueAdminServiceInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockExternalAccountServiceInterface) EXPECT() *MockExternalAccountServiceInterfaceMockRecorder {
+func (m *MockQueueAdminServiceInterface) EXPECT() *MockQueueAdminServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
-// Register mocks base method.
-func (m *MockExternalAccountServiceInterface) Register(ctx context.Context, userID uuid.UUID, req *dto.RegisterExternalAccountRequest) (*models.ExternalAccount, error) {
+// CancelItem mocks base method.
+func (m *MockQueueAdminServiceInterface) CancelItem(ctx context.Context, adminID, itemID uuid.UUID, note string) (*models.ProcessingQueueItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", ctx, userID, req)
-	ret0, _ := ret[0].(*models.ExternalAccount)
+	ret := m.ctrl.Call(m, "CancelItem", ctx, adminID, itemID, note)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Register indicates an expected call of Register.
-func (mr *MockExternalAccountServiceInterfaceMockRecorder) Register(ctx, userID, req interface{}) *gomock.Call {
+// CancelItem indicates an expected call of CancelItem.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) CancelItem(ctx, adminID, itemID, note interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).Register), ctx, userID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelItem", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).CancelItem), ctx, adminID, itemID, note)
 }
 
-// MockTransferMonitorServiceInterface is a mock of TransferMonitorServiceInterface interface.
-type MockTransferMonitorServiceInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockTransferMonitorServiceInterfaceMockRecorder
+// CleanupCompleted mocks base method.
+func (m *MockQueueAdminServiceInterface) CleanupCompleted(ctx context.Context, adminID uuid.UUID, olderThan time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupCompleted", ctx, adminID, olderThan)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockTransferMonitorServiceInterfaceMockRecorder is the mock recorder for MockTransferMonitorServiceInterface.
-type MockTransferMonitorServiceInterfaceMockRecorder struct {
-	mock *MockTransferMonitorServiceInterface
+// CleanupCompleted indicates an expected call of CleanupCompleted.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) CleanupCompleted(ctx, adminID, olderThan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupCompleted", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).CleanupCompleted), ctx, adminID, olderThan)
 }
 
-// NewMockTransferMonitorServiceInterface creates a new mock instance.
-func NewMockTransferMonitorServiceInterface(ctrl *gomock.Controller) *MockTransferMonitorServiceInterface {
-	mock := &MockTransferMonitorServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockTransferMonitorServiceInterfaceMockRecorder{mock}
-	return mock
+// DeadLetterItem mocks base method.
+func (m *MockQueueAdminServiceInterface) DeadLetterItem(ctx context.Context, adminID, itemID uuid.UUID, note string) (*models.ProcessingQueueItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetterItem", ctx, adminID, itemID, note)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTransferMonitorServiceInterface) EXPECT() *MockTransferMonitorServiceInterfaceMockRecorder {
-	return m.recorder
+// DeadLetterItem indicates an expected call of DeadLetterItem.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) DeadLetterItem(ctx, adminID, itemID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterItem", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).DeadLetterItem), ctx, adminID, itemID, note)
 }
 
-// MonitorPendingTransfers mocks base method.
-func (m *MockTransferMonitorServiceInterface) MonitorPendingTransfers(ctx context.Context) {
+// GetItem mocks base method.
+func (m *MockQueueAdminServiceInterface) GetItem(ctx context.Context, itemID uuid.UUID) (*models.ProcessingQueueItem, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "MonitorPendingTransfers", ctx)
+	ret := m.ctrl.Call(m, "GetItem", ctx, itemID)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MonitorPendingTransfers indicates an expected call of MonitorPendingTransfers.
-func (mr *MockTransferMonitorServiceInterfaceMockRecorder) MonitorPendingTransfers(ctx interface{}) *gomock.Call {
+// GetItem indicates an expected call of GetItem.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) GetItem(ctx, itemID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitorPendingTransfers", reflect.TypeOf((*MockTransferMonitorServiceInterface)(nil).MonitorPendingTransfers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).GetItem), ctx, itemID)
 }
 
-// MockRegulatorClientInterface is a mock of RegulatorClientInterface interface.
-type MockRegulatorClientInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockRegulatorClientInterfaceMockRecorder
+// GetMetrics mocks base method.
+func (m *MockQueueAdminServiceInterface) GetMetrics(ctx context.Context) (*dto.QueueMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetrics", ctx)
+	ret0, _ := ret[0].(*dto.QueueMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockRegulatorClientInterfaceMockRecorder is the mock recorder for MockRegulatorClientInterface.
-type MockRegulatorClientInterfaceMockRecorder struct {
-	mock *MockRegulatorClientInterface
+// GetMetrics indicates an expected call of GetMetrics.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) GetMetrics(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetrics", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).GetMetrics), ctx)
 }
 
-// NewMockRegulatorClientInterface creates a new mock instance.
-func NewMockRegulatorClientInterface(ctrl *gomock.Controller) *MockRegulatorClientInterface {
-	mock := &MockRegulatorClientInterface{ctrl: ctrl}
-	mock.recorder = &MockRegulatorClientInterfaceMockRecorder{mock}
-	return mock
+// GetWorkerStatus mocks base method.
+func (m *MockQueueAdminServiceInterface) GetWorkerStatus(ctx context.Context) (*models.ProcessingQueueControl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerStatus", ctx)
+	ret0, _ := ret[0].(*models.ProcessingQueueControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockRegulatorClientInterface) EXPECT() *MockRegulatorClientInterfaceMockRecorder {
-	return m.recorder
+// GetWorkerStatus indicates an expected call of GetWorkerStatus.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) GetWorkerStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerStatus", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).GetWorkerStatus), ctx)
 }
 
-// SendTransferNotification mocks base method.
-func (m *MockRegulatorClientInterface) SendTransferNotification(ctx context.Context, payload *dto.RegulatorNotificationPayload) (int, string, error) {
+// ListItems mocks base method.
+func (m *MockQueueAdminServiceInterface) ListItems(ctx context.Context, filters models.ProcessingQueueFilters, offset, limit int) ([]models.ProcessingQueueItem, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendTransferNotification", ctx, payload)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(string)
+	ret := m.ctrl.Call(m, "ListItems", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.ProcessingQueueItem)
+	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// SendTransferNotification indicates an expected call of SendTransferNotification.
-func (mr *MockRegulatorClientInterfaceMockRecorder) SendTransferNotification(ctx, payload interface{}) *gomock.Call {
+// ListItems indicates an expected call of ListItems.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) ListItems(ctx, filters, offset, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferNotification", reflect.TypeOf((*MockRegulatorClientInterface)(nil).SendTransferNotification), ctx, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).ListItems), ctx, filters, offset, limit)
 }
 
-// MockWebhookServiceInterface is a mock of WebhookServiceInterface interface.
-type MockWebhookServiceInterface struct {
-	ctrl     *gomock.Controller
-	recorder *MockWebhookServiceInterfaceMockRecorder
+// PauseWorkers mocks base method.
+func (m *MockQueueAdminServiceInterface) PauseWorkers(ctx context.Context, adminID uuid.UUID, reason string) (*models.ProcessingQueueControl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkers", ctx, adminID, reason)
+	ret0, _ := ret[0].(*models.ProcessingQueueControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockWebhookServiceInterfaceMockRecorder is the mock recorder for MockWebhookServiceInterface.
-type MockWebhookServiceInterfaceMockRecorder struct {
-	mock *MockWebhookServiceInterface
+// PauseWorkers indicates an expected call of PauseWorkers.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) PauseWorkers(ctx, adminID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkers", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).PauseWorkers), ctx, adminID, reason)
 }
 
-// NewMockWebhookServiceInterface creates a new mock instance.
-func NewMockWebhookServiceInterface(ctrl *gomock.Controller) *MockWebhookServiceInterface {
-	mock := &MockWebhookServiceInterface{ctrl: ctrl}
-	mock.recorder = &MockWebhookServiceInterfaceMockRecorder{mock}
-	return mock
+// RescheduleItem mocks base method.
+func (m *MockQueueAdminServiceInterface) RescheduleItem(ctx context.Context, adminID, itemID uuid.UUID, scheduledAt time.Time) (*models.ProcessingQueueItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleItem", ctx, adminID, itemID, scheduledAt)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockWebhookServiceInterface) EXPECT() *MockWebhookServiceInterfaceMockRecorder {
-	return m.recorder
+// RescheduleItem indicates an expected call of RescheduleItem.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) RescheduleItem(ctx, adminID, itemID, scheduledAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleItem", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).RescheduleItem), ctx, adminID, itemID, scheduledAt)
 }
 
-// ProcessPendingWebhooks mocks base method.
-func (m *MockWebhookServiceInterface) ProcessPendingWebhooks(ctx context.Context) {
+// ResumeWorkers mocks base method.
+func (m *MockQueueAdminServiceInterface) ResumeWorkers(ctx context.Context, adminID uuid.UUID) (*models.ProcessingQueueControl, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ProcessPendingWebhooks", ctx)
+	ret := m.ctrl.Call(m, "ResumeWorkers", ctx, adminID)
+	ret0, _ := ret[0].(*models.ProcessingQueueControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ProcessPendingWebhooks indicates an expected call of ProcessPendingWebhooks.
-func (mr *MockWebhookServiceInterfaceMockRecorder) ProcessPendingWebhooks(ctx interface{}) *gomock.Call {
+// ResumeWorkers indicates an expected call of ResumeWorkers.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) ResumeWorkers(ctx, adminID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPendingWebhooks", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ProcessPendingWebhooks), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkers", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).ResumeWorkers), ctx, adminID)
 }
 
-// QueueTransferNotification mocks base method.
-func (m *MockWebhookServiceInterface) QueueTransferNotification(ctx context.Context, transfer *models.Transfer) error {
+// RetryItem mocks base method.
+func (m *MockQueueAdminServiceInterface) RetryItem(ctx context.Context, adminID, itemID uuid.UUID) (*models.ProcessingQueueItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueueTransferNotification", ctx, transfer)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RetryItem", ctx, adminID, itemID)
+	ret0, _ := ret[0].(*models.ProcessingQueueItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// QueueTransferNotification indicates an expected call of QueueTransferNotification.
-func (mr *MockWebhookServiceInterfaceMockRecorder) QueueTransferNotification(ctx, transfer interface{}) *gomock.Call {
+// RetryItem indicates an expected call of RetryItem.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) RetryItem(ctx, adminID, itemID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueTransferNotification", reflect.TypeOf((*MockWebhookServiceInterface)(nil).QueueTransferNotification), ctx, transfer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryItem", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).RetryItem), ctx, adminID, itemID)
+}
+
+// RetryItems mocks base method.
+func (m *MockQueueAdminServiceInterface) RetryItems(ctx context.Context, adminID uuid.UUID, req *dto.RetryQueueItemsRequest) (*dto.RetryQueueItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryItems", ctx, adminID, req)
+	ret0, _ := ret[0].(*dto.RetryQueueItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryItems indicates an expected call of RetryItems.
+func (mr *MockQueueAdminServiceInterfaceMockRecorder) RetryItems(ctx, adminID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryItems", reflect.TypeOf((*MockQueueAdminServiceInterface)(nil).RetryItems), ctx, adminID, req)
 }
 
 // MockNorthwindClientInterface is a mock of NorthwindClientInterface interface.
@@ -2234,6 +2408,21 @@ func (m *MockNorthwindClientInterface) EXPECT() *MockNorthwindClientInterfaceMoc
 	return m.recorder
 }
 
+// CreateExternalAccount mocks base method.
+func (m *MockNorthwindClientInterface) CreateExternalAccount(ctx context.Context, details *dto.NorthwindCreateAccountRequest) (*dto.NorthwindExternalAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalAccount", ctx, details)
+	ret0, _ := ret[0].(*dto.NorthwindExternalAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalAccount indicates an expected call of CreateExternalAccount.
+func (mr *MockNorthwindClientInterfaceMockRecorder) CreateExternalAccount(ctx, details interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalAccount", reflect.TypeOf((*MockNorthwindClientInterface)(nil).CreateExternalAccount), ctx, details)
+}
+
 // GetTransfer mocks base method.
 func (m *MockNorthwindClientInterface) GetTransfer(ctx context.Context, transferID string) (*dto.NorthwindGetTransferResponse, error) {
 	m.ctrl.T.Helper()
@@ -2248,3 +2437,1488 @@ func (mr *MockNorthwindClientInterfaceMockRecorder) GetTransfer(ctx, transferID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockNorthwindClientInterface)(nil).GetTransfer), ctx, transferID)
 }
+
+// HealthCheck mocks base method.
+func (m *MockNorthwindClientInterface) HealthCheck(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthCheck", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HealthCheck indicates an expected call of HealthCheck.
+func (mr *MockNorthwindClientInterfaceMockRecorder) HealthCheck(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockNorthwindClientInterface)(nil).HealthCheck), ctx)
+}
+
+// InitiateTransfer mocks base method.
+func (m *MockNorthwindClientInterface) InitiateTransfer(ctx context.Context, req *dto.NorthwindInitiateTransferRequest) (*dto.NorthwindInitiateTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitiateTransfer", ctx, req)
+	ret0, _ := ret[0].(*dto.NorthwindInitiateTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitiateTransfer indicates an expected call of InitiateTransfer.
+func (mr *MockNorthwindClientInterfaceMockRecorder) InitiateTransfer(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateTransfer", reflect.TypeOf((*MockNorthwindClientInterface)(nil).InitiateTransfer), ctx, req)
+}
+
+// MockExternalAccountServiceInterface is a mock of ExternalAccountServiceInterface interface.
+type MockExternalAccountServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalAccountServiceInterfaceMockRecorder
+}
+
+// MockExternalAccountServiceInterfaceMockRecorder is the mock recorder for MockExternalAccountServiceInterface.
+type MockExternalAccountServiceInterfaceMockRecorder struct {
+	mock *MockExternalAccountServiceInterface
+}
+
+// NewMockExternalAccountServiceInterface creates a new mock instance.
+func NewMockExternalAccountServiceInterface(ctrl *gomock.Controller) *MockExternalAccountServiceInterface {
+	mock := &MockExternalAccountServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockExternalAccountServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalAccountServiceInterface) EXPECT() *MockExternalAccountServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockExternalAccountServiceInterface) Delete(ctx context.Context, userID, externalAccountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, externalAccountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) Delete(ctx, userID, externalAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).Delete), ctx, userID, externalAccountID)
+}
+
+// Get mocks base method.
+func (m *MockExternalAccountServiceInterface) Get(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, externalAccountID)
+	ret0, _ := ret[0].(*models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) Get(ctx, userID, externalAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).Get), ctx, userID, externalAccountID)
+}
+
+// GetTransferHistory mocks base method.
+func (m *MockExternalAccountServiceInterface) GetTransferHistory(ctx context.Context, userID, externalAccountID uuid.UUID, offset, limit int) ([]models.Transfer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferHistory", ctx, userID, externalAccountID, offset, limit)
+	ret0, _ := ret[0].([]models.Transfer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransferHistory indicates an expected call of GetTransferHistory.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) GetTransferHistory(ctx, userID, externalAccountID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferHistory", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).GetTransferHistory), ctx, userID, externalAccountID, offset, limit)
+}
+
+// GetTransferStats mocks base method.
+func (m *MockExternalAccountServiceInterface) GetTransferStats(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccountTransferStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferStats", ctx, userID, externalAccountID)
+	ret0, _ := ret[0].(*models.ExternalAccountTransferStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferStats indicates an expected call of GetTransferStats.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) GetTransferStats(ctx, userID, externalAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferStats", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).GetTransferStats), ctx, userID, externalAccountID)
+}
+
+// List mocks base method.
+func (m *MockExternalAccountServiceInterface) List(ctx context.Context, userID uuid.UUID) ([]models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userID)
+	ret0, _ := ret[0].([]models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) List(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).List), ctx, userID)
+}
+
+// Register mocks base method.
+func (m *MockExternalAccountServiceInterface) Register(ctx context.Context, userID uuid.UUID, req *dto.RegisterExternalAccountRequest) (*models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, userID, req)
+	ret0, _ := ret[0].(*models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) Register(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).Register), ctx, userID, req)
+}
+
+// SendMicroDeposits mocks base method.
+func (m *MockExternalAccountServiceInterface) SendMicroDeposits(ctx context.Context, userID, externalAccountID uuid.UUID) (*models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMicroDeposits", ctx, userID, externalAccountID)
+	ret0, _ := ret[0].(*models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMicroDeposits indicates an expected call of SendMicroDeposits.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) SendMicroDeposits(ctx, userID, externalAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMicroDeposits", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).SendMicroDeposits), ctx, userID, externalAccountID)
+}
+
+// UpdateNickname mocks base method.
+func (m *MockExternalAccountServiceInterface) UpdateNickname(ctx context.Context, userID, externalAccountID uuid.UUID, nickname string) (*models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNickname", ctx, userID, externalAccountID, nickname)
+	ret0, _ := ret[0].(*models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNickname indicates an expected call of UpdateNickname.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) UpdateNickname(ctx, userID, externalAccountID, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNickname", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).UpdateNickname), ctx, userID, externalAccountID, nickname)
+}
+
+// VerifyMicroDeposits mocks base method.
+func (m *MockExternalAccountServiceInterface) VerifyMicroDeposits(ctx context.Context, userID, externalAccountID uuid.UUID, amount1, amount2 decimal.Decimal) (*models.ExternalAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMicroDeposits", ctx, userID, externalAccountID, amount1, amount2)
+	ret0, _ := ret[0].(*models.ExternalAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMicroDeposits indicates an expected call of VerifyMicroDeposits.
+func (mr *MockExternalAccountServiceInterfaceMockRecorder) VerifyMicroDeposits(ctx, userID, externalAccountID, amount1, amount2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMicroDeposits", reflect.TypeOf((*MockExternalAccountServiceInterface)(nil).VerifyMicroDeposits), ctx, userID, externalAccountID, amount1, amount2)
+}
+
+// MockTransferSagaRecoveryServiceInterface is a mock of TransferSagaRecoveryServiceInterface interface.
+type MockTransferSagaRecoveryServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferSagaRecoveryServiceInterfaceMockRecorder
+}
+
+// MockTransferSagaRecoveryServiceInterfaceMockRecorder is the mock recorder for MockTransferSagaRecoveryServiceInterface.
+type MockTransferSagaRecoveryServiceInterfaceMockRecorder struct {
+	mock *MockTransferSagaRecoveryServiceInterface
+}
+
+// NewMockTransferSagaRecoveryServiceInterface creates a new mock instance.
+func NewMockTransferSagaRecoveryServiceInterface(ctrl *gomock.Controller) *MockTransferSagaRecoveryServiceInterface {
+	mock := &MockTransferSagaRecoveryServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferSagaRecoveryServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferSagaRecoveryServiceInterface) EXPECT() *MockTransferSagaRecoveryServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// RecoverIncompleteSagas mocks base method.
+func (m *MockTransferSagaRecoveryServiceInterface) RecoverIncompleteSagas(ctx context.Context) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverIncompleteSagas", ctx)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// RecoverIncompleteSagas indicates an expected call of RecoverIncompleteSagas.
+func (mr *MockTransferSagaRecoveryServiceInterfaceMockRecorder) RecoverIncompleteSagas(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverIncompleteSagas", reflect.TypeOf((*MockTransferSagaRecoveryServiceInterface)(nil).RecoverIncompleteSagas), ctx)
+}
+
+// MockTransferMonitorServiceInterface is a mock of TransferMonitorServiceInterface interface.
+type MockTransferMonitorServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferMonitorServiceInterfaceMockRecorder
+}
+
+// MockTransferMonitorServiceInterfaceMockRecorder is the mock recorder for MockTransferMonitorServiceInterface.
+type MockTransferMonitorServiceInterfaceMockRecorder struct {
+	mock *MockTransferMonitorServiceInterface
+}
+
+// NewMockTransferMonitorServiceInterface creates a new mock instance.
+func NewMockTransferMonitorServiceInterface(ctrl *gomock.Controller) *MockTransferMonitorServiceInterface {
+	mock := &MockTransferMonitorServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferMonitorServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferMonitorServiceInterface) EXPECT() *MockTransferMonitorServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// HandleStatusCallback mocks base method.
+func (m *MockTransferMonitorServiceInterface) HandleStatusCallback(ctx context.Context, data *dto.NorthwindTransferStatusData) (*models.Transfer, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleStatusCallback", ctx, data)
+	ret0, _ := ret[0].(*models.Transfer)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HandleStatusCallback indicates an expected call of HandleStatusCallback.
+func (mr *MockTransferMonitorServiceInterfaceMockRecorder) HandleStatusCallback(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleStatusCallback", reflect.TypeOf((*MockTransferMonitorServiceInterface)(nil).HandleStatusCallback), ctx, data)
+}
+
+// ListEscalatedTransfers mocks base method.
+func (m *MockTransferMonitorServiceInterface) ListEscalatedTransfers(ctx context.Context, offset, limit int) ([]models.Transfer, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEscalatedTransfers", ctx, offset, limit)
+	ret0, _ := ret[0].([]models.Transfer)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEscalatedTransfers indicates an expected call of ListEscalatedTransfers.
+func (mr *MockTransferMonitorServiceInterfaceMockRecorder) ListEscalatedTransfers(ctx, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEscalatedTransfers", reflect.TypeOf((*MockTransferMonitorServiceInterface)(nil).ListEscalatedTransfers), ctx, offset, limit)
+}
+
+// MonitorPendingTransfers mocks base method.
+func (m *MockTransferMonitorServiceInterface) MonitorPendingTransfers(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MonitorPendingTransfers", ctx)
+}
+
+// MonitorPendingTransfers indicates an expected call of MonitorPendingTransfers.
+func (mr *MockTransferMonitorServiceInterfaceMockRecorder) MonitorPendingTransfers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonitorPendingTransfers", reflect.TypeOf((*MockTransferMonitorServiceInterface)(nil).MonitorPendingTransfers), ctx)
+}
+
+// RequeueEscalatedTransfer mocks base method.
+func (m *MockTransferMonitorServiceInterface) RequeueEscalatedTransfer(ctx context.Context, transferID uuid.UUID) (*models.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueEscalatedTransfer", ctx, transferID)
+	ret0, _ := ret[0].(*models.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueEscalatedTransfer indicates an expected call of RequeueEscalatedTransfer.
+func (mr *MockTransferMonitorServiceInterfaceMockRecorder) RequeueEscalatedTransfer(ctx, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueEscalatedTransfer", reflect.TypeOf((*MockTransferMonitorServiceInterface)(nil).RequeueEscalatedTransfer), ctx, transferID)
+}
+
+// MockRegulatorClientInterface is a mock of RegulatorClientInterface interface.
+type MockRegulatorClientInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegulatorClientInterfaceMockRecorder
+}
+
+// MockRegulatorClientInterfaceMockRecorder is the mock recorder for MockRegulatorClientInterface.
+type MockRegulatorClientInterfaceMockRecorder struct {
+	mock *MockRegulatorClientInterface
+}
+
+// NewMockRegulatorClientInterface creates a new mock instance.
+func NewMockRegulatorClientInterface(ctrl *gomock.Controller) *MockRegulatorClientInterface {
+	mock := &MockRegulatorClientInterface{ctrl: ctrl}
+	mock.recorder = &MockRegulatorClientInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegulatorClientInterface) EXPECT() *MockRegulatorClientInterfaceMockRecorder {
+	return m.recorder
+}
+
+// SendTransferNotification mocks base method.
+func (m *MockRegulatorClientInterface) SendTransferNotification(ctx context.Context, payload *dto.RegulatorNotificationPayload, attempt int) (int, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransferNotification", ctx, payload, attempt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SendTransferNotification indicates an expected call of SendTransferNotification.
+func (mr *MockRegulatorClientInterfaceMockRecorder) SendTransferNotification(ctx, payload, attempt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferNotification", reflect.TypeOf((*MockRegulatorClientInterface)(nil).SendTransferNotification), ctx, payload, attempt)
+}
+
+// SubmitComplianceReport mocks base method.
+func (m *MockRegulatorClientInterface) SubmitComplianceReport(ctx context.Context, file *dto.ComplianceReportFile, attempt int) (int, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitComplianceReport", ctx, file, attempt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubmitComplianceReport indicates an expected call of SubmitComplianceReport.
+func (mr *MockRegulatorClientInterfaceMockRecorder) SubmitComplianceReport(ctx, file, attempt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitComplianceReport", reflect.TypeOf((*MockRegulatorClientInterface)(nil).SubmitComplianceReport), ctx, file, attempt)
+}
+
+// MockWebhookServiceInterface is a mock of WebhookServiceInterface interface.
+type MockWebhookServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceInterfaceMockRecorder
+}
+
+// MockWebhookServiceInterfaceMockRecorder is the mock recorder for MockWebhookServiceInterface.
+type MockWebhookServiceInterfaceMockRecorder struct {
+	mock *MockWebhookServiceInterface
+}
+
+// NewMockWebhookServiceInterface creates a new mock instance.
+func NewMockWebhookServiceInterface(ctrl *gomock.Controller) *MockWebhookServiceInterface {
+	mock := &MockWebhookServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookServiceInterface) EXPECT() *MockWebhookServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetNotification mocks base method.
+func (m *MockWebhookServiceInterface) GetNotification(ctx context.Context, notificationID uuid.UUID) (*models.WebhookNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotification", ctx, notificationID)
+	ret0, _ := ret[0].(*models.WebhookNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotification indicates an expected call of GetNotification.
+func (mr *MockWebhookServiceInterfaceMockRecorder) GetNotification(ctx, notificationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockWebhookServiceInterface)(nil).GetNotification), ctx, notificationID)
+}
+
+// ListNotifications mocks base method.
+func (m *MockWebhookServiceInterface) ListNotifications(ctx context.Context, filters models.WebhookNotificationFilters, offset, limit int) ([]models.WebhookNotification, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotifications", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookNotification)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockWebhookServiceInterfaceMockRecorder) ListNotifications(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ListNotifications), ctx, filters, offset, limit)
+}
+
+// ProcessPendingWebhooks mocks base method.
+func (m *MockWebhookServiceInterface) ProcessPendingWebhooks(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProcessPendingWebhooks", ctx)
+}
+
+// ProcessPendingWebhooks indicates an expected call of ProcessPendingWebhooks.
+func (mr *MockWebhookServiceInterfaceMockRecorder) ProcessPendingWebhooks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPendingWebhooks", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ProcessPendingWebhooks), ctx)
+}
+
+// QueueTransferNotification mocks base method.
+func (m *MockWebhookServiceInterface) QueueTransferNotification(ctx context.Context, transfer *models.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueTransferNotification", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueueTransferNotification indicates an expected call of QueueTransferNotification.
+func (mr *MockWebhookServiceInterfaceMockRecorder) QueueTransferNotification(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueTransferNotification", reflect.TypeOf((*MockWebhookServiceInterface)(nil).QueueTransferNotification), ctx, transfer)
+}
+
+// RecordDeadLetterMetrics mocks base method.
+func (m *MockWebhookServiceInterface) RecordDeadLetterMetrics(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordDeadLetterMetrics", ctx)
+}
+
+// RecordDeadLetterMetrics indicates an expected call of RecordDeadLetterMetrics.
+func (mr *MockWebhookServiceInterfaceMockRecorder) RecordDeadLetterMetrics(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDeadLetterMetrics", reflect.TypeOf((*MockWebhookServiceInterface)(nil).RecordDeadLetterMetrics), ctx)
+}
+
+// ReplayNotification mocks base method.
+func (m *MockWebhookServiceInterface) ReplayNotification(ctx context.Context, adminID, notificationID uuid.UUID) (*models.WebhookNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayNotification", ctx, adminID, notificationID)
+	ret0, _ := ret[0].(*models.WebhookNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayNotification indicates an expected call of ReplayNotification.
+func (mr *MockWebhookServiceInterfaceMockRecorder) ReplayNotification(ctx, adminID, notificationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayNotification", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ReplayNotification), ctx, adminID, notificationID)
+}
+
+// ReplayNotifications mocks base method.
+func (m *MockWebhookServiceInterface) ReplayNotifications(ctx context.Context, adminID uuid.UUID, req *dto.ReplayWebhookNotificationsRequest) (*dto.ReplayWebhookNotificationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayNotifications", ctx, adminID, req)
+	ret0, _ := ret[0].(*dto.ReplayWebhookNotificationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayNotifications indicates an expected call of ReplayNotifications.
+func (mr *MockWebhookServiceInterfaceMockRecorder) ReplayNotifications(ctx, adminID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayNotifications", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ReplayNotifications), ctx, adminID, req)
+}
+
+// ResolveNotification mocks base method.
+func (m *MockWebhookServiceInterface) ResolveNotification(ctx context.Context, adminID, notificationID uuid.UUID, note string) (*models.WebhookNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveNotification", ctx, adminID, notificationID, note)
+	ret0, _ := ret[0].(*models.WebhookNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveNotification indicates an expected call of ResolveNotification.
+func (mr *MockWebhookServiceInterfaceMockRecorder) ResolveNotification(ctx, adminID, notificationID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveNotification", reflect.TypeOf((*MockWebhookServiceInterface)(nil).ResolveNotification), ctx, adminID, notificationID, note)
+}
+
+// MockInboundCreditServiceInterface is a mock of InboundCreditServiceInterface interface.
+type MockInboundCreditServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboundCreditServiceInterfaceMockRecorder
+}
+
+// MockInboundCreditServiceInterfaceMockRecorder is the mock recorder for MockInboundCreditServiceInterface.
+type MockInboundCreditServiceInterfaceMockRecorder struct {
+	mock *MockInboundCreditServiceInterface
+}
+
+// NewMockInboundCreditServiceInterface creates a new mock instance.
+func NewMockInboundCreditServiceInterface(ctrl *gomock.Controller) *MockInboundCreditServiceInterface {
+	mock := &MockInboundCreditServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockInboundCreditServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboundCreditServiceInterface) EXPECT() *MockInboundCreditServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockInboundCreditServiceInterface) List(ctx context.Context, status string, offset, limit int) ([]models.InboundCredit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, status, offset, limit)
+	ret0, _ := ret[0].([]models.InboundCredit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockInboundCreditServiceInterfaceMockRecorder) List(ctx, status, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInboundCreditServiceInterface)(nil).List), ctx, status, offset, limit)
+}
+
+// ProcessNorthwindCredit mocks base method.
+func (m *MockInboundCreditServiceInterface) ProcessNorthwindCredit(ctx context.Context, eventID string, data *dto.NorthwindCreditReceivedData) (*models.InboundCredit, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessNorthwindCredit", ctx, eventID, data)
+	ret0, _ := ret[0].(*models.InboundCredit)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ProcessNorthwindCredit indicates an expected call of ProcessNorthwindCredit.
+func (mr *MockInboundCreditServiceInterfaceMockRecorder) ProcessNorthwindCredit(ctx, eventID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessNorthwindCredit", reflect.TypeOf((*MockInboundCreditServiceInterface)(nil).ProcessNorthwindCredit), ctx, eventID, data)
+}
+
+// Resolve mocks base method.
+func (m *MockInboundCreditServiceInterface) Resolve(ctx context.Context, adminID, creditID uuid.UUID, accountNumber, note string) (*models.InboundCredit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, adminID, creditID, accountNumber, note)
+	ret0, _ := ret[0].(*models.InboundCredit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockInboundCreditServiceInterfaceMockRecorder) Resolve(ctx, adminID, creditID, accountNumber, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockInboundCreditServiceInterface)(nil).Resolve), ctx, adminID, creditID, accountNumber, note)
+}
+
+// Return mocks base method.
+func (m *MockInboundCreditServiceInterface) Return(ctx context.Context, adminID, creditID uuid.UUID, note string) (*models.InboundCredit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Return", ctx, adminID, creditID, note)
+	ret0, _ := ret[0].(*models.InboundCredit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Return indicates an expected call of Return.
+func (mr *MockInboundCreditServiceInterfaceMockRecorder) Return(ctx, adminID, creditID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Return", reflect.TypeOf((*MockInboundCreditServiceInterface)(nil).Return), ctx, adminID, creditID, note)
+}
+
+// MockOutboxConsumerInterface is a mock of OutboxConsumerInterface interface.
+type MockOutboxConsumerInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxConsumerInterfaceMockRecorder
+}
+
+// MockOutboxConsumerInterfaceMockRecorder is the mock recorder for MockOutboxConsumerInterface.
+type MockOutboxConsumerInterfaceMockRecorder struct {
+	mock *MockOutboxConsumerInterface
+}
+
+// NewMockOutboxConsumerInterface creates a new mock instance.
+func NewMockOutboxConsumerInterface(ctrl *gomock.Controller) *MockOutboxConsumerInterface {
+	mock := &MockOutboxConsumerInterface{ctrl: ctrl}
+	mock.recorder = &MockOutboxConsumerInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxConsumerInterface) EXPECT() *MockOutboxConsumerInterfaceMockRecorder {
+	return m.recorder
+}
+
+// HandleEvent mocks base method.
+func (m *MockOutboxConsumerInterface) HandleEvent(ctx context.Context, event *models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleEvent indicates an expected call of HandleEvent.
+func (mr *MockOutboxConsumerInterfaceMockRecorder) HandleEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockOutboxConsumerInterface)(nil).HandleEvent), ctx, event)
+}
+
+// Name mocks base method.
+func (m *MockOutboxConsumerInterface) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockOutboxConsumerInterfaceMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockOutboxConsumerInterface)(nil).Name))
+}
+
+// MockOutboxRelayServiceInterface is a mock of OutboxRelayServiceInterface interface.
+type MockOutboxRelayServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRelayServiceInterfaceMockRecorder
+}
+
+// MockOutboxRelayServiceInterfaceMockRecorder is the mock recorder for MockOutboxRelayServiceInterface.
+type MockOutboxRelayServiceInterfaceMockRecorder struct {
+	mock *MockOutboxRelayServiceInterface
+}
+
+// NewMockOutboxRelayServiceInterface creates a new mock instance.
+func NewMockOutboxRelayServiceInterface(ctrl *gomock.Controller) *MockOutboxRelayServiceInterface {
+	mock := &MockOutboxRelayServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockOutboxRelayServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRelayServiceInterface) EXPECT() *MockOutboxRelayServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ListConsumers mocks base method.
+func (m *MockOutboxRelayServiceInterface) ListConsumers(ctx context.Context) ([]dto.OutboxConsumerStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsumers", ctx)
+	ret0, _ := ret[0].([]dto.OutboxConsumerStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumers indicates an expected call of ListConsumers.
+func (mr *MockOutboxRelayServiceInterfaceMockRecorder) ListConsumers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumers", reflect.TypeOf((*MockOutboxRelayServiceInterface)(nil).ListConsumers), ctx)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockOutboxRelayServiceInterface) ListDeadLetters(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxDeadLetter, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", ctx, consumer, offset, limit)
+	ret0, _ := ret[0].([]models.OutboxDeadLetter)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockOutboxRelayServiceInterfaceMockRecorder) ListDeadLetters(ctx, consumer, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockOutboxRelayServiceInterface)(nil).ListDeadLetters), ctx, consumer, offset, limit)
+}
+
+// ListUndeliveredEvents mocks base method.
+func (m *MockOutboxRelayServiceInterface) ListUndeliveredEvents(ctx context.Context, consumer string, offset, limit int) ([]models.OutboxEvent, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUndeliveredEvents", ctx, consumer, offset, limit)
+	ret0, _ := ret[0].([]models.OutboxEvent)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUndeliveredEvents indicates an expected call of ListUndeliveredEvents.
+func (mr *MockOutboxRelayServiceInterfaceMockRecorder) ListUndeliveredEvents(ctx, consumer, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUndeliveredEvents", reflect.TypeOf((*MockOutboxRelayServiceInterface)(nil).ListUndeliveredEvents), ctx, consumer, offset, limit)
+}
+
+// Relay mocks base method.
+func (m *MockOutboxRelayServiceInterface) Relay(ctx context.Context) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relay", ctx)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Relay indicates an expected call of Relay.
+func (mr *MockOutboxRelayServiceInterfaceMockRecorder) Relay(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockOutboxRelayServiceInterface)(nil).Relay), ctx)
+}
+
+// ReplayDeadLetter mocks base method.
+func (m *MockOutboxRelayServiceInterface) ReplayDeadLetter(ctx context.Context, adminID uuid.UUID, consumer string, sequence int64) (*models.OutboxDeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetter", ctx, adminID, consumer, sequence)
+	ret0, _ := ret[0].(*models.OutboxDeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetter indicates an expected call of ReplayDeadLetter.
+func (mr *MockOutboxRelayServiceInterfaceMockRecorder) ReplayDeadLetter(ctx, adminID, consumer, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetter", reflect.TypeOf((*MockOutboxRelayServiceInterface)(nil).ReplayDeadLetter), ctx, adminID, consumer, sequence)
+}
+
+// SkipEvent mocks base method.
+func (m *MockOutboxRelayServiceInterface) SkipEvent(ctx context.Context, adminID uuid.UUID, consumer string, sequence int64) (*models.OutboxDeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipEvent", ctx, adminID, consumer, sequence)
+	ret0, _ := ret[0].(*models.OutboxDeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SkipEvent indicates an expected call of SkipEvent.
+func (mr *MockOutboxRelayServiceInterfaceMockRecorder) SkipEvent(ctx, adminID, consumer, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipEvent", reflect.TypeOf((*MockOutboxRelayServiceInterface)(nil).SkipEvent), ctx, adminID, consumer, sequence)
+}
+
+// MockCustomerWebhookServiceInterface is a mock of CustomerWebhookServiceInterface interface.
+type MockCustomerWebhookServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerWebhookServiceInterfaceMockRecorder
+}
+
+// MockCustomerWebhookServiceInterfaceMockRecorder is the mock recorder for MockCustomerWebhookServiceInterface.
+type MockCustomerWebhookServiceInterfaceMockRecorder struct {
+	mock *MockCustomerWebhookServiceInterface
+}
+
+// NewMockCustomerWebhookServiceInterface creates a new mock instance.
+func NewMockCustomerWebhookServiceInterface(ctrl *gomock.Controller) *MockCustomerWebhookServiceInterface {
+	mock := &MockCustomerWebhookServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCustomerWebhookServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerWebhookServiceInterface) EXPECT() *MockCustomerWebhookServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockCustomerWebhookServiceInterface) CreateSubscription(ctx context.Context, userID uuid.UUID, req *dto.CreateWebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, userID, req)
+	ret0, _ := ret[0].(*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) CreateSubscription(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).CreateSubscription), ctx, userID, req)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockCustomerWebhookServiceInterface) DeleteSubscription(ctx context.Context, userID, subscriptionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, userID, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) DeleteSubscription(ctx, userID, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).DeleteSubscription), ctx, userID, subscriptionID)
+}
+
+// GetSubscription mocks base method.
+func (m *MockCustomerWebhookServiceInterface) GetSubscription(ctx context.Context, userID, subscriptionID uuid.UUID) (*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, userID, subscriptionID)
+	ret0, _ := ret[0].(*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) GetSubscription(ctx, userID, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).GetSubscription), ctx, userID, subscriptionID)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockCustomerWebhookServiceInterface) ListDeliveries(ctx context.Context, userID, subscriptionID uuid.UUID, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, userID, subscriptionID, offset, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) ListDeliveries(ctx, userID, subscriptionID, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).ListDeliveries), ctx, userID, subscriptionID, offset, limit)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockCustomerWebhookServiceInterface) ListSubscriptions(ctx context.Context, userID uuid.UUID) ([]models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, userID)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) ListSubscriptions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).ListSubscriptions), ctx, userID)
+}
+
+// Ping mocks base method.
+func (m *MockCustomerWebhookServiceInterface) Ping(ctx context.Context, userID, subscriptionID uuid.UUID) (*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx, userID, subscriptionID)
+	ret0, _ := ret[0].(*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) Ping(ctx, userID, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).Ping), ctx, userID, subscriptionID)
+}
+
+// ProcessPendingDeliveries mocks base method.
+func (m *MockCustomerWebhookServiceInterface) ProcessPendingDeliveries(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProcessPendingDeliveries", ctx)
+}
+
+// ProcessPendingDeliveries indicates an expected call of ProcessPendingDeliveries.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) ProcessPendingDeliveries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPendingDeliveries", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).ProcessPendingDeliveries), ctx)
+}
+
+// QueueEvent mocks base method.
+func (m *MockCustomerWebhookServiceInterface) QueueEvent(ctx context.Context, event *models.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueueEvent indicates an expected call of QueueEvent.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) QueueEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueEvent", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).QueueEvent), ctx, event)
+}
+
+// Redeliver mocks base method.
+func (m *MockCustomerWebhookServiceInterface) Redeliver(ctx context.Context, userID, subscriptionID, deliveryID uuid.UUID) (*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, userID, subscriptionID, deliveryID)
+	ret0, _ := ret[0].(*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) Redeliver(ctx, userID, subscriptionID, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).Redeliver), ctx, userID, subscriptionID, deliveryID)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockCustomerWebhookServiceInterface) UpdateSubscription(ctx context.Context, userID, subscriptionID uuid.UUID, req *dto.UpdateWebhookSubscriptionRequest) (*models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, userID, subscriptionID, req)
+	ret0, _ := ret[0].(*models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockCustomerWebhookServiceInterfaceMockRecorder) UpdateSubscription(ctx, userID, subscriptionID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockCustomerWebhookServiceInterface)(nil).UpdateSubscription), ctx, userID, subscriptionID, req)
+}
+
+// MockComplianceServiceInterface is a mock of ComplianceServiceInterface interface.
+type MockComplianceServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockComplianceServiceInterfaceMockRecorder
+}
+
+// MockComplianceServiceInterfaceMockRecorder is the mock recorder for MockComplianceServiceInterface.
+type MockComplianceServiceInterfaceMockRecorder struct {
+	mock *MockComplianceServiceInterface
+}
+
+// NewMockComplianceServiceInterface creates a new mock instance.
+func NewMockComplianceServiceInterface(ctrl *gomock.Controller) *MockComplianceServiceInterface {
+	mock := &MockComplianceServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockComplianceServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComplianceServiceInterface) EXPECT() *MockComplianceServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ApproveReport mocks base method.
+func (m *MockComplianceServiceInterface) ApproveReport(ctx context.Context, adminID, reportID uuid.UUID, note string) (*models.ComplianceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveReport", ctx, adminID, reportID, note)
+	ret0, _ := ret[0].(*models.ComplianceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveReport indicates an expected call of ApproveReport.
+func (mr *MockComplianceServiceInterfaceMockRecorder) ApproveReport(ctx, adminID, reportID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveReport", reflect.TypeOf((*MockComplianceServiceInterface)(nil).ApproveReport), ctx, adminID, reportID, note)
+}
+
+// GeneratePreviousBusinessDay mocks base method.
+func (m *MockComplianceServiceInterface) GeneratePreviousBusinessDay(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GeneratePreviousBusinessDay", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GeneratePreviousBusinessDay indicates an expected call of GeneratePreviousBusinessDay.
+func (mr *MockComplianceServiceInterfaceMockRecorder) GeneratePreviousBusinessDay(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeneratePreviousBusinessDay", reflect.TypeOf((*MockComplianceServiceInterface)(nil).GeneratePreviousBusinessDay), ctx)
+}
+
+// GenerateReports mocks base method.
+func (m *MockComplianceServiceInterface) GenerateReports(ctx context.Context, businessDate time.Time) (*dto.GenerateComplianceReportsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateReports", ctx, businessDate)
+	ret0, _ := ret[0].(*dto.GenerateComplianceReportsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateReports indicates an expected call of GenerateReports.
+func (mr *MockComplianceServiceInterfaceMockRecorder) GenerateReports(ctx, businessDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateReports", reflect.TypeOf((*MockComplianceServiceInterface)(nil).GenerateReports), ctx, businessDate)
+}
+
+// GetReport mocks base method.
+func (m *MockComplianceServiceInterface) GetReport(ctx context.Context, reportID uuid.UUID) (*models.ComplianceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", ctx, reportID)
+	ret0, _ := ret[0].(*models.ComplianceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockComplianceServiceInterfaceMockRecorder) GetReport(ctx, reportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockComplianceServiceInterface)(nil).GetReport), ctx, reportID)
+}
+
+// GetReportFile mocks base method.
+func (m *MockComplianceServiceInterface) GetReportFile(ctx context.Context, reportID uuid.UUID) (*dto.ComplianceReportFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportFile", ctx, reportID)
+	ret0, _ := ret[0].(*dto.ComplianceReportFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportFile indicates an expected call of GetReportFile.
+func (mr *MockComplianceServiceInterfaceMockRecorder) GetReportFile(ctx, reportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportFile", reflect.TypeOf((*MockComplianceServiceInterface)(nil).GetReportFile), ctx, reportID)
+}
+
+// ListReports mocks base method.
+func (m *MockComplianceServiceInterface) ListReports(ctx context.Context, filters models.ComplianceReportFilters, offset, limit int) ([]models.ComplianceReport, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReports", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.ComplianceReport)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockComplianceServiceInterfaceMockRecorder) ListReports(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockComplianceServiceInterface)(nil).ListReports), ctx, filters, offset, limit)
+}
+
+// RejectReport mocks base method.
+func (m *MockComplianceServiceInterface) RejectReport(ctx context.Context, adminID, reportID uuid.UUID, note string) (*models.ComplianceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectReport", ctx, adminID, reportID, note)
+	ret0, _ := ret[0].(*models.ComplianceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectReport indicates an expected call of RejectReport.
+func (mr *MockComplianceServiceInterfaceMockRecorder) RejectReport(ctx, adminID, reportID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectReport", reflect.TypeOf((*MockComplianceServiceInterface)(nil).RejectReport), ctx, adminID, reportID, note)
+}
+
+// SubmitApprovedReports mocks base method.
+func (m *MockComplianceServiceInterface) SubmitApprovedReports(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitApprovedReports", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitApprovedReports indicates an expected call of SubmitApprovedReports.
+func (mr *MockComplianceServiceInterfaceMockRecorder) SubmitApprovedReports(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitApprovedReports", reflect.TypeOf((*MockComplianceServiceInterface)(nil).SubmitApprovedReports), ctx)
+}
+
+// MockFraudScreeningServiceInterface is a mock of FraudScreeningServiceInterface interface.
+type MockFraudScreeningServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFraudScreeningServiceInterfaceMockRecorder
+}
+
+// MockFraudScreeningServiceInterfaceMockRecorder is the mock recorder for MockFraudScreeningServiceInterface.
+type MockFraudScreeningServiceInterfaceMockRecorder struct {
+	mock *MockFraudScreeningServiceInterface
+}
+
+// NewMockFraudScreeningServiceInterface creates a new mock instance.
+func NewMockFraudScreeningServiceInterface(ctrl *gomock.Controller) *MockFraudScreeningServiceInterface {
+	mock := &MockFraudScreeningServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockFraudScreeningServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFraudScreeningServiceInterface) EXPECT() *MockFraudScreeningServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AttachResource mocks base method.
+func (m *MockFraudScreeningServiceInterface) AttachResource(ctx context.Context, decisionID uuid.UUID, resourceType string, resourceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachResource", ctx, decisionID, resourceType, resourceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachResource indicates an expected call of AttachResource.
+func (mr *MockFraudScreeningServiceInterfaceMockRecorder) AttachResource(ctx, decisionID, resourceType, resourceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachResource", reflect.TypeOf((*MockFraudScreeningServiceInterface)(nil).AttachResource), ctx, decisionID, resourceType, resourceID)
+}
+
+// GetDecision mocks base method.
+func (m *MockFraudScreeningServiceInterface) GetDecision(ctx context.Context, decisionID uuid.UUID) (*models.FraudDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDecision", ctx, decisionID)
+	ret0, _ := ret[0].(*models.FraudDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDecision indicates an expected call of GetDecision.
+func (mr *MockFraudScreeningServiceInterfaceMockRecorder) GetDecision(ctx, decisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDecision", reflect.TypeOf((*MockFraudScreeningServiceInterface)(nil).GetDecision), ctx, decisionID)
+}
+
+// ListDecisions mocks base method.
+func (m *MockFraudScreeningServiceInterface) ListDecisions(ctx context.Context, filters models.FraudDecisionFilters, offset, limit int) ([]models.FraudDecision, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDecisions", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.FraudDecision)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDecisions indicates an expected call of ListDecisions.
+func (mr *MockFraudScreeningServiceInterfaceMockRecorder) ListDecisions(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDecisions", reflect.TypeOf((*MockFraudScreeningServiceInterface)(nil).ListDecisions), ctx, filters, offset, limit)
+}
+
+// ListRules mocks base method.
+func (m *MockFraudScreeningServiceInterface) ListRules(ctx context.Context) ([]dto.FraudRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx)
+	ret0, _ := ret[0].([]dto.FraudRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockFraudScreeningServiceInterfaceMockRecorder) ListRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockFraudScreeningServiceInterface)(nil).ListRules), ctx)
+}
+
+// Screen mocks base method.
+func (m *MockFraudScreeningServiceInterface) Screen(ctx context.Context, req *dto.FraudScreeningRequest) (*models.FraudDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Screen", ctx, req)
+	ret0, _ := ret[0].(*models.FraudDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Screen indicates an expected call of Screen.
+func (mr *MockFraudScreeningServiceInterfaceMockRecorder) Screen(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Screen", reflect.TypeOf((*MockFraudScreeningServiceInterface)(nil).Screen), ctx, req)
+}
+
+// UpdateRule mocks base method.
+func (m *MockFraudScreeningServiceInterface) UpdateRule(ctx context.Context, adminID uuid.UUID, name string, req *dto.UpdateFraudRuleRequest) (*dto.FraudRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", ctx, adminID, name, req)
+	ret0, _ := ret[0].(*dto.FraudRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRule indicates an expected call of UpdateRule.
+func (mr *MockFraudScreeningServiceInterfaceMockRecorder) UpdateRule(ctx, adminID, name, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockFraudScreeningServiceInterface)(nil).UpdateRule), ctx, adminID, name, req)
+}
+
+// MockSanctionsScreeningServiceInterface is a mock of SanctionsScreeningServiceInterface interface.
+type MockSanctionsScreeningServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSanctionsScreeningServiceInterfaceMockRecorder
+}
+
+// MockSanctionsScreeningServiceInterfaceMockRecorder is the mock recorder for MockSanctionsScreeningServiceInterface.
+type MockSanctionsScreeningServiceInterfaceMockRecorder struct {
+	mock *MockSanctionsScreeningServiceInterface
+}
+
+// NewMockSanctionsScreeningServiceInterface creates a new mock instance.
+func NewMockSanctionsScreeningServiceInterface(ctrl *gomock.Controller) *MockSanctionsScreeningServiceInterface {
+	mock := &MockSanctionsScreeningServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockSanctionsScreeningServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSanctionsScreeningServiceInterface) EXPECT() *MockSanctionsScreeningServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckUser mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) CheckUser(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUser indicates an expected call of CheckUser.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) CheckUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUser", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).CheckUser), ctx, userID)
+}
+
+// ClearMatch mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) ClearMatch(ctx context.Context, adminID, matchID uuid.UUID, note string) (*models.SanctionsMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearMatch", ctx, adminID, matchID, note)
+	ret0, _ := ret[0].(*models.SanctionsMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearMatch indicates an expected call of ClearMatch.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) ClearMatch(ctx, adminID, matchID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearMatch", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).ClearMatch), ctx, adminID, matchID, note)
+}
+
+// ConfirmMatch mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) ConfirmMatch(ctx context.Context, adminID, matchID uuid.UUID, note string) (*models.SanctionsMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMatch", ctx, adminID, matchID, note)
+	ret0, _ := ret[0].(*models.SanctionsMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMatch indicates an expected call of ConfirmMatch.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) ConfirmMatch(ctx, adminID, matchID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMatch", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).ConfirmMatch), ctx, adminID, matchID, note)
+}
+
+// GetListStatus mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) GetListStatus(ctx context.Context) (*models.SanctionsListLoad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListStatus", ctx)
+	ret0, _ := ret[0].(*models.SanctionsListLoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListStatus indicates an expected call of GetListStatus.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) GetListStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListStatus", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).GetListStatus), ctx)
+}
+
+// GetMatch mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) GetMatch(ctx context.Context, matchID uuid.UUID) (*models.SanctionsMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatch", ctx, matchID)
+	ret0, _ := ret[0].(*models.SanctionsMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatch indicates an expected call of GetMatch.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) GetMatch(ctx, matchID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatch", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).GetMatch), ctx, matchID)
+}
+
+// ListMatches mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) ListMatches(ctx context.Context, filters models.SanctionsMatchFilters, offset, limit int) ([]models.SanctionsMatch, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMatches", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.SanctionsMatch)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMatches indicates an expected call of ListMatches.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) ListMatches(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatches", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).ListMatches), ctx, filters, offset, limit)
+}
+
+// LoadList mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) LoadList(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadList", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadList indicates an expected call of LoadList.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) LoadList(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadList", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).LoadList), ctx)
+}
+
+// RefreshList mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) RefreshList(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshList", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshList indicates an expected call of RefreshList.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) RefreshList(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshList", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).RefreshList), ctx)
+}
+
+// Rescreen mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) Rescreen(ctx context.Context) (*models.SanctionsListLoad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rescreen", ctx)
+	ret0, _ := ret[0].(*models.SanctionsListLoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rescreen indicates an expected call of Rescreen.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) Rescreen(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rescreen", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).Rescreen), ctx)
+}
+
+// ScreenExternalAccount mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) ScreenExternalAccount(ctx context.Context, account *models.ExternalAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScreenExternalAccount", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScreenExternalAccount indicates an expected call of ScreenExternalAccount.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) ScreenExternalAccount(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenExternalAccount", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).ScreenExternalAccount), ctx, account)
+}
+
+// ScreenUser mocks base method.
+func (m *MockSanctionsScreeningServiceInterface) ScreenUser(ctx context.Context, user *models.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScreenUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScreenUser indicates an expected call of ScreenUser.
+func (mr *MockSanctionsScreeningServiceInterfaceMockRecorder) ScreenUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScreenUser", reflect.TypeOf((*MockSanctionsScreeningServiceInterface)(nil).ScreenUser), ctx, user)
+}
+
+// MockTransferReviewServiceInterface is a mock of TransferReviewServiceInterface interface.
+type MockTransferReviewServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferReviewServiceInterfaceMockRecorder
+}
+
+// MockTransferReviewServiceInterfaceMockRecorder is the mock recorder for MockTransferReviewServiceInterface.
+type MockTransferReviewServiceInterfaceMockRecorder struct {
+	mock *MockTransferReviewServiceInterface
+}
+
+// NewMockTransferReviewServiceInterface creates a new mock instance.
+func NewMockTransferReviewServiceInterface(ctrl *gomock.Controller) *MockTransferReviewServiceInterface {
+	mock := &MockTransferReviewServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTransferReviewServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferReviewServiceInterface) EXPECT() *MockTransferReviewServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockTransferReviewServiceInterface) Approve(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, adminID, reviewID, note)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockTransferReviewServiceInterfaceMockRecorder) Approve(ctx, adminID, reviewID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockTransferReviewServiceInterface)(nil).Approve), ctx, adminID, reviewID, note)
+}
+
+// Evaluate mocks base method.
+func (m *MockTransferReviewServiceInterface) Evaluate(ctx context.Context, req *dto.TransferReviewRequest) ([]dto.TransferReviewReason, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, req)
+	ret0, _ := ret[0].([]dto.TransferReviewReason)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockTransferReviewServiceInterfaceMockRecorder) Evaluate(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockTransferReviewServiceInterface)(nil).Evaluate), ctx, req)
+}
+
+// GetCustomerActivity mocks base method.
+func (m *MockTransferReviewServiceInterface) GetCustomerActivity(ctx context.Context, userID uuid.UUID) (*dto.TransferReviewActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerActivity", ctx, userID)
+	ret0, _ := ret[0].(*dto.TransferReviewActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerActivity indicates an expected call of GetCustomerActivity.
+func (mr *MockTransferReviewServiceInterfaceMockRecorder) GetCustomerActivity(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerActivity", reflect.TypeOf((*MockTransferReviewServiceInterface)(nil).GetCustomerActivity), ctx, userID)
+}
+
+// GetReview mocks base method.
+func (m *MockTransferReviewServiceInterface) GetReview(ctx context.Context, reviewID uuid.UUID) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, reviewID)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockTransferReviewServiceInterfaceMockRecorder) GetReview(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockTransferReviewServiceInterface)(nil).GetReview), ctx, reviewID)
+}
+
+// Hold mocks base method.
+func (m *MockTransferReviewServiceInterface) Hold(ctx context.Context, userID uuid.UUID, transfer *models.Transfer, reasons []dto.TransferReviewReason, transferType, debitDescription string) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", ctx, userID, transfer, reasons, transferType, debitDescription)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockTransferReviewServiceInterfaceMockRecorder) Hold(ctx, userID, transfer, reasons, transferType, debitDescription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockTransferReviewServiceInterface)(nil).Hold), ctx, userID, transfer, reasons, transferType, debitDescription)
+}
+
+// ListReviews mocks base method.
+func (m *MockTransferReviewServiceInterface) ListReviews(ctx context.Context, filters models.TransferReviewFilters, offset, limit int) ([]models.TransferReview, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviews", ctx, filters, offset, limit)
+	ret0, _ := ret[0].([]models.TransferReview)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListReviews indicates an expected call of ListReviews.
+func (mr *MockTransferReviewServiceInterfaceMockRecorder) ListReviews(ctx, filters, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockTransferReviewServiceInterface)(nil).ListReviews), ctx, filters, offset, limit)
+}
+
+// Reject mocks base method.
+func (m *MockTransferReviewServiceInterface) Reject(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, adminID, reviewID, note)
+	ret0, _ := ret[0].(*models.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockTransferReviewServiceInterfaceMockRecorder) Reject(ctx, adminID, reviewID, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockTransferReviewServiceInterface)(nil).Reject), ctx, adminID, reviewID, note)
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -45,7 +46,7 @@ func NewStatementService(
 	}
 }
 
-func (s *statementService) GenerateStatement(requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error) {
+func (s *statementService) GenerateStatement(ctx context.Context, requestorID, accountID uuid.UUID, periodType string, year, period int, isAdmin bool) (*models.AccountStatement, error) {
 	if err := s.validatePeriodType(periodType); err != nil {
 		return nil, err
 	}
@@ -63,17 +64,17 @@ func (s *statementService) GenerateStatement(requestorID, accountID uuid.UUID, p
 		return nil, err
 	}
 
-	requestor, err := s.validateRequestor(requestorID)
+	requestor, err := s.validateRequestor(ctx, requestorID)
 	if err != nil {
 		return nil, err
 	}
 
-	account, err := s.getAndAuthorizeAccount(accountID, requestor, isAdmin)
+	account, err := s.getAndAuthorizeAccount(ctx, accountID, requestor, isAdmin)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions, err := s.transactionRepo.GetByDateRange(accountID, startDate, endDate)
+	transactions, err := s.transactionRepo.GetByDateRange(ctx, accountID, startDate, endDate)
 	if err != nil {
 		slog.Error("failed to fetch transactions for statement",
 			"account_id", accountID,
@@ -87,7 +88,7 @@ func (s *statementService) GenerateStatement(requestorID, accountID uuid.UUID, p
 
 	summary := s.calculateSummary(transactions)
 
-	metrics, err := s.metricsService.GetAccountMetrics(requestorID, accountID, &startDate, &endDate, isAdmin)
+	metrics, err := s.metricsService.GetAccountMetrics(ctx, requestorID, accountID, &startDate, &endDate, isAdmin)
 	if err != nil {
 		slog.Warn("failed to fetch metrics for statement",
 			"account_id", accountID,
@@ -165,8 +166,8 @@ func (s *statementService) validateNotFuture(endDate time.Time) error {
 	return nil
 }
 
-func (s *statementService) validateRequestor(requestorID uuid.UUID) (*models.User, error) {
-	requestor, err := s.userRepo.GetByID(requestorID)
+func (s *statementService) validateRequestor(ctx context.Context, requestorID uuid.UUID) (*models.User, error) {
+	requestor, err := s.userRepo.GetByID(ctx, requestorID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			slog.Warn("user not found during statement request",
@@ -182,8 +183,8 @@ func (s *statementService) validateRequestor(requestorID uuid.UUID) (*models.Use
 	return requestor, nil
 }
 
-func (s *statementService) getAndAuthorizeAccount(accountID uuid.UUID, requestor *models.User, isAdmin bool) (*models.Account, error) {
-	account, err := s.accountRepo.GetByID(accountID)
+func (s *statementService) getAndAuthorizeAccount(ctx context.Context, accountID uuid.UUID, requestor *models.User, isAdmin bool) (*models.Account, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
 		slog.Error("failed to get account for statement",
 			"account_id", accountID,
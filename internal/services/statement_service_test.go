@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -21,14 +22,14 @@ type MockAccountMetricsService struct {
 	GetUserAggregateMetricsFunc func(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error)
 }
 
-func (m *MockAccountMetricsService) GetAccountMetrics(requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
+func (m *MockAccountMetricsService) GetAccountMetrics(_ context.Context, requestorID, accountID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.AccountMetrics, error) {
 	if m.GetAccountMetricsFunc != nil {
 		return m.GetAccountMetricsFunc(requestorID, accountID, startDate, endDate, isAdmin)
 	}
 	return nil, nil
 }
 
-func (m *MockAccountMetricsService) GetUserAggregateMetrics(requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
+func (m *MockAccountMetricsService) GetUserAggregateMetrics(_ context.Context, requestorID, targetUserID uuid.UUID, startDate, endDate *time.Time, isAdmin bool) (*models.UserAggregateMetrics, error) {
 	if m.GetUserAggregateMetricsFunc != nil {
 		return m.GetUserAggregateMetricsFunc(requestorID, targetUserID, startDate, endDate, isAdmin)
 	}
@@ -113,14 +114,14 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Success_Monthly() {
 		TransactionCount: 1,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, startDate, endDate).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, startDate, endDate).Return(transactions, nil)
 	s.mockMetricsService.GetAccountMetricsFunc = func(reqID, accID uuid.UUID, start, end *time.Time, admin bool) (*models.AccountMetrics, error) {
 		return metrics, nil
 	}
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, year, month, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, year, month, false)
 
 	s.NoError(err)
 	s.NotNil(statement)
@@ -162,14 +163,14 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Success_Quarterly() {
 		TransactionCount: 0,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 	s.mockMetricsService.GetAccountMetricsFunc = func(reqID, accID uuid.UUID, start, end *time.Time, admin bool) (*models.AccountMetrics, error) {
 		return metrics, nil
 	}
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeQuarterly, year, quarter, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeQuarterly, year, quarter, false)
 
 	s.NoError(err)
 	s.NotNil(statement)
@@ -183,7 +184,7 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Error_InvalidMonth() {
 	requestorID := uuid.New()
 	accountID := uuid.New()
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, 2025, 13, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, 2025, 13, false)
 
 	s.Error(err)
 	s.Nil(statement)
@@ -195,7 +196,7 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Error_InvalidQuarter()
 	requestorID := uuid.New()
 	accountID := uuid.New()
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeQuarterly, 2025, 5, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeQuarterly, 2025, 5, false)
 
 	s.Error(err)
 	s.Nil(statement)
@@ -207,7 +208,7 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Error_InvalidPeriodTyp
 	requestorID := uuid.New()
 	accountID := uuid.New()
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, "invalid", 2025, 1, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, "invalid", 2025, 1, false)
 
 	s.Error(err)
 	s.Nil(statement)
@@ -220,7 +221,7 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Error_FuturePeriod() {
 	accountID := uuid.New()
 	futureYear := time.Now().Year() + 2
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, futureYear, 1, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, futureYear, 1, false)
 
 	s.Error(err)
 	s.Nil(statement)
@@ -245,10 +246,10 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Error_Unauthorized() {
 		Balance: decimal.NewFromFloat(5000.00),
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, 2025, 9, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, 2025, 9, false)
 
 	s.Error(err)
 	s.Nil(statement)
@@ -282,14 +283,14 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Success_AdminAccess()
 		TransactionCount: 0,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(adminID).Return(admin, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), adminID).Return(admin, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 	s.mockMetricsService.GetAccountMetricsFunc = func(reqID, accID uuid.UUID, start, end *time.Time, admin bool) (*models.AccountMetrics, error) {
 		return metrics, nil
 	}
 
-	statement, err := s.service.GenerateStatement(adminID, accountID, PeriodTypeMonthly, 2025, 9, true)
+	statement, err := s.service.GenerateStatement(context.Background(), adminID, accountID, PeriodTypeMonthly, 2025, 9, true)
 
 	s.NoError(err)
 	s.NotNil(statement)
@@ -306,10 +307,10 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Error_AccountNotFound(
 		Role:  models.RoleCustomer,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(nil, errors.New("account not found"))
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(nil, errors.New("account not found"))
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, 2025, 9, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, 2025, 9, false)
 
 	s.Error(err)
 	s.Nil(statement)
@@ -381,14 +382,14 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Success_RunningBalance
 		TransactionCount: 3,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, startDate, endDate).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, startDate, endDate).Return(transactions, nil)
 	s.mockMetricsService.GetAccountMetricsFunc = func(reqID, accID uuid.UUID, start, end *time.Time, admin bool) (*models.AccountMetrics, error) {
 		return metrics, nil
 	}
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, year, month, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, year, month, false)
 
 	s.NoError(err)
 	s.NotNil(statement)
@@ -460,14 +461,14 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Success_OnlyCompletedI
 		TransactionCount: 1,
 	}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, startDate, endDate).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, startDate, endDate).Return(transactions, nil)
 	s.mockMetricsService.GetAccountMetricsFunc = func(reqID, accID uuid.UUID, start, end *time.Time, admin bool) (*models.AccountMetrics, error) {
 		return metrics, nil
 	}
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, year, month, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, year, month, false)
 
 	s.NoError(err)
 	s.NotNil(statement)
@@ -484,9 +485,9 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Error_RequestorNotFoun
 	requestorID := uuid.New()
 	accountID := uuid.New()
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(nil, repositories.ErrUserNotFound)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(nil, repositories.ErrUserNotFound)
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeMonthly, 2025, 9, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeMonthly, 2025, 9, false)
 
 	s.Error(err)
 	s.Nil(statement)
@@ -516,14 +517,14 @@ func (s *StatementServiceTestSuite) TestGenerateStatement_Success_Q1Dates() {
 	transactions := []models.Transaction{}
 	metrics := &models.AccountMetrics{AccountID: accountID}
 
-	s.mockUserRepo.EXPECT().GetByID(requestorID).Return(requestor, nil)
-	s.mockAccountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-	s.mockTransactionRepo.EXPECT().GetByDateRange(accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
+	s.mockUserRepo.EXPECT().GetByID(gomock.Any(), requestorID).Return(requestor, nil)
+	s.mockAccountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+	s.mockTransactionRepo.EXPECT().GetByDateRange(gomock.Any(), accountID, gomock.Any(), gomock.Any()).Return(transactions, nil)
 	s.mockMetricsService.GetAccountMetricsFunc = func(reqID, accID uuid.UUID, start, end *time.Time, admin bool) (*models.AccountMetrics, error) {
 		return metrics, nil
 	}
 
-	statement, err := s.service.GenerateStatement(requestorID, accountID, PeriodTypeQuarterly, year, quarter, false)
+	statement, err := s.service.GenerateStatement(context.Background(), requestorID, accountID, PeriodTypeQuarterly, year, quarter, false)
 
 	s.NoError(err)
 	s.NotNil(statement)
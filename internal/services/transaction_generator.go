@@ -37,90 +37,90 @@ func NewTransactionGenerator() TransactionGeneratorInterface {
 func initializeMerchantPool() []models.MerchantInfo {
 	return []models.MerchantInfo{
 		// Groceries (10 merchants)
-		{"Walmart Supercenter", models.CategoryGroceries, "5411"},
-		{"Kroger", models.CategoryGroceries, "5411"},
-		{"Whole Foods Market", models.CategoryGroceries, "5411"},
-		{"Safeway", models.CategoryGroceries, "5411"},
-		{"Trader Joe's", models.CategoryGroceries, "5411"},
-		{"Costco Wholesale", models.CategoryGroceries, "5411"},
-		{"Target", models.CategoryGroceries, "5411"},
-		{"Publix Super Market", models.CategoryGroceries, "5411"},
-		{"Aldi", models.CategoryGroceries, "5411"},
-		{"H-E-B", models.CategoryGroceries, "5411"},
+		{Name: "Walmart Supercenter", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Kroger", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Whole Foods Market", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Safeway", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Trader Joe's", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Costco Wholesale", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Target", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Publix Super Market", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "Aldi", Category: models.CategoryGroceries, MCCCode: "5411"},
+		{Name: "H-E-B", Category: models.CategoryGroceries, MCCCode: "5411"},
 
 		// Dining & Restaurants (12 merchants)
-		{"Starbucks", models.CategoryDining, "5814"},
-		{"McDonald's", models.CategoryDining, "5814"},
-		{"Chipotle Mexican Grill", models.CategoryDining, "5812"},
-		{"Subway", models.CategoryDining, "5814"},
-		{"Dunkin'", models.CategoryDining, "5814"},
-		{"Panera Bread", models.CategoryDining, "5812"},
-		{"Chick-fil-A", models.CategoryDining, "5814"},
-		{"Olive Garden", models.CategoryDining, "5812"},
-		{"Pizza Hut", models.CategoryDining, "5814"},
-		{"Taco Bell", models.CategoryDining, "5814"},
-		{"Panda Express", models.CategoryDining, "5814"},
-		{"Five Guys", models.CategoryDining, "5814"},
+		{Name: "Starbucks", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "McDonald's", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "Chipotle Mexican Grill", Category: models.CategoryDining, MCCCode: "5812"},
+		{Name: "Subway", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "Dunkin'", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "Panera Bread", Category: models.CategoryDining, MCCCode: "5812"},
+		{Name: "Chick-fil-A", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "Olive Garden", Category: models.CategoryDining, MCCCode: "5812"},
+		{Name: "Pizza Hut", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "Taco Bell", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "Panda Express", Category: models.CategoryDining, MCCCode: "5814"},
+		{Name: "Five Guys", Category: models.CategoryDining, MCCCode: "5814"},
 
 		// Transportation (8 merchants)
-		{"Uber", models.CategoryTransportation, "4121"},
-		{"Lyft", models.CategoryTransportation, "4121"},
-		{"Shell", models.CategoryTransportation, "5542"},
-		{"Chevron", models.CategoryTransportation, "5542"},
-		{"BP", models.CategoryTransportation, "5542"},
-		{"ExxonMobil", models.CategoryTransportation, "5542"},
-		{"Amtrak", models.CategoryTransportation, "4111"},
-		{"Metro Transit", models.CategoryTransportation, "4111"},
+		{Name: "Uber", Category: models.CategoryTransportation, MCCCode: "4121"},
+		{Name: "Lyft", Category: models.CategoryTransportation, MCCCode: "4121"},
+		{Name: "Shell", Category: models.CategoryTransportation, MCCCode: "5542"},
+		{Name: "Chevron", Category: models.CategoryTransportation, MCCCode: "5542"},
+		{Name: "BP", Category: models.CategoryTransportation, MCCCode: "5542"},
+		{Name: "ExxonMobil", Category: models.CategoryTransportation, MCCCode: "5542"},
+		{Name: "Amtrak", Category: models.CategoryTransportation, MCCCode: "4111"},
+		{Name: "Metro Transit", Category: models.CategoryTransportation, MCCCode: "4111"},
 
 		// Shopping & Retail (10 merchants)
-		{"Amazon.com", models.CategoryShopping, "5942"},
-		{"Best Buy", models.CategoryShopping, "5732"},
-		{"Home Depot", models.CategoryShopping, "5200"},
-		{"Lowe's", models.CategoryShopping, "5200"},
-		{"Macy's", models.CategoryShopping, "5311"},
-		{"Nordstrom", models.CategoryShopping, "5311"},
-		{"Gap", models.CategoryShopping, "5651"},
-		{"Nike", models.CategoryShopping, "5941"},
-		{"Apple Store", models.CategoryShopping, "5732"},
-		{"IKEA", models.CategoryShopping, "5712"},
+		{Name: "Amazon.com", Category: models.CategoryShopping, MCCCode: "5942"},
+		{Name: "Best Buy", Category: models.CategoryShopping, MCCCode: "5732"},
+		{Name: "Home Depot", Category: models.CategoryShopping, MCCCode: "5200"},
+		{Name: "Lowe's", Category: models.CategoryShopping, MCCCode: "5200"},
+		{Name: "Macy's", Category: models.CategoryShopping, MCCCode: "5311"},
+		{Name: "Nordstrom", Category: models.CategoryShopping, MCCCode: "5311"},
+		{Name: "Gap", Category: models.CategoryShopping, MCCCode: "5651"},
+		{Name: "Nike", Category: models.CategoryShopping, MCCCode: "5941"},
+		{Name: "Apple Store", Category: models.CategoryShopping, MCCCode: "5732"},
+		{Name: "IKEA", Category: models.CategoryShopping, MCCCode: "5712"},
 
 		// Entertainment (7 merchants)
-		{"Netflix", models.CategoryEntertainment, "7832"},
-		{"Spotify", models.CategoryEntertainment, "5815"},
-		{"AMC Theaters", models.CategoryEntertainment, "7832"},
-		{"Regal Cinemas", models.CategoryEntertainment, "7832"},
-		{"Xbox Live", models.CategoryEntertainment, "5816"},
-		{"PlayStation Network", models.CategoryEntertainment, "5816"},
-		{"Disney+", models.CategoryEntertainment, "7832"},
+		{Name: "Netflix", Category: models.CategoryEntertainment, MCCCode: "7832"},
+		{Name: "Spotify", Category: models.CategoryEntertainment, MCCCode: "5815"},
+		{Name: "AMC Theaters", Category: models.CategoryEntertainment, MCCCode: "7832"},
+		{Name: "Regal Cinemas", Category: models.CategoryEntertainment, MCCCode: "7832"},
+		{Name: "Xbox Live", Category: models.CategoryEntertainment, MCCCode: "5816"},
+		{Name: "PlayStation Network", Category: models.CategoryEntertainment, MCCCode: "5816"},
+		{Name: "Disney+", Category: models.CategoryEntertainment, MCCCode: "7832"},
 
 		// Bills & Utilities (6 merchants)
-		{"AT&T", models.CategoryBillsUtilities, "4814"},
-		{"Verizon Wireless", models.CategoryBillsUtilities, "4814"},
-		{"Comcast Xfinity", models.CategoryBillsUtilities, "4899"},
-		{"PG&E", models.CategoryBillsUtilities, "4900"},
-		{"Duke Energy", models.CategoryBillsUtilities, "4900"},
-		{"Water Department", models.CategoryBillsUtilities, "4900"},
+		{Name: "AT&T", Category: models.CategoryBillsUtilities, MCCCode: "4814"},
+		{Name: "Verizon Wireless", Category: models.CategoryBillsUtilities, MCCCode: "4814"},
+		{Name: "Comcast Xfinity", Category: models.CategoryBillsUtilities, MCCCode: "4899"},
+		{Name: "PG&E", Category: models.CategoryBillsUtilities, MCCCode: "4900"},
+		{Name: "Duke Energy", Category: models.CategoryBillsUtilities, MCCCode: "4900"},
+		{Name: "Water Department", Category: models.CategoryBillsUtilities, MCCCode: "4900"},
 
 		// Healthcare (5 merchants)
-		{"CVS Pharmacy", models.CategoryHealthcare, "5912"},
-		{"Walgreens", models.CategoryHealthcare, "5912"},
-		{"Kaiser Permanente", models.CategoryHealthcare, "8011"},
-		{"LabCorp", models.CategoryHealthcare, "8071"},
-		{"Quest Diagnostics", models.CategoryHealthcare, "8071"},
+		{Name: "CVS Pharmacy", Category: models.CategoryHealthcare, MCCCode: "5912"},
+		{Name: "Walgreens", Category: models.CategoryHealthcare, MCCCode: "5912"},
+		{Name: "Kaiser Permanente", Category: models.CategoryHealthcare, MCCCode: "8011"},
+		{Name: "LabCorp", Category: models.CategoryHealthcare, MCCCode: "8071"},
+		{Name: "Quest Diagnostics", Category: models.CategoryHealthcare, MCCCode: "8071"},
 
 		// Travel (4 merchants)
-		{"Delta Air Lines", models.CategoryTravel, "3000"},
-		{"United Airlines", models.CategoryTravel, "3000"},
-		{"Marriott Hotels", models.CategoryTravel, "7011"},
-		{"Hilton Hotels", models.CategoryTravel, "7011"},
+		{Name: "Delta Air Lines", Category: models.CategoryTravel, MCCCode: "3000"},
+		{Name: "United Airlines", Category: models.CategoryTravel, MCCCode: "3000"},
+		{Name: "Marriott Hotels", Category: models.CategoryTravel, MCCCode: "7011"},
+		{Name: "Hilton Hotels", Category: models.CategoryTravel, MCCCode: "7011"},
 
 		// Education (2 merchants)
-		{"Udemy", models.CategoryEducation, "8299"},
-		{"Coursera", models.CategoryEducation, "8299"},
+		{Name: "Udemy", Category: models.CategoryEducation, MCCCode: "8299"},
+		{Name: "Coursera", Category: models.CategoryEducation, MCCCode: "8299"},
 
 		// ATM/Cash (2 merchants)
-		{"ATM Withdrawal", models.CategoryATMCash, "6010"},
-		{"Cash Deposit", models.CategoryATMCash, "6011"},
+		{Name: "ATM Withdrawal", Category: models.CategoryATMCash, MCCCode: "6010"},
+		{Name: "Cash Deposit", Category: models.CategoryATMCash, MCCCode: "6011"},
 	}
 }
 
@@ -253,11 +253,11 @@ func (g *transactionGenerator) createSalaryTransaction(accountID uuid.UUID, date
 // GenerateBillTransactions generates monthly bill payments
 func (g *transactionGenerator) GenerateBillTransactions(accountID uuid.UUID, startDate, endDate time.Time, startingBalance decimal.Decimal) []*models.Transaction {
 	billMerchants := []models.MerchantInfo{
-		{"Electric Company", models.CategoryBillsUtilities, "4900"},
-		{"Internet Provider", models.CategoryBillsUtilities, "4899"},
-		{"Water Department", models.CategoryBillsUtilities, "4900"},
-		{"Gas Company", models.CategoryBillsUtilities, "4900"},
-		{"Phone Bill", models.CategoryBillsUtilities, "4814"},
+		{Name: "Electric Company", Category: models.CategoryBillsUtilities, MCCCode: "4900"},
+		{Name: "Internet Provider", Category: models.CategoryBillsUtilities, MCCCode: "4899"},
+		{Name: "Water Department", Category: models.CategoryBillsUtilities, MCCCode: "4900"},
+		{Name: "Gas Company", Category: models.CategoryBillsUtilities, MCCCode: "4900"},
+		{Name: "Phone Bill", Category: models.CategoryBillsUtilities, MCCCode: "4814"},
 	}
 
 	transactions := make([]*models.Transaction, 0)
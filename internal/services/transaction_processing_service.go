@@ -9,9 +9,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
 	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/telemetry"
 	"github.com/google/uuid"
 )
 
@@ -21,15 +23,25 @@ var (
 )
 
 type TransactionProcessingService struct {
-	transactionRepo repositories.TransactionRepositoryInterface
-	queueRepo       repositories.ProcessingQueueRepositoryInterface
-	accountRepo     repositories.AccountRepositoryInterface
-	auditLogger     AuditLoggerInterface
-	metrics         MetricsRecorderInterface
-	circuitBreaker  CircuitBreakerInterface
-	maxWorkers      int
-	workerSemaphore chan struct{}
-	logger          *slog.Logger
+	transactionRepo          repositories.TransactionRepositoryInterface
+	queueRepo                repositories.ProcessingQueueRepositoryInterface
+	accountRepo              repositories.AccountRepositoryInterface
+	auditLogger              AuditLoggerInterface
+	metrics                  MetricsRecorderInterface
+	circuitBreaker           CircuitBreakerInterface
+	workerID                 string
+	maxWorkers               int
+	leaseDuration            time.Duration
+	heartbeatInterval        time.Duration
+	reapInterval             time.Duration
+	highPriorityAccountTypes map[string]bool
+	workerSemaphore          chan struct{}
+	logger                   *slog.Logger
+
+	// claimMu orders claiming against Drain, so no item is claimed once draining has begun
+	claimMu  sync.Mutex
+	draining bool
+	workers  sync.WaitGroup
 }
 
 func NewTransactionProcessingService(
@@ -39,28 +51,51 @@ func NewTransactionProcessingService(
 	auditLogger AuditLoggerInterface,
 	metrics MetricsRecorderInterface,
 	circuitBreaker CircuitBreakerInterface,
-	maxWorkers int,
+	cfg config.ProcessingQueueConfig,
 ) TransactionProcessingServiceInterface {
+	highPriorityAccountTypes := make(map[string]bool, len(cfg.HighPriorityAccountTypes))
+	for _, accountType := range cfg.HighPriorityAccountTypes {
+		highPriorityAccountTypes[accountType] = true
+	}
+
 	return &TransactionProcessingService{
-		transactionRepo: transactionRepo,
-		queueRepo:       queueRepo,
-		accountRepo:     accountRepo,
-		auditLogger:     auditLogger,
-		metrics:         metrics,
-		circuitBreaker:  circuitBreaker,
-		maxWorkers:      maxWorkers,
-		workerSemaphore: make(chan struct{}, maxWorkers),
-		logger:          slog.Default(),
+		transactionRepo:          transactionRepo,
+		queueRepo:                queueRepo,
+		accountRepo:              accountRepo,
+		auditLogger:              auditLogger,
+		metrics:                  metrics,
+		circuitBreaker:           circuitBreaker,
+		workerID:                 cfg.WorkerID,
+		maxWorkers:               cfg.MaxWorkers,
+		leaseDuration:            cfg.LeaseDuration,
+		heartbeatInterval:        cfg.HeartbeatInterval,
+		reapInterval:             cfg.ReapInterval,
+		highPriorityAccountTypes: highPriorityAccountTypes,
+		workerSemaphore:          make(chan struct{}, cfg.MaxWorkers),
+		logger:                   slog.Default().With("worker_id", cfg.WorkerID),
+	}
+}
+
+// PriorityForAccountType returns the queue priority for transactions on accounts of this type.
+func (s *TransactionProcessingService) PriorityForAccountType(accountType string) int {
+	if s.highPriorityAccountTypes[accountType] {
+		return models.QueuePriorityHigh
 	}
+	return models.QueuePriorityNormal
 }
 
-func (s *TransactionProcessingService) EnqueueTransaction(transactionID uuid.UUID, operation string, priority int) error {
-	_, err := s.transactionRepo.GetByID(transactionID)
+// GetTransactionQueueItem returns the latest queue item for a transaction.
+func (s *TransactionProcessingService) GetTransactionQueueItem(ctx context.Context, transactionID uuid.UUID) (*models.ProcessingQueueItem, error) {
+	return s.queueRepo.GetLatestByTransactionID(ctx, transactionID)
+}
+
+func (s *TransactionProcessingService) EnqueueTransaction(ctx context.Context, transactionID uuid.UUID, operation string, priority int) error {
+	_, err := s.transactionRepo.GetByID(ctx, transactionID)
 	if err != nil {
 		return fmt.Errorf("transaction not found: %w", err)
 	}
 
-	if err := s.queueRepo.Enqueue(transactionID, operation, priority); err != nil {
+	if err := s.queueRepo.Enqueue(ctx, transactionID, operation, priority); err != nil {
 		return fmt.Errorf("failed to enqueue transaction: %w", err)
 	}
 
@@ -71,49 +106,169 @@ func (s *TransactionProcessingService) EnqueueTransaction(transactionID uuid.UUI
 	return nil
 }
 
+// StartProcessing claims due queue items for this worker and processes them until ctx is
+// cancelled. Only as many items are claimed as there are free workers, so claimed items do not
+// sit waiting while their lease runs down. Expired leases left by crashed instances are returned
+// to the queue every reap interval. While an operator has paused the queue no new items are
+// claimed; items already claimed run to completion and expired leases are still reaped. Items in
+// progress use ctx, so cancelling it interrupts them; call Drain first to let them finish.
 func (s *TransactionProcessingService) StartProcessing(ctx context.Context) {
-	s.logger.Info("starting transaction processing service",
+	s.logger.InfoContext(ctx, "starting transaction processing service",
 		slog.Int("max_workers", s.maxWorkers),
+		slog.Duration("lease", s.leaseDuration),
 	)
 
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
-
-	var wg sync.WaitGroup
+	reapTicker := time.NewTicker(s.reapInterval)
+	defer reapTicker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			s.logger.Info("processing service shutting down, waiting for workers to complete")
-			wg.Wait()
-			s.logger.Info("processing service stopped")
+			s.logger.InfoContext(ctx, "processing service shutting down, waiting for workers to complete")
+			s.workers.Wait()
+			s.logger.InfoContext(ctx, "processing service stopped")
 			return
 
-		case <-ticker.C:
-			items, err := s.queueRepo.FetchPending(s.maxWorkers * 2)
-			if err != nil {
-				s.logger.Error("failed to fetch pending items",
+		case <-reapTicker.C:
+			if _, err := s.ReapExpiredLeases(ctx); err != nil {
+				s.logger.ErrorContext(ctx, "failed to reap expired leases",
 					slog.String("error", err.Error()),
 				)
-				continue
 			}
 
-			for _, item := range items {
-				wg.Add(1)
-				go s.processQueueItemAsync(ctx, item, &wg)
+		case <-ticker.C:
+			// Leave items in the queue while the breaker is open; claiming them would only
+			// hold their leases until they are reaped.
+			if s.circuitBreaker.IsOpen() || s.isPaused(ctx) {
+				continue
 			}
+
+			s.claim(ctx)
 		}
 	}
 }
 
-func (s *TransactionProcessingService) processQueueItemAsync(ctx context.Context, queueItem *models.ProcessingQueueItem, wg *sync.WaitGroup) {
-	defer wg.Done()
+// claim claims as many due items as there are free workers and starts processing them, unless
+// the service is draining.
+func (s *TransactionProcessingService) claim(ctx context.Context) {
+	s.claimMu.Lock()
+	defer s.claimMu.Unlock()
+
+	if s.draining {
+		return
+	}
+
+	free := s.maxWorkers - len(s.workerSemaphore)
+	if free <= 0 {
+		return
+	}
+
+	items, err := s.queueRepo.ClaimPending(ctx, s.workerID, free, s.leaseDuration)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to claim pending items",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	for _, item := range items {
+		s.workers.Add(1)
+		go s.processQueueItemAsync(ctx, item)
+	}
+}
+
+// Drain stops this worker claiming queue items and waits for the items it has claimed to finish
+// processing, or for ctx to expire. Items left unprocessed when StartProcessing stops keep their
+// lease until it expires and they are reaped, so another instance picks them up.
+func (s *TransactionProcessingService) Drain(ctx context.Context) error {
+	s.claimMu.Lock()
+	s.draining = true
+	s.claimMu.Unlock()
+
+	s.logger.InfoContext(ctx, "processing service draining, waiting for claimed items",
+		slog.Int("in_flight", len(s.workerSemaphore)),
+	)
+	done := make(chan struct{})
+	go func() {
+		s.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		s.logger.InfoContext(ctx, "processing service drained")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("queue items still processing: %w", ctx.Err())
+	}
+}
+
+// isPaused reports whether an operator paused the queue. The flag is shared by every instance,
+// so it is read on each tick; if it cannot be read the worker does not claim.
+func (s *TransactionProcessingService) isPaused(ctx context.Context) bool {
+	control, err := s.queueRepo.GetControl(ctx)
+	if err != nil {
+		s.logger.Error("failed to read queue control",
+			slog.String("error", err.Error()),
+		)
+		return true
+	}
+	return control.Paused
+}
+
+// ReapExpiredLeases returns items whose worker stopped heartbeating to the queue, and fails the
+// items, and their transactions, that ran out of retries doing so.
+func (s *TransactionProcessingService) ReapExpiredLeases(ctx context.Context) (_ int64, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "TransactionProcessingService.ReapExpiredLeases")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	requeued, failed, err := s.queueRepo.ReapExpiredLeases(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if requeued > 0 {
+		s.logger.WarnContext(ctx, "returned expired queue leases to pending",
+			slog.Int64("count", requeued),
+		)
+		for i := int64(0); i < requeued; i++ {
+			s.metrics.IncrementCounter("queue.lease", map[string]string{"event": "reaped"})
+		}
+	}
+
+	for _, queueItem := range failed {
+		s.logger.ErrorContext(ctx, "queue item lease expired after max retries",
+			slog.String("queue_item_id", queueItem.ID.String()),
+			slog.String("transaction_id", queueItem.TransactionID.String()),
+		)
+		if err := s.failExhaustedTransaction(ctx, queueItem); err != nil {
+			s.logger.ErrorContext(ctx, "failed to fail transaction of expired queue item",
+				slog.String("transaction_id", queueItem.TransactionID.String()),
+				slog.String("error", err.Error()),
+			)
+		}
+		s.metrics.IncrementCounter("transaction.processed.failed", map[string]string{
+			"operation": queueItem.Operation,
+			"reason":    "lease_expired",
+		})
+		s.auditLogger.LogTransactionProcessingFailed(ctx, queueItem.TransactionID, queueItem.Operation, ErrMaxRetriesExceeded.Error(), queueItem.RetryCount)
+	}
+
+	return requeued + int64(len(failed)), nil
+}
+
+func (s *TransactionProcessingService) processQueueItemAsync(ctx context.Context, queueItem *models.ProcessingQueueItem) {
+	defer s.workers.Done()
 
 	s.workerSemaphore <- struct{}{}
 	defer func() { <-s.workerSemaphore }()
 
+	stopHeartbeat := s.startLeaseHeartbeat(ctx, queueItem)
+	defer stopHeartbeat()
+
 	if err := s.ProcessQueueItem(ctx, queueItem); err != nil {
-		s.logger.Error("failed to process queue item",
+		s.logger.ErrorContext(ctx, "failed to process queue item",
 			slog.String("queue_item_id", queueItem.ID.String()),
 			slog.String("transaction_id", queueItem.TransactionID.String()),
 			slog.String("error", err.Error()),
@@ -121,7 +276,56 @@ func (s *TransactionProcessingService) processQueueItemAsync(ctx context.Context
 	}
 }
 
-func (s *TransactionProcessingService) ProcessQueueItem(ctx context.Context, queueItem *models.ProcessingQueueItem) error {
+// startLeaseHeartbeat extends the item's lease every heartbeat interval until the returned stop
+// function is called. Losing the lease is logged and stops the heartbeat; the item has been
+// handed back to the queue, the queue refuses this worker's completion or failure of it, and the
+// transaction itself is guarded by its optimistic lock.
+func (s *TransactionProcessingService) startLeaseHeartbeat(ctx context.Context, queueItem *models.ProcessingQueueItem) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(s.heartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := s.queueRepo.ExtendLease(ctx, queueItem.ID, s.workerID, s.leaseDuration)
+				if err == nil {
+					continue
+				}
+				if errors.Is(err, repositories.ErrQueueLeaseLost) {
+					s.logger.WarnContext(ctx, "lost lease on queue item",
+						slog.String("queue_item_id", queueItem.ID.String()),
+					)
+					s.metrics.IncrementCounter("queue.lease", map[string]string{"event": "lost"})
+					return
+				}
+				s.logger.ErrorContext(ctx, "failed to extend queue item lease",
+					slog.String("queue_item_id", queueItem.ID.String()),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func (s *TransactionProcessingService) ProcessQueueItem(ctx context.Context, queueItem *models.ProcessingQueueItem) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "TransactionProcessingService.ProcessQueueItem")
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	startTime := time.Now()
 
 	if err := s.validateProcessingPreconditions(ctx, queueItem); err != nil {
@@ -136,6 +340,9 @@ func (s *TransactionProcessingService) ProcessQueueItem(ctx context.Context, que
 	}
 
 	if err := s.performOperation(ctx, queueItem, transaction); err != nil {
+		if reason, ok := permanentFailureReason(err); ok {
+			return s.handlePermanentFailure(ctx, queueItem, transaction, reason, err)
+		}
 		s.circuitBreaker.RecordFailure()
 		return s.handleProcessingError(ctx, queueItem, err)
 	}
@@ -159,14 +366,14 @@ func (s *TransactionProcessingService) validateProcessingPreconditions(ctx conte
 }
 
 func (s *TransactionProcessingService) fetchAndValidateTransaction(ctx context.Context, queueItem *models.ProcessingQueueItem) (*models.Transaction, error) {
-	transaction, err := s.transactionRepo.GetByID(queueItem.TransactionID)
+	transaction, err := s.transactionRepo.GetByID(ctx, queueItem.TransactionID)
 	if err != nil {
 		s.circuitBreaker.RecordFailure()
 		return nil, s.handleProcessingError(ctx, queueItem, err)
 	}
 
 	if transaction.Reference != "" {
-		if isDuplicate, err := s.checkDuplicateReference(transaction); isDuplicate {
+		if isDuplicate, err := s.checkDuplicateReference(ctx, transaction); isDuplicate {
 			s.metrics.IncrementCounter("transaction.duplicate.rejected", map[string]string{
 				"reference": transaction.Reference,
 			})
@@ -180,8 +387,8 @@ func (s *TransactionProcessingService) fetchAndValidateTransaction(ctx context.C
 	return transaction, nil
 }
 
-func (s *TransactionProcessingService) checkDuplicateReference(transaction *models.Transaction) (bool, error) {
-	existingTxn, err := s.transactionRepo.GetByReference(transaction.Reference)
+func (s *TransactionProcessingService) checkDuplicateReference(ctx context.Context, transaction *models.Transaction) (bool, error) {
+	existingTxn, err := s.transactionRepo.GetByReference(ctx, transaction.Reference)
 	if err != nil {
 		return false, err
 	}
@@ -200,7 +407,7 @@ func (s *TransactionProcessingService) performOperation(ctx context.Context, que
 }
 
 func (s *TransactionProcessingService) completeProcessing(ctx context.Context, queueItem *models.ProcessingQueueItem, startTime time.Time) error {
-	if err := s.queueRepo.MarkCompleted(queueItem.ID); err != nil {
+	if err := s.queueRepo.MarkCompleted(ctx, queueItem.ID, s.workerID); err != nil {
 		return err
 	}
 
@@ -218,27 +425,42 @@ func (s *TransactionProcessingService) completeProcessing(ctx context.Context, q
 	return nil
 }
 
+// processTransaction applies a queued transaction to its account and completes it. The balance,
+// the completion, the transaction.posted event and the transaction.<type> audit log are written in
+// one database transaction, so a queued transaction leaves the same record as one posted
+// synchronously.
 func (s *TransactionProcessingService) processTransaction(ctx context.Context, transaction *models.Transaction) error {
 	if !transaction.IsPending() {
 		return fmt.Errorf("transaction is not in pending status: %s", transaction.Status)
 	}
 
+	account, err := s.accountRepo.GetByID(ctx, transaction.AccountID)
+	if err != nil {
+		return err
+	}
+
 	oldStatus := transaction.Status
 	expectedVersion := transaction.Version
 
-	transaction.Complete()
-
-	if err := s.updateAccountBalance(ctx, transaction); err != nil {
-		return fmt.Errorf("failed to update account balance: %w", err)
-	}
-
-	if err := s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion); err != nil {
+	auditLog := &models.AuditLog{
+		UserID:     &account.UserID,
+		Action:     fmt.Sprintf("transaction.%s", transaction.TransactionType),
+		Resource:   "transaction",
+		ResourceID: transaction.ID.String(),
+		Metadata: models.JSONBMap{
+			"account_number": account.AccountNumber,
+			"amount":         transaction.Amount.String(),
+			"type":           transaction.TransactionType,
+		},
+	}
+	if err := s.transactionRepo.CompletePending(ctx, transaction, expectedVersion, auditLog); err != nil {
 		if errors.Is(err, models.ErrOptimisticLockConflict) {
 			s.auditLogger.LogOptimisticLockConflict(ctx, "transaction", transaction.ID, expectedVersion, transaction.Version)
 		}
 		return err
 	}
 
+	s.auditLogger.LogBalanceUpdate(ctx, account.ID, transaction.BalanceBefore.String(), transaction.BalanceAfter.String(), transaction.ID)
 	s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, transaction.Status)
 
 	return nil
@@ -258,7 +480,7 @@ func (s *TransactionProcessingService) reverseTransaction(ctx context.Context, t
 		return fmt.Errorf("failed to reverse account balance: %w", err)
 	}
 
-	if err := s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion); err != nil {
+	if err := s.transactionRepo.UpdateWithOptimisticLock(ctx, transaction, expectedVersion); err != nil {
 		return err
 	}
 
@@ -267,26 +489,8 @@ func (s *TransactionProcessingService) reverseTransaction(ctx context.Context, t
 	return nil
 }
 
-func (s *TransactionProcessingService) updateAccountBalance(ctx context.Context, transaction *models.Transaction) error {
-	account, err := s.accountRepo.GetByID(transaction.AccountID)
-	if err != nil {
-		return err
-	}
-
-	oldBalance := account.Balance.String()
-	newBalance := transaction.BalanceAfter
-
-	if err := s.accountRepo.UpdateBalance(account.ID, newBalance, transaction.TransactionType); err != nil {
-		return err
-	}
-
-	s.auditLogger.LogBalanceUpdate(ctx, account.ID, oldBalance, newBalance.String(), transaction.ID)
-
-	return nil
-}
-
 func (s *TransactionProcessingService) reverseAccountBalance(ctx context.Context, transaction *models.Transaction) error {
-	account, err := s.accountRepo.GetByID(transaction.AccountID)
+	account, err := s.accountRepo.GetByID(ctx, transaction.AccountID)
 	if err != nil {
 		return err
 	}
@@ -294,7 +498,7 @@ func (s *TransactionProcessingService) reverseAccountBalance(ctx context.Context
 	oldBalance := account.Balance.String()
 	newBalance := transaction.BalanceBefore
 
-	if err := s.accountRepo.UpdateBalance(account.ID, newBalance, transaction.TransactionType); err != nil {
+	if err := s.accountRepo.UpdateBalance(ctx, account.ID, newBalance, transaction.TransactionType); err != nil {
 		return err
 	}
 
@@ -309,7 +513,7 @@ func (s *TransactionProcessingService) handleProcessingError(ctx context.Context
 
 		s.auditLogger.LogRetryAttempt(ctx, queueItem.ID, queueItem.TransactionID, queueItem.RetryCount+1, queueItem.MaxRetries, backoffMs)
 
-		if retryErr := s.queueRepo.IncrementRetry(queueItem.ID); retryErr != nil {
+		if retryErr := s.queueRepo.IncrementRetry(ctx, queueItem.ID, s.workerID); retryErr != nil {
 			return fmt.Errorf("failed to increment retry: %w", retryErr)
 		}
 
@@ -323,17 +527,47 @@ func (s *TransactionProcessingService) handleProcessingError(ctx context.Context
 	return s.handleMaxRetriesExceeded(ctx, queueItem)
 }
 
+// permanentFailureReason reports whether err will recur on every retry, such as a debit the
+// account cannot cover, and the failure reason to record on the transaction.
+func permanentFailureReason(err error) (string, bool) {
+	switch {
+	case errors.Is(err, repositories.ErrInsufficientFunds):
+		return models.TransactionFailureInsufficientFunds, true
+	case errors.Is(err, repositories.ErrAccountNotActive):
+		return models.TransactionFailureAccountNotActive, true
+	default:
+		return "", false
+	}
+}
+
+// handlePermanentFailure fails the transaction and its queue item straight away. The database is
+// healthy, so the circuit breaker is not charged.
+func (s *TransactionProcessingService) handlePermanentFailure(ctx context.Context, queueItem *models.ProcessingQueueItem, transaction *models.Transaction, reason string, cause error) error {
+	oldStatus := transaction.Status
+	if err := s.transactionRepo.FailPending(ctx, transaction, transaction.Version, reason); err != nil {
+		return s.handleProcessingError(ctx, queueItem, err)
+	}
+
+	if err := s.queueRepo.MarkFailed(ctx, queueItem.ID, s.workerID, cause.Error()); err != nil {
+		return err
+	}
+
+	s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, transaction.Status)
+	s.metrics.IncrementCounter("transaction.processed.failed", map[string]string{
+		"operation": queueItem.Operation,
+		"reason":    reason,
+	})
+	s.auditLogger.LogTransactionProcessingFailed(ctx, queueItem.TransactionID, queueItem.Operation, cause.Error(), queueItem.RetryCount)
+
+	return cause
+}
+
 func (s *TransactionProcessingService) handleMaxRetriesExceeded(ctx context.Context, queueItem *models.ProcessingQueueItem) error {
-	transaction, err := s.transactionRepo.GetByID(queueItem.TransactionID)
-	if err == nil {
-		oldStatus := transaction.Status
-		transaction.Fail()
-		expectedVersion := transaction.Version - 1
-		_ = s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion)
-		s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, models.TransactionStatusFailed)
+	if err := s.failExhaustedTransaction(ctx, queueItem); err != nil {
+		return err
 	}
 
-	if err := s.queueRepo.MarkFailed(queueItem.ID, "max retries exceeded"); err != nil {
+	if err := s.queueRepo.MarkFailed(ctx, queueItem.ID, s.workerID, "max retries exceeded"); err != nil {
 		return err
 	}
 
@@ -347,57 +581,90 @@ func (s *TransactionProcessingService) handleMaxRetriesExceeded(ctx context.Cont
 	return ErrMaxRetriesExceeded
 }
 
+// failExhaustedTransaction fails the transaction of a queue item that ran out of retries. A
+// transaction that is no longer pending, or that another worker settled first, is left as it is.
+func (s *TransactionProcessingService) failExhaustedTransaction(ctx context.Context, queueItem *models.ProcessingQueueItem) error {
+	transaction, err := s.transactionRepo.GetByID(ctx, queueItem.TransactionID)
+	if err != nil {
+		return fmt.Errorf("failed to load transaction: %w", err)
+	}
+	if !transaction.IsPending() {
+		return nil
+	}
+
+	oldStatus := transaction.Status
+	if err := s.failPendingTransaction(ctx, transaction, models.TransactionFailureProcessingFailed); err != nil {
+		return err
+	}
+	if transaction.Status == oldStatus {
+		return nil
+	}
+
+	s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, transaction.Status)
+	return nil
+}
+
 func (s *TransactionProcessingService) handleDuplicateReference(ctx context.Context, queueItem *models.ProcessingQueueItem, transaction *models.Transaction) error {
-	transaction.Fail()
-	expectedVersion := transaction.Version
-	_ = s.transactionRepo.UpdateWithOptimisticLock(transaction, expectedVersion)
+	oldStatus := transaction.Status
+	if err := s.failPendingTransaction(ctx, transaction, models.TransactionFailureDuplicateReference); err != nil {
+		return s.handleProcessingError(ctx, queueItem, err)
+	}
 
-	if err := s.queueRepo.MarkFailed(queueItem.ID, "duplicate transaction reference"); err != nil {
+	if err := s.queueRepo.MarkFailed(ctx, queueItem.ID, s.workerID, "duplicate transaction reference"); err != nil {
 		return err
 	}
 
-	s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, models.TransactionStatusPending, models.TransactionStatusFailed)
+	if transaction.Status != oldStatus {
+		s.auditLogger.LogTransactionStateChange(ctx, transaction.ID, oldStatus, transaction.Status)
+	}
 
 	return ErrDuplicateReference
 }
 
-func (s *TransactionProcessingService) GetQueueMetrics() (*dto.QueueMetrics, error) {
-	pendingCount, err := s.queueRepo.GetPendingCount()
-	if err != nil {
-		return nil, err
-	}
-
-	processingCount, err := s.queueRepo.GetProcessingCount()
-	if err != nil {
-		return nil, err
+// failPendingTransaction fails a pending transaction. Losing the race to a worker that settled it
+// first is not an error: the transaction is left unchanged and still reports its old status.
+func (s *TransactionProcessingService) failPendingTransaction(ctx context.Context, transaction *models.Transaction, reason string) error {
+	err := s.transactionRepo.FailPending(ctx, transaction, transaction.Version, reason)
+	if errors.Is(err, models.ErrOptimisticLockConflict) {
+		s.logger.WarnContext(ctx, "transaction settled before it could be failed",
+			slog.String("transaction_id", transaction.ID.String()),
+			slog.String("reason", reason),
+		)
+		return nil
 	}
+	return err
+}
 
-	completedCount, err := s.queueRepo.GetCompletedCount()
+func (s *TransactionProcessingService) GetQueueMetrics(ctx context.Context) (*dto.QueueMetrics, error) {
+	counts, err := s.queueRepo.CountByStatus(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	failedCount, err := s.queueRepo.GetFailedCount()
+	avgProcessingMs, err := s.queueRepo.GetAverageProcessingTime(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	avgProcessingMs, err := s.queueRepo.GetAverageProcessingTime()
+	oldestPending, err := s.queueRepo.GetOldestPendingAge(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	oldestPending, err := s.queueRepo.GetOldestPendingAge()
+	control, err := s.queueRepo.GetControl(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &dto.QueueMetrics{
-		PendingCount:    pendingCount,
-		ProcessingCount: processingCount,
-		CompletedCount:  completedCount,
-		FailedCount:     failedCount,
-		AvgProcessingMs: avgProcessingMs,
-		OldestPending:   oldestPending,
+		PendingCount:      counts[models.QueueStatusPending],
+		ProcessingCount:   counts[models.QueueStatusProcessing],
+		CompletedCount:    counts[models.QueueStatusCompleted],
+		FailedCount:       counts[models.QueueStatusFailed],
+		CancelledCount:    counts[models.QueueStatusCancelled],
+		DeadLetteredCount: counts[models.QueueStatusDeadLettered],
+		AvgProcessingMs:   avgProcessingMs,
+		OldestPending:     oldestPending,
+		Paused:            control.Paused,
 	}, nil
 }
@@ -2,10 +2,13 @@ package services_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
 	"github.com/array/banking-api/internal/repositories/repository_mocks"
 	"github.com/array/banking-api/internal/services"
 	"github.com/array/banking-api/internal/services/service_mocks"
@@ -16,6 +19,8 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
+const testWorkerID = "test-worker"
+
 type TransactionProcessingServiceTestSuite struct {
 	suite.Suite
 	ctx               context.Context
@@ -33,6 +38,14 @@ func TestTransactionProcessingServiceSuite(t *testing.T) {
 	suite.Run(t, new(TransactionProcessingServiceTestSuite))
 }
 
+// failPending applies FailPending to the transaction the way the repository does once the update
+// succeeds
+func failPending(_ context.Context, transaction *models.Transaction, expectedVersion int, reason string) error {
+	transaction.FailWithReason(reason)
+	transaction.Version = expectedVersion + 1
+	return nil
+}
+
 func (s *TransactionProcessingServiceTestSuite) SetupTest() {
 	s.ctx = context.Background()
 	s.ctrl = gomock.NewController(s.T())
@@ -51,7 +64,13 @@ func (s *TransactionProcessingServiceTestSuite) SetupTest() {
 		s.auditLogger,
 		s.metrics,
 		s.circuitBreaker,
-		10,
+		config.ProcessingQueueConfig{
+			WorkerID:          testWorkerID,
+			MaxWorkers:        10,
+			LeaseDuration:     30 * time.Second,
+			HeartbeatInterval: 10 * time.Second,
+			ReapInterval:      time.Minute,
+		},
 	)
 }
 
@@ -76,11 +95,11 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	}
 
 	// Mock expectations
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.queueRepo.EXPECT().Enqueue(transactionID, models.QueueOperationProcess, models.QueuePriorityNormal).Return(nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.queueRepo.EXPECT().Enqueue(gomock.Any(), transactionID, models.QueueOperationProcess, models.QueuePriorityNormal).Return(nil).Times(1)
 	s.metrics.EXPECT().IncrementCounter("queue.enqueued", map[string]string{"operation": models.QueueOperationProcess}).Times(1)
 
-	err := s.processingService.EnqueueTransaction(transactionID, models.QueueOperationProcess, models.QueuePriorityNormal)
+	err := s.processingService.EnqueueTransaction(context.Background(), transactionID, models.QueueOperationProcess, models.QueuePriorityNormal)
 
 	s.NoError(err)
 }
@@ -102,11 +121,11 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	}
 
 	// Mock expectations
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.queueRepo.EXPECT().Enqueue(transactionID, models.QueueOperationProcess, models.QueuePriorityHigh).Return(nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.queueRepo.EXPECT().Enqueue(gomock.Any(), transactionID, models.QueueOperationProcess, models.QueuePriorityHigh).Return(nil).Times(1)
 	s.metrics.EXPECT().IncrementCounter("queue.enqueued", map[string]string{"operation": models.QueueOperationProcess}).Times(1)
 
-	err := s.processingService.EnqueueTransaction(transactionID, models.QueueOperationProcess, models.QueuePriorityHigh)
+	err := s.processingService.EnqueueTransaction(context.Background(), transactionID, models.QueueOperationProcess, models.QueuePriorityHigh)
 
 	s.NoError(err)
 }
@@ -128,12 +147,13 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 		}
 	}
 
-	// First call returns all 20 items, subsequent calls return empty to allow test to complete
-	s.queueRepo.EXPECT().FetchPending(20).Return(queueItems, nil).Times(1)
-	s.queueRepo.EXPECT().FetchPending(20).Return([]*models.ProcessingQueueItem{}, nil).AnyTimes()
+	// First claim fills every free worker, later claims return empty to allow test to complete
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), testWorkerID, 10, 30*time.Second).Return(queueItems, nil).Times(1)
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return([]*models.ProcessingQueueItem{}, nil).AnyTimes()
 
 	// Circuit breaker checks - will be called for each item
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).AnyTimes()
+	s.expectQueueRunning()
 	s.circuitBreaker.EXPECT().RecordSuccess().Times(20)
 
 	// Audit logger expectations - will be called for each item
@@ -165,11 +185,10 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 			Balance: decimal.NewFromFloat(1000.0),
 		}
 
-		s.transactionRepo.EXPECT().GetByID(item.TransactionID).Return(transaction, nil)
-		s.accountRepo.EXPECT().GetByID(accountID).Return(account, nil)
-		s.accountRepo.EXPECT().UpdateBalance(accountID, gomock.Any(), gomock.Any()).Return(nil)
-		s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 1).Return(nil)
-		s.queueRepo.EXPECT().MarkCompleted(item.ID).Return(nil)
+		s.transactionRepo.EXPECT().GetByID(gomock.Any(), item.TransactionID).Return(transaction, nil)
+		s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil)
+		s.expectCompletePending(transaction, account.Balance)
+		s.queueRepo.EXPECT().MarkCompleted(gomock.Any(), item.ID, testWorkerID).Return(nil)
 	}
 
 	// Start async processing
@@ -219,15 +238,13 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	// Mock expectations
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
 	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), transactionID, models.QueueOperationProcess).Times(1)
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.accountRepo.EXPECT().GetByID(accountID).Return(account, nil).Times(1)
-	s.auditLogger.EXPECT().LogBalanceUpdate(gomock.Any(), accountID, gomock.Any(), gomock.Any(), transactionID).Times(1)
-	s.accountRepo.EXPECT().UpdateBalance(accountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 1).Return(models.ErrOptimisticLockConflict).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+	s.transactionRepo.EXPECT().CompletePending(gomock.Any(), transaction, 1, gomock.Any()).Return(models.ErrOptimisticLockConflict).Times(1)
 	s.auditLogger.EXPECT().LogOptimisticLockConflict(gomock.Any(), "transaction", transactionID, 1, 1).Times(1)
 	s.circuitBreaker.EXPECT().RecordFailure().Times(1)
 	s.auditLogger.EXPECT().LogRetryAttempt(gomock.Any(), queueItem.ID, transactionID, 1, 3, int64(1000)).Times(1)
-	s.queueRepo.EXPECT().IncrementRetry(queueItem.ID).Return(nil).Times(1)
+	s.queueRepo.EXPECT().IncrementRetry(gomock.Any(), queueItem.ID, testWorkerID).Return(nil).Times(1)
 	s.metrics.EXPECT().IncrementCounter("transaction.processing.retry", map[string]string{"operation": models.QueueOperationProcess}).Times(1)
 
 	err := s.processingService.ProcessQueueItem(s.ctx, queueItem)
@@ -261,10 +278,10 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 
 	// Mock expectations
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 0).Return(nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), transaction, 1, models.TransactionFailureProcessingFailed).DoAndReturn(failPending).Times(1)
 	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transactionID, models.TransactionStatusPending, models.TransactionStatusFailed).Times(1)
-	s.queueRepo.EXPECT().MarkFailed(queueItem.ID, "max retries exceeded").Return(nil).Times(1)
+	s.queueRepo.EXPECT().MarkFailed(gomock.Any(), queueItem.ID, testWorkerID, "max retries exceeded").Return(nil).Times(1)
 	s.metrics.EXPECT().IncrementCounter("transaction.processed.failed", map[string]string{"operation": models.QueueOperationProcess, "reason": "max_retries"}).Times(1)
 	s.auditLogger.EXPECT().LogTransactionProcessingFailed(gomock.Any(), transactionID, models.QueueOperationProcess, "max retries exceeded", 3).Times(1)
 
@@ -272,6 +289,114 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 
 	s.Error(err)
 	s.Contains(err.Error(), "max retries exceeded")
+	s.Equal(models.TransactionStatusFailed, transaction.Status)
+	s.Equal(models.TransactionFailureProcessingFailed, transaction.FailureReason())
+}
+
+// Test: Retry Mechanism - Max Retries Exceeded - Settled Transaction Left Alone
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ProcessTransaction_MaxRetriesExceeded_LeavesSettledTransaction() {
+	transactionID := uuid.New()
+	queueItem := &models.ProcessingQueueItem{
+		ID:            uuid.New(),
+		TransactionID: transactionID,
+		Operation:     models.QueueOperationProcess,
+		Status:        models.QueueStatusProcessing,
+		RetryCount:    3,
+		MaxRetries:    3,
+	}
+
+	transaction := &models.Transaction{
+		ID:              transactionID,
+		AccountID:       uuid.New(),
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromFloat(100.0),
+		Status:          models.TransactionStatusCompleted,
+		Version:         2,
+	}
+
+	// Mock expectations - no failure is written or audited for the completed transaction
+	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.queueRepo.EXPECT().MarkFailed(gomock.Any(), queueItem.ID, testWorkerID, "max retries exceeded").Return(nil).Times(1)
+	s.metrics.EXPECT().IncrementCounter("transaction.processed.failed", map[string]string{"operation": models.QueueOperationProcess, "reason": "max_retries"}).Times(1)
+	s.auditLogger.EXPECT().LogTransactionProcessingFailed(gomock.Any(), transactionID, models.QueueOperationProcess, "max retries exceeded", 3).Times(1)
+
+	err := s.processingService.ProcessQueueItem(s.ctx, queueItem)
+
+	s.ErrorIs(err, services.ErrMaxRetriesExceeded)
+	s.Equal(models.TransactionStatusCompleted, transaction.Status)
+}
+
+// Test: Retry Mechanism - Max Retries Exceeded - Lost Race Not Audited
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ProcessTransaction_MaxRetriesExceeded_ConflictNotAudited() {
+	transactionID := uuid.New()
+	queueItem := &models.ProcessingQueueItem{
+		ID:            uuid.New(),
+		TransactionID: transactionID,
+		Operation:     models.QueueOperationProcess,
+		Status:        models.QueueStatusProcessing,
+		RetryCount:    3,
+		MaxRetries:    3,
+	}
+
+	transaction := &models.Transaction{
+		ID:              transactionID,
+		AccountID:       uuid.New(),
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromFloat(100.0),
+		Status:          models.TransactionStatusPending,
+		Version:         1,
+	}
+
+	// Mock expectations - another worker completed the transaction after it was read
+	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), transaction, 1, models.TransactionFailureProcessingFailed).Return(models.ErrOptimisticLockConflict).Times(1)
+	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.queueRepo.EXPECT().MarkFailed(gomock.Any(), queueItem.ID, testWorkerID, "max retries exceeded").Return(nil).Times(1)
+	s.metrics.EXPECT().IncrementCounter("transaction.processed.failed", map[string]string{"operation": models.QueueOperationProcess, "reason": "max_retries"}).Times(1)
+	s.auditLogger.EXPECT().LogTransactionProcessingFailed(gomock.Any(), transactionID, models.QueueOperationProcess, "max retries exceeded", 3).Times(1)
+
+	err := s.processingService.ProcessQueueItem(s.ctx, queueItem)
+
+	s.ErrorIs(err, services.ErrMaxRetriesExceeded)
+	s.Equal(models.TransactionStatusPending, transaction.Status)
+}
+
+// Test: Retry Mechanism - Max Retries Exceeded - Write Failure Keeps Item
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ProcessTransaction_MaxRetriesExceeded_FailWriteErrorKeepsItem() {
+	transactionID := uuid.New()
+	queueItem := &models.ProcessingQueueItem{
+		ID:            uuid.New(),
+		TransactionID: transactionID,
+		Operation:     models.QueueOperationProcess,
+		Status:        models.QueueStatusProcessing,
+		RetryCount:    3,
+		MaxRetries:    3,
+	}
+
+	transaction := &models.Transaction{
+		ID:              transactionID,
+		AccountID:       uuid.New(),
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromFloat(100.0),
+		Status:          models.TransactionStatusPending,
+		Version:         1,
+	}
+	dbErr := errors.New("connection reset")
+
+	// Mock expectations - the queue item is not failed while its transaction is still pending
+	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), transaction, 1, models.TransactionFailureProcessingFailed).Return(dbErr).Times(1)
+	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.queueRepo.EXPECT().MarkFailed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.processingService.ProcessQueueItem(s.ctx, queueItem)
+
+	s.ErrorIs(err, dbErr)
 }
 
 // Test: Circuit Breaker - Database Connection Failures - Opens Circuit
@@ -333,13 +458,12 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	// Mock expectations
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
 	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), transactionID, models.QueueOperationProcess).Times(1)
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.accountRepo.EXPECT().GetByID(accountID).Return(account, nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil).Times(1)
 	s.auditLogger.EXPECT().LogBalanceUpdate(gomock.Any(), accountID, gomock.Any(), gomock.Any(), transactionID).Times(1)
-	s.accountRepo.EXPECT().UpdateBalance(accountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 1).Return(nil).Times(1)
+	s.expectCompletePending(transaction, account.Balance).Times(1)
 	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transactionID, models.TransactionStatusPending, models.TransactionStatusCompleted).Times(1)
-	s.queueRepo.EXPECT().MarkCompleted(queueItem.ID).Return(nil).Times(1)
+	s.queueRepo.EXPECT().MarkCompleted(gomock.Any(), queueItem.ID, testWorkerID).Return(nil).Times(1)
 	s.circuitBreaker.EXPECT().RecordSuccess().Times(1)
 	s.auditLogger.EXPECT().LogQueueItemProcessed(gomock.Any(), queueItem.ID, transactionID, models.QueueOperationProcess, 0).Times(1)
 	s.metrics.EXPECT().RecordProcessingTime("transaction.processing", gomock.Any()).Times(1)
@@ -386,13 +510,12 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	// Mock expectations
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
 	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), transactionID, models.QueueOperationProcess).Times(1)
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.accountRepo.EXPECT().GetByID(accountID).Return(account, nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil).Times(1)
 	s.auditLogger.EXPECT().LogBalanceUpdate(gomock.Any(), accountID, gomock.Any(), gomock.Any(), transactionID).Times(1)
-	s.accountRepo.EXPECT().UpdateBalance(accountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 1).Return(nil).Times(1)
+	s.expectCompletePending(transaction, account.Balance).Times(1)
 	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transactionID, models.TransactionStatusPending, models.TransactionStatusCompleted).Times(1)
-	s.queueRepo.EXPECT().MarkCompleted(queueItem.ID).Return(nil).Times(1)
+	s.queueRepo.EXPECT().MarkCompleted(gomock.Any(), queueItem.ID, testWorkerID).Return(nil).Times(1)
 	s.circuitBreaker.EXPECT().RecordSuccess().Times(1)
 	s.auditLogger.EXPECT().LogQueueItemProcessed(gomock.Any(), queueItem.ID, transactionID, models.QueueOperationProcess, 0).Times(1)
 	s.metrics.EXPECT().RecordProcessingTime("transaction.processing", gomock.Any()).Times(1)
@@ -439,13 +562,12 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	// Mock expectations
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
 	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), transactionID, models.QueueOperationProcess).Times(1)
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.accountRepo.EXPECT().GetByID(accountID).Return(account, nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil).Times(1)
 	s.auditLogger.EXPECT().LogBalanceUpdate(gomock.Any(), accountID, gomock.Any(), gomock.Any(), transactionID).Times(1)
-	s.accountRepo.EXPECT().UpdateBalance(accountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 1).Return(nil).Times(1)
+	s.expectCompletePending(transaction, account.Balance).Times(1)
 	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transactionID, models.TransactionStatusPending, models.TransactionStatusCompleted).Times(1)
-	s.queueRepo.EXPECT().MarkCompleted(queueItem.ID).Return(nil).Times(1)
+	s.queueRepo.EXPECT().MarkCompleted(gomock.Any(), queueItem.ID, testWorkerID).Return(nil).Times(1)
 	s.circuitBreaker.EXPECT().RecordSuccess().Times(1)
 	s.auditLogger.EXPECT().LogQueueItemProcessed(gomock.Any(), queueItem.ID, transactionID, models.QueueOperationProcess, 0).Times(1)
 	s.metrics.EXPECT().RecordProcessingTime("transaction.processing", gomock.Any()).Times(1)
@@ -459,19 +581,26 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 
 // Test: Monitoring Metrics - Queue Depth - Tracks Correctly
 func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_GetQueueMetrics_QueueDepth_TracksCorrectly() {
-	s.queueRepo.EXPECT().GetPendingCount().Return(int64(25), nil)
-	s.queueRepo.EXPECT().GetProcessingCount().Return(int64(8), nil)
-	s.queueRepo.EXPECT().GetCompletedCount().Return(int64(100), nil)
-	s.queueRepo.EXPECT().GetFailedCount().Return(int64(2), nil)
-	s.queueRepo.EXPECT().GetAverageProcessingTime().Return(float64(150.5), nil)
-	s.queueRepo.EXPECT().GetOldestPendingAge().Return(nil, nil)
-
-	metrics, err := s.processingService.GetQueueMetrics()
+	s.queueRepo.EXPECT().CountByStatus(gomock.Any()).Return(map[string]int64{
+		models.QueueStatusPending:      25,
+		models.QueueStatusProcessing:   8,
+		models.QueueStatusCompleted:    100,
+		models.QueueStatusFailed:       2,
+		models.QueueStatusDeadLettered: 1,
+	}, nil)
+	s.queueRepo.EXPECT().GetAverageProcessingTime(gomock.Any()).Return(float64(150.5), nil)
+	s.queueRepo.EXPECT().GetOldestPendingAge(gomock.Any()).Return(nil, nil)
+	s.queueRepo.EXPECT().GetControl(gomock.Any()).Return(&models.ProcessingQueueControl{Paused: true}, nil)
+
+	metrics, err := s.processingService.GetQueueMetrics(context.Background())
 
 	s.NoError(err)
 	s.Equal(int64(25), metrics.PendingCount)
 	s.Equal(int64(8), metrics.ProcessingCount)
 	s.Equal(int64(2), metrics.FailedCount)
+	s.Equal(int64(1), metrics.DeadLetteredCount)
+	s.Zero(metrics.CancelledCount)
+	s.True(metrics.Paused)
 }
 
 // Test: Idempotency - Duplicate Transaction Reference - Rejects Duplicate
@@ -516,25 +645,29 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	// Mock expectations
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
 	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), transactionID, models.QueueOperationProcess).Times(1)
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.transactionRepo.EXPECT().GetByReference(reference).Return(existingTransaction, nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.transactionRepo.EXPECT().GetByReference(gomock.Any(), reference).Return(existingTransaction, nil).Times(1)
 	s.metrics.EXPECT().IncrementCounter("transaction.duplicate.rejected", map[string]string{"reference": reference}).Times(1)
-	s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 1).Return(nil).Times(1)
-	s.queueRepo.EXPECT().MarkFailed(queueItem.ID, "duplicate transaction reference").Return(nil).Times(1)
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), transaction, 1, models.TransactionFailureDuplicateReference).DoAndReturn(failPending).Times(1)
+	s.queueRepo.EXPECT().MarkFailed(gomock.Any(), queueItem.ID, testWorkerID, "duplicate transaction reference").Return(nil).Times(1)
 	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transactionID, models.TransactionStatusPending, models.TransactionStatusFailed).Times(1)
 
 	err := s.processingService.ProcessQueueItem(s.ctx, queueItem)
 
 	s.Error(err)
 	s.Contains(err.Error(), "duplicate")
+	s.Equal(models.TransactionStatusFailed, transaction.Status)
+	s.Equal(models.TransactionFailureDuplicateReference, transaction.FailureReason())
 }
 
 // Test: Context Cancellation - Processing In Progress - Graceful Shutdown
 func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_StartProcessing_ContextCancelled_GracefulShutdown() {
 	ctx, cancel := context.WithCancel(s.ctx)
 
-	// Setup empty queue for simplicity - FetchPending may be called multiple times
-	s.queueRepo.EXPECT().FetchPending(gomock.Any()).Return([]*models.ProcessingQueueItem{}, nil).AnyTimes()
+	// Setup empty queue for simplicity - ClaimPending may be called multiple times
+	s.circuitBreaker.EXPECT().IsOpen().Return(false).AnyTimes()
+	s.expectQueueRunning()
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.ProcessingQueueItem{}, nil).AnyTimes()
 
 	// Start processing
 	done := make(chan struct{})
@@ -594,13 +727,12 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 	// Mock expectations
 	s.circuitBreaker.EXPECT().IsOpen().Return(false).Times(1)
 	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), transactionID, models.QueueOperationProcess).Times(1)
-	s.transactionRepo.EXPECT().GetByID(transactionID).Return(transaction, nil).Times(1)
-	s.accountRepo.EXPECT().GetByID(accountID).Return(account, nil).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transactionID).Return(transaction, nil).Times(1)
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(account, nil).Times(1)
 	s.auditLogger.EXPECT().LogBalanceUpdate(gomock.Any(), accountID, gomock.Any(), gomock.Any(), transactionID).Times(1)
-	s.accountRepo.EXPECT().UpdateBalance(accountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	s.transactionRepo.EXPECT().UpdateWithOptimisticLock(transaction, 1).Return(nil).Times(1)
+	s.expectCompletePending(transaction, account.Balance).Times(1)
 	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transactionID, models.TransactionStatusPending, models.TransactionStatusCompleted).Times(1)
-	s.queueRepo.EXPECT().MarkCompleted(queueItem.ID).Return(nil).Times(1)
+	s.queueRepo.EXPECT().MarkCompleted(gomock.Any(), queueItem.ID, testWorkerID).Return(nil).Times(1)
 	s.circuitBreaker.EXPECT().RecordSuccess().Times(1)
 	s.auditLogger.EXPECT().LogQueueItemProcessed(gomock.Any(), queueItem.ID, transactionID, models.QueueOperationProcess, 0).Times(1)
 	s.metrics.EXPECT().RecordProcessingTime("transaction.processing", gomock.Any()).Times(1)
@@ -611,3 +743,401 @@ func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService
 
 	s.NoError(err)
 }
+
+// expectCompletePending expects the transaction to be completed at its current version, and
+// completes it against balance as the repository would.
+func (s *TransactionProcessingServiceTestSuite) expectCompletePending(transaction *models.Transaction, balance decimal.Decimal) *gomock.Call {
+	return s.transactionRepo.EXPECT().CompletePending(gomock.Any(), transaction, transaction.Version, gomock.Any()).DoAndReturn(
+		func(_ context.Context, transaction *models.Transaction, expectedVersion int, _ *models.AuditLog) error {
+			transaction.BalanceBefore = balance
+			if transaction.TransactionType == models.TransactionTypeCredit {
+				transaction.BalanceAfter = balance.Add(transaction.Amount)
+			} else {
+				transaction.BalanceAfter = balance.Sub(transaction.Amount)
+			}
+			transaction.Complete()
+			transaction.Version = expectedVersion + 1
+			return nil
+		})
+}
+
+// submittedTransaction is a transaction as SubmitTransaction queues it: pending, with no balances.
+func (s *TransactionProcessingServiceTestSuite) submittedTransaction(accountID uuid.UUID, amount decimal.Decimal) (*models.ProcessingQueueItem, *models.Transaction) {
+	transaction := &models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       accountID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          amount,
+		Description:     "Queued withdrawal",
+		Status:          models.TransactionStatusPending,
+		Version:         1,
+	}
+	queueItem := &models.ProcessingQueueItem{
+		ID:            uuid.New(),
+		TransactionID: transaction.ID,
+		Operation:     models.QueueOperationProcess,
+		Status:        models.QueueStatusProcessing,
+		MaxRetries:    3,
+	}
+
+	s.circuitBreaker.EXPECT().IsOpen().Return(false)
+	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), transaction.ID, models.QueueOperationProcess)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transaction.ID).Return(transaction, nil)
+
+	return queueItem, transaction
+}
+
+// Test: Process Transaction - Queued Debit - Applies The Amount Like The Synchronous Path
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ProcessTransaction_QueuedDebit_RecordsBalances() {
+	accountID := uuid.New()
+	queueItem, transaction := s.submittedTransaction(accountID, decimal.NewFromInt(100))
+	transaction.Reference = ""
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&models.Account{ID: accountID, Balance: decimal.NewFromInt(1000)}, nil)
+	s.expectCompletePending(transaction, decimal.NewFromInt(1000))
+	s.auditLogger.EXPECT().LogBalanceUpdate(gomock.Any(), accountID, "1000", "900", transaction.ID)
+	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transaction.ID, models.TransactionStatusPending, models.TransactionStatusCompleted)
+	s.queueRepo.EXPECT().MarkCompleted(gomock.Any(), queueItem.ID, testWorkerID).Return(nil)
+	s.circuitBreaker.EXPECT().RecordSuccess()
+	s.auditLogger.EXPECT().LogQueueItemProcessed(gomock.Any(), queueItem.ID, transaction.ID, models.QueueOperationProcess, 0)
+	s.metrics.EXPECT().RecordProcessingTime("transaction.processing", gomock.Any())
+	s.metrics.EXPECT().IncrementCounter("transaction.processed.success", gomock.Any())
+	s.auditLogger.EXPECT().LogTransactionProcessingCompleted(gomock.Any(), transaction.ID, models.QueueOperationProcess, gomock.Any())
+
+	err := s.processingService.ProcessQueueItem(s.ctx, queueItem)
+
+	s.Require().NoError(err)
+	s.Equal(models.TransactionStatusCompleted, transaction.Status)
+	s.True(decimal.NewFromInt(1000).Equal(transaction.BalanceBefore))
+	s.True(decimal.NewFromInt(900).Equal(transaction.BalanceAfter))
+}
+
+// Test: Process Transaction - Insufficient Funds - Fails Without Retrying
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ProcessTransaction_InsufficientFunds_FailsWithoutRetry() {
+	accountID := uuid.New()
+	queueItem, transaction := s.submittedTransaction(accountID, decimal.NewFromInt(5000))
+	transaction.Reference = ""
+
+	s.accountRepo.EXPECT().GetByID(gomock.Any(), accountID).Return(&models.Account{ID: accountID, Balance: decimal.NewFromInt(1000)}, nil)
+	s.transactionRepo.EXPECT().CompletePending(gomock.Any(), transaction, 1, gomock.Any()).Return(repositories.ErrInsufficientFunds)
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), transaction, 1, models.TransactionFailureInsufficientFunds).DoAndReturn(failPending)
+	s.queueRepo.EXPECT().MarkFailed(gomock.Any(), queueItem.ID, testWorkerID, gomock.Any()).Return(nil)
+	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transaction.ID, models.TransactionStatusPending, models.TransactionStatusFailed)
+	s.metrics.EXPECT().IncrementCounter("transaction.processed.failed", map[string]string{
+		"operation": models.QueueOperationProcess,
+		"reason":    models.TransactionFailureInsufficientFunds,
+	})
+	s.auditLogger.EXPECT().LogTransactionProcessingFailed(gomock.Any(), transaction.ID, models.QueueOperationProcess, gomock.Any(), 0)
+	// No RecordFailure or IncrementRetry: the database is fine and a retry would fail the same way
+
+	err := s.processingService.ProcessQueueItem(s.ctx, queueItem)
+
+	s.ErrorIs(err, repositories.ErrInsufficientFunds)
+	s.Equal(models.TransactionStatusFailed, transaction.Status)
+	s.Equal(models.TransactionFailureInsufficientFunds, transaction.FailureReason())
+}
+
+// Test: Priority - Configured Account Types - Queued At High Priority
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_PriorityForAccountType() {
+	service := services.NewTransactionProcessingService(
+		s.transactionRepo,
+		s.queueRepo,
+		s.accountRepo,
+		s.auditLogger,
+		s.metrics,
+		s.circuitBreaker,
+		config.ProcessingQueueConfig{
+			WorkerID:                 testWorkerID,
+			MaxWorkers:               1,
+			HighPriorityAccountTypes: []string{models.AccountTypeChecking},
+		},
+	)
+
+	s.Equal(models.QueuePriorityHigh, service.PriorityForAccountType(models.AccountTypeChecking))
+	s.Equal(models.QueuePriorityNormal, service.PriorityForAccountType(models.AccountTypeSavings))
+}
+
+// expectQueueRunning lets StartProcessing read an unpaused queue control on every tick.
+func (s *TransactionProcessingServiceTestSuite) expectQueueRunning() {
+	s.queueRepo.EXPECT().GetControl(gomock.Any()).Return(models.NewProcessingQueueControl(), nil).AnyTimes()
+}
+
+// newLeaseTestService builds a service with short lease timings so heartbeats fire during a test
+func (s *TransactionProcessingServiceTestSuite) newLeaseTestService() services.TransactionProcessingServiceInterface {
+	return services.NewTransactionProcessingService(
+		s.transactionRepo,
+		s.queueRepo,
+		s.accountRepo,
+		s.auditLogger,
+		s.metrics,
+		s.circuitBreaker,
+		config.ProcessingQueueConfig{
+			WorkerID:          testWorkerID,
+			MaxWorkers:        2,
+			LeaseDuration:     time.Second,
+			HeartbeatInterval: 50 * time.Millisecond,
+			ReapInterval:      time.Hour,
+		},
+	)
+}
+
+// expectSlowProcessing expects one item claim whose transaction lookup blocks until release is closed
+func (s *TransactionProcessingServiceTestSuite) expectSlowProcessing(release chan struct{}) *models.ProcessingQueueItem {
+	queueItem := &models.ProcessingQueueItem{
+		ID:            uuid.New(),
+		TransactionID: uuid.New(),
+		Operation:     models.QueueOperationProcess,
+		Status:        models.QueueStatusProcessing,
+		MaxRetries:    3,
+		LockedBy:      testWorkerID,
+	}
+
+	s.circuitBreaker.EXPECT().IsOpen().Return(false).AnyTimes()
+	s.expectQueueRunning()
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), testWorkerID, 2, time.Second).Return([]*models.ProcessingQueueItem{queueItem}, nil).Times(1)
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), testWorkerID, gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	s.auditLogger.EXPECT().LogTransactionProcessingStarted(gomock.Any(), queueItem.TransactionID, queueItem.Operation)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), queueItem.TransactionID).DoAndReturn(func(context.Context, uuid.UUID) (*models.Transaction, error) {
+		<-release
+		return nil, errors.New("transaction lookup failed")
+	})
+	s.circuitBreaker.EXPECT().RecordFailure().AnyTimes()
+	s.auditLogger.EXPECT().LogRetryAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.queueRepo.EXPECT().IncrementRetry(gomock.Any(), queueItem.ID, testWorkerID).Return(nil).AnyTimes()
+	s.metrics.EXPECT().IncrementCounter("transaction.processing.retry", gomock.Any()).AnyTimes()
+
+	return queueItem
+}
+
+// Test: Leases - Long Running Item - Heartbeat Extends Lease
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_StartProcessing_LongRunningItem_HeartbeatExtendsLease() {
+	release := make(chan struct{})
+	queueItem := s.expectSlowProcessing(release)
+
+	heartbeats := make(chan struct{}, 10)
+	s.queueRepo.EXPECT().ExtendLease(gomock.Any(), queueItem.ID, testWorkerID, time.Second).DoAndReturn(func(context.Context, uuid.UUID, string, time.Duration) error {
+		heartbeats <- struct{}{}
+		return nil
+	}).MinTimes(2)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	done := make(chan struct{})
+	go func() {
+		s.newLeaseTestService().StartProcessing(ctx)
+		close(done)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-heartbeats:
+		case <-time.After(3 * time.Second):
+			s.FailNow("lease was not extended")
+		}
+	}
+
+	close(release)
+	cancel()
+	<-done
+}
+
+// Test: Leases - Lease Lost - Heartbeat Stops
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_StartProcessing_LeaseLost_StopsHeartbeat() {
+	release := make(chan struct{})
+	queueItem := s.expectSlowProcessing(release)
+
+	lost := make(chan struct{})
+	s.queueRepo.EXPECT().ExtendLease(gomock.Any(), queueItem.ID, testWorkerID, time.Second).Return(repositories.ErrQueueLeaseLost).Times(1)
+	s.metrics.EXPECT().IncrementCounter("queue.lease", map[string]string{"event": "lost"}).Do(func(string, map[string]string) {
+		close(lost)
+	})
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	done := make(chan struct{})
+	go func() {
+		s.newLeaseTestService().StartProcessing(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-lost:
+	case <-time.After(3 * time.Second):
+		s.FailNow("lost lease was not detected")
+	}
+
+	// Further heartbeat ticks must not extend a lease the worker no longer holds
+	time.Sleep(150 * time.Millisecond)
+	close(release)
+	cancel()
+	<-done
+}
+
+// Test: Shutdown - Drain - Waits For Claimed Item
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_Drain_WaitsForClaimedItem() {
+	release := make(chan struct{})
+	queueItem := s.expectSlowProcessing(release)
+
+	heartbeats := make(chan struct{}, 10)
+	s.queueRepo.EXPECT().ExtendLease(gomock.Any(), queueItem.ID, testWorkerID, time.Second).DoAndReturn(func(context.Context, uuid.UUID, string, time.Duration) error {
+		heartbeats <- struct{}{}
+		return nil
+	}).AnyTimes()
+
+	service := s.newLeaseTestService()
+	ctx, cancel := context.WithCancel(s.ctx)
+	done := make(chan struct{})
+	go func() {
+		service.StartProcessing(ctx)
+		close(done)
+	}()
+
+	// The item is in flight once its lease is being extended
+	select {
+	case <-heartbeats:
+	case <-time.After(3 * time.Second):
+		s.FailNow("item was not claimed")
+	}
+
+	drained := make(chan error, 1)
+	go func() { drained <- service.Drain(context.Background()) }()
+
+	select {
+	case <-drained:
+		s.FailNow("drain returned while the item was still processing")
+	case <-time.After(150 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case err := <-drained:
+		s.NoError(err)
+	case <-time.After(3 * time.Second):
+		s.FailNow("drain did not return after the item finished")
+	}
+
+	cancel()
+	<-done
+}
+
+// Test: Shutdown - Drain - Gives Up At Deadline
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_Drain_GivesUpAtDeadline() {
+	release := make(chan struct{})
+	queueItem := s.expectSlowProcessing(release)
+
+	heartbeats := make(chan struct{}, 10)
+	s.queueRepo.EXPECT().ExtendLease(gomock.Any(), queueItem.ID, testWorkerID, time.Second).DoAndReturn(func(context.Context, uuid.UUID, string, time.Duration) error {
+		heartbeats <- struct{}{}
+		return nil
+	}).AnyTimes()
+
+	service := s.newLeaseTestService()
+	ctx, cancel := context.WithCancel(s.ctx)
+	done := make(chan struct{})
+	go func() {
+		service.StartProcessing(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-heartbeats:
+	case <-time.After(3 * time.Second):
+		s.FailNow("item was not claimed")
+	}
+
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelDrain()
+	s.ErrorIs(service.Drain(drainCtx), context.DeadlineExceeded)
+
+	close(release)
+	cancel()
+	<-done
+}
+
+// Test: Leases - Circuit Breaker Open - Does Not Claim
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_StartProcessing_CircuitBreakerOpen_DoesNotClaim() {
+	s.circuitBreaker.EXPECT().IsOpen().Return(true).AnyTimes()
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 1500*time.Millisecond)
+	defer cancel()
+
+	s.processingService.StartProcessing(ctx)
+}
+
+// Test: Operator Controls - Workers Paused - Does Not Claim
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_StartProcessing_Paused_DoesNotClaim() {
+	s.circuitBreaker.EXPECT().IsOpen().Return(false).AnyTimes()
+	s.queueRepo.EXPECT().GetControl(gomock.Any()).Return(&models.ProcessingQueueControl{Paused: true}, nil).MinTimes(1)
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 1500*time.Millisecond)
+	defer cancel()
+
+	s.processingService.StartProcessing(ctx)
+}
+
+// Test: Operator Controls - Control Unreadable - Does Not Claim
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_StartProcessing_ControlError_DoesNotClaim() {
+	s.circuitBreaker.EXPECT().IsOpen().Return(false).AnyTimes()
+	s.queueRepo.EXPECT().GetControl(gomock.Any()).Return(nil, errors.New("connection refused")).MinTimes(1)
+	s.queueRepo.EXPECT().ClaimPending(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 1500*time.Millisecond)
+	defer cancel()
+
+	s.processingService.StartProcessing(ctx)
+}
+
+// Test: Leases - Expired Leases - Returned To Queue
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ReapExpiredLeases_RecordsReapedItems() {
+	s.queueRepo.EXPECT().ReapExpiredLeases(gomock.Any(), gomock.Any()).Return(int64(2), nil, nil).Times(1)
+	s.metrics.EXPECT().IncrementCounter("queue.lease", map[string]string{"event": "reaped"}).Times(2)
+
+	reaped, err := s.processingService.ReapExpiredLeases(s.ctx)
+
+	s.NoError(err)
+	s.Equal(int64(2), reaped)
+}
+
+// Test: Leases - Exhausted Items - Transactions Failed
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ReapExpiredLeases_FailsExhaustedItems() {
+	transaction := &models.Transaction{
+		ID:              uuid.New(),
+		AccountID:       uuid.New(),
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          decimal.NewFromFloat(100.0),
+		Status:          models.TransactionStatusPending,
+		Version:         1,
+	}
+	queueItem := &models.ProcessingQueueItem{
+		ID:            uuid.New(),
+		TransactionID: transaction.ID,
+		Operation:     models.QueueOperationProcess,
+		Status:        models.QueueStatusFailed,
+		RetryCount:    3,
+		MaxRetries:    3,
+	}
+
+	s.queueRepo.EXPECT().ReapExpiredLeases(gomock.Any(), gomock.Any()).Return(int64(1), []*models.ProcessingQueueItem{queueItem}, nil).Times(1)
+	s.metrics.EXPECT().IncrementCounter("queue.lease", map[string]string{"event": "reaped"}).Times(1)
+	s.transactionRepo.EXPECT().GetByID(gomock.Any(), transaction.ID).Return(transaction, nil).Times(1)
+	s.transactionRepo.EXPECT().FailPending(gomock.Any(), transaction, 1, models.TransactionFailureProcessingFailed).DoAndReturn(failPending).Times(1)
+	s.auditLogger.EXPECT().LogTransactionStateChange(gomock.Any(), transaction.ID, models.TransactionStatusPending, models.TransactionStatusFailed).Times(1)
+	s.metrics.EXPECT().IncrementCounter("transaction.processed.failed", map[string]string{"operation": models.QueueOperationProcess, "reason": "lease_expired"}).Times(1)
+	s.auditLogger.EXPECT().LogTransactionProcessingFailed(gomock.Any(), transaction.ID, models.QueueOperationProcess, "max retries exceeded", 3).Times(1)
+
+	reaped, err := s.processingService.ReapExpiredLeases(s.ctx)
+
+	s.NoError(err)
+	s.Equal(int64(2), reaped)
+	s.Equal(models.TransactionStatusFailed, transaction.Status)
+}
+
+// Test: Leases - Nothing Expired - No Metrics
+func (s *TransactionProcessingServiceTestSuite) TestTransactionProcessingService_ReapExpiredLeases_NothingExpired() {
+	s.queueRepo.EXPECT().ReapExpiredLeases(gomock.Any(), gomock.Any()).Return(int64(0), nil, nil).Times(1)
+
+	reaped, err := s.processingService.ReapExpiredLeases(s.ctx)
+
+	s.NoError(err)
+	s.Zero(reaped)
+}
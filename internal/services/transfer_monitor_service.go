@@ -2,22 +2,33 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
 	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
 )
 
 const (
 	monitorBatchLimit = 100
 )
 
+var (
+	ErrTransferNotFound     = errors.New("transfer not found")
+	ErrTransferNotEscalated = errors.New("transfer is not in the stuck transfer queue")
+)
+
 type transferMonitorService struct {
 	transferRepo    repositories.TransferRepositoryInterface
 	accountService  AccountServiceInterface
 	northwindClient NorthwindClientInterface
-	webhookService  WebhookServiceInterface
+	config          config.TransferMonitorConfig
 	logger          *slog.Logger
 }
 
@@ -25,40 +36,46 @@ func NewTransferMonitorService(
 	transferRepo repositories.TransferRepositoryInterface,
 	accountService AccountServiceInterface,
 	northwindClient NorthwindClientInterface,
-	webhookService WebhookServiceInterface,
+	cfg config.TransferMonitorConfig,
 ) TransferMonitorServiceInterface {
 	return &transferMonitorService{
 		transferRepo:    transferRepo,
 		accountService:  accountService,
 		northwindClient: northwindClient,
-		webhookService:  webhookService,
+		config:          cfg,
 		logger:          slog.Default().With("service", "TransferMonitor"),
 	}
 }
 
-// MonitorPendingTransfers checks the status of pending external transfers and updates them.
+// MonitorPendingTransfers reconciles external transfers whose status callback has not arrived.
+// Only transfers whose backoff has elapsed are checked; transfers older than MaxPendingAge that
+// are still unresolved are escalated to the admin stuck queue and no longer polled. Transfers whose
+// saga has not reached the partner are left to saga recovery.
 func (s *transferMonitorService) MonitorPendingTransfers(ctx context.Context) {
-	s.logger.Info("starting check for pending external transfers")
+	ctx, span := telemetry.StartSpan(ctx, "TransferMonitorService.MonitorPendingTransfers")
+	defer span.End()
+
+	s.logger.InfoContext(ctx, "starting check for pending external transfers")
 
-	transfers, err := s.transferRepo.FindPendingExternal(monitorBatchLimit)
+	transfers, err := s.transferRepo.FindPendingExternal(ctx, time.Now(), monitorBatchLimit)
 	if err != nil {
-		s.logger.Error("failed to fetch pending external transfers", "error", err)
+		s.logger.ErrorContext(ctx, "failed to fetch pending external transfers", "error", err)
 		return
 	}
 
 	if len(transfers) == 0 {
-		s.logger.Info("no pending external transfers to monitor")
+		s.logger.InfoContext(ctx, "no pending external transfers to monitor")
 		return
 	}
 
-	s.logger.Info("found pending external transfers", "count", len(transfers))
+	s.logger.InfoContext(ctx, "found pending external transfers", "count", len(transfers))
 
 	for _, transfer := range transfers {
 		if transfer.ExternalTransferID == nil || *transfer.ExternalTransferID == "" {
 			if time.Since(transfer.CreatedAt) > 5*time.Minute {
-				s.logger.Warn("failing transfer that is missing external ID", "transfer_id", transfer.ID)
+				s.logger.WarnContext(ctx, "failing transfer that is missing external ID", "transfer_id", transfer.ID)
 				if err := s.accountService.HandleFailedExternalTransfer(ctx, &transfer, "Transfer initiation failed; no external ID received."); err != nil {
-					s.logger.Error("failed to handle internally failed transfer", "transfer_id", transfer.ID, "error", err)
+					s.logger.ErrorContext(ctx, "failed to handle internally failed transfer", "transfer_id", transfer.ID, "error", err)
 				}
 			}
 			continue
@@ -68,41 +85,138 @@ func (s *transferMonitorService) MonitorPendingTransfers(ctx context.Context) {
 	}
 }
 
+// HandleStatusCallback applies a status pushed by Northwind. Deliveries that repeat the current
+// status, or arrive after a later status was already applied, are acknowledged without changes.
+func (s *transferMonitorService) HandleStatusCallback(ctx context.Context, data *dto.NorthwindTransferStatusData) (_ *models.Transfer, _ bool, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "TransferMonitorService.HandleStatusCallback")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	transfer, err := s.transferRepo.FindByExternalTransferID(ctx, data.TransferID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransferNotFound) {
+			return nil, false, ErrTransferNotFound
+		}
+		return nil, false, err
+	}
+
+	applied, err := s.applyPartnerStatus(ctx, transfer, data.Status, data.Reason)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return transfer, applied, nil
+}
+
+// ListEscalatedTransfers returns unresolved transfers in the admin stuck queue.
+func (s *transferMonitorService) ListEscalatedTransfers(ctx context.Context, offset, limit int) ([]models.Transfer, int64, error) {
+	return s.transferRepo.FindEscalatedExternal(ctx, offset, limit)
+}
+
+// RequeueEscalatedTransfer removes a transfer from the stuck queue and resets its backoff so
+// the next monitor run checks it again.
+func (s *transferMonitorService) RequeueEscalatedTransfer(ctx context.Context, transferID uuid.UUID) (*models.Transfer, error) {
+	transfer, err := s.transferRepo.FindByID(ctx, transferID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransferNotFound) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, err
+	}
+
+	if !transfer.IsEscalated() || transfer.IsTerminal() {
+		return nil, ErrTransferNotEscalated
+	}
+
+	transfer.ResetStatusChecks()
+	if err := s.transferRepo.UpdateStatusCheck(ctx, transfer); err != nil {
+		if errors.Is(err, repositories.ErrTransferStatusChanged) {
+			return nil, ErrTransferNotEscalated
+		}
+		return nil, fmt.Errorf("failed to requeue transfer: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "requeued escalated transfer", "transfer_id", transfer.ID)
+	return transfer, nil
+}
+
 func (s *transferMonitorService) checkAndUpdateTransferStatus(ctx context.Context, transfer models.Transfer) {
-	s.logger.Info("checking status for external transfer", "transfer_id", transfer.ID, "external_id", *transfer.ExternalTransferID)
+	s.logger.InfoContext(ctx, "checking status for external transfer", "transfer_id", transfer.ID, "external_id", *transfer.ExternalTransferID)
+
+	now := time.Now()
+	transfer.ScheduleNextStatusCheck(now, s.config.PollBaseInterval, s.config.PollMaxInterval)
 
 	nwTransfer, err := s.northwindClient.GetTransfer(ctx, *transfer.ExternalTransferID)
 	if err != nil {
-		s.logger.Error("failed to get transfer status from Northwind", "transfer_id", transfer.ID, "external_id", *transfer.ExternalTransferID, "error", err)
+		s.logger.ErrorContext(ctx, "failed to get transfer status from Northwind", "transfer_id", transfer.ID, "external_id", *transfer.ExternalTransferID, "error", err)
+	} else if _, err := s.applyPartnerStatus(ctx, &transfer, nwTransfer.Status, ""); err != nil {
+		s.logger.ErrorContext(ctx, "failed to apply transfer status", "transfer_id", transfer.ID, "status", nwTransfer.Status, "error", err)
 		return
 	}
 
-	if nwTransfer.Status == transfer.Status {
-		return // No change
+	if transfer.IsTerminal() {
+		return
 	}
 
-	s.logger.Info("status change detected for external transfer", "transfer_id", transfer.ID, "old_status", transfer.Status, "new_status", nwTransfer.Status)
+	escalate := s.config.MaxPendingAge > 0 && now.Sub(transfer.CreatedAt) > s.config.MaxPendingAge
+	if escalate {
+		transfer.Escalate(now)
+		s.logger.ErrorContext(ctx, "escalating stuck external transfer to admin queue", "transfer_id", transfer.ID, "status", transfer.Status, "age", now.Sub(transfer.CreatedAt).String(), "attempts", transfer.StatusCheckAttempts)
+	}
 
-	switch nwTransfer.Status {
-	case "completed":
-		transfer.Status = models.TransferStatusCompleted
-		now := time.Now()
-		transfer.CompletedAt = &now
-		if err := s.transferRepo.Update(&transfer); err != nil {
-			s.logger.Error("failed to update transfer status to completed", "transfer_id", transfer.ID, "error", err)
-		} else {
-			s.webhookService.QueueTransferNotification(ctx, &transfer)
+	if err := s.transferRepo.UpdateStatusCheck(ctx, &transfer); err != nil {
+		if errors.Is(err, repositories.ErrTransferStatusChanged) {
+			s.logger.InfoContext(ctx, "transfer status changed during status check", "transfer_id", transfer.ID)
+			return
 		}
-	case "failed":
-		if err := s.accountService.HandleFailedExternalTransfer(ctx, &transfer, "Transfer failed at external bank."); err != nil {
-			s.logger.Error("failed to handle failed external transfer", "transfer_id", transfer.ID, "error", err)
+		s.logger.ErrorContext(ctx, "failed to record transfer status check", "transfer_id", transfer.ID, "error", err)
+	}
+}
+
+// applyPartnerStatus moves the transfer to the partner's status when that is a valid forward
+// transition, persisting the change. It reports whether anything changed.
+func (s *transferMonitorService) applyPartnerStatus(ctx context.Context, transfer *models.Transfer, partnerStatus, reason string) (bool, error) {
+	if partnerStatus == transfer.Status {
+		return false, nil // No change
+	}
+
+	if !models.IsValidTransferStatus(partnerStatus) {
+		s.logger.WarnContext(ctx, "unknown transfer status from Northwind", "status", partnerStatus, "transfer_id", transfer.ID)
+		return false, nil
+	}
+
+	if !transfer.CanTransitionTo(partnerStatus) {
+		s.logger.InfoContext(ctx, "ignoring out-of-order transfer status", "transfer_id", transfer.ID, "current_status", transfer.Status, "partner_status", partnerStatus)
+		return false, nil
+	}
+
+	s.logger.InfoContext(ctx, "status change detected for external transfer", "transfer_id", transfer.ID, "old_status", transfer.Status, "new_status", partnerStatus)
+
+	switch partnerStatus {
+	case models.TransferStatusCompleted:
+		transfer.NextStatusCheckAt = nil
+		if err := s.accountService.CompleteExternalTransfer(ctx, transfer); err != nil {
+			return false, fmt.Errorf("failed to update transfer status to completed: %w", err)
+		}
+	case models.TransferStatusFailed:
+		if reason == "" {
+			reason = "Transfer failed at external bank."
 		}
-	case "processing":
-		transfer.Status = "processing"
-		if err := s.transferRepo.Update(&transfer); err != nil {
-			s.logger.Error("failed to update transfer status to processing", "transfer_id", transfer.ID, "error", err)
+		transfer.NextStatusCheckAt = nil
+		if err := s.accountService.HandleFailedExternalTransfer(ctx, transfer, reason); err != nil {
+			return false, fmt.Errorf("failed to handle failed external transfer: %w", err)
+		}
+	case models.TransferStatusProcessing:
+		from := transfer.Status
+		transfer.Status = models.TransferStatusProcessing
+		if err := s.transferRepo.UpdateStatusFrom(ctx, transfer, from); err != nil {
+			transfer.Status = from
+			if errors.Is(err, repositories.ErrTransferStatusChanged) {
+				s.logger.InfoContext(ctx, "transfer status changed concurrently", "transfer_id", transfer.ID, "expected_status", from)
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to update transfer status to processing: %w", err)
 		}
-	default:
-		s.logger.Warn("unknown transfer status from Northwind", "status", nwTransfer.Status, "transfer_id", transfer.ID)
 	}
+
+	return true, nil
 }
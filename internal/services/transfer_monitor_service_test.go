@@ -6,8 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/array/banking-api/internal/config"
 	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/northwindtest"
+	"github.com/array/banking-api/internal/repositories"
 	"github.com/array/banking-api/internal/repositories/repository_mocks"
 	"github.com/array/banking-api/internal/services/service_mocks"
 	"github.com/golang/mock/gomock"
@@ -21,7 +24,6 @@ type TransferMonitorServiceTestSuite struct {
 	transferRepo    *repository_mocks.MockTransferRepositoryInterface
 	accountService  *service_mocks.MockAccountServiceInterface
 	northwindClient *service_mocks.MockNorthwindClientInterface
-	webhookService  *service_mocks.MockWebhookServiceInterface
 	service         TransferMonitorServiceInterface
 }
 
@@ -30,8 +32,11 @@ func (s *TransferMonitorServiceTestSuite) SetupTest() {
 	s.transferRepo = repository_mocks.NewMockTransferRepositoryInterface(s.ctrl)
 	s.accountService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
 	s.northwindClient = service_mocks.NewMockNorthwindClientInterface(s.ctrl)
-	s.webhookService = service_mocks.NewMockWebhookServiceInterface(s.ctrl)
-	s.service = NewTransferMonitorService(s.transferRepo, s.accountService, s.northwindClient, s.webhookService)
+	s.service = NewTransferMonitorService(s.transferRepo, s.accountService, s.northwindClient, config.TransferMonitorConfig{
+		PollBaseInterval: 30 * time.Second,
+		PollMaxInterval:  10 * time.Minute,
+		MaxPendingAge:    72 * time.Hour,
+	})
 }
 
 func (s *TransferMonitorServiceTestSuite) TearDownTest() {
@@ -48,19 +53,20 @@ func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_Completed(
 		ID:                 uuid.New(),
 		ExternalTransferID: &externalID,
 		Status:             "processing",
+		CreatedAt:          time.Now(),
 	}
 
-	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
 	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
 		ID:     externalID,
 		Status: "completed",
 	}, nil)
-	s.transferRepo.EXPECT().Update(gomock.Any()).DoAndReturn(func(t *models.Transfer) error {
-		s.Equal(models.TransferStatusCompleted, t.Status)
-		s.NotNil(t.CompletedAt)
+	s.accountService.EXPECT().CompleteExternalTransfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal(transfer.ID, t.ID)
+		s.Nil(t.NextStatusCheckAt)
+		t.Status = models.TransferStatusCompleted
 		return nil
 	})
-	s.webhookService.EXPECT().QueueTransferNotification(gomock.Any(), gomock.Any()).Return(nil)
 
 	s.service.MonitorPendingTransfers(context.Background())
 }
@@ -71,14 +77,18 @@ func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_Failed() {
 		ID:                 uuid.New(),
 		ExternalTransferID: &externalID,
 		Status:             "processing",
+		CreatedAt:          time.Now(),
 	}
 
-	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
 	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
 		ID:     externalID,
 		Status: "failed",
 	}, nil)
-	s.accountService.EXPECT().HandleFailedExternalTransfer(gomock.Any(), gomock.Any(), "Transfer failed at external bank.").Return(nil)
+	s.accountService.EXPECT().HandleFailedExternalTransfer(gomock.Any(), gomock.Any(), "Transfer failed at external bank.").DoAndReturn(func(_ context.Context, t *models.Transfer, reason string) error {
+		t.Fail(reason)
+		return nil
+	})
 
 	s.service.MonitorPendingTransfers(context.Background())
 }
@@ -89,17 +99,48 @@ func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_StillProce
 		ID:                 uuid.New(),
 		ExternalTransferID: &externalID,
 		Status:             models.TransferStatusPending, // Our initial status
+		CreatedAt:          time.Now(),
 	}
 
-	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
 	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
 		ID:     externalID,
 		Status: "processing", // Northwind's processing status
 	}, nil)
-	s.transferRepo.EXPECT().Update(gomock.Any()).DoAndReturn(func(t *models.Transfer) error {
+	s.transferRepo.EXPECT().UpdateStatusFrom(gomock.Any(), gomock.Any(), models.TransferStatusPending).DoAndReturn(func(_ context.Context, t *models.Transfer, _ string) error {
 		s.Equal("processing", t.Status)
 		return nil
 	})
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal("processing", t.Status)
+		s.Equal(1, t.StatusCheckAttempts)
+		return nil
+	})
+
+	s.service.MonitorPendingTransfers(context.Background())
+}
+
+func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_CompletedByCallbackDuringCheck() {
+	externalID := "nw_txn_raced"
+	transfer := models.Transfer{
+		ID:                 uuid.New(),
+		ExternalTransferID: &externalID,
+		Status:             models.TransferStatusPending,
+		CreatedAt:          time.Now(),
+	}
+
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
+		ID:     externalID,
+		Status: "processing",
+	}, nil)
+	// A completion callback committed after the transfer was read; neither write may reopen it
+	s.transferRepo.EXPECT().UpdateStatusFrom(gomock.Any(), gomock.Any(), models.TransferStatusPending).Return(repositories.ErrTransferStatusChanged)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal(models.TransferStatusPending, t.Status)
+		return repositories.ErrTransferStatusChanged
+	})
+	s.transferRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 
 	s.service.MonitorPendingTransfers(context.Background())
 }
@@ -112,18 +153,18 @@ func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_StuckWitho
 		CreatedAt:          time.Now().Add(-10 * time.Minute), // Older than 5 minutes
 	}
 
-	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
 	s.accountService.EXPECT().HandleFailedExternalTransfer(gomock.Any(), gomock.Any(), "Transfer initiation failed; no external ID received.").Return(nil)
 
 	s.service.MonitorPendingTransfers(context.Background())
 }
 
 func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_NoPending() {
-	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any()).Return([]models.Transfer{}, nil)
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{}, nil)
 	// No other calls should be made
 	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
 	s.accountService.EXPECT().HandleFailedExternalTransfer(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-	s.transferRepo.EXPECT().Update(gomock.Any()).Times(0)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), gomock.Any()).Times(0)
 
 	s.service.MonitorPendingTransfers(context.Background())
 }
@@ -134,13 +175,301 @@ func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_NorthwindA
 		ID:                 uuid.New(),
 		ExternalTransferID: &externalID,
 		Status:             "processing",
+		CreatedAt:          time.Now(),
 	}
 
-	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
 	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(nil, errors.New("API is down"))
-	// No status updates should happen
+	// No status change; only the backoff for the next check is recorded
 	s.accountService.EXPECT().HandleFailedExternalTransfer(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-	s.transferRepo.EXPECT().Update(gomock.Any()).Times(0)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal("processing", t.Status)
+		s.Equal(1, t.StatusCheckAttempts)
+		s.NotNil(t.NextStatusCheckAt)
+		return nil
+	})
+
+	s.service.MonitorPendingTransfers(context.Background())
+}
+
+func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_NoChangeBacksOff() {
+	externalID := "nw_txn_unchanged"
+	transfer := models.Transfer{
+		ID:                  uuid.New(),
+		ExternalTransferID:  &externalID,
+		Status:              models.TransferStatusProcessing,
+		StatusCheckAttempts: 3,
+		CreatedAt:           time.Now().Add(-time.Hour),
+	}
+
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
+		ID:     externalID,
+		Status: "processing",
+	}, nil)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal(4, t.StatusCheckAttempts)
+		s.Require().NotNil(t.NextStatusCheckAt)
+		// Fourth unresolved check: 30s * 2^3 = 4m
+		s.WithinDuration(time.Now().Add(4*time.Minute), *t.NextStatusCheckAt, 5*time.Second)
+		s.Nil(t.EscalatedAt)
+		return nil
+	})
+
+	s.service.MonitorPendingTransfers(context.Background())
+}
+
+func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_BackoffCapped() {
+	externalID := "nw_txn_capped"
+	transfer := models.Transfer{
+		ID:                  uuid.New(),
+		ExternalTransferID:  &externalID,
+		Status:              models.TransferStatusProcessing,
+		StatusCheckAttempts: 20,
+		CreatedAt:           time.Now().Add(-time.Hour),
+	}
+
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
+		ID:     externalID,
+		Status: "processing",
+	}, nil)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.WithinDuration(time.Now().Add(10*time.Minute), *t.NextStatusCheckAt, 5*time.Second)
+		return nil
+	})
+
+	s.service.MonitorPendingTransfers(context.Background())
+}
+
+func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_EscalatesStuckTransfer() {
+	externalID := "nw_txn_stuck"
+	transfer := models.Transfer{
+		ID:                 uuid.New(),
+		ExternalTransferID: &externalID,
+		Status:             models.TransferStatusProcessing,
+		CreatedAt:          time.Now().Add(-73 * time.Hour),
+	}
+
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
+		ID:     externalID,
+		Status: "processing",
+	}, nil)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.NotNil(t.EscalatedAt)
+		s.Nil(t.NextStatusCheckAt)
+		return nil
+	})
+
+	s.service.MonitorPendingTransfers(context.Background())
+}
+
+func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_OldTransferCompletesInsteadOfEscalating() {
+	externalID := "nw_txn_late"
+	transfer := models.Transfer{
+		ID:                 uuid.New(),
+		ExternalTransferID: &externalID,
+		Status:             models.TransferStatusProcessing,
+		CreatedAt:          time.Now().Add(-73 * time.Hour),
+	}
+
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Transfer{transfer}, nil)
+	s.northwindClient.EXPECT().GetTransfer(gomock.Any(), externalID).Return(&dto.NorthwindGetTransferResponse{
+		ID:     externalID,
+		Status: "completed",
+	}, nil)
+	s.accountService.EXPECT().CompleteExternalTransfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal(transfer.ID, t.ID)
+		s.Nil(t.EscalatedAt)
+		t.Status = models.TransferStatusCompleted
+		return nil
+	})
 
 	s.service.MonitorPendingTransfers(context.Background())
 }
+
+func (s *TransferMonitorServiceTestSuite) TestHandleStatusCallback_Completed() {
+	externalID := "nw_txn_cb"
+	transfer := &models.Transfer{ID: uuid.New(), ExternalTransferID: &externalID, Status: models.TransferStatusProcessing}
+
+	s.transferRepo.EXPECT().FindByExternalTransferID(gomock.Any(), externalID).Return(transfer, nil)
+	s.accountService.EXPECT().CompleteExternalTransfer(gomock.Any(), transfer).Return(nil)
+
+	result, applied, err := s.service.HandleStatusCallback(context.Background(), &dto.NorthwindTransferStatusData{
+		TransferID: externalID,
+		Status:     "completed",
+	})
+	s.NoError(err)
+	s.True(applied)
+	s.Equal(transfer.ID, result.ID)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestHandleStatusCallback_FailedUsesPartnerReason() {
+	externalID := "nw_txn_cb_failed"
+	transfer := &models.Transfer{ID: uuid.New(), ExternalTransferID: &externalID, Status: models.TransferStatusPending}
+
+	s.transferRepo.EXPECT().FindByExternalTransferID(gomock.Any(), externalID).Return(transfer, nil)
+	s.accountService.EXPECT().HandleFailedExternalTransfer(gomock.Any(), transfer, "account closed").Return(nil)
+
+	_, applied, err := s.service.HandleStatusCallback(context.Background(), &dto.NorthwindTransferStatusData{
+		TransferID: externalID,
+		Status:     "failed",
+		Reason:     "account closed",
+	})
+	s.NoError(err)
+	s.True(applied)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestHandleStatusCallback_OutOfOrderIgnored() {
+	externalID := "nw_txn_cb_late"
+	transfer := &models.Transfer{ID: uuid.New(), ExternalTransferID: &externalID, Status: models.TransferStatusCompleted}
+
+	s.transferRepo.EXPECT().FindByExternalTransferID(gomock.Any(), externalID).Return(transfer, nil)
+	// A late "processing" delivery must not reopen a completed transfer
+	s.transferRepo.EXPECT().UpdateStatusFrom(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, applied, err := s.service.HandleStatusCallback(context.Background(), &dto.NorthwindTransferStatusData{
+		TransferID: externalID,
+		Status:     "processing",
+	})
+	s.NoError(err)
+	s.False(applied)
+	s.Equal(models.TransferStatusCompleted, result.Status)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestHandleStatusCallback_Redelivery() {
+	externalID := "nw_txn_cb_dup"
+	transfer := &models.Transfer{ID: uuid.New(), ExternalTransferID: &externalID, Status: models.TransferStatusProcessing}
+
+	s.transferRepo.EXPECT().FindByExternalTransferID(gomock.Any(), externalID).Return(transfer, nil)
+	s.transferRepo.EXPECT().UpdateStatusFrom(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, applied, err := s.service.HandleStatusCallback(context.Background(), &dto.NorthwindTransferStatusData{
+		TransferID: externalID,
+		Status:     "processing",
+	})
+	s.NoError(err)
+	s.False(applied)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestHandleStatusCallback_ProcessingLosesRace() {
+	externalID := "nw_txn_cb_raced"
+	transfer := &models.Transfer{ID: uuid.New(), ExternalTransferID: &externalID, Status: models.TransferStatusPending}
+
+	s.transferRepo.EXPECT().FindByExternalTransferID(gomock.Any(), externalID).Return(transfer, nil)
+	s.transferRepo.EXPECT().UpdateStatusFrom(gomock.Any(), transfer, models.TransferStatusPending).Return(repositories.ErrTransferStatusChanged)
+
+	result, applied, err := s.service.HandleStatusCallback(context.Background(), &dto.NorthwindTransferStatusData{
+		TransferID: externalID,
+		Status:     "processing",
+	})
+	s.NoError(err)
+	s.False(applied)
+	s.Equal(models.TransferStatusPending, result.Status)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestHandleStatusCallback_UnknownTransfer() {
+	s.transferRepo.EXPECT().FindByExternalTransferID(gomock.Any(), "nw_unknown").Return(nil, repositories.ErrTransferNotFound)
+
+	_, _, err := s.service.HandleStatusCallback(context.Background(), &dto.NorthwindTransferStatusData{
+		TransferID: "nw_unknown",
+		Status:     "completed",
+	})
+	s.ErrorIs(err, ErrTransferNotFound)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestRequeueEscalatedTransfer() {
+	escalatedAt := time.Now().Add(-time.Hour)
+	transfer := &models.Transfer{
+		ID:                  uuid.New(),
+		Status:              models.TransferStatusProcessing,
+		StatusCheckAttempts: 12,
+		EscalatedAt:         &escalatedAt,
+	}
+
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), transfer).Return(nil)
+
+	result, err := s.service.RequeueEscalatedTransfer(context.Background(), transfer.ID)
+	s.NoError(err)
+	s.Nil(result.EscalatedAt)
+	s.Equal(0, result.StatusCheckAttempts)
+	s.Nil(result.NextStatusCheckAt)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestRequeueEscalatedTransfer_ResolvedConcurrently() {
+	escalatedAt := time.Now().Add(-time.Hour)
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusProcessing, EscalatedAt: &escalatedAt}
+
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil)
+	s.transferRepo.EXPECT().UpdateStatusCheck(gomock.Any(), transfer).Return(repositories.ErrTransferStatusChanged)
+
+	_, err := s.service.RequeueEscalatedTransfer(context.Background(), transfer.ID)
+	s.ErrorIs(err, ErrTransferNotEscalated)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestRequeueEscalatedTransfer_NotEscalated() {
+	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusProcessing}
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), transfer.ID).Return(transfer, nil)
+
+	_, err := s.service.RequeueEscalatedTransfer(context.Background(), transfer.ID)
+	s.ErrorIs(err, ErrTransferNotEscalated)
+}
+
+func (s *TransferMonitorServiceTestSuite) TestRequeueEscalatedTransfer_NotFound() {
+	s.transferRepo.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrTransferNotFound)
+
+	_, err := s.service.RequeueEscalatedTransfer(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrTransferNotFound)
+}
+
+// TestMonitorPendingTransfers_AgainstSimulator polls the partner simulator with the real client
+func (s *TransferMonitorServiceTestSuite) TestMonitorPendingTransfers_AgainstSimulator() {
+	sim := northwindtest.NewServer(northwindtest.Options{Seed: 1})
+	defer sim.Close()
+
+	client := NewNorthwindClient(sim.NorthwindConfig(), nil)
+	service := NewTransferMonitorService(s.transferRepo, s.accountService, client, config.TransferMonitorConfig{
+		PollBaseInterval: 30 * time.Second,
+		PollMaxInterval:  10 * time.Minute,
+		MaxPendingAge:    72 * time.Hour,
+	})
+
+	completed, err := client.InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{
+		SourceAccountID:      "1012345678",
+		DestinationAccountID: uuid.NewString(),
+		Amount:               "50.00",
+		IdempotencyKey:       "transfer-completes",
+	})
+	s.Require().NoError(err)
+
+	sim.SetScenario(northwindtest.Scenario{FailureRate: 1, FailureReason: "account closed"})
+	failed, err := client.InitiateTransfer(context.Background(), &dto.NorthwindInitiateTransferRequest{
+		SourceAccountID:      "1012345678",
+		DestinationAccountID: uuid.NewString(),
+		Amount:               "75.00",
+		IdempotencyKey:       "transfer-fails",
+	})
+	s.Require().NoError(err)
+
+	transfers := []models.Transfer{
+		{ID: uuid.New(), ExternalTransferID: &completed.ID, Status: completed.Status, CreatedAt: time.Now()},
+		{ID: uuid.New(), ExternalTransferID: &failed.ID, Status: failed.Status, CreatedAt: time.Now()},
+	}
+
+	s.transferRepo.EXPECT().FindPendingExternal(gomock.Any(), gomock.Any(), gomock.Any()).Return(transfers, nil)
+	s.accountService.EXPECT().CompleteExternalTransfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, t *models.Transfer) error {
+		s.Equal(transfers[0].ID, t.ID)
+		t.Status = models.TransferStatusCompleted
+		return nil
+	})
+	// Polling does not carry the partner's reason; only status callbacks do
+	s.accountService.EXPECT().HandleFailedExternalTransfer(gomock.Any(), gomock.Any(), "Transfer failed at external bank.").DoAndReturn(func(_ context.Context, t *models.Transfer, reason string) error {
+		s.Equal(transfers[1].ID, t.ID)
+		t.Fail(reason)
+		return nil
+	})
+
+	service.MonitorPendingTransfers(context.Background())
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/google/uuid"
+)
+
+// transferReviewActivityLimit caps the recent transfers and transactions shown with a review.
+const transferReviewActivityLimit = 20
+
+var (
+	ErrTransferReviewNotFound = errors.New("transfer review not found")
+	ErrTransferReviewDecided  = errors.New("transfer review has already been decided")
+)
+
+type transferReviewService struct {
+	reviewRepo repositories.TransferReviewRepositoryInterface
+	auditRepo  repositories.AuditLogRepositoryInterface
+	triggers   []TransferReviewTrigger
+	config     config.TransferReviewConfig
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func NewTransferReviewService(
+	reviewRepo repositories.TransferReviewRepositoryInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	triggers []TransferReviewTrigger,
+	cfg config.TransferReviewConfig,
+) TransferReviewServiceInterface {
+	return &transferReviewService{
+		reviewRepo: reviewRepo,
+		auditRepo:  auditRepo,
+		triggers:   triggers,
+		config:     cfg,
+		logger:     slog.Default().With("service", "TransferReviewService"),
+		now:        time.Now,
+	}
+}
+
+// Evaluate runs every trigger against the transfer and returns the reasons it should be held.
+// No reasons means the transfer executes immediately.
+func (s *transferReviewService) Evaluate(ctx context.Context, req *dto.TransferReviewRequest) ([]dto.TransferReviewReason, error) {
+	if len(s.triggers) == 0 {
+		return nil, nil
+	}
+
+	history := &TransferReviewHistory{}
+	if req.IsExternal() {
+		count, err := s.reviewRepo.CountReleasedTransfersToExternalAccount(ctx, *req.ExternalAccountID)
+		if err != nil {
+			return nil, err
+		}
+		history.PriorPayeeTransfers = count
+	}
+
+	var reasons []dto.TransferReviewReason
+	for _, trigger := range s.triggers {
+		if reason, triggered := trigger.Evaluate(req, history); triggered {
+			reasons = append(reasons, dto.TransferReviewReason{Trigger: trigger.Name(), Reason: reason})
+		}
+	}
+	return reasons, nil
+}
+
+// Hold debits the source account and queues the transfer for review with an SLA deadline. The
+// repository errors for an inactive or underfunded account are returned unchanged.
+func (s *transferReviewService) Hold(ctx context.Context, userID uuid.UUID, transfer *models.Transfer, reasons []dto.TransferReviewReason, transferType, debitDescription string) (*models.TransferReview, error) {
+	review := &models.TransferReview{
+		UserID:       userID,
+		Reasons:      models.JSONBMap{"reasons": reasons},
+		TransferType: transferType,
+		DueAt:        s.now().Add(s.config.SLA),
+	}
+	if err := s.reviewRepo.CreateHeld(ctx, transfer, review, debitDescription); err != nil {
+		return nil, err
+	}
+
+	triggers := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		triggers = append(triggers, reason.Trigger)
+	}
+	s.logger.InfoContext(ctx, "transfer held for review", "transfer_id", transfer.ID, "review_id", review.ID, "triggers", triggers)
+	s.audit(ctx, &userID, "transfer.held_for_review", "transfer", transfer.ID.String(), models.JSONBMap{
+		"review_id": review.ID.String(),
+		"amount":    transfer.Amount.String(),
+		"triggers":  triggers,
+		"due_at":    review.DueAt,
+	})
+
+	return review, nil
+}
+
+func (s *transferReviewService) ListReviews(ctx context.Context, filters models.TransferReviewFilters, offset, limit int) ([]models.TransferReview, int64, error) {
+	return s.reviewRepo.List(ctx, filters, offset, limit)
+}
+
+func (s *transferReviewService) GetReview(ctx context.Context, reviewID uuid.UUID) (*models.TransferReview, error) {
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrTransferReviewNotFound) {
+			return nil, ErrTransferReviewNotFound
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+// GetCustomerActivity returns the customer's latest transfers and transactions, for deciding a
+// review in context.
+func (s *transferReviewService) GetCustomerActivity(ctx context.Context, userID uuid.UUID) (*dto.TransferReviewActivity, error) {
+	transfers, err := s.reviewRepo.ListRecentTransfers(ctx, userID, transferReviewActivityLimit)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := s.reviewRepo.ListRecentTransactions(ctx, userID, transferReviewActivityLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TransferReviewActivity{RecentTransfers: transfers, RecentTransactions: transactions}, nil
+}
+
+// Approve releases a held transfer. Internal transfers complete immediately; external transfers
+// return to pending with their saga at debit_posted and still have to be submitted to the partner.
+func (s *transferReviewService) Approve(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error) {
+	review, err := s.reviewRepo.Approve(ctx, reviewID, adminID, note)
+	if err != nil {
+		return nil, s.mapDecisionErr(err)
+	}
+
+	s.logger.InfoContext(ctx, "approved held transfer", "review_id", review.ID, "transfer_id", review.TransferID, "admin_id", adminID)
+	s.audit(ctx, &adminID, "transfer_review.approved", "transfer_review", review.ID.String(), models.JSONBMap{
+		"note":            note,
+		"transfer_id":     review.TransferID.String(),
+		"transfer_status": review.Transfer.Status,
+		"overdue":         review.ReviewedAt != nil && review.ReviewedAt.After(review.DueAt),
+	})
+	return review, nil
+}
+
+// Reject returns the reserved funds to the customer and fails the held transfer.
+func (s *transferReviewService) Reject(ctx context.Context, adminID, reviewID uuid.UUID, note string) (*models.TransferReview, error) {
+	review, err := s.reviewRepo.Reject(ctx, reviewID, adminID, note, "Transfer rejected after review", "Reversal for transfer rejected after review")
+	if err != nil {
+		return nil, s.mapDecisionErr(err)
+	}
+
+	s.logger.InfoContext(ctx, "rejected held transfer", "review_id", review.ID, "transfer_id", review.TransferID, "admin_id", adminID)
+	s.audit(ctx, &adminID, "transfer_review.rejected", "transfer_review", review.ID.String(), models.JSONBMap{
+		"note":           note,
+		"transfer_id":    review.TransferID.String(),
+		"reversal_tx_id": review.Transfer.ReversalTransactionID.String(),
+		"overdue":        review.ReviewedAt != nil && review.ReviewedAt.After(review.DueAt),
+	})
+	return review, nil
+}
+
+func (s *transferReviewService) mapDecisionErr(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrTransferReviewNotFound):
+		return ErrTransferReviewNotFound
+	case errors.Is(err, repositories.ErrTransferReviewDecided):
+		return ErrTransferReviewDecided
+	case errors.Is(err, repositories.ErrAccountNotActive):
+		return ErrAccountNotActive
+	case errors.Is(err, repositories.ErrAccountNotFound):
+		return ErrAccountNotFound
+	}
+	return fmt.Errorf("failed to decide transfer review: %w", err)
+}
+
+func (s *transferReviewService) audit(ctx context.Context, userID *uuid.UUID, action, resource, resourceID string, metadata models.JSONBMap) {
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     userID,
+		Action:     action,
+		Resource:   resource,
+		ResourceID: resourceID,
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
+	}
+}
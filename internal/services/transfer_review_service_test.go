@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransferReviewServiceTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	reviewRepo *repository_mocks.MockTransferReviewRepositoryInterface
+	auditRepo  *repository_mocks.MockAuditLogRepositoryInterface
+	service    *transferReviewService
+	config     config.TransferReviewConfig
+	now        time.Time
+	userID     uuid.UUID
+	adminID    uuid.UUID
+}
+
+func (s *TransferReviewServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.reviewRepo = repository_mocks.NewMockTransferReviewRepositoryInterface(s.ctrl)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.config = config.TransferReviewConfig{
+		InternalThreshold: decimal.NewFromInt(25000),
+		ExternalThreshold: decimal.NewFromInt(10000),
+		FirstPayeeHold:    true,
+		SLA:               4 * time.Hour,
+	}
+	s.service = NewTransferReviewService(s.reviewRepo, s.auditRepo, DefaultTransferReviewTriggers(s.config), s.config).(*transferReviewService)
+
+	s.now = time.Date(2026, 3, 6, 15, 0, 0, 0, time.UTC)
+	s.service.now = func() time.Time { return s.now }
+	s.userID = uuid.New()
+	s.adminID = uuid.New()
+}
+
+func (s *TransferReviewServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestTransferReviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferReviewServiceTestSuite))
+}
+
+func (s *TransferReviewServiceTestSuite) internalRequest(amount int64) *dto.TransferReviewRequest {
+	toAccountID := uuid.New()
+	return &dto.TransferReviewRequest{
+		UserID:      s.userID,
+		AccountID:   uuid.New(),
+		ToAccountID: &toAccountID,
+		Amount:      decimal.NewFromInt(amount),
+	}
+}
+
+func (s *TransferReviewServiceTestSuite) externalRequest(amount int64) *dto.TransferReviewRequest {
+	externalAccountID := uuid.New()
+	return &dto.TransferReviewRequest{
+		UserID:            s.userID,
+		AccountID:         uuid.New(),
+		ExternalAccountID: &externalAccountID,
+		Amount:            decimal.NewFromInt(amount),
+	}
+}
+
+func (s *TransferReviewServiceTestSuite) triggers(reasons []dto.TransferReviewReason) []string {
+	names := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		names = append(names, reason.Trigger)
+	}
+	return names
+}
+
+func (s *TransferReviewServiceTestSuite) TestEvaluate_InternalBelowThreshold() {
+	reasons, err := s.service.Evaluate(context.Background(), s.internalRequest(24999))
+	s.Require().NoError(err)
+	s.Empty(reasons)
+}
+
+func (s *TransferReviewServiceTestSuite) TestEvaluate_InternalAtThreshold() {
+	reasons, err := s.service.Evaluate(context.Background(), s.internalRequest(25000))
+	s.Require().NoError(err)
+	s.Equal([]string{TransferReviewTriggerAmountThreshold}, s.triggers(reasons))
+}
+
+func (s *TransferReviewServiceTestSuite) TestEvaluate_FirstExternalPayee() {
+	req := s.externalRequest(50)
+	s.reviewRepo.EXPECT().CountReleasedTransfersToExternalAccount(gomock.Any(), *req.ExternalAccountID).Return(int64(0), nil)
+
+	reasons, err := s.service.Evaluate(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]string{TransferReviewTriggerFirstPayee}, s.triggers(reasons))
+}
+
+func (s *TransferReviewServiceTestSuite) TestEvaluate_KnownPayeeAboveExternalThreshold() {
+	req := s.externalRequest(12000)
+	s.reviewRepo.EXPECT().CountReleasedTransfersToExternalAccount(gomock.Any(), *req.ExternalAccountID).Return(int64(3), nil)
+
+	reasons, err := s.service.Evaluate(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]string{TransferReviewTriggerAmountThreshold}, s.triggers(reasons))
+	s.Contains(reasons[0].Reason, "10000.00")
+}
+
+func (s *TransferReviewServiceTestSuite) TestEvaluate_CountError() {
+	req := s.externalRequest(50)
+	s.reviewRepo.EXPECT().CountReleasedTransfersToExternalAccount(gomock.Any(), gomock.Any()).Return(int64(0), errors.New("db down"))
+
+	_, err := s.service.Evaluate(context.Background(), req)
+	s.Error(err)
+}
+
+func (s *TransferReviewServiceTestSuite) TestEvaluate_DisabledTriggers() {
+	s.service.triggers = DefaultTransferReviewTriggers(config.TransferReviewConfig{})
+
+	reasons, err := s.service.Evaluate(context.Background(), s.externalRequest(1000000))
+	s.Require().NoError(err)
+	s.Empty(reasons)
+}
+
+func (s *TransferReviewServiceTestSuite) TestHold_SetsSLADeadline() {
+	transfer := &models.Transfer{ID: uuid.New(), Amount: decimal.NewFromInt(30000)}
+	reasons := []dto.TransferReviewReason{{Trigger: TransferReviewTriggerAmountThreshold, Reason: "large"}}
+
+	s.reviewRepo.EXPECT().CreateHeld(gomock.Any(), transfer, gomock.Any(), "Transfer to 1012345678: Rent").
+		DoAndReturn(func(_ context.Context, transfer *models.Transfer, review *models.TransferReview, debitDescription string) error {
+			review.ID = uuid.New()
+			review.TransferID = transfer.ID
+			return nil
+		})
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("transfer.held_for_review", log.Action)
+		s.Equal(&s.userID, log.UserID)
+		return nil
+	})
+
+	review, err := s.service.Hold(context.Background(), s.userID, transfer, reasons, "", "Transfer to 1012345678: Rent")
+	s.Require().NoError(err)
+	s.Equal(s.userID, review.UserID)
+	s.Equal(s.now.Add(4*time.Hour), review.DueAt)
+	s.Equal(reasons, review.Reasons["reasons"])
+}
+
+func (s *TransferReviewServiceTestSuite) TestHold_RepositoryError() {
+	transfer := &models.Transfer{ID: uuid.New(), Amount: decimal.NewFromInt(30000)}
+	s.reviewRepo.EXPECT().CreateHeld(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(repositories.ErrInsufficientFunds)
+
+	_, err := s.service.Hold(context.Background(), s.userID, transfer, nil, "", "Held")
+	s.ErrorIs(err, repositories.ErrInsufficientFunds)
+}
+
+func (s *TransferReviewServiceTestSuite) TestApprove_Audited() {
+	reviewID := uuid.New()
+	s.reviewRepo.EXPECT().Approve(gomock.Any(), reviewID, s.adminID, "Customer confirmed").Return(&models.TransferReview{
+		ID:       reviewID,
+		Status:   models.TransferReviewStatusApproved,
+		Transfer: models.Transfer{Status: models.TransferStatusCompleted},
+	}, nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("transfer_review.approved", log.Action)
+		s.Equal("Customer confirmed", log.Metadata["note"])
+		return nil
+	})
+
+	review, err := s.service.Approve(context.Background(), s.adminID, reviewID, "Customer confirmed")
+	s.Require().NoError(err)
+	s.Equal(models.TransferReviewStatusApproved, review.Status)
+}
+
+func (s *TransferReviewServiceTestSuite) TestApprove_MapsErrors() {
+	cases := map[error]error{
+		repositories.ErrTransferReviewNotFound: ErrTransferReviewNotFound,
+		repositories.ErrTransferReviewDecided:  ErrTransferReviewDecided,
+		repositories.ErrAccountNotActive:       ErrAccountNotActive,
+	}
+	for repoErr, want := range cases {
+		s.reviewRepo.EXPECT().Approve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repoErr)
+
+		_, err := s.service.Approve(context.Background(), s.adminID, uuid.New(), "note")
+		s.ErrorIs(err, want)
+	}
+}
+
+func (s *TransferReviewServiceTestSuite) TestReject_Audited() {
+	reviewID := uuid.New()
+	reversalID := uuid.New()
+	s.reviewRepo.EXPECT().Reject(gomock.Any(), reviewID, s.adminID, "Customer did not confirm", gomock.Any(), gomock.Any()).Return(&models.TransferReview{
+		ID:       reviewID,
+		Status:   models.TransferReviewStatusRejected,
+		Transfer: models.Transfer{Status: models.TransferStatusFailed, ReversalTransactionID: &reversalID},
+	}, nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("transfer_review.rejected", log.Action)
+		s.Equal(reversalID.String(), log.Metadata["reversal_tx_id"])
+		return nil
+	})
+
+	review, err := s.service.Reject(context.Background(), s.adminID, reviewID, "Customer did not confirm")
+	s.Require().NoError(err)
+	s.Equal(models.TransferReviewStatusRejected, review.Status)
+}
+
+func (s *TransferReviewServiceTestSuite) TestGetReview_NotFound() {
+	s.reviewRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, repositories.ErrTransferReviewNotFound)
+
+	_, err := s.service.GetReview(context.Background(), uuid.New())
+	s.ErrorIs(err, ErrTransferReviewNotFound)
+}
+
+func (s *TransferReviewServiceTestSuite) TestGetCustomerActivity() {
+	s.reviewRepo.EXPECT().ListRecentTransfers(gomock.Any(), s.userID, transferReviewActivityLimit).Return([]models.Transfer{{ID: uuid.New()}}, nil)
+	s.reviewRepo.EXPECT().ListRecentTransactions(gomock.Any(), s.userID, transferReviewActivityLimit).Return([]models.Transaction{{ID: uuid.New()}, {ID: uuid.New()}}, nil)
+
+	activity, err := s.service.GetCustomerActivity(context.Background(), s.userID)
+	s.Require().NoError(err)
+	s.Len(activity.RecentTransfers, 1)
+	s.Len(activity.RecentTransactions, 2)
+}
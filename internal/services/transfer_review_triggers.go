@@ -0,0 +1,72 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
+	"github.com/shopspring/decimal"
+)
+
+// Built-in transfer review trigger names
+const (
+	TransferReviewTriggerAmountThreshold = "amount_threshold"
+	TransferReviewTriggerFirstPayee      = "first_external_payee"
+)
+
+// TransferReviewTrigger is a pluggable check that holds a transfer for manual review. Evaluate
+// returns a reason when the transfer should be held.
+type TransferReviewTrigger interface {
+	Name() string
+	Evaluate(req *dto.TransferReviewRequest, history *TransferReviewHistory) (reason string, triggered bool)
+}
+
+// TransferReviewHistory is the customer activity triggers compare a transfer against.
+type TransferReviewHistory struct {
+	PriorPayeeTransfers int64 // Transfers to the payee released to the partner; external transfers only
+}
+
+// DefaultTransferReviewTriggers returns the built-in triggers configured from cfg. Triggers whose
+// settings disable them are left out.
+func DefaultTransferReviewTriggers(cfg config.TransferReviewConfig) []TransferReviewTrigger {
+	var triggers []TransferReviewTrigger
+	if cfg.InternalThreshold.IsPositive() || cfg.ExternalThreshold.IsPositive() {
+		triggers = append(triggers, amountThresholdTrigger{internal: cfg.InternalThreshold, external: cfg.ExternalThreshold})
+	}
+	if cfg.FirstPayeeHold {
+		triggers = append(triggers, firstPayeeTrigger{})
+	}
+	return triggers
+}
+
+// amountThresholdTrigger holds transfers at or above a threshold; internal and external transfers
+// have separate thresholds, and a zero threshold never triggers.
+type amountThresholdTrigger struct {
+	internal decimal.Decimal
+	external decimal.Decimal
+}
+
+func (amountThresholdTrigger) Name() string { return TransferReviewTriggerAmountThreshold }
+
+func (t amountThresholdTrigger) Evaluate(req *dto.TransferReviewRequest, history *TransferReviewHistory) (string, bool) {
+	threshold := t.internal
+	if req.IsExternal() {
+		threshold = t.external
+	}
+	if !threshold.IsPositive() || req.Amount.LessThan(threshold) {
+		return "", false
+	}
+	return fmt.Sprintf("amount %s is at or above the review threshold of %s", req.Amount.StringFixed(2), threshold.StringFixed(2)), true
+}
+
+// firstPayeeTrigger holds the first transfer to an external payee.
+type firstPayeeTrigger struct{}
+
+func (firstPayeeTrigger) Name() string { return TransferReviewTriggerFirstPayee }
+
+func (firstPayeeTrigger) Evaluate(req *dto.TransferReviewRequest, history *TransferReviewHistory) (string, bool) {
+	if !req.IsExternal() || history.PriorPayeeTransfers > 0 {
+		return "", false
+	}
+	return "first transfer to this payee", true
+}
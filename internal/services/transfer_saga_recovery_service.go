@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/telemetry"
+)
+
+const (
+	sagaRecoveryBatchLimit = 100
+)
+
+type transferSagaRecoveryService struct {
+	sagaRepo       repositories.TransferSagaRepositoryInterface
+	accountService AccountServiceInterface
+	gracePeriod    time.Duration
+	logger         *slog.Logger
+}
+
+// NewTransferSagaRecoveryService creates the worker that finishes external transfer sagas
+// interrupted after the debit was posted.
+func NewTransferSagaRecoveryService(
+	sagaRepo repositories.TransferSagaRepositoryInterface,
+	accountService AccountServiceInterface,
+	gracePeriod time.Duration,
+) TransferSagaRecoveryServiceInterface {
+	return &transferSagaRecoveryService{
+		sagaRepo:       sagaRepo,
+		accountService: accountService,
+		gracePeriod:    gracePeriod,
+		logger:         slog.Default().With("service", "TransferSagaRecovery"),
+	}
+}
+
+// RecoverIncompleteSagas resumes sagas that have been at debit_posted for longer than the grace
+// period. The grace period keeps the worker away from transfers still being submitted in-request.
+func (s *transferSagaRecoveryService) RecoverIncompleteSagas(ctx context.Context) int {
+	ctx, span := telemetry.StartSpan(ctx, "TransferSagaRecoveryService.RecoverIncompleteSagas")
+	defer span.End()
+
+	sagas, err := s.sagaRepo.FindStalled(ctx, time.Now().Add(-s.gracePeriod), sagaRecoveryBatchLimit)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to find stalled transfer sagas", "error", err)
+		return 0
+	}
+	if len(sagas) == 0 {
+		return 0
+	}
+
+	s.logger.InfoContext(ctx, "found stalled transfer sagas", "count", len(sagas))
+
+	recovered := 0
+	for _, saga := range sagas {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := s.accountService.ResumeExternalTransfer(ctx, saga.TransferID); err != nil {
+			s.logger.ErrorContext(ctx, "failed to recover transfer saga", "transfer_id", saga.TransferID, "recovery_attempts", saga.RecoveryAttempts, "error", err)
+			continue
+		}
+		recovered++
+	}
+	return recovered
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories/repository_mocks"
+	"github.com/array/banking-api/internal/services/service_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransferSagaRecoveryServiceTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	sagaRepo       *repository_mocks.MockTransferSagaRepositoryInterface
+	accountService *service_mocks.MockAccountServiceInterface
+	service        TransferSagaRecoveryServiceInterface
+}
+
+func (s *TransferSagaRecoveryServiceTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.sagaRepo = repository_mocks.NewMockTransferSagaRepositoryInterface(s.ctrl)
+	s.accountService = service_mocks.NewMockAccountServiceInterface(s.ctrl)
+	s.service = NewTransferSagaRecoveryService(s.sagaRepo, s.accountService, time.Minute)
+}
+
+func (s *TransferSagaRecoveryServiceTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func TestTransferSagaRecoveryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferSagaRecoveryServiceTestSuite))
+}
+
+func (s *TransferSagaRecoveryServiceTestSuite) TestRecoverIncompleteSagas() {
+	resumed := models.TransferSaga{TransferID: uuid.New(), Step: models.TransferSagaStepDebitPosted}
+	failing := models.TransferSaga{TransferID: uuid.New(), Step: models.TransferSagaStepDebitPosted}
+
+	s.sagaRepo.EXPECT().FindStalled(gomock.Any(), gomock.Any(), sagaRecoveryBatchLimit).DoAndReturn(func(_ context.Context, olderThan time.Time, _ int) ([]models.TransferSaga, error) {
+		s.WithinDuration(time.Now().Add(-time.Minute), olderThan, time.Second, "sagas inside the grace period are skipped")
+		return []models.TransferSaga{resumed, failing}, nil
+	})
+	s.accountService.EXPECT().ResumeExternalTransfer(gomock.Any(), resumed.TransferID).Return(nil)
+	s.accountService.EXPECT().ResumeExternalTransfer(gomock.Any(), failing.TransferID).Return(ErrNorthwindUnavailable)
+
+	s.Equal(1, s.service.RecoverIncompleteSagas(context.Background()))
+}
+
+func (s *TransferSagaRecoveryServiceTestSuite) TestRecoverIncompleteSagas_RepositoryError() {
+	s.sagaRepo.EXPECT().FindStalled(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database is down"))
+
+	s.Equal(0, s.service.RecoverIncompleteSagas(context.Background()))
+}
+
+func (s *TransferSagaRecoveryServiceTestSuite) TestRecoverIncompleteSagas_StopsWhenCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.sagaRepo.EXPECT().FindStalled(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.TransferSaga{{TransferID: uuid.New()}}, nil)
+	s.accountService.EXPECT().ResumeExternalTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	s.Equal(0, s.service.RecoverIncompleteSagas(ctx))
+}
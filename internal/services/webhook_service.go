@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
@@ -11,16 +12,27 @@ import (
 	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
 	"github.com/array/banking-api/internal/repositories"
+	"github.com/array/banking-api/internal/requestctx"
+	"github.com/array/banking-api/internal/telemetry"
+	"github.com/google/uuid"
 )
 
 const (
 	webhookBatchLimit    = 100
+	webhookReplayLimit   = 100 // Notifications replayed per bulk request
 	initialBackoffPeriod = 1 * time.Minute
 )
 
+var (
+	ErrWebhookNotificationNotFound     = errors.New("webhook notification not found")
+	ErrWebhookNotificationInvalidState = errors.New("webhook notification is not failed or dead-lettered")
+)
+
 type webhookService struct {
 	webhookRepo     repositories.WebhookNotificationRepositoryInterface
 	regulatorClient RegulatorClientInterface
+	auditRepo       repositories.AuditLogRepositoryInterface
+	metrics         MetricsRecorderInterface
 	regulatorConfig config.RegulatorConfig
 	logger          *slog.Logger
 }
@@ -28,20 +40,27 @@ type webhookService struct {
 func NewWebhookService(
 	webhookRepo repositories.WebhookNotificationRepositoryInterface,
 	regulatorClient RegulatorClientInterface,
+	auditRepo repositories.AuditLogRepositoryInterface,
+	metrics MetricsRecorderInterface,
 	regulatorConfig config.RegulatorConfig,
 ) WebhookServiceInterface {
 	return &webhookService{
 		webhookRepo:     webhookRepo,
 		regulatorClient: regulatorClient,
+		auditRepo:       auditRepo,
+		metrics:         metrics,
 		regulatorConfig: regulatorConfig,
 		logger:          slog.Default().With("service", "WebhookService"),
 	}
 }
 
 // QueueTransferNotification creates a database record to send a webhook for a transfer.
-func (s *webhookService) QueueTransferNotification(ctx context.Context, transfer *models.Transfer) error {
+func (s *webhookService) QueueTransferNotification(ctx context.Context, transfer *models.Transfer) (err error) {
+	ctx, span := telemetry.StartSpan(ctx, "WebhookService.QueueTransferNotification")
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if transfer.Status != models.TransferStatusCompleted && transfer.Status != models.TransferStatusFailed {
-		s.logger.Warn("attempted to queue webhook for transfer with non-terminal status", "transfer_id", transfer.ID, "status", transfer.Status)
+		s.logger.WarnContext(ctx, "attempted to queue webhook for transfer with non-terminal status", "transfer_id", transfer.ID, "status", transfer.Status)
 		return nil // Don't queue for non-terminal states
 	}
 
@@ -51,34 +70,38 @@ func (s *webhookService) QueueTransferNotification(ctx context.Context, transfer
 		Status:     models.WebhookStatusPending,
 	}
 
-	if err := s.webhookRepo.Create(notification); err != nil {
-		s.logger.Error("failed to create webhook notification record", "error", err, "transfer_id", transfer.ID)
+	if err := s.webhookRepo.Create(ctx, notification); err != nil {
+		s.logger.ErrorContext(ctx, "failed to create webhook notification record", "error", err, "transfer_id", transfer.ID)
 		return fmt.Errorf("failed to queue webhook notification: %w", err)
 	}
 
-	s.logger.Info("queued webhook notification for transfer", "transfer_id", transfer.ID, "notification_id", notification.ID)
+	s.logger.InfoContext(ctx, "queued webhook notification for transfer", "transfer_id", transfer.ID, "notification_id", notification.ID)
 	return nil
 }
 
 // ProcessPendingWebhooks fetches and sends pending webhooks.
 func (s *webhookService) ProcessPendingWebhooks(ctx context.Context) {
-	s.logger.Info("starting check for pending webhook notifications")
+	ctx, span := telemetry.StartSpan(ctx, "WebhookService.ProcessPendingWebhooks")
+	defer span.End()
 
-	notifications, err := s.webhookRepo.FindPending(webhookBatchLimit)
+	s.logger.InfoContext(ctx, "starting check for pending webhook notifications")
+
+	notifications, err := s.webhookRepo.FindPending(ctx, webhookBatchLimit)
 	if err != nil {
-		s.logger.Error("failed to fetch pending webhooks", "error", err)
+		s.logger.ErrorContext(ctx, "failed to fetch pending webhooks", "error", err)
 		return
 	}
 
 	if len(notifications) == 0 {
-		s.logger.Info("no pending webhooks to process")
+		s.logger.InfoContext(ctx, "no pending webhooks to process")
 		return
 	}
 
-	s.logger.Info("found pending webhooks to process", "count", len(notifications))
+	s.logger.InfoContext(ctx, "found pending webhooks to process", "count", len(notifications))
 
 	for _, notification := range notifications {
 		payload := &dto.RegulatorNotificationPayload{
+			EventID:     "evt_" + notification.ID.String(),
 			TransferID:  notification.Transfer.ID,
 			Status:      notification.Transfer.Status,
 			Amount:      notification.Transfer.Amount.String(),
@@ -88,7 +111,7 @@ func (s *webhookService) ProcessPendingWebhooks(ctx context.Context) {
 			Reason:      notification.Transfer.ErrorMessage,
 		}
 
-		statusCode, responseBody, err := s.regulatorClient.SendTransferNotification(ctx, payload)
+		statusCode, responseBody, err := s.regulatorClient.SendTransferNotification(ctx, payload, notification.Attempts+1)
 
 		now := time.Now()
 		notification.LastAttemptAt = &now
@@ -99,21 +122,200 @@ func (s *webhookService) ProcessPendingWebhooks(ctx context.Context) {
 		}
 
 		if err != nil {
-			s.logger.Warn("failed to send webhook notification", "error", err, "notification_id", notification.ID, "attempt", notification.Attempts)
+			s.logger.WarnContext(ctx, "failed to send webhook notification", "error", err, "notification_id", notification.ID, "attempt", notification.Attempts)
+			lastError := err.Error()
+			notification.LastError = &lastError
 			notification.Status = models.WebhookStatusFailed
 			if notification.Attempts < models.WebhookMaxAttempts {
 				// Exponential backoff: 1m, 2m, 4m, 8m
 				backoffDuration := initialBackoffPeriod * time.Duration(math.Pow(2, float64(notification.Attempts-1)))
 				nextAttempt := now.Add(backoffDuration)
 				notification.NextAttemptAt = &nextAttempt
+			} else {
+				s.logger.ErrorContext(ctx, "webhook notification moved to dead-letter queue", "notification_id", notification.ID, "transfer_id", notification.TransferID, "attempts", notification.Attempts)
+				notification.MarkDeadLettered()
+				s.metrics.IncrementCounter("webhook.dead_lettered", nil)
 			}
 		} else {
-			s.logger.Info("successfully sent webhook notification", "notification_id", notification.ID)
+			s.logger.InfoContext(ctx, "successfully sent webhook notification", "notification_id", notification.ID)
 			notification.Status = models.WebhookStatusSent
+			notification.LastError = nil
+		}
+
+		if err := s.webhookRepo.Update(ctx, &notification); err != nil {
+			s.logger.ErrorContext(ctx, "failed to update webhook notification status", "error", err, "notification_id", notification.ID)
+		}
+	}
+}
+
+// RecordDeadLetterMetrics exports the number of notifications in each status and sets the
+// DLQ alert while the dead-lettered count is at or above the configured threshold.
+func (s *webhookService) RecordDeadLetterMetrics(ctx context.Context) {
+	counts, err := s.webhookRepo.CountByStatus(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to count webhook notifications", "error", err)
+		return
+	}
+
+	for _, status := range []string{
+		models.WebhookStatusPending,
+		models.WebhookStatusSent,
+		models.WebhookStatusFailed,
+		models.WebhookStatusDeadLettered,
+		models.WebhookStatusResolved,
+	} {
+		s.metrics.RecordGauge("webhook_notifications", float64(counts[status]), map[string]string{"status": status})
+	}
+
+	depth := counts[models.WebhookStatusDeadLettered]
+	threshold := s.regulatorConfig.DeadLetterAlertThreshold
+	if threshold > 0 && depth >= int64(threshold) {
+		s.logger.ErrorContext(ctx, "regulator webhook dead-letter queue needs attention", "depth", depth, "threshold", threshold)
+		s.metrics.RecordGauge("webhook_dead_letter_alert", 1, nil)
+		return
+	}
+	s.metrics.RecordGauge("webhook_dead_letter_alert", 0, nil)
+}
+
+// ListNotifications lists notifications matching the filters, oldest first.
+func (s *webhookService) ListNotifications(ctx context.Context, filters models.WebhookNotificationFilters, offset, limit int) ([]models.WebhookNotification, int64, error) {
+	return s.webhookRepo.List(ctx, filters, offset, limit)
+}
+
+// GetNotification returns a single notification with its transfer.
+func (s *webhookService) GetNotification(ctx context.Context, notificationID uuid.UUID) (*models.WebhookNotification, error) {
+	notification, err := s.webhookRepo.GetByID(ctx, notificationID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWebhookNotificationNotFound) {
+			return nil, ErrWebhookNotificationNotFound
 		}
+		return nil, err
+	}
+	return notification, nil
+}
 
-		if err := s.webhookRepo.Update(&notification); err != nil {
-			s.logger.Error("failed to update webhook notification status", "error", err, "notification_id", notification.ID)
+// ReplayNotification resets a failed or dead-lettered notification so the next webhook run
+// sends it again with a full set of attempts.
+func (s *webhookService) ReplayNotification(ctx context.Context, adminID, notificationID uuid.UUID) (*models.WebhookNotification, error) {
+	notification, err := s.GetNotification(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.replay(ctx, adminID, notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+// ReplayNotifications replays the requested notifications, or the oldest in the requested
+// status. Notifications that cannot be replayed are skipped with the reason.
+func (s *webhookService) ReplayNotifications(ctx context.Context, adminID uuid.UUID, req *dto.ReplayWebhookNotificationsRequest) (*dto.ReplayWebhookNotificationsResponse, error) {
+	result := &dto.ReplayWebhookNotificationsResponse{
+		Replayed: []uuid.UUID{},
+		Skipped:  []dto.WebhookNotificationReplaySkip{},
+	}
+
+	if req.Status != "" {
+		notifications, _, err := s.webhookRepo.List(ctx, models.WebhookNotificationFilters{Statuses: []string{req.Status}}, 0, webhookReplayLimit)
+		if err != nil {
+			return nil, err
 		}
+		for i := range notifications {
+			if err := s.replayInto(ctx, result, adminID, &notifications[i]); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	for _, id := range req.NotificationIDs {
+		notification, err := s.GetNotification(ctx, id)
+		if err != nil {
+			if !errors.Is(err, ErrWebhookNotificationNotFound) {
+				return nil, err
+			}
+			result.Skipped = append(result.Skipped, dto.WebhookNotificationReplaySkip{ID: id, Reason: err.Error()})
+			continue
+		}
+		if err := s.replayInto(ctx, result, adminID, notification); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ResolveNotification closes a failed or dead-lettered notification, for example when the
+// regulator confirms it received the report through another channel.
+func (s *webhookService) ResolveNotification(ctx context.Context, adminID, notificationID uuid.UUID, note string) (*models.WebhookNotification, error) {
+	notification, err := s.GetNotification(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	if !notification.CanReplay() {
+		return nil, fmt.Errorf("%w: status is %s", ErrWebhookNotificationInvalidState, notification.Status)
+	}
+
+	previousStatus := notification.Status
+	notification.Resolve(adminID, note)
+	if err := s.webhookRepo.Update(ctx, notification); err != nil {
+		return nil, fmt.Errorf("failed to resolve webhook notification: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "resolved webhook notification", "notification_id", notification.ID, "admin_id", adminID)
+	s.audit(ctx, adminID, notification, "webhook_notification.resolved", models.JSONBMap{
+		"previous_status": previousStatus,
+		"note":            note,
+	})
+
+	return notification, nil
+}
+
+// replayInto replays one notification of a bulk request, recording it as skipped when it is
+// not in a replayable state. Other errors abort the request.
+func (s *webhookService) replayInto(ctx context.Context, result *dto.ReplayWebhookNotificationsResponse, adminID uuid.UUID, notification *models.WebhookNotification) error {
+	if err := s.replay(ctx, adminID, notification); err != nil {
+		if !errors.Is(err, ErrWebhookNotificationInvalidState) {
+			return err
+		}
+		result.Skipped = append(result.Skipped, dto.WebhookNotificationReplaySkip{ID: notification.ID, Reason: err.Error()})
+		return nil
+	}
+	result.Replayed = append(result.Replayed, notification.ID)
+	return nil
+}
+
+func (s *webhookService) replay(ctx context.Context, adminID uuid.UUID, notification *models.WebhookNotification) error {
+	if !notification.CanReplay() {
+		return fmt.Errorf("%w: status is %s", ErrWebhookNotificationInvalidState, notification.Status)
+	}
+
+	previousStatus, previousAttempts := notification.Status, notification.Attempts
+	notification.Replay()
+	if err := s.webhookRepo.Update(ctx, notification); err != nil {
+		return fmt.Errorf("failed to replay webhook notification: %w", err)
+	}
+
+	s.logger.Info("replaying webhook notification", "notification_id", notification.ID, "admin_id", adminID)
+	s.audit(ctx, adminID, notification, "webhook_notification.replayed", models.JSONBMap{
+		"previous_status":   previousStatus,
+		"previous_attempts": previousAttempts,
+	})
+
+	return nil
+}
+
+func (s *webhookService) audit(ctx context.Context, adminID uuid.UUID, notification *models.WebhookNotification, action string, metadata models.JSONBMap) {
+	metadata["transfer_id"] = notification.TransferID.String()
+	if err := s.auditRepo.Create(ctx, &models.AuditLog{
+		UserID:     &adminID,
+		Action:     action,
+		Resource:   "webhook_notification",
+		ResourceID: notification.ID.String(),
+		IPAddress:  requestctx.IPAddress(ctx),
+		UserAgent:  requestctx.UserAgent(ctx),
+		Metadata:   metadata,
+	}); err != nil {
+		s.logger.Error("failed to create audit log", "error", err, "action", action)
 	}
 }
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/array/banking-api/internal/config"
+	"github.com/array/banking-api/internal/dto"
 	"github.com/array/banking-api/internal/models"
+	"github.com/array/banking-api/internal/repositories"
 	"github.com/array/banking-api/internal/repositories/repository_mocks"
 	"github.com/array/banking-api/internal/services/service_mocks"
 	"github.com/golang/mock/gomock"
@@ -22,6 +24,8 @@ type WebhookServiceTestSuite struct {
 	ctrl            *gomock.Controller
 	webhookRepo     *repository_mocks.MockWebhookNotificationRepositoryInterface
 	regulatorClient *service_mocks.MockRegulatorClientInterface
+	auditRepo       *repository_mocks.MockAuditLogRepositoryInterface
+	metrics         *service_mocks.MockMetricsRecorderInterface
 	service         WebhookServiceInterface
 }
 
@@ -29,8 +33,10 @@ func (s *WebhookServiceTestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.webhookRepo = repository_mocks.NewMockWebhookNotificationRepositoryInterface(s.ctrl)
 	s.regulatorClient = service_mocks.NewMockRegulatorClientInterface(s.ctrl)
-	cfg := config.RegulatorConfig{WebhookURL: "https://example.com/webhook"}
-	s.service = NewWebhookService(s.webhookRepo, s.regulatorClient, cfg)
+	s.auditRepo = repository_mocks.NewMockAuditLogRepositoryInterface(s.ctrl)
+	s.metrics = service_mocks.NewMockMetricsRecorderInterface(s.ctrl)
+	cfg := config.RegulatorConfig{WebhookURL: "https://example.com/webhook", DeadLetterAlertThreshold: 3}
+	s.service = NewWebhookService(s.webhookRepo, s.regulatorClient, s.auditRepo, s.metrics, cfg)
 }
 
 func (s *WebhookServiceTestSuite) TearDownTest() {
@@ -44,7 +50,7 @@ func TestWebhookServiceTestSuite(t *testing.T) {
 func (s *WebhookServiceTestSuite) TestQueueTransferNotification_Completed() {
 	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusCompleted}
 
-	s.webhookRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(n *models.WebhookNotification) error {
+	s.webhookRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *models.WebhookNotification) error {
 		s.Equal(transfer.ID, n.TransferID)
 		s.Equal(models.WebhookStatusPending, n.Status)
 		return nil
@@ -57,7 +63,7 @@ func (s *WebhookServiceTestSuite) TestQueueTransferNotification_Completed() {
 func (s *WebhookServiceTestSuite) TestQueueTransferNotification_Failed() {
 	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusFailed}
 
-	s.webhookRepo.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	s.webhookRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	err := s.service.QueueTransferNotification(context.Background(), transfer)
 	s.NoError(err)
@@ -67,7 +73,7 @@ func (s *WebhookServiceTestSuite) TestQueueTransferNotification_NonTerminalStatu
 	transfer := &models.Transfer{ID: uuid.New(), Status: models.TransferStatusPending}
 
 	// Create should NOT be called
-	s.webhookRepo.EXPECT().Create(gomock.Any()).Times(0)
+	s.webhookRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
 
 	err := s.service.QueueTransferNotification(context.Background(), transfer)
 	s.NoError(err)
@@ -85,9 +91,12 @@ func (s *WebhookServiceTestSuite) TestProcessPendingWebhooks_Success() {
 		},
 	}
 
-	s.webhookRepo.EXPECT().FindPending(gomock.Any()).Return([]models.WebhookNotification{notification}, nil)
-	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any()).Return(http.StatusAccepted, `{"status":"received"}`, nil)
-	s.webhookRepo.EXPECT().Update(gomock.Any()).DoAndReturn(func(n *models.WebhookNotification) error {
+	s.webhookRepo.EXPECT().FindPending(gomock.Any(), gomock.Any()).Return([]models.WebhookNotification{notification}, nil)
+	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any(), 1).DoAndReturn(func(_ context.Context, payload *dto.RegulatorNotificationPayload, _ int) (int, string, error) {
+		s.Equal("evt_"+notification.ID.String(), payload.EventID, "the event ID is stable across retries")
+		return http.StatusAccepted, `{"status":"received"}`, nil
+	})
+	s.webhookRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *models.WebhookNotification) error {
 		s.Equal(models.WebhookStatusSent, n.Status)
 		s.Equal(1, n.Attempts)
 		s.NotNil(n.LastAttemptAt)
@@ -100,11 +109,11 @@ func (s *WebhookServiceTestSuite) TestProcessPendingWebhooks_Success() {
 }
 
 func (s *WebhookServiceTestSuite) TestProcessPendingWebhooks_FindPendingError() {
-	s.webhookRepo.EXPECT().FindPending(gomock.Any()).Return(nil, errors.New("database is down"))
+	s.webhookRepo.EXPECT().FindPending(gomock.Any(), gomock.Any()).Return(nil, errors.New("database is down"))
 
 	// No other calls should be made if fetching fails
-	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any()).Times(0)
-	s.webhookRepo.EXPECT().Update(gomock.Any()).Times(0)
+	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.webhookRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
 
 	// The service should log the error and return, not panic.
 	s.service.ProcessPendingWebhooks(context.Background())
@@ -123,15 +132,16 @@ func (s *WebhookServiceTestSuite) TestProcessPendingWebhooks_RetryLogic() {
 		},
 	}
 
-	s.webhookRepo.EXPECT().FindPending(gomock.Any()).Return([]models.WebhookNotification{notification}, nil)
-	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any()).Return(http.StatusInternalServerError, `{"error":"server unavailable"}`, errors.New("regulator is down"))
-	s.webhookRepo.EXPECT().Update(gomock.Any()).DoAndReturn(func(n *models.WebhookNotification) error {
+	s.webhookRepo.EXPECT().FindPending(gomock.Any(), gomock.Any()).Return([]models.WebhookNotification{notification}, nil)
+	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(http.StatusInternalServerError, `{"error":"server unavailable"}`, errors.New("regulator is down"))
+	s.webhookRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *models.WebhookNotification) error {
 		s.Equal(models.WebhookStatusFailed, n.Status)
 		s.Equal(1, n.Attempts)
 		s.NotNil(n.LastAttemptAt)
 		s.NotNil(n.NextAttemptAt)
 		s.Equal(http.StatusInternalServerError, *n.ResponseStatusCode)
 		s.Contains(*n.ResponseBody, "server unavailable")
+		s.Equal("regulator is down", *n.LastError)
 		s.True(n.NextAttemptAt.After(time.Now())) // Next attempt is in the future
 		return nil
 	})
@@ -140,10 +150,156 @@ func (s *WebhookServiceTestSuite) TestProcessPendingWebhooks_RetryLogic() {
 }
 
 func (s *WebhookServiceTestSuite) TestProcessPendingWebhooks_NoPending() {
-	s.webhookRepo.EXPECT().FindPending(gomock.Any()).Return([]models.WebhookNotification{}, nil)
+	s.webhookRepo.EXPECT().FindPending(gomock.Any(), gomock.Any()).Return([]models.WebhookNotification{}, nil)
 	// No other calls should be made
-	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any()).Times(0)
-	s.webhookRepo.EXPECT().Update(gomock.Any()).Times(0)
+	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	s.webhookRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+	s.service.ProcessPendingWebhooks(context.Background())
+}
+
+func (s *WebhookServiceTestSuite) TestProcessPendingWebhooks_FinalAttemptDeadLetters() {
+	notification := models.WebhookNotification{
+		ID:         uuid.New(),
+		TransferID: uuid.New(),
+		Status:     models.WebhookStatusFailed,
+		Attempts:   models.WebhookMaxAttempts - 1,
+		Transfer:   models.Transfer{ID: uuid.New(), Status: models.TransferStatusCompleted, Amount: decimal.NewFromInt(50)},
+	}
+
+	s.webhookRepo.EXPECT().FindPending(gomock.Any(), gomock.Any()).Return([]models.WebhookNotification{notification}, nil)
+	s.regulatorClient.EXPECT().SendTransferNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(http.StatusBadGateway, "", errors.New("bad gateway"))
+	s.metrics.EXPECT().IncrementCounter("webhook.dead_lettered", gomock.Any())
+	s.webhookRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, n *models.WebhookNotification) error {
+		s.Equal(models.WebhookStatusDeadLettered, n.Status)
+		s.Equal(models.WebhookMaxAttempts, n.Attempts)
+		s.NotNil(n.DeadLetteredAt)
+		s.Nil(n.NextAttemptAt)
+		return nil
+	})
 
 	s.service.ProcessPendingWebhooks(context.Background())
 }
+
+func (s *WebhookServiceTestSuite) TestRecordDeadLetterMetrics_RaisesAlertAtThreshold() {
+	s.webhookRepo.EXPECT().CountByStatus(gomock.Any()).Return(map[string]int64{
+		models.WebhookStatusSent:         10,
+		models.WebhookStatusDeadLettered: 3,
+	}, nil)
+	s.metrics.EXPECT().RecordGauge("webhook_notifications", float64(3), map[string]string{"status": models.WebhookStatusDeadLettered})
+	s.metrics.EXPECT().RecordGauge("webhook_notifications", float64(10), map[string]string{"status": models.WebhookStatusSent})
+	s.metrics.EXPECT().RecordGauge("webhook_notifications", float64(0), gomock.Any()).Times(3)
+	s.metrics.EXPECT().RecordGauge("webhook_dead_letter_alert", float64(1), gomock.Any())
+
+	s.service.RecordDeadLetterMetrics(context.Background())
+}
+
+func (s *WebhookServiceTestSuite) TestRecordDeadLetterMetrics_ClearsAlertBelowThreshold() {
+	s.webhookRepo.EXPECT().CountByStatus(gomock.Any()).Return(map[string]int64{models.WebhookStatusDeadLettered: 2}, nil)
+	s.metrics.EXPECT().RecordGauge("webhook_notifications", gomock.Any(), gomock.Any()).Times(5)
+	s.metrics.EXPECT().RecordGauge("webhook_dead_letter_alert", float64(0), gomock.Any())
+
+	s.service.RecordDeadLetterMetrics(context.Background())
+}
+
+func (s *WebhookServiceTestSuite) TestReplayNotification_ResetsAttempts() {
+	adminID := uuid.New()
+	notification := &models.WebhookNotification{ID: uuid.New(), TransferID: uuid.New(), Status: models.WebhookStatusDeadLettered, Attempts: models.WebhookMaxAttempts}
+	deadLetteredAt := time.Now()
+	notification.DeadLetteredAt = &deadLetteredAt
+
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), notification.ID).Return(notification, nil)
+	s.webhookRepo.EXPECT().Update(gomock.Any(), notification).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("webhook_notification.replayed", log.Action)
+		s.Equal(adminID, *log.UserID)
+		s.Equal(models.WebhookStatusDeadLettered, log.Metadata["previous_status"])
+		return nil
+	})
+
+	replayed, err := s.service.ReplayNotification(context.Background(), adminID, notification.ID)
+	s.Require().NoError(err)
+	s.Equal(models.WebhookStatusPending, replayed.Status)
+	s.Equal(0, replayed.Attempts)
+	s.Nil(replayed.DeadLetteredAt)
+	s.NotNil(replayed.NextAttemptAt)
+}
+
+func (s *WebhookServiceTestSuite) TestReplayNotification_RejectsSent() {
+	notification := &models.WebhookNotification{ID: uuid.New(), Status: models.WebhookStatusSent}
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), notification.ID).Return(notification, nil)
+
+	_, err := s.service.ReplayNotification(context.Background(), uuid.New(), notification.ID)
+	s.ErrorIs(err, ErrWebhookNotificationInvalidState)
+}
+
+func (s *WebhookServiceTestSuite) TestReplayNotification_NotFound() {
+	id := uuid.New()
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), id).Return(nil, repositories.ErrWebhookNotificationNotFound)
+
+	_, err := s.service.ReplayNotification(context.Background(), uuid.New(), id)
+	s.ErrorIs(err, ErrWebhookNotificationNotFound)
+}
+
+func (s *WebhookServiceTestSuite) TestReplayNotifications_ByIDsReportsSkipped() {
+	failed := &models.WebhookNotification{ID: uuid.New(), Status: models.WebhookStatusFailed, Attempts: 2}
+	sent := &models.WebhookNotification{ID: uuid.New(), Status: models.WebhookStatusSent}
+	missingID := uuid.New()
+
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), failed.ID).Return(failed, nil)
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), sent.ID).Return(sent, nil)
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), missingID).Return(nil, repositories.ErrWebhookNotificationNotFound)
+	s.webhookRepo.EXPECT().Update(gomock.Any(), failed).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	result, err := s.service.ReplayNotifications(context.Background(), uuid.New(), &dto.ReplayWebhookNotificationsRequest{
+		NotificationIDs: []uuid.UUID{failed.ID, sent.ID, missingID},
+	})
+	s.Require().NoError(err)
+	s.Equal([]uuid.UUID{failed.ID}, result.Replayed)
+	s.Require().Len(result.Skipped, 2)
+	s.Equal(sent.ID, result.Skipped[0].ID)
+	s.Equal(missingID, result.Skipped[1].ID)
+}
+
+func (s *WebhookServiceTestSuite) TestReplayNotifications_ByStatus() {
+	notifications := []models.WebhookNotification{
+		{ID: uuid.New(), Status: models.WebhookStatusDeadLettered, Attempts: models.WebhookMaxAttempts},
+		{ID: uuid.New(), Status: models.WebhookStatusDeadLettered, Attempts: models.WebhookMaxAttempts},
+	}
+	s.webhookRepo.EXPECT().List(gomock.Any(), models.WebhookNotificationFilters{Statuses: []string{models.WebhookStatusDeadLettered}}, 0, webhookReplayLimit).Return(notifications, int64(2), nil)
+	s.webhookRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	result, err := s.service.ReplayNotifications(context.Background(), uuid.New(), &dto.ReplayWebhookNotificationsRequest{Status: models.WebhookStatusDeadLettered})
+	s.Require().NoError(err)
+	s.Equal([]uuid.UUID{notifications[0].ID, notifications[1].ID}, result.Replayed)
+	s.Empty(result.Skipped)
+}
+
+func (s *WebhookServiceTestSuite) TestResolveNotification() {
+	adminID := uuid.New()
+	notification := &models.WebhookNotification{ID: uuid.New(), Status: models.WebhookStatusDeadLettered}
+
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), notification.ID).Return(notification, nil)
+	s.webhookRepo.EXPECT().Update(gomock.Any(), notification).Return(nil)
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+		s.Equal("webhook_notification.resolved", log.Action)
+		s.Equal("reported by phone", log.Metadata["note"])
+		return nil
+	})
+
+	resolved, err := s.service.ResolveNotification(context.Background(), adminID, notification.ID, "reported by phone")
+	s.Require().NoError(err)
+	s.Equal(models.WebhookStatusResolved, resolved.Status)
+	s.Equal(adminID, *resolved.ResolvedBy)
+	s.Equal("reported by phone", *resolved.ResolutionNote)
+}
+
+func (s *WebhookServiceTestSuite) TestResolveNotification_RejectsResolved() {
+	notification := &models.WebhookNotification{ID: uuid.New(), Status: models.WebhookStatusResolved}
+	s.webhookRepo.EXPECT().GetByID(gomock.Any(), notification.ID).Return(notification, nil)
+
+	_, err := s.service.ResolveNotification(context.Background(), uuid.New(), notification.ID, "again")
+	s.ErrorIs(err, ErrWebhookNotificationInvalidState)
+}
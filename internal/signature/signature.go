@@ -0,0 +1,100 @@
+// Package signature signs and verifies HMAC-SHA256 webhook payloads.
+//
+// The signed message is "<timestamp>.<body>", where timestamp is Unix seconds. Signatures are
+// hex encoded. Receivers reject timestamps outside a tolerance window to limit replay.
+//
+// During secret rotation a sender can sign with several secrets at once; the signatures are
+// sent comma separated and a receiver accepts the message if any of them verifies.
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTolerance is the maximum allowed clock skew between sender and receiver
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrMissingSignature        = errors.New("missing signature or timestamp")
+	ErrInvalidTimestamp        = errors.New("invalid signature timestamp")
+	ErrTimestampOutOfTolerance = errors.New("signature timestamp outside tolerance")
+	ErrSignatureMismatch       = errors.New("signature mismatch")
+)
+
+// Compute returns the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" using secret
+func Compute(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign returns the timestamp and signature headers for body at the given time
+func Sign(secret string, body []byte, now time.Time) (timestamp, sig string) {
+	timestamp = strconv.FormatInt(now.Unix(), 10)
+	return timestamp, Compute(secret, timestamp, body)
+}
+
+// SignAll returns the timestamp and a comma-separated signature header with one signature per
+// non-empty secret, so receivers holding either the current or the previous secret can verify
+// during rotation.
+func SignAll(secrets []string, body []byte, now time.Time) (timestamp, header string) {
+	timestamp = strconv.FormatInt(now.Unix(), 10)
+	sigs := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		if secret != "" {
+			sigs = append(sigs, Compute(secret, timestamp, body))
+		}
+	}
+	return timestamp, strings.Join(sigs, ",")
+}
+
+// Verify checks sig against body and timestamp using secret. A zero tolerance disables the
+// timestamp window check.
+func Verify(secret, timestamp, sig string, body []byte, tolerance time.Duration, now time.Time) error {
+	return VerifyAny([]string{secret}, timestamp, sig, body, tolerance, now)
+}
+
+// VerifyAny checks a comma-separated signature header against body and timestamp, succeeding if
+// any signature matches any of the secrets. A zero tolerance disables the timestamp window check.
+func VerifyAny(secrets []string, timestamp, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	if header == "" || timestamp == "" {
+		return ErrMissingSignature
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	if tolerance > 0 {
+		skew := now.Sub(time.Unix(unix, 0))
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > tolerance {
+			return ErrTimestampOutOfTolerance
+		}
+	}
+
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		expected := []byte(Compute(secret, timestamp, body))
+		for _, sig := range strings.Split(header, ",") {
+			if hmac.Equal(expected, []byte(strings.TrimSpace(sig))) {
+				return nil
+			}
+		}
+	}
+
+	return ErrSignatureMismatch
+}
@@ -0,0 +1,82 @@
+package signature
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SignatureTestSuite struct {
+	suite.Suite
+	secret string
+	body   []byte
+	now    time.Time
+}
+
+func (s *SignatureTestSuite) SetupTest() {
+	s.secret = "whsec_test"
+	s.body = []byte(`{"event_id":"evt_1"}`)
+	s.now = time.Unix(1_700_000_000, 0)
+}
+
+func TestSignatureTestSuite(t *testing.T) {
+	suite.Run(t, new(SignatureTestSuite))
+}
+
+func (s *SignatureTestSuite) TestSignAndVerify() {
+	timestamp, sig := Sign(s.secret, s.body, s.now)
+
+	s.Equal("1700000000", timestamp)
+	s.NoError(Verify(s.secret, timestamp, sig, s.body, DefaultTolerance, s.now.Add(time.Minute)))
+}
+
+func (s *SignatureTestSuite) TestVerify_TamperedBody() {
+	timestamp, sig := Sign(s.secret, s.body, s.now)
+
+	err := Verify(s.secret, timestamp, sig, []byte(`{"event_id":"evt_2"}`), DefaultTolerance, s.now)
+	s.ErrorIs(err, ErrSignatureMismatch)
+}
+
+func (s *SignatureTestSuite) TestVerify_WrongSecret() {
+	timestamp, sig := Sign("other", s.body, s.now)
+
+	err := Verify(s.secret, timestamp, sig, s.body, DefaultTolerance, s.now)
+	s.ErrorIs(err, ErrSignatureMismatch)
+}
+
+func (s *SignatureTestSuite) TestVerify_StaleTimestamp() {
+	timestamp, sig := Sign(s.secret, s.body, s.now)
+
+	err := Verify(s.secret, timestamp, sig, s.body, DefaultTolerance, s.now.Add(DefaultTolerance+time.Second))
+	s.ErrorIs(err, ErrTimestampOutOfTolerance)
+
+	// Timestamps too far in the future are rejected as well
+	err = Verify(s.secret, timestamp, sig, s.body, DefaultTolerance, s.now.Add(-DefaultTolerance-time.Second))
+	s.ErrorIs(err, ErrTimestampOutOfTolerance)
+}
+
+func (s *SignatureTestSuite) TestVerify_MissingOrMalformed() {
+	s.ErrorIs(Verify(s.secret, "", "abc", s.body, DefaultTolerance, s.now), ErrMissingSignature)
+	s.ErrorIs(Verify(s.secret, "123", "", s.body, DefaultTolerance, s.now), ErrMissingSignature)
+	s.ErrorIs(Verify(s.secret, "not-a-number", "abc", s.body, DefaultTolerance, s.now), ErrInvalidTimestamp)
+}
+
+func (s *SignatureTestSuite) TestSignAll_VerifiesWithEitherSecret() {
+	timestamp, header := SignAll([]string{"current", "", "previous"}, s.body, s.now)
+
+	s.Len(strings.Split(header, ","), 2, "empty secrets are skipped")
+	s.NoError(VerifyAny([]string{"current"}, timestamp, header, s.body, DefaultTolerance, s.now))
+	s.NoError(VerifyAny([]string{"previous"}, timestamp, header, s.body, DefaultTolerance, s.now))
+	s.NoError(Verify("previous", timestamp, header, s.body, DefaultTolerance, s.now))
+	s.ErrorIs(VerifyAny([]string{"retired"}, timestamp, header, s.body, DefaultTolerance, s.now), ErrSignatureMismatch)
+}
+
+func (s *SignatureTestSuite) TestVerifyAny_AcceptsAnyReceiverSecret() {
+	timestamp, sig := Sign("previous", s.body, s.now)
+
+	s.NoError(VerifyAny([]string{"current", "previous"}, timestamp, sig, s.body, DefaultTolerance, s.now))
+	s.ErrorIs(VerifyAny([]string{"", "current"}, timestamp, sig, s.body, DefaultTolerance, s.now), ErrSignatureMismatch)
+	s.ErrorIs(VerifyAny(nil, timestamp, sig, s.body, DefaultTolerance, s.now), ErrSignatureMismatch)
+}
@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/array/banking-api/internal/config"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type TelemetryTestSuite struct {
+	suite.Suite
+	previousProvider trace.TracerProvider
+}
+
+func (s *TelemetryTestSuite) SetupTest() {
+	s.previousProvider = otel.GetTracerProvider()
+}
+
+func (s *TelemetryTestSuite) TearDownTest() {
+	otel.SetTracerProvider(s.previousProvider)
+}
+
+func TestTelemetryTestSuite(t *testing.T) {
+	suite.Run(t, new(TelemetryTestSuite))
+}
+
+func (s *TelemetryTestSuite) TestSetup_FileExporterWritesSpans() {
+	path := filepath.Join(s.T().TempDir(), "traces.jsonl")
+	shutdown, err := Setup(context.Background(), config.TracingConfig{
+		ServiceName: "banking-api-test",
+		Exporter:    ExporterFile,
+		FilePath:    path,
+		SampleRatio: 1,
+	})
+	s.Require().NoError(err)
+
+	ctx, span := StartSpan(context.Background(), "AccountService.Test")
+	s.NotEmpty(TraceID(ctx))
+	span.End()
+	s.Require().NoError(shutdown(context.Background()))
+
+	written, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	s.Contains(string(written), "AccountService.Test")
+	s.Contains(string(written), "banking-api-test")
+}
+
+func (s *TelemetryTestSuite) TestSetup_UnknownExporter() {
+	_, err := Setup(context.Background(), config.TracingConfig{Exporter: "zipkin"})
+	s.True(errors.Is(err, ErrUnknownExporter))
+}
+
+func (s *TelemetryTestSuite) TestEndSpan_RecordsError() {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	_, span := StartSpan(context.Background(), "TransferMonitor.HandleStatusCallback")
+	EndSpan(span, errors.New("transfer not found"))
+
+	spans := recorder.Ended()
+	s.Require().Len(spans, 1)
+	s.Equal(codes.Error, spans[0].Status().Code)
+	s.Equal("transfer not found", spans[0].Status().Description)
+}
+
+func (s *TelemetryTestSuite) TestTraceID_NoSpan() {
+	s.Empty(TraceID(context.Background()))
+}
+
+func (s *TelemetryTestSuite) TestLogHandler_AddsTraceIDs() {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	var buf bytes.Buffer
+	logger := slog.New(NewLogHandler(slog.NewTextHandler(&buf, nil))).With("service", "Test")
+
+	ctx, span := StartSpan(context.Background(), "Test")
+	logger.InfoContext(ctx, "with span")
+	span.End()
+	s.Contains(buf.String(), "trace_id="+span.SpanContext().TraceID().String())
+	s.Contains(buf.String(), "span_id="+span.SpanContext().SpanID().String())
+	s.Contains(buf.String(), "service=Test")
+
+	buf.Reset()
+	logger.Info("without span")
+	s.NotContains(buf.String(), "trace_id")
+
+	buf.Reset()
+	logger.InfoContext(ctx, "with request trace ID", "trace_id", "client-trace-id")
+	s.Contains(buf.String(), "trace_id=client-trace-id")
+	s.Equal(1, strings.Count(buf.String(), "trace_id="))
+}